@@ -8,22 +8,29 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"time"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/config"
 	configmodels "github.com/archesai/archesai/pkg/config/models"
 	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/logger"
 	"github.com/archesai/archesai/pkg/server"
+	"github.com/archesai/archesai/pkg/webhooks"
 )
 
 // App composes all internal packages and provides unified initialization and startup.
 type App struct {
-	config    *config.Configuration[configmodels.Config]
-	db        *database.Database
-	apiServer *server.APIServer
-	handlers  *Handlers
+	config     *config.Configuration[configmodels.Config]
+	db         *database.Database
+	apiServer  *server.APIServer
+	handlers   *Handlers
+	relay      *events.OutboxRelay
+	subscriber events.Subscriber
+	dispatcher *webhooks.Dispatcher
+	purge      *database.PurgeJob
 }
 
 // NewApp creates a new App.
@@ -53,12 +60,21 @@ func (a *App) Initialize() error {
 	}
 	a.db = db
 
+	// Events are published and consumed in process
+	bus := events.NewMemoryBus()
+
 	// Create services and handlers
 	services := &Services{
 		DB:        db,
-		Publisher: events.NewNoOpPublisher(),
+		Publisher: bus,
+		TxManager: database.NewTxManager(db),
 	}
+	services.Auth = NewAuthService(services, auth.NewConfig(cfg.Config))
 	a.handlers = NewHandlers(services)
+	a.subscriber = bus
+	a.dispatcher = NewWebhookDispatcher(services)
+	a.relay = events.NewOutboxRelay(db, bus, events.DefaultRelayConfig())
+	a.purge = NewPurgeJob(services)
 
 	// Create API server
 	a.apiServer = server.NewAPIServer(&server.APIConfig{
@@ -69,14 +85,47 @@ func (a *App) Initialize() error {
 	// Register routes
 	RegisterRoutes(a.apiServer.Mux(), a.handlers)
 
-	// Apply middleware
-	a.apiServer.ApplyMiddleware()
+	// Apply middleware. Requests with an access token or API key act as its
+	// user, in its organization.
+	a.apiServer.ApplyMiddleware(server.NewAuthMiddleware(services.Auth).OptionalAuth())
 
 	return nil
 }
 
 // Start starts the application and blocks until shutdown signal is received.
 func (a *App) Start() error {
+	// Run background workers until shutdown
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	var workers sync.WaitGroup
+
+	// Publish the events repositories wrote to the outbox
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		if err := a.relay.Run(workerCtx); err != nil {
+			slog.Error("outbox relay error", "error", err)
+		}
+	}()
+
+	// Deliver events to webhook endpoints
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		if err := a.dispatcher.Run(workerCtx, a.subscriber); err != nil {
+			slog.Error("webhook dispatcher error", "error", err)
+		}
+	}()
+
+	// Purge deleted rows once they can no longer be restored
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		if err := a.purge.Run(workerCtx); err != nil {
+			slog.Error("purge job error", "error", err)
+		}
+	}()
+
 	// Start server in goroutine
 	go func() {
 		slog.Info("starting server", "port", a.config.Config.API.Port)
@@ -102,9 +151,13 @@ func (a *App) Start() error {
 		return err
 	}
 
+	// Stop the workers and wait for in-flight work
+	stopWorkers()
+	workers.Wait()
+
 	// Close database
 	if a.db != nil {
-		a.db.SQLDB().Close()
+		a.db.Close()
 	}
 
 	slog.Info("server shutdown complete")
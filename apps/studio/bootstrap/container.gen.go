@@ -5,7 +5,10 @@ package bootstrap
 import (
 	postgresrepos "github.com/archesai/archesai/apps/studio/infrastructure/postgres/repositories"
 	sqliterepos "github.com/archesai/archesai/apps/studio/infrastructure/sqlite/repositories"
+	"github.com/archesai/archesai/pkg/auth"
 	authbootstrap "github.com/archesai/archesai/pkg/auth/bootstrap"
+	authhandlers "github.com/archesai/archesai/pkg/auth/handlers"
+	"github.com/archesai/archesai/pkg/cache"
 	configbootstrap "github.com/archesai/archesai/pkg/config/bootstrap"
 	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/events"
@@ -13,12 +16,20 @@ import (
 	pipelinesbootstrap "github.com/archesai/archesai/pkg/pipelines/bootstrap"
 	serverbootstrap "github.com/archesai/archesai/pkg/server/bootstrap"
 	storagebootstrap "github.com/archesai/archesai/pkg/storage/bootstrap"
+	"github.com/archesai/archesai/pkg/webhooks"
+	webhooksbootstrap "github.com/archesai/archesai/pkg/webhooks/bootstrap"
+	webhookhandlers "github.com/archesai/archesai/pkg/webhooks/handlers"
 )
 
 // Services holds shared dependencies for all handlers.
 type Services struct {
 	DB        *database.Database
 	Publisher events.Publisher
+	// TxManager runs the writes of several repositories in one transaction.
+	TxManager *database.TxManager
+	// Auth authenticates requests and switches the organization of sessions.
+	// Without it, sessions cannot switch organizations.
+	Auth *auth.Service
 }
 
 // NewHandlers creates all handlers with the given services.
@@ -27,66 +38,212 @@ func NewHandlers(services *Services) *Handlers {
 	db := services.DB.SQLDB()
 
 	if services.DB.IsSQLite() {
+		authorizer := auth.NewMembershipAuthorizer(
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteRoleRepository(db),
+		)
+		webhookHandlers := webhooksbootstrap.NewApplicationHandlers(
+			sqliterepos.NewSQLiteWebhookDeliveryRepository(db),
+			sqliterepos.NewSQLiteWebhookEndpointRepository(db),
+		)
+		webhookHandlers.RedeliverWebhookDelivery = webhooks.NewRedeliverer(
+			sqliterepos.NewSQLiteWebhookDeliveryRepository(db),
+			services.Publisher,
+		)
+		webhookHandlers.CreateWebhookEndpoint = webhookhandlers.NewCreateWebhookEndpointWith(
+			sqliterepos.NewSQLiteWebhookEndpointRepository(db),
+		)
+		webhookHandlers.UpdateWebhookEndpoint = webhookhandlers.NewUpdateWebhookEndpointWith(
+			sqliterepos.NewSQLiteWebhookEndpointRepository(db),
+		)
+		authHandlers := authbootstrap.NewApplicationHandlers(
+			sqliterepos.NewSQLiteAPIKeyRepository(db),
+			sqliterepos.NewSQLiteAccountRepository(db),
+			sqliterepos.NewSQLiteInvitationRepository(db),
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteOrganizationRepository(db),
+			sqliterepos.NewSQLiteRoleRepository(db),
+			sqliterepos.NewSQLiteSessionRepository(db),
+			sqliterepos.NewSQLiteUserRepository(db),
+		)
+		authHandlers.CreateAPIKey = authhandlers.NewCreateAPIKeyWith(
+			sqliterepos.NewSQLiteAPIKeyRepository(db),
+		)
+		authHandlers.ListOrganizations = authhandlers.NewListOrganizationsWith(
+			sqliterepos.NewSQLiteOrganizationRepository(db),
+			sqliterepos.NewSQLiteMemberRepository(db),
+		)
+		authHandlers.CreateRole = authhandlers.NewCreateRoleWith(
+			sqliterepos.NewSQLiteRoleRepository(db),
+		)
+		authHandlers.UpdateMember = authhandlers.NewUpdateMemberWith(
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteRoleRepository(db),
+		)
+		if services.Auth != nil {
+			authHandlers.UpdateSession = authhandlers.NewUpdateSessionWith(services.Auth)
+		}
 		return &Handlers{
-			Auth: authbootstrap.NewHTTPHandlers(authbootstrap.NewApplicationHandlers(
-				sqliterepos.NewSQLiteAPIKeyRepository(db),
-				sqliterepos.NewSQLiteAccountRepository(db),
-				sqliterepos.NewSQLiteInvitationRepository(db),
-				sqliterepos.NewSQLiteMemberRepository(db),
-				sqliterepos.NewSQLiteOrganizationRepository(db),
-				sqliterepos.NewSQLiteSessionRepository(db),
-				sqliterepos.NewSQLiteUserRepository(db),
-				services.Publisher,
-			)),
+			Auth:   authbootstrap.NewHTTPHandlers(authHandlers, authorizer),
 			Config: configbootstrap.NewHTTPHandlers(configbootstrap.NewApplicationHandlers()),
 			Executor: executorbootstrap.NewHTTPHandlers(executorbootstrap.NewApplicationHandlers(
 				sqliterepos.NewSQLiteExecutorRepository(db),
-				services.Publisher,
-			)),
+			), authorizer),
 			Pipelines: pipelinesbootstrap.NewHTTPHandlers(pipelinesbootstrap.NewApplicationHandlers(
 				sqliterepos.NewSQLitePipelineRepository(db),
 				sqliterepos.NewSQLiteRunRepository(db),
 				sqliterepos.NewSQLiteToolRepository(db),
-				services.Publisher,
-			)),
+			), authorizer),
 			Server: serverbootstrap.NewHTTPHandlers(serverbootstrap.NewApplicationHandlers()),
 			Storage: storagebootstrap.NewHTTPHandlers(storagebootstrap.NewApplicationHandlers(
 				sqliterepos.NewSQLiteArtifactRepository(db),
 				sqliterepos.NewSQLiteLabelRepository(db),
-				services.Publisher,
-			)),
+			), authorizer),
+			Webhooks: webhooksbootstrap.NewHTTPHandlers(webhookHandlers, authorizer),
 		}
 	}
 
 	// PostgreSQL
 	pool := services.DB.PgxPool()
+	authorizer := auth.NewMembershipAuthorizer(
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresRoleRepository(pool),
+	)
+	webhookHandlers := webhooksbootstrap.NewApplicationHandlers(
+		postgresrepos.NewPostgresWebhookDeliveryRepository(pool),
+		postgresrepos.NewPostgresWebhookEndpointRepository(pool),
+	)
+	webhookHandlers.RedeliverWebhookDelivery = webhooks.NewRedeliverer(
+		postgresrepos.NewPostgresWebhookDeliveryRepository(pool),
+		services.Publisher,
+	)
+	webhookHandlers.CreateWebhookEndpoint = webhookhandlers.NewCreateWebhookEndpointWith(
+		postgresrepos.NewPostgresWebhookEndpointRepository(pool),
+	)
+	webhookHandlers.UpdateWebhookEndpoint = webhookhandlers.NewUpdateWebhookEndpointWith(
+		postgresrepos.NewPostgresWebhookEndpointRepository(pool),
+	)
+	authHandlers := authbootstrap.NewApplicationHandlers(
+		postgresrepos.NewPostgresAPIKeyRepository(pool),
+		postgresrepos.NewPostgresAccountRepository(pool),
+		postgresrepos.NewPostgresInvitationRepository(pool),
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresOrganizationRepository(pool),
+		postgresrepos.NewPostgresRoleRepository(pool),
+		postgresrepos.NewPostgresSessionRepository(pool),
+		postgresrepos.NewPostgresUserRepository(pool),
+	)
+	authHandlers.CreateAPIKey = authhandlers.NewCreateAPIKeyWith(
+		postgresrepos.NewPostgresAPIKeyRepository(pool),
+	)
+	authHandlers.ListOrganizations = authhandlers.NewListOrganizationsWith(
+		postgresrepos.NewPostgresOrganizationRepository(pool),
+		postgresrepos.NewPostgresMemberRepository(pool),
+	)
+	authHandlers.CreateRole = authhandlers.NewCreateRoleWith(
+		postgresrepos.NewPostgresRoleRepository(pool),
+	)
+	authHandlers.UpdateMember = authhandlers.NewUpdateMemberWith(
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresRoleRepository(pool),
+	)
+	if services.Auth != nil {
+		authHandlers.UpdateSession = authhandlers.NewUpdateSessionWith(services.Auth)
+	}
 	return &Handlers{
-		Auth: authbootstrap.NewHTTPHandlers(authbootstrap.NewApplicationHandlers(
-			postgresrepos.NewPostgresAPIKeyRepository(pool),
-			postgresrepos.NewPostgresAccountRepository(pool),
-			postgresrepos.NewPostgresInvitationRepository(pool),
-			postgresrepos.NewPostgresMemberRepository(pool),
-			postgresrepos.NewPostgresOrganizationRepository(pool),
-			postgresrepos.NewPostgresSessionRepository(pool),
-			postgresrepos.NewPostgresUserRepository(pool),
-			services.Publisher,
-		)),
+		Auth:   authbootstrap.NewHTTPHandlers(authHandlers, authorizer),
 		Config: configbootstrap.NewHTTPHandlers(configbootstrap.NewApplicationHandlers()),
 		Executor: executorbootstrap.NewHTTPHandlers(executorbootstrap.NewApplicationHandlers(
 			postgresrepos.NewPostgresExecutorRepository(pool),
-			services.Publisher,
-		)),
+		), authorizer),
 		Pipelines: pipelinesbootstrap.NewHTTPHandlers(pipelinesbootstrap.NewApplicationHandlers(
 			postgresrepos.NewPostgresPipelineRepository(pool),
 			postgresrepos.NewPostgresRunRepository(pool),
 			postgresrepos.NewPostgresToolRepository(pool),
-			services.Publisher,
-		)),
+		), authorizer),
 		Server: serverbootstrap.NewHTTPHandlers(serverbootstrap.NewApplicationHandlers()),
 		Storage: storagebootstrap.NewHTTPHandlers(storagebootstrap.NewApplicationHandlers(
 			postgresrepos.NewPostgresArtifactRepository(pool),
 			postgresrepos.NewPostgresLabelRepository(pool),
-			services.Publisher,
-		)),
+		), authorizer),
+		Webhooks: webhooksbootstrap.NewHTTPHandlers(webhookHandlers, authorizer),
 	}
 }
+
+// NewAuthService creates the service that authenticates requests with access
+// tokens and API keys.
+func NewAuthService(services *Services, cfg *auth.Config) *auth.Service {
+	if services.DB.IsSQLite() {
+		db := services.DB.SQLDB()
+		return auth.NewService(
+			cfg,
+			sqliterepos.NewSQLiteSessionRepository(db),
+			sqliterepos.NewSQLiteUserRepository(db),
+			sqliterepos.NewSQLiteAccountRepository(db),
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteAPIKeyRepository(db),
+			services.TxManager,
+			cache.NewMemoryCache[string](),
+			nil,
+			nil,
+		)
+	}
+
+	pool := services.DB.PgxPool()
+	return auth.NewService(
+		cfg,
+		postgresrepos.NewPostgresSessionRepository(pool),
+		postgresrepos.NewPostgresUserRepository(pool),
+		postgresrepos.NewPostgresAccountRepository(pool),
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresAPIKeyRepository(pool),
+		services.TxManager,
+		cache.NewMemoryCache[string](),
+		nil,
+		nil,
+	)
+}
+
+// NewWebhookDispatcher creates the worker that delivers published events to
+// webhook endpoints.
+func NewWebhookDispatcher(services *Services) *webhooks.Dispatcher {
+	if services.DB.IsSQLite() {
+		db := services.DB.SQLDB()
+		return webhooks.NewDispatcher(
+			sqliterepos.NewSQLiteWebhookEndpointRepository(db),
+			sqliterepos.NewSQLiteWebhookDeliveryRepository(db),
+			webhooks.NewSQLPendingLister(services.DB),
+			webhooks.DefaultConfig(),
+		)
+	}
+
+	pool := services.DB.PgxPool()
+	return webhooks.NewDispatcher(
+		postgresrepos.NewPostgresWebhookEndpointRepository(pool),
+		postgresrepos.NewPostgresWebhookDeliveryRepository(pool),
+		webhooks.NewSQLPendingLister(services.DB),
+		webhooks.DefaultConfig(),
+	)
+}
+
+// NewPurgeJob creates the worker that removes soft-deleted rows once they
+// can no longer be restored.
+func NewPurgeJob(services *Services) *database.PurgeJob {
+	if services.DB.IsSQLite() {
+		db := services.DB.SQLDB()
+		return database.NewPurgeJob(
+			database.DefaultPurgeConfig(),
+			sqliterepos.NewSQLiteArtifactRepository(db),
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteOrganizationRepository(db),
+		)
+	}
+
+	pool := services.DB.PgxPool()
+	return database.NewPurgeJob(
+		database.DefaultPurgeConfig(),
+		postgresrepos.NewPostgresArtifactRepository(pool),
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresOrganizationRepository(pool),
+	)
+}
@@ -0,0 +1,93 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqliterepos "github.com/archesai/archesai/apps/studio/infrastructure/sqlite/repositories"
+	authmodels "github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	storagemodels "github.com/archesai/archesai/pkg/storage/models"
+)
+
+func TestArtifactLabelRoutes(t *testing.T) {
+	app := newTenantApp(t)
+	ctx := context.Background()
+	sqlDB := app.db.SQLDB()
+
+	_, err := app.services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	user, err := sqliterepos.NewSQLiteUserRepository(sqlDB).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	org := app.organization(t, "first", user.ID)
+
+	// Jane administers her organization, so she can label its artifacts
+	members := sqliterepos.NewSQLiteMemberRepository(sqlDB)
+	admin, err := members.GetMemberByUserAndOrganization(ctx, user.ID.String(), org.String())
+	require.NoError(t, err)
+	admin.Role = authmodels.MemberRoleAdmin
+	_, err = members.Update(ctx, admin.ID, admin)
+	require.NoError(t, err)
+	tokens, err := app.services.Auth.AuthenticateWithPassword(ctx, "jane@example.com", "secure-password-123")
+	require.NoError(t, err)
+
+	tenantCtx := database.WithTenant(ctx, org)
+	artifact, err := storagemodels.NewArtifact(0, nil, "text/plain", nil, org, nil, nil, nil, nil)
+	require.NoError(t, err)
+	_, err = sqliterepos.NewSQLiteArtifactRepository(sqlDB).Create(tenantCtx, artifact)
+	require.NoError(t, err)
+	label, err := storagemodels.NewLabel("draft", org)
+	require.NoError(t, err)
+	_, err = sqliterepos.NewSQLiteLabelRepository(sqlDB).Create(tenantCtx, label)
+	require.NoError(t, err)
+
+	labels := "/artifacts/" + artifact.ID.String() + "/labels"
+	labelNames := func() []string {
+		t.Helper()
+		rec := app.do(t, http.MethodGet, labels, tokens.AccessToken, "")
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var list struct {
+			Data []storagemodels.Label `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+		names := make([]string, 0, len(list.Data))
+		for _, l := range list.Data {
+			names = append(names, l.Name)
+		}
+		return names
+	}
+	assert.Empty(t, labelNames())
+
+	// Adding a label twice keeps one association
+	for range 2 {
+		rec := app.do(t, http.MethodPut, labels+"/"+label.ID.String(), tokens.AccessToken, "")
+		require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	}
+	assert.Equal(t, []string{"draft"}, labelNames())
+
+	rec := app.do(t, http.MethodDelete, labels+"/"+label.ID.String(), tokens.AccessToken, "")
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	assert.Empty(t, labelNames())
+
+	// Labels of other organizations cannot be added, nor are they listed
+	other := app.organization(t, "other", uuid.Nil)
+	foreign, err := storagemodels.NewLabel("secret", other)
+	require.NoError(t, err)
+	_, err = sqliterepos.NewSQLiteLabelRepository(sqlDB).Create(database.WithTenant(ctx, other), foreign)
+	require.NoError(t, err)
+	rec = app.do(t, http.MethodPut, labels+"/"+foreign.ID.String(), tokens.AccessToken, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+	rec = app.do(t, http.MethodPut, labels+"/"+uuid.NewString(), tokens.AccessToken, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	_, err = sqlDB.ExecContext(ctx, `INSERT INTO artifact_labels (artifact_id, label_id) VALUES (?, ?)`,
+		artifact.ID.String(), foreign.ID.String())
+	require.NoError(t, err)
+	assert.Empty(t, labelNames())
+}
@@ -11,6 +11,7 @@ import (
 	pipelinesbootstrap "github.com/archesai/archesai/pkg/pipelines/bootstrap"
 	serverbootstrap "github.com/archesai/archesai/pkg/server/bootstrap"
 	storagebootstrap "github.com/archesai/archesai/pkg/storage/bootstrap"
+	webhooksbootstrap "github.com/archesai/archesai/pkg/webhooks/bootstrap"
 )
 
 // Handlers composes all handlers from internal packages.
@@ -21,6 +22,7 @@ type Handlers struct {
 	Pipelines *pipelinesbootstrap.HTTPHandlers
 	Server    *serverbootstrap.HTTPHandlers
 	Storage   *storagebootstrap.HTTPHandlers
+	Webhooks  *webhooksbootstrap.HTTPHandlers
 }
 
 // RegisterRoutes registers all routes from all internal packages.
@@ -31,4 +33,5 @@ func RegisterRoutes(mux *http.ServeMux, handlers *Handlers) {
 	pipelinesbootstrap.RegisterRoutes(mux, handlers.Pipelines)
 	serverbootstrap.RegisterRoutes(mux, handlers.Server)
 	storagebootstrap.RegisterRoutes(mux, handlers.Storage)
+	webhooksbootstrap.RegisterRoutes(mux, handlers.Webhooks)
 }
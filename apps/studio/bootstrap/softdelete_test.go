@@ -0,0 +1,120 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqliterepos "github.com/archesai/archesai/apps/studio/infrastructure/sqlite/repositories"
+	authmodels "github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	storagemodels "github.com/archesai/archesai/pkg/storage/models"
+)
+
+func TestSoftDeleteRoutes(t *testing.T) {
+	app := newTenantApp(t)
+	ctx := context.Background()
+	sqlDB := app.db.SQLDB()
+	members := sqliterepos.NewSQLiteMemberRepository(sqlDB)
+
+	_, err := app.services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	jane, err := sqliterepos.NewSQLiteUserRepository(sqlDB).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	promote := func(org uuid.UUID) {
+		t.Helper()
+		admin, err := members.GetMemberByUserAndOrganization(ctx, jane.ID.String(), org.String())
+		require.NoError(t, err)
+		admin.Role = authmodels.MemberRoleAdmin
+		_, err = members.Update(ctx, admin.ID, admin)
+		require.NoError(t, err)
+	}
+	org := app.organization(t, "first", jane.ID)
+	promote(org)
+	tokens, err := app.services.Auth.AuthenticateWithPassword(ctx, "jane@example.com", "secure-password-123")
+	require.NoError(t, err)
+
+	// dataID returns the ID of the entity in the data of a response
+	dataID := func(t *testing.T, body []byte) uuid.UUID {
+		t.Helper()
+		var out struct {
+			Data struct {
+				ID uuid.UUID `json:"id"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(body, &out))
+		return out.Data.ID
+	}
+
+	t.Run("artifact", func(t *testing.T) {
+		artifact, err := storagemodels.NewArtifact(0, nil, "text/plain", nil, org, nil, nil, nil, nil)
+		require.NoError(t, err)
+		_, err = sqliterepos.NewSQLiteArtifactRepository(sqlDB).Create(database.WithTenant(ctx, org), artifact)
+		require.NoError(t, err)
+		path := "/artifacts/" + artifact.ID.String()
+
+		rec := app.do(t, http.MethodGet, path, tokens.AccessToken, "")
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, artifact.ID, dataID(t, rec.Body.Bytes()))
+
+		rec = app.do(t, http.MethodDelete, path, tokens.AccessToken, "")
+		require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+		rec = app.do(t, http.MethodGet, path, tokens.AccessToken, "")
+		assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+		rec = app.do(t, http.MethodPost, path+"/restore", tokens.AccessToken, "")
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, artifact.ID, dataID(t, rec.Body.Bytes()))
+		rec = app.do(t, http.MethodGet, path, tokens.AccessToken, "")
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		// Only deleted artifacts can be restored
+		rec = app.do(t, http.MethodPost, path+"/restore", tokens.AccessToken, "")
+		assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+	})
+
+	t.Run("member", func(t *testing.T) {
+		_, err := app.services.Auth.Register(ctx, "bob@example.com", "secure-password-123", "Bob")
+		require.NoError(t, err)
+		bob, err := sqliterepos.NewSQLiteUserRepository(sqlDB).GetUserByEmail(ctx, "bob@example.com")
+		require.NoError(t, err)
+		member, err := authmodels.NewMember(org, authmodels.MemberRoleBasic, nil, bob.ID)
+		require.NoError(t, err)
+		_, err = members.Create(ctx, member)
+		require.NoError(t, err)
+
+		// Jane administers another organization, whose path does not restore
+		// the members of the first
+		other := app.organization(t, "other", jane.ID)
+		promote(other)
+
+		path := "/organizations/" + org.String() + "/members/" + member.ID.String()
+		rec := app.do(t, http.MethodDelete, path, tokens.AccessToken, "")
+		require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+		rec = app.do(t, http.MethodPost, "/organizations/"+other.String()+"/members/"+member.ID.String()+"/restore", tokens.AccessToken, "")
+		assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+		rec = app.do(t, http.MethodPost, path+"/restore", tokens.AccessToken, "")
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, member.ID, dataID(t, rec.Body.Bytes()))
+		rec = app.do(t, http.MethodGet, path, tokens.AccessToken, "")
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	})
+
+	t.Run("organization slug", func(t *testing.T) {
+		// A deleted organization does not hold on to its slug
+		organizations := sqliterepos.NewSQLiteOrganizationRepository(sqlDB)
+		deleted := app.organization(t, "reused", uuid.Nil)
+		require.NoError(t, organizations.Delete(ctx, deleted))
+		app.organization(t, "reused", uuid.Nil)
+
+		// Restoring it would duplicate the slug
+		assert.Error(t, organizations.Restore(ctx, deleted))
+	})
+}
@@ -0,0 +1,457 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/apps/studio/infrastructure/sqlite"
+	sqliterepos "github.com/archesai/archesai/apps/studio/infrastructure/sqlite/repositories"
+	"github.com/archesai/archesai/pkg/auth"
+	authmodels "github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/database/databasetest"
+	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+	"github.com/archesai/archesai/pkg/server"
+)
+
+// tenantApp is the app under test: the routes of NewHandlers behind the auth
+// middleware, on an SQLite database.
+type tenantApp struct {
+	db       *database.Database
+	services *Services
+	handler  http.Handler
+}
+
+func newTenantApp(t *testing.T) *tenantApp {
+	t.Helper()
+	db := database.NewDatabase(databasetest.SQLite(t, sqlite.Migrations), nil, database.TypeSQLite)
+	services := &Services{
+		DB:        db,
+		Publisher: events.NewNoOpPublisher(),
+		TxManager: database.NewTxManager(db),
+	}
+	services.Auth = NewAuthService(services, &auth.Config{
+		API:      &auth.APIConfig{Host: "localhost", Port: 8080},
+		Platform: &auth.PlatformConfig{},
+		Auth:     &auth.ProvidersConfig{Local: &auth.LocalAuthConfig{Enabled: true, JWTSecret: "test-secret"}},
+	})
+	mux := http.NewServeMux()
+	RegisterRoutes(mux, NewHandlers(services))
+	return &tenantApp{
+		db:       db,
+		services: services,
+		handler:  server.NewAuthMiddleware(services.Auth).OptionalAuth()(mux),
+	}
+}
+
+// organization creates an organization with a pipeline named after it, and
+// makes the user a member if userID is set.
+func (a *tenantApp) organization(t *testing.T, name string, userID uuid.UUID) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	sqlDB := a.db.SQLDB()
+
+	org, err := authmodels.NewOrganization(nil, 0, nil, name, authmodels.OrganizationPlanFREE, name, "cus_"+name)
+	require.NoError(t, err)
+	_, err = sqliterepos.NewSQLiteOrganizationRepository(sqlDB).Create(ctx, org)
+	require.NoError(t, err)
+
+	if userID != uuid.Nil {
+		member, err := authmodels.NewMember(org.ID, authmodels.MemberRoleBasic, nil, userID)
+		require.NoError(t, err)
+		_, err = sqliterepos.NewSQLiteMemberRepository(sqlDB).Create(ctx, member)
+		require.NoError(t, err)
+	}
+
+	pipeline, err := models.NewPipeline(nil, &name, org.ID)
+	require.NoError(t, err)
+	_, err = sqliterepos.NewSQLitePipelineRepository(sqlDB).Create(database.WithTenant(ctx, org.ID), pipeline)
+	require.NoError(t, err)
+	return org.ID
+}
+
+// do sends a request with a bearer token and returns the response.
+func (a *tenantApp) do(t *testing.T, method, path, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	a.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// pipelineNames lists the pipelines the token can see.
+func (a *tenantApp) pipelineNames(t *testing.T, token string) []string {
+	t.Helper()
+	rec := a.do(t, http.MethodGet, "/pipelines", token, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list struct {
+		Data []models.Pipeline `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	names := make([]string, 0, len(list.Data))
+	for _, p := range list.Data {
+		names = append(names, *p.Name)
+	}
+	return names
+}
+
+func TestSessionOrganizationScopesList(t *testing.T) {
+	app := newTenantApp(t)
+	ctx := context.Background()
+
+	registered, err := app.services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	user, err := sqliterepos.NewSQLiteUserRepository(app.db.SQLDB()).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+
+	// Without an organization, tenant-scoped lists are forbidden
+	rec := app.do(t, http.MethodGet, "/pipelines", registered.AccessToken, "")
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	first := app.organization(t, "first", user.ID)
+	second := app.organization(t, "second", user.ID)
+	other := app.organization(t, "other", uuid.Nil)
+
+	// A new login starts in the organization the user joined first
+	tokens, err := app.services.Auth.AuthenticateWithPassword(ctx, "jane@example.com", "secure-password-123")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first"}, app.pipelineNames(t, tokens.AccessToken))
+
+	// Selecting another organization reissues tokens scoped to it
+	path := "/auth/sessions/" + tokens.SessionID
+	rec = app.do(t, http.MethodPatch, path, tokens.AccessToken, `{"organizationID":"`+second.String()+`"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var switched struct {
+		Data        authmodels.Session `json:"data"`
+		AccessToken string             `json:"accessToken"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &switched))
+	require.NotNil(t, switched.Data.OrganizationID)
+	assert.Equal(t, second, *switched.Data.OrganizationID)
+	assert.Equal(t, []string{"second"}, app.pipelineNames(t, switched.AccessToken))
+
+	// The old token keeps the organization it was issued for
+	assert.Equal(t, []string{"first"}, app.pipelineNames(t, tokens.AccessToken))
+
+	// Organizations the user is not a member of cannot be selected
+	rec = app.do(t, http.MethodPatch, path, switched.AccessToken, `{"organizationID":"`+other.String()+`"}`)
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	// Nor can other sessions be changed
+	rec = app.do(t, http.MethodPatch, "/auth/sessions/"+registered.SessionID, switched.AccessToken,
+		`{"organizationID":"`+first.String()+`"}`)
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+}
+
+func TestRefreshTokenRechecksMembership(t *testing.T) {
+	app := newTenantApp(t)
+	ctx := context.Background()
+
+	_, err := app.services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	user, err := sqliterepos.NewSQLiteUserRepository(app.db.SQLDB()).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	first := app.organization(t, "first", user.ID)
+	tokens, err := app.services.Auth.AuthenticateWithPassword(ctx, "jane@example.com", "secure-password-123")
+	require.NoError(t, err)
+
+	// Refreshing keeps the organization while the user is a member
+	refreshed, err := app.services.Auth.RefreshToken(ctx, tokens.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first"}, app.pipelineNames(t, refreshed.AccessToken))
+
+	// Once they leave it, refreshed tokens are scoped to no organization
+	members := sqliterepos.NewSQLiteMemberRepository(app.db.SQLDB())
+	member, err := members.GetMemberByUserAndOrganization(ctx, user.ID.String(), first.String())
+	require.NoError(t, err)
+	require.NoError(t, members.Delete(ctx, member.ID))
+
+	refreshed, err = app.services.Auth.RefreshToken(ctx, refreshed.RefreshToken)
+	require.NoError(t, err)
+	claims, err := app.services.Auth.ValidateAccessToken(refreshed.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, claims.OrganizationID)
+	rec := app.do(t, http.MethodGet, "/pipelines", refreshed.AccessToken, "")
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+}
+
+func TestAPIKeyScopesList(t *testing.T) {
+	app := newTenantApp(t)
+	ctx := context.Background()
+
+	_, err := app.services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	user, err := sqliterepos.NewSQLiteUserRepository(app.db.SQLDB()).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	app.organization(t, "first", user.ID)
+	second := app.organization(t, "second", user.ID)
+
+	key, hash, err := auth.NewAPIKeySecret(second)
+	require.NoError(t, err)
+	name, prefix := "CI", "ak"
+	apiKey, err := authmodels.NewAPIKey(nil, hash, nil, &name, second, &prefix, 60, []string{}, user.ID)
+	require.NoError(t, err)
+	_, err = sqliterepos.NewSQLiteAPIKeyRepository(app.db.SQLDB()).Create(database.WithTenant(ctx, second), apiKey)
+	require.NoError(t, err)
+
+	// API keys act in the organization they were created in
+	assert.Equal(t, []string{"second"}, app.pipelineNames(t, key))
+
+	// Unknown keys are not authenticated
+	unknown, _, err := auth.NewAPIKeySecret(second)
+	require.NoError(t, err)
+	rec := app.do(t, http.MethodGet, "/pipelines", unknown, "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	app := newTenantApp(t)
+	ctx := context.Background()
+
+	_, err := app.services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	user, err := sqliterepos.NewSQLiteUserRepository(app.db.SQLDB()).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	first := app.organization(t, "first", user.ID)
+	app.organization(t, "second", user.ID)
+	tokens, err := app.services.Auth.AuthenticateWithPassword(ctx, "jane@example.com", "secure-password-123")
+	require.NoError(t, err)
+
+	// Basic members cannot create keys
+	rec := app.do(t, http.MethodPost, "/api-keys", tokens.AccessToken, `{"name":"CI"}`)
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	members := sqliterepos.NewSQLiteMemberRepository(app.db.SQLDB())
+	admin, err := members.GetMemberByUserAndOrganization(ctx, user.ID.String(), first.String())
+	require.NoError(t, err)
+	admin.Role = authmodels.MemberRoleAdmin
+	_, err = members.Update(ctx, admin.ID, admin)
+	require.NoError(t, err)
+
+	// Expired keys are refused
+	rec = app.do(t, http.MethodPost, "/api-keys", tokens.AccessToken, `{"expiresAt":"2020-01-01T00:00:00Z"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+
+	// The key is returned once and belongs to the caller's organization
+	rec = app.do(t, http.MethodPost, "/api-keys", tokens.AccessToken, `{"name":"CI"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		Data authmodels.APIKey `json:"data"`
+		Key  string            `json:"key"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.True(t, auth.IsAPIKey(created.Key), created.Key)
+	assert.Equal(t, first, created.Data.OrganizationID)
+	assert.Equal(t, user.ID, created.Data.UserID)
+	assert.Equal(t, int32(60), created.Data.RateLimit)
+
+	// Only the hash is stored
+	stored, err := sqliterepos.NewSQLiteAPIKeyRepository(app.db.SQLDB()).Get(database.WithTenant(ctx, first), created.Data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, auth.HashAPIKey(created.Key), stored.KeyHash)
+
+	// The key acts in that organization
+	assert.Equal(t, []string{"first"}, app.pipelineNames(t, created.Key))
+}
+
+func TestMembersScopedToPathOrganization(t *testing.T) {
+	app := newTenantApp(t)
+	ctx := context.Background()
+
+	_, err := app.services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	user, err := sqliterepos.NewSQLiteUserRepository(app.db.SQLDB()).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	first := app.organization(t, "first", user.ID)
+	other := app.organization(t, "other", uuid.Nil)
+
+	// Jane administers her organization
+	members := sqliterepos.NewSQLiteMemberRepository(app.db.SQLDB())
+	admin, err := members.GetMemberByUserAndOrganization(ctx, user.ID.String(), first.String())
+	require.NoError(t, err)
+	admin.Role = authmodels.MemberRoleAdmin
+	_, err = members.Update(ctx, admin.ID, admin)
+	require.NoError(t, err)
+	tokens, err := app.services.Auth.AuthenticateWithPassword(ctx, "jane@example.com", "secure-password-123")
+	require.NoError(t, err)
+
+	_, err = app.services.Auth.Register(ctx, "john@example.com", "secure-password-123", "John")
+	require.NoError(t, err)
+	john, err := sqliterepos.NewSQLiteUserRepository(app.db.SQLDB()).GetUserByEmail(ctx, "john@example.com")
+	require.NoError(t, err)
+	member, err := authmodels.NewMember(other, authmodels.MemberRoleBasic, nil, john.ID)
+	require.NoError(t, err)
+	_, err = members.Create(ctx, member)
+	require.NoError(t, err)
+
+	// Lists only hold the members of the organization in the path
+	rec := app.do(t, http.MethodGet, "/organizations/"+first.String()+"/members", tokens.AccessToken, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list struct {
+		Data []authmodels.Member `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, user.ID, list.Data[0].UserID)
+
+	// Members of other organizations are not found through it
+	path := "/organizations/" + first.String() + "/members/" + member.ID.String()
+	for _, method := range []string{http.MethodGet, http.MethodPatch, http.MethodDelete} {
+		body := ""
+		if method == http.MethodPatch {
+			body = `{"role":"admin"}`
+		}
+		rec = app.do(t, method, path, tokens.AccessToken, body)
+		assert.Equal(t, http.StatusNotFound, rec.Code, method+" "+rec.Body.String())
+	}
+	_, err = members.Get(ctx, member.ID)
+	assert.NoError(t, err)
+}
+
+func TestCreateRoleAndAssign(t *testing.T) {
+	app := newTenantApp(t)
+	ctx := context.Background()
+
+	_, err := app.services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	jane, err := sqliterepos.NewSQLiteUserRepository(app.db.SQLDB()).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	org := app.organization(t, "first", jane.ID)
+
+	// Jane administers the organization
+	members := sqliterepos.NewSQLiteMemberRepository(app.db.SQLDB())
+	admin, err := members.GetMemberByUserAndOrganization(ctx, jane.ID.String(), org.String())
+	require.NoError(t, err)
+	admin.Role = authmodels.MemberRoleAdmin
+	_, err = members.Update(ctx, admin.ID, admin)
+	require.NoError(t, err)
+	tokens, err := app.services.Auth.AuthenticateWithPassword(ctx, "jane@example.com", "secure-password-123")
+	require.NoError(t, err)
+
+	_, err = app.services.Auth.Register(ctx, "john@example.com", "secure-password-123", "John")
+	require.NoError(t, err)
+	john, err := sqliterepos.NewSQLiteUserRepository(app.db.SQLDB()).GetUserByEmail(ctx, "john@example.com")
+	require.NoError(t, err)
+	member, err := authmodels.NewMember(org, authmodels.MemberRoleBasic, nil, john.ID)
+	require.NoError(t, err)
+	_, err = members.Create(ctx, member)
+	require.NoError(t, err)
+
+	// The role belongs to the caller's organization
+	rec := app.do(t, http.MethodPost, "/roles", tokens.AccessToken,
+		`{"name":"Pipeline Editor","permissions":["pipelines:write"]}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var role struct {
+		Data authmodels.Role `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &role))
+	assert.Equal(t, org, role.Data.OrganizationID)
+	assert.Equal(t, "Pipeline Editor", role.Data.Name)
+
+	// Assigning it grants its permissions
+	path := "/organizations/" + org.String() + "/members/" + member.ID.String()
+	rec = app.do(t, http.MethodPatch, path, tokens.AccessToken, `{"roleID":"`+role.Data.ID.String()+`"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var updated struct {
+		Data authmodels.Member `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+	require.NotNil(t, updated.Data.RoleID)
+	assert.Equal(t, role.Data.ID, *updated.Data.RoleID)
+	assert.Equal(t, authmodels.MemberRoleBasic, updated.Data.Role)
+
+	johnTokens, err := app.services.Auth.AuthenticateWithPassword(ctx, "john@example.com", "secure-password-123")
+	require.NoError(t, err)
+	rec = app.do(t, http.MethodGet, "/auth/permissions", johnTokens.AccessToken, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var permissions struct {
+		Data authmodels.Permissions `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &permissions))
+	assert.Contains(t, permissions.Data.Permissions, "pipelines:write")
+
+	// Roles of no organization are refused
+	rec = app.do(t, http.MethodPatch, path, tokens.AccessToken, `{"roleID":"`+uuid.New().String()+`"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+
+	// Only owners make owners
+	rec = app.do(t, http.MethodPatch, path, tokens.AccessToken, `{"role":"owner"}`)
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+	stored, err := members.Get(ctx, member.ID)
+	require.NoError(t, err)
+	assert.Equal(t, authmodels.MemberRoleBasic, stored.Role)
+}
+
+func TestOrganizationsRequireMembership(t *testing.T) {
+	app := newTenantApp(t)
+	ctx := context.Background()
+
+	_, err := app.services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	user, err := sqliterepos.NewSQLiteUserRepository(app.db.SQLDB()).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	first := app.organization(t, "first", user.ID)
+	other := app.organization(t, "other", uuid.Nil)
+	tokens, err := app.services.Auth.AuthenticateWithPassword(ctx, "jane@example.com", "secure-password-123")
+	require.NoError(t, err)
+
+	// Lists only hold the organizations of the caller
+	rec := app.do(t, http.MethodGet, "/organizations", tokens.AccessToken, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list struct {
+		Data []authmodels.Organization `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, first, list.Data[0].ID)
+
+	requests := []struct {
+		method string
+		suffix string
+		body   string
+	}{
+		{method: http.MethodGet},
+		{method: http.MethodPatch, body: `{"billingEmail":"billing@example.com"}`},
+		{method: http.MethodDelete},
+		{method: http.MethodPost, suffix: "/restore"},
+	}
+
+	// Organizations the caller is not a member of are forbidden
+	for _, req := range requests {
+		rec = app.do(t, req.method, "/organizations/"+other.String()+req.suffix, tokens.AccessToken, req.body)
+		assert.Equal(t, http.StatusForbidden, rec.Code, req.method+" "+rec.Body.String())
+	}
+
+	// Basic members can read their organization but not change it
+	want := []int{http.StatusOK, http.StatusForbidden, http.StatusForbidden, http.StatusForbidden}
+	for i, req := range requests {
+		rec = app.do(t, req.method, "/organizations/"+first.String()+req.suffix, tokens.AccessToken, req.body)
+		assert.Equal(t, want[i], rec.Code, req.method+" "+rec.Body.String())
+	}
+
+	// Owners can delete and restore it
+	members := sqliterepos.NewSQLiteMemberRepository(app.db.SQLDB())
+	owner, err := members.GetMemberByUserAndOrganization(ctx, user.ID.String(), first.String())
+	require.NoError(t, err)
+	owner.Role = authmodels.MemberRoleOwner
+	_, err = members.Update(ctx, owner.ID, owner)
+	require.NoError(t, err)
+	want = []int{http.StatusOK, http.StatusOK, http.StatusNoContent, http.StatusOK}
+	for i, req := range requests {
+		rec = app.do(t, req.method, "/organizations/"+first.String()+req.suffix, tokens.AccessToken, req.body)
+		assert.Equal(t, want[i], rec.Code, req.method+" "+rec.Body.String())
+	}
+}
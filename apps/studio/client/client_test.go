@@ -0,0 +1,93 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/apps/studio/bootstrap"
+	"github.com/archesai/archesai/apps/studio/client"
+	"github.com/archesai/archesai/apps/studio/infrastructure/sqlite"
+	sqliterepos "github.com/archesai/archesai/apps/studio/infrastructure/sqlite/repositories"
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth"
+	authmodels "github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/database/databasetest"
+	"github.com/archesai/archesai/pkg/events"
+	pipelinemodels "github.com/archesai/archesai/pkg/pipelines/models"
+	"github.com/archesai/archesai/pkg/server"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// The client calls operations of several composed packages, each decoded
+// into the models of its own package.
+func TestClientAgainstApp(t *testing.T) {
+	ctx := context.Background()
+	db := database.NewDatabase(databasetest.SQLite(t, sqlite.Migrations), nil, database.TypeSQLite)
+	services := &bootstrap.Services{
+		DB:        db,
+		Publisher: events.NewNoOpPublisher(),
+		TxManager: database.NewTxManager(db),
+	}
+	services.Auth = bootstrap.NewAuthService(services, &auth.Config{
+		API:      &auth.APIConfig{Host: "localhost", Port: 8080},
+		Platform: &auth.PlatformConfig{},
+		Auth:     &auth.ProvidersConfig{Local: &auth.LocalAuthConfig{Enabled: true, JWTSecret: "test-secret"}},
+	})
+	mux := http.NewServeMux()
+	bootstrap.RegisterRoutes(mux, bootstrap.NewHandlers(services))
+	srv := httptest.NewServer(server.NewAuthMiddleware(services.Auth).OptionalAuth()(mux))
+	defer srv.Close()
+
+	// Jane is a member of an organization with three pipelines
+	_, err := services.Auth.Register(ctx, "jane@example.com", "secure-password-123", "Jane")
+	require.NoError(t, err)
+	user, err := sqliterepos.NewSQLiteUserRepository(db.SQLDB()).GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	org, err := authmodels.NewOrganization(nil, 0, nil, "acme", authmodels.OrganizationPlanFREE, "acme", "cus_acme")
+	require.NoError(t, err)
+	_, err = sqliterepos.NewSQLiteOrganizationRepository(db.SQLDB()).Create(ctx, org)
+	require.NoError(t, err)
+	member, err := authmodels.NewMember(org.ID, authmodels.MemberRoleBasic, nil, user.ID)
+	require.NoError(t, err)
+	_, err = sqliterepos.NewSQLiteMemberRepository(db.SQLDB()).Create(ctx, member)
+	require.NoError(t, err)
+	pipelines := sqliterepos.NewSQLitePipelineRepository(db.SQLDB())
+	for _, name := range []string{"a", "b", "c"} {
+		pipeline, err := pipelinemodels.NewPipeline(nil, &name, org.ID)
+		require.NoError(t, err)
+		_, err = pipelines.Create(database.WithTenant(ctx, org.ID), pipeline)
+		require.NoError(t, err)
+	}
+
+	tokens, err := services.Auth.AuthenticateWithPassword(ctx, "jane@example.com", "secure-password-123")
+	require.NoError(t, err)
+	c := client.New(srv.URL, apiclient.WithBearerToken(tokens.AccessToken))
+
+	members, err := c.ListMembers(ctx, org.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, members.Data, 1)
+	assert.Equal(t, user.ID, members.Data[0].UserID)
+
+	var names []string
+	limit := int32(2)
+	for pipeline, err := range c.ListPipelinesAll(ctx, &client.ListPipelinesParams{
+		Page: &servermodels.Page{Limit: &limit},
+		Sort: []string{"name"},
+	}) {
+		require.NoError(t, err)
+		names = append(names, *pipeline.Name)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+
+	_, err = c.GetPipeline(ctx, member.ID)
+	var apiErr *apiclient.Error
+	require.True(t, errors.As(err, &apiErr), err)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
@@ -0,0 +1,43 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// ConfirmEmailChange - POST /auth/confirm-email
+// ============================================================================
+
+// ConfirmEmailChangeRequestBody defines the request body of ConfirmEmailChange.
+type ConfirmEmailChangeRequestBody struct {
+	NewEmail string    `json:"newEmail"`
+	Token    string    `json:"token"`
+	UserID   uuid.UUID `json:"userID"`
+}
+
+// ConfirmEmailChange calls POST /auth/confirm-email.
+//
+// Verify e-mail change
+func (c *Client) ConfirmEmailChange(ctx context.Context, body ConfirmEmailChangeRequestBody) error {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/confirm-email",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("ConfirmEmailChange: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// ConfirmEmailVerification - POST /auth/verify-email
+// ============================================================================
+
+// ConfirmEmailVerificationRequestBody defines the request body of ConfirmEmailVerification.
+type ConfirmEmailVerificationRequestBody struct {
+	Token string `json:"token"`
+}
+
+// ConfirmEmailVerificationResponse is the 200 response of ConfirmEmailVerification.
+type ConfirmEmailVerificationResponse struct {
+	Session models.Session `json:"session"`
+	User    models.User    `json:"user"`
+}
+
+// ConfirmEmailVerification calls POST /auth/verify-email.
+//
+// Confirm e-mail verification
+func (c *Client) ConfirmEmailVerification(ctx context.Context, body ConfirmEmailVerificationRequestBody) (*ConfirmEmailVerificationResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/verify-email",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp ConfirmEmailVerificationResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ConfirmEmailVerification: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,40 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// ConfirmPasswordReset - POST /auth/reset-password
+// ============================================================================
+
+// ConfirmPasswordResetRequestBody defines the request body of ConfirmPasswordReset.
+type ConfirmPasswordResetRequestBody struct {
+	NewPassword string `json:"newPassword"`
+	Token       string `json:"token"`
+}
+
+// ConfirmPasswordReset calls POST /auth/reset-password.
+//
+// Verify password reset
+func (c *Client) ConfirmPasswordReset(ctx context.Context, body ConfirmPasswordResetRequestBody) error {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/reset-password",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("ConfirmPasswordReset: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// CreateAPIKey - POST /api-keys
+// ============================================================================
+
+// CreateAPIKeyRequestBody defines the request body of CreateAPIKey.
+type CreateAPIKeyRequestBody struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+	RateLimit *int32     `json:"rateLimit,omitempty"`
+	Scopes    []string   `json:"scopes,omitempty"`
+}
+
+// CreateAPIKeyResponse is the 201 response of CreateAPIKey.
+type CreateAPIKeyResponse struct {
+	Data models.APIKey `json:"data"`
+	Key  string        `json:"key"`
+}
+
+// CreateAPIKey calls POST /api-keys.
+//
+// Create an API key
+func (c *Client) CreateAPIKey(ctx context.Context, body CreateAPIKeyRequestBody) (*CreateAPIKeyResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/api-keys",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreateAPIKeyResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreateAPIKey: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,47 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/storage/models"
+)
+
+// ============================================================================
+// CreateArtifact - POST /artifacts
+// ============================================================================
+
+// CreateArtifactRequestBody defines the request body of CreateArtifact.
+type CreateArtifactRequestBody struct {
+	Name *string `json:"name,omitempty"`
+	Text string  `json:"text"`
+}
+
+// CreateArtifactResponse is the 201 response of CreateArtifact.
+type CreateArtifactResponse struct {
+	Data models.Artifact `json:"data"`
+}
+
+// CreateArtifact calls POST /artifacts.
+//
+// Create a artifact
+func (c *Client) CreateArtifact(ctx context.Context, body CreateArtifactRequestBody) (*CreateArtifactResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/artifacts",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreateArtifactResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreateArtifact: %w", err)
+	}
+	return &resp, nil
+}
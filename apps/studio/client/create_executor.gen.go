@@ -0,0 +1,57 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/executor/models"
+)
+
+// ============================================================================
+// CreateExecutor - POST /executors
+// ============================================================================
+
+// CreateExecutorRequestBody defines the request body of CreateExecutor.
+type CreateExecutorRequestBody struct {
+	CPUShares    *int32  `json:"cpuShares,omitempty"`
+	Dependencies *string `json:"dependencies,omitempty"`
+	Description  string  `json:"description"`
+	Env          *string `json:"env,omitempty"`
+	ExecuteCode  string  `json:"executeCode"`
+	ExtraFiles   *string `json:"extraFiles,omitempty"`
+	Language     string  `json:"language"`
+	MemoryMB     *int32  `json:"memoryMB,omitempty"`
+	Name         string  `json:"name"`
+	SchemaIn     *string `json:"schemaIn,omitempty"`
+	SchemaOut    *string `json:"schemaOut,omitempty"`
+	Timeout      *int32  `json:"timeout,omitempty"`
+}
+
+// CreateExecutorResponse is the 201 response of CreateExecutor.
+type CreateExecutorResponse struct {
+	Data models.Executor `json:"data"`
+}
+
+// CreateExecutor calls POST /executors.
+//
+// Create an executor
+func (c *Client) CreateExecutor(ctx context.Context, body CreateExecutorRequestBody) (*CreateExecutorResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/executors",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreateExecutorResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreateExecutor: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,49 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// CreateInvitation - POST /organizations/{organizationID}/invitations
+// ============================================================================
+
+// CreateInvitationRequestBody defines the request body of CreateInvitation.
+type CreateInvitationRequestBody struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// CreateInvitationResponse is the 201 response of CreateInvitation.
+type CreateInvitationResponse struct {
+	Data models.Invitation `json:"data"`
+}
+
+// CreateInvitation calls POST /organizations/{organizationID}/invitations.
+//
+// Create an invitation
+func (c *Client) CreateInvitation(ctx context.Context, organizationID uuid.UUID, body CreateInvitationRequestBody) (*CreateInvitationResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/invitations",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreateInvitationResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreateInvitation: %w", err)
+	}
+	return &resp, nil
+}
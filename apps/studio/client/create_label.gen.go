@@ -0,0 +1,46 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/storage/models"
+)
+
+// ============================================================================
+// CreateLabel - POST /labels
+// ============================================================================
+
+// CreateLabelRequestBody defines the request body of CreateLabel.
+type CreateLabelRequestBody struct {
+	Name string `json:"name"`
+}
+
+// CreateLabelResponse is the 201 response of CreateLabel.
+type CreateLabelResponse struct {
+	Data models.Label `json:"data"`
+}
+
+// CreateLabel calls POST /labels.
+//
+// Create a label
+func (c *Client) CreateLabel(ctx context.Context, body CreateLabelRequestBody) (*CreateLabelResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/labels",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreateLabelResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreateLabel: %w", err)
+	}
+	return &resp, nil
+}
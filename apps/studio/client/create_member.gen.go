@@ -0,0 +1,48 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// CreateMember - POST /organizations/{organizationID}/members
+// ============================================================================
+
+// CreateMemberRequestBody defines the request body of CreateMember.
+type CreateMemberRequestBody struct {
+	Role string `json:"role"`
+}
+
+// CreateMemberResponse is the 201 response of CreateMember.
+type CreateMemberResponse struct {
+	Data models.Member `json:"data"`
+}
+
+// CreateMember calls POST /organizations/{organizationID}/members.
+//
+// Create a member
+func (c *Client) CreateMember(ctx context.Context, organizationID uuid.UUID, body CreateMemberRequestBody) (*CreateMemberResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/members",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreateMemberResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreateMember: %w", err)
+	}
+	return &resp, nil
+}
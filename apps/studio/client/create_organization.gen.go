@@ -0,0 +1,49 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// CreateOrganization - POST /organizations
+// ============================================================================
+
+// CreateOrganizationRequestBody defines the request body of CreateOrganization.
+type CreateOrganizationRequestBody struct {
+	BillingEmail   string    `json:"billingEmail"`
+	OrganizationID uuid.UUID `json:"organizationID"`
+}
+
+// CreateOrganizationResponse is the 201 response of CreateOrganization.
+type CreateOrganizationResponse struct {
+	Data models.Organization `json:"data"`
+}
+
+// CreateOrganization calls POST /organizations.
+//
+// Create an organization
+func (c *Client) CreateOrganization(ctx context.Context, body CreateOrganizationRequestBody) (*CreateOrganizationResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/organizations",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreateOrganizationResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreateOrganization: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,47 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+)
+
+// ============================================================================
+// CreatePipeline - POST /pipelines
+// ============================================================================
+
+// CreatePipelineRequestBody defines the request body of CreatePipeline.
+type CreatePipelineRequestBody struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// CreatePipelineResponse is the 201 response of CreatePipeline.
+type CreatePipelineResponse struct {
+	Data models.Pipeline `json:"data"`
+}
+
+// CreatePipeline calls POST /pipelines.
+//
+// Create a pipeline
+func (c *Client) CreatePipeline(ctx context.Context, body CreatePipelineRequestBody) (*CreatePipelineResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/pipelines",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreatePipelineResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreatePipeline: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,53 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+)
+
+// ============================================================================
+// CreatePipelineStep - POST /pipelines/{id}/steps
+// ============================================================================
+
+// CreatePipelineStepRequestBody defines the request body of CreatePipelineStep.
+type CreatePipelineStepRequestBody struct {
+	Config       *models.StepConfig `json:"config,omitempty"`
+	Dependencies []uuid.UUID        `json:"dependencies,omitempty"`
+	Description  *string            `json:"description,omitempty"`
+	Name         string             `json:"name"`
+	Position     *int32             `json:"position,omitempty"`
+	ToolID       uuid.UUID          `json:"toolID"`
+}
+
+// CreatePipelineStepResponse is the 201 response of CreatePipelineStep.
+type CreatePipelineStepResponse struct {
+	Data models.PipelineStep `json:"data"`
+}
+
+// CreatePipelineStep calls POST /pipelines/{id}/steps.
+//
+// Add a step to a pipeline
+func (c *Client) CreatePipelineStep(ctx context.Context, id uuid.UUID, body CreatePipelineStepRequestBody) (*CreatePipelineStepResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/pipelines/" + url.PathEscape(apiclient.FormatParam(id)) + "/steps",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreatePipelineStepResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreatePipelineStep: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,48 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+)
+
+// ============================================================================
+// CreateRun - POST /runs
+// ============================================================================
+
+// CreateRunRequestBody defines the request body of CreateRun.
+type CreateRunRequestBody struct {
+	PipelineID uuid.UUID `json:"pipelineID"`
+}
+
+// CreateRunResponse is the 201 response of CreateRun.
+type CreateRunResponse struct {
+	Data models.Run `json:"data"`
+}
+
+// CreateRun calls POST /runs.
+//
+// Create a run
+func (c *Client) CreateRun(ctx context.Context, body CreateRunRequestBody) (*CreateRunResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/runs",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreateRunResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreateRun: %w", err)
+	}
+	return &resp, nil
+}
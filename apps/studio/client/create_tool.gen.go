@@ -0,0 +1,47 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+)
+
+// ============================================================================
+// CreateTool - POST /tools
+// ============================================================================
+
+// CreateToolRequestBody defines the request body of CreateTool.
+type CreateToolRequestBody struct {
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+// CreateToolResponse is the 201 response of CreateTool.
+type CreateToolResponse struct {
+	Data models.Tool `json:"data"`
+}
+
+// CreateTool calls POST /tools.
+//
+// Create a tool
+func (c *Client) CreateTool(ctx context.Context, body CreateToolRequestBody) (*CreateToolResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/tools",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp CreateToolResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("CreateTool: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,35 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// DeleteAccount - DELETE /auth/accounts/{id}
+// ============================================================================
+
+// DeleteAccount calls DELETE /auth/accounts/{id}.
+//
+// Delete an account
+func (c *Client) DeleteAccount(ctx context.Context, id uuid.UUID) error {
+	req := apiclient.Request{
+		Method: http.MethodDelete,
+		Path:   "/auth/accounts/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("DeleteAccount: %w", err)
+	}
+	return nil
+}
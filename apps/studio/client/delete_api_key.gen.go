@@ -0,0 +1,35 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// DeleteAPIKey - DELETE /api-keys/{id}
+// ============================================================================
+
+// DeleteAPIKey calls DELETE /api-keys/{id}.
+//
+// Delete an API key
+func (c *Client) DeleteAPIKey(ctx context.Context, id uuid.UUID) error {
+	req := apiclient.Request{
+		Method: http.MethodDelete,
+		Path:   "/api-keys/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("DeleteAPIKey: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// DeleteArtifact - DELETE /artifacts/{id}
+// ============================================================================
+
+// DeleteArtifact calls DELETE /artifacts/{id}.
+//
+// Delete an artifact
+func (c *Client) DeleteArtifact(ctx context.Context, id uuid.UUID) error {
+	req := apiclient.Request{
+		Method: http.MethodDelete,
+		Path:   "/artifacts/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("DeleteArtifact: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// DeleteCurrentUser - DELETE /auth/me
+// ============================================================================
+
+// DeleteCurrentUserParams defines the query and header parameters of DeleteCurrentUser.
+type DeleteCurrentUserParams struct {
+	XConfirm string
+}
+
+// DeleteCurrentUser calls DELETE /auth/me.
+//
+// Delete current user
+func (c *Client) DeleteCurrentUser(ctx context.Context, params *DeleteCurrentUserParams) error {
+	req := apiclient.Request{
+		Method: http.MethodDelete,
+		Path:   "/auth/me",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		req.Header.Set("X-Confirm", apiclient.FormatParam(params.XConfirm))
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("DeleteCurrentUser: %w", err)
+	}
+	return nil
+}
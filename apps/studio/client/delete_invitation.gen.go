@@ -0,0 +1,35 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// DeleteInvitation - DELETE /organizations/{organizationID}/invitations/{id}
+// ============================================================================
+
+// DeleteInvitation calls DELETE /organizations/{organizationID}/invitations/{id}.
+//
+// Delete an invitation
+func (c *Client) DeleteInvitation(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) error {
+	req := apiclient.Request{
+		Method: http.MethodDelete,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/invitations/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("DeleteInvitation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// DeleteLabel - DELETE /labels/{id}
+// ============================================================================
+
+// DeleteLabel calls DELETE /labels/{id}.
+//
+// Delete a label
+func (c *Client) DeleteLabel(ctx context.Context, id uuid.UUID) error {
+	req := apiclient.Request{
+		Method: http.MethodDelete,
+		Path:   "/labels/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("DeleteLabel: %w", err)
+	}
+	return nil
+}
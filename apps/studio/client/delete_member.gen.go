@@ -0,0 +1,35 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// DeleteMember - DELETE /organizations/{organizationID}/members/{id}
+// ============================================================================
+
+// DeleteMember calls DELETE /organizations/{organizationID}/members/{id}.
+//
+// Delete a member
+func (c *Client) DeleteMember(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) error {
+	req := apiclient.Request{
+		Method: http.MethodDelete,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/members/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("DeleteMember: %w", err)
+	}
+	return nil
+}
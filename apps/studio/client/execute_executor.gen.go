@@ -0,0 +1,54 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// ExecuteExecutor - POST /executors/{id}/execute
+// ============================================================================
+
+// ExecuteExecutorRequestBody defines the request body of ExecuteExecutor.
+type ExecuteExecutorRequestBody struct {
+	Input map[string]any `json:"input"`
+}
+
+// ExecuteExecutorResponseData defines the data structure of ExecuteExecutorResponse.
+type ExecuteExecutorResponseData struct {
+	ExecutionTimeMs int64          `json:"executionTimeMs,omitempty"`
+	Logs            string         `json:"logs,omitempty"`
+	Output          map[string]any `json:"output"`
+}
+
+// ExecuteExecutorResponse is the 200 response of ExecuteExecutor.
+type ExecuteExecutorResponse struct {
+	Data ExecuteExecutorResponseData `json:"data,omitempty"`
+}
+
+// ExecuteExecutor calls POST /executors/{id}/execute.
+//
+// Execute a custom executor
+func (c *Client) ExecuteExecutor(ctx context.Context, id uuid.UUID, body ExecuteExecutorRequestBody) (*ExecuteExecutorResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/executors/" + url.PathEscape(apiclient.FormatParam(id)) + "/execute",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp ExecuteExecutorResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ExecuteExecutor: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// GetAccount - GET /auth/accounts/{id}
+// ============================================================================
+
+// GetAccountResponse is the 200 response of GetAccount.
+type GetAccountResponse struct {
+	Data models.Account `json:"data"`
+}
+
+// GetAccount calls GET /auth/accounts/{id}.
+//
+// Find an account
+func (c *Client) GetAccount(ctx context.Context, id uuid.UUID) (*GetAccountResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/auth/accounts/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetAccountResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetAccount: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// GetAPIKey - GET /api-keys/{id}
+// ============================================================================
+
+// GetAPIKeyResponse is the 200 response of GetAPIKey.
+type GetAPIKeyResponse struct {
+	Data models.APIKey `json:"data"`
+}
+
+// GetAPIKey calls GET /api-keys/{id}.
+//
+// Get an API key
+func (c *Client) GetAPIKey(ctx context.Context, id uuid.UUID) (*GetAPIKeyResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/api-keys/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetAPIKeyResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetAPIKey: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/storage/models"
+)
+
+// ============================================================================
+// GetArtifact - GET /artifacts/{id}
+// ============================================================================
+
+// GetArtifactResponse is the 200 response of GetArtifact.
+type GetArtifactResponse struct {
+	Data models.Artifact `json:"data"`
+}
+
+// GetArtifact calls GET /artifacts/{id}.
+//
+// Find an artifact
+func (c *Client) GetArtifact(ctx context.Context, id uuid.UUID) (*GetArtifactResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/artifacts/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetArtifactResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetArtifact: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,40 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// GetCurrentUser - GET /auth/me
+// ============================================================================
+
+// GetCurrentUserResponse is the 200 response of GetCurrentUser.
+type GetCurrentUserResponse struct {
+	Data models.User `json:"data"`
+}
+
+// GetCurrentUser calls GET /auth/me.
+//
+// Get current user
+func (c *Client) GetCurrentUser(ctx context.Context) (*GetCurrentUserResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/auth/me",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetCurrentUserResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetCurrentUser: %w", err)
+	}
+	return &resp, nil
+}
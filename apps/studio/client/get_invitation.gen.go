@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// GetInvitation - GET /organizations/{organizationID}/invitations/{id}
+// ============================================================================
+
+// GetInvitationResponse is the 200 response of GetInvitation.
+type GetInvitationResponse struct {
+	Data models.Invitation `json:"data"`
+}
+
+// GetInvitation calls GET /organizations/{organizationID}/invitations/{id}.
+//
+// Get an invitation
+func (c *Client) GetInvitation(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) (*GetInvitationResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/invitations/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetInvitationResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetInvitation: %w", err)
+	}
+	return &resp, nil
+}
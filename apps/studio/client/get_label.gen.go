@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/storage/models"
+)
+
+// ============================================================================
+// GetLabel - GET /labels/{id}
+// ============================================================================
+
+// GetLabelResponse is the 200 response of GetLabel.
+type GetLabelResponse struct {
+	Data models.Label `json:"data"`
+}
+
+// GetLabel calls GET /labels/{id}.
+//
+// Find a label
+func (c *Client) GetLabel(ctx context.Context, id uuid.UUID) (*GetLabelResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/labels/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetLabelResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetLabel: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// GetMember - GET /organizations/{organizationID}/members/{id}
+// ============================================================================
+
+// GetMemberResponse is the 200 response of GetMember.
+type GetMemberResponse struct {
+	Data models.Member `json:"data"`
+}
+
+// GetMember calls GET /organizations/{organizationID}/members/{id}.
+//
+// Get a member
+func (c *Client) GetMember(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) (*GetMemberResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/members/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetMemberResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetMember: %w", err)
+	}
+	return &resp, nil
+}
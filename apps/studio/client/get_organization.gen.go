@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// GetOrganization - GET /organizations/{id}
+// ============================================================================
+
+// GetOrganizationResponse is the 200 response of GetOrganization.
+type GetOrganizationResponse struct {
+	Data models.Organization `json:"data"`
+}
+
+// GetOrganization calls GET /organizations/{id}.
+//
+// Get an organization
+func (c *Client) GetOrganization(ctx context.Context, id uuid.UUID) (*GetOrganizationResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetOrganizationResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetOrganization: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// GetSession - GET /auth/sessions/{id}
+// ============================================================================
+
+// GetSessionResponse is the 200 response of GetSession.
+type GetSessionResponse struct {
+	Data models.Session `json:"data"`
+}
+
+// GetSession calls GET /auth/sessions/{id}.
+//
+// Find a session
+func (c *Client) GetSession(ctx context.Context, id uuid.UUID) (*GetSessionResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/auth/sessions/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetSessionResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetSession: %w", err)
+	}
+	return &resp, nil
+}
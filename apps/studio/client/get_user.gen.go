@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// GetUser - GET /users/{id}
+// ============================================================================
+
+// GetUserResponse is the 200 response of GetUser.
+type GetUserResponse struct {
+	Data models.User `json:"data"`
+}
+
+// GetUser calls GET /users/{id}.
+//
+// Get a user
+func (c *Client) GetUser(ctx context.Context, id uuid.UUID) (*GetUserResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/users/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetUserResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetUser: %w", err)
+	}
+	return &resp, nil
+}
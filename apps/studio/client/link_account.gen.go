@@ -0,0 +1,46 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// LinkAccount - POST /auth/link
+// ============================================================================
+
+// LinkAccountRequestBody defines the request body of LinkAccount.
+type LinkAccountRequestBody struct {
+	Provider    string  `json:"provider"`
+	RedirectURL *string `json:"redirectUrl,omitempty"`
+}
+
+// LinkAccountResponse is the 200 response of LinkAccount.
+type LinkAccountResponse struct {
+	AuthorizationURL string `json:"authorizationURL"`
+}
+
+// LinkAccount calls POST /auth/link.
+//
+// Link authentication provider
+func (c *Client) LinkAccount(ctx context.Context, body LinkAccountRequestBody) (*LinkAccountResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/link",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp LinkAccountResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("LinkAccount: %w", err)
+	}
+	return &resp, nil
+}
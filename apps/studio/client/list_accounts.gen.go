@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListAccounts - GET /auth/accounts
+// ============================================================================
+
+// ListAccountsResponse is the 200 response of ListAccounts.
+type ListAccountsResponse struct {
+	Data []models.Account            `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListAccounts calls GET /auth/accounts.
+//
+// List linked accounts
+func (c *Client) ListAccounts(ctx context.Context) (*ListAccountsResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/auth/accounts",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp ListAccountsResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListAccounts: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,74 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListAPIKeys - GET /api-keys
+// ============================================================================
+
+// ListAPIKeysParams defines the query and header parameters of ListAPIKeys.
+type ListAPIKeysParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListAPIKeysResponse is the 200 response of ListAPIKeys.
+type ListAPIKeysResponse struct {
+	Data []models.APIKey             `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListAPIKeys calls GET /api-keys.
+//
+// List API keys
+func (c *Client) ListAPIKeys(ctx context.Context, params *ListAPIKeysParams) (*ListAPIKeysResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/api-keys",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListAPIKeysResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListAPIKeys: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListAPIKeysAll iterates over the items of every page of ListAPIKeys,
+// starting at params.Page.
+func (c *Client) ListAPIKeysAll(ctx context.Context, params *ListAPIKeysParams) iter.Seq2[models.APIKey, error] {
+	var p ListAPIKeysParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.APIKey, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListAPIKeys(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
@@ -0,0 +1,79 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+	"github.com/archesai/archesai/pkg/storage/models"
+)
+
+// ============================================================================
+// ListArtifacts - GET /artifacts
+// ============================================================================
+
+// ListArtifactsParams defines the query and header parameters of ListArtifacts.
+type ListArtifactsParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	Q      *string
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListArtifactsResponse is the 200 response of ListArtifacts.
+type ListArtifactsResponse struct {
+	Data       []models.Artifact           `json:"data"`
+	Highlights map[string]string           `json:"highlights,omitempty"`
+	Meta       servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListArtifacts calls GET /artifacts.
+//
+// List artifacts
+func (c *Client) ListArtifacts(ctx context.Context, params *ListArtifactsParams) (*ListArtifactsResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/artifacts",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		if params.Q != nil {
+			req.Query.Set("q", apiclient.FormatParam(*params.Q))
+		}
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListArtifactsResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListArtifacts: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListArtifactsAll iterates over the items of every page of ListArtifacts,
+// starting at params.Page.
+func (c *Client) ListArtifactsAll(ctx context.Context, params *ListArtifactsParams) iter.Seq2[models.Artifact, error] {
+	var p ListArtifactsParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Artifact, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListArtifacts(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
@@ -0,0 +1,74 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/executor/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListExecutors - GET /executors
+// ============================================================================
+
+// ListExecutorsParams defines the query and header parameters of ListExecutors.
+type ListExecutorsParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListExecutorsResponse is the 200 response of ListExecutors.
+type ListExecutorsResponse struct {
+	Data []models.Executor           `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListExecutors calls GET /executors.
+//
+// List executors
+func (c *Client) ListExecutors(ctx context.Context, params *ListExecutorsParams) (*ListExecutorsResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/executors",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListExecutorsResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListExecutors: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListExecutorsAll iterates over the items of every page of ListExecutors,
+// starting at params.Page.
+func (c *Client) ListExecutorsAll(ctx context.Context, params *ListExecutorsParams) iter.Seq2[models.Executor, error] {
+	var p ListExecutorsParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Executor, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListExecutors(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
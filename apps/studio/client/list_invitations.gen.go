@@ -0,0 +1,76 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListInvitations - GET /organizations/{organizationID}/invitations
+// ============================================================================
+
+// ListInvitationsParams defines the query and header parameters of ListInvitations.
+type ListInvitationsParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListInvitationsResponse is the 200 response of ListInvitations.
+type ListInvitationsResponse struct {
+	Data []models.Invitation         `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListInvitations calls GET /organizations/{organizationID}/invitations.
+//
+// List invitations
+func (c *Client) ListInvitations(ctx context.Context, organizationID uuid.UUID, params *ListInvitationsParams) (*ListInvitationsResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/invitations",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListInvitationsResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListInvitations: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListInvitationsAll iterates over the items of every page of ListInvitations,
+// starting at params.Page.
+func (c *Client) ListInvitationsAll(ctx context.Context, organizationID uuid.UUID, params *ListInvitationsParams) iter.Seq2[models.Invitation, error] {
+	var p ListInvitationsParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Invitation, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListInvitations(ctx, organizationID, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
@@ -0,0 +1,74 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+	"github.com/archesai/archesai/pkg/storage/models"
+)
+
+// ============================================================================
+// ListLabels - GET /labels
+// ============================================================================
+
+// ListLabelsParams defines the query and header parameters of ListLabels.
+type ListLabelsParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListLabelsResponse is the 200 response of ListLabels.
+type ListLabelsResponse struct {
+	Data []models.Label              `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListLabels calls GET /labels.
+//
+// List labels
+func (c *Client) ListLabels(ctx context.Context, params *ListLabelsParams) (*ListLabelsResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/labels",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListLabelsResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListLabels: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListLabelsAll iterates over the items of every page of ListLabels,
+// starting at params.Page.
+func (c *Client) ListLabelsAll(ctx context.Context, params *ListLabelsParams) iter.Seq2[models.Label, error] {
+	var p ListLabelsParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Label, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListLabels(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
@@ -0,0 +1,76 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListMembers - GET /organizations/{organizationID}/members
+// ============================================================================
+
+// ListMembersParams defines the query and header parameters of ListMembers.
+type ListMembersParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListMembersResponse is the 200 response of ListMembers.
+type ListMembersResponse struct {
+	Data []models.Member             `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListMembers calls GET /organizations/{organizationID}/members.
+//
+// List members
+func (c *Client) ListMembers(ctx context.Context, organizationID uuid.UUID, params *ListMembersParams) (*ListMembersResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/members",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListMembersResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListMembers: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListMembersAll iterates over the items of every page of ListMembers,
+// starting at params.Page.
+func (c *Client) ListMembersAll(ctx context.Context, organizationID uuid.UUID, params *ListMembersParams) iter.Seq2[models.Member, error] {
+	var p ListMembersParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Member, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListMembers(ctx, organizationID, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
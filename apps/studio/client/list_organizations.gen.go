@@ -0,0 +1,74 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListOrganizations - GET /organizations
+// ============================================================================
+
+// ListOrganizationsParams defines the query and header parameters of ListOrganizations.
+type ListOrganizationsParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListOrganizationsResponse is the 200 response of ListOrganizations.
+type ListOrganizationsResponse struct {
+	Data []models.Organization       `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListOrganizations calls GET /organizations.
+//
+// List organizations
+func (c *Client) ListOrganizations(ctx context.Context, params *ListOrganizationsParams) (*ListOrganizationsResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/organizations",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListOrganizationsResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListOrganizations: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListOrganizationsAll iterates over the items of every page of ListOrganizations,
+// starting at params.Page.
+func (c *Client) ListOrganizationsAll(ctx context.Context, params *ListOrganizationsParams) iter.Seq2[models.Organization, error] {
+	var p ListOrganizationsParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Organization, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListOrganizations(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
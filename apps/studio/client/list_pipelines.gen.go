@@ -0,0 +1,79 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListPipelines - GET /pipelines
+// ============================================================================
+
+// ListPipelinesParams defines the query and header parameters of ListPipelines.
+type ListPipelinesParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	Q      *string
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListPipelinesResponse is the 200 response of ListPipelines.
+type ListPipelinesResponse struct {
+	Data       []models.Pipeline           `json:"data"`
+	Highlights map[string]string           `json:"highlights,omitempty"`
+	Meta       servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListPipelines calls GET /pipelines.
+//
+// List pipelines
+func (c *Client) ListPipelines(ctx context.Context, params *ListPipelinesParams) (*ListPipelinesResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/pipelines",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		if params.Q != nil {
+			req.Query.Set("q", apiclient.FormatParam(*params.Q))
+		}
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListPipelinesResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListPipelines: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListPipelinesAll iterates over the items of every page of ListPipelines,
+// starting at params.Page.
+func (c *Client) ListPipelinesAll(ctx context.Context, params *ListPipelinesParams) iter.Seq2[models.Pipeline, error] {
+	var p ListPipelinesParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Pipeline, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListPipelines(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
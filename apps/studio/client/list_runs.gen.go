@@ -0,0 +1,74 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListRuns - GET /runs
+// ============================================================================
+
+// ListRunsParams defines the query and header parameters of ListRuns.
+type ListRunsParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListRunsResponse is the 200 response of ListRuns.
+type ListRunsResponse struct {
+	Data []models.Run                `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListRuns calls GET /runs.
+//
+// List runs
+func (c *Client) ListRuns(ctx context.Context, params *ListRunsParams) (*ListRunsResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/runs",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListRunsResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListRuns: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListRunsAll iterates over the items of every page of ListRuns,
+// starting at params.Page.
+func (c *Client) ListRunsAll(ctx context.Context, params *ListRunsParams) iter.Seq2[models.Run, error] {
+	var p ListRunsParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Run, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListRuns(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
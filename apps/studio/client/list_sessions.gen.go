@@ -0,0 +1,71 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListSessions - GET /auth/sessions
+// ============================================================================
+
+// ListSessionsParams defines the query and header parameters of ListSessions.
+type ListSessionsParams struct {
+	Page *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListSessionsResponse is the 200 response of ListSessions.
+type ListSessionsResponse struct {
+	Data []models.Session            `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListSessions calls GET /auth/sessions.
+//
+// List sessions
+func (c *Client) ListSessions(ctx context.Context, params *ListSessionsParams) (*ListSessionsResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/auth/sessions",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListSessionsResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListSessions: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListSessionsAll iterates over the items of every page of ListSessions,
+// starting at params.Page.
+func (c *Client) ListSessionsAll(ctx context.Context, params *ListSessionsParams) iter.Seq2[models.Session, error] {
+	var p ListSessionsParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Session, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListSessions(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
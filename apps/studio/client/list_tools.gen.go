@@ -0,0 +1,74 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListTools - GET /tools
+// ============================================================================
+
+// ListToolsParams defines the query and header parameters of ListTools.
+type ListToolsParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListToolsResponse is the 200 response of ListTools.
+type ListToolsResponse struct {
+	Data []models.Tool               `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListTools calls GET /tools.
+//
+// List tools
+func (c *Client) ListTools(ctx context.Context, params *ListToolsParams) (*ListToolsResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/tools",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListToolsResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListTools: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListToolsAll iterates over the items of every page of ListTools,
+// starting at params.Page.
+func (c *Client) ListToolsAll(ctx context.Context, params *ListToolsParams) iter.Seq2[models.Tool, error] {
+	var p ListToolsParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.Tool, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListTools(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
@@ -0,0 +1,74 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// ============================================================================
+// ListUsers - GET /users
+// ============================================================================
+
+// ListUsersParams defines the query and header parameters of ListUsers.
+type ListUsersParams struct {
+	// Filter is a filter tree such as {"type": "eq", "field": "name", "value": "x"}
+	Filter map[string]any
+	Page   *servermodels.Page
+	// Sort lists fields to sort by, such as "name", "-createdAt" or "createdAt:asc"
+	Sort []string
+}
+
+// ListUsersResponse is the 200 response of ListUsers.
+type ListUsersResponse struct {
+	Data []models.User               `json:"data"`
+	Meta servermodels.PaginationMeta `json:"meta"`
+}
+
+// ListUsers calls GET /users.
+//
+// List users
+func (c *Client) ListUsers(ctx context.Context, params *ListUsersParams) (*ListUsersResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/users",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		apiclient.SetFilter(req.Query, "filter", params.Filter)
+		apiclient.SetPage(req.Query, params.Page)
+		apiclient.SetSort(req.Query, "sort", params.Sort)
+	}
+
+	var resp ListUsersResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("ListUsers: %w", err)
+	}
+	return &resp, nil
+}
+
+// ListUsersAll iterates over the items of every page of ListUsers,
+// starting at params.Page.
+func (c *Client) ListUsersAll(ctx context.Context, params *ListUsersParams) iter.Seq2[models.User, error] {
+	var p ListUsersParams
+	if params != nil {
+		p = *params
+	}
+	return apiclient.Paginate(ctx, p.Page, func(ctx context.Context, page servermodels.Page) ([]models.User, servermodels.PaginationMeta, error) {
+		p.Page = &page
+		resp, err := c.ListUsers(ctx, &p)
+		if err != nil {
+			return nil, servermodels.PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
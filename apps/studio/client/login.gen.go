@@ -0,0 +1,60 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// Login - POST /auth/login
+// ============================================================================
+
+// LoginRequestBody defines the request body of Login.
+type LoginRequestBody struct {
+	Email      string `json:"email"`
+	Password   string `json:"password"`
+	RememberMe *bool  `json:"rememberMe,omitempty"`
+}
+
+// LoginResponse is the 201 response of Login.
+type LoginResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	AuthMethod     *string    `json:"authMethod"`
+	AuthProvider   *string    `json:"authProvider"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	IPAddress      *string    `json:"ipAddress"`
+	OrganizationID *uuid.UUID `json:"organizationID"`
+	Token          string     `json:"token"`
+	UserAgent      *string    `json:"userAgent"`
+	UserID         uuid.UUID  `json:"userID"`
+}
+
+// Login calls POST /auth/login.
+//
+// Login
+func (c *Client) Login(ctx context.Context, body LoginRequestBody) (*LoginResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/login",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp LoginResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("Login: %w", err)
+	}
+	return &resp, nil
+}
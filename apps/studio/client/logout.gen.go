@@ -0,0 +1,39 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// Logout - POST /auth/logout
+// ============================================================================
+
+// LogoutResponse is the 200 response of Logout.
+type LogoutResponse struct {
+	Message string `json:"message"`
+}
+
+// Logout calls POST /auth/logout.
+//
+// Logout
+func (c *Client) Logout(ctx context.Context) (*LogoutResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/logout",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp LogoutResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("Logout: %w", err)
+	}
+	return &resp, nil
+}
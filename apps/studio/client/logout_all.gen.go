@@ -0,0 +1,39 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// LogoutAll - POST /auth/logout-all
+// ============================================================================
+
+// LogoutAllResponse is the 200 response of LogoutAll.
+type LogoutAllResponse struct {
+	Message string `json:"message"`
+}
+
+// LogoutAll calls POST /auth/logout-all.
+//
+// Logout all sessions
+func (c *Client) LogoutAll(ctx context.Context) (*LogoutAllResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/logout-all",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp LogoutAllResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("LogoutAll: %w", err)
+	}
+	return &resp, nil
+}
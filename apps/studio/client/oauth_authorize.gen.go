@@ -0,0 +1,57 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// OauthAuthorize - GET /auth/oauth/{provider}/authorize
+// ============================================================================
+
+// OauthAuthorizeParams defines the query and header parameters of OauthAuthorize.
+type OauthAuthorizeParams struct {
+	RedirectURI *string
+	Scope       *string
+	State       *string
+}
+
+// OauthAuthorizeResponse is the 200 response of OauthAuthorize.
+type OauthAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorizationURL"`
+}
+
+// OauthAuthorize calls GET /auth/oauth/{provider}/authorize.
+//
+// Start OAuth authorization flow
+func (c *Client) OauthAuthorize(ctx context.Context, provider string, params *OauthAuthorizeParams) (*OauthAuthorizeResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/auth/oauth/" + url.PathEscape(apiclient.FormatParam(provider)) + "/authorize",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		if params.RedirectURI != nil {
+			req.Query.Set("redirect_uri", apiclient.FormatParam(*params.RedirectURI))
+		}
+		if params.Scope != nil {
+			req.Query.Set("scope", apiclient.FormatParam(*params.Scope))
+		}
+		if params.State != nil {
+			req.Query.Set("state", apiclient.FormatParam(*params.State))
+		}
+	}
+
+	var resp OauthAuthorizeResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("OauthAuthorize: %w", err)
+	}
+	return &resp, nil
+}
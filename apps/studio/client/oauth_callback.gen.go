@@ -0,0 +1,74 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// OauthCallback - GET /auth/oauth/{provider}/callback
+// ============================================================================
+
+// OauthCallbackParams defines the query and header parameters of OauthCallback.
+type OauthCallbackParams struct {
+	Code             *string
+	State            *string
+	Error            *string
+	ErrorDescription *string
+}
+
+// OauthCallbackResponse is the 200 response of OauthCallback.
+type OauthCallbackResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	AuthMethod     *string    `json:"authMethod"`
+	AuthProvider   *string    `json:"authProvider"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	IPAddress      *string    `json:"ipAddress"`
+	OrganizationID *uuid.UUID `json:"organizationID"`
+	Token          string     `json:"token"`
+	UserAgent      *string    `json:"userAgent"`
+	UserID         uuid.UUID  `json:"userID"`
+}
+
+// OauthCallback calls GET /auth/oauth/{provider}/callback.
+//
+// Handle OAuth callback
+func (c *Client) OauthCallback(ctx context.Context, provider string, params *OauthCallbackParams) (*OauthCallbackResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/auth/oauth/" + url.PathEscape(apiclient.FormatParam(provider)) + "/callback",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params != nil {
+		if params.Code != nil {
+			req.Query.Set("code", apiclient.FormatParam(*params.Code))
+		}
+		if params.State != nil {
+			req.Query.Set("state", apiclient.FormatParam(*params.State))
+		}
+		if params.Error != nil {
+			req.Query.Set("error", apiclient.FormatParam(*params.Error))
+		}
+		if params.ErrorDescription != nil {
+			req.Query.Set("error_description", apiclient.FormatParam(*params.ErrorDescription))
+		}
+	}
+
+	var resp OauthCallbackResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("OauthCallback: %w", err)
+	}
+	return &resp, nil
+}
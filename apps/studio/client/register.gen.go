@@ -0,0 +1,60 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// Register - POST /auth/register
+// ============================================================================
+
+// RegisterRequestBody defines the request body of Register.
+type RegisterRequestBody struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// RegisterResponse is the 201 response of Register.
+type RegisterResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	AuthMethod     *string    `json:"authMethod"`
+	AuthProvider   *string    `json:"authProvider"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	IPAddress      *string    `json:"ipAddress"`
+	OrganizationID *uuid.UUID `json:"organizationID"`
+	Token          string     `json:"token"`
+	UserAgent      *string    `json:"userAgent"`
+	UserID         uuid.UUID  `json:"userID"`
+}
+
+// Register calls POST /auth/register.
+//
+// Register
+func (c *Client) Register(ctx context.Context, body RegisterRequestBody) (*RegisterResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/register",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp RegisterResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("Register: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,42 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// RequestEmailChange - POST /auth/change-email
+// ============================================================================
+
+// RequestEmailChangeRequestBody defines the request body of RequestEmailChange.
+type RequestEmailChangeRequestBody struct {
+	NewEmail string    `json:"newEmail"`
+	UserID   uuid.UUID `json:"userID"`
+}
+
+// RequestEmailChange calls POST /auth/change-email.
+//
+// Request e-mail change
+func (c *Client) RequestEmailChange(ctx context.Context, body RequestEmailChangeRequestBody) error {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/change-email",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("RequestEmailChange: %w", err)
+	}
+	return nil
+}
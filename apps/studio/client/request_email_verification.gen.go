@@ -0,0 +1,33 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// RequestEmailVerification - POST /auth/request-verification
+// ============================================================================
+
+// RequestEmailVerification calls POST /auth/request-verification.
+//
+// Request e-mail verification
+func (c *Client) RequestEmailVerification(ctx context.Context) error {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/request-verification",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("RequestEmailVerification: %w", err)
+	}
+	return nil
+}
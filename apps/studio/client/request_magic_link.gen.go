@@ -0,0 +1,51 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// RequestMagicLink - POST /auth/magic-links/request
+// ============================================================================
+
+// RequestMagicLinkRequestBody defines the request body of RequestMagicLink.
+type RequestMagicLinkRequestBody struct {
+	DeliveryMethod *string `json:"deliveryMethod,omitempty"`
+	Identifier     string  `json:"identifier"`
+	RedirectURL    *string `json:"redirectUrl,omitempty"`
+}
+
+// RequestMagicLinkResponse is the 200 response of RequestMagicLink.
+type RequestMagicLinkResponse struct {
+	ExpiresIn *int32                 `json:"expiresIn,omitempty"`
+	Message   *string                `json:"message,omitempty"`
+	OtpCode   *string                `json:"otpCode,omitempty"`
+	Token     *models.MagicLinkToken `json:"token,omitempty"`
+}
+
+// RequestMagicLink calls POST /auth/magic-links/request.
+//
+// Request a magic link
+func (c *Client) RequestMagicLink(ctx context.Context, body RequestMagicLinkRequestBody) (*RequestMagicLinkResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/magic-links/request",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp RequestMagicLinkResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("RequestMagicLink: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,39 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// RequestPasswordReset - POST /auth/forgot-password
+// ============================================================================
+
+// RequestPasswordResetRequestBody defines the request body of RequestPasswordReset.
+type RequestPasswordResetRequestBody struct {
+	Email string `json:"email"`
+}
+
+// RequestPasswordReset calls POST /auth/forgot-password.
+//
+// Request password reset
+func (c *Client) RequestPasswordReset(ctx context.Context, body RequestPasswordResetRequestBody) error {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/forgot-password",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("RequestPasswordReset: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// UpdateAccount - PATCH /auth/accounts/{id}
+// ============================================================================
+
+// UpdateAccountRequestBody defines the request body of UpdateAccount.
+type UpdateAccountRequestBody struct {
+	Provider                  *string `json:"provider,omitempty"`
+	ProviderAccountIdentifier *string `json:"providerAccountIdentifier,omitempty"`
+	Type                      *string `json:"type,omitempty"`
+}
+
+// UpdateAccountResponse is the 200 response of UpdateAccount.
+type UpdateAccountResponse struct {
+	Data models.Account `json:"data"`
+}
+
+// UpdateAccount calls PATCH /auth/accounts/{id}.
+//
+// Update an account
+func (c *Client) UpdateAccount(ctx context.Context, id uuid.UUID, body UpdateAccountRequestBody) (*UpdateAccountResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/auth/accounts/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateAccountResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateAccount: %w", err)
+	}
+	return &resp, nil
+}
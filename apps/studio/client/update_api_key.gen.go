@@ -0,0 +1,52 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// UpdateAPIKey - PATCH /api-keys/{id}
+// ============================================================================
+
+// UpdateAPIKeyRequestBody defines the request body of UpdateAPIKey.
+type UpdateAPIKeyRequestBody struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+	RateLimit *int32     `json:"rateLimit,omitempty"`
+	Scopes    []string   `json:"scopes,omitempty"`
+}
+
+// UpdateAPIKeyResponse is the 200 response of UpdateAPIKey.
+type UpdateAPIKeyResponse struct {
+	Data models.APIKey `json:"data"`
+}
+
+// UpdateAPIKey calls PATCH /api-keys/{id}.
+//
+// Update an API key
+func (c *Client) UpdateAPIKey(ctx context.Context, id uuid.UUID, body UpdateAPIKeyRequestBody) (*UpdateAPIKeyResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/api-keys/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateAPIKeyResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateAPIKey: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/storage/models"
+)
+
+// ============================================================================
+// UpdateArtifact - PATCH /artifacts/{id}
+// ============================================================================
+
+// UpdateArtifactRequestBody defines the request body of UpdateArtifact.
+type UpdateArtifactRequestBody struct {
+	Name *string `json:"name,omitempty"`
+	Text *string `json:"text,omitempty"`
+	URL  *string `json:"url,omitempty"`
+}
+
+// UpdateArtifactResponse is the 200 response of UpdateArtifact.
+type UpdateArtifactResponse struct {
+	Data models.Artifact `json:"data"`
+}
+
+// UpdateArtifact calls PATCH /artifacts/{id}.
+//
+// Update an artifact
+func (c *Client) UpdateArtifact(ctx context.Context, id uuid.UUID, body UpdateArtifactRequestBody) (*UpdateArtifactResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/artifacts/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateArtifactResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateArtifact: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,47 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// UpdateCurrentUser - PATCH /auth/me
+// ============================================================================
+
+// UpdateCurrentUserRequestBody defines the request body of UpdateCurrentUser.
+type UpdateCurrentUserRequestBody struct {
+	Image *string `json:"image,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// UpdateCurrentUserResponse is the 200 response of UpdateCurrentUser.
+type UpdateCurrentUserResponse struct {
+	Data models.User `json:"data"`
+}
+
+// UpdateCurrentUser calls PATCH /auth/me.
+//
+// Update current user
+func (c *Client) UpdateCurrentUser(ctx context.Context, body UpdateCurrentUserRequestBody) (*UpdateCurrentUserResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/auth/me",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateCurrentUserResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateCurrentUser: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,60 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/executor/models"
+)
+
+// ============================================================================
+// UpdateExecutor - PATCH /executors/{id}
+// ============================================================================
+
+// UpdateExecutorRequestBody defines the request body of UpdateExecutor.
+type UpdateExecutorRequestBody struct {
+	CPUShares    *int32  `json:"cpuShares,omitempty"`
+	Dependencies *string `json:"dependencies,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Env          *string `json:"env,omitempty"`
+	ExecuteCode  *string `json:"executeCode,omitempty"`
+	ExtraFiles   *string `json:"extraFiles,omitempty"`
+	IsActive     *bool   `json:"isActive,omitempty"`
+	Language     *string `json:"language,omitempty"`
+	MemoryMB     *int32  `json:"memoryMB,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	SchemaIn     *string `json:"schemaIn,omitempty"`
+	SchemaOut    *string `json:"schemaOut,omitempty"`
+	Timeout      *int32  `json:"timeout,omitempty"`
+}
+
+// UpdateExecutorResponse is the 200 response of UpdateExecutor.
+type UpdateExecutorResponse struct {
+	Data models.Executor `json:"data"`
+}
+
+// UpdateExecutor calls PATCH /executors/{id}.
+//
+// Update an executor
+func (c *Client) UpdateExecutor(ctx context.Context, id uuid.UUID, body UpdateExecutorRequestBody) (*UpdateExecutorResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/executors/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateExecutorResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateExecutor: %w", err)
+	}
+	return &resp, nil
+}
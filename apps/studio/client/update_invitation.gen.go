@@ -0,0 +1,49 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// UpdateInvitation - PATCH /organizations/{organizationID}/invitations/{id}
+// ============================================================================
+
+// UpdateInvitationRequestBody defines the request body of UpdateInvitation.
+type UpdateInvitationRequestBody struct {
+	Email *string `json:"email,omitempty"`
+	Role  *string `json:"role,omitempty"`
+}
+
+// UpdateInvitationResponse is the 200 response of UpdateInvitation.
+type UpdateInvitationResponse struct {
+	Data models.Invitation `json:"data"`
+}
+
+// UpdateInvitation calls PATCH /organizations/{organizationID}/invitations/{id}.
+//
+// Update an invitation
+func (c *Client) UpdateInvitation(ctx context.Context, organizationID uuid.UUID, id uuid.UUID, body UpdateInvitationRequestBody) (*UpdateInvitationResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/invitations/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateInvitationResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateInvitation: %w", err)
+	}
+	return &resp, nil
+}
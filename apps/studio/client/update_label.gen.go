@@ -0,0 +1,48 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/storage/models"
+)
+
+// ============================================================================
+// UpdateLabel - PATCH /labels/{id}
+// ============================================================================
+
+// UpdateLabelRequestBody defines the request body of UpdateLabel.
+type UpdateLabelRequestBody struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// UpdateLabelResponse is the 200 response of UpdateLabel.
+type UpdateLabelResponse struct {
+	Data models.Label `json:"data"`
+}
+
+// UpdateLabel calls PATCH /labels/{id}.
+//
+// Update a label
+func (c *Client) UpdateLabel(ctx context.Context, id uuid.UUID, body UpdateLabelRequestBody) (*UpdateLabelResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/labels/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateLabelResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateLabel: %w", err)
+	}
+	return &resp, nil
+}
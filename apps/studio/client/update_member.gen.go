@@ -0,0 +1,49 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// UpdateMember - PATCH /organizations/{organizationID}/members/{id}
+// ============================================================================
+
+// UpdateMemberRequestBody defines the request body of UpdateMember.
+type UpdateMemberRequestBody struct {
+	Role   *string    `json:"role,omitempty"`
+	RoleID *uuid.UUID `json:"roleID,omitempty"`
+}
+
+// UpdateMemberResponse is the 200 response of UpdateMember.
+type UpdateMemberResponse struct {
+	Data models.Member `json:"data"`
+}
+
+// UpdateMember calls PATCH /organizations/{organizationID}/members/{id}.
+//
+// Update a member
+func (c *Client) UpdateMember(ctx context.Context, organizationID uuid.UUID, id uuid.UUID, body UpdateMemberRequestBody) (*UpdateMemberResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/members/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateMemberResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateMember: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,49 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// UpdateOrganization - PATCH /organizations/{id}
+// ============================================================================
+
+// UpdateOrganizationRequestBody defines the request body of UpdateOrganization.
+type UpdateOrganizationRequestBody struct {
+	BillingEmail   *string    `json:"billingEmail,omitempty"`
+	OrganizationID *uuid.UUID `json:"organizationID,omitempty"`
+}
+
+// UpdateOrganizationResponse is the 200 response of UpdateOrganization.
+type UpdateOrganizationResponse struct {
+	Data models.Organization `json:"data"`
+}
+
+// UpdateOrganization calls PATCH /organizations/{id}.
+//
+// Update an organization
+func (c *Client) UpdateOrganization(ctx context.Context, id uuid.UUID, body UpdateOrganizationRequestBody) (*UpdateOrganizationResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/organizations/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateOrganizationResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateOrganization: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,49 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+)
+
+// ============================================================================
+// UpdatePipeline - PATCH /pipelines/{id}
+// ============================================================================
+
+// UpdatePipelineRequestBody defines the request body of UpdatePipeline.
+type UpdatePipelineRequestBody struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// UpdatePipelineResponse is the 200 response of UpdatePipeline.
+type UpdatePipelineResponse struct {
+	Data models.Pipeline `json:"data"`
+}
+
+// UpdatePipeline calls PATCH /pipelines/{id}.
+//
+// Update a pipeline
+func (c *Client) UpdatePipeline(ctx context.Context, id uuid.UUID, body UpdatePipelineRequestBody) (*UpdatePipelineResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/pipelines/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdatePipelineResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdatePipeline: %w", err)
+	}
+	return &resp, nil
+}
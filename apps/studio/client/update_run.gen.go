@@ -0,0 +1,48 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+)
+
+// ============================================================================
+// UpdateRun - PATCH /runs/{id}
+// ============================================================================
+
+// UpdateRunRequestBody defines the request body of UpdateRun.
+type UpdateRunRequestBody struct {
+	PipelineID *uuid.UUID `json:"pipelineID,omitempty"`
+}
+
+// UpdateRunResponse is the 200 response of UpdateRun.
+type UpdateRunResponse struct {
+	Data models.Run `json:"data"`
+}
+
+// UpdateRun calls PATCH /runs/{id}.
+//
+// Update a run
+func (c *Client) UpdateRun(ctx context.Context, id uuid.UUID, body UpdateRunRequestBody) (*UpdateRunResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/runs/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateRunResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateRun: %w", err)
+	}
+	return &resp, nil
+}
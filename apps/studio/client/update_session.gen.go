@@ -0,0 +1,51 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// UpdateSession - PATCH /auth/sessions/{id}
+// ============================================================================
+
+// UpdateSessionRequestBody defines the request body of UpdateSession.
+type UpdateSessionRequestBody struct {
+	OrganizationID uuid.UUID `json:"organizationID"`
+}
+
+// UpdateSessionResponse is the 200 response of UpdateSession.
+type UpdateSessionResponse struct {
+	AccessToken  string         `json:"accessToken"`
+	Data         models.Session `json:"data"`
+	ExpiresIn    int32          `json:"expiresIn"`
+	RefreshToken string         `json:"refreshToken"`
+}
+
+// UpdateSession calls PATCH /auth/sessions/{id}.
+//
+// Update Session
+func (c *Client) UpdateSession(ctx context.Context, id uuid.UUID, body UpdateSessionRequestBody) (*UpdateSessionResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/auth/sessions/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateSessionResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateSession: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,49 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+)
+
+// ============================================================================
+// UpdateTool - PATCH /tools/{id}
+// ============================================================================
+
+// UpdateToolRequestBody defines the request body of UpdateTool.
+type UpdateToolRequestBody struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// UpdateToolResponse is the 200 response of UpdateTool.
+type UpdateToolResponse struct {
+	Data models.Tool `json:"data"`
+}
+
+// UpdateTool calls PATCH /tools/{id}.
+//
+// Update a tool
+func (c *Client) UpdateTool(ctx context.Context, id uuid.UUID, body UpdateToolRequestBody) (*UpdateToolResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/tools/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateToolResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateTool: %w", err)
+	}
+	return &resp, nil
+}
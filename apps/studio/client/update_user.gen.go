@@ -0,0 +1,49 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+// ============================================================================
+// UpdateUser - PATCH /users/{id}
+// ============================================================================
+
+// UpdateUserRequestBody defines the request body of UpdateUser.
+type UpdateUserRequestBody struct {
+	Email *string `json:"email,omitempty"`
+	Image *string `json:"image,omitempty"`
+}
+
+// UpdateUserResponse is the 200 response of UpdateUser.
+type UpdateUserResponse struct {
+	Data models.User `json:"data"`
+}
+
+// UpdateUser calls PATCH /users/{id}.
+//
+// Update an user
+func (c *Client) UpdateUser(ctx context.Context, id uuid.UUID, body UpdateUserRequestBody) (*UpdateUserResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPatch,
+		Path:   "/users/" + url.PathEscape(apiclient.FormatParam(id)),
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp UpdateUserResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("UpdateUser: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,60 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// VerifyMagicLink - POST /auth/magic-links/verify
+// ============================================================================
+
+// VerifyMagicLinkRequestBody defines the request body of VerifyMagicLink.
+type VerifyMagicLinkRequestBody struct {
+	Code       *string `json:"code,omitempty"`
+	Identifier *string `json:"identifier,omitempty"`
+	Token      *string `json:"token,omitempty"`
+}
+
+// VerifyMagicLinkResponse is the 201 response of VerifyMagicLink.
+type VerifyMagicLinkResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	AuthMethod     *string    `json:"authMethod"`
+	AuthProvider   *string    `json:"authProvider"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	IPAddress      *string    `json:"ipAddress"`
+	OrganizationID *uuid.UUID `json:"organizationID"`
+	Token          string     `json:"token"`
+	UserAgent      *string    `json:"userAgent"`
+	UserID         uuid.UUID  `json:"userID"`
+}
+
+// VerifyMagicLink calls POST /auth/magic-links/verify.
+//
+// Verify a magic link token
+func (c *Client) VerifyMagicLink(ctx context.Context, body VerifyMagicLinkRequestBody) (*VerifyMagicLinkResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodPost,
+		Path:   "/auth/magic-links/verify",
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   body,
+	}
+
+	var resp VerifyMagicLinkResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("VerifyMagicLink: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,28 @@
+-- drop "pipeline_step" table
+DROP TABLE "public"."pipeline_step";
+-- drop "api_key" table
+DROP TABLE "public"."api_key";
+-- drop "artifact" table
+DROP TABLE "public"."artifact";
+-- drop "executor" table
+DROP TABLE "public"."executor";
+-- drop "invitation" table
+DROP TABLE "public"."invitation";
+-- drop "label" table
+DROP TABLE "public"."label";
+-- drop "member" table
+DROP TABLE "public"."member";
+-- drop "run" table
+DROP TABLE "public"."run";
+-- drop "pipeline" table
+DROP TABLE "public"."pipeline";
+-- drop "tool" table
+DROP TABLE "public"."tool";
+-- drop "organization" table
+DROP TABLE "public"."organization";
+-- drop "account" table
+DROP TABLE "public"."account";
+-- drop "session" table
+DROP TABLE "public"."session";
+-- drop "user" table
+DROP TABLE "public"."user";
@@ -0,0 +1,4 @@
+-- drop "webhook_delivery" table
+DROP TABLE "public"."webhook_delivery";
+-- drop "webhook_endpoint" table
+DROP TABLE "public"."webhook_endpoint";
@@ -0,0 +1,12 @@
+-- create "webhook_endpoint" table
+CREATE TABLE "public"."webhook_endpoint" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "description" text NULL, "disabled_at" timestamptz NULL, "enabled" boolean NOT NULL DEFAULT true, "event_types" text[] NOT NULL, "failure_count" integer NOT NULL DEFAULT 0, "organization_id" uuid NOT NULL, "secret" text NOT NULL, "url" text NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_endpoint_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "public"."organization" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- create index "idx_webhook_endpoint_organization_id" to table: "webhook_endpoint"
+CREATE INDEX "idx_webhook_endpoint_organization_id" ON "public"."webhook_endpoint" ("organization_id");
+-- create "webhook_delivery" table
+CREATE TABLE "public"."webhook_delivery" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "attempts" integer NOT NULL DEFAULT 0, "endpoint_id" uuid NOT NULL, "event_id" uuid NOT NULL, "event_type" text NOT NULL, "last_attempt_at" timestamptz NULL, "last_error" text NULL, "next_attempt_at" timestamptz NULL, "organization_id" uuid NOT NULL, "payload" text NOT NULL, "response_status" integer NULL, "status" text NOT NULL DEFAULT 'pending', PRIMARY KEY ("id"), CONSTRAINT "webhook_delivery_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "public"."organization" ("id") ON UPDATE CASCADE ON DELETE CASCADE, CONSTRAINT "webhook_delivery_endpoint_id_fkey" FOREIGN KEY ("endpoint_id") REFERENCES "public"."webhook_endpoint" ("id") ON UPDATE CASCADE ON DELETE CASCADE, CONSTRAINT "webhook_delivery_status_check" CHECK (status = ANY (ARRAY['pending'::text, 'succeeded'::text, 'failed'::text])));
+-- create index "idx_webhook_delivery_endpoint_id" to table: "webhook_delivery"
+CREATE INDEX "idx_webhook_delivery_endpoint_id" ON "public"."webhook_delivery" ("endpoint_id");
+-- create index "idx_webhook_delivery_organization_id" to table: "webhook_delivery"
+CREATE INDEX "idx_webhook_delivery_organization_id" ON "public"."webhook_delivery" ("organization_id");
+-- create index "idx_webhook_delivery_status" to table: "webhook_delivery"
+CREATE INDEX "idx_webhook_delivery_status" ON "public"."webhook_delivery" ("status");
@@ -0,0 +1,4 @@
+-- modify "artifact" table
+ALTER TABLE "public"."artifact" DROP COLUMN "search_vector";
+-- modify "pipeline" table
+ALTER TABLE "public"."pipeline" DROP COLUMN "search_vector";
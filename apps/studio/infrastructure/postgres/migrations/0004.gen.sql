@@ -0,0 +1,13 @@
+-- table-rewrite: adds stored generated column "artifact"."search_vector", which rewrites the table under an exclusive lock
+-- blocking-index: creates index "idx_artifact_search_vector" without CONCURRENTLY, which blocks writes to "artifact" while it builds
+-- table-rewrite: adds stored generated column "pipeline"."search_vector", which rewrites the table under an exclusive lock
+-- blocking-index: creates index "idx_pipeline_search_vector" without CONCURRENTLY, which blocks writes to "pipeline" while it builds
+
+-- modify "artifact" table
+ALTER TABLE "public"."artifact" ADD COLUMN "search_vector" tsvector NOT NULL GENERATED ALWAYS AS (to_tsvector('english'::regconfig, COALESCE("text", ''))) STORED;
+-- create index "idx_artifact_search_vector" to table: "artifact"
+CREATE INDEX "idx_artifact_search_vector" ON "public"."artifact" USING GIN ("search_vector");
+-- modify "pipeline" table
+ALTER TABLE "public"."pipeline" ADD COLUMN "search_vector" tsvector NOT NULL GENERATED ALWAYS AS (to_tsvector('english'::regconfig, COALESCE("name", '') || ' ' || COALESCE("description", ''))) STORED;
+-- create index "idx_pipeline_search_vector" to table: "pipeline"
+CREATE INDEX "idx_pipeline_search_vector" ON "public"."pipeline" USING GIN ("search_vector");
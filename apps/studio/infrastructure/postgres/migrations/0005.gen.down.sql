@@ -0,0 +1,2 @@
+-- drop "artifact_labels" table
+DROP TABLE "public"."artifact_labels";
@@ -0,0 +1,8 @@
+-- modify "artifact" table
+ALTER TABLE "public"."artifact" DROP COLUMN "deleted_at";
+-- modify "member" table
+ALTER TABLE "public"."member" DROP COLUMN "deleted_at";
+-- drop index "idx_organization_slug" from table: "organization"
+DROP INDEX "public"."idx_organization_slug";
+-- modify "organization" table
+ALTER TABLE "public"."organization" DROP COLUMN "deleted_at";
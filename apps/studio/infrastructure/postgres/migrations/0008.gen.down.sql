@@ -0,0 +1,2 @@
+-- modify "pipeline_step" table
+ALTER TABLE "public"."pipeline_step" DROP COLUMN "config";
@@ -32,26 +32,17 @@ FROM
   executor
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 LIMIT
   1;
 
--- name: ListExecutors :many
-SELECT
-  *
-FROM
-  executor
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountExecutors :one
 SELECT
   COUNT(*)
 FROM
-  executor;
+  executor
+WHERE
+  organization_id = sqlc.arg('tenant_id');
 
 -- name: UpdateExecutor :one
 UPDATE executor
@@ -66,20 +57,21 @@ SET
   language = COALESCE(sqlc.narg('language'), language),
   memory_mb = COALESCE(sqlc.narg('memory_mb'), memory_mb),
   name = COALESCE(sqlc.narg('name'), name),
-  organization_id = COALESCE(sqlc.narg('organization_id'), organization_id),
   schema_in = COALESCE(sqlc.narg('schema_in'), schema_in),
   schema_out = COALESCE(sqlc.narg('schema_out'), schema_out),
   timeout = COALESCE(sqlc.narg('timeout'), timeout),
   version = COALESCE(sqlc.narg('version'), version)
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 RETURNING
   *;
 
--- name: DeleteExecutor :exec
+-- name: DeleteExecutor :execrows
 DELETE FROM executor
 WHERE
-  id = sqlc.arg('id');
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id');
 
 -- name: ListExecutorsByOrganization :many
 SELECT
@@ -87,6 +79,6 @@ SELECT
 FROM
   executor
 WHERE
-  organization_id = sqlc.arg('organization_id')
+  organization_id = sqlc.arg('tenant_id')
 ORDER BY
   created_at DESC;
\ No newline at end of file
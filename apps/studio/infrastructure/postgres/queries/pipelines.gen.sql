@@ -20,42 +20,34 @@ FROM
   pipeline
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 LIMIT
   1;
 
--- name: ListPipelines :many
-SELECT
-  *
-FROM
-  pipeline
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountPipelines :one
 SELECT
   COUNT(*)
 FROM
-  pipeline;
+  pipeline
+WHERE
+  organization_id = sqlc.arg('tenant_id');
 
 -- name: UpdatePipeline :one
 UPDATE pipeline
 SET
   description = COALESCE(sqlc.narg('description'), description),
-  name = COALESCE(sqlc.narg('name'), name),
-  organization_id = COALESCE(sqlc.narg('organization_id'), organization_id)
+  name = COALESCE(sqlc.narg('name'), name)
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 RETURNING
   *;
 
--- name: DeletePipeline :exec
+-- name: DeletePipeline :execrows
 DELETE FROM pipeline
 WHERE
-  id = sqlc.arg('id');
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id');
 
 -- name: ListPipelinesByOrganization :many
 SELECT
@@ -63,6 +55,6 @@ SELECT
 FROM
   pipeline
 WHERE
-  organization_id = sqlc.arg('organization_id')
+  organization_id = sqlc.arg('tenant_id')
 ORDER BY
   created_at DESC;
\ No newline at end of file
@@ -28,18 +28,6 @@ WHERE
 LIMIT
   1;
 
--- name: ListSessions :many
-SELECT
-  *
-FROM
-  "session"
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountSessions :one
 SELECT
   COUNT(*)
@@ -62,7 +50,7 @@ WHERE
 RETURNING
   *;
 
--- name: DeleteSession :exec
+-- name: DeleteSession :execrows
 DELETE FROM "session"
 WHERE
   id = sqlc.arg('id');
\ No newline at end of file
@@ -24,18 +24,6 @@ WHERE
 LIMIT
   1;
 
--- name: ListUsers :many
-SELECT
-  *
-FROM
-  "user"
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountUsers :one
 SELECT
   COUNT(*)
@@ -54,7 +42,7 @@ WHERE
 RETURNING
   *;
 
--- name: DeleteUser :exec
+-- name: DeleteUser :execrows
 DELETE FROM "user"
 WHERE
   id = sqlc.arg('id');
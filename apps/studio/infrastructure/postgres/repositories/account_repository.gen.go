@@ -9,23 +9,37 @@ import (
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresAccountRepository implements AccountRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresAccountRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresAccountRepository creates a new PostgreSQL repository.
 func NewPostgresAccountRepository(db *pgxpool.Pool) *PostgresAccountRepository {
 	return &PostgresAccountRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresAccountRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Account operations
 
 // Create creates a new account
@@ -43,10 +57,20 @@ func (r *PostgresAccountRepository) Create(ctx context.Context, entity *models.A
 		UserID:                entity.UserID,
 	}
 
-	result, err := r.queries.CreateAccount(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create account: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewAccountCreatedEvent(entity.ID))
+
+	var result Account
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateAccount(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create account: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapAccountFromDB(&result), nil
 }
@@ -57,7 +81,7 @@ func (r *PostgresAccountRepository) Get(ctx context.Context, id uuid.UUID) (*mod
 		ID: id,
 	}
 
-	result, err := r.queries.GetAccount(ctx, params)
+	result, err := r.queriesFor(ctx).GetAccount(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrAccountNotFound
@@ -81,13 +105,23 @@ func (r *PostgresAccountRepository) Update(ctx context.Context, id uuid.UUID, en
 		Scope:                 entity.Scope,
 	}
 
-	result, err := r.queries.UpdateAccount(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrAccountNotFound
+	pending := events.Ensure(entity.Events(), models.NewAccountUpdatedEvent(id))
+
+	var result Account
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateAccount(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to update account: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update account: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapAccountFromDB(&result), nil
 }
@@ -98,26 +132,38 @@ func (r *PostgresAccountRepository) Delete(ctx context.Context, id uuid.UUID) er
 		ID: id,
 	}
 
-	err := r.queries.DeleteAccount(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteAccount(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete account: %w", err)
+		}
+		if n == 0 {
 			return models.ErrAccountNotFound
 		}
-		return fmt.Errorf("failed to delete account: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewAccountDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of accounts
-func (r *PostgresAccountRepository) List(ctx context.Context, limit, offset int32) ([]*models.Account, int64, error) {
-	listParams := ListAccountsParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of accounts
+func (r *PostgresAccountRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Account, database.PageInfo, error) {
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "account", accountColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListAccounts(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Account])
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list accounts: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count accounts: %w", err)
 	}
 
 	items := make([]*models.Account, len(results))
@@ -125,11 +171,7 @@ func (r *PostgresAccountRepository) List(ctx context.Context, limit, offset int3
 		items[i] = mapAccountFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // GetAccountByProvider retrieves a single Account by provider and accountIdentifier
@@ -139,7 +181,7 @@ func (r *PostgresAccountRepository) GetAccountByProvider(ctx context.Context, pr
 		AccountIdentifier: accountIdentifier,
 	}
 
-	result, err := r.queries.GetAccountByProvider(ctx, params)
+	result, err := r.queriesFor(ctx).GetAccountByProvider(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrAccountNotFound
@@ -157,7 +199,7 @@ func (r *PostgresAccountRepository) ListAccountsByUserID(ctx context.Context, us
 		UserID: uuid.MustParse(userID),
 	}
 
-	result, err := r.queries.ListAccountsByUserID(ctx, params)
+	result, err := r.queriesFor(ctx).ListAccountsByUserID(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrAccountNotFound
@@ -172,6 +214,22 @@ func (r *PostgresAccountRepository) ListAccountsByUserID(ctx context.Context, us
 
 }
 
+// accountColumns maps Account fields to the columns List can filter and sort on.
+var accountColumns = database.Columns{
+	"id":                    {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":             {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":             {Name: "updated_at", Kind: database.ColumnTime},
+	"accessToken":           {Name: "access_token", Kind: database.ColumnString},
+	"accessTokenExpiresAt":  {Name: "access_token_expires_at", Kind: database.ColumnTime},
+	"accountIdentifier":     {Name: "account_identifier", Kind: database.ColumnString},
+	"idToken":               {Name: "id_token", Kind: database.ColumnString},
+	"provider":              {Name: "provider", Kind: database.ColumnString},
+	"refreshToken":          {Name: "refresh_token", Kind: database.ColumnString},
+	"refreshTokenExpiresAt": {Name: "refresh_token_expires_at", Kind: database.ColumnTime},
+	"scope":                 {Name: "scope", Kind: database.ColumnString},
+	"userID":                {Name: "user_id", Kind: database.ColumnUUID},
+}
+
 func mapAccountFromDB(db *Account) *models.Account {
 	if db == nil {
 		return nil
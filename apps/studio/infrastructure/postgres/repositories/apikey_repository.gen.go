@@ -9,27 +9,45 @@ import (
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresAPIKeyRepository implements APIKeyRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresAPIKeyRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresAPIKeyRepository creates a new PostgreSQL repository.
 func NewPostgresAPIKeyRepository(db *pgxpool.Pool) *PostgresAPIKeyRepository {
 	return &PostgresAPIKeyRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresAPIKeyRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // APIKey operations
 
 // Create creates a new apikey
 func (r *PostgresAPIKeyRepository) Create(ctx context.Context, entity *models.APIKey) (*models.APIKey, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := CreateAPIKeyParams{
 		ID:             entity.ID,
 		ExpiresAt:      entity.ExpiresAt,
@@ -41,22 +59,39 @@ func (r *PostgresAPIKeyRepository) Create(ctx context.Context, entity *models.AP
 		Scopes:         entity.Scopes,
 		UserID:         entity.UserID,
 	}
+	// The row always belongs to the tenant of the context
+	params.OrganizationID = tenantID
 
-	result, err := r.queries.CreateAPIKey(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create apikey: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewAPIKeyCreatedEvent(entity.ID))
+
+	var result APIKey
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateAPIKey(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create apikey: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapAPIKeyFromDB(&result), nil
 }
 
 // Get retrieves a apikey by ID
 func (r *PostgresAPIKeyRepository) Get(ctx context.Context, id uuid.UUID) (*models.APIKey, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := GetAPIKeyParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.GetAPIKey(ctx, params)
+	result, err := r.queriesFor(ctx).GetAPIKey(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrAPIKeyNotFound
@@ -69,52 +104,89 @@ func (r *PostgresAPIKeyRepository) Get(ctx context.Context, id uuid.UUID) (*mode
 
 // Update updates an existing apikey
 func (r *PostgresAPIKeyRepository) Update(ctx context.Context, id uuid.UUID, entity *models.APIKey) (*models.APIKey, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	params := UpdateAPIKeyParams{
 		ID:        id,
+		TenantID:  tenantID,
 		ExpiresAt: entity.ExpiresAt,
 		Name:      entity.Name,
 		RateLimit: &entity.RateLimit,
 		Scopes:    entity.Scopes,
 	}
 
-	result, err := r.queries.UpdateAPIKey(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrAPIKeyNotFound
+	pending := events.Ensure(entity.Events(), models.NewAPIKeyUpdatedEvent(id))
+
+	var result APIKey
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateAPIKey(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrAPIKeyNotFound
+			}
+			return fmt.Errorf("failed to update apikey: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update apikey: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapAPIKeyFromDB(&result), nil
 }
 
 // Delete removes a apikey
 func (r *PostgresAPIKeyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
 	params := DeleteAPIKeyParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	err := r.queries.DeleteAPIKey(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteAPIKey(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete apikey: %w", err)
+		}
+		if n == 0 {
 			return models.ErrAPIKeyNotFound
 		}
-		return fmt.Errorf("failed to delete apikey: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewAPIKeyDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of apikeys
-func (r *PostgresAPIKeyRepository) List(ctx context.Context, limit, offset int32) ([]*models.APIKey, int64, error) {
-	listParams := ListAPIKeysParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of apikeys
+func (r *PostgresAPIKeyRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.APIKey, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "api_key", apikeyColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListAPIKeys(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list apikeys: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[APIKey])
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list apikeys: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list apikeys: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count apikeys: %w", err)
 	}
 
 	items := make([]*models.APIKey, len(results))
@@ -122,11 +194,22 @@ func (r *PostgresAPIKeyRepository) List(ctx context.Context, limit, offset int32
 		items[i] = mapAPIKeyFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
+	return items, database.PageInfo{Total: total}, nil
+}
 
-	return items, count, nil
+// apikeyColumns maps APIKey fields to the columns List can filter and sort on.
+var apikeyColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"expiresAt":      {Name: "expires_at", Kind: database.ColumnTime},
+	"keyHash":        {Name: "key_hash", Kind: database.ColumnString},
+	"lastUsedAt":     {Name: "last_used_at", Kind: database.ColumnTime},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"prefix":         {Name: "prefix", Kind: database.ColumnString},
+	"rateLimit":      {Name: "rate_limit", Kind: database.ColumnInteger},
+	"userID":         {Name: "user_id", Kind: database.ColumnUUID},
 }
 
 func mapAPIKeyFromDB(db *APIKey) *models.APIKey {
@@ -7,7 +7,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,21 +18,37 @@ import (
 )
 
 // PostgresArtifactRepository implements ArtifactRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresArtifactRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresArtifactRepository creates a new PostgreSQL repository.
 func NewPostgresArtifactRepository(db *pgxpool.Pool) *PostgresArtifactRepository {
 	return &PostgresArtifactRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresArtifactRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Artifact operations
 
 // Create creates a new artifact
 func (r *PostgresArtifactRepository) Create(ctx context.Context, entity *models.Artifact) (*models.Artifact, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := CreateArtifactParams{
 		ID:             entity.ID,
 		Credits:        entity.Credits,
@@ -42,22 +61,39 @@ func (r *PostgresArtifactRepository) Create(ctx context.Context, entity *models.
 		Text:           entity.Text,
 		URL:            entity.URL,
 	}
+	// The row always belongs to the tenant of the context
+	params.OrganizationID = tenantID
 
-	result, err := r.queries.CreateArtifact(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create artifact: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewArtifactCreatedEvent(entity.ID))
+
+	var result Artifact
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateArtifact(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create artifact: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapArtifactFromDB(&result), nil
 }
 
 // Get retrieves a artifact by ID
 func (r *PostgresArtifactRepository) Get(ctx context.Context, id uuid.UUID) (*models.Artifact, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := GetArtifactParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.GetArtifact(ctx, params)
+	result, err := r.queriesFor(ctx).GetArtifact(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrArtifactNotFound
@@ -70,9 +106,14 @@ func (r *PostgresArtifactRepository) Get(ctx context.Context, id uuid.UUID) (*mo
 
 // Update updates an existing artifact
 func (r *PostgresArtifactRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Artifact) (*models.Artifact, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	params := UpdateArtifactParams{
 		ID:           id,
+		TenantID:     tenantID,
 		Credits:      &entity.Credits,
 		Description:  entity.Description,
 		MimeType:     &entity.MimeType,
@@ -82,43 +123,120 @@ func (r *PostgresArtifactRepository) Update(ctx context.Context, id uuid.UUID, e
 		URL:          entity.URL,
 	}
 
-	result, err := r.queries.UpdateArtifact(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrArtifactNotFound
+	pending := events.Ensure(entity.Events(), models.NewArtifactUpdatedEvent(id))
+
+	var result Artifact
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateArtifact(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrArtifactNotFound
+			}
+			return fmt.Errorf("failed to update artifact: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update artifact: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapArtifactFromDB(&result), nil
 }
 
-// Delete removes a artifact
+// Delete marks a artifact as deleted
 func (r *PostgresArtifactRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
 	params := DeleteArtifactParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	err := r.queries.DeleteArtifact(ctx, params)
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteArtifact(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete artifact: %w", err)
+		}
+		if n == 0 {
+			return models.ErrArtifactNotFound
+		}
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewArtifactDeletedEvent(id)})
+	})
+}
+
+// Restore clears the deletion mark of a deleted artifact
+func (r *PostgresArtifactRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := database.RequireTenant(ctx)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+		return err
+	}
+	params := RestoreArtifactParams{
+		ID:       id,
+		TenantID: tenantID,
+	}
+
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).RestoreArtifact(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to restore artifact: %w", err)
+		}
+		if n == 0 {
 			return models.ErrArtifactNotFound
 		}
-		return fmt.Errorf("failed to delete artifact: %w", err)
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewArtifactUpdatedEvent(id)})
+	})
+}
+
+// List returns a filtered, sorted and paginated list of artifacts that are not deleted
+func (r *PostgresArtifactRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error) {
+	opts.Deleted = database.DeletedExclude
+	return r.list(ctx, opts)
+}
+
+// ListDeleted returns a filtered, sorted and paginated list of deleted artifacts
+func (r *PostgresArtifactRepository) ListDeleted(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error) {
+	opts.Deleted = database.DeletedOnly
+	return r.list(ctx, opts)
+}
+
+// Purge removes the artifacts deleted before the given time, in all organizations.
+// Purged rows cannot be restored and record no events.
+func (r *PostgresArtifactRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	n, err := r.queriesFor(ctx).PurgeArtifacts(ctx, PurgeArtifactsParams{Before: before})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge artifacts: %w", err)
 	}
-	return nil
+	return n, nil
 }
 
-// List returns a paginated list of artifacts
-func (r *PostgresArtifactRepository) List(ctx context.Context, limit, offset int32) ([]*models.Artifact, int64, error) {
-	listParams := ListArtifactsParams{
-		Limit:  limit,
-		Offset: offset,
+func (r *PostgresArtifactRepository) list(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	opts.SearchIndex = artifactSearchIndex
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "artifact", artifactColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListArtifacts(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Artifact])
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list artifacts: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count artifacts: %w", err)
 	}
 
 	items := make([]*models.Artifact, len(results))
@@ -126,20 +244,107 @@ func (r *PostgresArtifactRepository) List(ctx context.Context, limit, offset int
 		items[i] = mapArtifactFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
+	info := database.PageInfo{Total: total}
+	if query.SnippetSQL != "" {
+		rows, err := conn.Query(ctx, query.SnippetSQL, query.SnippetArgs...)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to highlight artifacts: %w", err)
+		}
+		defer rows.Close()
+		if info.Snippets, err = database.CollectSnippets(rows); err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to highlight artifacts: %w", err)
+		}
+	}
+	return items, info, nil
+}
+
+// AddLabel associates a label with a artifact. It returns
+// models.ErrLabelNotFound if the label does not exist in the tenant of the context.
+func (r *PostgresArtifactRepository) AddLabel(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error {
+	if _, err := r.Get(ctx, id); err != nil {
+		return err
+	}
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	params := AddArtifactLabelParams{
+		ArtifactID: id,
+		LabelID:    labelID,
+		TenantID:   tenantID,
+	}
 
-	return items, count, nil
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		affected, err := r.queries.WithTx(tx).AddArtifactLabel(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to add label to artifact: %w", err)
+		}
+		if affected == 0 {
+			return models.ErrLabelNotFound
+		}
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewArtifactUpdatedEvent(id)})
+	})
+}
+
+// RemoveLabel removes the association between a artifact and a label
+func (r *PostgresArtifactRepository) RemoveLabel(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error {
+	if _, err := r.Get(ctx, id); err != nil {
+		return err
+	}
+	params := RemoveArtifactLabelParams{
+		ArtifactID: id,
+		LabelID:    labelID,
+	}
+
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		if err := r.queries.WithTx(tx).RemoveArtifactLabel(ctx, params); err != nil {
+			return fmt.Errorf("failed to remove label from artifact: %w", err)
+		}
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewArtifactUpdatedEvent(id)})
+	})
+}
+
+// ListLabels returns the labels associated with a artifact
+func (r *PostgresArtifactRepository) ListLabels(ctx context.Context, id uuid.UUID) ([]*models.Label, error) {
+	if _, err := r.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := ListArtifactLabelsParams{
+		ArtifactID: id,
+		TenantID:   tenantID,
+	}
+
+	result, err := r.queriesFor(ctx).ListArtifactLabels(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifact labels: %w", err)
+	}
+
+	items := make([]*models.Label, len(result))
+	for i, res := range result {
+		items[i] = mapLabelFromDB(&res)
+	}
+	return items, nil
 }
 
 // ListArtifactsByOrganization retrieves multiple Artifacts by organizationID
 func (r *PostgresArtifactRepository) ListArtifactsByOrganization(ctx context.Context, organizationID string) ([]*models.Artifact, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
 	params := ListArtifactsByOrganizationParams{
-		OrganizationID: uuid.MustParse(organizationID),
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.ListArtifactsByOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).ListArtifactsByOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrArtifactNotFound
@@ -156,7 +361,12 @@ func (r *PostgresArtifactRepository) ListArtifactsByOrganization(ctx context.Con
 
 // ListArtifactsByProducer retrieves multiple Artifacts by producerID
 func (r *PostgresArtifactRepository) ListArtifactsByProducer(ctx context.Context, producerID string) ([]*models.Artifact, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := ListArtifactsByProducerParams{
+		TenantID: tenantID,
 		ProducerID: func() *uuid.UUID {
 			if producerID == "" {
 				return nil
@@ -166,7 +376,7 @@ func (r *PostgresArtifactRepository) ListArtifactsByProducer(ctx context.Context
 		}(),
 	}
 
-	result, err := r.queries.ListArtifactsByProducer(ctx, params)
+	result, err := r.queriesFor(ctx).ListArtifactsByProducer(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrArtifactNotFound
@@ -181,6 +391,27 @@ func (r *PostgresArtifactRepository) ListArtifactsByProducer(ctx context.Context
 
 }
 
+// artifactColumns maps Artifact fields to the columns List can filter and sort on.
+var artifactColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"credits":        {Name: "credits", Kind: database.ColumnInteger},
+	"description":    {Name: "description", Kind: database.ColumnString},
+	"mimeType":       {Name: "mime_type", Kind: database.ColumnString},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"previewImage":   {Name: "preview_image", Kind: database.ColumnString},
+	"producerID":     {Name: "producer_id", Kind: database.ColumnUUID},
+	"text":           {Name: "text", Kind: database.ColumnString},
+	"url":            {Name: "url", Kind: database.ColumnString},
+}
+
+// artifactSearchIndex describes the full-text index List searches.
+var artifactSearchIndex = &database.SearchIndex{
+	Columns: []string{"text"},
+}
+
 func mapArtifactFromDB(db *Artifact) *models.Artifact {
 	if db == nil {
 		return nil
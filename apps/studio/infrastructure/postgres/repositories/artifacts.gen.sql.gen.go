@@ -7,19 +7,59 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+const addArtifactLabel = `-- name: AddArtifactLabel :execrows
+INSERT INTO
+  artifact_labels (artifact_id, label_id)
+SELECT
+  o.id,
+  r.id
+FROM
+  artifact o,
+  label r
+WHERE
+  o.id = $1
+  AND r.id = $2
+  AND r.organization_id = $3
+ON CONFLICT (artifact_id, label_id) DO UPDATE
+SET
+  artifact_id = excluded.artifact_id
+`
+
+type AddArtifactLabelParams struct {
+	ArtifactID uuid.UUID
+	LabelID    uuid.UUID
+	TenantID   uuid.UUID
+}
+
+func (q *Queries) AddArtifactLabel(ctx context.Context, arg AddArtifactLabelParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addArtifactLabel, arg.ArtifactID, arg.LabelID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const countArtifacts = `-- name: CountArtifacts :one
 SELECT
   COUNT(*)
 FROM
   artifact
+WHERE
+  organization_id = $1
+  AND deleted_at IS NULL
 `
 
-func (q *Queries) CountArtifacts(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countArtifacts)
+type CountArtifactsParams struct {
+	TenantID uuid.UUID
+}
+
+func (q *Queries) CountArtifacts(ctx context.Context, arg CountArtifactsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countArtifacts, arg.TenantID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -42,7 +82,7 @@ VALUES
     $10
   )
 RETURNING
-  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url
+  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url, search_vector, deleted_at
 `
 
 type CreateArtifactParams struct {
@@ -85,42 +125,55 @@ func (q *Queries) CreateArtifact(ctx context.Context, arg CreateArtifactParams)
 		&i.ProducerID,
 		&i.Text,
 		&i.URL,
+		&i.SearchVector,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteArtifact = `-- name: DeleteArtifact :exec
-DELETE FROM artifact
+const deleteArtifact = `-- name: DeleteArtifact :execrows
+UPDATE artifact
+SET
+  deleted_at = CURRENT_TIMESTAMP
 WHERE
   id = $1
+  AND organization_id = $2
+  AND deleted_at IS NULL
 `
 
 type DeleteArtifactParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
-func (q *Queries) DeleteArtifact(ctx context.Context, arg DeleteArtifactParams) error {
-	_, err := q.db.Exec(ctx, deleteArtifact, arg.ID)
-	return err
+func (q *Queries) DeleteArtifact(ctx context.Context, arg DeleteArtifactParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteArtifact, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getArtifact = `-- name: GetArtifact :one
 SELECT
-  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url
+  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url, search_vector, deleted_at
 FROM
   artifact
 WHERE
   id = $1
+  AND organization_id = $2
+  AND deleted_at IS NULL
 LIMIT
   1
 `
 
 type GetArtifactParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) GetArtifact(ctx context.Context, arg GetArtifactParams) (Artifact, error) {
-	row := q.db.QueryRow(ctx, getArtifact, arg.ID)
+	row := q.db.QueryRow(ctx, getArtifact, arg.ID, arg.TenantID)
 	var i Artifact
 	err := row.Scan(
 		&i.ID,
@@ -135,50 +188,45 @@ func (q *Queries) GetArtifact(ctx context.Context, arg GetArtifactParams) (Artif
 		&i.ProducerID,
 		&i.Text,
 		&i.URL,
+		&i.SearchVector,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const listArtifacts = `-- name: ListArtifacts :many
+const listArtifactLabels = `-- name: ListArtifactLabels :many
 SELECT
-  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url
+  r.id, r.created_at, r.updated_at, r.name, r.organization_id
 FROM
-  artifact
+  label r
+  JOIN artifact_labels j ON j.label_id = r.id
+WHERE
+  j.artifact_id = $1
+  AND r.organization_id = $2
 ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
+  r.created_at DESC
 `
 
-type ListArtifactsParams struct {
-	Offset int32
-	Limit  int32
+type ListArtifactLabelsParams struct {
+	ArtifactID uuid.UUID
+	TenantID   uuid.UUID
 }
 
-func (q *Queries) ListArtifacts(ctx context.Context, arg ListArtifactsParams) ([]Artifact, error) {
-	rows, err := q.db.Query(ctx, listArtifacts, arg.Offset, arg.Limit)
+func (q *Queries) ListArtifactLabels(ctx context.Context, arg ListArtifactLabelsParams) ([]Label, error) {
+	rows, err := q.db.Query(ctx, listArtifactLabels, arg.ArtifactID, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Artifact
+	var items []Label
 	for rows.Next() {
-		var i Artifact
+		var i Label
 		if err := rows.Scan(
 			&i.ID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.Credits,
-			&i.Description,
-			&i.MimeType,
 			&i.Name,
 			&i.OrganizationID,
-			&i.PreviewImage,
-			&i.ProducerID,
-			&i.Text,
-			&i.URL,
 		); err != nil {
 			return nil, err
 		}
@@ -192,21 +240,22 @@ func (q *Queries) ListArtifacts(ctx context.Context, arg ListArtifactsParams) ([
 
 const listArtifactsByOrganization = `-- name: ListArtifactsByOrganization :many
 SELECT
-  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url
+  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url, search_vector, deleted_at
 FROM
   artifact
 WHERE
-  organization_id = $1
+  organization_id = $1 AND
+  deleted_at IS NULL
 ORDER BY
   created_at DESC
 `
 
 type ListArtifactsByOrganizationParams struct {
-	OrganizationID uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) ListArtifactsByOrganization(ctx context.Context, arg ListArtifactsByOrganizationParams) ([]Artifact, error) {
-	rows, err := q.db.Query(ctx, listArtifactsByOrganization, arg.OrganizationID)
+	rows, err := q.db.Query(ctx, listArtifactsByOrganization, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -227,6 +276,8 @@ func (q *Queries) ListArtifactsByOrganization(ctx context.Context, arg ListArtif
 			&i.ProducerID,
 			&i.Text,
 			&i.URL,
+			&i.SearchVector,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -240,21 +291,24 @@ func (q *Queries) ListArtifactsByOrganization(ctx context.Context, arg ListArtif
 
 const listArtifactsByProducer = `-- name: ListArtifactsByProducer :many
 SELECT
-  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url
+  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url, search_vector, deleted_at
 FROM
   artifact
 WHERE
-  producer_id = $1
+  producer_id = $1 AND
+  organization_id = $2 AND
+  deleted_at IS NULL
 ORDER BY
   created_at DESC
 `
 
 type ListArtifactsByProducerParams struct {
 	ProducerID *uuid.UUID
+	TenantID   uuid.UUID
 }
 
 func (q *Queries) ListArtifactsByProducer(ctx context.Context, arg ListArtifactsByProducerParams) ([]Artifact, error) {
-	rows, err := q.db.Query(ctx, listArtifactsByProducer, arg.ProducerID)
+	rows, err := q.db.Query(ctx, listArtifactsByProducer, arg.ProducerID, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -275,6 +329,8 @@ func (q *Queries) ListArtifactsByProducer(ctx context.Context, arg ListArtifacts
 			&i.ProducerID,
 			&i.Text,
 			&i.URL,
+			&i.SearchVector,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -286,6 +342,65 @@ func (q *Queries) ListArtifactsByProducer(ctx context.Context, arg ListArtifacts
 	return items, nil
 }
 
+const purgeArtifacts = `-- name: PurgeArtifacts :execrows
+DELETE FROM artifact
+WHERE
+  deleted_at IS NOT NULL
+  AND deleted_at < $1::timestamptz
+`
+
+type PurgeArtifactsParams struct {
+	Before time.Time
+}
+
+func (q *Queries) PurgeArtifacts(ctx context.Context, arg PurgeArtifactsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeArtifacts, arg.Before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeArtifactLabel = `-- name: RemoveArtifactLabel :exec
+DELETE FROM artifact_labels
+WHERE
+  artifact_id = $1
+  AND label_id = $2
+`
+
+type RemoveArtifactLabelParams struct {
+	ArtifactID uuid.UUID
+	LabelID    uuid.UUID
+}
+
+func (q *Queries) RemoveArtifactLabel(ctx context.Context, arg RemoveArtifactLabelParams) error {
+	_, err := q.db.Exec(ctx, removeArtifactLabel, arg.ArtifactID, arg.LabelID)
+	return err
+}
+
+const restoreArtifact = `-- name: RestoreArtifact :execrows
+UPDATE artifact
+SET
+  deleted_at = NULL
+WHERE
+  id = $1
+  AND organization_id = $2
+  AND deleted_at IS NOT NULL
+`
+
+type RestoreArtifactParams struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+}
+
+func (q *Queries) RestoreArtifact(ctx context.Context, arg RestoreArtifactParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreArtifact, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateArtifact = `-- name: UpdateArtifact :one
 UPDATE artifact
 SET
@@ -293,28 +408,29 @@ SET
   description = COALESCE($2, description),
   mime_type = COALESCE($3, mime_type),
   name = COALESCE($4, name),
-  organization_id = COALESCE($5, organization_id),
-  preview_image = COALESCE($6, preview_image),
-  producer_id = COALESCE($7, producer_id),
-  text = COALESCE($8, text),
-  url = COALESCE($9, url)
+  preview_image = COALESCE($5, preview_image),
+  producer_id = COALESCE($6, producer_id),
+  text = COALESCE($7, text),
+  url = COALESCE($8, url)
 WHERE
-  id = $10
+  id = $9
+  AND organization_id = $10
+  AND deleted_at IS NULL
 RETURNING
-  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url
+  id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url, search_vector, deleted_at
 `
 
 type UpdateArtifactParams struct {
-	Credits        *int32
-	Description    *string
-	MimeType       *string
-	Name           *string
-	OrganizationID *uuid.UUID
-	PreviewImage   *string
-	ProducerID     *uuid.UUID
-	Text           *string
-	URL            *string
-	ID             uuid.UUID
+	Credits      *int32
+	Description  *string
+	MimeType     *string
+	Name         *string
+	PreviewImage *string
+	ProducerID   *uuid.UUID
+	Text         *string
+	URL          *string
+	ID           uuid.UUID
+	TenantID     uuid.UUID
 }
 
 func (q *Queries) UpdateArtifact(ctx context.Context, arg UpdateArtifactParams) (Artifact, error) {
@@ -323,12 +439,12 @@ func (q *Queries) UpdateArtifact(ctx context.Context, arg UpdateArtifactParams)
 		arg.Description,
 		arg.MimeType,
 		arg.Name,
-		arg.OrganizationID,
 		arg.PreviewImage,
 		arg.ProducerID,
 		arg.Text,
 		arg.URL,
 		arg.ID,
+		arg.TenantID,
 	)
 	var i Artifact
 	err := row.Scan(
@@ -344,6 +460,8 @@ func (q *Queries) UpdateArtifact(ctx context.Context, arg UpdateArtifactParams)
 		&i.ProducerID,
 		&i.Text,
 		&i.URL,
+		&i.SearchVector,
+		&i.DeletedAt,
 	)
 	return i, err
 }
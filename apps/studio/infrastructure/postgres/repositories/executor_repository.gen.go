@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,21 +17,37 @@ import (
 )
 
 // PostgresExecutorRepository implements ExecutorRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresExecutorRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresExecutorRepository creates a new PostgreSQL repository.
 func NewPostgresExecutorRepository(db *pgxpool.Pool) *PostgresExecutorRepository {
 	return &PostgresExecutorRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresExecutorRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Executor operations
 
 // Create creates a new executor
 func (r *PostgresExecutorRepository) Create(ctx context.Context, entity *models.Executor) (*models.Executor, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := CreateExecutorParams{
 		ID:             entity.ID,
 		CPUShares:      entity.CPUShares,
@@ -48,22 +66,39 @@ func (r *PostgresExecutorRepository) Create(ctx context.Context, entity *models.
 		Timeout:        entity.Timeout,
 		Version:        entity.Version,
 	}
+	// The row always belongs to the tenant of the context
+	params.OrganizationID = tenantID
 
-	result, err := r.queries.CreateExecutor(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create executor: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewExecutorCreatedEvent(entity.ID))
+
+	var result Executor
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateExecutor(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create executor: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapExecutorFromDB(&result), nil
 }
 
 // Get retrieves a executor by ID
 func (r *PostgresExecutorRepository) Get(ctx context.Context, id uuid.UUID) (*models.Executor, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := GetExecutorParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.GetExecutor(ctx, params)
+	result, err := r.queriesFor(ctx).GetExecutor(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrExecutorNotFound
@@ -76,10 +111,15 @@ func (r *PostgresExecutorRepository) Get(ctx context.Context, id uuid.UUID) (*mo
 
 // Update updates an existing executor
 func (r *PostgresExecutorRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Executor) (*models.Executor, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	languageStr := string(entity.Language)
 	params := UpdateExecutorParams{
 		ID:           id,
+		TenantID:     tenantID,
 		CPUShares:    &entity.CPUShares,
 		Dependencies: entity.Dependencies,
 		Description:  &entity.Description,
@@ -95,43 +135,75 @@ func (r *PostgresExecutorRepository) Update(ctx context.Context, id uuid.UUID, e
 		Timeout:      &entity.Timeout,
 	}
 
-	result, err := r.queries.UpdateExecutor(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrExecutorNotFound
+	pending := events.Ensure(entity.Events(), models.NewExecutorUpdatedEvent(id))
+
+	var result Executor
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateExecutor(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrExecutorNotFound
+			}
+			return fmt.Errorf("failed to update executor: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update executor: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapExecutorFromDB(&result), nil
 }
 
 // Delete removes a executor
 func (r *PostgresExecutorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
 	params := DeleteExecutorParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	err := r.queries.DeleteExecutor(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteExecutor(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete executor: %w", err)
+		}
+		if n == 0 {
 			return models.ErrExecutorNotFound
 		}
-		return fmt.Errorf("failed to delete executor: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewExecutorDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of executors
-func (r *PostgresExecutorRepository) List(ctx context.Context, limit, offset int32) ([]*models.Executor, int64, error) {
-	listParams := ListExecutorsParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of executors
+func (r *PostgresExecutorRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Executor, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "executor", executorColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListExecutors(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list executors: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Executor])
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list executors: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list executors: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count executors: %w", err)
 	}
 
 	items := make([]*models.Executor, len(results))
@@ -139,20 +211,24 @@ func (r *PostgresExecutorRepository) List(ctx context.Context, limit, offset int
 		items[i] = mapExecutorFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // ListExecutorsByOrganization retrieves multiple Executors by organizationID
 func (r *PostgresExecutorRepository) ListExecutorsByOrganization(ctx context.Context, organizationID string) ([]*models.Executor, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
 	params := ListExecutorsByOrganizationParams{
-		OrganizationID: uuid.MustParse(organizationID),
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.ListExecutorsByOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).ListExecutorsByOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrExecutorNotFound
@@ -167,6 +243,28 @@ func (r *PostgresExecutorRepository) ListExecutorsByOrganization(ctx context.Con
 
 }
 
+// executorColumns maps Executor fields to the columns List can filter and sort on.
+var executorColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"cpuShares":      {Name: "cpu_shares", Kind: database.ColumnInteger},
+	"dependencies":   {Name: "dependencies", Kind: database.ColumnString},
+	"description":    {Name: "description", Kind: database.ColumnString},
+	"env":            {Name: "env", Kind: database.ColumnString},
+	"executeCode":    {Name: "execute_code", Kind: database.ColumnString},
+	"extraFiles":     {Name: "extra_files", Kind: database.ColumnString},
+	"isActive":       {Name: "is_active", Kind: database.ColumnBool},
+	"language":       {Name: "language", Kind: database.ColumnString},
+	"memoryMB":       {Name: "memory_mb", Kind: database.ColumnInteger},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"schemaIn":       {Name: "schema_in", Kind: database.ColumnString},
+	"schemaOut":      {Name: "schema_out", Kind: database.ColumnString},
+	"timeout":        {Name: "timeout", Kind: database.ColumnInteger},
+	"version":        {Name: "version", Kind: database.ColumnInteger},
+}
+
 func mapExecutorFromDB(db *Executor) *models.Executor {
 	if db == nil {
 		return nil
@@ -16,10 +16,16 @@ SELECT
   COUNT(*)
 FROM
   executor
+WHERE
+  organization_id = $1
 `
 
-func (q *Queries) CountExecutors(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countExecutors)
+type CountExecutorsParams struct {
+	TenantID uuid.UUID
+}
+
+func (q *Queries) CountExecutors(ctx context.Context, arg CountExecutorsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countExecutors, arg.TenantID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -113,19 +119,24 @@ func (q *Queries) CreateExecutor(ctx context.Context, arg CreateExecutorParams)
 	return i, err
 }
 
-const deleteExecutor = `-- name: DeleteExecutor :exec
+const deleteExecutor = `-- name: DeleteExecutor :execrows
 DELETE FROM executor
 WHERE
   id = $1
+  AND organization_id = $2
 `
 
 type DeleteExecutorParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
-func (q *Queries) DeleteExecutor(ctx context.Context, arg DeleteExecutorParams) error {
-	_, err := q.db.Exec(ctx, deleteExecutor, arg.ID)
-	return err
+func (q *Queries) DeleteExecutor(ctx context.Context, arg DeleteExecutorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExecutor, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getExecutor = `-- name: GetExecutor :one
@@ -135,16 +146,18 @@ FROM
   executor
 WHERE
   id = $1
+  AND organization_id = $2
 LIMIT
   1
 `
 
 type GetExecutorParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) GetExecutor(ctx context.Context, arg GetExecutorParams) (Executor, error) {
-	row := q.db.QueryRow(ctx, getExecutor, arg.ID)
+	row := q.db.QueryRow(ctx, getExecutor, arg.ID, arg.TenantID)
 	var i Executor
 	err := row.Scan(
 		&i.ID,
@@ -169,63 +182,6 @@ func (q *Queries) GetExecutor(ctx context.Context, arg GetExecutorParams) (Execu
 	return i, err
 }
 
-const listExecutors = `-- name: ListExecutors :many
-SELECT
-  id, created_at, updated_at, cpu_shares, dependencies, description, env, execute_code, extra_files, is_active, language, memory_mb, name, organization_id, schema_in, schema_out, timeout, version
-FROM
-  executor
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListExecutorsParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListExecutors(ctx context.Context, arg ListExecutorsParams) ([]Executor, error) {
-	rows, err := q.db.Query(ctx, listExecutors, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Executor
-	for rows.Next() {
-		var i Executor
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.CPUShares,
-			&i.Dependencies,
-			&i.Description,
-			&i.Env,
-			&i.ExecuteCode,
-			&i.ExtraFiles,
-			&i.IsActive,
-			&i.Language,
-			&i.MemoryMB,
-			&i.Name,
-			&i.OrganizationID,
-			&i.SchemaIn,
-			&i.SchemaOut,
-			&i.Timeout,
-			&i.Version,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listExecutorsByOrganization = `-- name: ListExecutorsByOrganization :many
 SELECT
   id, created_at, updated_at, cpu_shares, dependencies, description, env, execute_code, extra_files, is_active, language, memory_mb, name, organization_id, schema_in, schema_out, timeout, version
@@ -238,11 +194,11 @@ ORDER BY
 `
 
 type ListExecutorsByOrganizationParams struct {
-	OrganizationID uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) ListExecutorsByOrganization(ctx context.Context, arg ListExecutorsByOrganizationParams) ([]Executor, error) {
-	rows, err := q.db.Query(ctx, listExecutorsByOrganization, arg.OrganizationID)
+	rows, err := q.db.Query(ctx, listExecutorsByOrganization, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -293,34 +249,34 @@ SET
   language = COALESCE($8, language),
   memory_mb = COALESCE($9, memory_mb),
   name = COALESCE($10, name),
-  organization_id = COALESCE($11, organization_id),
-  schema_in = COALESCE($12, schema_in),
-  schema_out = COALESCE($13, schema_out),
-  timeout = COALESCE($14, timeout),
-  version = COALESCE($15, version)
+  schema_in = COALESCE($11, schema_in),
+  schema_out = COALESCE($12, schema_out),
+  timeout = COALESCE($13, timeout),
+  version = COALESCE($14, version)
 WHERE
-  id = $16
+  id = $15
+  AND organization_id = $16
 RETURNING
   id, created_at, updated_at, cpu_shares, dependencies, description, env, execute_code, extra_files, is_active, language, memory_mb, name, organization_id, schema_in, schema_out, timeout, version
 `
 
 type UpdateExecutorParams struct {
-	CPUShares      *int32
-	Dependencies   *string
-	Description    *string
-	Env            *string
-	ExecuteCode    *string
-	ExtraFiles     *string
-	IsActive       *bool
-	Language       *string
-	MemoryMB       *int32
-	Name           *string
-	OrganizationID *uuid.UUID
-	SchemaIn       *string
-	SchemaOut      *string
-	Timeout        *int32
-	Version        *int32
-	ID             uuid.UUID
+	CPUShares    *int32
+	Dependencies *string
+	Description  *string
+	Env          *string
+	ExecuteCode  *string
+	ExtraFiles   *string
+	IsActive     *bool
+	Language     *string
+	MemoryMB     *int32
+	Name         *string
+	SchemaIn     *string
+	SchemaOut    *string
+	Timeout      *int32
+	Version      *int32
+	ID           uuid.UUID
+	TenantID     uuid.UUID
 }
 
 func (q *Queries) UpdateExecutor(ctx context.Context, arg UpdateExecutorParams) (Executor, error) {
@@ -335,12 +291,12 @@ func (q *Queries) UpdateExecutor(ctx context.Context, arg UpdateExecutorParams)
 		arg.Language,
 		arg.MemoryMB,
 		arg.Name,
-		arg.OrganizationID,
 		arg.SchemaIn,
 		arg.SchemaOut,
 		arg.Timeout,
 		arg.Version,
 		arg.ID,
+		arg.TenantID,
 	)
 	var i Executor
 	err := row.Scan(
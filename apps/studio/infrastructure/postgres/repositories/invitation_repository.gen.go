@@ -9,23 +9,37 @@ import (
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresInvitationRepository implements InvitationRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresInvitationRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresInvitationRepository creates a new PostgreSQL repository.
 func NewPostgresInvitationRepository(db *pgxpool.Pool) *PostgresInvitationRepository {
 	return &PostgresInvitationRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresInvitationRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Invitation operations
 
 // Create creates a new invitation
@@ -40,10 +54,20 @@ func (r *PostgresInvitationRepository) Create(ctx context.Context, entity *model
 		Status:         string(entity.Status),
 	}
 
-	result, err := r.queries.CreateInvitation(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewInvitationCreatedEvent(entity.ID))
+
+	var result Invitation
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateInvitation(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create invitation: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapInvitationFromDB(&result), nil
 }
@@ -54,7 +78,7 @@ func (r *PostgresInvitationRepository) Get(ctx context.Context, id uuid.UUID) (*
 		ID: id,
 	}
 
-	result, err := r.queries.GetInvitation(ctx, params)
+	result, err := r.queriesFor(ctx).GetInvitation(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrInvitationNotFound
@@ -78,13 +102,23 @@ func (r *PostgresInvitationRepository) Update(ctx context.Context, id uuid.UUID,
 		Status:    &statusStr,
 	}
 
-	result, err := r.queries.UpdateInvitation(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrInvitationNotFound
+	pending := events.Ensure(entity.Events(), models.NewInvitationUpdatedEvent(id))
+
+	var result Invitation
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateInvitation(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrInvitationNotFound
+			}
+			return fmt.Errorf("failed to update invitation: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update invitation: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapInvitationFromDB(&result), nil
 }
@@ -95,26 +129,38 @@ func (r *PostgresInvitationRepository) Delete(ctx context.Context, id uuid.UUID)
 		ID: id,
 	}
 
-	err := r.queries.DeleteInvitation(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteInvitation(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete invitation: %w", err)
+		}
+		if n == 0 {
 			return models.ErrInvitationNotFound
 		}
-		return fmt.Errorf("failed to delete invitation: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewInvitationDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of invitations
-func (r *PostgresInvitationRepository) List(ctx context.Context, limit, offset int32) ([]*models.Invitation, int64, error) {
-	listParams := ListInvitationsParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of invitations
+func (r *PostgresInvitationRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Invitation, database.PageInfo, error) {
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "invitation", invitationColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListInvitations(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list invitations: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Invitation])
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list invitations: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count invitations: %w", err)
 	}
 
 	items := make([]*models.Invitation, len(results))
@@ -122,11 +168,7 @@ func (r *PostgresInvitationRepository) List(ctx context.Context, limit, offset i
 		items[i] = mapInvitationFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // ListInvitationsByOrganization retrieves multiple Invitations by organizationID
@@ -135,7 +177,7 @@ func (r *PostgresInvitationRepository) ListInvitationsByOrganization(ctx context
 		OrganizationID: uuid.MustParse(organizationID),
 	}
 
-	result, err := r.queries.ListInvitationsByOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).ListInvitationsByOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrInvitationNotFound
@@ -157,7 +199,7 @@ func (r *PostgresInvitationRepository) GetInvitationByEmail(ctx context.Context,
 		OrganizationID: uuid.MustParse(organizationID),
 	}
 
-	result, err := r.queries.GetInvitationByEmail(ctx, params)
+	result, err := r.queriesFor(ctx).GetInvitationByEmail(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrInvitationNotFound
@@ -175,7 +217,7 @@ func (r *PostgresInvitationRepository) ListInvitationsByInviter(ctx context.Cont
 		InviterID: uuid.MustParse(inviterID),
 	}
 
-	result, err := r.queries.ListInvitationsByInviter(ctx, params)
+	result, err := r.queriesFor(ctx).ListInvitationsByInviter(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrInvitationNotFound
@@ -190,6 +232,19 @@ func (r *PostgresInvitationRepository) ListInvitationsByInviter(ctx context.Cont
 
 }
 
+// invitationColumns maps Invitation fields to the columns List can filter and sort on.
+var invitationColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"email":          {Name: "email", Kind: database.ColumnString},
+	"expiresAt":      {Name: "expires_at", Kind: database.ColumnTime},
+	"inviterID":      {Name: "inviter_id", Kind: database.ColumnUUID},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"role":           {Name: "role", Kind: database.ColumnString},
+	"status":         {Name: "status", Kind: database.ColumnString},
+}
+
 func mapInvitationFromDB(db *Invitation) *models.Invitation {
 	if db == nil {
 		return nil
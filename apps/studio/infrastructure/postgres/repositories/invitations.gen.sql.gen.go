@@ -78,7 +78,7 @@ func (q *Queries) CreateInvitation(ctx context.Context, arg CreateInvitationPara
 	return i, err
 }
 
-const deleteInvitation = `-- name: DeleteInvitation :exec
+const deleteInvitation = `-- name: DeleteInvitation :execrows
 DELETE FROM invitation
 WHERE
   id = $1
@@ -88,9 +88,12 @@ type DeleteInvitationParams struct {
 	ID uuid.UUID
 }
 
-func (q *Queries) DeleteInvitation(ctx context.Context, arg DeleteInvitationParams) error {
-	_, err := q.db.Exec(ctx, deleteInvitation, arg.ID)
-	return err
+func (q *Queries) DeleteInvitation(ctx context.Context, arg DeleteInvitationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteInvitation, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getInvitation = `-- name: GetInvitation :one
@@ -159,54 +162,6 @@ func (q *Queries) GetInvitationByEmail(ctx context.Context, arg GetInvitationByE
 	return i, err
 }
 
-const listInvitations = `-- name: ListInvitations :many
-SELECT
-  id, created_at, updated_at, email, expires_at, inviter_id, organization_id, role, status
-FROM
-  invitation
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListInvitationsParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListInvitations(ctx context.Context, arg ListInvitationsParams) ([]Invitation, error) {
-	rows, err := q.db.Query(ctx, listInvitations, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Invitation
-	for rows.Next() {
-		var i Invitation
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.Email,
-			&i.ExpiresAt,
-			&i.InviterID,
-			&i.OrganizationID,
-			&i.Role,
-			&i.Status,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listInvitationsByInviter = `-- name: ListInvitationsByInviter :many
 SELECT
   id, created_at, updated_at, email, expires_at, inviter_id, organization_id, role, status
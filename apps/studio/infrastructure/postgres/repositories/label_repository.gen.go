@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,42 +17,75 @@ import (
 )
 
 // PostgresLabelRepository implements LabelRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresLabelRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresLabelRepository creates a new PostgreSQL repository.
 func NewPostgresLabelRepository(db *pgxpool.Pool) *PostgresLabelRepository {
 	return &PostgresLabelRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresLabelRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Label operations
 
 // Create creates a new label
 func (r *PostgresLabelRepository) Create(ctx context.Context, entity *models.Label) (*models.Label, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := CreateLabelParams{
 		ID:             entity.ID,
 		Name:           entity.Name,
 		OrganizationID: entity.OrganizationID,
 	}
+	// The row always belongs to the tenant of the context
+	params.OrganizationID = tenantID
 
-	result, err := r.queries.CreateLabel(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create label: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewLabelCreatedEvent(entity.ID))
+
+	var result Label
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateLabel(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create label: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapLabelFromDB(&result), nil
 }
 
 // Get retrieves a label by ID
 func (r *PostgresLabelRepository) Get(ctx context.Context, id uuid.UUID) (*models.Label, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := GetLabelParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.GetLabel(ctx, params)
+	result, err := r.queriesFor(ctx).GetLabel(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrLabelNotFound
@@ -63,49 +98,86 @@ func (r *PostgresLabelRepository) Get(ctx context.Context, id uuid.UUID) (*model
 
 // Update updates an existing label
 func (r *PostgresLabelRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Label) (*models.Label, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	params := UpdateLabelParams{
-		ID:   id,
-		Name: &entity.Name,
+		ID:       id,
+		TenantID: tenantID,
+		Name:     &entity.Name,
 	}
 
-	result, err := r.queries.UpdateLabel(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrLabelNotFound
+	pending := events.Ensure(entity.Events(), models.NewLabelUpdatedEvent(id))
+
+	var result Label
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateLabel(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrLabelNotFound
+			}
+			return fmt.Errorf("failed to update label: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update label: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapLabelFromDB(&result), nil
 }
 
 // Delete removes a label
 func (r *PostgresLabelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
 	params := DeleteLabelParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	err := r.queries.DeleteLabel(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteLabel(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete label: %w", err)
+		}
+		if n == 0 {
 			return models.ErrLabelNotFound
 		}
-		return fmt.Errorf("failed to delete label: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewLabelDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of labels
-func (r *PostgresLabelRepository) List(ctx context.Context, limit, offset int32) ([]*models.Label, int64, error) {
-	listParams := ListLabelsParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of labels
+func (r *PostgresLabelRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Label, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "label", labelColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListLabels(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list labels: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Label])
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list labels: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count labels: %w", err)
 	}
 
 	items := make([]*models.Label, len(results))
@@ -113,20 +185,24 @@ func (r *PostgresLabelRepository) List(ctx context.Context, limit, offset int32)
 		items[i] = mapLabelFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // ListLabelsByOrganization retrieves multiple Labels by organizationID
 func (r *PostgresLabelRepository) ListLabelsByOrganization(ctx context.Context, organizationID string) ([]*models.Label, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
 	params := ListLabelsByOrganizationParams{
-		OrganizationID: uuid.MustParse(organizationID),
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.ListLabelsByOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).ListLabelsByOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrLabelNotFound
@@ -143,12 +219,20 @@ func (r *PostgresLabelRepository) ListLabelsByOrganization(ctx context.Context,
 
 // GetLabelByName retrieves a single Label by name and organizationID
 func (r *PostgresLabelRepository) GetLabelByName(ctx context.Context, name string, organizationID string) (*models.Label, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, models.ErrLabelNotFound
+	}
 	params := GetLabelByNameParams{
-		Name:           name,
-		OrganizationID: uuid.MustParse(organizationID),
+		TenantID: tenantID,
+		Name:     name,
 	}
 
-	result, err := r.queries.GetLabelByName(ctx, params)
+	result, err := r.queriesFor(ctx).GetLabelByName(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrLabelNotFound
@@ -160,6 +244,15 @@ func (r *PostgresLabelRepository) GetLabelByName(ctx context.Context, name strin
 
 }
 
+// labelColumns maps Label fields to the columns List can filter and sort on.
+var labelColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+}
+
 func mapLabelFromDB(db *Label) *models.Label {
 	if db == nil {
 		return nil
@@ -16,10 +16,16 @@ SELECT
   COUNT(*)
 FROM
   label
+WHERE
+  organization_id = $1
 `
 
-func (q *Queries) CountLabels(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countLabels)
+type CountLabelsParams struct {
+	TenantID uuid.UUID
+}
+
+func (q *Queries) CountLabels(ctx context.Context, arg CountLabelsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countLabels, arg.TenantID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -57,19 +63,24 @@ func (q *Queries) CreateLabel(ctx context.Context, arg CreateLabelParams) (Label
 	return i, err
 }
 
-const deleteLabel = `-- name: DeleteLabel :exec
+const deleteLabel = `-- name: DeleteLabel :execrows
 DELETE FROM label
 WHERE
   id = $1
+  AND organization_id = $2
 `
 
 type DeleteLabelParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
-func (q *Queries) DeleteLabel(ctx context.Context, arg DeleteLabelParams) error {
-	_, err := q.db.Exec(ctx, deleteLabel, arg.ID)
-	return err
+func (q *Queries) DeleteLabel(ctx context.Context, arg DeleteLabelParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLabel, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getLabel = `-- name: GetLabel :one
@@ -79,16 +90,18 @@ FROM
   label
 WHERE
   id = $1
+  AND organization_id = $2
 LIMIT
   1
 `
 
 type GetLabelParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) GetLabel(ctx context.Context, arg GetLabelParams) (Label, error) {
-	row := q.db.QueryRow(ctx, getLabel, arg.ID)
+	row := q.db.QueryRow(ctx, getLabel, arg.ID, arg.TenantID)
 	var i Label
 	err := row.Scan(
 		&i.ID,
@@ -113,12 +126,12 @@ LIMIT
 `
 
 type GetLabelByNameParams struct {
-	Name           string
-	OrganizationID uuid.UUID
+	Name     string
+	TenantID uuid.UUID
 }
 
 func (q *Queries) GetLabelByName(ctx context.Context, arg GetLabelByNameParams) (Label, error) {
-	row := q.db.QueryRow(ctx, getLabelByName, arg.Name, arg.OrganizationID)
+	row := q.db.QueryRow(ctx, getLabelByName, arg.Name, arg.TenantID)
 	var i Label
 	err := row.Scan(
 		&i.ID,
@@ -130,50 +143,6 @@ func (q *Queries) GetLabelByName(ctx context.Context, arg GetLabelByNameParams)
 	return i, err
 }
 
-const listLabels = `-- name: ListLabels :many
-SELECT
-  id, created_at, updated_at, name, organization_id
-FROM
-  label
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListLabelsParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListLabels(ctx context.Context, arg ListLabelsParams) ([]Label, error) {
-	rows, err := q.db.Query(ctx, listLabels, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Label
-	for rows.Next() {
-		var i Label
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.Name,
-			&i.OrganizationID,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listLabelsByOrganization = `-- name: ListLabelsByOrganization :many
 SELECT
   id, created_at, updated_at, name, organization_id
@@ -186,11 +155,11 @@ ORDER BY
 `
 
 type ListLabelsByOrganizationParams struct {
-	OrganizationID uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) ListLabelsByOrganization(ctx context.Context, arg ListLabelsByOrganizationParams) ([]Label, error) {
-	rows, err := q.db.Query(ctx, listLabelsByOrganization, arg.OrganizationID)
+	rows, err := q.db.Query(ctx, listLabelsByOrganization, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -218,22 +187,22 @@ func (q *Queries) ListLabelsByOrganization(ctx context.Context, arg ListLabelsBy
 const updateLabel = `-- name: UpdateLabel :one
 UPDATE label
 SET
-  name = COALESCE($1, name),
-  organization_id = COALESCE($2, organization_id)
+  name = COALESCE($1, name)
 WHERE
-  id = $3
+  id = $2
+  AND organization_id = $3
 RETURNING
   id, created_at, updated_at, name, organization_id
 `
 
 type UpdateLabelParams struct {
-	Name           *string
-	OrganizationID *uuid.UUID
-	ID             uuid.UUID
+	Name     *string
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) UpdateLabel(ctx context.Context, arg UpdateLabelParams) (Label, error) {
-	row := q.db.QueryRow(ctx, updateLabel, arg.Name, arg.OrganizationID, arg.ID)
+	row := q.db.QueryRow(ctx, updateLabel, arg.Name, arg.ID, arg.TenantID)
 	var i Label
 	err := row.Scan(
 		&i.ID,
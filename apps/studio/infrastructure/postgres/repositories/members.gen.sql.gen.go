@@ -7,6 +7,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -16,6 +17,8 @@ SELECT
   COUNT(*)
 FROM
   member
+WHERE
+  deleted_at IS NULL
 `
 
 func (q *Queries) CountMembers(ctx context.Context) (int64, error) {
@@ -27,22 +30,24 @@ func (q *Queries) CountMembers(ctx context.Context) (int64, error) {
 
 const createMember = `-- name: CreateMember :one
 INSERT INTO
-  member (id, organization_id, role, user_id)
+  member (id, organization_id, role, role_id, user_id)
 VALUES
   (
     $1,
     $2,
     $3,
-    $4
+    $4,
+    $5
   )
 RETURNING
-  id, created_at, updated_at, organization_id, role, user_id
+  id, created_at, updated_at, organization_id, role, user_id, role_id, deleted_at
 `
 
 type CreateMemberParams struct {
 	ID             uuid.UUID
 	OrganizationID uuid.UUID
 	Role           string
+	RoleID         *uuid.UUID
 	UserID         uuid.UUID
 }
 
@@ -51,6 +56,7 @@ func (q *Queries) CreateMember(ctx context.Context, arg CreateMemberParams) (Mem
 		arg.ID,
 		arg.OrganizationID,
 		arg.Role,
+		arg.RoleID,
 		arg.UserID,
 	)
 	var i Member
@@ -61,32 +67,41 @@ func (q *Queries) CreateMember(ctx context.Context, arg CreateMemberParams) (Mem
 		&i.OrganizationID,
 		&i.Role,
 		&i.UserID,
+		&i.RoleID,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteMember = `-- name: DeleteMember :exec
-DELETE FROM member
+const deleteMember = `-- name: DeleteMember :execrows
+UPDATE member
+SET
+  deleted_at = CURRENT_TIMESTAMP
 WHERE
   id = $1
+  AND deleted_at IS NULL
 `
 
 type DeleteMemberParams struct {
 	ID uuid.UUID
 }
 
-func (q *Queries) DeleteMember(ctx context.Context, arg DeleteMemberParams) error {
-	_, err := q.db.Exec(ctx, deleteMember, arg.ID)
-	return err
+func (q *Queries) DeleteMember(ctx context.Context, arg DeleteMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMember, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getMember = `-- name: GetMember :one
 SELECT
-  id, created_at, updated_at, organization_id, role, user_id
+  id, created_at, updated_at, organization_id, role, user_id, role_id, deleted_at
 FROM
   member
 WHERE
   id = $1
+  AND deleted_at IS NULL
 LIMIT
   1
 `
@@ -105,18 +120,21 @@ func (q *Queries) GetMember(ctx context.Context, arg GetMemberParams) (Member, e
 		&i.OrganizationID,
 		&i.Role,
 		&i.UserID,
+		&i.RoleID,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getMemberByUserAndOrganization = `-- name: GetMemberByUserAndOrganization :one
 SELECT
-  id, created_at, updated_at, organization_id, role, user_id
+  id, created_at, updated_at, organization_id, role, user_id, role_id, deleted_at
 FROM
   member
 WHERE
   user_id = $1 AND
-  organization_id = $2
+  organization_id = $2 AND
+  deleted_at IS NULL
 LIMIT
   1
 `
@@ -136,62 +154,20 @@ func (q *Queries) GetMemberByUserAndOrganization(ctx context.Context, arg GetMem
 		&i.OrganizationID,
 		&i.Role,
 		&i.UserID,
+		&i.RoleID,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const listMembers = `-- name: ListMembers :many
-SELECT
-  id, created_at, updated_at, organization_id, role, user_id
-FROM
-  member
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListMembersParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListMembers(ctx context.Context, arg ListMembersParams) ([]Member, error) {
-	rows, err := q.db.Query(ctx, listMembers, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Member
-	for rows.Next() {
-		var i Member
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.OrganizationID,
-			&i.Role,
-			&i.UserID,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listMembersByOrganization = `-- name: ListMembersByOrganization :many
 SELECT
-  id, created_at, updated_at, organization_id, role, user_id
+  id, created_at, updated_at, organization_id, role, user_id, role_id, deleted_at
 FROM
   member
 WHERE
-  organization_id = $1
+  organization_id = $1 AND
+  deleted_at IS NULL
 ORDER BY
   created_at DESC
 `
@@ -216,6 +192,8 @@ func (q *Queries) ListMembersByOrganization(ctx context.Context, arg ListMembers
 			&i.OrganizationID,
 			&i.Role,
 			&i.UserID,
+			&i.RoleID,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -229,11 +207,12 @@ func (q *Queries) ListMembersByOrganization(ctx context.Context, arg ListMembers
 
 const listMembersByUser = `-- name: ListMembersByUser :many
 SELECT
-  id, created_at, updated_at, organization_id, role, user_id
+  id, created_at, updated_at, organization_id, role, user_id, role_id, deleted_at
 FROM
   member
 WHERE
-  user_id = $1
+  user_id = $1 AND
+  deleted_at IS NULL
 ORDER BY
   created_at DESC
 `
@@ -258,6 +237,8 @@ func (q *Queries) ListMembersByUser(ctx context.Context, arg ListMembersByUserPa
 			&i.OrganizationID,
 			&i.Role,
 			&i.UserID,
+			&i.RoleID,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -269,21 +250,64 @@ func (q *Queries) ListMembersByUser(ctx context.Context, arg ListMembersByUserPa
 	return items, nil
 }
 
+const purgeMembers = `-- name: PurgeMembers :execrows
+DELETE FROM member
+WHERE
+  deleted_at IS NOT NULL
+  AND deleted_at < $1::timestamptz
+`
+
+type PurgeMembersParams struct {
+	Before time.Time
+}
+
+func (q *Queries) PurgeMembers(ctx context.Context, arg PurgeMembersParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeMembers, arg.Before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreMember = `-- name: RestoreMember :execrows
+UPDATE member
+SET
+  deleted_at = NULL
+WHERE
+  id = $1
+  AND deleted_at IS NOT NULL
+`
+
+type RestoreMemberParams struct {
+	ID uuid.UUID
+}
+
+func (q *Queries) RestoreMember(ctx context.Context, arg RestoreMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreMember, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateMember = `-- name: UpdateMember :one
 UPDATE member
 SET
   organization_id = COALESCE($1, organization_id),
   role = COALESCE($2, role),
-  user_id = COALESCE($3, user_id)
+  role_id = COALESCE($3, role_id),
+  user_id = COALESCE($4, user_id)
 WHERE
-  id = $4
+  id = $5
+  AND deleted_at IS NULL
 RETURNING
-  id, created_at, updated_at, organization_id, role, user_id
+  id, created_at, updated_at, organization_id, role, user_id, role_id, deleted_at
 `
 
 type UpdateMemberParams struct {
 	OrganizationID *uuid.UUID
 	Role           *string
+	RoleID         *uuid.UUID
 	UserID         *uuid.UUID
 	ID             uuid.UUID
 }
@@ -292,6 +316,7 @@ func (q *Queries) UpdateMember(ctx context.Context, arg UpdateMemberParams) (Mem
 	row := q.db.QueryRow(ctx, updateMember,
 		arg.OrganizationID,
 		arg.Role,
+		arg.RoleID,
 		arg.UserID,
 		arg.ID,
 	)
@@ -303,6 +328,8 @@ func (q *Queries) UpdateMember(ctx context.Context, arg UpdateMemberParams) (Mem
 		&i.OrganizationID,
 		&i.Role,
 		&i.UserID,
+		&i.RoleID,
+		&i.DeletedAt,
 	)
 	return i, err
 }
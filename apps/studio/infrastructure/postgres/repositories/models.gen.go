@@ -7,6 +7,7 @@ package repositories
 import (
 	"time"
 
+	pipelinesmodels "github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
 
@@ -53,6 +54,25 @@ type Artifact struct {
 	ProducerID     *uuid.UUID
 	Text           *string
 	URL            *string
+	SearchVector   interface{}
+	DeletedAt      *time.Time
+}
+
+type ArtifactLabel struct {
+	ArtifactID uuid.UUID
+	LabelID    uuid.UUID
+}
+
+type EventOutbox struct {
+	ID             string
+	EventType      string
+	OrganizationID *uuid.UUID
+	Payload        []byte
+	CreatedAt      time.Time
+	PublishedAt    *time.Time
+	Attempts       int32
+	LastError      *string
+	DeadLetteredAt *time.Time
 }
 
 type Executor struct {
@@ -103,6 +123,8 @@ type Member struct {
 	OrganizationID uuid.UUID
 	Role           string
 	UserID         uuid.UUID
+	RoleID         *uuid.UUID
+	DeletedAt      *time.Time
 }
 
 type Organization struct {
@@ -116,6 +138,7 @@ type Organization struct {
 	Plan                     string
 	Slug                     string
 	StripeCustomerIdentifier string
+	DeletedAt                *time.Time
 }
 
 type Pipeline struct {
@@ -125,6 +148,7 @@ type Pipeline struct {
 	Description    *string
 	Name           *string
 	OrganizationID uuid.UUID
+	SearchVector   interface{}
 }
 
 type PipelineStep struct {
@@ -133,6 +157,17 @@ type PipelineStep struct {
 	UpdatedAt  time.Time
 	PipelineID uuid.UUID
 	ToolID     uuid.UUID
+	Config     *pipelinesmodels.StepConfig
+}
+
+type Role struct {
+	ID             uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Description    *string
+	Name           string
+	OrganizationID uuid.UUID
+	Permissions    []string
 }
 
 type Run struct {
@@ -183,3 +218,34 @@ type User struct {
 	Image         *string
 	Name          string
 }
+
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Attempts       int32
+	EndpointID     uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	LastAttemptAt  *time.Time
+	LastError      *string
+	NextAttemptAt  *time.Time
+	OrganizationID uuid.UUID
+	Payload        string
+	ResponseStatus *int32
+	Status         string
+}
+
+type WebhookEndpoint struct {
+	ID             uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Description    *string
+	DisabledAt     *time.Time
+	Enabled        bool
+	EventTypes     []string
+	FailureCount   int32
+	OrganizationID uuid.UUID
+	Secret         string
+	URL            string
+}
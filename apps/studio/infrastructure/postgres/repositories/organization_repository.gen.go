@@ -7,25 +7,40 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresOrganizationRepository implements OrganizationRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresOrganizationRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresOrganizationRepository creates a new PostgreSQL repository.
 func NewPostgresOrganizationRepository(db *pgxpool.Pool) *PostgresOrganizationRepository {
 	return &PostgresOrganizationRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresOrganizationRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Organization operations
 
 // Create creates a new organization
@@ -41,10 +56,20 @@ func (r *PostgresOrganizationRepository) Create(ctx context.Context, entity *mod
 		StripeCustomerIdentifier: entity.StripeCustomerIdentifier,
 	}
 
-	result, err := r.queries.CreateOrganization(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create organization: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewOrganizationCreatedEvent(entity.ID))
+
+	var result Organization
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateOrganization(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create organization: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapOrganizationFromDB(&result), nil
 }
@@ -55,7 +80,7 @@ func (r *PostgresOrganizationRepository) Get(ctx context.Context, id uuid.UUID)
 		ID: id,
 	}
 
-	result, err := r.queries.GetOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).GetOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrOrganizationNotFound
@@ -79,43 +104,104 @@ func (r *PostgresOrganizationRepository) Update(ctx context.Context, id uuid.UUI
 		Plan:         &planStr,
 	}
 
-	result, err := r.queries.UpdateOrganization(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrOrganizationNotFound
+	pending := events.Ensure(entity.Events(), models.NewOrganizationUpdatedEvent(id))
+
+	var result Organization
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateOrganization(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrOrganizationNotFound
+			}
+			return fmt.Errorf("failed to update organization: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update organization: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapOrganizationFromDB(&result), nil
 }
 
-// Delete removes a organization
+// Delete marks a organization as deleted
 func (r *PostgresOrganizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	params := DeleteOrganizationParams{
 		ID: id,
 	}
 
-	err := r.queries.DeleteOrganization(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteOrganization(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete organization: %w", err)
+		}
+		if n == 0 {
+			return models.ErrOrganizationNotFound
+		}
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewOrganizationDeletedEvent(id)})
+	})
+}
+
+// Restore clears the deletion mark of a deleted organization
+func (r *PostgresOrganizationRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	params := RestoreOrganizationParams{
+		ID: id,
+	}
+
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).RestoreOrganization(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to restore organization: %w", err)
+		}
+		if n == 0 {
 			return models.ErrOrganizationNotFound
 		}
-		return fmt.Errorf("failed to delete organization: %w", err)
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewOrganizationUpdatedEvent(id)})
+	})
+}
+
+// List returns a filtered, sorted and paginated list of organizations that are not deleted
+func (r *PostgresOrganizationRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error) {
+	opts.Deleted = database.DeletedExclude
+	return r.list(ctx, opts)
+}
+
+// ListDeleted returns a filtered, sorted and paginated list of deleted organizations
+func (r *PostgresOrganizationRepository) ListDeleted(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error) {
+	opts.Deleted = database.DeletedOnly
+	return r.list(ctx, opts)
+}
+
+// Purge removes the organizations deleted before the given time, in all organizations.
+// Purged rows cannot be restored and record no events.
+func (r *PostgresOrganizationRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	n, err := r.queriesFor(ctx).PurgeOrganizations(ctx, PurgeOrganizationsParams{Before: before})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge organizations: %w", err)
 	}
-	return nil
+	return n, nil
 }
 
-// List returns a paginated list of organizations
-func (r *PostgresOrganizationRepository) List(ctx context.Context, limit, offset int32) ([]*models.Organization, int64, error) {
-	listParams := ListOrganizationsParams{
-		Limit:  limit,
-		Offset: offset,
+func (r *PostgresOrganizationRepository) list(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error) {
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "organization", organizationColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListOrganizations(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list organizations: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Organization])
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count organizations: %w", err)
 	}
 
 	items := make([]*models.Organization, len(results))
@@ -123,11 +209,7 @@ func (r *PostgresOrganizationRepository) List(ctx context.Context, limit, offset
 		items[i] = mapOrganizationFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // GetOrganizationBySlug retrieves a single Organization by slug
@@ -136,7 +218,7 @@ func (r *PostgresOrganizationRepository) GetOrganizationBySlug(ctx context.Conte
 		Slug: slug,
 	}
 
-	result, err := r.queries.GetOrganizationBySlug(ctx, params)
+	result, err := r.queriesFor(ctx).GetOrganizationBySlug(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrOrganizationNotFound
@@ -154,7 +236,7 @@ func (r *PostgresOrganizationRepository) GetOrganizationByStripeCustomerID(ctx c
 		StripeCustomerIdentifier: stripeCustomerIdentifier,
 	}
 
-	result, err := r.queries.GetOrganizationByStripeCustomerID(ctx, params)
+	result, err := r.queriesFor(ctx).GetOrganizationByStripeCustomerID(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrOrganizationNotFound
@@ -166,6 +248,20 @@ func (r *PostgresOrganizationRepository) GetOrganizationByStripeCustomerID(ctx c
 
 }
 
+// organizationColumns maps Organization fields to the columns List can filter and sort on.
+var organizationColumns = database.Columns{
+	"id":                       {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":                {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":                {Name: "updated_at", Kind: database.ColumnTime},
+	"billingEmail":             {Name: "billing_email", Kind: database.ColumnString},
+	"credits":                  {Name: "credits", Kind: database.ColumnInteger},
+	"logo":                     {Name: "logo", Kind: database.ColumnString},
+	"name":                     {Name: "name", Kind: database.ColumnString},
+	"plan":                     {Name: "plan", Kind: database.ColumnString},
+	"slug":                     {Name: "slug", Kind: database.ColumnString},
+	"stripeCustomerIdentifier": {Name: "stripe_customer_identifier", Kind: database.ColumnString},
+}
+
 func mapOrganizationFromDB(db *Organization) *models.Organization {
 	if db == nil {
 		return nil
@@ -7,6 +7,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -16,6 +17,8 @@ SELECT
   COUNT(*)
 FROM
   organization
+WHERE
+  deleted_at IS NULL
 `
 
 func (q *Queries) CountOrganizations(ctx context.Context) (int64, error) {
@@ -40,7 +43,7 @@ VALUES
     $8
   )
 RETURNING
-  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier
+  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier, deleted_at
 `
 
 type CreateOrganizationParams struct {
@@ -77,32 +80,40 @@ func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganization
 		&i.Plan,
 		&i.Slug,
 		&i.StripeCustomerIdentifier,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteOrganization = `-- name: DeleteOrganization :exec
-DELETE FROM organization
+const deleteOrganization = `-- name: DeleteOrganization :execrows
+UPDATE organization
+SET
+  deleted_at = CURRENT_TIMESTAMP
 WHERE
   id = $1
+  AND deleted_at IS NULL
 `
 
 type DeleteOrganizationParams struct {
 	ID uuid.UUID
 }
 
-func (q *Queries) DeleteOrganization(ctx context.Context, arg DeleteOrganizationParams) error {
-	_, err := q.db.Exec(ctx, deleteOrganization, arg.ID)
-	return err
+func (q *Queries) DeleteOrganization(ctx context.Context, arg DeleteOrganizationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrganization, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getOrganization = `-- name: GetOrganization :one
 SELECT
-  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier
+  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier, deleted_at
 FROM
   organization
 WHERE
   id = $1
+  AND deleted_at IS NULL
 LIMIT
   1
 `
@@ -125,17 +136,19 @@ func (q *Queries) GetOrganization(ctx context.Context, arg GetOrganizationParams
 		&i.Plan,
 		&i.Slug,
 		&i.StripeCustomerIdentifier,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getOrganizationBySlug = `-- name: GetOrganizationBySlug :one
 SELECT
-  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier
+  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier, deleted_at
 FROM
   organization
 WHERE
-  slug = $1
+  slug = $1 AND
+  deleted_at IS NULL
 LIMIT
   1
 `
@@ -158,17 +171,19 @@ func (q *Queries) GetOrganizationBySlug(ctx context.Context, arg GetOrganization
 		&i.Plan,
 		&i.Slug,
 		&i.StripeCustomerIdentifier,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getOrganizationByStripeCustomerID = `-- name: GetOrganizationByStripeCustomerID :one
 SELECT
-  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier
+  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier, deleted_at
 FROM
   organization
 WHERE
-  stripe_customer_identifier = $1
+  stripe_customer_identifier = $1 AND
+  deleted_at IS NULL
 LIMIT
   1
 `
@@ -191,57 +206,49 @@ func (q *Queries) GetOrganizationByStripeCustomerID(ctx context.Context, arg Get
 		&i.Plan,
 		&i.Slug,
 		&i.StripeCustomerIdentifier,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const listOrganizations = `-- name: ListOrganizations :many
-SELECT
-  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier
-FROM
-  organization
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
+const purgeOrganizations = `-- name: PurgeOrganizations :execrows
+DELETE FROM organization
+WHERE
+  deleted_at IS NOT NULL
+  AND deleted_at < $1::timestamptz
 `
 
-type ListOrganizationsParams struct {
-	Offset int32
-	Limit  int32
+type PurgeOrganizationsParams struct {
+	Before time.Time
 }
 
-func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Organization, error) {
-	rows, err := q.db.Query(ctx, listOrganizations, arg.Offset, arg.Limit)
+func (q *Queries) PurgeOrganizations(ctx context.Context, arg PurgeOrganizationsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeOrganizations, arg.Before)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Organization
-	for rows.Next() {
-		var i Organization
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.BillingEmail,
-			&i.Credits,
-			&i.Logo,
-			&i.Name,
-			&i.Plan,
-			&i.Slug,
-			&i.StripeCustomerIdentifier,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	return result.RowsAffected(), nil
+}
+
+const restoreOrganization = `-- name: RestoreOrganization :execrows
+UPDATE organization
+SET
+  deleted_at = NULL
+WHERE
+  id = $1
+  AND deleted_at IS NOT NULL
+`
+
+type RestoreOrganizationParams struct {
+	ID uuid.UUID
+}
+
+func (q *Queries) RestoreOrganization(ctx context.Context, arg RestoreOrganizationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreOrganization, arg.ID)
+	if err != nil {
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
 const updateOrganization = `-- name: UpdateOrganization :one
@@ -256,8 +263,9 @@ SET
   stripe_customer_identifier = COALESCE($7, stripe_customer_identifier)
 WHERE
   id = $8
+  AND deleted_at IS NULL
 RETURNING
-  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier
+  id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier, deleted_at
 `
 
 type UpdateOrganizationParams struct {
@@ -294,6 +302,7 @@ func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganization
 		&i.Plan,
 		&i.Slug,
 		&i.StripeCustomerIdentifier,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,43 +17,76 @@ import (
 )
 
 // PostgresPipelineRepository implements PipelineRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresPipelineRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresPipelineRepository creates a new PostgreSQL repository.
 func NewPostgresPipelineRepository(db *pgxpool.Pool) *PostgresPipelineRepository {
 	return &PostgresPipelineRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresPipelineRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Pipeline operations
 
 // Create creates a new pipeline
 func (r *PostgresPipelineRepository) Create(ctx context.Context, entity *models.Pipeline) (*models.Pipeline, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := CreatePipelineParams{
 		ID:             entity.ID,
 		Description:    entity.Description,
 		Name:           entity.Name,
 		OrganizationID: entity.OrganizationID,
 	}
+	// The row always belongs to the tenant of the context
+	params.OrganizationID = tenantID
 
-	result, err := r.queries.CreatePipeline(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create pipeline: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewPipelineCreatedEvent(entity.ID))
+
+	var result Pipeline
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreatePipeline(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create pipeline: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapPipelineFromDB(&result), nil
 }
 
 // Get retrieves a pipeline by ID
 func (r *PostgresPipelineRepository) Get(ctx context.Context, id uuid.UUID) (*models.Pipeline, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := GetPipelineParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.GetPipeline(ctx, params)
+	result, err := r.queriesFor(ctx).GetPipeline(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrPipelineNotFound
@@ -64,50 +99,88 @@ func (r *PostgresPipelineRepository) Get(ctx context.Context, id uuid.UUID) (*mo
 
 // Update updates an existing pipeline
 func (r *PostgresPipelineRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Pipeline) (*models.Pipeline, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	params := UpdatePipelineParams{
 		ID:          id,
+		TenantID:    tenantID,
 		Description: entity.Description,
 		Name:        entity.Name,
 	}
 
-	result, err := r.queries.UpdatePipeline(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrPipelineNotFound
+	pending := events.Ensure(entity.Events(), models.NewPipelineUpdatedEvent(id))
+
+	var result Pipeline
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdatePipeline(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrPipelineNotFound
+			}
+			return fmt.Errorf("failed to update pipeline: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update pipeline: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapPipelineFromDB(&result), nil
 }
 
 // Delete removes a pipeline
 func (r *PostgresPipelineRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
 	params := DeletePipelineParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	err := r.queries.DeletePipeline(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeletePipeline(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete pipeline: %w", err)
+		}
+		if n == 0 {
 			return models.ErrPipelineNotFound
 		}
-		return fmt.Errorf("failed to delete pipeline: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewPipelineDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of pipelines
-func (r *PostgresPipelineRepository) List(ctx context.Context, limit, offset int32) ([]*models.Pipeline, int64, error) {
-	listParams := ListPipelinesParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of pipelines
+func (r *PostgresPipelineRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Pipeline, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	opts.SearchIndex = pipelineSearchIndex
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "pipeline", pipelineColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListPipelines(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list pipelines: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelines: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Pipeline])
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelines: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count pipelines: %w", err)
 	}
 
 	items := make([]*models.Pipeline, len(results))
@@ -115,20 +188,35 @@ func (r *PostgresPipelineRepository) List(ctx context.Context, limit, offset int
 		items[i] = mapPipelineFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	info := database.PageInfo{Total: total}
+	if query.SnippetSQL != "" {
+		rows, err := conn.Query(ctx, query.SnippetSQL, query.SnippetArgs...)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to highlight pipelines: %w", err)
+		}
+		defer rows.Close()
+		if info.Snippets, err = database.CollectSnippets(rows); err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to highlight pipelines: %w", err)
+		}
+	}
+	return items, info, nil
 }
 
 // ListPipelinesByOrganization retrieves multiple Pipelines by organizationID
 func (r *PostgresPipelineRepository) ListPipelinesByOrganization(ctx context.Context, organizationID string) ([]*models.Pipeline, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
 	params := ListPipelinesByOrganizationParams{
-		OrganizationID: uuid.MustParse(organizationID),
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.ListPipelinesByOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).ListPipelinesByOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrPipelineNotFound
@@ -143,6 +231,21 @@ func (r *PostgresPipelineRepository) ListPipelinesByOrganization(ctx context.Con
 
 }
 
+// pipelineColumns maps Pipeline fields to the columns List can filter and sort on.
+var pipelineColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"description":    {Name: "description", Kind: database.ColumnString},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+}
+
+// pipelineSearchIndex describes the full-text index List searches.
+var pipelineSearchIndex = &database.SearchIndex{
+	Columns: []string{"name", "description"},
+}
+
 func mapPipelineFromDB(db *Pipeline) *models.Pipeline {
 	if db == nil {
 		return nil
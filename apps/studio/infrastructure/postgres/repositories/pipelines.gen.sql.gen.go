@@ -16,10 +16,16 @@ SELECT
   COUNT(*)
 FROM
   pipeline
+WHERE
+  organization_id = $1
 `
 
-func (q *Queries) CountPipelines(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countPipelines)
+type CountPipelinesParams struct {
+	TenantID uuid.UUID
+}
+
+func (q *Queries) CountPipelines(ctx context.Context, arg CountPipelinesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPipelines, arg.TenantID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -36,7 +42,7 @@ VALUES
     $4
   )
 RETURNING
-  id, created_at, updated_at, description, name, organization_id
+  id, created_at, updated_at, description, name, organization_id, search_vector
 `
 
 type CreatePipelineParams struct {
@@ -61,42 +67,50 @@ func (q *Queries) CreatePipeline(ctx context.Context, arg CreatePipelineParams)
 		&i.Description,
 		&i.Name,
 		&i.OrganizationID,
+		&i.SearchVector,
 	)
 	return i, err
 }
 
-const deletePipeline = `-- name: DeletePipeline :exec
+const deletePipeline = `-- name: DeletePipeline :execrows
 DELETE FROM pipeline
 WHERE
   id = $1
+  AND organization_id = $2
 `
 
 type DeletePipelineParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
-func (q *Queries) DeletePipeline(ctx context.Context, arg DeletePipelineParams) error {
-	_, err := q.db.Exec(ctx, deletePipeline, arg.ID)
-	return err
+func (q *Queries) DeletePipeline(ctx context.Context, arg DeletePipelineParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePipeline, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getPipeline = `-- name: GetPipeline :one
 SELECT
-  id, created_at, updated_at, description, name, organization_id
+  id, created_at, updated_at, description, name, organization_id, search_vector
 FROM
   pipeline
 WHERE
   id = $1
+  AND organization_id = $2
 LIMIT
   1
 `
 
 type GetPipelineParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) GetPipeline(ctx context.Context, arg GetPipelineParams) (Pipeline, error) {
-	row := q.db.QueryRow(ctx, getPipeline, arg.ID)
+	row := q.db.QueryRow(ctx, getPipeline, arg.ID, arg.TenantID)
 	var i Pipeline
 	err := row.Scan(
 		&i.ID,
@@ -105,58 +119,14 @@ func (q *Queries) GetPipeline(ctx context.Context, arg GetPipelineParams) (Pipel
 		&i.Description,
 		&i.Name,
 		&i.OrganizationID,
+		&i.SearchVector,
 	)
 	return i, err
 }
 
-const listPipelines = `-- name: ListPipelines :many
-SELECT
-  id, created_at, updated_at, description, name, organization_id
-FROM
-  pipeline
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListPipelinesParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListPipelines(ctx context.Context, arg ListPipelinesParams) ([]Pipeline, error) {
-	rows, err := q.db.Query(ctx, listPipelines, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Pipeline
-	for rows.Next() {
-		var i Pipeline
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.Description,
-			&i.Name,
-			&i.OrganizationID,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listPipelinesByOrganization = `-- name: ListPipelinesByOrganization :many
 SELECT
-  id, created_at, updated_at, description, name, organization_id
+  id, created_at, updated_at, description, name, organization_id, search_vector
 FROM
   pipeline
 WHERE
@@ -166,11 +136,11 @@ ORDER BY
 `
 
 type ListPipelinesByOrganizationParams struct {
-	OrganizationID uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) ListPipelinesByOrganization(ctx context.Context, arg ListPipelinesByOrganizationParams) ([]Pipeline, error) {
-	rows, err := q.db.Query(ctx, listPipelinesByOrganization, arg.OrganizationID)
+	rows, err := q.db.Query(ctx, listPipelinesByOrganization, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +155,7 @@ func (q *Queries) ListPipelinesByOrganization(ctx context.Context, arg ListPipel
 			&i.Description,
 			&i.Name,
 			&i.OrganizationID,
+			&i.SearchVector,
 		); err != nil {
 			return nil, err
 		}
@@ -200,27 +171,27 @@ const updatePipeline = `-- name: UpdatePipeline :one
 UPDATE pipeline
 SET
   description = COALESCE($1, description),
-  name = COALESCE($2, name),
-  organization_id = COALESCE($3, organization_id)
+  name = COALESCE($2, name)
 WHERE
-  id = $4
+  id = $3
+  AND organization_id = $4
 RETURNING
-  id, created_at, updated_at, description, name, organization_id
+  id, created_at, updated_at, description, name, organization_id, search_vector
 `
 
 type UpdatePipelineParams struct {
-	Description    *string
-	Name           *string
-	OrganizationID *uuid.UUID
-	ID             uuid.UUID
+	Description *string
+	Name        *string
+	ID          uuid.UUID
+	TenantID    uuid.UUID
 }
 
 func (q *Queries) UpdatePipeline(ctx context.Context, arg UpdatePipelineParams) (Pipeline, error) {
 	row := q.db.QueryRow(ctx, updatePipeline,
 		arg.Description,
 		arg.Name,
-		arg.OrganizationID,
 		arg.ID,
+		arg.TenantID,
 	)
 	var i Pipeline
 	err := row.Scan(
@@ -230,6 +201,7 @@ func (q *Queries) UpdatePipeline(ctx context.Context, arg UpdatePipelineParams)
 		&i.Description,
 		&i.Name,
 		&i.OrganizationID,
+		&i.SearchVector,
 	)
 	return i, err
 }
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,31 +17,54 @@ import (
 )
 
 // PostgresPipelineStepRepository implements PipelineStepRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresPipelineStepRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresPipelineStepRepository creates a new PostgreSQL repository.
 func NewPostgresPipelineStepRepository(db *pgxpool.Pool) *PostgresPipelineStepRepository {
 	return &PostgresPipelineStepRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresPipelineStepRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // PipelineStep operations
 
 // Create creates a new pipelinestep
 func (r *PostgresPipelineStepRepository) Create(ctx context.Context, entity *models.PipelineStep) (*models.PipelineStep, error) {
 	params := CreatePipelineStepParams{
 		ID:         entity.ID,
+		Config:     entity.Config,
 		PipelineID: entity.PipelineID,
 		ToolID:     entity.ToolID,
 	}
 
-	result, err := r.queries.CreatePipelineStep(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create pipelinestep: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewPipelineStepCreatedEvent(entity.ID))
+
+	var result PipelineStep
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreatePipelineStep(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create pipelinestep: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapPipelineStepFromDB(&result), nil
 }
@@ -50,7 +75,7 @@ func (r *PostgresPipelineStepRepository) Get(ctx context.Context, id uuid.UUID)
 		ID: id,
 	}
 
-	result, err := r.queries.GetPipelineStep(ctx, params)
+	result, err := r.queriesFor(ctx).GetPipelineStep(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrPipelineStepNotFound
@@ -66,16 +91,27 @@ func (r *PostgresPipelineStepRepository) Update(ctx context.Context, id uuid.UUI
 
 	params := UpdatePipelineStepParams{
 		ID:     id,
+		Config: entity.Config,
 		ToolID: &entity.ToolID,
 	}
 
-	result, err := r.queries.UpdatePipelineStep(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrPipelineStepNotFound
+	pending := events.Ensure(entity.Events(), models.NewPipelineStepUpdatedEvent(id))
+
+	var result PipelineStep
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdatePipelineStep(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrPipelineStepNotFound
+			}
+			return fmt.Errorf("failed to update pipelinestep: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update pipelinestep: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapPipelineStepFromDB(&result), nil
 }
@@ -86,26 +122,38 @@ func (r *PostgresPipelineStepRepository) Delete(ctx context.Context, id uuid.UUI
 		ID: id,
 	}
 
-	err := r.queries.DeletePipelineStep(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeletePipelineStep(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete pipelinestep: %w", err)
+		}
+		if n == 0 {
 			return models.ErrPipelineStepNotFound
 		}
-		return fmt.Errorf("failed to delete pipelinestep: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewPipelineStepDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of pipelinesteps
-func (r *PostgresPipelineStepRepository) List(ctx context.Context, limit, offset int32) ([]*models.PipelineStep, int64, error) {
-	listParams := ListPipelineStepsParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of pipelinesteps
+func (r *PostgresPipelineStepRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.PipelineStep, database.PageInfo, error) {
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "pipeline_step", pipelineStepColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListPipelineSteps(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list pipelinesteps: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelinesteps: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[PipelineStep])
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelinesteps: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count pipelinesteps: %w", err)
 	}
 
 	items := make([]*models.PipelineStep, len(results))
@@ -113,11 +161,16 @@ func (r *PostgresPipelineStepRepository) List(ctx context.Context, limit, offset
 		items[i] = mapPipelineStepFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
+	return items, database.PageInfo{Total: total}, nil
+}
 
-	return items, count, nil
+// pipelineStepColumns maps PipelineStep fields to the columns List can filter and sort on.
+var pipelineStepColumns = database.Columns{
+	"id":         {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":  {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":  {Name: "updated_at", Kind: database.ColumnTime},
+	"pipelineID": {Name: "pipeline_id", Kind: database.ColumnUUID},
+	"toolID":     {Name: "tool_id", Kind: database.ColumnUUID},
 }
 
 func mapPipelineStepFromDB(db *PipelineStep) *models.PipelineStep {
@@ -129,6 +182,7 @@ func mapPipelineStepFromDB(db *PipelineStep) *models.PipelineStep {
 		ID:         db.ID,
 		CreatedAt:  db.CreatedAt,
 		UpdatedAt:  db.UpdatedAt,
+		Config:     db.Config,
 		PipelineID: db.PipelineID,
 		ToolID:     db.ToolID,
 	}
@@ -8,6 +8,7 @@ package repositories
 import (
 	"context"
 
+	pipelinesmodels "github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
 
@@ -27,25 +28,32 @@ func (q *Queries) CountPipelineSteps(ctx context.Context) (int64, error) {
 
 const createPipelineStep = `-- name: CreatePipelineStep :one
 INSERT INTO
-  pipeline_step (id, pipeline_id, tool_id)
+  pipeline_step (id, config, pipeline_id, tool_id)
 VALUES
   (
     $1,
     $2,
-    $3
+    $3,
+    $4
   )
 RETURNING
-  id, created_at, updated_at, pipeline_id, tool_id
+  id, created_at, updated_at, pipeline_id, tool_id, config
 `
 
 type CreatePipelineStepParams struct {
 	ID         uuid.UUID
+	Config     *pipelinesmodels.StepConfig
 	PipelineID uuid.UUID
 	ToolID     uuid.UUID
 }
 
 func (q *Queries) CreatePipelineStep(ctx context.Context, arg CreatePipelineStepParams) (PipelineStep, error) {
-	row := q.db.QueryRow(ctx, createPipelineStep, arg.ID, arg.PipelineID, arg.ToolID)
+	row := q.db.QueryRow(ctx, createPipelineStep,
+		arg.ID,
+		arg.Config,
+		arg.PipelineID,
+		arg.ToolID,
+	)
 	var i PipelineStep
 	err := row.Scan(
 		&i.ID,
@@ -53,11 +61,12 @@ func (q *Queries) CreatePipelineStep(ctx context.Context, arg CreatePipelineStep
 		&i.UpdatedAt,
 		&i.PipelineID,
 		&i.ToolID,
+		&i.Config,
 	)
 	return i, err
 }
 
-const deletePipelineStep = `-- name: DeletePipelineStep :exec
+const deletePipelineStep = `-- name: DeletePipelineStep :execrows
 DELETE FROM pipeline_step
 WHERE
   id = $1
@@ -67,14 +76,17 @@ type DeletePipelineStepParams struct {
 	ID uuid.UUID
 }
 
-func (q *Queries) DeletePipelineStep(ctx context.Context, arg DeletePipelineStepParams) error {
-	_, err := q.db.Exec(ctx, deletePipelineStep, arg.ID)
-	return err
+func (q *Queries) DeletePipelineStep(ctx context.Context, arg DeletePipelineStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePipelineStep, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getPipelineStep = `-- name: GetPipelineStep :one
 SELECT
-  id, created_at, updated_at, pipeline_id, tool_id
+  id, created_at, updated_at, pipeline_id, tool_id, config
 FROM
   pipeline_step
 WHERE
@@ -96,73 +108,37 @@ func (q *Queries) GetPipelineStep(ctx context.Context, arg GetPipelineStepParams
 		&i.UpdatedAt,
 		&i.PipelineID,
 		&i.ToolID,
+		&i.Config,
 	)
 	return i, err
 }
 
-const listPipelineSteps = `-- name: ListPipelineSteps :many
-SELECT
-  id, created_at, updated_at, pipeline_id, tool_id
-FROM
-  pipeline_step
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListPipelineStepsParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListPipelineSteps(ctx context.Context, arg ListPipelineStepsParams) ([]PipelineStep, error) {
-	rows, err := q.db.Query(ctx, listPipelineSteps, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []PipelineStep
-	for rows.Next() {
-		var i PipelineStep
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.PipelineID,
-			&i.ToolID,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const updatePipelineStep = `-- name: UpdatePipelineStep :one
 UPDATE pipeline_step
 SET
-  pipeline_id = COALESCE($1, pipeline_id),
-  tool_id = COALESCE($2, tool_id)
+  config = COALESCE($1, config),
+  pipeline_id = COALESCE($2, pipeline_id),
+  tool_id = COALESCE($3, tool_id)
 WHERE
-  id = $3
+  id = $4
 RETURNING
-  id, created_at, updated_at, pipeline_id, tool_id
+  id, created_at, updated_at, pipeline_id, tool_id, config
 `
 
 type UpdatePipelineStepParams struct {
+	Config     *pipelinesmodels.StepConfig
 	PipelineID *uuid.UUID
 	ToolID     *uuid.UUID
 	ID         uuid.UUID
 }
 
 func (q *Queries) UpdatePipelineStep(ctx context.Context, arg UpdatePipelineStepParams) (PipelineStep, error) {
-	row := q.db.QueryRow(ctx, updatePipelineStep, arg.PipelineID, arg.ToolID, arg.ID)
+	row := q.db.QueryRow(ctx, updatePipelineStep,
+		arg.Config,
+		arg.PipelineID,
+		arg.ToolID,
+		arg.ID,
+	)
 	var i PipelineStep
 	err := row.Scan(
 		&i.ID,
@@ -170,6 +146,7 @@ func (q *Queries) UpdatePipelineStep(ctx context.Context, arg UpdatePipelineStep
 		&i.UpdatedAt,
 		&i.PipelineID,
 		&i.ToolID,
+		&i.Config,
 	)
 	return i, err
 }
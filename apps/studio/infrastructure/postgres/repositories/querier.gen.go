@@ -9,20 +9,24 @@ import (
 )
 
 type Querier interface {
-	CountAPIKeys(ctx context.Context) (int64, error)
+	AddArtifactLabel(ctx context.Context, arg AddArtifactLabelParams) (int64, error)
+	CountAPIKeys(ctx context.Context, arg CountAPIKeysParams) (int64, error)
 	CountAccounts(ctx context.Context) (int64, error)
-	CountArtifacts(ctx context.Context) (int64, error)
-	CountExecutors(ctx context.Context) (int64, error)
+	CountArtifacts(ctx context.Context, arg CountArtifactsParams) (int64, error)
+	CountExecutors(ctx context.Context, arg CountExecutorsParams) (int64, error)
 	CountInvitations(ctx context.Context) (int64, error)
-	CountLabels(ctx context.Context) (int64, error)
+	CountLabels(ctx context.Context, arg CountLabelsParams) (int64, error)
 	CountMembers(ctx context.Context) (int64, error)
 	CountOrganizations(ctx context.Context) (int64, error)
 	CountPipelineSteps(ctx context.Context) (int64, error)
-	CountPipelines(ctx context.Context) (int64, error)
-	CountRuns(ctx context.Context) (int64, error)
+	CountPipelines(ctx context.Context, arg CountPipelinesParams) (int64, error)
+	CountRoles(ctx context.Context, arg CountRolesParams) (int64, error)
+	CountRuns(ctx context.Context, arg CountRunsParams) (int64, error)
 	CountSessions(ctx context.Context) (int64, error)
-	CountTools(ctx context.Context) (int64, error)
+	CountTools(ctx context.Context, arg CountToolsParams) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
+	CountWebhookDeliverys(ctx context.Context, arg CountWebhookDeliverysParams) (int64, error)
+	CountWebhookEndpoints(ctx context.Context, arg CountWebhookEndpointsParams) (int64, error)
 	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (APIKey, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
 	CreateArtifact(ctx context.Context, arg CreateArtifactParams) (Artifact, error)
@@ -33,24 +37,30 @@ type Querier interface {
 	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error)
 	CreatePipeline(ctx context.Context, arg CreatePipelineParams) (Pipeline, error)
 	CreatePipelineStep(ctx context.Context, arg CreatePipelineStepParams) (PipelineStep, error)
+	CreateRole(ctx context.Context, arg CreateRoleParams) (Role, error)
 	CreateRun(ctx context.Context, arg CreateRunParams) (Run, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTool(ctx context.Context, arg CreateToolParams) (Tool, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeleteAPIKey(ctx context.Context, arg DeleteAPIKeyParams) error
-	DeleteAccount(ctx context.Context, arg DeleteAccountParams) error
-	DeleteArtifact(ctx context.Context, arg DeleteArtifactParams) error
-	DeleteExecutor(ctx context.Context, arg DeleteExecutorParams) error
-	DeleteInvitation(ctx context.Context, arg DeleteInvitationParams) error
-	DeleteLabel(ctx context.Context, arg DeleteLabelParams) error
-	DeleteMember(ctx context.Context, arg DeleteMemberParams) error
-	DeleteOrganization(ctx context.Context, arg DeleteOrganizationParams) error
-	DeletePipeline(ctx context.Context, arg DeletePipelineParams) error
-	DeletePipelineStep(ctx context.Context, arg DeletePipelineStepParams) error
-	DeleteRun(ctx context.Context, arg DeleteRunParams) error
-	DeleteSession(ctx context.Context, arg DeleteSessionParams) error
-	DeleteTool(ctx context.Context, arg DeleteToolParams) error
-	DeleteUser(ctx context.Context, arg DeleteUserParams) error
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error)
+	DeleteAPIKey(ctx context.Context, arg DeleteAPIKeyParams) (int64, error)
+	DeleteAccount(ctx context.Context, arg DeleteAccountParams) (int64, error)
+	DeleteArtifact(ctx context.Context, arg DeleteArtifactParams) (int64, error)
+	DeleteExecutor(ctx context.Context, arg DeleteExecutorParams) (int64, error)
+	DeleteInvitation(ctx context.Context, arg DeleteInvitationParams) (int64, error)
+	DeleteLabel(ctx context.Context, arg DeleteLabelParams) (int64, error)
+	DeleteMember(ctx context.Context, arg DeleteMemberParams) (int64, error)
+	DeleteOrganization(ctx context.Context, arg DeleteOrganizationParams) (int64, error)
+	DeletePipeline(ctx context.Context, arg DeletePipelineParams) (int64, error)
+	DeletePipelineStep(ctx context.Context, arg DeletePipelineStepParams) (int64, error)
+	DeleteRole(ctx context.Context, arg DeleteRoleParams) (int64, error)
+	DeleteRun(ctx context.Context, arg DeleteRunParams) (int64, error)
+	DeleteSession(ctx context.Context, arg DeleteSessionParams) (int64, error)
+	DeleteTool(ctx context.Context, arg DeleteToolParams) (int64, error)
+	DeleteUser(ctx context.Context, arg DeleteUserParams) (int64, error)
+	DeleteWebhookDelivery(ctx context.Context, arg DeleteWebhookDeliveryParams) (int64, error)
+	DeleteWebhookEndpoint(ctx context.Context, arg DeleteWebhookEndpointParams) (int64, error)
 	GetAPIKey(ctx context.Context, arg GetAPIKeyParams) (APIKey, error)
 	GetAccount(ctx context.Context, arg GetAccountParams) (Account, error)
 	GetAccountByProvider(ctx context.Context, arg GetAccountByProviderParams) (Account, error)
@@ -67,40 +77,37 @@ type Querier interface {
 	GetOrganizationByStripeCustomerID(ctx context.Context, arg GetOrganizationByStripeCustomerIDParams) (Organization, error)
 	GetPipeline(ctx context.Context, arg GetPipelineParams) (Pipeline, error)
 	GetPipelineStep(ctx context.Context, arg GetPipelineStepParams) (PipelineStep, error)
+	GetRole(ctx context.Context, arg GetRoleParams) (Role, error)
 	GetRun(ctx context.Context, arg GetRunParams) (Run, error)
 	GetSession(ctx context.Context, arg GetSessionParams) (Session, error)
 	GetTool(ctx context.Context, arg GetToolParams) (Tool, error)
 	GetUser(ctx context.Context, arg GetUserParams) (User, error)
 	GetUserByEmail(ctx context.Context, arg GetUserByEmailParams) (User, error)
 	GetUserBySessionID(ctx context.Context, arg GetUserBySessionIDParams) (User, error)
-	ListAPIKeys(ctx context.Context, arg ListAPIKeysParams) ([]APIKey, error)
-	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error)
+	GetWebhookEndpoint(ctx context.Context, arg GetWebhookEndpointParams) (WebhookEndpoint, error)
 	ListAccountsByUserID(ctx context.Context, arg ListAccountsByUserIDParams) ([]Account, error)
-	ListArtifacts(ctx context.Context, arg ListArtifactsParams) ([]Artifact, error)
+	ListArtifactLabels(ctx context.Context, arg ListArtifactLabelsParams) ([]Label, error)
 	ListArtifactsByOrganization(ctx context.Context, arg ListArtifactsByOrganizationParams) ([]Artifact, error)
 	ListArtifactsByProducer(ctx context.Context, arg ListArtifactsByProducerParams) ([]Artifact, error)
-	ListExecutors(ctx context.Context, arg ListExecutorsParams) ([]Executor, error)
 	ListExecutorsByOrganization(ctx context.Context, arg ListExecutorsByOrganizationParams) ([]Executor, error)
-	ListInvitations(ctx context.Context, arg ListInvitationsParams) ([]Invitation, error)
 	ListInvitationsByInviter(ctx context.Context, arg ListInvitationsByInviterParams) ([]Invitation, error)
 	ListInvitationsByOrganization(ctx context.Context, arg ListInvitationsByOrganizationParams) ([]Invitation, error)
-	ListLabels(ctx context.Context, arg ListLabelsParams) ([]Label, error)
 	ListLabelsByOrganization(ctx context.Context, arg ListLabelsByOrganizationParams) ([]Label, error)
-	ListMembers(ctx context.Context, arg ListMembersParams) ([]Member, error)
 	ListMembersByOrganization(ctx context.Context, arg ListMembersByOrganizationParams) ([]Member, error)
 	ListMembersByUser(ctx context.Context, arg ListMembersByUserParams) ([]Member, error)
-	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Organization, error)
-	ListPipelineSteps(ctx context.Context, arg ListPipelineStepsParams) ([]PipelineStep, error)
-	ListPipelines(ctx context.Context, arg ListPipelinesParams) ([]Pipeline, error)
 	ListPipelinesByOrganization(ctx context.Context, arg ListPipelinesByOrganizationParams) ([]Pipeline, error)
-	ListRuns(ctx context.Context, arg ListRunsParams) ([]Run, error)
 	ListRunsByOrganization(ctx context.Context, arg ListRunsByOrganizationParams) ([]Run, error)
 	ListRunsByPipeline(ctx context.Context, arg ListRunsByPipelineParams) ([]Run, error)
 	ListRunsByTool(ctx context.Context, arg ListRunsByToolParams) ([]Run, error)
-	ListSessions(ctx context.Context, arg ListSessionsParams) ([]Session, error)
-	ListTools(ctx context.Context, arg ListToolsParams) ([]Tool, error)
 	ListToolsByOrganization(ctx context.Context, arg ListToolsByOrganizationParams) ([]Tool, error)
-	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	PurgeArtifacts(ctx context.Context, arg PurgeArtifactsParams) (int64, error)
+	PurgeMembers(ctx context.Context, arg PurgeMembersParams) (int64, error)
+	PurgeOrganizations(ctx context.Context, arg PurgeOrganizationsParams) (int64, error)
+	RemoveArtifactLabel(ctx context.Context, arg RemoveArtifactLabelParams) error
+	RestoreArtifact(ctx context.Context, arg RestoreArtifactParams) (int64, error)
+	RestoreMember(ctx context.Context, arg RestoreMemberParams) (int64, error)
+	RestoreOrganization(ctx context.Context, arg RestoreOrganizationParams) (int64, error)
 	UpdateAPIKey(ctx context.Context, arg UpdateAPIKeyParams) (APIKey, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
 	UpdateArtifact(ctx context.Context, arg UpdateArtifactParams) (Artifact, error)
@@ -111,10 +118,13 @@ type Querier interface {
 	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organization, error)
 	UpdatePipeline(ctx context.Context, arg UpdatePipelineParams) (Pipeline, error)
 	UpdatePipelineStep(ctx context.Context, arg UpdatePipelineStepParams) (PipelineStep, error)
+	UpdateRole(ctx context.Context, arg UpdateRoleParams) (Role, error)
 	UpdateRun(ctx context.Context, arg UpdateRunParams) (Run, error)
 	UpdateSession(ctx context.Context, arg UpdateSessionParams) (Session, error)
 	UpdateTool(ctx context.Context, arg UpdateToolParams) (Tool, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateWebhookDelivery(ctx context.Context, arg UpdateWebhookDeliveryParams) (WebhookDelivery, error)
+	UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,21 +17,37 @@ import (
 )
 
 // PostgresRunRepository implements RunRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresRunRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresRunRepository creates a new PostgreSQL repository.
 func NewPostgresRunRepository(db *pgxpool.Pool) *PostgresRunRepository {
 	return &PostgresRunRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresRunRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Run operations
 
 // Create creates a new run
 func (r *PostgresRunRepository) Create(ctx context.Context, entity *models.Run) (*models.Run, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := CreateRunParams{
 		ID:             entity.ID,
 		OrganizationID: entity.OrganizationID,
@@ -38,22 +56,39 @@ func (r *PostgresRunRepository) Create(ctx context.Context, entity *models.Run)
 		Status:         string(entity.Status),
 		ToolID:         entity.ToolID,
 	}
+	// The row always belongs to the tenant of the context
+	params.OrganizationID = tenantID
 
-	result, err := r.queries.CreateRun(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create run: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewRunCreatedEvent(entity.ID))
+
+	var result Run
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateRun(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create run: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapRunFromDB(&result), nil
 }
 
 // Get retrieves a run by ID
 func (r *PostgresRunRepository) Get(ctx context.Context, id uuid.UUID) (*models.Run, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := GetRunParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.GetRun(ctx, params)
+	result, err := r.queriesFor(ctx).GetRun(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrRunNotFound
@@ -66,10 +101,15 @@ func (r *PostgresRunRepository) Get(ctx context.Context, id uuid.UUID) (*models.
 
 // Update updates an existing run
 func (r *PostgresRunRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Run) (*models.Run, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	statusStr := string(entity.Status)
 	params := UpdateRunParams{
 		ID:          id,
+		TenantID:    tenantID,
 		CompletedAt: entity.CompletedAt,
 		Error:       entity.Error,
 		PipelineID:  &entity.PipelineID,
@@ -79,43 +119,75 @@ func (r *PostgresRunRepository) Update(ctx context.Context, id uuid.UUID, entity
 		ToolID:      &entity.ToolID,
 	}
 
-	result, err := r.queries.UpdateRun(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrRunNotFound
+	pending := events.Ensure(entity.Events(), models.NewRunUpdatedEvent(id))
+
+	var result Run
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateRun(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrRunNotFound
+			}
+			return fmt.Errorf("failed to update run: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update run: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapRunFromDB(&result), nil
 }
 
 // Delete removes a run
 func (r *PostgresRunRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
 	params := DeleteRunParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	err := r.queries.DeleteRun(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteRun(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete run: %w", err)
+		}
+		if n == 0 {
 			return models.ErrRunNotFound
 		}
-		return fmt.Errorf("failed to delete run: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewRunDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of runs
-func (r *PostgresRunRepository) List(ctx context.Context, limit, offset int32) ([]*models.Run, int64, error) {
-	listParams := ListRunsParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of runs
+func (r *PostgresRunRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Run, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "run", runColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListRuns(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list runs: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list runs: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Run])
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list runs: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count runs: %w", err)
 	}
 
 	items := make([]*models.Run, len(results))
@@ -123,20 +195,21 @@ func (r *PostgresRunRepository) List(ctx context.Context, limit, offset int32) (
 		items[i] = mapRunFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // ListRunsByPipeline retrieves multiple Runs by pipelineID
 func (r *PostgresRunRepository) ListRunsByPipeline(ctx context.Context, pipelineID string) ([]*models.Run, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := ListRunsByPipelineParams{
+		TenantID:   tenantID,
 		PipelineID: uuid.MustParse(pipelineID),
 	}
 
-	result, err := r.queries.ListRunsByPipeline(ctx, params)
+	result, err := r.queriesFor(ctx).ListRunsByPipeline(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrRunNotFound
@@ -153,11 +226,19 @@ func (r *PostgresRunRepository) ListRunsByPipeline(ctx context.Context, pipeline
 
 // ListRunsByOrganization retrieves multiple Runs by organizationID
 func (r *PostgresRunRepository) ListRunsByOrganization(ctx context.Context, organizationID string) ([]*models.Run, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
 	params := ListRunsByOrganizationParams{
-		OrganizationID: uuid.MustParse(organizationID),
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.ListRunsByOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).ListRunsByOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrRunNotFound
@@ -174,11 +255,16 @@ func (r *PostgresRunRepository) ListRunsByOrganization(ctx context.Context, orga
 
 // ListRunsByTool retrieves multiple Runs by toolID
 func (r *PostgresRunRepository) ListRunsByTool(ctx context.Context, toolID string) ([]*models.Run, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := ListRunsByToolParams{
-		ToolID: uuid.MustParse(toolID),
+		TenantID: tenantID,
+		ToolID:   uuid.MustParse(toolID),
 	}
 
-	result, err := r.queries.ListRunsByTool(ctx, params)
+	result, err := r.queriesFor(ctx).ListRunsByTool(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrRunNotFound
@@ -193,6 +279,21 @@ func (r *PostgresRunRepository) ListRunsByTool(ctx context.Context, toolID strin
 
 }
 
+// runColumns maps Run fields to the columns List can filter and sort on.
+var runColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"completedAt":    {Name: "completed_at", Kind: database.ColumnTime},
+	"error":          {Name: "error", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"pipelineID":     {Name: "pipeline_id", Kind: database.ColumnUUID},
+	"progress":       {Name: "progress", Kind: database.ColumnInteger},
+	"startedAt":      {Name: "started_at", Kind: database.ColumnTime},
+	"status":         {Name: "status", Kind: database.ColumnString},
+	"toolID":         {Name: "tool_id", Kind: database.ColumnUUID},
+}
+
 func mapRunFromDB(db *Run) *models.Run {
 	if db == nil {
 		return nil
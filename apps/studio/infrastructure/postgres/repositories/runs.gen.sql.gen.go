@@ -17,10 +17,16 @@ SELECT
   COUNT(*)
 FROM
   run
+WHERE
+  organization_id = $1
 `
 
-func (q *Queries) CountRuns(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countRuns)
+type CountRunsParams struct {
+	TenantID uuid.UUID
+}
+
+func (q *Queries) CountRuns(ctx context.Context, arg CountRunsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countRuns, arg.TenantID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -86,19 +92,24 @@ func (q *Queries) CreateRun(ctx context.Context, arg CreateRunParams) (Run, erro
 	return i, err
 }
 
-const deleteRun = `-- name: DeleteRun :exec
+const deleteRun = `-- name: DeleteRun :execrows
 DELETE FROM run
 WHERE
   id = $1
+  AND organization_id = $2
 `
 
 type DeleteRunParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
-func (q *Queries) DeleteRun(ctx context.Context, arg DeleteRunParams) error {
-	_, err := q.db.Exec(ctx, deleteRun, arg.ID)
-	return err
+func (q *Queries) DeleteRun(ctx context.Context, arg DeleteRunParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRun, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getRun = `-- name: GetRun :one
@@ -108,16 +119,18 @@ FROM
   run
 WHERE
   id = $1
+  AND organization_id = $2
 LIMIT
   1
 `
 
 type GetRunParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) GetRun(ctx context.Context, arg GetRunParams) (Run, error) {
-	row := q.db.QueryRow(ctx, getRun, arg.ID)
+	row := q.db.QueryRow(ctx, getRun, arg.ID, arg.TenantID)
 	var i Run
 	err := row.Scan(
 		&i.ID,
@@ -135,56 +148,6 @@ func (q *Queries) GetRun(ctx context.Context, arg GetRunParams) (Run, error) {
 	return i, err
 }
 
-const listRuns = `-- name: ListRuns :many
-SELECT
-  id, created_at, updated_at, completed_at, error, organization_id, pipeline_id, progress, started_at, status, tool_id
-FROM
-  run
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListRunsParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListRuns(ctx context.Context, arg ListRunsParams) ([]Run, error) {
-	rows, err := q.db.Query(ctx, listRuns, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Run
-	for rows.Next() {
-		var i Run
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.CompletedAt,
-			&i.Error,
-			&i.OrganizationID,
-			&i.PipelineID,
-			&i.Progress,
-			&i.StartedAt,
-			&i.Status,
-			&i.ToolID,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listRunsByOrganization = `-- name: ListRunsByOrganization :many
 SELECT
   id, created_at, updated_at, completed_at, error, organization_id, pipeline_id, progress, started_at, status, tool_id
@@ -197,11 +160,11 @@ ORDER BY
 `
 
 type ListRunsByOrganizationParams struct {
-	OrganizationID uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) ListRunsByOrganization(ctx context.Context, arg ListRunsByOrganizationParams) ([]Run, error) {
-	rows, err := q.db.Query(ctx, listRunsByOrganization, arg.OrganizationID)
+	rows, err := q.db.Query(ctx, listRunsByOrganization, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -238,17 +201,19 @@ SELECT
 FROM
   run
 WHERE
-  pipeline_id = $1
+  pipeline_id = $1 AND
+  organization_id = $2
 ORDER BY
   created_at DESC
 `
 
 type ListRunsByPipelineParams struct {
 	PipelineID uuid.UUID
+	TenantID   uuid.UUID
 }
 
 func (q *Queries) ListRunsByPipeline(ctx context.Context, arg ListRunsByPipelineParams) ([]Run, error) {
-	rows, err := q.db.Query(ctx, listRunsByPipeline, arg.PipelineID)
+	rows, err := q.db.Query(ctx, listRunsByPipeline, arg.PipelineID, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -285,17 +250,19 @@ SELECT
 FROM
   run
 WHERE
-  tool_id = $1
+  tool_id = $1 AND
+  organization_id = $2
 ORDER BY
   created_at DESC
 `
 
 type ListRunsByToolParams struct {
-	ToolID uuid.UUID
+	ToolID   uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) ListRunsByTool(ctx context.Context, arg ListRunsByToolParams) ([]Run, error) {
-	rows, err := q.db.Query(ctx, listRunsByTool, arg.ToolID)
+	rows, err := q.db.Query(ctx, listRunsByTool, arg.ToolID, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -331,41 +298,41 @@ UPDATE run
 SET
   completed_at = COALESCE($1, completed_at),
   error = COALESCE($2, error),
-  organization_id = COALESCE($3, organization_id),
-  pipeline_id = COALESCE($4, pipeline_id),
-  progress = COALESCE($5, progress),
-  started_at = COALESCE($6, started_at),
-  status = COALESCE($7, status),
-  tool_id = COALESCE($8, tool_id)
+  pipeline_id = COALESCE($3, pipeline_id),
+  progress = COALESCE($4, progress),
+  started_at = COALESCE($5, started_at),
+  status = COALESCE($6, status),
+  tool_id = COALESCE($7, tool_id)
 WHERE
-  id = $9
+  id = $8
+  AND organization_id = $9
 RETURNING
   id, created_at, updated_at, completed_at, error, organization_id, pipeline_id, progress, started_at, status, tool_id
 `
 
 type UpdateRunParams struct {
-	CompletedAt    *time.Time
-	Error          *string
-	OrganizationID *uuid.UUID
-	PipelineID     *uuid.UUID
-	Progress       *int32
-	StartedAt      *time.Time
-	Status         *string
-	ToolID         *uuid.UUID
-	ID             uuid.UUID
+	CompletedAt *time.Time
+	Error       *string
+	PipelineID  *uuid.UUID
+	Progress    *int32
+	StartedAt   *time.Time
+	Status      *string
+	ToolID      *uuid.UUID
+	ID          uuid.UUID
+	TenantID    uuid.UUID
 }
 
 func (q *Queries) UpdateRun(ctx context.Context, arg UpdateRunParams) (Run, error) {
 	row := q.db.QueryRow(ctx, updateRun,
 		arg.CompletedAt,
 		arg.Error,
-		arg.OrganizationID,
 		arg.PipelineID,
 		arg.Progress,
 		arg.StartedAt,
 		arg.Status,
 		arg.ToolID,
 		arg.ID,
+		arg.TenantID,
 	)
 	var i Run
 	err := row.Scan(
@@ -9,23 +9,37 @@ import (
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresSessionRepository implements SessionRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresSessionRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresSessionRepository creates a new PostgreSQL repository.
 func NewPostgresSessionRepository(db *pgxpool.Pool) *PostgresSessionRepository {
 	return &PostgresSessionRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresSessionRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Session operations
 
 // Create creates a new session
@@ -48,10 +62,20 @@ func (r *PostgresSessionRepository) Create(ctx context.Context, entity *models.S
 		UserID:         entity.UserID,
 	}
 
-	result, err := r.queries.CreateSession(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewSessionCreatedEvent(entity.ID))
+
+	var result Session
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateSession(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapSessionFromDB(&result), nil
 }
@@ -62,7 +86,7 @@ func (r *PostgresSessionRepository) Get(ctx context.Context, id uuid.UUID) (*mod
 		ID: id,
 	}
 
-	result, err := r.queries.GetSession(ctx, params)
+	result, err := r.queriesFor(ctx).GetSession(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrSessionNotFound
@@ -90,13 +114,23 @@ func (r *PostgresSessionRepository) Update(ctx context.Context, id uuid.UUID, en
 		OrganizationID: entity.OrganizationID,
 	}
 
-	result, err := r.queries.UpdateSession(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrSessionNotFound
+	pending := events.Ensure(entity.Events(), models.NewSessionUpdatedEvent(id))
+
+	var result Session
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateSession(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrSessionNotFound
+			}
+			return fmt.Errorf("failed to update session: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update session: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapSessionFromDB(&result), nil
 }
@@ -107,26 +141,38 @@ func (r *PostgresSessionRepository) Delete(ctx context.Context, id uuid.UUID) er
 		ID: id,
 	}
 
-	err := r.queries.DeleteSession(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteSession(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete session: %w", err)
+		}
+		if n == 0 {
 			return models.ErrSessionNotFound
 		}
-		return fmt.Errorf("failed to delete session: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewSessionDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of sessions
-func (r *PostgresSessionRepository) List(ctx context.Context, limit, offset int32) ([]*models.Session, int64, error) {
-	listParams := ListSessionsParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of sessions
+func (r *PostgresSessionRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Session, database.PageInfo, error) {
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "session", sessionColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListSessions(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list sessions: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Session])
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count sessions: %w", err)
 	}
 
 	items := make([]*models.Session, len(results))
@@ -134,11 +180,22 @@ func (r *PostgresSessionRepository) List(ctx context.Context, limit, offset int3
 		items[i] = mapSessionFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
+	return items, database.PageInfo{Total: total}, nil
+}
 
-	return items, count, nil
+// sessionColumns maps Session fields to the columns List can filter and sort on.
+var sessionColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"authMethod":     {Name: "auth_method", Kind: database.ColumnString},
+	"authProvider":   {Name: "auth_provider", Kind: database.ColumnString},
+	"expiresAt":      {Name: "expires_at", Kind: database.ColumnTime},
+	"ipAddress":      {Name: "ip_address", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"token":          {Name: "token", Kind: database.ColumnString},
+	"userAgent":      {Name: "user_agent", Kind: database.ColumnString},
+	"userID":         {Name: "user_id", Kind: database.ColumnUUID},
 }
 
 func mapSessionFromDB(db *Session) *models.Session {
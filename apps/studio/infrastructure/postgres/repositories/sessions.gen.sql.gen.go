@@ -86,7 +86,7 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 	return i, err
 }
 
-const deleteSession = `-- name: DeleteSession :exec
+const deleteSession = `-- name: DeleteSession :execrows
 DELETE FROM "session"
 WHERE
   id = $1
@@ -96,9 +96,12 @@ type DeleteSessionParams struct {
 	ID uuid.UUID
 }
 
-func (q *Queries) DeleteSession(ctx context.Context, arg DeleteSessionParams) error {
-	_, err := q.db.Exec(ctx, deleteSession, arg.ID)
-	return err
+func (q *Queries) DeleteSession(ctx context.Context, arg DeleteSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSession, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getSession = `-- name: GetSession :one
@@ -135,56 +138,6 @@ func (q *Queries) GetSession(ctx context.Context, arg GetSessionParams) (Session
 	return i, err
 }
 
-const listSessions = `-- name: ListSessions :many
-SELECT
-  id, created_at, updated_at, auth_method, auth_provider, expires_at, ip_address, organization_id, token, user_agent, user_id
-FROM
-  "session"
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListSessionsParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListSessions(ctx context.Context, arg ListSessionsParams) ([]Session, error) {
-	rows, err := q.db.Query(ctx, listSessions, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Session
-	for rows.Next() {
-		var i Session
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.AuthMethod,
-			&i.AuthProvider,
-			&i.ExpiresAt,
-			&i.IPAddress,
-			&i.OrganizationID,
-			&i.Token,
-			&i.UserAgent,
-			&i.UserID,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const updateSession = `-- name: UpdateSession :one
 UPDATE "session"
 SET
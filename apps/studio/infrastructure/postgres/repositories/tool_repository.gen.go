@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,21 +17,37 @@ import (
 )
 
 // PostgresToolRepository implements ToolRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresToolRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresToolRepository creates a new PostgreSQL repository.
 func NewPostgresToolRepository(db *pgxpool.Pool) *PostgresToolRepository {
 	return &PostgresToolRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresToolRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Tool operations
 
 // Create creates a new tool
 func (r *PostgresToolRepository) Create(ctx context.Context, entity *models.Tool) (*models.Tool, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := CreateToolParams{
 		ID:             entity.ID,
 		Description:    entity.Description,
@@ -38,22 +56,39 @@ func (r *PostgresToolRepository) Create(ctx context.Context, entity *models.Tool
 		OrganizationID: entity.OrganizationID,
 		OutputMimeType: entity.OutputMimeType,
 	}
+	// The row always belongs to the tenant of the context
+	params.OrganizationID = tenantID
 
-	result, err := r.queries.CreateTool(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create tool: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewToolCreatedEvent(entity.ID))
+
+	var result Tool
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateTool(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create tool: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapToolFromDB(&result), nil
 }
 
 // Get retrieves a tool by ID
 func (r *PostgresToolRepository) Get(ctx context.Context, id uuid.UUID) (*models.Tool, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	params := GetToolParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.GetTool(ctx, params)
+	result, err := r.queriesFor(ctx).GetTool(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrToolNotFound
@@ -66,52 +101,89 @@ func (r *PostgresToolRepository) Get(ctx context.Context, id uuid.UUID) (*models
 
 // Update updates an existing tool
 func (r *PostgresToolRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Tool) (*models.Tool, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	params := UpdateToolParams{
 		ID:             id,
+		TenantID:       tenantID,
 		Description:    &entity.Description,
 		InputMimeType:  &entity.InputMimeType,
 		Name:           &entity.Name,
 		OutputMimeType: &entity.OutputMimeType,
 	}
 
-	result, err := r.queries.UpdateTool(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrToolNotFound
+	pending := events.Ensure(entity.Events(), models.NewToolUpdatedEvent(id))
+
+	var result Tool
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateTool(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrToolNotFound
+			}
+			return fmt.Errorf("failed to update tool: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update tool: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapToolFromDB(&result), nil
 }
 
 // Delete removes a tool
 func (r *PostgresToolRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
 	params := DeleteToolParams{
-		ID: id,
+		ID:       id,
+		TenantID: tenantID,
 	}
 
-	err := r.queries.DeleteTool(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteTool(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete tool: %w", err)
+		}
+		if n == 0 {
 			return models.ErrToolNotFound
 		}
-		return fmt.Errorf("failed to delete tool: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewToolDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of tools
-func (r *PostgresToolRepository) List(ctx context.Context, limit, offset int32) ([]*models.Tool, int64, error) {
-	listParams := ListToolsParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of tools
+func (r *PostgresToolRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Tool, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "tool", toolColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListTools(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list tools: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Tool])
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list tools: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list tools: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count tools: %w", err)
 	}
 
 	items := make([]*models.Tool, len(results))
@@ -119,20 +191,24 @@ func (r *PostgresToolRepository) List(ctx context.Context, limit, offset int32)
 		items[i] = mapToolFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // ListToolsByOrganization retrieves multiple Tools by organizationID
 func (r *PostgresToolRepository) ListToolsByOrganization(ctx context.Context, organizationID string) ([]*models.Tool, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
 	params := ListToolsByOrganizationParams{
-		OrganizationID: uuid.MustParse(organizationID),
+		TenantID: tenantID,
 	}
 
-	result, err := r.queries.ListToolsByOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).ListToolsByOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrToolNotFound
@@ -147,6 +223,18 @@ func (r *PostgresToolRepository) ListToolsByOrganization(ctx context.Context, or
 
 }
 
+// toolColumns maps Tool fields to the columns List can filter and sort on.
+var toolColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"description":    {Name: "description", Kind: database.ColumnString},
+	"inputMimeType":  {Name: "input_mime_type", Kind: database.ColumnString},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"outputMimeType": {Name: "output_mime_type", Kind: database.ColumnString},
+}
+
 func mapToolFromDB(db *Tool) *models.Tool {
 	if db == nil {
 		return nil
@@ -16,10 +16,16 @@ SELECT
   COUNT(*)
 FROM
   tool
+WHERE
+  organization_id = $1
 `
 
-func (q *Queries) CountTools(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countTools)
+type CountToolsParams struct {
+	TenantID uuid.UUID
+}
+
+func (q *Queries) CountTools(ctx context.Context, arg CountToolsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTools, arg.TenantID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -73,19 +79,24 @@ func (q *Queries) CreateTool(ctx context.Context, arg CreateToolParams) (Tool, e
 	return i, err
 }
 
-const deleteTool = `-- name: DeleteTool :exec
+const deleteTool = `-- name: DeleteTool :execrows
 DELETE FROM tool
 WHERE
   id = $1
+  AND organization_id = $2
 `
 
 type DeleteToolParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
-func (q *Queries) DeleteTool(ctx context.Context, arg DeleteToolParams) error {
-	_, err := q.db.Exec(ctx, deleteTool, arg.ID)
-	return err
+func (q *Queries) DeleteTool(ctx context.Context, arg DeleteToolParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTool, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getTool = `-- name: GetTool :one
@@ -95,16 +106,18 @@ FROM
   tool
 WHERE
   id = $1
+  AND organization_id = $2
 LIMIT
   1
 `
 
 type GetToolParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) GetTool(ctx context.Context, arg GetToolParams) (Tool, error) {
-	row := q.db.QueryRow(ctx, getTool, arg.ID)
+	row := q.db.QueryRow(ctx, getTool, arg.ID, arg.TenantID)
 	var i Tool
 	err := row.Scan(
 		&i.ID,
@@ -119,53 +132,6 @@ func (q *Queries) GetTool(ctx context.Context, arg GetToolParams) (Tool, error)
 	return i, err
 }
 
-const listTools = `-- name: ListTools :many
-SELECT
-  id, created_at, updated_at, description, input_mime_type, name, organization_id, output_mime_type
-FROM
-  tool
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListToolsParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListTools(ctx context.Context, arg ListToolsParams) ([]Tool, error) {
-	rows, err := q.db.Query(ctx, listTools, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Tool
-	for rows.Next() {
-		var i Tool
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.Description,
-			&i.InputMimeType,
-			&i.Name,
-			&i.OrganizationID,
-			&i.OutputMimeType,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listToolsByOrganization = `-- name: ListToolsByOrganization :many
 SELECT
   id, created_at, updated_at, description, input_mime_type, name, organization_id, output_mime_type
@@ -178,11 +144,11 @@ ORDER BY
 `
 
 type ListToolsByOrganizationParams struct {
-	OrganizationID uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) ListToolsByOrganization(ctx context.Context, arg ListToolsByOrganizationParams) ([]Tool, error) {
-	rows, err := q.db.Query(ctx, listToolsByOrganization, arg.OrganizationID)
+	rows, err := q.db.Query(ctx, listToolsByOrganization, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -216,10 +182,10 @@ SET
   description = COALESCE($1, description),
   input_mime_type = COALESCE($2, input_mime_type),
   name = COALESCE($3, name),
-  organization_id = COALESCE($4, organization_id),
-  output_mime_type = COALESCE($5, output_mime_type)
+  output_mime_type = COALESCE($4, output_mime_type)
 WHERE
-  id = $6
+  id = $5
+  AND organization_id = $6
 RETURNING
   id, created_at, updated_at, description, input_mime_type, name, organization_id, output_mime_type
 `
@@ -228,9 +194,9 @@ type UpdateToolParams struct {
 	Description    *string
 	InputMimeType  *string
 	Name           *string
-	OrganizationID *uuid.UUID
 	OutputMimeType *string
 	ID             uuid.UUID
+	TenantID       uuid.UUID
 }
 
 func (q *Queries) UpdateTool(ctx context.Context, arg UpdateToolParams) (Tool, error) {
@@ -238,9 +204,9 @@ func (q *Queries) UpdateTool(ctx context.Context, arg UpdateToolParams) (Tool, e
 		arg.Description,
 		arg.InputMimeType,
 		arg.Name,
-		arg.OrganizationID,
 		arg.OutputMimeType,
 		arg.ID,
+		arg.TenantID,
 	)
 	var i Tool
 	err := row.Scan(
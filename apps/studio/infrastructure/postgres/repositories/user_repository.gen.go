@@ -9,23 +9,37 @@ import (
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresUserRepository implements UserRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresUserRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresUserRepository creates a new PostgreSQL repository.
 func NewPostgresUserRepository(db *pgxpool.Pool) *PostgresUserRepository {
 	return &PostgresUserRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresUserRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // User operations
 
 // Create creates a new user
@@ -38,10 +52,20 @@ func (r *PostgresUserRepository) Create(ctx context.Context, entity *models.User
 		Name:          entity.Name,
 	}
 
-	result, err := r.queries.CreateUser(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewUserCreatedEvent(entity.ID))
+
+	var result User
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateUser(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapUserFromDB(&result), nil
 }
@@ -52,7 +76,7 @@ func (r *PostgresUserRepository) Get(ctx context.Context, id uuid.UUID) (*models
 		ID: id,
 	}
 
-	result, err := r.queries.GetUser(ctx, params)
+	result, err := r.queriesFor(ctx).GetUser(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrUserNotFound
@@ -74,13 +98,23 @@ func (r *PostgresUserRepository) Update(ctx context.Context, id uuid.UUID, entit
 		Name:          &entity.Name,
 	}
 
-	result, err := r.queries.UpdateUser(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrUserNotFound
+	pending := events.Ensure(entity.Events(), models.NewUserUpdatedEvent(id))
+
+	var result User
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateUser(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrUserNotFound
+			}
+			return fmt.Errorf("failed to update user: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update user: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapUserFromDB(&result), nil
 }
@@ -91,26 +125,38 @@ func (r *PostgresUserRepository) Delete(ctx context.Context, id uuid.UUID) error
 		ID: id,
 	}
 
-	err := r.queries.DeleteUser(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteUser(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		if n == 0 {
 			return models.ErrUserNotFound
 		}
-		return fmt.Errorf("failed to delete user: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewUserDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of users
-func (r *PostgresUserRepository) List(ctx context.Context, limit, offset int32) ([]*models.User, int64, error) {
-	listParams := ListUsersParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of users
+func (r *PostgresUserRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.User, database.PageInfo, error) {
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "user", userColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListUsers(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list users: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[User])
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count users: %w", err)
 	}
 
 	items := make([]*models.User, len(results))
@@ -118,11 +164,7 @@ func (r *PostgresUserRepository) List(ctx context.Context, limit, offset int32)
 		items[i] = mapUserFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // GetUserByEmail retrieves a single User by email
@@ -131,7 +173,7 @@ func (r *PostgresUserRepository) GetUserByEmail(ctx context.Context, email strin
 		Email: email,
 	}
 
-	result, err := r.queries.GetUserByEmail(ctx, params)
+	result, err := r.queriesFor(ctx).GetUserByEmail(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrUserNotFound
@@ -149,7 +191,7 @@ func (r *PostgresUserRepository) GetUserBySessionID(ctx context.Context, session
 		SessionID: uuid.MustParse(sessionID),
 	}
 
-	result, err := r.queries.GetUserBySessionID(ctx, params)
+	result, err := r.queriesFor(ctx).GetUserBySessionID(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrUserNotFound
@@ -161,6 +203,17 @@ func (r *PostgresUserRepository) GetUserBySessionID(ctx context.Context, session
 
 }
 
+// userColumns maps User fields to the columns List can filter and sort on.
+var userColumns = database.Columns{
+	"id":            {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":     {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":     {Name: "updated_at", Kind: database.ColumnTime},
+	"email":         {Name: "email", Kind: database.ColumnString},
+	"emailVerified": {Name: "email_verified", Kind: database.ColumnBool},
+	"image":         {Name: "image", Kind: database.ColumnString},
+	"name":          {Name: "name", Kind: database.ColumnString},
+}
+
 func mapUserFromDB(db *User) *models.User {
 	if db == nil {
 		return nil
@@ -243,9 +243,27 @@ table "artifact" {
     null = true
     type = sql("text")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+
+  column "search_vector" {
+    null = false
+    type = sql("tsvector")
+    as {
+      expr = "to_tsvector('english'::regconfig, COALESCE(\"text\", ''))"
+      type = STORED
+    }
+  }
   primary_key {
     columns = [column.id]
   }
+  index "idx_artifact_search_vector" {
+    type    = GIN
+    columns = [column.search_vector]
+  }
   foreign_key "artifact_organization_id_fkey" {
     columns     = [column.organization_id]
     ref_columns = [table.organization.column.id]
@@ -266,6 +284,38 @@ table "artifact" {
   }
 }
 
+table "artifact_labels" {
+  schema = schema.public
+
+  column "artifact_id" {
+    null = false
+    type = sql("uuid")
+  }
+
+  column "label_id" {
+    null = false
+    type = sql("uuid")
+  }
+  primary_key {
+    columns = [column.artifact_id, column.label_id]
+  }
+  foreign_key "artifact_labels_artifact_id_fkey" {
+    columns     = [column.artifact_id]
+    ref_columns = [table.artifact.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "CASCADE"
+  }
+  foreign_key "artifact_labels_label_id_fkey" {
+    columns     = [column.label_id]
+    ref_columns = [table.label.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "CASCADE"
+  }
+  index "idx_artifact_labels_label_id" {
+    columns = [column.label_id]
+  }
+}
+
 table "executor" {
   schema = schema.public
 
@@ -546,10 +596,20 @@ table "member" {
     default = "basic"
   }
 
+  column "role_id" {
+    null = true
+    type = sql("uuid")
+  }
+
   column "user_id" {
     null = false
     type = sql("uuid")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("timestamptz")
+  }
   primary_key {
     columns = [column.id]
   }
@@ -565,6 +625,12 @@ table "member" {
     on_update   = "NO_ACTION"
     on_delete   = "CASCADE"
   }
+  foreign_key "member_role_id_fkey" {
+    columns     = [column.role_id]
+    ref_columns = [table.role.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "SET_NULL"
+  }
   index "idx_member_organization_id" {
     columns = [column.organization_id]
   }
@@ -633,9 +699,19 @@ table "organization" {
     null = false
     type = sql("text")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("timestamptz")
+  }
   primary_key {
     columns = [column.id]
   }
+  index "idx_organization_slug" {
+    unique  = true
+    columns = [column.slug]
+    where   = "(deleted_at IS NULL)"
+  }
   check "organization_plan_check" {
     expr = "(plan = ANY (ARRAY['FREE'::text, 'BASIC'::text, 'STANDARD'::text, 'PREMIUM'::text, 'UNLIMITED'::text]))"
   }
@@ -676,9 +752,22 @@ table "pipeline" {
     null = false
     type = sql("uuid")
   }
+
+  column "search_vector" {
+    null = false
+    type = sql("tsvector")
+    as {
+      expr = "to_tsvector('english'::regconfig, COALESCE(\"name\", '') || ' ' || COALESCE(\"description\", ''))"
+      type = STORED
+    }
+  }
   primary_key {
     columns = [column.id]
   }
+  index "idx_pipeline_search_vector" {
+    type    = GIN
+    columns = [column.search_vector]
+  }
   foreign_key "pipeline_organization_id_fkey" {
     columns     = [column.organization_id]
     ref_columns = [table.organization.column.id]
@@ -711,6 +800,11 @@ table "pipeline_step" {
     default = sql("CURRENT_TIMESTAMP")
   }
 
+  column "config" {
+    null = true
+    type = sql("jsonb")
+  }
+
   column "pipeline_id" {
     null = false
     type = sql("uuid")
@@ -725,6 +819,64 @@ table "pipeline_step" {
   }
 }
 
+table "role" {
+  schema = schema.public
+
+  column "id" {
+    null    = false
+    type    = sql("uuid")
+    default = sql("gen_random_uuid()")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "updated_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "description" {
+    null = true
+    type = sql("text")
+  }
+
+  column "name" {
+    null = false
+    type = sql("text")
+  }
+
+  column "organization_id" {
+    null = false
+    type = sql("uuid")
+  }
+
+  column "permissions" {
+    null    = false
+    type    = sql("text[]")
+    default = "{}"
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "role_organization_id_fkey" {
+    columns     = [column.organization_id]
+    ref_columns = [table.organization.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "CASCADE"
+  }
+  index "idx_role_name" {
+    columns = [column.name]
+  }
+  index "idx_role_organization_id" {
+    columns = [column.organization_id]
+  }
+}
+
 table "run" {
   schema = schema.public
 
@@ -1009,6 +1161,247 @@ table "user" {
   }
 }
 
+table "webhook_delivery" {
+  schema = schema.public
+
+  column "id" {
+    null    = false
+    type    = sql("uuid")
+    default = sql("gen_random_uuid()")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "updated_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "attempts" {
+    null    = false
+    type    = sql("integer")
+    default = 0
+  }
+
+  column "endpoint_id" {
+    null = false
+    type = sql("uuid")
+  }
+
+  column "event_id" {
+    null = false
+    type = sql("uuid")
+  }
+
+  column "event_type" {
+    null = false
+    type = sql("text")
+  }
+
+  column "last_attempt_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+
+  column "last_error" {
+    null = true
+    type = sql("text")
+  }
+
+  column "next_attempt_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+
+  column "organization_id" {
+    null = false
+    type = sql("uuid")
+  }
+
+  column "payload" {
+    null = false
+    type = sql("text")
+  }
+
+  column "response_status" {
+    null = true
+    type = sql("integer")
+  }
+
+  column "status" {
+    null    = false
+    type    = sql("text")
+    default = "pending"
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "webhook_delivery_organization_id_fkey" {
+    columns     = [column.organization_id]
+    ref_columns = [table.organization.column.id]
+    on_update   = "CASCADE"
+    on_delete   = "CASCADE"
+  }
+  foreign_key "webhook_delivery_endpoint_id_fkey" {
+    columns     = [column.endpoint_id]
+    ref_columns = [table.webhook_endpoint.column.id]
+    on_update   = "CASCADE"
+    on_delete   = "CASCADE"
+  }
+  index "idx_webhook_delivery_endpoint_id" {
+    columns = [column.endpoint_id]
+  }
+  index "idx_webhook_delivery_organization_id" {
+    columns = [column.organization_id]
+  }
+  index "idx_webhook_delivery_status" {
+    columns = [column.status]
+  }
+  check "webhook_delivery_status_check" {
+    expr = "(status = ANY (ARRAY['pending'::text, 'succeeded'::text, 'failed'::text]))"
+  }
+}
+
+table "webhook_endpoint" {
+  schema = schema.public
+
+  column "id" {
+    null    = false
+    type    = sql("uuid")
+    default = sql("gen_random_uuid()")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "updated_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "description" {
+    null = true
+    type = sql("text")
+  }
+
+  column "disabled_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+
+  column "enabled" {
+    null    = false
+    type    = sql("boolean")
+    default = true
+  }
+
+  column "event_types" {
+    null = false
+    type = sql("text[]")
+  }
+
+  column "failure_count" {
+    null    = false
+    type    = sql("integer")
+    default = 0
+  }
+
+  column "organization_id" {
+    null = false
+    type = sql("uuid")
+  }
+
+  column "secret" {
+    null = false
+    type = sql("text")
+  }
+
+  column "url" {
+    null = false
+    type = sql("text")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "webhook_endpoint_organization_id_fkey" {
+    columns     = [column.organization_id]
+    ref_columns = [table.organization.column.id]
+    on_update   = "CASCADE"
+    on_delete   = "CASCADE"
+  }
+  index "idx_webhook_endpoint_organization_id" {
+    columns = [column.organization_id]
+  }
+}
+
+
+table "event_outbox" {
+  schema = schema.public
+
+  column "id" {
+    null = false
+    type = sql("text")
+  }
+
+  column "event_type" {
+    null = false
+    type = sql("text")
+  }
+
+  column "organization_id" {
+    null = true
+    type = sql("uuid")
+  }
+
+  column "payload" {
+    null = false
+    type = sql("jsonb")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "published_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+
+  column "attempts" {
+    null    = false
+    type    = sql("integer")
+    default = 0
+  }
+
+  column "last_error" {
+    null = true
+    type = sql("text")
+  }
+
+  column "dead_lettered_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_event_outbox_pending" {
+    columns = [column.created_at, column.id]
+    where   = "(published_at IS NULL)"
+  }
+}
+
 
 schema "public" {
   comment = "standard public schema"
@@ -0,0 +1,32 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "account" table
+DROP TABLE `account`;
+-- drop "api_key" table
+DROP TABLE `api_key`;
+-- drop "artifact" table
+DROP TABLE `artifact`;
+-- drop "executor" table
+DROP TABLE `executor`;
+-- drop "invitation" table
+DROP TABLE `invitation`;
+-- drop "label" table
+DROP TABLE `label`;
+-- drop "member" table
+DROP TABLE `member`;
+-- drop "organization" table
+DROP TABLE `organization`;
+-- drop "pipeline" table
+DROP TABLE `pipeline`;
+-- drop "pipeline_step" table
+DROP TABLE `pipeline_step`;
+-- drop "run" table
+DROP TABLE `run`;
+-- drop "session" table
+DROP TABLE `session`;
+-- drop "tool" table
+DROP TABLE `tool`;
+-- drop "user" table
+DROP TABLE `user`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,8 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "webhook_delivery" table
+DROP TABLE `webhook_delivery`;
+-- drop "webhook_endpoint" table
+DROP TABLE `webhook_endpoint`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,12 @@
+-- create "webhook_delivery" table
+CREATE TABLE `webhook_delivery` (`id` text NOT NULL DEFAULT (lower(hex(randomblob(16)))), `created_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `updated_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `attempts` integer NOT NULL DEFAULT 0, `endpoint_id` text NOT NULL, `event_id` text NOT NULL, `event_type` text NOT NULL, `last_attempt_at` text NULL, `last_error` text NULL, `next_attempt_at` text NULL, `organization_id` text NOT NULL, `payload` text NOT NULL, `response_status` integer NULL, `status` text NOT NULL DEFAULT 'pending', PRIMARY KEY (`id`), CONSTRAINT `webhook_delivery_organization_id_fkey` FOREIGN KEY (`organization_id`) REFERENCES `organization` (`id`) ON UPDATE CASCADE ON DELETE CASCADE, CONSTRAINT `webhook_delivery_endpoint_id_fkey` FOREIGN KEY (`endpoint_id`) REFERENCES `webhook_endpoint` (`id`) ON UPDATE CASCADE ON DELETE CASCADE);
+-- create index "idx_webhook_delivery_endpoint_id" to table: "webhook_delivery"
+CREATE INDEX `idx_webhook_delivery_endpoint_id` ON `webhook_delivery` (`endpoint_id`);
+-- create index "idx_webhook_delivery_organization_id" to table: "webhook_delivery"
+CREATE INDEX `idx_webhook_delivery_organization_id` ON `webhook_delivery` (`organization_id`);
+-- create index "idx_webhook_delivery_status" to table: "webhook_delivery"
+CREATE INDEX `idx_webhook_delivery_status` ON `webhook_delivery` (`status`);
+-- create "webhook_endpoint" table
+CREATE TABLE `webhook_endpoint` (`id` text NOT NULL DEFAULT (lower(hex(randomblob(16)))), `created_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `updated_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `description` text NULL, `disabled_at` text NULL, `enabled` integer NOT NULL DEFAULT 1, `event_types` text NOT NULL, `failure_count` integer NOT NULL DEFAULT 0, `organization_id` text NOT NULL, `secret` text NOT NULL, `url` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `webhook_endpoint_organization_id_fkey` FOREIGN KEY (`organization_id`) REFERENCES `organization` (`id`) ON UPDATE CASCADE ON DELETE CASCADE);
+-- create index "idx_webhook_endpoint_organization_id" to table: "webhook_endpoint"
+CREATE INDEX `idx_webhook_endpoint_organization_id` ON `webhook_endpoint` (`organization_id`);
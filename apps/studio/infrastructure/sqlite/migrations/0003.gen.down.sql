@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "event_outbox" table
+DROP TABLE `event_outbox`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,16 @@
+-- drop search trigger "artifact_fts_au"
+DROP TRIGGER IF EXISTS "artifact_fts_au";
+-- drop search trigger "artifact_fts_ad"
+DROP TRIGGER IF EXISTS "artifact_fts_ad";
+-- drop search trigger "artifact_fts_ai"
+DROP TRIGGER IF EXISTS "artifact_fts_ai";
+-- drop search table "artifact_fts"
+DROP TABLE IF EXISTS "artifact_fts";
+-- drop search trigger "pipeline_fts_au"
+DROP TRIGGER IF EXISTS "pipeline_fts_au";
+-- drop search trigger "pipeline_fts_ad"
+DROP TRIGGER IF EXISTS "pipeline_fts_ad";
+-- drop search trigger "pipeline_fts_ai"
+DROP TRIGGER IF EXISTS "pipeline_fts_ai";
+-- drop search table "pipeline_fts"
+DROP TABLE IF EXISTS "pipeline_fts";
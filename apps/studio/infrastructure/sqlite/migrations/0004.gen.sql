@@ -0,0 +1,34 @@
+-- create search table "artifact_fts"
+CREATE VIRTUAL TABLE "artifact_fts" USING fts5("text", content='artifact', content_rowid='rowid', tokenize='porter unicode61');
+-- create search trigger "artifact_fts_ai"
+CREATE TRIGGER "artifact_fts_ai" AFTER INSERT ON "artifact" BEGIN
+  INSERT INTO "artifact_fts" (rowid, "text") VALUES (new.rowid, new."text");
+END;
+-- create search trigger "artifact_fts_ad"
+CREATE TRIGGER "artifact_fts_ad" AFTER DELETE ON "artifact" BEGIN
+  INSERT INTO "artifact_fts" ("artifact_fts", rowid, "text") VALUES ('delete', old.rowid, old."text");
+END;
+-- create search trigger "artifact_fts_au"
+CREATE TRIGGER "artifact_fts_au" AFTER UPDATE ON "artifact" BEGIN
+  INSERT INTO "artifact_fts" ("artifact_fts", rowid, "text") VALUES ('delete', old.rowid, old."text");
+  INSERT INTO "artifact_fts" (rowid, "text") VALUES (new.rowid, new."text");
+END;
+-- index the rows of "artifact"
+INSERT INTO "artifact_fts" ("artifact_fts") VALUES ('rebuild');
+-- create search table "pipeline_fts"
+CREATE VIRTUAL TABLE "pipeline_fts" USING fts5("name", "description", content='pipeline', content_rowid='rowid', tokenize='porter unicode61');
+-- create search trigger "pipeline_fts_ai"
+CREATE TRIGGER "pipeline_fts_ai" AFTER INSERT ON "pipeline" BEGIN
+  INSERT INTO "pipeline_fts" (rowid, "name", "description") VALUES (new.rowid, new."name", new."description");
+END;
+-- create search trigger "pipeline_fts_ad"
+CREATE TRIGGER "pipeline_fts_ad" AFTER DELETE ON "pipeline" BEGIN
+  INSERT INTO "pipeline_fts" ("pipeline_fts", rowid, "name", "description") VALUES ('delete', old.rowid, old."name", old."description");
+END;
+-- create search trigger "pipeline_fts_au"
+CREATE TRIGGER "pipeline_fts_au" AFTER UPDATE ON "pipeline" BEGIN
+  INSERT INTO "pipeline_fts" ("pipeline_fts", rowid, "name", "description") VALUES ('delete', old.rowid, old."name", old."description");
+  INSERT INTO "pipeline_fts" (rowid, "name", "description") VALUES (new.rowid, new."name", new."description");
+END;
+-- index the rows of "pipeline"
+INSERT INTO "pipeline_fts" ("pipeline_fts") VALUES ('rebuild');
@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "artifact_labels" table
+DROP TABLE `artifact_labels`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,4 @@
+-- create "artifact_labels" table
+CREATE TABLE `artifact_labels` (`artifact_id` text NOT NULL, `label_id` text NOT NULL, PRIMARY KEY (`artifact_id`, `label_id`), CONSTRAINT `artifact_labels_artifact_id_fkey` FOREIGN KEY (`artifact_id`) REFERENCES `artifact` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `artifact_labels_label_id_fkey` FOREIGN KEY (`label_id`) REFERENCES `label` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "idx_artifact_labels_label_id" to table: "artifact_labels"
+CREATE INDEX `idx_artifact_labels_label_id` ON `artifact_labels` (`label_id`);
@@ -0,0 +1,2 @@
+-- add column "dead_lettered_at" to table: "event_outbox"
+ALTER TABLE `event_outbox` ADD COLUMN `dead_lettered_at` text NULL;
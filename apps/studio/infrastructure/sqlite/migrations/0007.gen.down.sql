@@ -0,0 +1,61 @@
+-- drop search trigger "artifact_fts_au"
+DROP TRIGGER IF EXISTS "artifact_fts_au";
+-- drop search trigger "artifact_fts_ad"
+DROP TRIGGER IF EXISTS "artifact_fts_ad";
+-- drop search trigger "artifact_fts_ai"
+DROP TRIGGER IF EXISTS "artifact_fts_ai";
+-- drop search table "artifact_fts"
+DROP TABLE IF EXISTS "artifact_fts";
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_artifact" table
+CREATE TABLE `new_artifact` (`id` text NOT NULL DEFAULT (lower(hex(randomblob(16)))), `created_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `updated_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `credits` integer NOT NULL DEFAULT 0, `description` text NULL, `mime_type` text NOT NULL DEFAULT 'application/octet-stream', `name` text NULL, `organization_id` text NOT NULL, `preview_image` text NULL, `producer_id` text NULL, `text` text NULL, `url` text NULL, PRIMARY KEY (`id`), CONSTRAINT `artifact_producer_id_fkey` FOREIGN KEY (`producer_id`) REFERENCES `run` (`id`) ON UPDATE CASCADE ON DELETE SET NULL, CONSTRAINT `artifact_organization_id_fkey` FOREIGN KEY (`organization_id`) REFERENCES `organization` (`id`) ON UPDATE CASCADE ON DELETE CASCADE);
+-- copy rows from old table "artifact" to new temporary table "new_artifact"
+INSERT INTO `new_artifact` (`id`, `created_at`, `updated_at`, `credits`, `description`, `mime_type`, `name`, `organization_id`, `preview_image`, `producer_id`, `text`, `url`) SELECT `id`, `created_at`, `updated_at`, `credits`, `description`, `mime_type`, `name`, `organization_id`, `preview_image`, `producer_id`, `text`, `url` FROM `artifact`;
+-- drop "artifact" table after copying rows
+DROP TABLE `artifact`;
+-- rename temporary table "new_artifact" to "artifact"
+ALTER TABLE `new_artifact` RENAME TO `artifact`;
+-- create index "idx_artifact_organization_id" to table: "artifact"
+CREATE INDEX `idx_artifact_organization_id` ON `artifact` (`organization_id`);
+-- create index "idx_artifact_producer_id" to table: "artifact"
+CREATE INDEX `idx_artifact_producer_id` ON `artifact` (`producer_id`);
+-- create "new_member" table
+CREATE TABLE `new_member` (`id` text NOT NULL DEFAULT (lower(hex(randomblob(16)))), `created_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `updated_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `organization_id` text NOT NULL, `role` text NOT NULL DEFAULT 'basic', `role_id` text NULL, `user_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `member_role_id_fkey` FOREIGN KEY (`role_id`) REFERENCES `role` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT `member_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `member_organization_id_fkey` FOREIGN KEY (`organization_id`) REFERENCES `organization` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- copy rows from old table "member" to new temporary table "new_member"
+INSERT INTO `new_member` (`id`, `created_at`, `updated_at`, `organization_id`, `role`, `role_id`, `user_id`) SELECT `id`, `created_at`, `updated_at`, `organization_id`, `role`, `role_id`, `user_id` FROM `member`;
+-- drop "member" table after copying rows
+DROP TABLE `member`;
+-- rename temporary table "new_member" to "member"
+ALTER TABLE `new_member` RENAME TO `member`;
+-- create index "idx_member_organization_id" to table: "member"
+CREATE INDEX `idx_member_organization_id` ON `member` (`organization_id`);
+-- create index "idx_member_user_id" to table: "member"
+CREATE INDEX `idx_member_user_id` ON `member` (`user_id`);
+-- create "new_organization" table
+CREATE TABLE `new_organization` (`id` text NOT NULL DEFAULT (lower(hex(randomblob(16)))), `created_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `updated_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `billing_email` text NULL, `credits` integer NOT NULL DEFAULT 0, `logo` text NULL, `name` text NOT NULL, `plan` text NOT NULL DEFAULT 'FREE', `slug` text NOT NULL, `stripe_customer_identifier` text NOT NULL, PRIMARY KEY (`id`));
+-- copy rows from old table "organization" to new temporary table "new_organization"
+INSERT INTO `new_organization` (`id`, `created_at`, `updated_at`, `billing_email`, `credits`, `logo`, `name`, `plan`, `slug`, `stripe_customer_identifier`) SELECT `id`, `created_at`, `updated_at`, `billing_email`, `credits`, `logo`, `name`, `plan`, `slug`, `stripe_customer_identifier` FROM `organization`;
+-- drop "organization" table after copying rows
+DROP TABLE `organization`;
+-- rename temporary table "new_organization" to "organization"
+ALTER TABLE `new_organization` RENAME TO `organization`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
+-- create search table "artifact_fts"
+CREATE VIRTUAL TABLE "artifact_fts" USING fts5("text", content='artifact', content_rowid='rowid', tokenize='porter unicode61');
+-- create search trigger "artifact_fts_ai"
+CREATE TRIGGER "artifact_fts_ai" AFTER INSERT ON "artifact" BEGIN
+  INSERT INTO "artifact_fts" (rowid, "text") VALUES (new.rowid, new."text");
+END;
+-- create search trigger "artifact_fts_ad"
+CREATE TRIGGER "artifact_fts_ad" AFTER DELETE ON "artifact" BEGIN
+  INSERT INTO "artifact_fts" ("artifact_fts", rowid, "text") VALUES ('delete', old.rowid, old."text");
+END;
+-- create search trigger "artifact_fts_au"
+CREATE TRIGGER "artifact_fts_au" AFTER UPDATE ON "artifact" BEGIN
+  INSERT INTO "artifact_fts" ("artifact_fts", rowid, "text") VALUES ('delete', old.rowid, old."text");
+  INSERT INTO "artifact_fts" (rowid, "text") VALUES (new.rowid, new."text");
+END;
+-- index the rows of "artifact"
+INSERT INTO "artifact_fts" ("artifact_fts") VALUES ('rebuild');
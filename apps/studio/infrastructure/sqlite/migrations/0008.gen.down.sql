@@ -0,0 +1,12 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_pipeline_step" table
+CREATE TABLE `new_pipeline_step` (`id` text NOT NULL DEFAULT (lower(hex(randomblob(16)))), `created_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `updated_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `pipeline_id` text NOT NULL, `tool_id` text NOT NULL, PRIMARY KEY (`id`));
+-- copy rows from old table "pipeline_step" to new temporary table "new_pipeline_step"
+INSERT INTO `new_pipeline_step` (`id`, `created_at`, `updated_at`, `pipeline_id`, `tool_id`) SELECT `id`, `created_at`, `updated_at`, `pipeline_id`, `tool_id` FROM `pipeline_step`;
+-- drop "pipeline_step" table after copying rows
+DROP TABLE `pipeline_step`;
+-- rename temporary table "new_pipeline_step" to "pipeline_step"
+ALTER TABLE `new_pipeline_step` RENAME TO `pipeline_step`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+-- add column "config" to table: "pipeline_step"
+ALTER TABLE `pipeline_step` ADD COLUMN `config` text NULL;
@@ -29,18 +29,6 @@ WHERE
 LIMIT
   1;
 
--- name: ListAccounts :many
-SELECT
-  *
-FROM
-  account
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountAccounts :one
 SELECT
   COUNT(*)
@@ -64,7 +52,7 @@ WHERE
 RETURNING
   *;
 
--- name: DeleteAccount :exec
+-- name: DeleteAccount :execrows
 DELETE FROM account
 WHERE
   id = sqlc.arg('id');
@@ -26,26 +26,19 @@ FROM
   artifact
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NULL
 LIMIT
   1;
 
--- name: ListArtifacts :many
-SELECT
-  *
-FROM
-  artifact
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountArtifacts :one
 SELECT
   COUNT(*)
 FROM
-  artifact;
+  artifact
+WHERE
+  organization_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NULL;
 
 -- name: UpdateArtifact :one
 UPDATE artifact
@@ -54,20 +47,75 @@ SET
   description = COALESCE(sqlc.narg('description'), description),
   mime_type = COALESCE(sqlc.narg('mime_type'), mime_type),
   name = COALESCE(sqlc.narg('name'), name),
-  organization_id = COALESCE(sqlc.narg('organization_id'), organization_id),
   preview_image = COALESCE(sqlc.narg('preview_image'), preview_image),
   producer_id = COALESCE(sqlc.narg('producer_id'), producer_id),
   text = COALESCE(sqlc.narg('text'), text),
   url = COALESCE(sqlc.narg('url'), url)
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NULL
 RETURNING
   *;
 
--- name: DeleteArtifact :exec
+-- name: DeleteArtifact :execrows
+UPDATE artifact
+SET
+  deleted_at = CURRENT_TIMESTAMP
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NULL;
+
+-- name: RestoreArtifact :execrows
+UPDATE artifact
+SET
+  deleted_at = NULL
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NOT NULL;
+
+-- name: PurgeArtifacts :execrows
 DELETE FROM artifact
 WHERE
-  id = sqlc.arg('id');
+  deleted_at IS NOT NULL
+  AND deleted_at < sqlc.arg('before')::timestamptz;
+
+-- name: AddArtifactLabel :execrows
+INSERT INTO
+  artifact_labels (artifact_id, label_id)
+SELECT
+  o.id,
+  r.id
+FROM
+  artifact o,
+  label r
+WHERE
+  o.id = sqlc.arg('artifact_id')
+  AND r.id = sqlc.arg('label_id')
+  AND r.organization_id = sqlc.arg('tenant_id')
+ON CONFLICT (artifact_id, label_id) DO UPDATE
+SET
+  artifact_id = excluded.artifact_id;
+
+-- name: RemoveArtifactLabel :exec
+DELETE FROM artifact_labels
+WHERE
+  artifact_id = sqlc.arg('artifact_id')
+  AND label_id = sqlc.arg('label_id');
+
+-- name: ListArtifactLabels :many
+SELECT
+  r.*
+FROM
+  label r
+  JOIN artifact_labels j ON j.label_id = r.id
+WHERE
+  j.artifact_id = sqlc.arg('artifact_id')
+  AND r.organization_id = sqlc.arg('tenant_id')
+ORDER BY
+  r.created_at DESC;
 
 -- name: ListArtifactsByOrganization :many
 SELECT
@@ -75,7 +123,8 @@ SELECT
 FROM
   artifact
 WHERE
-  organization_id = sqlc.arg('organization_id')
+  organization_id = sqlc.arg('tenant_id') AND
+  deleted_at IS NULL
 ORDER BY
   created_at DESC;
 -- name: ListArtifactsByProducer :many
@@ -84,6 +133,8 @@ SELECT
 FROM
   artifact
 WHERE
-  producer_id = sqlc.arg('producer_id')
+  producer_id = sqlc.arg('producer_id') AND
+  organization_id = sqlc.arg('tenant_id') AND
+  deleted_at IS NULL
 ORDER BY
   created_at DESC;
\ No newline at end of file
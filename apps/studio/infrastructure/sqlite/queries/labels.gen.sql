@@ -19,41 +19,33 @@ FROM
   label
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 LIMIT
   1;
 
--- name: ListLabels :many
-SELECT
-  *
-FROM
-  label
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountLabels :one
 SELECT
   COUNT(*)
 FROM
-  label;
+  label
+WHERE
+  organization_id = sqlc.arg('tenant_id');
 
 -- name: UpdateLabel :one
 UPDATE label
 SET
-  name = COALESCE(sqlc.narg('name'), name),
-  organization_id = COALESCE(sqlc.narg('organization_id'), organization_id)
+  name = COALESCE(sqlc.narg('name'), name)
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 RETURNING
   *;
 
--- name: DeleteLabel :exec
+-- name: DeleteLabel :execrows
 DELETE FROM label
 WHERE
-  id = sqlc.arg('id');
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id');
 
 -- name: ListLabelsByOrganization :many
 SELECT
@@ -61,7 +53,7 @@ SELECT
 FROM
   label
 WHERE
-  organization_id = sqlc.arg('organization_id')
+  organization_id = sqlc.arg('tenant_id')
 ORDER BY
   created_at DESC;
 -- name: GetLabelByName :one
@@ -71,6 +63,6 @@ FROM
   label
 WHERE
   name = sqlc.arg('name') AND
-  organization_id = sqlc.arg('organization_id')
+  organization_id = sqlc.arg('tenant_id')
 LIMIT
   1;
\ No newline at end of file
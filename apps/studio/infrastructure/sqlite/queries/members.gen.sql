@@ -2,12 +2,13 @@
 
 -- name: CreateMember :one
 INSERT INTO
-  member (id, organization_id, role, user_id)
+  member (id, organization_id, role, role_id, user_id)
 VALUES
   (
     $1,
     sqlc.arg('organization_id'),
     sqlc.arg('role'),
+    sqlc.narg('role_id'),
     sqlc.arg('user_id')
   )
 RETURNING
@@ -20,42 +21,52 @@ FROM
   member
 WHERE
   id = sqlc.arg('id')
+  AND deleted_at IS NULL
 LIMIT
   1;
 
--- name: ListMembers :many
-SELECT
-  *
-FROM
-  member
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountMembers :one
 SELECT
   COUNT(*)
 FROM
-  member;
+  member
+WHERE
+  deleted_at IS NULL;
 
 -- name: UpdateMember :one
 UPDATE member
 SET
   organization_id = COALESCE(sqlc.narg('organization_id'), organization_id),
   role = COALESCE(sqlc.narg('role'), role),
+  role_id = COALESCE(sqlc.narg('role_id'), role_id),
   user_id = COALESCE(sqlc.narg('user_id'), user_id)
 WHERE
   id = sqlc.arg('id')
+  AND deleted_at IS NULL
 RETURNING
   *;
 
--- name: DeleteMember :exec
+-- name: DeleteMember :execrows
+UPDATE member
+SET
+  deleted_at = CURRENT_TIMESTAMP
+WHERE
+  id = sqlc.arg('id')
+  AND deleted_at IS NULL;
+
+-- name: RestoreMember :execrows
+UPDATE member
+SET
+  deleted_at = NULL
+WHERE
+  id = sqlc.arg('id')
+  AND deleted_at IS NOT NULL;
+
+-- name: PurgeMembers :execrows
 DELETE FROM member
 WHERE
-  id = sqlc.arg('id');
+  deleted_at IS NOT NULL
+  AND deleted_at < sqlc.arg('before')::timestamptz;
 
 -- name: ListMembersByOrganization :many
 SELECT
@@ -63,7 +74,8 @@ SELECT
 FROM
   member
 WHERE
-  organization_id = sqlc.arg('organization_id')
+  organization_id = sqlc.arg('organization_id') AND
+  deleted_at IS NULL
 ORDER BY
   created_at DESC;
 -- name: ListMembersByUser :many
@@ -72,7 +84,8 @@ SELECT
 FROM
   member
 WHERE
-  user_id = sqlc.arg('user_id')
+  user_id = sqlc.arg('user_id') AND
+  deleted_at IS NULL
 ORDER BY
   created_at DESC;
 -- name: GetMemberByUserAndOrganization :one
@@ -82,6 +95,7 @@ FROM
   member
 WHERE
   user_id = sqlc.arg('user_id') AND
-  organization_id = sqlc.arg('organization_id')
+  organization_id = sqlc.arg('organization_id') AND
+  deleted_at IS NULL
 LIMIT
   1;
\ No newline at end of file
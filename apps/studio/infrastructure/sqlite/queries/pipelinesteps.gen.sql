@@ -2,10 +2,11 @@
 
 -- name: CreatePipelineStep :one
 INSERT INTO
-  pipeline_step (id, pipeline_id, tool_id)
+  pipeline_step (id, config, pipeline_id, tool_id)
 VALUES
   (
     $1,
+    sqlc.narg('config'),
     sqlc.arg('pipeline_id'),
     sqlc.arg('tool_id')
   )
@@ -22,18 +23,6 @@ WHERE
 LIMIT
   1;
 
--- name: ListPipelineSteps :many
-SELECT
-  *
-FROM
-  pipeline_step
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountPipelineSteps :one
 SELECT
   COUNT(*)
@@ -43,6 +32,7 @@ FROM
 -- name: UpdatePipelineStep :one
 UPDATE pipeline_step
 SET
+  config = COALESCE(sqlc.narg('config'), config),
   pipeline_id = COALESCE(sqlc.narg('pipeline_id'), pipeline_id),
   tool_id = COALESCE(sqlc.narg('tool_id'), tool_id)
 WHERE
@@ -50,7 +40,7 @@ WHERE
 RETURNING
   *;
 
--- name: DeletePipelineStep :exec
+-- name: DeletePipelineStep :execrows
 DELETE FROM pipeline_step
 WHERE
   id = sqlc.arg('id');
\ No newline at end of file
@@ -0,0 +1,52 @@
+
+
+-- name: CreateRole :one
+INSERT INTO
+  role (id, description, name, organization_id, permissions)
+VALUES
+  (
+    $1,
+    sqlc.narg('description'),
+    sqlc.arg('name'),
+    sqlc.arg('organization_id'),
+    sqlc.arg('permissions')
+  )
+RETURNING
+  *;
+
+-- name: GetRole :one
+SELECT
+  *
+FROM
+  role
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+LIMIT
+  1;
+
+-- name: CountRoles :one
+SELECT
+  COUNT(*)
+FROM
+  role
+WHERE
+  organization_id = sqlc.arg('tenant_id');
+
+-- name: UpdateRole :one
+UPDATE role
+SET
+  description = COALESCE(sqlc.narg('description'), description),
+  name = COALESCE(sqlc.narg('name'), name),
+  permissions = COALESCE(sqlc.narg('permissions'), permissions)
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+RETURNING
+  *;
+
+-- name: DeleteRole :execrows
+DELETE FROM role
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id');
\ No newline at end of file
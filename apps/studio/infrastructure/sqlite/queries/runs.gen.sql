@@ -25,33 +25,23 @@ FROM
   run
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 LIMIT
   1;
 
--- name: ListRuns :many
-SELECT
-  *
-FROM
-  run
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountRuns :one
 SELECT
   COUNT(*)
 FROM
-  run;
+  run
+WHERE
+  organization_id = sqlc.arg('tenant_id');
 
 -- name: UpdateRun :one
 UPDATE run
 SET
   completed_at = COALESCE(sqlc.narg('completed_at'), completed_at),
   error = COALESCE(sqlc.narg('error'), error),
-  organization_id = COALESCE(sqlc.narg('organization_id'), organization_id),
   pipeline_id = COALESCE(sqlc.narg('pipeline_id'), pipeline_id),
   progress = COALESCE(sqlc.narg('progress'), progress),
   started_at = COALESCE(sqlc.narg('started_at'), started_at),
@@ -59,13 +49,15 @@ SET
   tool_id = COALESCE(sqlc.narg('tool_id'), tool_id)
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 RETURNING
   *;
 
--- name: DeleteRun :exec
+-- name: DeleteRun :execrows
 DELETE FROM run
 WHERE
-  id = sqlc.arg('id');
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id');
 
 -- name: ListRunsByPipeline :many
 SELECT
@@ -73,7 +65,8 @@ SELECT
 FROM
   run
 WHERE
-  pipeline_id = sqlc.arg('pipeline_id')
+  pipeline_id = sqlc.arg('pipeline_id') AND
+  organization_id = sqlc.arg('tenant_id')
 ORDER BY
   created_at DESC;
 -- name: ListRunsByOrganization :many
@@ -82,7 +75,7 @@ SELECT
 FROM
   run
 WHERE
-  organization_id = sqlc.arg('organization_id')
+  organization_id = sqlc.arg('tenant_id')
 ORDER BY
   created_at DESC;
 -- name: ListRunsByTool :many
@@ -91,6 +84,7 @@ SELECT
 FROM
   run
 WHERE
-  tool_id = sqlc.arg('tool_id')
+  tool_id = sqlc.arg('tool_id') AND
+  organization_id = sqlc.arg('tenant_id')
 ORDER BY
   created_at DESC;
\ No newline at end of file
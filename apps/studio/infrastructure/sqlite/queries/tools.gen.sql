@@ -22,26 +22,17 @@ FROM
   tool
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 LIMIT
   1;
 
--- name: ListTools :many
-SELECT
-  *
-FROM
-  tool
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountTools :one
 SELECT
   COUNT(*)
 FROM
-  tool;
+  tool
+WHERE
+  organization_id = sqlc.arg('tenant_id');
 
 -- name: UpdateTool :one
 UPDATE tool
@@ -49,17 +40,18 @@ SET
   description = COALESCE(sqlc.narg('description'), description),
   input_mime_type = COALESCE(sqlc.narg('input_mime_type'), input_mime_type),
   name = COALESCE(sqlc.narg('name'), name),
-  organization_id = COALESCE(sqlc.narg('organization_id'), organization_id),
   output_mime_type = COALESCE(sqlc.narg('output_mime_type'), output_mime_type)
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 RETURNING
   *;
 
--- name: DeleteTool :exec
+-- name: DeleteTool :execrows
 DELETE FROM tool
 WHERE
-  id = sqlc.arg('id');
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id');
 
 -- name: ListToolsByOrganization :many
 SELECT
@@ -67,6 +59,6 @@ SELECT
 FROM
   tool
 WHERE
-  organization_id = sqlc.arg('organization_id')
+  organization_id = sqlc.arg('tenant_id')
 ORDER BY
   created_at DESC;
\ No newline at end of file
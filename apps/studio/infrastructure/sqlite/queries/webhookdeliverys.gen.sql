@@ -0,0 +1,66 @@
+
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO
+  webhook_delivery (id, attempts, endpoint_id, event_id, event_type, last_attempt_at, last_error, next_attempt_at, organization_id, payload, response_status, status)
+VALUES
+  (
+    $1,
+    sqlc.arg('attempts'),
+    sqlc.arg('endpoint_id'),
+    sqlc.arg('event_id'),
+    sqlc.arg('event_type'),
+    sqlc.narg('last_attempt_at'),
+    sqlc.narg('last_error'),
+    sqlc.narg('next_attempt_at'),
+    sqlc.arg('organization_id'),
+    sqlc.arg('payload'),
+    sqlc.narg('response_status'),
+    sqlc.arg('status')
+  )
+RETURNING
+  *;
+
+-- name: GetWebhookDelivery :one
+SELECT
+  *
+FROM
+  webhook_delivery
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+LIMIT
+  1;
+
+-- name: CountWebhookDeliverys :one
+SELECT
+  COUNT(*)
+FROM
+  webhook_delivery
+WHERE
+  organization_id = sqlc.arg('tenant_id');
+
+-- name: UpdateWebhookDelivery :one
+UPDATE webhook_delivery
+SET
+  attempts = COALESCE(sqlc.narg('attempts'), attempts),
+  endpoint_id = COALESCE(sqlc.narg('endpoint_id'), endpoint_id),
+  event_id = COALESCE(sqlc.narg('event_id'), event_id),
+  event_type = COALESCE(sqlc.narg('event_type'), event_type),
+  last_attempt_at = COALESCE(sqlc.narg('last_attempt_at'), last_attempt_at),
+  last_error = COALESCE(sqlc.narg('last_error'), last_error),
+  next_attempt_at = COALESCE(sqlc.narg('next_attempt_at'), next_attempt_at),
+  payload = COALESCE(sqlc.narg('payload'), payload),
+  response_status = COALESCE(sqlc.narg('response_status'), response_status),
+  status = COALESCE(sqlc.narg('status'), status)
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+RETURNING
+  *;
+
+-- name: DeleteWebhookDelivery :execrows
+DELETE FROM webhook_delivery
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id');
\ No newline at end of file
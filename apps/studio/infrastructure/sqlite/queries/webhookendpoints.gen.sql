@@ -0,0 +1,60 @@
+
+
+-- name: CreateWebhookEndpoint :one
+INSERT INTO
+  webhook_endpoint (id, description, disabled_at, enabled, event_types, failure_count, organization_id, secret, url)
+VALUES
+  (
+    $1,
+    sqlc.narg('description'),
+    sqlc.narg('disabled_at'),
+    sqlc.arg('enabled'),
+    sqlc.arg('event_types'),
+    sqlc.arg('failure_count'),
+    sqlc.arg('organization_id'),
+    sqlc.arg('secret'),
+    sqlc.arg('url')
+  )
+RETURNING
+  *;
+
+-- name: GetWebhookEndpoint :one
+SELECT
+  *
+FROM
+  webhook_endpoint
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+LIMIT
+  1;
+
+-- name: CountWebhookEndpoints :one
+SELECT
+  COUNT(*)
+FROM
+  webhook_endpoint
+WHERE
+  organization_id = sqlc.arg('tenant_id');
+
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoint
+SET
+  description = COALESCE(sqlc.narg('description'), description),
+  disabled_at = COALESCE(sqlc.narg('disabled_at'), disabled_at),
+  enabled = COALESCE(sqlc.narg('enabled'), enabled),
+  event_types = COALESCE(sqlc.narg('event_types'), event_types),
+  failure_count = COALESCE(sqlc.narg('failure_count'), failure_count),
+  secret = COALESCE(sqlc.narg('secret'), secret),
+  url = COALESCE(sqlc.narg('url'), url)
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
+RETURNING
+  *;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoint
+WHERE
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id');
\ No newline at end of file
@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 )
 
 // SQLiteAPIKeyRepository implements APIKeyRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteAPIKeyRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteAPIKeyRepository creates a new SQLite repository.
 func NewSQLiteAPIKeyRepository(db *sql.DB) *SQLiteAPIKeyRepository {
 	return &SQLiteAPIKeyRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,36 +33,208 @@ func NewSQLiteAPIKeyRepository(db *sql.DB) *SQLiteAPIKeyRepository {
 // APIKey operations
 
 // Create creates a new apikey
-func (r *SQLiteAPIKeyRepository) Create(ctx context.Context, apikey *models.APIKey) (*models.APIKey, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per apikey
-	return nil, fmt.Errorf("CreateAPIKey not yet implemented - requires custom mapping")
+func (r *SQLiteAPIKeyRepository) Create(ctx context.Context, entity *models.APIKey) (*models.APIKey, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewAPIKeyCreatedEvent(entity.ID))
+
+	var result *models.APIKey
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "api_key" (id, created_at, updated_at, expires_at, key_hash, name, organization_id, prefix, rate_limit, scopes, user_id)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, expires_at, key_hash, last_used_at, name, organization_id, prefix, rate_limit, scopes, user_id`,
+			entity.ID, now, now,
+			database.SQLiteNullTimeValue(entity.ExpiresAt),
+			entity.KeyHash,
+			entity.Name,
+			tenantID.String(),
+			entity.Prefix,
+			entity.RateLimit,
+			database.JSONValue(entity.Scopes),
+			entity.UserID,
+		)
+
+		created, err := scanAPIKey(row)
+		if err != nil {
+			return fmt.Errorf("failed to create apikey: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a apikey by ID
 func (r *SQLiteAPIKeyRepository) Get(ctx context.Context, id uuid.UUID) (*models.APIKey, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per apikey
-	return nil, fmt.Errorf("GetAPIKey not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, expires_at, key_hash, last_used_at, name, organization_id, prefix, rate_limit, scopes, user_id FROM "api_key" WHERE id = ? AND organization_id = ?`,
+		id.String(),
+		tenantID.String(),
+	)
+
+	result, err := scanAPIKey(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get apikey: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing apikey
-func (r *SQLiteAPIKeyRepository) Update(ctx context.Context, id uuid.UUID, apikey *models.APIKey) (*models.APIKey, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per apikey
-	return nil, fmt.Errorf("UpdateAPIKey not yet implemented - requires custom mapping")
+// Update updates an existing apikey. Fields that are nil are left unchanged.
+func (r *SQLiteAPIKeyRepository) Update(ctx context.Context, id uuid.UUID, entity *models.APIKey) (*models.APIKey, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewAPIKeyUpdatedEvent(id))
+
+	var result *models.APIKey
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "api_key"
+			SET expires_at = COALESCE(?, expires_at), name = COALESCE(?, name), rate_limit = COALESCE(?, rate_limit), scopes = COALESCE(?, scopes)
+			WHERE id = ? AND organization_id = ?
+			RETURNING id, created_at, updated_at, expires_at, key_hash, last_used_at, name, organization_id, prefix, rate_limit, scopes, user_id`,
+			database.SQLiteNullTimeValue(entity.ExpiresAt),
+			entity.Name,
+			entity.RateLimit,
+			database.JSONValue(entity.Scopes),
+			id.String(),
+			tenantID.String(),
+		)
+
+		updated, err := scanAPIKey(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrAPIKeyNotFound
+			}
+			return fmt.Errorf("failed to update apikey: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a apikey
 func (r *SQLiteAPIKeyRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per apikey
-	return fmt.Errorf("DeleteAPIKey not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "api_key" WHERE id = ? AND organization_id = ?`,
+			id.String(),
+			tenantID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete apikey: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete apikey: %w", err)
+		}
+		if n == 0 {
+			return models.ErrAPIKeyNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewAPIKeyDeletedEvent(id)})
+	})
+}
+
+// List returns a filtered, sorted and paginated list of apikeys
+func (r *SQLiteAPIKeyRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.APIKey, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	opts.Select = apikeySelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "api_key", apikeyColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list apikeys: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.APIKey
+	for rows.Next() {
+		item, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list apikeys: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list apikeys: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count apikeys: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
-// List returns a paginated list of apikeys
-func (r *SQLiteAPIKeyRepository) List(ctx context.Context, limit, offset int32) ([]*models.APIKey, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per apikey
-	return nil, 0, fmt.Errorf("ListAPIKeys not yet implemented - requires custom mapping")
+// apikeySelectColumns are the columns scanAPIKey reads, in order.
+var apikeySelectColumns = []string{"id", "created_at", "updated_at", "expires_at", "key_hash", "last_used_at", "name", "organization_id", "prefix", "rate_limit", "scopes", "user_id"}
+
+// apikeyColumns maps APIKey fields to the columns List can filter and sort on.
+var apikeyColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"expiresAt":      {Name: "expires_at", Kind: database.ColumnTime},
+	"keyHash":        {Name: "key_hash", Kind: database.ColumnString},
+	"lastUsedAt":     {Name: "last_used_at", Kind: database.ColumnTime},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"prefix":         {Name: "prefix", Kind: database.ColumnString},
+	"rateLimit":      {Name: "rate_limit", Kind: database.ColumnInteger},
+	"userID":         {Name: "user_id", Kind: database.ColumnUUID},
+}
+
+// scanAPIKey reads a apikey row of apikeySelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanAPIKey(row interface{ Scan(dest ...any) error }) (*models.APIKey, error) {
+	var entity models.APIKey
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		database.SQLiteNullTime(&entity.ExpiresAt),
+		&entity.KeyHash,
+		database.SQLiteNullTime(&entity.LastUsedAt),
+		&entity.Name,
+		&entity.OrganizationID,
+		&entity.Prefix,
+		&entity.RateLimit,
+		database.JSONColumn(&entity.Scopes),
+		&entity.UserID,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
@@ -5,20 +5,29 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/google/uuid"
 )
 
 // SQLiteArtifactRepository implements ArtifactRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteArtifactRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteArtifactRepository creates a new SQLite repository.
 func NewSQLiteArtifactRepository(db *sql.DB) *SQLiteArtifactRepository {
 	return &SQLiteArtifactRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,50 +35,409 @@ func NewSQLiteArtifactRepository(db *sql.DB) *SQLiteArtifactRepository {
 // Artifact operations
 
 // Create creates a new artifact
-func (r *SQLiteArtifactRepository) Create(ctx context.Context, artifact *models.Artifact) (*models.Artifact, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per artifact
-	return nil, fmt.Errorf("CreateArtifact not yet implemented - requires custom mapping")
+func (r *SQLiteArtifactRepository) Create(ctx context.Context, entity *models.Artifact) (*models.Artifact, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewArtifactCreatedEvent(entity.ID))
+
+	var result *models.Artifact
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "artifact" (id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url`,
+			entity.ID, now, now,
+			entity.Credits,
+			entity.Description,
+			entity.MimeType,
+			entity.Name,
+			tenantID.String(),
+			entity.PreviewImage,
+			entity.ProducerID,
+			entity.Text,
+			entity.URL,
+		)
+
+		created, err := scanArtifact(row)
+		if err != nil {
+			return fmt.Errorf("failed to create artifact: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a artifact by ID
 func (r *SQLiteArtifactRepository) Get(ctx context.Context, id uuid.UUID) (*models.Artifact, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per artifact
-	return nil, fmt.Errorf("GetArtifact not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url FROM "artifact" WHERE id = ? AND organization_id = ? AND deleted_at IS NULL`,
+		id.String(),
+		tenantID.String(),
+	)
+
+	result, err := scanArtifact(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrArtifactNotFound
+		}
+		return nil, fmt.Errorf("failed to get artifact: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing artifact
-func (r *SQLiteArtifactRepository) Update(ctx context.Context, id uuid.UUID, artifact *models.Artifact) (*models.Artifact, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per artifact
-	return nil, fmt.Errorf("UpdateArtifact not yet implemented - requires custom mapping")
+// Update updates an existing artifact. Fields that are nil are left unchanged.
+func (r *SQLiteArtifactRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Artifact) (*models.Artifact, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewArtifactUpdatedEvent(id))
+
+	var result *models.Artifact
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "artifact"
+			SET credits = COALESCE(?, credits), description = COALESCE(?, description), mime_type = COALESCE(?, mime_type), name = COALESCE(?, name), preview_image = COALESCE(?, preview_image), text = COALESCE(?, text), url = COALESCE(?, url)
+			WHERE id = ? AND organization_id = ? AND deleted_at IS NULL
+			RETURNING id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url`,
+			entity.Credits,
+			entity.Description,
+			entity.MimeType,
+			entity.Name,
+			entity.PreviewImage,
+			entity.Text,
+			entity.URL,
+			id.String(),
+			tenantID.String(),
+		)
+
+		updated, err := scanArtifact(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrArtifactNotFound
+			}
+			return fmt.Errorf("failed to update artifact: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
-// Delete removes a artifact
+// Delete marks a artifact as deleted
 func (r *SQLiteArtifactRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per artifact
-	return fmt.Errorf("DeleteArtifact not yet implemented - requires custom mapping")
+	return r.setDeletedAt(ctx, id, "CURRENT_TIMESTAMP", "deleted_at IS NULL", models.NewArtifactDeletedEvent(id))
+}
+
+// Restore clears the deletion mark of a deleted artifact
+func (r *SQLiteArtifactRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	return r.setDeletedAt(ctx, id, "NULL", "deleted_at IS NOT NULL", models.NewArtifactUpdatedEvent(id))
+}
+
+// setDeletedAt updates the deleted_at column of a artifact in the given state and records event.
+func (r *SQLiteArtifactRepository) setDeletedAt(ctx context.Context, id uuid.UUID, value, state string, event events.Event) error {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`UPDATE "artifact" SET deleted_at = `+value+` WHERE id = ? AND organization_id = ? AND `+state,
+			id.String(),
+			tenantID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update artifact: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to update artifact: %w", err)
+		}
+		if n == 0 {
+			return models.ErrArtifactNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{event})
+	})
+}
+
+// List returns a filtered, sorted and paginated list of artifacts that are not deleted
+func (r *SQLiteArtifactRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error) {
+	opts.Deleted = database.DeletedExclude
+	return r.list(ctx, opts)
 }
 
-// List returns a paginated list of artifacts
-func (r *SQLiteArtifactRepository) List(ctx context.Context, limit, offset int32) ([]*models.Artifact, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per artifact
-	return nil, 0, fmt.Errorf("ListArtifacts not yet implemented - requires custom mapping")
+// ListDeleted returns a filtered, sorted and paginated list of deleted artifacts
+func (r *SQLiteArtifactRepository) ListDeleted(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error) {
+	opts.Deleted = database.DeletedOnly
+	return r.list(ctx, opts)
+}
+
+// Purge removes the artifacts deleted before the given time, in all organizations.
+// Purged rows cannot be restored and record no events.
+func (r *SQLiteArtifactRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	res, err := database.SQLConn(ctx, r.db).ExecContext(ctx,
+		`DELETE FROM "artifact" WHERE deleted_at IS NOT NULL AND deleted_at < ?`,
+		database.SQLiteTimeValue(before),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge artifacts: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge artifacts: %w", err)
+	}
+	return n, nil
+}
+
+func (r *SQLiteArtifactRepository) list(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	opts.SearchIndex = artifactSearchIndex
+	opts.Select = artifactSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "artifact", artifactColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Artifact
+	for rows.Next() {
+		item, err := scanArtifact(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list artifacts: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count artifacts: %w", err)
+	}
+
+	info := database.PageInfo{Total: total}
+	if query.SnippetSQL != "" {
+		rows, err := conn.QueryContext(ctx, query.SnippetSQL, query.SnippetArgs...)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to highlight artifacts: %w", err)
+		}
+		defer func() { _ = rows.Close() }()
+		if info.Snippets, err = database.CollectSnippets(rows); err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to highlight artifacts: %w", err)
+		}
+	}
+	return items, info, nil
+}
+
+// AddLabel associates a label with a artifact. It returns
+// models.ErrLabelNotFound if the label does not exist in the tenant of the context.
+func (r *SQLiteArtifactRepository) AddLabel(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error {
+	if _, err := r.Get(ctx, id); err != nil {
+		return err
+	}
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		// Re-adding an association updates it in place, so a row is affected
+		// unless the label is missing
+		result, err := tx.ExecContext(ctx,
+			`INSERT INTO "artifact_labels" (artifact_id, label_id) SELECT ?, id FROM "label" WHERE id = ? AND organization_id = ? ON CONFLICT (artifact_id, label_id) DO UPDATE SET artifact_id = excluded.artifact_id`,
+			id.String(), labelID.String(), tenantID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to add label to artifact: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to add label to artifact: %w", err)
+		}
+		if affected == 0 {
+			return models.ErrLabelNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewArtifactUpdatedEvent(id)})
+	})
+}
+
+// RemoveLabel removes the association between a artifact and a label
+func (r *SQLiteArtifactRepository) RemoveLabel(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error {
+	if _, err := r.Get(ctx, id); err != nil {
+		return err
+	}
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx,
+			`DELETE FROM "artifact_labels" WHERE artifact_id = ? AND label_id = ?`,
+			id.String(), labelID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to remove label from artifact: %w", err)
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewArtifactUpdatedEvent(id)})
+	})
+}
+
+// ListLabels returns the labels associated with a artifact
+func (r *SQLiteArtifactRepository) ListLabels(ctx context.Context, id uuid.UUID) ([]*models.Label, error) {
+	if _, err := r.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx,
+		"SELECT "+strings.Join(labelSelectColumns, ", ")+
+			` FROM "label" WHERE id IN (SELECT label_id FROM "artifact_labels" WHERE artifact_id = ?) AND organization_id = ? ORDER BY created_at DESC`,
+		id.String(), tenantID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifact labels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Label
+	for rows.Next() {
+		item, err := scanLabel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list artifact labels: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list artifact labels: %w", err)
+	}
+	return items, nil
 }
 
 // Additional methods
 
 // ListArtifactsByOrganization retrieves multiple artifacts by organizationID
 func (r *SQLiteArtifactRepository) ListArtifactsByOrganization(ctx context.Context, organizationID string) ([]*models.Artifact, error) {
-	// TODO: Implement ListArtifactsByOrganization
-	return nil, fmt.Errorf("ListArtifactsByOrganization not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
+	query := `SELECT id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url FROM "artifact" WHERE organization_id = ? AND deleted_at IS NULL ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListArtifactsByOrganization: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Artifact
+	for rows.Next() {
+		item, err := scanArtifact(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListArtifactsByOrganization: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListArtifactsByOrganization: %w", err)
+	}
+	return items, nil
 }
 
 // ListArtifactsByProducer retrieves multiple artifacts by producerID
 func (r *SQLiteArtifactRepository) ListArtifactsByProducer(ctx context.Context, producerID string) ([]*models.Artifact, error) {
-	// TODO: Implement ListArtifactsByProducer
-	return nil, fmt.Errorf("ListArtifactsByProducer not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, created_at, updated_at, credits, description, mime_type, name, organization_id, preview_image, producer_id, text, url FROM "artifact" WHERE producer_id = ? AND organization_id = ? AND deleted_at IS NULL ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, producerID, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListArtifactsByProducer: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Artifact
+	for rows.Next() {
+		item, err := scanArtifact(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListArtifactsByProducer: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListArtifactsByProducer: %w", err)
+	}
+	return items, nil
+}
+
+// artifactSelectColumns are the columns scanArtifact reads, in order.
+var artifactSelectColumns = []string{"id", "created_at", "updated_at", "credits", "description", "mime_type", "name", "organization_id", "preview_image", "producer_id", "text", "url"}
+
+// artifactColumns maps Artifact fields to the columns List can filter and sort on.
+var artifactColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"credits":        {Name: "credits", Kind: database.ColumnInteger},
+	"description":    {Name: "description", Kind: database.ColumnString},
+	"mimeType":       {Name: "mime_type", Kind: database.ColumnString},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"previewImage":   {Name: "preview_image", Kind: database.ColumnString},
+	"producerID":     {Name: "producer_id", Kind: database.ColumnUUID},
+	"text":           {Name: "text", Kind: database.ColumnString},
+	"url":            {Name: "url", Kind: database.ColumnString},
+}
+
+// artifactSearchIndex describes the full-text index List searches.
+var artifactSearchIndex = &database.SearchIndex{
+	Columns: []string{"text"},
+}
+
+// scanArtifact reads a artifact row of artifactSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanArtifact(row interface{ Scan(dest ...any) error }) (*models.Artifact, error) {
+	var entity models.Artifact
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.Credits,
+		&entity.Description,
+		&entity.MimeType,
+		&entity.Name,
+		&entity.OrganizationID,
+		&entity.PreviewImage,
+		&entity.ProducerID,
+		&entity.Text,
+		&entity.URL,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
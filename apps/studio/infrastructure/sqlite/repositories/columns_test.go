@@ -0,0 +1,52 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/apps/studio/infrastructure/sqlite"
+	"github.com/archesai/archesai/apps/studio/infrastructure/sqlite/repositories"
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/database/databasetest"
+)
+
+// Columns added by hand after the generated migrations come last in the
+// table, which must not shift the columns the repository reads.
+func TestRepositoryReadsColumnsByName(t *testing.T) {
+	db := databasetest.SQLite(t, sqlite.Migrations)
+	ctx := context.Background()
+	_, err := db.ExecContext(ctx, `ALTER TABLE "user" ADD COLUMN nickname TEXT NOT NULL DEFAULT 'anon'`)
+	require.NoError(t, err)
+
+	repo := repositories.NewSQLiteUserRepository(db)
+	user, err := models.NewUser("jane@example.com", true, nil, "Jane")
+	require.NoError(t, err)
+
+	created, err := repo.Create(ctx, user)
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", created.Email)
+
+	got, err := repo.Get(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Jane", got.Name)
+	assert.True(t, got.EmailVerified)
+
+	name := "Janet"
+	got.Name = name
+	updated, err := repo.Update(ctx, user.ID, got)
+	require.NoError(t, err)
+	assert.Equal(t, name, updated.Name)
+
+	byEmail, err := repo.GetUserByEmail(ctx, "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, byEmail.ID)
+
+	users, _, err := repo.List(ctx, database.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, name, users[0].Name)
+}
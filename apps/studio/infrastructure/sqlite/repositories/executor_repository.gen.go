@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/google/uuid"
 )
 
 // SQLiteExecutorRepository implements ExecutorRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteExecutorRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteExecutorRepository creates a new SQLite repository.
 func NewSQLiteExecutorRepository(db *sql.DB) *SQLiteExecutorRepository {
 	return &SQLiteExecutorRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,44 +33,270 @@ func NewSQLiteExecutorRepository(db *sql.DB) *SQLiteExecutorRepository {
 // Executor operations
 
 // Create creates a new executor
-func (r *SQLiteExecutorRepository) Create(ctx context.Context, executor *models.Executor) (*models.Executor, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per executor
-	return nil, fmt.Errorf("CreateExecutor not yet implemented - requires custom mapping")
+func (r *SQLiteExecutorRepository) Create(ctx context.Context, entity *models.Executor) (*models.Executor, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewExecutorCreatedEvent(entity.ID))
+
+	var result *models.Executor
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "executor" (id, created_at, updated_at, cpu_shares, dependencies, description, env, execute_code, extra_files, is_active, language, memory_mb, name, organization_id, schema_in, schema_out, timeout, version)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, cpu_shares, dependencies, description, env, execute_code, extra_files, is_active, language, memory_mb, name, organization_id, schema_in, schema_out, timeout, version`,
+			entity.ID, now, now,
+			entity.CPUShares,
+			entity.Dependencies,
+			entity.Description,
+			entity.Env,
+			entity.ExecuteCode,
+			entity.ExtraFiles,
+			entity.IsActive,
+			entity.Language,
+			entity.MemoryMB,
+			entity.Name,
+			tenantID.String(),
+			entity.SchemaIn,
+			entity.SchemaOut,
+			entity.Timeout,
+			entity.Version,
+		)
+
+		created, err := scanExecutor(row)
+		if err != nil {
+			return fmt.Errorf("failed to create executor: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a executor by ID
 func (r *SQLiteExecutorRepository) Get(ctx context.Context, id uuid.UUID) (*models.Executor, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per executor
-	return nil, fmt.Errorf("GetExecutor not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, cpu_shares, dependencies, description, env, execute_code, extra_files, is_active, language, memory_mb, name, organization_id, schema_in, schema_out, timeout, version FROM "executor" WHERE id = ? AND organization_id = ?`,
+		id.String(),
+		tenantID.String(),
+	)
+
+	result, err := scanExecutor(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrExecutorNotFound
+		}
+		return nil, fmt.Errorf("failed to get executor: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing executor
-func (r *SQLiteExecutorRepository) Update(ctx context.Context, id uuid.UUID, executor *models.Executor) (*models.Executor, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per executor
-	return nil, fmt.Errorf("UpdateExecutor not yet implemented - requires custom mapping")
+// Update updates an existing executor. Fields that are nil are left unchanged.
+func (r *SQLiteExecutorRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Executor) (*models.Executor, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewExecutorUpdatedEvent(id))
+
+	var result *models.Executor
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "executor"
+			SET cpu_shares = COALESCE(?, cpu_shares), dependencies = COALESCE(?, dependencies), description = COALESCE(?, description), env = COALESCE(?, env), execute_code = COALESCE(?, execute_code), extra_files = COALESCE(?, extra_files), is_active = COALESCE(?, is_active), language = COALESCE(?, language), memory_mb = COALESCE(?, memory_mb), name = COALESCE(?, name), schema_in = COALESCE(?, schema_in), schema_out = COALESCE(?, schema_out), timeout = COALESCE(?, timeout)
+			WHERE id = ? AND organization_id = ?
+			RETURNING id, created_at, updated_at, cpu_shares, dependencies, description, env, execute_code, extra_files, is_active, language, memory_mb, name, organization_id, schema_in, schema_out, timeout, version`,
+			entity.CPUShares,
+			entity.Dependencies,
+			entity.Description,
+			entity.Env,
+			entity.ExecuteCode,
+			entity.ExtraFiles,
+			entity.IsActive,
+			entity.Language,
+			entity.MemoryMB,
+			entity.Name,
+			entity.SchemaIn,
+			entity.SchemaOut,
+			entity.Timeout,
+			id.String(),
+			tenantID.String(),
+		)
+
+		updated, err := scanExecutor(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrExecutorNotFound
+			}
+			return fmt.Errorf("failed to update executor: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a executor
 func (r *SQLiteExecutorRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per executor
-	return fmt.Errorf("DeleteExecutor not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "executor" WHERE id = ? AND organization_id = ?`,
+			id.String(),
+			tenantID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete executor: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete executor: %w", err)
+		}
+		if n == 0 {
+			return models.ErrExecutorNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewExecutorDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of executors
-func (r *SQLiteExecutorRepository) List(ctx context.Context, limit, offset int32) ([]*models.Executor, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per executor
-	return nil, 0, fmt.Errorf("ListExecutors not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of executors
+func (r *SQLiteExecutorRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Executor, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	opts.Select = executorSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "executor", executorColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list executors: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Executor
+	for rows.Next() {
+		item, err := scanExecutor(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list executors: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list executors: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count executors: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // Additional methods
 
 // ListExecutorsByOrganization retrieves multiple executors by organizationID
 func (r *SQLiteExecutorRepository) ListExecutorsByOrganization(ctx context.Context, organizationID string) ([]*models.Executor, error) {
-	// TODO: Implement ListExecutorsByOrganization
-	return nil, fmt.Errorf("ListExecutorsByOrganization not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
+	query := `SELECT id, created_at, updated_at, cpu_shares, dependencies, description, env, execute_code, extra_files, is_active, language, memory_mb, name, organization_id, schema_in, schema_out, timeout, version FROM "executor" WHERE organization_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListExecutorsByOrganization: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Executor
+	for rows.Next() {
+		item, err := scanExecutor(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListExecutorsByOrganization: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListExecutorsByOrganization: %w", err)
+	}
+	return items, nil
+}
+
+// executorSelectColumns are the columns scanExecutor reads, in order.
+var executorSelectColumns = []string{"id", "created_at", "updated_at", "cpu_shares", "dependencies", "description", "env", "execute_code", "extra_files", "is_active", "language", "memory_mb", "name", "organization_id", "schema_in", "schema_out", "timeout", "version"}
+
+// executorColumns maps Executor fields to the columns List can filter and sort on.
+var executorColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"cpuShares":      {Name: "cpu_shares", Kind: database.ColumnInteger},
+	"dependencies":   {Name: "dependencies", Kind: database.ColumnString},
+	"description":    {Name: "description", Kind: database.ColumnString},
+	"env":            {Name: "env", Kind: database.ColumnString},
+	"executeCode":    {Name: "execute_code", Kind: database.ColumnString},
+	"extraFiles":     {Name: "extra_files", Kind: database.ColumnString},
+	"isActive":       {Name: "is_active", Kind: database.ColumnBool},
+	"language":       {Name: "language", Kind: database.ColumnString},
+	"memoryMB":       {Name: "memory_mb", Kind: database.ColumnInteger},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"schemaIn":       {Name: "schema_in", Kind: database.ColumnString},
+	"schemaOut":      {Name: "schema_out", Kind: database.ColumnString},
+	"timeout":        {Name: "timeout", Kind: database.ColumnInteger},
+	"version":        {Name: "version", Kind: database.ColumnInteger},
+}
+
+// scanExecutor reads a executor row of executorSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanExecutor(row interface{ Scan(dest ...any) error }) (*models.Executor, error) {
+	var entity models.Executor
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.CPUShares,
+		&entity.Dependencies,
+		&entity.Description,
+		&entity.Env,
+		&entity.ExecuteCode,
+		&entity.ExtraFiles,
+		&entity.IsActive,
+		&entity.Language,
+		&entity.MemoryMB,
+		&entity.Name,
+		&entity.OrganizationID,
+		&entity.SchemaIn,
+		&entity.SchemaOut,
+		&entity.Timeout,
+		&entity.Version,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/google/uuid"
 )
 
 // SQLiteLabelRepository implements LabelRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteLabelRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteLabelRepository creates a new SQLite repository.
 func NewSQLiteLabelRepository(db *sql.DB) *SQLiteLabelRepository {
 	return &SQLiteLabelRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,50 +33,240 @@ func NewSQLiteLabelRepository(db *sql.DB) *SQLiteLabelRepository {
 // Label operations
 
 // Create creates a new label
-func (r *SQLiteLabelRepository) Create(ctx context.Context, label *models.Label) (*models.Label, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per label
-	return nil, fmt.Errorf("CreateLabel not yet implemented - requires custom mapping")
+func (r *SQLiteLabelRepository) Create(ctx context.Context, entity *models.Label) (*models.Label, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewLabelCreatedEvent(entity.ID))
+
+	var result *models.Label
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "label" (id, created_at, updated_at, name, organization_id)
+			VALUES (?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, name, organization_id`,
+			entity.ID, now, now,
+			entity.Name,
+			tenantID.String(),
+		)
+
+		created, err := scanLabel(row)
+		if err != nil {
+			return fmt.Errorf("failed to create label: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a label by ID
 func (r *SQLiteLabelRepository) Get(ctx context.Context, id uuid.UUID) (*models.Label, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per label
-	return nil, fmt.Errorf("GetLabel not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, name, organization_id FROM "label" WHERE id = ? AND organization_id = ?`,
+		id.String(),
+		tenantID.String(),
+	)
+
+	result, err := scanLabel(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrLabelNotFound
+		}
+		return nil, fmt.Errorf("failed to get label: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing label
-func (r *SQLiteLabelRepository) Update(ctx context.Context, id uuid.UUID, label *models.Label) (*models.Label, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per label
-	return nil, fmt.Errorf("UpdateLabel not yet implemented - requires custom mapping")
+// Update updates an existing label. Fields that are nil are left unchanged.
+func (r *SQLiteLabelRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Label) (*models.Label, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewLabelUpdatedEvent(id))
+
+	var result *models.Label
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "label"
+			SET name = COALESCE(?, name)
+			WHERE id = ? AND organization_id = ?
+			RETURNING id, created_at, updated_at, name, organization_id`,
+			entity.Name,
+			id.String(),
+			tenantID.String(),
+		)
+
+		updated, err := scanLabel(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrLabelNotFound
+			}
+			return fmt.Errorf("failed to update label: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a label
 func (r *SQLiteLabelRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per label
-	return fmt.Errorf("DeleteLabel not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "label" WHERE id = ? AND organization_id = ?`,
+			id.String(),
+			tenantID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete label: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete label: %w", err)
+		}
+		if n == 0 {
+			return models.ErrLabelNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewLabelDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of labels
-func (r *SQLiteLabelRepository) List(ctx context.Context, limit, offset int32) ([]*models.Label, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per label
-	return nil, 0, fmt.Errorf("ListLabels not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of labels
+func (r *SQLiteLabelRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Label, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	opts.Select = labelSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "label", labelColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list labels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Label
+	for rows.Next() {
+		item, err := scanLabel(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list labels: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count labels: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // Additional methods
 
 // ListLabelsByOrganization retrieves multiple labels by organizationID
 func (r *SQLiteLabelRepository) ListLabelsByOrganization(ctx context.Context, organizationID string) ([]*models.Label, error) {
-	// TODO: Implement ListLabelsByOrganization
-	return nil, fmt.Errorf("ListLabelsByOrganization not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
+	query := `SELECT id, created_at, updated_at, name, organization_id FROM "label" WHERE organization_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListLabelsByOrganization: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Label
+	for rows.Next() {
+		item, err := scanLabel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListLabelsByOrganization: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListLabelsByOrganization: %w", err)
+	}
+	return items, nil
 }
 
 // GetLabelByName retrieves a single label by name and organizationID
 func (r *SQLiteLabelRepository) GetLabelByName(ctx context.Context, name string, organizationID string) (*models.Label, error) {
-	// TODO: Implement GetLabelByName
-	return nil, fmt.Errorf("GetLabelByName not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, models.ErrLabelNotFound
+	}
+	query := `SELECT id, created_at, updated_at, name, organization_id FROM "label" WHERE name = ? AND organization_id = ? LIMIT 1`
+	result, err := scanLabel(database.SQLConn(ctx, r.db).QueryRowContext(ctx, query, name, tenantID.String()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrLabelNotFound
+		}
+		return nil, fmt.Errorf("failed to GetLabelByName: %w", err)
+	}
+	return result, nil
+}
+
+// labelSelectColumns are the columns scanLabel reads, in order.
+var labelSelectColumns = []string{"id", "created_at", "updated_at", "name", "organization_id"}
+
+// labelColumns maps Label fields to the columns List can filter and sort on.
+var labelColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+}
+
+// scanLabel reads a label row of labelSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanLabel(row interface{ Scan(dest ...any) error }) (*models.Label, error) {
+	var entity models.Label
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.Name,
+		&entity.OrganizationID,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
@@ -5,20 +5,28 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 )
 
 // SQLiteOrganizationRepository implements OrganizationRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteOrganizationRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteOrganizationRepository creates a new SQLite repository.
 func NewSQLiteOrganizationRepository(db *sql.DB) *SQLiteOrganizationRepository {
 	return &SQLiteOrganizationRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,50 +34,247 @@ func NewSQLiteOrganizationRepository(db *sql.DB) *SQLiteOrganizationRepository {
 // Organization operations
 
 // Create creates a new organization
-func (r *SQLiteOrganizationRepository) Create(ctx context.Context, organization *models.Organization) (*models.Organization, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per organization
-	return nil, fmt.Errorf("CreateOrganization not yet implemented - requires custom mapping")
+func (r *SQLiteOrganizationRepository) Create(ctx context.Context, entity *models.Organization) (*models.Organization, error) {
+	pending := events.Ensure(entity.Events(), models.NewOrganizationCreatedEvent(entity.ID))
+
+	var result *models.Organization
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "organization" (id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier`,
+			entity.ID, now, now,
+			entity.BillingEmail,
+			entity.Credits,
+			entity.Logo,
+			entity.Name,
+			entity.Plan,
+			entity.Slug,
+			entity.StripeCustomerIdentifier,
+		)
+
+		created, err := scanOrganization(row)
+		if err != nil {
+			return fmt.Errorf("failed to create organization: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a organization by ID
 func (r *SQLiteOrganizationRepository) Get(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per organization
-	return nil, fmt.Errorf("GetOrganization not yet implemented - requires custom mapping")
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier FROM "organization" WHERE id = ? AND deleted_at IS NULL`,
+		id.String(),
+	)
+
+	result, err := scanOrganization(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing organization
-func (r *SQLiteOrganizationRepository) Update(ctx context.Context, id uuid.UUID, organization *models.Organization) (*models.Organization, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per organization
-	return nil, fmt.Errorf("UpdateOrganization not yet implemented - requires custom mapping")
+// Update updates an existing organization. Fields that are nil are left unchanged.
+func (r *SQLiteOrganizationRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Organization) (*models.Organization, error) {
+	pending := events.Ensure(entity.Events(), models.NewOrganizationUpdatedEvent(id))
+
+	var result *models.Organization
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "organization"
+			SET billing_email = COALESCE(?, billing_email), credits = COALESCE(?, credits), logo = COALESCE(?, logo), name = COALESCE(?, name), plan = COALESCE(?, plan)
+			WHERE id = ? AND deleted_at IS NULL
+			RETURNING id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier`,
+			entity.BillingEmail,
+			entity.Credits,
+			entity.Logo,
+			entity.Name,
+			entity.Plan,
+			id.String(),
+		)
+
+		updated, err := scanOrganization(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrOrganizationNotFound
+			}
+			return fmt.Errorf("failed to update organization: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
-// Delete removes a organization
+// Delete marks a organization as deleted
 func (r *SQLiteOrganizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per organization
-	return fmt.Errorf("DeleteOrganization not yet implemented - requires custom mapping")
+	return r.setDeletedAt(ctx, id, "CURRENT_TIMESTAMP", "deleted_at IS NULL", models.NewOrganizationDeletedEvent(id))
+}
+
+// Restore clears the deletion mark of a deleted organization
+func (r *SQLiteOrganizationRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	return r.setDeletedAt(ctx, id, "NULL", "deleted_at IS NOT NULL", models.NewOrganizationUpdatedEvent(id))
 }
 
-// List returns a paginated list of organizations
-func (r *SQLiteOrganizationRepository) List(ctx context.Context, limit, offset int32) ([]*models.Organization, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per organization
-	return nil, 0, fmt.Errorf("ListOrganizations not yet implemented - requires custom mapping")
+// setDeletedAt updates the deleted_at column of a organization in the given state and records event.
+func (r *SQLiteOrganizationRepository) setDeletedAt(ctx context.Context, id uuid.UUID, value, state string, event events.Event) error {
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`UPDATE "organization" SET deleted_at = `+value+` WHERE id = ? AND `+state,
+			id.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update organization: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to update organization: %w", err)
+		}
+		if n == 0 {
+			return models.ErrOrganizationNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{event})
+	})
+}
+
+// List returns a filtered, sorted and paginated list of organizations that are not deleted
+func (r *SQLiteOrganizationRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error) {
+	opts.Deleted = database.DeletedExclude
+	return r.list(ctx, opts)
+}
+
+// ListDeleted returns a filtered, sorted and paginated list of deleted organizations
+func (r *SQLiteOrganizationRepository) ListDeleted(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error) {
+	opts.Deleted = database.DeletedOnly
+	return r.list(ctx, opts)
+}
+
+// Purge removes the organizations deleted before the given time, in all organizations.
+// Purged rows cannot be restored and record no events.
+func (r *SQLiteOrganizationRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	res, err := database.SQLConn(ctx, r.db).ExecContext(ctx,
+		`DELETE FROM "organization" WHERE deleted_at IS NOT NULL AND deleted_at < ?`,
+		database.SQLiteTimeValue(before),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge organizations: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge organizations: %w", err)
+	}
+	return n, nil
+}
+
+func (r *SQLiteOrganizationRepository) list(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error) {
+	opts.Select = organizationSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "organization", organizationColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Organization
+	for rows.Next() {
+		item, err := scanOrganization(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list organizations: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count organizations: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // Additional methods
 
 // GetOrganizationBySlug retrieves a single organization by slug
 func (r *SQLiteOrganizationRepository) GetOrganizationBySlug(ctx context.Context, slug string) (*models.Organization, error) {
-	// TODO: Implement GetOrganizationBySlug
-	return nil, fmt.Errorf("GetOrganizationBySlug not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier FROM "organization" WHERE slug = ? AND deleted_at IS NULL LIMIT 1`
+	result, err := scanOrganization(database.SQLConn(ctx, r.db).QueryRowContext(ctx, query, slug))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to GetOrganizationBySlug: %w", err)
+	}
+	return result, nil
 }
 
 // GetOrganizationByStripeCustomerID retrieves a single organization by stripeCustomerIdentifier
 func (r *SQLiteOrganizationRepository) GetOrganizationByStripeCustomerID(ctx context.Context, stripeCustomerIdentifier string) (*models.Organization, error) {
-	// TODO: Implement GetOrganizationByStripeCustomerID
-	return nil, fmt.Errorf("GetOrganizationByStripeCustomerID not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, billing_email, credits, logo, name, plan, slug, stripe_customer_identifier FROM "organization" WHERE stripe_customer_identifier = ? AND deleted_at IS NULL LIMIT 1`
+	result, err := scanOrganization(database.SQLConn(ctx, r.db).QueryRowContext(ctx, query, stripeCustomerIdentifier))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to GetOrganizationByStripeCustomerID: %w", err)
+	}
+	return result, nil
+}
+
+// organizationSelectColumns are the columns scanOrganization reads, in order.
+var organizationSelectColumns = []string{"id", "created_at", "updated_at", "billing_email", "credits", "logo", "name", "plan", "slug", "stripe_customer_identifier"}
+
+// organizationColumns maps Organization fields to the columns List can filter and sort on.
+var organizationColumns = database.Columns{
+	"id":                       {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":                {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":                {Name: "updated_at", Kind: database.ColumnTime},
+	"billingEmail":             {Name: "billing_email", Kind: database.ColumnString},
+	"credits":                  {Name: "credits", Kind: database.ColumnInteger},
+	"logo":                     {Name: "logo", Kind: database.ColumnString},
+	"name":                     {Name: "name", Kind: database.ColumnString},
+	"plan":                     {Name: "plan", Kind: database.ColumnString},
+	"slug":                     {Name: "slug", Kind: database.ColumnString},
+	"stripeCustomerIdentifier": {Name: "stripe_customer_identifier", Kind: database.ColumnString},
+}
+
+// scanOrganization reads a organization row of organizationSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanOrganization(row interface{ Scan(dest ...any) error }) (*models.Organization, error) {
+	var entity models.Organization
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.BillingEmail,
+		&entity.Credits,
+		&entity.Logo,
+		&entity.Name,
+		&entity.Plan,
+		&entity.Slug,
+		&entity.StripeCustomerIdentifier,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
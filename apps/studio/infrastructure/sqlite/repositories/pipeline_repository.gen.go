@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
 
 // SQLitePipelineRepository implements PipelineRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLitePipelineRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLitePipelineRepository creates a new SQLite repository.
 func NewSQLitePipelineRepository(db *sql.DB) *SQLitePipelineRepository {
 	return &SQLitePipelineRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,44 +33,240 @@ func NewSQLitePipelineRepository(db *sql.DB) *SQLitePipelineRepository {
 // Pipeline operations
 
 // Create creates a new pipeline
-func (r *SQLitePipelineRepository) Create(ctx context.Context, pipeline *models.Pipeline) (*models.Pipeline, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipeline
-	return nil, fmt.Errorf("CreatePipeline not yet implemented - requires custom mapping")
+func (r *SQLitePipelineRepository) Create(ctx context.Context, entity *models.Pipeline) (*models.Pipeline, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewPipelineCreatedEvent(entity.ID))
+
+	var result *models.Pipeline
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "pipeline" (id, created_at, updated_at, description, name, organization_id)
+			VALUES (?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, description, name, organization_id`,
+			entity.ID, now, now,
+			entity.Description,
+			entity.Name,
+			tenantID.String(),
+		)
+
+		created, err := scanPipeline(row)
+		if err != nil {
+			return fmt.Errorf("failed to create pipeline: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a pipeline by ID
 func (r *SQLitePipelineRepository) Get(ctx context.Context, id uuid.UUID) (*models.Pipeline, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipeline
-	return nil, fmt.Errorf("GetPipeline not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, description, name, organization_id FROM "pipeline" WHERE id = ? AND organization_id = ?`,
+		id.String(),
+		tenantID.String(),
+	)
+
+	result, err := scanPipeline(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrPipelineNotFound
+		}
+		return nil, fmt.Errorf("failed to get pipeline: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing pipeline
-func (r *SQLitePipelineRepository) Update(ctx context.Context, id uuid.UUID, pipeline *models.Pipeline) (*models.Pipeline, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipeline
-	return nil, fmt.Errorf("UpdatePipeline not yet implemented - requires custom mapping")
+// Update updates an existing pipeline. Fields that are nil are left unchanged.
+func (r *SQLitePipelineRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Pipeline) (*models.Pipeline, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewPipelineUpdatedEvent(id))
+
+	var result *models.Pipeline
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "pipeline"
+			SET description = COALESCE(?, description), name = COALESCE(?, name)
+			WHERE id = ? AND organization_id = ?
+			RETURNING id, created_at, updated_at, description, name, organization_id`,
+			entity.Description,
+			entity.Name,
+			id.String(),
+			tenantID.String(),
+		)
+
+		updated, err := scanPipeline(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrPipelineNotFound
+			}
+			return fmt.Errorf("failed to update pipeline: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a pipeline
 func (r *SQLitePipelineRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipeline
-	return fmt.Errorf("DeletePipeline not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "pipeline" WHERE id = ? AND organization_id = ?`,
+			id.String(),
+			tenantID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete pipeline: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete pipeline: %w", err)
+		}
+		if n == 0 {
+			return models.ErrPipelineNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewPipelineDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of pipelines
-func (r *SQLitePipelineRepository) List(ctx context.Context, limit, offset int32) ([]*models.Pipeline, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipeline
-	return nil, 0, fmt.Errorf("ListPipelines not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of pipelines
+func (r *SQLitePipelineRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Pipeline, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	opts.SearchIndex = pipelineSearchIndex
+	opts.Select = pipelineSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "pipeline", pipelineColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelines: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Pipeline
+	for rows.Next() {
+		item, err := scanPipeline(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelines: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelines: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count pipelines: %w", err)
+	}
+
+	info := database.PageInfo{Total: total}
+	if query.SnippetSQL != "" {
+		rows, err := conn.QueryContext(ctx, query.SnippetSQL, query.SnippetArgs...)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to highlight pipelines: %w", err)
+		}
+		defer func() { _ = rows.Close() }()
+		if info.Snippets, err = database.CollectSnippets(rows); err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to highlight pipelines: %w", err)
+		}
+	}
+	return items, info, nil
 }
 
 // Additional methods
 
 // ListPipelinesByOrganization retrieves multiple pipelines by organizationID
 func (r *SQLitePipelineRepository) ListPipelinesByOrganization(ctx context.Context, organizationID string) ([]*models.Pipeline, error) {
-	// TODO: Implement ListPipelinesByOrganization
-	return nil, fmt.Errorf("ListPipelinesByOrganization not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
+	query := `SELECT id, created_at, updated_at, description, name, organization_id FROM "pipeline" WHERE organization_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListPipelinesByOrganization: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Pipeline
+	for rows.Next() {
+		item, err := scanPipeline(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListPipelinesByOrganization: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListPipelinesByOrganization: %w", err)
+	}
+	return items, nil
+}
+
+// pipelineSelectColumns are the columns scanPipeline reads, in order.
+var pipelineSelectColumns = []string{"id", "created_at", "updated_at", "description", "name", "organization_id"}
+
+// pipelineColumns maps Pipeline fields to the columns List can filter and sort on.
+var pipelineColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"description":    {Name: "description", Kind: database.ColumnString},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+}
+
+// pipelineSearchIndex describes the full-text index List searches.
+var pipelineSearchIndex = &database.SearchIndex{
+	Columns: []string{"name", "description"},
+}
+
+// scanPipeline reads a pipeline row of pipelineSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanPipeline(row interface{ Scan(dest ...any) error }) (*models.Pipeline, error) {
+	var entity models.Pipeline
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.Description,
+		&entity.Name,
+		&entity.OrganizationID,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
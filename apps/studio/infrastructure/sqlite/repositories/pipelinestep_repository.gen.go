@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
 
 // SQLitePipelineStepRepository implements PipelineStepRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLitePipelineStepRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLitePipelineStepRepository creates a new SQLite repository.
 func NewSQLitePipelineStepRepository(db *sql.DB) *SQLitePipelineStepRepository {
 	return &SQLitePipelineStepRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,36 +33,165 @@ func NewSQLitePipelineStepRepository(db *sql.DB) *SQLitePipelineStepRepository {
 // PipelineStep operations
 
 // Create creates a new pipelinestep
-func (r *SQLitePipelineStepRepository) Create(ctx context.Context, pipelineStep *models.PipelineStep) (*models.PipelineStep, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipelineStep
-	return nil, fmt.Errorf("CreatePipelineStep not yet implemented - requires custom mapping")
+func (r *SQLitePipelineStepRepository) Create(ctx context.Context, entity *models.PipelineStep) (*models.PipelineStep, error) {
+	pending := events.Ensure(entity.Events(), models.NewPipelineStepCreatedEvent(entity.ID))
+
+	var result *models.PipelineStep
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "pipeline_step" (id, created_at, updated_at, config, pipeline_id, tool_id)
+			VALUES (?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, config, pipeline_id, tool_id`,
+			entity.ID, now, now,
+			database.JSONValue(entity.Config),
+			entity.PipelineID,
+			entity.ToolID,
+		)
+
+		created, err := scanPipelineStep(row)
+		if err != nil {
+			return fmt.Errorf("failed to create pipelinestep: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a pipelinestep by ID
 func (r *SQLitePipelineStepRepository) Get(ctx context.Context, id uuid.UUID) (*models.PipelineStep, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipelineStep
-	return nil, fmt.Errorf("GetPipelineStep not yet implemented - requires custom mapping")
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, config, pipeline_id, tool_id FROM "pipeline_step" WHERE id = ?`,
+		id.String(),
+	)
+
+	result, err := scanPipelineStep(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrPipelineStepNotFound
+		}
+		return nil, fmt.Errorf("failed to get pipelinestep: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing pipelinestep
-func (r *SQLitePipelineStepRepository) Update(ctx context.Context, id uuid.UUID, pipelineStep *models.PipelineStep) (*models.PipelineStep, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipelineStep
-	return nil, fmt.Errorf("UpdatePipelineStep not yet implemented - requires custom mapping")
+// Update updates an existing pipelinestep. Fields that are nil are left unchanged.
+func (r *SQLitePipelineStepRepository) Update(ctx context.Context, id uuid.UUID, entity *models.PipelineStep) (*models.PipelineStep, error) {
+	pending := events.Ensure(entity.Events(), models.NewPipelineStepUpdatedEvent(id))
+
+	var result *models.PipelineStep
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "pipeline_step"
+			SET config = COALESCE(?, config), tool_id = COALESCE(?, tool_id)
+			WHERE id = ?
+			RETURNING id, created_at, updated_at, config, pipeline_id, tool_id`,
+			database.JSONValue(entity.Config),
+			entity.ToolID,
+			id.String(),
+		)
+
+		updated, err := scanPipelineStep(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrPipelineStepNotFound
+			}
+			return fmt.Errorf("failed to update pipelinestep: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a pipelinestep
 func (r *SQLitePipelineStepRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipelineStep
-	return fmt.Errorf("DeletePipelineStep not yet implemented - requires custom mapping")
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "pipeline_step" WHERE id = ?`,
+			id.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete pipelinestep: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete pipelinestep: %w", err)
+		}
+		if n == 0 {
+			return models.ErrPipelineStepNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewPipelineStepDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of pipelinesteps
-func (r *SQLitePipelineStepRepository) List(ctx context.Context, limit, offset int32) ([]*models.PipelineStep, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per pipelineStep
-	return nil, 0, fmt.Errorf("ListPipelineSteps not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of pipelinesteps
+func (r *SQLitePipelineStepRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.PipelineStep, database.PageInfo, error) {
+	opts.Select = pipelineStepSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "pipeline_step", pipelineStepColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelinesteps: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.PipelineStep
+	for rows.Next() {
+		item, err := scanPipelineStep(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelinesteps: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list pipelinesteps: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count pipelinesteps: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
+}
+
+// pipelineStepSelectColumns are the columns scanPipelineStep reads, in order.
+var pipelineStepSelectColumns = []string{"id", "created_at", "updated_at", "config", "pipeline_id", "tool_id"}
+
+// pipelineStepColumns maps PipelineStep fields to the columns List can filter and sort on.
+var pipelineStepColumns = database.Columns{
+	"id":         {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":  {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":  {Name: "updated_at", Kind: database.ColumnTime},
+	"pipelineID": {Name: "pipeline_id", Kind: database.ColumnUUID},
+	"toolID":     {Name: "tool_id", Kind: database.ColumnUUID},
+}
+
+// scanPipelineStep reads a pipelinestep row of pipelineStepSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanPipelineStep(row interface{ Scan(dest ...any) error }) (*models.PipelineStep, error) {
+	var entity models.PipelineStep
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		database.JSONColumn(&entity.Config),
+		&entity.PipelineID,
+		&entity.ToolID,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
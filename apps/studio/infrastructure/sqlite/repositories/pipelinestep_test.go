@@ -0,0 +1,58 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/apps/studio/infrastructure/sqlite"
+	"github.com/archesai/archesai/apps/studio/infrastructure/sqlite/repositories"
+	"github.com/archesai/archesai/pkg/database/databasetest"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+)
+
+// The step config is stored as JSON and decoded back into its variant.
+func TestPipelineStepConfigRoundTrip(t *testing.T) {
+	db := databasetest.SQLite(t, sqlite.Migrations)
+	ctx := context.Background()
+	repo := repositories.NewSQLitePipelineStepRepository(db)
+
+	script := models.NewStepConfig(models.ScriptStepConfig{
+		Runtime: models.ScriptStepConfigRuntimePython,
+		Source:  models.NewScriptSource(models.InlineScriptSource{Code: "print(input)"}),
+	})
+
+	tests := []struct {
+		name   string
+		config *models.StepConfig
+	}{
+		{name: "no config"},
+		{name: "script", config: &script},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, err := models.NewPipelineStep(tt.config, uuid.New(), uuid.New())
+			require.NoError(t, err)
+			_, err = repo.Create(ctx, step)
+			require.NoError(t, err)
+
+			got, err := repo.Get(ctx, step.ID)
+			require.NoError(t, err)
+			if tt.config == nil {
+				assert.Nil(t, got.Config)
+				return
+			}
+			require.NotNil(t, got.Config)
+			config, ok := got.Config.AsScriptStepConfig()
+			require.True(t, ok)
+			source, ok := config.Source.AsInlineScriptSource()
+			require.True(t, ok)
+			assert.Equal(t, "print(input)", source.Code)
+			assert.Equal(t, models.ScriptStepConfigKindScript, *config.Kind)
+		})
+	}
+}
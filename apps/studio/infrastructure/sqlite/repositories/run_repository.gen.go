@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
 
 // SQLiteRunRepository implements RunRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteRunRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteRunRepository creates a new SQLite repository.
 func NewSQLiteRunRepository(db *sql.DB) *SQLiteRunRepository {
 	return &SQLiteRunRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,56 +33,294 @@ func NewSQLiteRunRepository(db *sql.DB) *SQLiteRunRepository {
 // Run operations
 
 // Create creates a new run
-func (r *SQLiteRunRepository) Create(ctx context.Context, run *models.Run) (*models.Run, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per run
-	return nil, fmt.Errorf("CreateRun not yet implemented - requires custom mapping")
+func (r *SQLiteRunRepository) Create(ctx context.Context, entity *models.Run) (*models.Run, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewRunCreatedEvent(entity.ID))
+
+	var result *models.Run
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "run" (id, created_at, updated_at, organization_id, pipeline_id, progress, status, tool_id)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, completed_at, error, organization_id, pipeline_id, progress, started_at, status, tool_id`,
+			entity.ID, now, now,
+			tenantID.String(),
+			entity.PipelineID,
+			entity.Progress,
+			entity.Status,
+			entity.ToolID,
+		)
+
+		created, err := scanRun(row)
+		if err != nil {
+			return fmt.Errorf("failed to create run: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a run by ID
 func (r *SQLiteRunRepository) Get(ctx context.Context, id uuid.UUID) (*models.Run, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per run
-	return nil, fmt.Errorf("GetRun not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, completed_at, error, organization_id, pipeline_id, progress, started_at, status, tool_id FROM "run" WHERE id = ? AND organization_id = ?`,
+		id.String(),
+		tenantID.String(),
+	)
+
+	result, err := scanRun(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrRunNotFound
+		}
+		return nil, fmt.Errorf("failed to get run: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing run
-func (r *SQLiteRunRepository) Update(ctx context.Context, id uuid.UUID, run *models.Run) (*models.Run, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per run
-	return nil, fmt.Errorf("UpdateRun not yet implemented - requires custom mapping")
+// Update updates an existing run. Fields that are nil are left unchanged.
+func (r *SQLiteRunRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Run) (*models.Run, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewRunUpdatedEvent(id))
+
+	var result *models.Run
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "run"
+			SET completed_at = COALESCE(?, completed_at), error = COALESCE(?, error), pipeline_id = COALESCE(?, pipeline_id), progress = COALESCE(?, progress), started_at = COALESCE(?, started_at), status = COALESCE(?, status), tool_id = COALESCE(?, tool_id)
+			WHERE id = ? AND organization_id = ?
+			RETURNING id, created_at, updated_at, completed_at, error, organization_id, pipeline_id, progress, started_at, status, tool_id`,
+			database.SQLiteNullTimeValue(entity.CompletedAt),
+			entity.Error,
+			entity.PipelineID,
+			entity.Progress,
+			database.SQLiteNullTimeValue(entity.StartedAt),
+			entity.Status,
+			entity.ToolID,
+			id.String(),
+			tenantID.String(),
+		)
+
+		updated, err := scanRun(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrRunNotFound
+			}
+			return fmt.Errorf("failed to update run: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a run
 func (r *SQLiteRunRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per run
-	return fmt.Errorf("DeleteRun not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "run" WHERE id = ? AND organization_id = ?`,
+			id.String(),
+			tenantID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete run: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete run: %w", err)
+		}
+		if n == 0 {
+			return models.ErrRunNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewRunDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of runs
-func (r *SQLiteRunRepository) List(ctx context.Context, limit, offset int32) ([]*models.Run, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per run
-	return nil, 0, fmt.Errorf("ListRuns not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of runs
+func (r *SQLiteRunRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Run, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	opts.Select = runSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "run", runColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list runs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Run
+	for rows.Next() {
+		item, err := scanRun(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list runs: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list runs: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count runs: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // Additional methods
 
 // ListRunsByPipeline retrieves multiple runs by pipelineID
 func (r *SQLiteRunRepository) ListRunsByPipeline(ctx context.Context, pipelineID string) ([]*models.Run, error) {
-	// TODO: Implement ListRunsByPipeline
-	return nil, fmt.Errorf("ListRunsByPipeline not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, created_at, updated_at, completed_at, error, organization_id, pipeline_id, progress, started_at, status, tool_id FROM "run" WHERE pipeline_id = ? AND organization_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, pipelineID, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListRunsByPipeline: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Run
+	for rows.Next() {
+		item, err := scanRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListRunsByPipeline: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListRunsByPipeline: %w", err)
+	}
+	return items, nil
 }
 
 // ListRunsByOrganization retrieves multiple runs by organizationID
 func (r *SQLiteRunRepository) ListRunsByOrganization(ctx context.Context, organizationID string) ([]*models.Run, error) {
-	// TODO: Implement ListRunsByOrganization
-	return nil, fmt.Errorf("ListRunsByOrganization not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
+	query := `SELECT id, created_at, updated_at, completed_at, error, organization_id, pipeline_id, progress, started_at, status, tool_id FROM "run" WHERE organization_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListRunsByOrganization: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Run
+	for rows.Next() {
+		item, err := scanRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListRunsByOrganization: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListRunsByOrganization: %w", err)
+	}
+	return items, nil
 }
 
 // ListRunsByTool retrieves multiple runs by toolID
 func (r *SQLiteRunRepository) ListRunsByTool(ctx context.Context, toolID string) ([]*models.Run, error) {
-	// TODO: Implement ListRunsByTool
-	return nil, fmt.Errorf("ListRunsByTool not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, created_at, updated_at, completed_at, error, organization_id, pipeline_id, progress, started_at, status, tool_id FROM "run" WHERE tool_id = ? AND organization_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, toolID, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListRunsByTool: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Run
+	for rows.Next() {
+		item, err := scanRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListRunsByTool: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListRunsByTool: %w", err)
+	}
+	return items, nil
+}
+
+// runSelectColumns are the columns scanRun reads, in order.
+var runSelectColumns = []string{"id", "created_at", "updated_at", "completed_at", "error", "organization_id", "pipeline_id", "progress", "started_at", "status", "tool_id"}
+
+// runColumns maps Run fields to the columns List can filter and sort on.
+var runColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"completedAt":    {Name: "completed_at", Kind: database.ColumnTime},
+	"error":          {Name: "error", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"pipelineID":     {Name: "pipeline_id", Kind: database.ColumnUUID},
+	"progress":       {Name: "progress", Kind: database.ColumnInteger},
+	"startedAt":      {Name: "started_at", Kind: database.ColumnTime},
+	"status":         {Name: "status", Kind: database.ColumnString},
+	"toolID":         {Name: "tool_id", Kind: database.ColumnUUID},
+}
+
+// scanRun reads a run row of runSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanRun(row interface{ Scan(dest ...any) error }) (*models.Run, error) {
+	var entity models.Run
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		database.SQLiteNullTime(&entity.CompletedAt),
+		&entity.Error,
+		&entity.OrganizationID,
+		&entity.PipelineID,
+		&entity.Progress,
+		database.SQLiteNullTime(&entity.StartedAt),
+		&entity.Status,
+		&entity.ToolID,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
@@ -0,0 +1,59 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/apps/studio/infrastructure/sqlite"
+	"github.com/archesai/archesai/apps/studio/infrastructure/sqlite/repositories"
+	authmodels "github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/database/databasetest"
+	"github.com/archesai/archesai/pkg/storage/models"
+)
+
+// Additional methods that take the tenant column as a parameter only return
+// rows of the tenant of the context.
+func TestAdditionalMethodsTenantParameter(t *testing.T) {
+	db := databasetest.SQLite(t, sqlite.Migrations)
+	ctx := context.Background()
+
+	orgs := repositories.NewSQLiteOrganizationRepository(db)
+	labels := repositories.NewSQLiteLabelRepository(db)
+	var ids []uuid.UUID
+	var tenants []context.Context
+	for _, name := range []string{"first", "second"} {
+		org, err := authmodels.NewOrganization(nil, 0, nil, name, authmodels.OrganizationPlanFREE, name, "cus_"+name)
+		require.NoError(t, err)
+		_, err = orgs.Create(ctx, org)
+		require.NoError(t, err)
+		label, err := models.NewLabel("draft", org.ID)
+		require.NoError(t, err)
+		tenant := database.WithTenant(ctx, org.ID)
+		_, err = labels.Create(tenant, label)
+		require.NoError(t, err)
+		ids = append(ids, org.ID)
+		tenants = append(tenants, tenant)
+	}
+	first, second := ids[0], ids[1]
+
+	got, err := labels.ListLabelsByOrganization(tenants[0], first.String())
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, first, got[0].OrganizationID)
+
+	got, err = labels.ListLabelsByOrganization(tenants[0], second.String())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	label, err := labels.GetLabelByName(tenants[1], "draft", second.String())
+	require.NoError(t, err)
+	assert.Equal(t, second, label.OrganizationID)
+
+	_, err = labels.GetLabelByName(tenants[1], "draft", first.String())
+	assert.ErrorIs(t, err, models.ErrLabelNotFound)
+}
@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
 
 // SQLiteToolRepository implements ToolRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteToolRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteToolRepository creates a new SQLite repository.
 func NewSQLiteToolRepository(db *sql.DB) *SQLiteToolRepository {
 	return &SQLiteToolRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,44 +33,231 @@ func NewSQLiteToolRepository(db *sql.DB) *SQLiteToolRepository {
 // Tool operations
 
 // Create creates a new tool
-func (r *SQLiteToolRepository) Create(ctx context.Context, tool *models.Tool) (*models.Tool, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per tool
-	return nil, fmt.Errorf("CreateTool not yet implemented - requires custom mapping")
+func (r *SQLiteToolRepository) Create(ctx context.Context, entity *models.Tool) (*models.Tool, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewToolCreatedEvent(entity.ID))
+
+	var result *models.Tool
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "tool" (id, created_at, updated_at, description, input_mime_type, name, organization_id, output_mime_type)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, description, input_mime_type, name, organization_id, output_mime_type`,
+			entity.ID, now, now,
+			entity.Description,
+			entity.InputMimeType,
+			entity.Name,
+			tenantID.String(),
+			entity.OutputMimeType,
+		)
+
+		created, err := scanTool(row)
+		if err != nil {
+			return fmt.Errorf("failed to create tool: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a tool by ID
 func (r *SQLiteToolRepository) Get(ctx context.Context, id uuid.UUID) (*models.Tool, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per tool
-	return nil, fmt.Errorf("GetTool not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, description, input_mime_type, name, organization_id, output_mime_type FROM "tool" WHERE id = ? AND organization_id = ?`,
+		id.String(),
+		tenantID.String(),
+	)
+
+	result, err := scanTool(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrToolNotFound
+		}
+		return nil, fmt.Errorf("failed to get tool: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing tool
-func (r *SQLiteToolRepository) Update(ctx context.Context, id uuid.UUID, tool *models.Tool) (*models.Tool, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per tool
-	return nil, fmt.Errorf("UpdateTool not yet implemented - requires custom mapping")
+// Update updates an existing tool. Fields that are nil are left unchanged.
+func (r *SQLiteToolRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Tool) (*models.Tool, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := events.Ensure(entity.Events(), models.NewToolUpdatedEvent(id))
+
+	var result *models.Tool
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "tool"
+			SET description = COALESCE(?, description), input_mime_type = COALESCE(?, input_mime_type), name = COALESCE(?, name), output_mime_type = COALESCE(?, output_mime_type)
+			WHERE id = ? AND organization_id = ?
+			RETURNING id, created_at, updated_at, description, input_mime_type, name, organization_id, output_mime_type`,
+			entity.Description,
+			entity.InputMimeType,
+			entity.Name,
+			entity.OutputMimeType,
+			id.String(),
+			tenantID.String(),
+		)
+
+		updated, err := scanTool(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrToolNotFound
+			}
+			return fmt.Errorf("failed to update tool: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a tool
 func (r *SQLiteToolRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per tool
-	return fmt.Errorf("DeleteTool not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "tool" WHERE id = ? AND organization_id = ?`,
+			id.String(),
+			tenantID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete tool: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete tool: %w", err)
+		}
+		if n == 0 {
+			return models.ErrToolNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewToolDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of tools
-func (r *SQLiteToolRepository) List(ctx context.Context, limit, offset int32) ([]*models.Tool, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per tool
-	return nil, 0, fmt.Errorf("ListTools not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of tools
+func (r *SQLiteToolRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Tool, database.PageInfo, error) {
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+	opts.Tenant = &database.TenantScope{Column: "organization_id", ID: tenantID}
+	opts.Select = toolSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "tool", toolColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list tools: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Tool
+	for rows.Next() {
+		item, err := scanTool(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list tools: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list tools: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count tools: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // Additional methods
 
 // ListToolsByOrganization retrieves multiple tools by organizationID
 func (r *SQLiteToolRepository) ListToolsByOrganization(ctx context.Context, organizationID string) ([]*models.Tool, error) {
-	// TODO: Implement ListToolsByOrganization
-	return nil, fmt.Errorf("ListToolsByOrganization not yet implemented - requires custom mapping")
+	tenantID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows of other tenants are never visible, so the query only filters on the tenant
+	if id, err := uuid.Parse(organizationID); err != nil || id != tenantID {
+		return nil, nil
+	}
+	query := `SELECT id, created_at, updated_at, description, input_mime_type, name, organization_id, output_mime_type FROM "tool" WHERE organization_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListToolsByOrganization: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Tool
+	for rows.Next() {
+		item, err := scanTool(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListToolsByOrganization: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListToolsByOrganization: %w", err)
+	}
+	return items, nil
+}
+
+// toolSelectColumns are the columns scanTool reads, in order.
+var toolSelectColumns = []string{"id", "created_at", "updated_at", "description", "input_mime_type", "name", "organization_id", "output_mime_type"}
+
+// toolColumns maps Tool fields to the columns List can filter and sort on.
+var toolColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"description":    {Name: "description", Kind: database.ColumnString},
+	"inputMimeType":  {Name: "input_mime_type", Kind: database.ColumnString},
+	"name":           {Name: "name", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"outputMimeType": {Name: "output_mime_type", Kind: database.ColumnString},
+}
+
+// scanTool reads a tool row of toolSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanTool(row interface{ Scan(dest ...any) error }) (*models.Tool, error) {
+	var entity models.Tool
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.Description,
+		&entity.InputMimeType,
+		&entity.Name,
+		&entity.OrganizationID,
+		&entity.OutputMimeType,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
@@ -240,6 +240,11 @@ table "artifact" {
     null = true
     type = sql("TEXT")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("TEXT")
+  }
   primary_key {
     columns = [column.id]
   }
@@ -263,6 +268,38 @@ table "artifact" {
   }
 }
 
+table "artifact_labels" {
+  schema = schema.main
+
+  column "artifact_id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "label_id" {
+    null = false
+    type = sql("TEXT")
+  }
+  primary_key {
+    columns = [column.artifact_id, column.label_id]
+  }
+  foreign_key "artifact_labels_artifact_id_fkey" {
+    columns     = [column.artifact_id]
+    ref_columns = [table.artifact.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "CASCADE"
+  }
+  foreign_key "artifact_labels_label_id_fkey" {
+    columns     = [column.label_id]
+    ref_columns = [table.label.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "CASCADE"
+  }
+  index "idx_artifact_labels_label_id" {
+    columns = [column.label_id]
+  }
+}
+
 table "executor" {
   schema = schema.main
 
@@ -534,10 +571,20 @@ table "member" {
     default = "basic"
   }
 
+  column "role_id" {
+    null = true
+    type = sql("TEXT")
+  }
+
   column "user_id" {
     null = false
     type = sql("TEXT")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("TEXT")
+  }
   primary_key {
     columns = [column.id]
   }
@@ -553,6 +600,12 @@ table "member" {
     on_update   = "NO_ACTION"
     on_delete   = "CASCADE"
   }
+  foreign_key "member_role_id_fkey" {
+    columns     = [column.role_id]
+    ref_columns = [table.role.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "SET_NULL"
+  }
   index "idx_member_organization_id" {
     columns = [column.organization_id]
   }
@@ -618,9 +671,19 @@ table "organization" {
     null = false
     type = sql("TEXT")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("TEXT")
+  }
   primary_key {
     columns = [column.id]
   }
+  index "idx_organization_slug" {
+    unique  = true
+    columns = [column.slug]
+    where   = "(deleted_at IS NULL)"
+  }
 }
 
 table "pipeline" {
@@ -693,6 +756,11 @@ table "pipeline_step" {
     default = sql("CURRENT_TIMESTAMP")
   }
 
+  column "config" {
+    null = true
+    type = sql("TEXT")
+  }
+
   column "pipeline_id" {
     null = false
     type = sql("TEXT")
@@ -707,6 +775,64 @@ table "pipeline_step" {
   }
 }
 
+table "role" {
+  schema = schema.main
+
+  column "id" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("lower(hex(randomblob(16)))")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "updated_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "description" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "name" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "organization_id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "permissions" {
+    null    = false
+    type    = sql("TEXT")
+    default = "[]"
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "role_organization_id_fkey" {
+    columns     = [column.organization_id]
+    ref_columns = [table.organization.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "CASCADE"
+  }
+  index "idx_role_name" {
+    columns = [column.name]
+  }
+  index "idx_role_organization_id" {
+    columns = [column.organization_id]
+  }
+}
+
 table "run" {
   schema = schema.main
 
@@ -985,6 +1111,244 @@ table "user" {
   }
 }
 
+table "webhook_delivery" {
+  schema = schema.main
+
+  column "id" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("lower(hex(randomblob(16)))")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "updated_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "attempts" {
+    null    = false
+    type    = sql("INTEGER")
+    default = 0
+  }
+
+  column "endpoint_id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "event_id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "event_type" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "last_attempt_at" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "last_error" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "next_attempt_at" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "organization_id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "payload" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "response_status" {
+    null = true
+    type = sql("INTEGER")
+  }
+
+  column "status" {
+    null    = false
+    type    = sql("TEXT")
+    default = "pending"
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "webhook_delivery_organization_id_fkey" {
+    columns     = [column.organization_id]
+    ref_columns = [table.organization.column.id]
+    on_update   = "CASCADE"
+    on_delete   = "CASCADE"
+  }
+  foreign_key "webhook_delivery_endpoint_id_fkey" {
+    columns     = [column.endpoint_id]
+    ref_columns = [table.webhook_endpoint.column.id]
+    on_update   = "CASCADE"
+    on_delete   = "CASCADE"
+  }
+  index "idx_webhook_delivery_endpoint_id" {
+    columns = [column.endpoint_id]
+  }
+  index "idx_webhook_delivery_organization_id" {
+    columns = [column.organization_id]
+  }
+  index "idx_webhook_delivery_status" {
+    columns = [column.status]
+  }
+}
+
+table "webhook_endpoint" {
+  schema = schema.main
+
+  column "id" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("lower(hex(randomblob(16)))")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "updated_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "description" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "disabled_at" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "enabled" {
+    null    = false
+    type    = sql("INTEGER")
+    default = 1
+  }
+
+  column "event_types" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "failure_count" {
+    null    = false
+    type    = sql("INTEGER")
+    default = 0
+  }
+
+  column "organization_id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "secret" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "url" {
+    null = false
+    type = sql("TEXT")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "webhook_endpoint_organization_id_fkey" {
+    columns     = [column.organization_id]
+    ref_columns = [table.organization.column.id]
+    on_update   = "CASCADE"
+    on_delete   = "CASCADE"
+  }
+  index "idx_webhook_endpoint_organization_id" {
+    columns = [column.organization_id]
+  }
+}
+
+
+table "event_outbox" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "event_type" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "organization_id" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "payload" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "published_at" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "attempts" {
+    null    = false
+    type    = sql("INTEGER")
+    default = 0
+  }
+
+  column "last_error" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "dead_lettered_at" {
+    null = true
+    type = sql("TEXT")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_event_outbox_pending" {
+    columns = [column.created_at, column.id]
+    where   = "(published_at IS NULL)"
+  }
+}
+
 
 schema "main" {
 }
\ No newline at end of file
@@ -30,6 +30,7 @@ import type {
   BadRequestResponse,
   CreateArtifactBody,
   InternalServerErrorResponse,
+  LabelListResponseResponse,
   ListArtifactsParams,
   NoContentResponse,
   NotFoundResponse,
@@ -611,6 +612,113 @@ export const useDeleteArtifact = <
 
   return useMutation(mutationOptions, queryClient);
 };
+/**
+ * Restore a deleted artifact. Deleted artifacts can be restored until they are purged, 30 days after their deletion.
+ * @summary Restore an artifact
+ */
+export const getRestoreArtifactUrl = (id: string | undefined | null) => {
+  return `/artifacts/${id}/restore`;
+};
+
+export const restoreArtifact = async (
+  id: string | undefined | null,
+  options?: RequestInit,
+): Promise<ArtifactResponseResponse> => {
+  return customFetch<ArtifactResponseResponse>(getRestoreArtifactUrl(id), {
+    ...options,
+    method: "POST",
+  });
+};
+
+export const getRestoreArtifactMutationOptions = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(options?: {
+  mutation?: UseMutationOptions<
+    Awaited<ReturnType<typeof restoreArtifact>>,
+    TError,
+    { id: string | undefined | null },
+    TContext
+  >;
+  request?: SecondParameter<typeof customFetch>;
+}): UseMutationOptions<
+  Awaited<ReturnType<typeof restoreArtifact>>,
+  TError,
+  { id: string | undefined | null },
+  TContext
+> => {
+  const mutationKey = ["restoreArtifact"];
+  const { mutation: mutationOptions, request: requestOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey }, request: undefined };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof restoreArtifact>>,
+    { id: string | undefined | null }
+  > = (props) => {
+    const { id } = props ?? {};
+
+    return restoreArtifact(id, requestOptions);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type RestoreArtifactMutationResult = NonNullable<
+  Awaited<ReturnType<typeof restoreArtifact>>
+>;
+
+export type RestoreArtifactMutationError =
+  | BadRequestResponse
+  | UnauthorizedResponse
+  | NotFoundResponse
+  | UnprocessableEntityResponse
+  | TooManyRequestsResponse
+  | InternalServerErrorResponse;
+
+/**
+ * @summary Restore an artifact
+ */
+export const useRestoreArtifact = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: UseMutationOptions<
+      Awaited<ReturnType<typeof restoreArtifact>>,
+      TError,
+      { id: string | undefined | null },
+      TContext
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseMutationResult<
+  Awaited<ReturnType<typeof restoreArtifact>>,
+  TError,
+  { id: string | undefined | null },
+  TContext
+> => {
+  const mutationOptions = getRestoreArtifactMutationOptions(options);
+
+  return useMutation(mutationOptions, queryClient);
+};
 /**
  * List artifacts
  * @summary List artifacts
@@ -1077,3 +1185,603 @@ export const useCreateArtifact = <
 
   return useMutation(mutationOptions, queryClient);
 };
+/**
+ * List the labels of an artifact
+ * @summary List the labels of an artifact
+ */
+export const getListArtifactLabelsUrl = (id: string | undefined | null) => {
+  return `/artifacts/${id}/labels`;
+};
+
+export const listArtifactLabels = async (
+  id: string | undefined | null,
+  options?: RequestInit,
+): Promise<LabelListResponseResponse> => {
+  return customFetch<LabelListResponseResponse>(getListArtifactLabelsUrl(id), {
+    ...options,
+    method: "GET",
+  });
+};
+
+export const getListArtifactLabelsQueryKey = (
+  id?: string | undefined | null,
+) => {
+  return [`/artifacts/${id}/labels`] as const;
+};
+
+export const getListArtifactLabelsQueryOptions = <
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options?: {
+    query?: Partial<
+      UseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+) => {
+  const { query: queryOptions, request: requestOptions } = options ?? {};
+
+  const queryKey = queryOptions?.queryKey ?? getListArtifactLabelsQueryKey(id);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof listArtifactLabels>>
+  > = ({ signal }) => listArtifactLabels(id, { signal, ...requestOptions });
+
+  return {
+    enabled: !!id,
+    queryFn,
+    queryKey,
+    ...queryOptions,
+  } as UseQueryOptions<
+    Awaited<ReturnType<typeof listArtifactLabels>>,
+    TError,
+    TData
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+};
+
+export type ListArtifactLabelsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof listArtifactLabels>>
+>;
+export type ListArtifactLabelsQueryError =
+  | BadRequestResponse
+  | UnauthorizedResponse
+  | NotFoundResponse
+  | UnprocessableEntityResponse
+  | TooManyRequestsResponse
+  | InternalServerErrorResponse;
+
+export function useListArtifactLabels<
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options: {
+    query: Partial<
+      UseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    > &
+      Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listArtifactLabels>>,
+          TError,
+          Awaited<ReturnType<typeof listArtifactLabels>>
+        >,
+        "initialData"
+      >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): DefinedUseQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+};
+export function useListArtifactLabels<
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options?: {
+    query?: Partial<
+      UseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    > &
+      Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listArtifactLabels>>,
+          TError,
+          Awaited<ReturnType<typeof listArtifactLabels>>
+        >,
+        "initialData"
+      >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+};
+export function useListArtifactLabels<
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options?: {
+    query?: Partial<
+      UseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+};
+/**
+ * @summary List the labels of an artifact
+ */
+
+export function useListArtifactLabels<
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options?: {
+    query?: Partial<
+      UseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+} {
+  const queryOptions = getListArtifactLabelsQueryOptions(id, options);
+
+  const query = useQuery(queryOptions, queryClient) as UseQueryResult<
+    TData,
+    TError
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+}
+
+export const getListArtifactLabelsSuspenseQueryOptions = <
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options?: {
+    query?: Partial<
+      UseSuspenseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+) => {
+  const { query: queryOptions, request: requestOptions } = options ?? {};
+
+  const queryKey = queryOptions?.queryKey ?? getListArtifactLabelsQueryKey(id);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof listArtifactLabels>>
+  > = ({ signal }) => listArtifactLabels(id, { signal, ...requestOptions });
+
+  return { queryFn, queryKey, ...queryOptions } as UseSuspenseQueryOptions<
+    Awaited<ReturnType<typeof listArtifactLabels>>,
+    TError,
+    TData
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+};
+
+export type ListArtifactLabelsSuspenseQueryResult = NonNullable<
+  Awaited<ReturnType<typeof listArtifactLabels>>
+>;
+export type ListArtifactLabelsSuspenseQueryError =
+  | BadRequestResponse
+  | UnauthorizedResponse
+  | NotFoundResponse
+  | UnprocessableEntityResponse
+  | TooManyRequestsResponse
+  | InternalServerErrorResponse;
+
+export function useListArtifactLabelsSuspense<
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options: {
+    query: Partial<
+      UseSuspenseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseSuspenseQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+};
+export function useListArtifactLabelsSuspense<
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options?: {
+    query?: Partial<
+      UseSuspenseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseSuspenseQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+};
+export function useListArtifactLabelsSuspense<
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options?: {
+    query?: Partial<
+      UseSuspenseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseSuspenseQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+};
+/**
+ * @summary List the labels of an artifact
+ */
+
+export function useListArtifactLabelsSuspense<
+  TData = Awaited<ReturnType<typeof listArtifactLabels>>,
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+>(
+  id: string | undefined | null,
+  options?: {
+    query?: Partial<
+      UseSuspenseQueryOptions<
+        Awaited<ReturnType<typeof listArtifactLabels>>,
+        TError,
+        TData
+      >
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseSuspenseQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+} {
+  const queryOptions = getListArtifactLabelsSuspenseQueryOptions(id, options);
+
+  const query = useSuspenseQuery(
+    queryOptions,
+    queryClient,
+  ) as UseSuspenseQueryResult<TData, TError> & {
+    queryKey: DataTag<QueryKey, TData, TError>;
+  };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+}
+
+/**
+ * Add a label to an artifact. Adding a label twice has no effect.
+ * @summary Add a label to an artifact
+ */
+export const getAddArtifactLabelUrl = (
+  id: string | undefined | null,
+  labelID: string | undefined | null,
+) => {
+  return `/artifacts/${id}/labels/${labelID}`;
+};
+
+export const addArtifactLabel = async (
+  id: string | undefined | null,
+  labelID: string | undefined | null,
+  options?: RequestInit,
+): Promise<NoContentResponse> => {
+  return customFetch<NoContentResponse>(getAddArtifactLabelUrl(id, labelID), {
+    ...options,
+    method: "PUT",
+  });
+};
+
+export const getAddArtifactLabelMutationOptions = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(options?: {
+  mutation?: UseMutationOptions<
+    Awaited<ReturnType<typeof addArtifactLabel>>,
+    TError,
+    { id: string | undefined | null; labelID: string | undefined | null },
+    TContext
+  >;
+  request?: SecondParameter<typeof customFetch>;
+}): UseMutationOptions<
+  Awaited<ReturnType<typeof addArtifactLabel>>,
+  TError,
+  { id: string | undefined | null; labelID: string | undefined | null },
+  TContext
+> => {
+  const mutationKey = ["addArtifactLabel"];
+  const { mutation: mutationOptions, request: requestOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey }, request: undefined };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof addArtifactLabel>>,
+    { id: string | undefined | null; labelID: string | undefined | null }
+  > = (props) => {
+    const { id, labelID } = props ?? {};
+
+    return addArtifactLabel(id, labelID, requestOptions);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AddArtifactLabelMutationResult = NonNullable<
+  Awaited<ReturnType<typeof addArtifactLabel>>
+>;
+
+export type AddArtifactLabelMutationError =
+  | BadRequestResponse
+  | UnauthorizedResponse
+  | NotFoundResponse
+  | UnprocessableEntityResponse
+  | TooManyRequestsResponse
+  | InternalServerErrorResponse;
+
+/**
+ * @summary Add a label to an artifact
+ */
+export const useAddArtifactLabel = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: UseMutationOptions<
+      Awaited<ReturnType<typeof addArtifactLabel>>,
+      TError,
+      { id: string | undefined | null; labelID: string | undefined | null },
+      TContext
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseMutationResult<
+  Awaited<ReturnType<typeof addArtifactLabel>>,
+  TError,
+  { id: string | undefined | null; labelID: string | undefined | null },
+  TContext
+> => {
+  const mutationOptions = getAddArtifactLabelMutationOptions(options);
+
+  return useMutation(mutationOptions, queryClient);
+};
+/**
+ * Remove a label from an artifact. Removing a label the artifact does not have has no effect.
+ * @summary Remove a label from an artifact
+ */
+export const getRemoveArtifactLabelUrl = (
+  id: string | undefined | null,
+  labelID: string | undefined | null,
+) => {
+  return `/artifacts/${id}/labels/${labelID}`;
+};
+
+export const removeArtifactLabel = async (
+  id: string | undefined | null,
+  labelID: string | undefined | null,
+  options?: RequestInit,
+): Promise<NoContentResponse> => {
+  return customFetch<NoContentResponse>(
+    getRemoveArtifactLabelUrl(id, labelID),
+    {
+      ...options,
+      method: "DELETE",
+    },
+  );
+};
+
+export const getRemoveArtifactLabelMutationOptions = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(options?: {
+  mutation?: UseMutationOptions<
+    Awaited<ReturnType<typeof removeArtifactLabel>>,
+    TError,
+    { id: string | undefined | null; labelID: string | undefined | null },
+    TContext
+  >;
+  request?: SecondParameter<typeof customFetch>;
+}): UseMutationOptions<
+  Awaited<ReturnType<typeof removeArtifactLabel>>,
+  TError,
+  { id: string | undefined | null; labelID: string | undefined | null },
+  TContext
+> => {
+  const mutationKey = ["removeArtifactLabel"];
+  const { mutation: mutationOptions, request: requestOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey }, request: undefined };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof removeArtifactLabel>>,
+    { id: string | undefined | null; labelID: string | undefined | null }
+  > = (props) => {
+    const { id, labelID } = props ?? {};
+
+    return removeArtifactLabel(id, labelID, requestOptions);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type RemoveArtifactLabelMutationResult = NonNullable<
+  Awaited<ReturnType<typeof removeArtifactLabel>>
+>;
+
+export type RemoveArtifactLabelMutationError =
+  | BadRequestResponse
+  | UnauthorizedResponse
+  | NotFoundResponse
+  | UnprocessableEntityResponse
+  | TooManyRequestsResponse
+  | InternalServerErrorResponse;
+
+/**
+ * @summary Remove a label from an artifact
+ */
+export const useRemoveArtifactLabel = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: UseMutationOptions<
+      Awaited<ReturnType<typeof removeArtifactLabel>>,
+      TError,
+      { id: string | undefined | null; labelID: string | undefined | null },
+      TContext
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseMutationResult<
+  Awaited<ReturnType<typeof removeArtifactLabel>>,
+  TError,
+  { id: string | undefined | null; labelID: string | undefined | null },
+  TContext
+> => {
+  const mutationOptions = getRemoveArtifactLabelMutationOptions(options);
+
+  return useMutation(mutationOptions, queryClient);
+};
@@ -52,6 +52,7 @@ import type {
   SessionCreatedResponse,
   SessionListResponseResponse,
   SessionResponseResponse,
+  SessionUpdatedResponseResponse,
   TooManyRequestsResponse,
   UnauthorizedResponse,
   UnprocessableEntityResponse,
@@ -3848,7 +3849,7 @@ export function useGetSessionSuspense<
 }
 
 /**
- * This endpoint will update the active organization for the current session
+ * This endpoint will update the active organization for the current session and issue tokens scoped to it. The caller must be a member of the organization.
  * @summary Update Session
  */
 export const getUpdateSessionUrl = (id: string | undefined | null) => {
@@ -3859,8 +3860,8 @@ export const updateSession = async (
   id: string | undefined | null,
   updateSessionBody: UpdateSessionBody,
   options?: RequestInit,
-): Promise<SessionResponseResponse> => {
-  return customFetch<SessionResponseResponse>(getUpdateSessionUrl(id), {
+): Promise<SessionUpdatedResponseResponse> => {
+  return customFetch<SessionUpdatedResponseResponse>(getUpdateSessionUrl(id), {
     ...options,
     body: JSON.stringify(updateSessionBody),
     headers: { "Content-Type": "application/json", ...options?.headers },
@@ -1182,3 +1182,123 @@ export const useDeleteMember = <
 
   return useMutation(mutationOptions, queryClient);
 };
+/**
+ * Restore a deleted member. Deleted members can be restored until they are purged, 30 days after their deletion.
+ * @summary Restore a member
+ */
+export const getRestoreMemberUrl = (
+  organizationID: string | undefined | null,
+  id: string | undefined | null,
+) => {
+  return `/organizations/${organizationID}/members/${id}/restore`;
+};
+
+export const restoreMember = async (
+  organizationID: string | undefined | null,
+  id: string | undefined | null,
+  options?: RequestInit,
+): Promise<MemberResponseResponse> => {
+  return customFetch<MemberResponseResponse>(
+    getRestoreMemberUrl(organizationID, id),
+    {
+      ...options,
+      method: "POST",
+    },
+  );
+};
+
+export const getRestoreMemberMutationOptions = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(options?: {
+  mutation?: UseMutationOptions<
+    Awaited<ReturnType<typeof restoreMember>>,
+    TError,
+    {
+      organizationID: string | undefined | null;
+      id: string | undefined | null;
+    },
+    TContext
+  >;
+  request?: SecondParameter<typeof customFetch>;
+}): UseMutationOptions<
+  Awaited<ReturnType<typeof restoreMember>>,
+  TError,
+  { organizationID: string | undefined | null; id: string | undefined | null },
+  TContext
+> => {
+  const mutationKey = ["restoreMember"];
+  const { mutation: mutationOptions, request: requestOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey }, request: undefined };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof restoreMember>>,
+    { organizationID: string | undefined | null; id: string | undefined | null }
+  > = (props) => {
+    const { organizationID, id } = props ?? {};
+
+    return restoreMember(organizationID, id, requestOptions);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type RestoreMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof restoreMember>>
+>;
+
+export type RestoreMemberMutationError =
+  | BadRequestResponse
+  | UnauthorizedResponse
+  | NotFoundResponse
+  | UnprocessableEntityResponse
+  | TooManyRequestsResponse
+  | InternalServerErrorResponse;
+
+/**
+ * @summary Restore a member
+ */
+export const useRestoreMember = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: UseMutationOptions<
+      Awaited<ReturnType<typeof restoreMember>>,
+      TError,
+      {
+        organizationID: string | undefined | null;
+        id: string | undefined | null;
+      },
+      TContext
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseMutationResult<
+  Awaited<ReturnType<typeof restoreMember>>,
+  TError,
+  { organizationID: string | undefined | null; id: string | undefined | null },
+  TContext
+> => {
+  const mutationOptions = getRestoreMemberMutationOptions(options);
+
+  return useMutation(mutationOptions, queryClient);
+};
@@ -1127,3 +1127,110 @@ export const useDeleteOrganization = <
 
   return useMutation(mutationOptions, queryClient);
 };
+/**
+ * Restore a deleted organization. Deleted organizations can be restored until they are purged, 30 days after their deletion.
+ * @summary Restore an organization
+ */
+export const getRestoreOrganizationUrl = (id: string | undefined | null) => {
+  return `/organizations/${id}/restore`;
+};
+
+export const restoreOrganization = async (
+  id: string | undefined | null,
+  options?: RequestInit,
+): Promise<OrganizationResponseResponse> => {
+  return customFetch<OrganizationResponseResponse>(getRestoreOrganizationUrl(id), {
+    ...options,
+    method: "POST",
+  });
+};
+
+export const getRestoreOrganizationMutationOptions = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(options?: {
+  mutation?: UseMutationOptions<
+    Awaited<ReturnType<typeof restoreOrganization>>,
+    TError,
+    { id: string | undefined | null },
+    TContext
+  >;
+  request?: SecondParameter<typeof customFetch>;
+}): UseMutationOptions<
+  Awaited<ReturnType<typeof restoreOrganization>>,
+  TError,
+  { id: string | undefined | null },
+  TContext
+> => {
+  const mutationKey = ["restoreOrganization"];
+  const { mutation: mutationOptions, request: requestOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey }, request: undefined };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof restoreOrganization>>,
+    { id: string | undefined | null }
+  > = (props) => {
+    const { id } = props ?? {};
+
+    return restoreOrganization(id, requestOptions);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type RestoreOrganizationMutationResult = NonNullable<
+  Awaited<ReturnType<typeof restoreOrganization>>
+>;
+
+export type RestoreOrganizationMutationError =
+  | BadRequestResponse
+  | UnauthorizedResponse
+  | NotFoundResponse
+  | UnprocessableEntityResponse
+  | TooManyRequestsResponse
+  | InternalServerErrorResponse;
+
+/**
+ * @summary Restore an organization
+ */
+export const useRestoreOrganization = <
+  TError =
+    | BadRequestResponse
+    | UnauthorizedResponse
+    | NotFoundResponse
+    | UnprocessableEntityResponse
+    | TooManyRequestsResponse
+    | InternalServerErrorResponse,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: UseMutationOptions<
+      Awaited<ReturnType<typeof restoreOrganization>>,
+      TError,
+      { id: string | undefined | null },
+      TContext
+    >;
+    request?: SecondParameter<typeof customFetch>;
+  },
+  queryClient?: QueryClient,
+): UseMutationResult<
+  Awaited<ReturnType<typeof restoreOrganization>>,
+  TError,
+  { id: string | undefined | null },
+  TContext
+> => {
+  const mutationOptions = getRestoreOrganizationMutationOptions(options);
+
+  return useMutation(mutationOptions, queryClient);
+};
@@ -63,7 +63,130 @@ export type PipelineAllOf = {
  */
 export type Pipeline = Base & PipelineAllOf;
 
+/**
+ * The kind of step, always http
+ */
+export type HTTPStepConfigKind =
+  (typeof HTTPStepConfigKind)[keyof typeof HTTPStepConfigKind];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const HTTPStepConfigKind = {
+  http: "http",
+} as const;
+
+/**
+ * The HTTP method of the request
+ */
+export type HTTPStepConfigMethod =
+  (typeof HTTPStepConfigMethod)[keyof typeof HTTPStepConfigMethod];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const HTTPStepConfigMethod = {
+  GET: "GET",
+  POST: "POST",
+  PUT: "PUT",
+  PATCH: "PATCH",
+  DELETE: "DELETE",
+} as const;
+
+/**
+ * A step that sends its input to an HTTP endpoint
+ */
+export interface HTTPStepConfig {
+  /** The kind of step, always http */
+  kind?: HTTPStepConfigKind;
+  /** The HTTP method of the request */
+  method: HTTPStepConfigMethod;
+  /**
+   * How long to wait for a response
+   * @minimum 1
+   * @maximum 3600
+   */
+  timeoutSeconds?: number;
+  /**
+   * The URL the request is sent to
+   * @maxLength 2048
+   */
+  url: string;
+}
+
+/**
+ * Script code stored in the step
+ */
+export interface InlineScriptSource {
+  /**
+   * The code of the script
+   * @minLength 1
+   * @maxLength 65536
+   */
+  code: string;
+}
+
+/**
+ * Script code read from an artifact
+ */
+export interface ArtifactScriptSource {
+  /**
+   * The artifact holding the code of the script
+   * @minLength 36
+   * @maxLength 36
+   */
+  artifactID: string;
+}
+
+/**
+ * Where the code of a script step comes from
+ */
+export type ScriptSource = InlineScriptSource | ArtifactScriptSource;
+
+/**
+ * The kind of step, always script
+ */
+export type ScriptStepConfigKind =
+  (typeof ScriptStepConfigKind)[keyof typeof ScriptStepConfigKind];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ScriptStepConfigKind = {
+  script: "script",
+} as const;
+
+/**
+ * The runtime that executes the script
+ */
+export type ScriptStepConfigRuntime =
+  (typeof ScriptStepConfigRuntime)[keyof typeof ScriptStepConfigRuntime];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ScriptStepConfigRuntime = {
+  python: "python",
+  node: "node",
+  bash: "bash",
+} as const;
+
+/**
+ * A step that runs a script on its input
+ */
+export interface ScriptStepConfig {
+  /** The kind of step, always script */
+  kind?: ScriptStepConfigKind;
+  /** The runtime that executes the script */
+  runtime: ScriptStepConfigRuntime;
+  source: ScriptSource;
+}
+
+/**
+ * The configuration of a pipeline step, selected by its kind
+ */
+export type StepConfig = HTTPStepConfig | ScriptStepConfig;
+
+/**
+ * How the step processes its input
+ */
+export type PipelineStepAllOfConfig = StepConfig | null;
+
 export type PipelineStepAllOf = {
+  /** How the step processes its input */
+  config: PipelineStepAllOfConfig;
   /**
    * The pipeline this step belongs to
    * @minLength 36
@@ -1234,7 +1357,7 @@ export interface ConfigAuthFirebase {
   /**
    * Firebase service account client email address
    * @maxLength 255
-   * @pattern ^(?!\.)(?!.*\.\.)([A-Za-z0-9_'+\-\.]*)[A-Za-z0-9_+-]@([A-Za-z0-9][A-Za-z0-9\-]*\.)+[A-Za-z]{2,}$
+   * @pattern ^([A-Za-z0-9_'+\-]+\.)*[A-Za-z0-9_'+\-]*[A-Za-z0-9_+\-]@([A-Za-z0-9][A-Za-z0-9\-]*\.)+[A-Za-z]{2,}$
    */
   clientEmail?: string;
   /**
@@ -1576,7 +1699,7 @@ export interface ConfigEmail {
   /**
    * Username for the email service
    * @maxLength 255
-   * @pattern ^(?!\.)(?!.*\.\.)([A-Za-z0-9_'+\-\.]*)[A-Za-z0-9_+-]@([A-Za-z0-9][A-Za-z0-9\-]*\.)+[A-Za-z]{2,}$
+   * @pattern ^([A-Za-z0-9_'+\-]+\.)*[A-Za-z0-9_'+\-]*[A-Za-z0-9_+\-]@([A-Za-z0-9][A-Za-z0-9\-]*\.)+[A-Za-z]{2,}$
    */
   user?: string;
   /**
@@ -2452,6 +2575,31 @@ export type SessionResponseResponse = {
   data: Session;
 };
 
+/**
+ * Session updated, with tokens scoped to its active organization
+ */
+export type SessionUpdatedResponseResponse = {
+  data: Session;
+  /**
+   * Access token scoped to the active organization of the session
+   * @minLength 1
+   * @maxLength 4096
+   */
+  accessToken: string;
+  /**
+   * Refresh token of the session
+   * @minLength 1
+   * @maxLength 4096
+   */
+  refreshToken: string;
+  /**
+   * Seconds until the access token expires
+   * @minimum 1
+   * @maximum 2147483647
+   */
+  expiresIn: number;
+};
+
 export type UserListResponseResponse = {
   /** @maxItems 10000 */
   data: User[];
@@ -3552,6 +3700,7 @@ export type LabelsSortParameterItem = {
 export type LabelsSortParameter = LabelsSortParameterItem[];
 
 export type CreatePipelineStepBody = {
+  config?: StepConfig;
   /**
    * UUID identifier
    * @minLength 36
@@ -3739,7 +3888,7 @@ export type RegisterBody = {
    * The email address for the new account
    * @minLength 1
    * @maxLength 255
-   * @pattern ^(?!\.)(?!.*\.\.)([A-Za-z0-9_'+\-\.]*)[A-Za-z0-9_+-]@([A-Za-z0-9][A-Za-z0-9\-]*\.)+[A-Za-z]{2,}$
+   * @pattern ^([A-Za-z0-9_'+\-]+\.)*[A-Za-z0-9_'+\-]*[A-Za-z0-9_+\-]@([A-Za-z0-9][A-Za-z0-9\-]*\.)+[A-Za-z]{2,}$
    */
   email: string;
   /**
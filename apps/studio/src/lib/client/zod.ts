@@ -549,6 +549,78 @@ export const deleteArtifactParams = zod.object({
 })
 
 
+/**
+ * Restore a deleted artifact. Deleted artifacts can be restored until they are purged, 30 days after their deletion.
+ * @summary Restore an artifact
+ */
+export const restoreArtifactPathIdMin = 36;
+export const restoreArtifactPathIdMax = 36;
+
+
+
+export const restoreArtifactParams = zod.object({
+  "id": zod.string().uuid().min(restoreArtifactPathIdMin).max(restoreArtifactPathIdMax).describe('The unique identifier of the resource.')
+})
+
+export const restoreArtifactResponseDataCreatedAtMax = 255;
+
+export const restoreArtifactResponseDataIdMin = 36;
+export const restoreArtifactResponseDataIdMax = 36;
+
+export const restoreArtifactResponseDataUpdatedAtMax = 255;
+
+export const restoreArtifactResponseDataNameMax = 255;
+
+
+export const restoreArtifactResponseDataNameRegExp = new RegExp('^[\\w\\s\\-.,!?()@#+/\']+$');
+export const restoreArtifactResponseDataDescriptionMax = 1000;
+
+
+export const restoreArtifactResponseDataDescriptionRegExp = new RegExp('^[\\w\\s\\-.,!?()@#+/\':;]+$');
+export const restoreArtifactResponseDataCreditsDefault = 0;
+export const restoreArtifactResponseDataCreditsMin = 0;
+export const restoreArtifactResponseDataCreditsMax = 2147483647;
+
+export const restoreArtifactResponseDataMimeTypeDefault = "application/octet-stream";
+export const restoreArtifactResponseDataMimeTypeMax = 255;
+
+
+export const restoreArtifactResponseDataMimeTypeRegExp = new RegExp('^[a-z]+/[a-z0-9\\+\\-\\.]+$');
+export const restoreArtifactResponseDataOrganizationIDMin = 36;
+export const restoreArtifactResponseDataOrganizationIDMax = 36;
+
+export const restoreArtifactResponseDataPreviewImageMax = 2048;
+
+export const restoreArtifactResponseDataProducerIDMin = 36;
+export const restoreArtifactResponseDataProducerIDMax = 36;
+
+export const restoreArtifactResponseDataTextMax = 255;
+
+
+export const restoreArtifactResponseDataTextRegExp = new RegExp('^[\\w\\s\\-.,!?()@#+/\':;]+$');
+export const restoreArtifactResponseDataUrlMax = 2048;
+
+
+
+export const restoreArtifactResponse = zod.object({
+  "data": zod.object({
+  "createdAt": zod.string().datetime({}).min(1).max(restoreArtifactResponseDataCreatedAtMax).describe('The date and time when the resource was created'),
+  "id": zod.string().uuid().min(restoreArtifactResponseDataIdMin).max(restoreArtifactResponseDataIdMax).describe('Unique identifier for the resource'),
+  "updatedAt": zod.string().datetime({}).min(1).max(restoreArtifactResponseDataUpdatedAtMax).describe('The date and time when the resource was last updated')
+}).describe('Base schema for all entities with common fields').and(zod.object({
+  "name": zod.string().min(1).max(restoreArtifactResponseDataNameMax).regex(restoreArtifactResponseDataNameRegExp).nullable().describe('The name of the artifact, used for display purposes'),
+  "description": zod.string().min(1).max(restoreArtifactResponseDataDescriptionMax).regex(restoreArtifactResponseDataDescriptionRegExp).nullable().describe('The artifact\'s description'),
+  "credits": zod.number().min(restoreArtifactResponseDataCreditsMin).max(restoreArtifactResponseDataCreditsMax).describe('The number of credits required to access this artifact. This is used for metering and billing purposes.'),
+  "mimeType": zod.string().min(1).max(restoreArtifactResponseDataMimeTypeMax).regex(restoreArtifactResponseDataMimeTypeRegExp).describe('The MIME type of the artifact, e.g. image/png'),
+  "organizationID": zod.string().uuid().min(restoreArtifactResponseDataOrganizationIDMin).max(restoreArtifactResponseDataOrganizationIDMax).describe('The organization that owns this artifact'),
+  "previewImage": zod.string().url().min(1).max(restoreArtifactResponseDataPreviewImageMax).nullable().describe('The URL of the preview image for this artifact. This is used for displaying a thumbnail in the UI.'),
+  "producerID": zod.string().uuid().min(restoreArtifactResponseDataProducerIDMin).max(restoreArtifactResponseDataProducerIDMax).nullable().describe('The ID of the entity that produced this artifact'),
+  "text": zod.string().min(1).max(restoreArtifactResponseDataTextMax).regex(restoreArtifactResponseDataTextRegExp).nullable().describe('The artifact text'),
+  "url": zod.string().url().min(1).max(restoreArtifactResponseDataUrlMax).nullable().describe('The URL of the artifact if it\'s stored externally')
+})).describe('Schema for Artifact entity')
+})
+
+
 /**
  * Update an artifact
  * @summary Update an artifact
@@ -639,6 +711,91 @@ export const updateArtifactResponse = zod.object({
 })
 
 
+/**
+ * List the labels of an artifact
+ * @summary List the labels of an artifact
+ */
+export const listArtifactLabelsPathIdMin = 36;
+export const listArtifactLabelsPathIdMax = 36;
+
+
+
+export const listArtifactLabelsParams = zod.object({
+  "id": zod.string().uuid().min(listArtifactLabelsPathIdMin).max(listArtifactLabelsPathIdMax).describe('The unique identifier of the resource.')
+})
+
+export const listArtifactLabelsResponseDataItemCreatedAtMax = 255;
+
+export const listArtifactLabelsResponseDataItemIdMin = 36;
+export const listArtifactLabelsResponseDataItemIdMax = 36;
+
+export const listArtifactLabelsResponseDataItemUpdatedAtMax = 255;
+
+export const listArtifactLabelsResponseDataItemNameMax = 255;
+
+
+export const listArtifactLabelsResponseDataItemNameRegExp = new RegExp('^[\\w\\s\\-.,!?()@#+/\']+$');
+export const listArtifactLabelsResponseDataItemOrganizationIDMin = 36;
+export const listArtifactLabelsResponseDataItemOrganizationIDMax = 36;
+
+export const listArtifactLabelsResponseDataMax = 10000;
+
+export const listArtifactLabelsResponseMetaTotalMin = 0;
+export const listArtifactLabelsResponseMetaTotalMax = 2147483647;
+
+
+
+export const listArtifactLabelsResponse = zod.object({
+  "data": zod.array(zod.object({
+  "createdAt": zod.string().datetime({}).min(1).max(listArtifactLabelsResponseDataItemCreatedAtMax).describe('The date and time when the resource was created'),
+  "id": zod.string().uuid().min(listArtifactLabelsResponseDataItemIdMin).max(listArtifactLabelsResponseDataItemIdMax).describe('Unique identifier for the resource'),
+  "updatedAt": zod.string().datetime({}).min(1).max(listArtifactLabelsResponseDataItemUpdatedAtMax).describe('The date and time when the resource was last updated')
+}).describe('Base schema for all entities with common fields').and(zod.object({
+  "name": zod.string().min(1).max(listArtifactLabelsResponseDataItemNameMax).regex(listArtifactLabelsResponseDataItemNameRegExp).describe('The name of the label'),
+  "organizationID": zod.string().uuid().min(listArtifactLabelsResponseDataItemOrganizationIDMin).max(listArtifactLabelsResponseDataItemOrganizationIDMax).describe('The organization this label belongs to')
+})).describe('Schema for Label entity')).max(listArtifactLabelsResponseDataMax),
+  "meta": zod.object({
+  "total": zod.number().min(listArtifactLabelsResponseMetaTotalMin).max(listArtifactLabelsResponseMetaTotalMax).describe('Total number of items in the collection')
+}).describe('Pagination metadata')
+})
+
+
+/**
+ * Add a label to an artifact. Adding a label twice has no effect.
+ * @summary Add a label to an artifact
+ */
+export const addArtifactLabelPathIdMin = 36;
+export const addArtifactLabelPathIdMax = 36;
+
+export const addArtifactLabelPathLabelIDMin = 36;
+export const addArtifactLabelPathLabelIDMax = 36;
+
+
+
+export const addArtifactLabelParams = zod.object({
+  "id": zod.string().uuid().min(addArtifactLabelPathIdMin).max(addArtifactLabelPathIdMax).describe('The unique identifier of the resource.'),
+  "labelID": zod.string().uuid().min(addArtifactLabelPathLabelIDMin).max(addArtifactLabelPathLabelIDMax).describe('The unique identifier of the label.')
+})
+
+
+/**
+ * Remove a label from an artifact. Removing a label the artifact does not have has no effect.
+ * @summary Remove a label from an artifact
+ */
+export const removeArtifactLabelPathIdMin = 36;
+export const removeArtifactLabelPathIdMax = 36;
+
+export const removeArtifactLabelPathLabelIDMin = 36;
+export const removeArtifactLabelPathLabelIDMax = 36;
+
+
+
+export const removeArtifactLabelParams = zod.object({
+  "id": zod.string().uuid().min(removeArtifactLabelPathIdMin).max(removeArtifactLabelPathIdMax).describe('The unique identifier of the resource.'),
+  "labelID": zod.string().uuid().min(removeArtifactLabelPathLabelIDMin).max(removeArtifactLabelPathLabelIDMax).describe('The unique identifier of the label.')
+})
+
+
 /**
  * List all linked authentication providers for the current user
  * @summary List linked accounts
@@ -1338,7 +1495,7 @@ export const registerBodyNameRegExp = new RegExp('^[\\w\\s\\-.,!?()@#+/]*$');
 export const registerBodyEmailMax = 255;
 
 
-export const registerBodyEmailRegExp = new RegExp('^(?!\\.)(?!.*\\.\\.)([A-Za-z0-9_\'+\\-\\.]*)[A-Za-z0-9_+-]@([A-Za-z0-9][A-Za-z0-9\\-]*\\.)+[A-Za-z]{2,}$');
+export const registerBodyEmailRegExp = new RegExp('^([A-Za-z0-9_\'+\\-]+\\.)*[A-Za-z0-9_\'+\\-]*[A-Za-z0-9_+\\-]@([A-Za-z0-9][A-Za-z0-9\\-]*\\.)+[A-Za-z]{2,}$');
 export const registerBodyPasswordMin = 8;
 export const registerBodyPasswordMax = 72;
 
@@ -1536,7 +1693,7 @@ export const deleteSessionParams = zod.object({
 
 
 /**
- * This endpoint will update the active organization for the current session
+ * This endpoint will update the active organization for the current session and issue tokens scoped to it. The caller must be a member of the organization.
  * @summary Update Session
  */
 export const updateSessionPathIdMin = 36;
@@ -1589,6 +1746,14 @@ export const updateSessionResponseDataUserIDMax = 36;
 
 
 
+export const updateSessionResponseAccessTokenMax = 4096;
+
+export const updateSessionResponseRefreshTokenMax = 4096;
+
+export const updateSessionResponseExpiresInMax = 2147483647;
+
+
+
 export const updateSessionResponse = zod.object({
   "data": zod.object({
   "createdAt": zod.string().datetime({}).min(1).max(updateSessionResponseDataCreatedAtMax).describe('The date and time when the resource was created'),
@@ -1604,6 +1769,10 @@ export const updateSessionResponse = zod.object({
   "userAgent": zod.string().min(1).max(updateSessionResponseDataUserAgentMax).regex(updateSessionResponseDataUserAgentRegExp).nullable().describe('The user agent of the session'),
   "userID": zod.string().uuid().min(updateSessionResponseDataUserIDMin).max(updateSessionResponseDataUserIDMax).describe('The user who owns this session')
 })).describe('Schema for Session entity')
+,
+  "accessToken": zod.string().min(1).max(updateSessionResponseAccessTokenMax).describe('Access token scoped to the active organization of the session'),
+  "refreshToken": zod.string().min(1).max(updateSessionResponseRefreshTokenMax).describe('Refresh token of the session'),
+  "expiresIn": zod.number().min(1).max(updateSessionResponseExpiresInMax).describe('Seconds until the access token expires')
 })
 
 
@@ -1718,7 +1887,7 @@ export const getConfigResponseDataApiEmailServiceRegExp = new RegExp('^[\\w\\-]+
 export const getConfigResponseDataApiEmailUserMax = 255;
 
 
-export const getConfigResponseDataApiEmailUserRegExp = new RegExp('^(?!\\.)(?!.*\\.\\.)([A-Za-z0-9_\'+\\-\\.]*)[A-Za-z0-9_+-]@([A-Za-z0-9][A-Za-z0-9\\-]*\\.)+[A-Za-z]{2,}$');
+export const getConfigResponseDataApiEmailUserRegExp = new RegExp('^([A-Za-z0-9_\'+\\-]+\\.)*[A-Za-z0-9_\'+\\-]*[A-Za-z0-9_+\\-]@([A-Za-z0-9][A-Za-z0-9\\-]*\\.)+[A-Za-z]{2,}$');
 export const getConfigResponseDataApiEnvironmentDefault = "development";export const getConfigResponseDataApiHostDefault = "0.0.0.0";
 export const getConfigResponseDataApiHostMax = 255;
 
@@ -3489,6 +3658,67 @@ export const deleteOrganizationParams = zod.object({
 })
 
 
+/**
+ * Restore a deleted organization. Deleted organizations can be restored until they are purged, 30 days after their deletion.
+ * @summary Restore an organization
+ */
+export const restoreOrganizationPathIdMin = 36;
+export const restoreOrganizationPathIdMax = 36;
+
+
+
+export const restoreOrganizationParams = zod.object({
+  "id": zod.string().uuid().min(restoreOrganizationPathIdMin).max(restoreOrganizationPathIdMax).describe('The unique identifier of the resource.')
+})
+
+export const restoreOrganizationResponseDataCreatedAtMax = 255;
+
+export const restoreOrganizationResponseDataIdMin = 36;
+export const restoreOrganizationResponseDataIdMax = 36;
+
+export const restoreOrganizationResponseDataUpdatedAtMax = 255;
+
+export const restoreOrganizationResponseDataNameMax = 255;
+
+
+export const restoreOrganizationResponseDataNameRegExp = new RegExp('^[\\w\\s\\-.,!?()@#+/\'&]+$');
+export const restoreOrganizationResponseDataBillingEmailMin = 5;
+export const restoreOrganizationResponseDataBillingEmailMax = 255;
+
+export const restoreOrganizationResponseDataCreditsDefault = 0;
+export const restoreOrganizationResponseDataCreditsMin = 0;
+export const restoreOrganizationResponseDataCreditsMax = 2147483647;
+
+export const restoreOrganizationResponseDataLogoMax = 2048;
+
+export const restoreOrganizationResponseDataPlanDefault = "FREE";export const restoreOrganizationResponseDataSlugMin = 3;
+export const restoreOrganizationResponseDataSlugMax = 50;
+
+
+export const restoreOrganizationResponseDataSlugRegExp = new RegExp('^[a-z0-9]+(?:-[a-z0-9]+)*$');
+export const restoreOrganizationResponseDataStripeCustomerIdentifierMax = 255;
+
+
+export const restoreOrganizationResponseDataStripeCustomerIdentifierRegExp = new RegExp('^cus_[a-zA-Z0-9]+$');
+
+
+export const restoreOrganizationResponse = zod.object({
+  "data": zod.object({
+  "createdAt": zod.string().datetime({}).min(1).max(restoreOrganizationResponseDataCreatedAtMax).describe('The date and time when the resource was created'),
+  "id": zod.string().uuid().min(restoreOrganizationResponseDataIdMin).max(restoreOrganizationResponseDataIdMax).describe('Unique identifier for the resource'),
+  "updatedAt": zod.string().datetime({}).min(1).max(restoreOrganizationResponseDataUpdatedAtMax).describe('The date and time when the resource was last updated')
+}).describe('Base schema for all entities with common fields').and(zod.object({
+  "name": zod.string().min(1).max(restoreOrganizationResponseDataNameMax).regex(restoreOrganizationResponseDataNameRegExp).describe('The organization\'s display name'),
+  "billingEmail": zod.string().email().min(restoreOrganizationResponseDataBillingEmailMin).max(restoreOrganizationResponseDataBillingEmailMax).nullable().describe('Email address for billing communications'),
+  "credits": zod.number().min(restoreOrganizationResponseDataCreditsMin).max(restoreOrganizationResponseDataCreditsMax).describe('Available credits for this organization'),
+  "logo": zod.string().url().min(1).max(restoreOrganizationResponseDataLogoMax).nullable().describe('The organization\'s logo URL'),
+  "plan": zod.enum(['FREE', 'BASIC', 'STANDARD', 'PREMIUM', 'UNLIMITED']).describe('The current subscription plan'),
+  "slug": zod.string().min(restoreOrganizationResponseDataSlugMin).max(restoreOrganizationResponseDataSlugMax).regex(restoreOrganizationResponseDataSlugRegExp).describe('URL-friendly unique identifier for the organization'),
+  "stripeCustomerIdentifier": zod.string().min(1).max(restoreOrganizationResponseDataStripeCustomerIdentifierMax).regex(restoreOrganizationResponseDataStripeCustomerIdentifierRegExp).describe('Stripe customer identifier')
+})).describe('Schema for Organization entity')
+})
+
+
 /**
  * Update an organization
  * @summary Update an organization
@@ -3980,6 +4210,51 @@ export const deleteMemberParams = zod.object({
 })
 
 
+/**
+ * Restore a deleted member. Deleted members can be restored until they are purged, 30 days after their deletion.
+ * @summary Restore a member
+ */
+export const restoreMemberPathOrganizationIDMin = 36;
+export const restoreMemberPathOrganizationIDMax = 36;
+
+export const restoreMemberPathIdMin = 36;
+export const restoreMemberPathIdMax = 36;
+
+
+
+export const restoreMemberParams = zod.object({
+  "organizationID": zod.string().uuid().min(restoreMemberPathOrganizationIDMin).max(restoreMemberPathOrganizationIDMax).describe('The unique identifier of the organization.'),
+  "id": zod.string().uuid().min(restoreMemberPathIdMin).max(restoreMemberPathIdMax).describe('The unique identifier of the resource.')
+})
+
+export const restoreMemberResponseDataCreatedAtMax = 255;
+
+export const restoreMemberResponseDataIdMin = 36;
+export const restoreMemberResponseDataIdMax = 36;
+
+export const restoreMemberResponseDataUpdatedAtMax = 255;
+
+export const restoreMemberResponseDataOrganizationIDMin = 36;
+export const restoreMemberResponseDataOrganizationIDMax = 36;
+
+export const restoreMemberResponseDataRoleDefault = "basic";export const restoreMemberResponseDataUserIDMin = 36;
+export const restoreMemberResponseDataUserIDMax = 36;
+
+
+
+export const restoreMemberResponse = zod.object({
+  "data": zod.object({
+  "createdAt": zod.string().datetime({}).min(1).max(restoreMemberResponseDataCreatedAtMax).describe('The date and time when the resource was created'),
+  "id": zod.string().uuid().min(restoreMemberResponseDataIdMin).max(restoreMemberResponseDataIdMax).describe('Unique identifier for the resource'),
+  "updatedAt": zod.string().datetime({}).min(1).max(restoreMemberResponseDataUpdatedAtMax).describe('The date and time when the resource was last updated')
+}).describe('Base schema for all entities with common fields').and(zod.object({
+  "organizationID": zod.string().uuid().min(restoreMemberResponseDataOrganizationIDMin).max(restoreMemberResponseDataOrganizationIDMax).describe('The organization this member belongs to'),
+  "role": zod.enum(['admin', 'owner', 'basic']).describe('The role of the member'),
+  "userID": zod.string().uuid().min(restoreMemberResponseDataUserIDMin).max(restoreMemberResponseDataUserIDMax).describe('The user who is a member of the organization')
+})).describe('Schema for Member entity')
+})
+
+
 /**
  * Update a member
  * @summary Update a member
@@ -4367,6 +4642,16 @@ export const getPipelineStepsResponseDataItemIdMax = 36;
 
 export const getPipelineStepsResponseDataItemUpdatedAtMax = 255;
 
+export const getPipelineStepsResponseDataItemConfigOneTimeoutSecondsMin = 1;
+export const getPipelineStepsResponseDataItemConfigOneTimeoutSecondsMax = 3600;
+
+export const getPipelineStepsResponseDataItemConfigOneUrlMax = 2048;
+
+export const getPipelineStepsResponseDataItemConfigTwoSourceOneCodeMax = 65536;
+
+export const getPipelineStepsResponseDataItemConfigTwoSourceTwoArtifactIDMin = 36;
+export const getPipelineStepsResponseDataItemConfigTwoSourceTwoArtifactIDMax = 36;
+
 export const getPipelineStepsResponseDataItemPipelineIDMin = 36;
 export const getPipelineStepsResponseDataItemPipelineIDMax = 36;
 
@@ -4383,6 +4668,20 @@ export const getPipelineStepsResponse = zod.object({
   "id": zod.string().uuid().min(getPipelineStepsResponseDataItemIdMin).max(getPipelineStepsResponseDataItemIdMax).describe('Unique identifier for the resource'),
   "updatedAt": zod.string().datetime({}).min(1).max(getPipelineStepsResponseDataItemUpdatedAtMax).describe('The date and time when the resource was last updated')
 }).describe('Base schema for all entities with common fields').and(zod.object({
+  "config": zod.union([zod.union([zod.object({
+  "kind": zod.enum(['http']).optional().describe('The kind of step, always http'),
+  "method": zod.enum(['GET', 'POST', 'PUT', 'PATCH', 'DELETE']).describe('The HTTP method of the request'),
+  "timeoutSeconds": zod.number().min(getPipelineStepsResponseDataItemConfigOneTimeoutSecondsMin).max(getPipelineStepsResponseDataItemConfigOneTimeoutSecondsMax).optional().describe('How long to wait for a response'),
+  "url": zod.string().url().max(getPipelineStepsResponseDataItemConfigOneUrlMax).describe('The URL the request is sent to')
+}).describe('A step that sends its input to an HTTP endpoint'),zod.object({
+  "kind": zod.enum(['script']).optional().describe('The kind of step, always script'),
+  "runtime": zod.enum(['python', 'node', 'bash']).describe('The runtime that executes the script'),
+  "source": zod.union([zod.object({
+  "code": zod.string().min(1).max(getPipelineStepsResponseDataItemConfigTwoSourceOneCodeMax).describe('The code of the script')
+}).describe('Script code stored in the step'),zod.object({
+  "artifactID": zod.string().uuid().min(getPipelineStepsResponseDataItemConfigTwoSourceTwoArtifactIDMin).max(getPipelineStepsResponseDataItemConfigTwoSourceTwoArtifactIDMax).describe('The artifact holding the code of the script')
+}).describe('Script code read from an artifact')]).describe('Where the code of a script step comes from')
+}).describe('A step that runs a script on its input')]).describe('The configuration of a pipeline step, selected by its kind'),zod.null()]).describe('How the step processes its input'),
   "pipelineID": zod.string().uuid().min(getPipelineStepsResponseDataItemPipelineIDMin).max(getPipelineStepsResponseDataItemPipelineIDMax).describe('The pipeline this step belongs to'),
   "toolID": zod.string().uuid().min(getPipelineStepsResponseDataItemToolIDMin).max(getPipelineStepsResponseDataItemToolIDMax).describe('The tool used in this step')
 })).describe('Schema for PipelineStep entity')).max(getPipelineStepsResponseDataMax)
@@ -4402,6 +4701,16 @@ export const createPipelineStepParams = zod.object({
   "id": zod.string().uuid().min(createPipelineStepPathIdMin).max(createPipelineStepPathIdMax).describe('The unique identifier of the resource.')
 })
 
+export const createPipelineStepBodyConfigOneTimeoutSecondsMin = 1;
+export const createPipelineStepBodyConfigOneTimeoutSecondsMax = 3600;
+
+export const createPipelineStepBodyConfigOneUrlMax = 2048;
+
+export const createPipelineStepBodyConfigTwoSourceOneCodeMax = 65536;
+
+export const createPipelineStepBodyConfigTwoSourceTwoArtifactIDMin = 36;
+export const createPipelineStepBodyConfigTwoSourceTwoArtifactIDMax = 36;
+
 export const createPipelineStepBodyNameMax = 255;
 
 
@@ -4424,6 +4733,20 @@ export const createPipelineStepBodyToolIDMax = 36;
 
 
 export const createPipelineStepBody = zod.object({
+  "config": zod.union([zod.object({
+  "kind": zod.enum(['http']).optional().describe('The kind of step, always http'),
+  "method": zod.enum(['GET', 'POST', 'PUT', 'PATCH', 'DELETE']).describe('The HTTP method of the request'),
+  "timeoutSeconds": zod.number().min(createPipelineStepBodyConfigOneTimeoutSecondsMin).max(createPipelineStepBodyConfigOneTimeoutSecondsMax).optional().describe('How long to wait for a response'),
+  "url": zod.string().url().max(createPipelineStepBodyConfigOneUrlMax).describe('The URL the request is sent to')
+}).describe('A step that sends its input to an HTTP endpoint'),zod.object({
+  "kind": zod.enum(['script']).optional().describe('The kind of step, always script'),
+  "runtime": zod.enum(['python', 'node', 'bash']).describe('The runtime that executes the script'),
+  "source": zod.union([zod.object({
+  "code": zod.string().min(1).max(createPipelineStepBodyConfigTwoSourceOneCodeMax).describe('The code of the script')
+}).describe('Script code stored in the step'),zod.object({
+  "artifactID": zod.string().uuid().min(createPipelineStepBodyConfigTwoSourceTwoArtifactIDMin).max(createPipelineStepBodyConfigTwoSourceTwoArtifactIDMax).describe('The artifact holding the code of the script')
+}).describe('Script code read from an artifact')]).describe('Where the code of a script step comes from')
+}).describe('A step that runs a script on its input')]).describe('The configuration of a pipeline step, selected by its kind').optional(),
   "name": zod.string().min(1).max(createPipelineStepBodyNameMax).regex(createPipelineStepBodyNameRegExp).describe('Name of the step'),
   "description": zod.string().max(createPipelineStepBodyDescriptionMax).regex(createPipelineStepBodyDescriptionRegExp).optional().describe('Description of what this step does'),
   "dependencies": zod.array(zod.string().uuid().min(createPipelineStepBodyDependenciesItemMin).max(createPipelineStepBodyDependenciesItemMax).describe('UUID identifier')).max(createPipelineStepBodyDependenciesMax).optional().describe('IDs of steps this step depends on'),
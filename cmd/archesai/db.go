@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/archesai/archesai/cmd/archesai/flags"
+	"github.com/archesai/archesai/pkg/config"
+	configmodels "github.com/archesai/archesai/pkg/config/models"
+	"github.com/archesai/archesai/pkg/database"
+)
+
+// dbCmd represents the db parent command
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Database utilities",
+	Long:  `Commands for working with the database of a generated application.`,
+}
+
+// dbMigrateCmd represents the db migrate parent command
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply, revert and inspect migrations",
+	Long: `Apply, revert and inspect the migrations generated by "archesai generate".
+
+The database and migrations directory default to database.url and
+database.type from the config, and infrastructure/<type>/migrations.
+Applied migrations are recorded in the atlas_schema_revisions table.`,
+}
+
+func init() {
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(dbMigrateCmd)
+	flags.SetDBMigrateFlags(dbMigrateCmd)
+}
+
+// migrationTarget returns the database type, URL and migrations directory
+// selected by the flags and the config.
+func migrationTarget() (database.Type, string, string, error) {
+	url, dbType := flags.DBMigrate.URL, flags.DBMigrate.Type
+	if url == "" || dbType == "" {
+		cfg, err := config.NewParser[configmodels.Config]().Load()
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to load config: %w", err)
+		}
+		if db := cfg.Config.Database; db != nil {
+			if dbType == "" && url == "" {
+				dbType = db.Type.String()
+			}
+			if url == "" {
+				url = db.URL
+			}
+		}
+	}
+
+	t := database.DetectTypeFromURL(url)
+	if dbType != "" {
+		t = database.ParseTypeFromString(dbType)
+	}
+
+	dir := flags.DBMigrate.Dir
+	if dir == "" {
+		sub := "postgres"
+		if t == database.TypeSQLite {
+			sub = "sqlite"
+		}
+		dir = filepath.Join("infrastructure", sub, "migrations")
+	}
+	return t, url, dir, nil
+}
+
+// openMigrationRunner connects to the target database and returns a runner
+// for its migrations directory, and a function closing the connection.
+func openMigrationRunner() (*database.MigrationRunner, func(), error) {
+	dbType, url, dir, err := migrationTarget()
+	if err != nil {
+		return nil, nil, err
+	}
+	if url == "" {
+		return nil, nil, fmt.Errorf("no database URL: set --url or database.url")
+	}
+	// The runner reads the "migrations" directory of a filesystem
+	if filepath.Base(dir) != "migrations" {
+		return nil, nil, fmt.Errorf("migrations directory %s must be named migrations", dir)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil, nil, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	db, err := database.Open(dbType.String(), url)
+	if err != nil {
+		return nil, nil, err
+	}
+	runner := database.NewMigrationRunner(db, os.DirFS(filepath.Dir(dir)))
+	if flags.DBMigrate.DryRun {
+		runner = runner.WithDryRun(os.Stdout)
+	}
+	return runner, func() { _ = db.Close() }, nil
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/archesai/archesai/cmd/archesai/flags"
+	"github.com/archesai/archesai/internal/codegen"
+	"github.com/archesai/archesai/internal/codegen/generators"
+	"github.com/archesai/archesai/pkg/database"
+)
+
+var dbMigrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply pending migrations",
+	Long: `Apply all pending migrations. Concurrent runs against the same PostgreSQL
+database wait for each other, so every migration is applied once.
+
+Examples:
+  archesai db migrate up
+  archesai db migrate up --dry-run
+  archesai db migrate up --url postgresql://localhost:5432/app`,
+	Args:          cobra.NoArgs,
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return withMigrationRunner((*database.MigrationRunner).Up)
+	},
+}
+
+var dbMigrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Revert the last applied migration",
+	Long: `Revert the last applied migration by running its down migration, the file
+of the same name ending in .down.sql.
+
+Examples:
+  archesai db migrate down
+  archesai db migrate down --dry-run`,
+	Args:          cobra.NoArgs,
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return withMigrationRunner((*database.MigrationRunner).Down)
+	},
+}
+
+var dbMigrateToCmd = &cobra.Command{
+	Use:   "to <version>",
+	Short: "Migrate up or down to a version",
+	Long: `Apply or revert migrations until the given migration is the last one
+applied. The version is the migration number or the file name without .sql.
+
+Examples:
+  archesai db migrate to 3
+  archesai db migrate to 0003.gen --dry-run`,
+	Args:          cobra.ExactArgs(1),
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE: func(_ *cobra.Command, args []string) error {
+		return withMigrationRunner(func(r *database.MigrationRunner) error { return r.To(args[0]) })
+	},
+}
+
+var dbMigrateStatusCmd = &cobra.Command{
+	Use:           "status",
+	Short:         "List migrations and whether they are applied",
+	Args:          cobra.NoArgs,
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE:          runDBMigrateStatus,
+}
+
+var dbMigrateNewCmd = &cobra.Command{
+	Use:   "new <name>",
+	Short: "Create an empty migration to write by hand",
+	Long: `Create an empty migration and its down migration, numbered after the
+existing migrations. Offline migration generation does not see hand-written
+migrations; see the database guide.
+
+Examples:
+  archesai db migrate new backfill_slugs`,
+	Args:          cobra.ExactArgs(1),
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE:          runDBMigrateNew,
+}
+
+var dbMigrateHashCmd = &cobra.Command{
+	Use:   "hash",
+	Short: "Record the checksums of the migrations",
+	Long: `Write the atlas.sum file of the migrations directory. Once it exists,
+migrations that no longer match it are refused, and migration generation
+keeps it up to date. Run it again after editing a migration by hand.`,
+	Args:          cobra.NoArgs,
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE:          runDBMigrateHash,
+}
+
+var dbMigrateSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Record the schema snapshot of the PostgreSQL migrations",
+	Long: `Replay the PostgreSQL migrations and write the schema they produce to
+schema.snapshot.hcl in the migrations directory, which offline migration
+generation diffs against. Run it once for migrations that predate the
+snapshot, and again after editing a migration by hand.
+
+The migrations are replayed in a PostgreSQL testcontainer, or in the empty
+database at codegen.devURL when codegen.migrations is dev. Neither --url nor
+the database of the config is touched.
+
+Examples:
+  archesai db migrate snapshot
+  archesai db migrate snapshot --dir infrastructure/postgres/migrations`,
+	Args:          cobra.NoArgs,
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE:          runDBMigrateSnapshot,
+}
+
+func init() {
+	dbMigrateCmd.AddCommand(
+		dbMigrateUpCmd, dbMigrateDownCmd, dbMigrateToCmd,
+		dbMigrateStatusCmd, dbMigrateNewCmd, dbMigrateHashCmd,
+		dbMigrateSnapshotCmd,
+	)
+	flags.SetDBMigrateDryRunFlag(dbMigrateUpCmd)
+	flags.SetDBMigrateDryRunFlag(dbMigrateDownCmd)
+	flags.SetDBMigrateDryRunFlag(dbMigrateToCmd)
+}
+
+// withMigrationRunner calls fn with a runner for the target database.
+func withMigrationRunner(fn func(*database.MigrationRunner) error) error {
+	runner, closeDB, err := openMigrationRunner()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	return fn(runner)
+}
+
+func runDBMigrateStatus(_ *cobra.Command, _ []string) error {
+	runner, closeDB, err := openMigrationRunner()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	statuses, err := runner.Status()
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "VERSION\tSTATUS\tEXECUTED\tDOWN")
+	for _, s := range statuses {
+		status, executed, down := "pending", "-", "no"
+		switch {
+		case s.Name == "":
+			status = "missing file"
+		case s.Error != "":
+			status = "failed: " + s.Error
+		case s.Applied:
+			status = "applied"
+		case !s.ExecutedAt.IsZero():
+			status = "partial"
+		}
+		if !s.ExecutedAt.IsZero() {
+			executed = s.ExecutedAt.Local().Format(time.DateTime)
+		}
+		if s.Reversible {
+			down = "yes"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Version, status, executed, down)
+	}
+	return w.Flush()
+}
+
+func runDBMigrateNew(_ *cobra.Command, args []string) error {
+	_, _, dir, err := migrationTarget()
+	if err != nil {
+		return err
+	}
+	up, down, err := database.CreateMigration(dir, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created %s\nCreated %s\n", up, down)
+	return nil
+}
+
+func runDBMigrateHash(_ *cobra.Command, _ []string) error {
+	_, _, dir, err := migrationTarget()
+	if err != nil {
+		return err
+	}
+	if err := database.WriteMigrationSum(dir); err != nil {
+		return err
+	}
+	fmt.Printf("Updated %s\n", dir)
+	return nil
+}
+
+func runDBMigrateSnapshot(cmd *cobra.Command, _ []string) error {
+	cfg, err := codegen.LoadConfig()
+	if err != nil {
+		return err
+	}
+	var devURL string
+	if cfg.Migrations != nil && generators.MigrationMode(*cfg.Migrations) == generators.MigrationModeDev {
+		if cfg.DevURL == nil || *cfg.DevURL == "" {
+			return fmt.Errorf("codegen.devURL is required when codegen.migrations is %s", generators.MigrationModeDev)
+		}
+		devURL = *cfg.DevURL
+	}
+
+	dir := flags.DBMigrate.Dir
+	if dir == "" {
+		dir = filepath.Join("infrastructure", "postgres", "migrations")
+	}
+	path, err := generators.RecordSchemaSnapshot(cmd.Context(), dir, devURL)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Updated %s\n", path)
+	return nil
+}
@@ -0,0 +1,33 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// DBMigrateFlags holds the db migrate command flag values.
+type DBMigrateFlags struct {
+	URL    string
+	Type   string
+	Dir    string
+	DryRun bool
+}
+
+// DBMigrate is the global instance of db migrate flags.
+var DBMigrate DBMigrateFlags
+
+// SetDBMigrateFlags configures the flags shared by the db migrate commands.
+func SetDBMigrateFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().
+		StringVar(&DBMigrate.URL, "url", "", "Database URL (default: database.url from the config)")
+	cmd.PersistentFlags().
+		StringVar(&DBMigrate.Type, "type", "", "Database type: postgresql or sqlite (default: detected from --url, or database.type)")
+	cmd.PersistentFlags().
+		StringVar(&DBMigrate.Dir, "dir", "", "Migrations directory (default: infrastructure/<type>/migrations)")
+}
+
+// SetDBMigrateDryRunFlag configures the --dry-run flag on a db migrate
+// command that changes the database.
+func SetDBMigrateDryRunFlag(cmd *cobra.Command) {
+	cmd.Flags().
+		BoolVar(&DBMigrate.DryRun, "dry-run", false, "Print the SQL that would run instead of running it")
+}
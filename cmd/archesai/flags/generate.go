@@ -20,6 +20,12 @@ func SetGenerateFlags(cmd *cobra.Command) {
 	cmd.Flags().
 		StringVar(&Generate.Only, "only", "", "Only generate specific components (comma-separated: models,repositories,postgres,sqlite,application,controllers,hcl,sqlc,client,bootstrap)")
 	cmd.Flags().BoolVarP(&Generate.TUI, "tui", "t", false, "Enable TUI mode with progress display")
+	cmd.Flags().
+		BoolVar(&Generate.DryRun, "dry-run", false, "Print a unified diff of the changes instead of writing files")
+	cmd.Flags().
+		BoolVar(&Generate.Check, "check", false, "Exit non-zero if any generated file would change (writes nothing)")
+	cmd.Flags().
+		BoolVar(&Generate.AllowDestructive, "allow-destructive", false, "Allow migrations that drop data, rewrite tables or block writes")
 
 	_ = cmd.MarkFlagRequired("output")
 	_ = cmd.MarkFlagRequired("spec")
@@ -15,12 +15,20 @@ type SpecShowFlags struct {
 	JSON     bool
 }
 
+// SpecDiffFlags holds the spec diff command flag values.
+type SpecDiffFlags struct {
+	Format string
+}
+
 // SpecLint is the global instance of spec lint flags.
 var SpecLint SpecLintFlags
 
 // SpecShow is the global instance of spec show flags.
 var SpecShow SpecShowFlags
 
+// SpecDiff is the global instance of spec diff flags.
+var SpecDiff SpecDiffFlags
+
 // SetSpecLintFlags configures flags on the spec lint command.
 func SetSpecLintFlags(cmd *cobra.Command) {
 	cmd.Flags().
@@ -35,3 +43,9 @@ func SetSpecShowFlags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&SpecShow.JSON, "json", false, "Output as JSON instead of YAML")
 	_ = cmd.MarkFlagRequired("spec")
 }
+
+// SetSpecDiffFlags configures flags on the spec diff command.
+func SetSpecDiffFlags(cmd *cobra.Command) {
+	cmd.Flags().
+		StringVar(&SpecDiff.Format, "format", "text", "Report format: text, json or markdown")
+}
@@ -0,0 +1,22 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// TemplatesEjectFlags holds the templates eject command flag values.
+type TemplatesEjectFlags struct {
+	Dir   string
+	Force bool
+}
+
+// TemplatesEject is the global instance of templates eject flags.
+var TemplatesEject TemplatesEjectFlags
+
+// SetTemplatesEjectFlags configures flags on the templates eject command.
+func SetTemplatesEjectFlags(cmd *cobra.Command) {
+	cmd.Flags().
+		StringVar(&TemplatesEject.Dir, "dir", "", "Destination directory (default: codegen.templates from arches.yaml, or ./templates)")
+	cmd.Flags().
+		BoolVar(&TemplatesEject.Force, "force", false, "Overwrite templates that were already ejected")
+}
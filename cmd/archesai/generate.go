@@ -19,17 +19,26 @@ This command generates:
 - HTTP Handlers
 - Application Handlers
 - React frontend and TypeScript client
+- Typed Go client
 - Database schema (HCL and SQLC)
+- Repository contract tests for SQLite and PostgreSQL
+- Mocks of the handler and repository interfaces
 - Bootstrap code (app, container, routes, wire)
 
 Use --only to generate specific components (comma-separated):
-  go.mod, models, repositories, postgres, sqlite, application, controllers,
-  hcl, sqlc, client, app, container, routes, wire, bootstrap (alias for app,container,routes,wire)
+  go.mod, models, repositories, postgres, sqlite, contract_tests, mocks, application, controllers,
+  hcl, sqlc, client, go-client, app, container, routes, wire, bootstrap (alias for app,container,routes,wire)
 
 By default (no --only flag), all components are generated.
 
 The --lint flag enables strict OpenAPI linting. If ANY violations are found,
-code generation will be blocked.`,
+code generation will be blocked.
+
+The --dry-run flag renders everything in memory and prints a unified diff
+against the files on disk without writing anything. The --check flag lists the
+files that would change and exits non-zero if there are any, which lets CI catch
+specs edited without regenerating. Steps that shell out (migrations, sqlc and
+the TypeScript client) are skipped in both modes.`,
 	SilenceErrors: true,
 	SilenceUsage:  true,
 	RunE:          runGenerate,
@@ -42,13 +51,16 @@ func init() {
 
 func runGenerate(_ *cobra.Command, _ []string) error {
 	opts := codegen.Options{
-		OutputPath: flags.Generate.OutputPath,
-		SpecPath:   flags.Generate.SpecPath,
-		Lint:       flags.Generate.Lint,
-		Only:       flags.Generate.Only,
+		OutputPath:       flags.Generate.OutputPath,
+		SpecPath:         flags.Generate.SpecPath,
+		Lint:             flags.Generate.Lint,
+		Only:             flags.Generate.Only,
+		DryRun:           flags.Generate.DryRun,
+		Check:            flags.Generate.Check,
+		AllowDestructive: flags.Generate.AllowDestructive,
 	}
 
-	if flags.Generate.TUI {
+	if flags.Generate.TUI && !opts.DryRun && !opts.Check {
 		return codegen.RunTUI(opts)
 	}
 	return codegen.Run(opts)
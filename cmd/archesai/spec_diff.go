@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/archesai/archesai/cmd/archesai/flags"
+	"github.com/archesai/archesai/internal/openapi"
+	"github.com/archesai/archesai/internal/spec"
+)
+
+// specDiffCmd represents the spec diff command
+var specDiffCmd = &cobra.Command{
+	Use:   "diff <old> <new>",
+	Short: "Report changes between two OpenAPI specifications",
+	Long: `Report the changes between two versions of an OpenAPI specification.
+
+This command compares the operations of both specifications and classifies
+every change as breaking or non-breaking. Removed operations, new required
+parameters or fields, narrowed enums, type changes and removed response
+fields are breaking. The command exits non-zero when any change is breaking,
+so it can guard pull requests in CI.
+
+Examples:
+  archesai spec diff old/openapi.yaml api/openapi.yaml
+  archesai spec diff old/openapi.yaml api/openapi.yaml --format markdown
+  archesai spec diff old/openapi.yaml api/openapi.yaml --format json`,
+	Args:          cobra.ExactArgs(2),
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE:          runSpecDiff,
+}
+
+func init() {
+	specCmd.AddCommand(specDiffCmd)
+	flags.SetSpecDiffFlags(specDiffCmd)
+}
+
+func runSpecDiff(_ *cobra.Command, args []string) error {
+	format := flags.SpecDiff.Format
+	if format != "text" && format != "json" && format != "markdown" {
+		return fmt.Errorf("unknown format %q: use text, json or markdown", format)
+	}
+
+	oldSpec, err := loadSpec(args[0])
+	if err != nil {
+		return err
+	}
+	newSpec, err := loadSpec(args[1])
+	if err != nil {
+		return err
+	}
+
+	diff := spec.Diff(oldSpec, newSpec)
+	switch format {
+	case "markdown":
+		err = diff.WriteMarkdown(os.Stdout)
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(diff)
+	default:
+		err = diff.WriteText(os.Stdout)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	if breaking := diff.Breaking(); len(breaking) > 0 {
+		return fmt.Errorf("found %d breaking changes", len(breaking))
+	}
+	return nil
+}
+
+// loadSpec parses the specification at path and extracts its operations.
+func loadSpec(path string) (*spec.Spec, error) {
+	parser := openapi.NewParser()
+	if _, err := parser.Parse(path); err != nil {
+		return nil, fmt.Errorf("failed to parse spec %s: %w", path, err)
+	}
+	s, err := parser.ExtractSpec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract spec %s: %w", path, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/archesai/archesai/internal/templates"
+)
+
+// templatesCmd represents the templates parent command
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Code generation template utilities",
+	Long: `Commands for customizing the templates used by archesai generate.
+
+Templates in the directory set by codegen.templates in arches.yaml shadow the
+embedded templates with the same name.`,
+}
+
+// templatesListCmd lists the embedded templates
+var templatesListCmd = &cobra.Command{
+	Use:           "list",
+	Short:         "List the embedded templates",
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE:          runTemplatesList,
+}
+
+func init() {
+	rootCmd.AddCommand(templatesCmd)
+	templatesCmd.AddCommand(templatesListCmd)
+}
+
+func runTemplatesList(_ *cobra.Command, _ []string) error {
+	names, err := templates.DefaultNames()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/archesai/archesai/cmd/archesai/flags"
+	"github.com/archesai/archesai/internal/codegen"
+	"github.com/archesai/archesai/internal/templates"
+)
+
+// templatesEjectCmd copies embedded templates out for editing
+var templatesEjectCmd = &cobra.Command{
+	Use:   "eject <name>...",
+	Short: "Copy embedded templates into the override directory",
+	Long: `Copy one or more embedded templates into the template override directory
+so they can be edited. Use "archesai templates list" to see the names.
+
+Examples:
+  archesai templates eject controller.go.tmpl
+  archesai templates eject repository.go.tmpl --dir ./templates`,
+	Args:          cobra.MinimumNArgs(1),
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	RunE:          runTemplatesEject,
+}
+
+func init() {
+	templatesCmd.AddCommand(templatesEjectCmd)
+	flags.SetTemplatesEjectFlags(templatesEjectCmd)
+}
+
+func runTemplatesEject(_ *cobra.Command, args []string) error {
+	dir := flags.TemplatesEject.Dir
+	if dir == "" {
+		cfg, err := codegen.LoadConfig()
+		if err != nil {
+			return err
+		}
+		dir = "templates"
+		if cfg.Templates != nil && *cfg.Templates != "" {
+			dir = *cfg.Templates
+		}
+	}
+
+	paths, err := templates.Eject(dir, args, flags.TemplatesEject.Force)
+	for i, path := range paths {
+		fmt.Printf("Ejected %s to %s\n", args[i], path)
+	}
+	return err
+}
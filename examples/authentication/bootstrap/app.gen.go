@@ -8,8 +8,10 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"time"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/config"
 	configmodels "github.com/archesai/archesai/pkg/config/models"
 	"github.com/archesai/archesai/pkg/database"
@@ -24,6 +26,8 @@ type App struct {
 	db        *database.Database
 	apiServer *server.APIServer
 	handlers  *Handlers
+	relay     *events.OutboxRelay
+	purge     *database.PurgeJob
 }
 
 // NewApp creates a new App.
@@ -57,8 +61,12 @@ func (a *App) Initialize() error {
 	services := &Services{
 		DB:        db,
 		Publisher: events.NewNoOpPublisher(),
+		TxManager: database.NewTxManager(db),
 	}
+	services.Auth = NewAuthService(services, auth.NewConfig(cfg.Config))
 	a.handlers = NewHandlers(services)
+	a.relay = events.NewOutboxRelay(db, services.Publisher, events.DefaultRelayConfig())
+	a.purge = NewPurgeJob(services)
 
 	// Create API server
 	a.apiServer = server.NewAPIServer(&server.APIConfig{
@@ -69,14 +77,38 @@ func (a *App) Initialize() error {
 	// Register routes
 	RegisterRoutes(a.apiServer.Mux(), a.handlers)
 
-	// Apply middleware
-	a.apiServer.ApplyMiddleware()
+	// Apply middleware. Requests with an access token or API key act as its
+	// user, in its organization.
+	a.apiServer.ApplyMiddleware(server.NewAuthMiddleware(services.Auth).OptionalAuth())
 
 	return nil
 }
 
 // Start starts the application and blocks until shutdown signal is received.
 func (a *App) Start() error {
+	// Run background workers until shutdown
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	var workers sync.WaitGroup
+
+	// Publish the events repositories wrote to the outbox
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		if err := a.relay.Run(workerCtx); err != nil {
+			slog.Error("outbox relay error", "error", err)
+		}
+	}()
+
+	// Purge deleted rows once they can no longer be restored
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		if err := a.purge.Run(workerCtx); err != nil {
+			slog.Error("purge job error", "error", err)
+		}
+	}()
+
 	// Start server in goroutine
 	go func() {
 		slog.Info("starting server", "port", a.config.Config.API.Port)
@@ -102,9 +134,13 @@ func (a *App) Start() error {
 		return err
 	}
 
+	// Stop the workers and wait for in-flight work
+	stopWorkers()
+	workers.Wait()
+
 	// Close database
 	if a.db != nil {
-		a.db.SQLDB().Close()
+		a.db.Close()
 	}
 
 	slog.Info("server shutdown complete")
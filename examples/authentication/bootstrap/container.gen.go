@@ -3,7 +3,10 @@
 package bootstrap
 
 import (
+	"github.com/archesai/archesai/pkg/auth"
 	authbootstrap "github.com/archesai/archesai/pkg/auth/bootstrap"
+	authhandlers "github.com/archesai/archesai/pkg/auth/handlers"
+	"github.com/archesai/archesai/pkg/cache"
 	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/events"
 	serverbootstrap "github.com/archesai/archesai/pkg/server/bootstrap"
@@ -15,6 +18,11 @@ import (
 type Services struct {
 	DB        *database.Database
 	Publisher events.Publisher
+	// TxManager runs the writes of several repositories in one transaction.
+	TxManager *database.TxManager
+	// Auth authenticates requests and switches the organization of sessions.
+	// Without it, sessions cannot switch organizations.
+	Auth *auth.Service
 }
 
 // NewHandlers creates all handlers with the given services.
@@ -23,34 +31,132 @@ func NewHandlers(services *Services) *Handlers {
 	db := services.DB.SQLDB()
 
 	if services.DB.IsSQLite() {
+		authorizer := auth.NewMembershipAuthorizer(
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteRoleRepository(db),
+		)
+		authHandlers := authbootstrap.NewApplicationHandlers(
+			sqliterepos.NewSQLiteAPIKeyRepository(db),
+			sqliterepos.NewSQLiteAccountRepository(db),
+			sqliterepos.NewSQLiteInvitationRepository(db),
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteOrganizationRepository(db),
+			sqliterepos.NewSQLiteRoleRepository(db),
+			sqliterepos.NewSQLiteSessionRepository(db),
+			sqliterepos.NewSQLiteUserRepository(db),
+		)
+		authHandlers.CreateAPIKey = authhandlers.NewCreateAPIKeyWith(
+			sqliterepos.NewSQLiteAPIKeyRepository(db),
+		)
+		authHandlers.ListOrganizations = authhandlers.NewListOrganizationsWith(
+			sqliterepos.NewSQLiteOrganizationRepository(db),
+			sqliterepos.NewSQLiteMemberRepository(db),
+		)
+		authHandlers.CreateRole = authhandlers.NewCreateRoleWith(
+			sqliterepos.NewSQLiteRoleRepository(db),
+		)
+		authHandlers.UpdateMember = authhandlers.NewUpdateMemberWith(
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteRoleRepository(db),
+		)
+		if services.Auth != nil {
+			authHandlers.UpdateSession = authhandlers.NewUpdateSessionWith(services.Auth)
+		}
 		return &Handlers{
-			Auth: authbootstrap.NewHTTPHandlers(authbootstrap.NewApplicationHandlers(
-				sqliterepos.NewSQLiteAPIKeyRepository(db),
-				sqliterepos.NewSQLiteAccountRepository(db),
-				sqliterepos.NewSQLiteInvitationRepository(db),
-				sqliterepos.NewSQLiteMemberRepository(db),
-				sqliterepos.NewSQLiteOrganizationRepository(db),
-				sqliterepos.NewSQLiteSessionRepository(db),
-				sqliterepos.NewSQLiteUserRepository(db),
-				services.Publisher,
-			)),
+			Auth:   authbootstrap.NewHTTPHandlers(authHandlers, authorizer),
 			Server: serverbootstrap.NewHTTPHandlers(serverbootstrap.NewApplicationHandlers()),
 		}
 	}
 
 	// PostgreSQL
 	pool := services.DB.PgxPool()
+	authorizer := auth.NewMembershipAuthorizer(
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresRoleRepository(pool),
+	)
+	authHandlers := authbootstrap.NewApplicationHandlers(
+		postgresrepos.NewPostgresAPIKeyRepository(pool),
+		postgresrepos.NewPostgresAccountRepository(pool),
+		postgresrepos.NewPostgresInvitationRepository(pool),
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresOrganizationRepository(pool),
+		postgresrepos.NewPostgresRoleRepository(pool),
+		postgresrepos.NewPostgresSessionRepository(pool),
+		postgresrepos.NewPostgresUserRepository(pool),
+	)
+	authHandlers.CreateAPIKey = authhandlers.NewCreateAPIKeyWith(
+		postgresrepos.NewPostgresAPIKeyRepository(pool),
+	)
+	authHandlers.ListOrganizations = authhandlers.NewListOrganizationsWith(
+		postgresrepos.NewPostgresOrganizationRepository(pool),
+		postgresrepos.NewPostgresMemberRepository(pool),
+	)
+	authHandlers.CreateRole = authhandlers.NewCreateRoleWith(
+		postgresrepos.NewPostgresRoleRepository(pool),
+	)
+	authHandlers.UpdateMember = authhandlers.NewUpdateMemberWith(
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresRoleRepository(pool),
+	)
+	if services.Auth != nil {
+		authHandlers.UpdateSession = authhandlers.NewUpdateSessionWith(services.Auth)
+	}
 	return &Handlers{
-		Auth: authbootstrap.NewHTTPHandlers(authbootstrap.NewApplicationHandlers(
-			postgresrepos.NewPostgresAPIKeyRepository(pool),
-			postgresrepos.NewPostgresAccountRepository(pool),
-			postgresrepos.NewPostgresInvitationRepository(pool),
-			postgresrepos.NewPostgresMemberRepository(pool),
-			postgresrepos.NewPostgresOrganizationRepository(pool),
-			postgresrepos.NewPostgresSessionRepository(pool),
-			postgresrepos.NewPostgresUserRepository(pool),
-			services.Publisher,
-		)),
+		Auth:   authbootstrap.NewHTTPHandlers(authHandlers, authorizer),
 		Server: serverbootstrap.NewHTTPHandlers(serverbootstrap.NewApplicationHandlers()),
 	}
 }
+
+// NewAuthService creates the service that authenticates requests with access
+// tokens and API keys.
+func NewAuthService(services *Services, cfg *auth.Config) *auth.Service {
+	if services.DB.IsSQLite() {
+		db := services.DB.SQLDB()
+		return auth.NewService(
+			cfg,
+			sqliterepos.NewSQLiteSessionRepository(db),
+			sqliterepos.NewSQLiteUserRepository(db),
+			sqliterepos.NewSQLiteAccountRepository(db),
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteAPIKeyRepository(db),
+			services.TxManager,
+			cache.NewMemoryCache[string](),
+			nil,
+			nil,
+		)
+	}
+
+	pool := services.DB.PgxPool()
+	return auth.NewService(
+		cfg,
+		postgresrepos.NewPostgresSessionRepository(pool),
+		postgresrepos.NewPostgresUserRepository(pool),
+		postgresrepos.NewPostgresAccountRepository(pool),
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresAPIKeyRepository(pool),
+		services.TxManager,
+		cache.NewMemoryCache[string](),
+		nil,
+		nil,
+	)
+}
+
+// NewPurgeJob creates the worker that removes soft-deleted rows once they
+// can no longer be restored.
+func NewPurgeJob(services *Services) *database.PurgeJob {
+	if services.DB.IsSQLite() {
+		db := services.DB.SQLDB()
+		return database.NewPurgeJob(
+			database.DefaultPurgeConfig(),
+			sqliterepos.NewSQLiteMemberRepository(db),
+			sqliterepos.NewSQLiteOrganizationRepository(db),
+		)
+	}
+
+	pool := services.DB.PgxPool()
+	return database.NewPurgeJob(
+		database.DefaultPurgeConfig(),
+		postgresrepos.NewPostgresMemberRepository(pool),
+		postgresrepos.NewPostgresOrganizationRepository(pool),
+	)
+}
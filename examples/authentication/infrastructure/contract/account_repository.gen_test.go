@@ -0,0 +1,167 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	postgresrepos "github.com/archesai/examples/authentication/infrastructure/postgres/repositories"
+	sqliterepos "github.com/archesai/examples/authentication/infrastructure/sqlite/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAccountRepository(s *store) repositories.AccountRepository {
+	if s.sqlite != nil {
+		return sqliterepos.NewSQLiteAccountRepository(s.sqlite)
+	}
+	return postgresrepos.NewPostgresAccountRepository(s.pool)
+}
+
+// newAccount returns a account with valid values, creating the rows it references.
+func newAccount(t *testing.T, s *store) *models.Account {
+	t.Helper()
+	return &models.Account{
+		ID:                    uuid.New(),
+		AccessToken:           ptr("ya29.a0AfH6SMBa..."),
+		AccessTokenExpiresAt:  ptr(time.Now().UTC().Truncate(time.Microsecond)),
+		AccountIdentifier:     "google123456789",
+		IDToken:               ptr("eyJhbGciOiJSUzI1NiIs..."),
+		Provider:              models.AccountProvider("google"),
+		RefreshToken:          ptr("1//0fM8N5..."),
+		RefreshTokenExpiresAt: ptr(time.Now().UTC().Truncate(time.Microsecond)),
+		Scope:                 ptr("openid profile email"),
+		UserID:                createUser(t, s).ID,
+	}
+}
+
+// createAccount stores a new account.
+func createAccount(t *testing.T, s *store) *models.Account {
+	t.Helper()
+	created, err := newAccountRepository(s).Create(context.Background(), newAccount(t, s))
+	require.NoError(t, err)
+	return created
+}
+
+func TestAccountRepositoryContract(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		run  func(t *testing.T, s *store, repo repositories.AccountRepository)
+	}{
+		{
+			name: "create and get",
+			run: func(t *testing.T, s *store, repo repositories.AccountRepository) {
+				entity := newAccount(t, s)
+				created, err := repo.Create(ctx, entity)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, created.ID)
+
+				got, err := repo.Get(ctx, entity.ID)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, got.ID)
+				assert.False(t, got.CreatedAt.IsZero(), "CreatedAt")
+				assertEqual(t, "AccessToken", entity.AccessToken, got.AccessToken)
+				assertEqual(t, "AccessTokenExpiresAt", entity.AccessTokenExpiresAt, got.AccessTokenExpiresAt)
+				assertEqual(t, "AccountIdentifier", entity.AccountIdentifier, got.AccountIdentifier)
+				assertEqual(t, "IDToken", entity.IDToken, got.IDToken)
+				assertEqual(t, "Provider", entity.Provider, got.Provider)
+				assertEqual(t, "RefreshToken", entity.RefreshToken, got.RefreshToken)
+				assertEqual(t, "RefreshTokenExpiresAt", entity.RefreshTokenExpiresAt, got.RefreshTokenExpiresAt)
+				assertEqual(t, "Scope", entity.Scope, got.Scope)
+				assertEqual(t, "UserID", entity.UserID, got.UserID)
+			},
+		},
+		{
+			name: "update",
+			run: func(t *testing.T, s *store, repo repositories.AccountRepository) {
+				entity := createAccount(t, s)
+				updated, err := repo.Update(ctx, entity.ID, entity)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, updated.ID)
+
+				got, err := repo.Get(ctx, entity.ID)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, got.ID)
+			},
+		},
+		{
+			name: "delete",
+			run: func(t *testing.T, s *store, repo repositories.AccountRepository) {
+				entity := createAccount(t, s)
+				require.NoError(t, repo.Delete(ctx, entity.ID))
+
+				_, err := repo.Get(ctx, entity.ID)
+				assert.ErrorIs(t, err, models.ErrAccountNotFound)
+				assert.ErrorIs(t, repo.Delete(ctx, entity.ID), models.ErrAccountNotFound)
+			},
+		},
+		{
+			name: "not found",
+			run: func(t *testing.T, s *store, repo repositories.AccountRepository) {
+				missing := uuid.New()
+				_, err := repo.Get(ctx, missing)
+				assert.ErrorIs(t, err, models.ErrAccountNotFound)
+				_, err = repo.Update(ctx, missing, newAccount(t, s))
+				assert.ErrorIs(t, err, models.ErrAccountNotFound)
+				assert.ErrorIs(t, repo.Delete(ctx, missing), models.ErrAccountNotFound)
+			},
+		},
+		{
+			name: "list",
+			run: func(t *testing.T, s *store, repo repositories.AccountRepository) {
+				for range 3 {
+					createAccount(t, s)
+				}
+
+				items, info, err := repo.List(ctx, database.ListOptions{Page: database.Page{Limit: 2}})
+				require.NoError(t, err)
+				assert.Len(t, items, 2)
+				assert.Equal(t, int64(3), info.Total)
+
+				items, _, err = repo.List(ctx, database.ListOptions{Page: database.Page{Limit: 2, Offset: 2}})
+				require.NoError(t, err)
+				assert.Len(t, items, 1)
+			},
+		},
+		{
+			name: "GetAccountByProvider",
+			run: func(t *testing.T, s *store, repo repositories.AccountRepository) {
+				entity := createAccount(t, s)
+				got, err := repo.GetAccountByProvider(ctx, string(entity.Provider), entity.AccountIdentifier)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, got.ID)
+			},
+		},
+		{
+			name: "ListAccountsByUserID",
+			run: func(t *testing.T, s *store, repo repositories.AccountRepository) {
+				entity := createAccount(t, s)
+				items, err := repo.ListAccountsByUserID(ctx, entity.UserID.String())
+				require.NoError(t, err)
+				ids := make([]uuid.UUID, len(items))
+				for i, item := range items {
+					ids[i] = item.ID
+				}
+				assert.Contains(t, ids, entity.ID)
+			},
+		},
+	}
+
+	for _, d := range dialects {
+		t.Run(d.name, func(t *testing.T) {
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					s := d.open(t)
+					tt.run(t, s, newAccountRepository(s))
+				})
+			}
+		})
+	}
+}
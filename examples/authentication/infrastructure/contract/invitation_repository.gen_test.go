@@ -0,0 +1,176 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	postgresrepos "github.com/archesai/examples/authentication/infrastructure/postgres/repositories"
+	sqliterepos "github.com/archesai/examples/authentication/infrastructure/sqlite/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newInvitationRepository(s *store) repositories.InvitationRepository {
+	if s.sqlite != nil {
+		return sqliterepos.NewSQLiteInvitationRepository(s.sqlite)
+	}
+	return postgresrepos.NewPostgresInvitationRepository(s.pool)
+}
+
+// newInvitation returns a invitation with valid values, creating the rows it references.
+func newInvitation(t *testing.T, s *store) *models.Invitation {
+	t.Helper()
+	return &models.Invitation{
+		ID:             uuid.New(),
+		Email:          "user@example.com",
+		ExpiresAt:      time.Now().UTC().Truncate(time.Microsecond),
+		InviterID:      createUser(t, s).ID,
+		OrganizationID: createOrganization(t, s).ID,
+		Role:           models.InvitationRole("admin"),
+		Status:         models.InvitationStatus("pending"),
+	}
+}
+
+// createInvitation stores a new invitation.
+func createInvitation(t *testing.T, s *store) *models.Invitation {
+	t.Helper()
+	created, err := newInvitationRepository(s).Create(context.Background(), newInvitation(t, s))
+	require.NoError(t, err)
+	return created
+}
+
+func TestInvitationRepositoryContract(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		run  func(t *testing.T, s *store, repo repositories.InvitationRepository)
+	}{
+		{
+			name: "create and get",
+			run: func(t *testing.T, s *store, repo repositories.InvitationRepository) {
+				entity := newInvitation(t, s)
+				created, err := repo.Create(ctx, entity)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, created.ID)
+
+				got, err := repo.Get(ctx, entity.ID)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, got.ID)
+				assert.False(t, got.CreatedAt.IsZero(), "CreatedAt")
+				assertEqual(t, "Email", entity.Email, got.Email)
+				assertEqual(t, "ExpiresAt", entity.ExpiresAt, got.ExpiresAt)
+				assertEqual(t, "InviterID", entity.InviterID, got.InviterID)
+				assertEqual(t, "OrganizationID", entity.OrganizationID, got.OrganizationID)
+				assertEqual(t, "Role", entity.Role, got.Role)
+				assertEqual(t, "Status", entity.Status, got.Status)
+			},
+		},
+		{
+			name: "update",
+			run: func(t *testing.T, s *store, repo repositories.InvitationRepository) {
+				entity := createInvitation(t, s)
+				entity.Role = models.InvitationRole("owner")
+				updated, err := repo.Update(ctx, entity.ID, entity)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, updated.ID)
+
+				got, err := repo.Get(ctx, entity.ID)
+				require.NoError(t, err)
+				assertEqual(t, "Role", entity.Role, updated.Role)
+				assertEqual(t, "Role", entity.Role, got.Role)
+			},
+		},
+		{
+			name: "delete",
+			run: func(t *testing.T, s *store, repo repositories.InvitationRepository) {
+				entity := createInvitation(t, s)
+				require.NoError(t, repo.Delete(ctx, entity.ID))
+
+				_, err := repo.Get(ctx, entity.ID)
+				assert.ErrorIs(t, err, models.ErrInvitationNotFound)
+				assert.ErrorIs(t, repo.Delete(ctx, entity.ID), models.ErrInvitationNotFound)
+			},
+		},
+		{
+			name: "not found",
+			run: func(t *testing.T, s *store, repo repositories.InvitationRepository) {
+				missing := uuid.New()
+				_, err := repo.Get(ctx, missing)
+				assert.ErrorIs(t, err, models.ErrInvitationNotFound)
+				_, err = repo.Update(ctx, missing, newInvitation(t, s))
+				assert.ErrorIs(t, err, models.ErrInvitationNotFound)
+				assert.ErrorIs(t, repo.Delete(ctx, missing), models.ErrInvitationNotFound)
+			},
+		},
+		{
+			name: "list",
+			run: func(t *testing.T, s *store, repo repositories.InvitationRepository) {
+				for range 3 {
+					createInvitation(t, s)
+				}
+
+				items, info, err := repo.List(ctx, database.ListOptions{Page: database.Page{Limit: 2}})
+				require.NoError(t, err)
+				assert.Len(t, items, 2)
+				assert.Equal(t, int64(3), info.Total)
+
+				items, _, err = repo.List(ctx, database.ListOptions{Page: database.Page{Limit: 2, Offset: 2}})
+				require.NoError(t, err)
+				assert.Len(t, items, 1)
+			},
+		},
+		{
+			name: "ListInvitationsByOrganization",
+			run: func(t *testing.T, s *store, repo repositories.InvitationRepository) {
+				entity := createInvitation(t, s)
+				items, err := repo.ListInvitationsByOrganization(ctx, entity.OrganizationID.String())
+				require.NoError(t, err)
+				ids := make([]uuid.UUID, len(items))
+				for i, item := range items {
+					ids[i] = item.ID
+				}
+				assert.Contains(t, ids, entity.ID)
+			},
+		},
+		{
+			name: "GetInvitationByEmail",
+			run: func(t *testing.T, s *store, repo repositories.InvitationRepository) {
+				entity := createInvitation(t, s)
+				got, err := repo.GetInvitationByEmail(ctx, entity.Email, entity.OrganizationID.String())
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, got.ID)
+			},
+		},
+		{
+			name: "ListInvitationsByInviter",
+			run: func(t *testing.T, s *store, repo repositories.InvitationRepository) {
+				entity := createInvitation(t, s)
+				items, err := repo.ListInvitationsByInviter(ctx, entity.InviterID.String())
+				require.NoError(t, err)
+				ids := make([]uuid.UUID, len(items))
+				for i, item := range items {
+					ids[i] = item.ID
+				}
+				assert.Contains(t, ids, entity.ID)
+			},
+		},
+	}
+
+	for _, d := range dialects {
+		t.Run(d.name, func(t *testing.T) {
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					s := d.open(t)
+					tt.run(t, s, newInvitationRepository(s))
+				})
+			}
+		})
+	}
+}
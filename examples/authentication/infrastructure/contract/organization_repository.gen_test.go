@@ -0,0 +1,203 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	postgresrepos "github.com/archesai/examples/authentication/infrastructure/postgres/repositories"
+	sqliterepos "github.com/archesai/examples/authentication/infrastructure/sqlite/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOrganizationRepository(s *store) repositories.OrganizationRepository {
+	if s.sqlite != nil {
+		return sqliterepos.NewSQLiteOrganizationRepository(s.sqlite)
+	}
+	return postgresrepos.NewPostgresOrganizationRepository(s.pool)
+}
+
+// newOrganization returns a organization with valid values, creating the rows it references.
+func newOrganization(t *testing.T, s *store) *models.Organization {
+	t.Helper()
+	return &models.Organization{
+		ID:                       uuid.New(),
+		BillingEmail:             ptr("billing@acme.com"),
+		Credits:                  int32(1000),
+		Logo:                     ptr("https://example.com/org-logo.png"),
+		Name:                     "Acme Corporation",
+		Plan:                     models.OrganizationPlan("STANDARD"),
+		Slug:                     "acme-corp" + "-" + uuid.NewString()[:8],
+		StripeCustomerIdentifier: "cus_1234567890",
+	}
+}
+
+// createOrganization stores a new organization.
+func createOrganization(t *testing.T, s *store) *models.Organization {
+	t.Helper()
+	created, err := newOrganizationRepository(s).Create(context.Background(), newOrganization(t, s))
+	require.NoError(t, err)
+	return created
+}
+
+func TestOrganizationRepositoryContract(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		run  func(t *testing.T, s *store, repo repositories.OrganizationRepository)
+	}{
+		{
+			name: "create and get",
+			run: func(t *testing.T, s *store, repo repositories.OrganizationRepository) {
+				entity := newOrganization(t, s)
+				created, err := repo.Create(ctx, entity)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, created.ID)
+
+				got, err := repo.Get(ctx, entity.ID)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, got.ID)
+				assert.False(t, got.CreatedAt.IsZero(), "CreatedAt")
+				assertEqual(t, "BillingEmail", entity.BillingEmail, got.BillingEmail)
+				assertEqual(t, "Credits", entity.Credits, got.Credits)
+				assertEqual(t, "Logo", entity.Logo, got.Logo)
+				assertEqual(t, "Name", entity.Name, got.Name)
+				assertEqual(t, "Plan", entity.Plan, got.Plan)
+				assertEqual(t, "Slug", entity.Slug, got.Slug)
+				assertEqual(t, "StripeCustomerIdentifier", entity.StripeCustomerIdentifier, got.StripeCustomerIdentifier)
+			},
+		},
+		{
+			name: "update",
+			run: func(t *testing.T, s *store, repo repositories.OrganizationRepository) {
+				entity := createOrganization(t, s)
+				entity.Credits = int32(1001)
+				updated, err := repo.Update(ctx, entity.ID, entity)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, updated.ID)
+
+				got, err := repo.Get(ctx, entity.ID)
+				require.NoError(t, err)
+				assertEqual(t, "Credits", entity.Credits, updated.Credits)
+				assertEqual(t, "Credits", entity.Credits, got.Credits)
+			},
+		},
+		{
+			name: "delete",
+			run: func(t *testing.T, s *store, repo repositories.OrganizationRepository) {
+				entity := createOrganization(t, s)
+				require.NoError(t, repo.Delete(ctx, entity.ID))
+
+				_, err := repo.Get(ctx, entity.ID)
+				assert.ErrorIs(t, err, models.ErrOrganizationNotFound)
+				assert.ErrorIs(t, repo.Delete(ctx, entity.ID), models.ErrOrganizationNotFound)
+			},
+		},
+		{
+			name: "restore",
+			run: func(t *testing.T, s *store, repo repositories.OrganizationRepository) {
+				entity := createOrganization(t, s)
+				require.NoError(t, repo.Delete(ctx, entity.ID))
+
+				deleted, _, err := repo.ListDeleted(ctx, database.ListOptions{})
+				require.NoError(t, err)
+				require.Len(t, deleted, 1)
+				assert.Equal(t, entity.ID, deleted[0].ID)
+				items, _, err := repo.List(ctx, database.ListOptions{})
+				require.NoError(t, err)
+				assert.Empty(t, items)
+
+				require.NoError(t, repo.Restore(ctx, entity.ID))
+				_, err = repo.Get(ctx, entity.ID)
+				assert.NoError(t, err)
+				assert.ErrorIs(t, repo.Restore(ctx, entity.ID), models.ErrOrganizationNotFound)
+			},
+		},
+		{
+			name: "purge",
+			run: func(t *testing.T, s *store, repo repositories.OrganizationRepository) {
+				kept := createOrganization(t, s)
+				entity := createOrganization(t, s)
+				require.NoError(t, repo.Delete(ctx, entity.ID))
+
+				n, err := repo.Purge(ctx, time.Now().Add(-time.Hour))
+				require.NoError(t, err)
+				assert.Zero(t, n)
+				n, err = repo.Purge(ctx, time.Now().Add(time.Minute))
+				require.NoError(t, err)
+				assert.Equal(t, int64(1), n)
+
+				deleted, _, err := repo.ListDeleted(ctx, database.ListOptions{})
+				require.NoError(t, err)
+				assert.Empty(t, deleted)
+				assert.ErrorIs(t, repo.Restore(ctx, entity.ID), models.ErrOrganizationNotFound)
+				_, err = repo.Get(ctx, kept.ID)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "not found",
+			run: func(t *testing.T, s *store, repo repositories.OrganizationRepository) {
+				missing := uuid.New()
+				_, err := repo.Get(ctx, missing)
+				assert.ErrorIs(t, err, models.ErrOrganizationNotFound)
+				_, err = repo.Update(ctx, missing, newOrganization(t, s))
+				assert.ErrorIs(t, err, models.ErrOrganizationNotFound)
+				assert.ErrorIs(t, repo.Delete(ctx, missing), models.ErrOrganizationNotFound)
+			},
+		},
+		{
+			name: "list",
+			run: func(t *testing.T, s *store, repo repositories.OrganizationRepository) {
+				for range 3 {
+					createOrganization(t, s)
+				}
+
+				items, info, err := repo.List(ctx, database.ListOptions{Page: database.Page{Limit: 2}})
+				require.NoError(t, err)
+				assert.Len(t, items, 2)
+				assert.Equal(t, int64(3), info.Total)
+
+				items, _, err = repo.List(ctx, database.ListOptions{Page: database.Page{Limit: 2, Offset: 2}})
+				require.NoError(t, err)
+				assert.Len(t, items, 1)
+			},
+		},
+		{
+			name: "GetOrganizationBySlug",
+			run: func(t *testing.T, s *store, repo repositories.OrganizationRepository) {
+				entity := createOrganization(t, s)
+				got, err := repo.GetOrganizationBySlug(ctx, entity.Slug)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, got.ID)
+			},
+		},
+		{
+			name: "GetOrganizationByStripeCustomerID",
+			run: func(t *testing.T, s *store, repo repositories.OrganizationRepository) {
+				entity := createOrganization(t, s)
+				got, err := repo.GetOrganizationByStripeCustomerID(ctx, entity.StripeCustomerIdentifier)
+				require.NoError(t, err)
+				assert.Equal(t, entity.ID, got.ID)
+			},
+		},
+	}
+
+	for _, d := range dialects {
+		t.Run(d.name, func(t *testing.T) {
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					s := d.open(t)
+					tt.run(t, s, newOrganizationRepository(s))
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+-- drop "api_key" table
+DROP TABLE "public"."api_key";
+-- drop "invitation" table
+DROP TABLE "public"."invitation";
+-- drop "member" table
+DROP TABLE "public"."member";
+-- drop "organization" table
+DROP TABLE "public"."organization";
+-- drop "account" table
+DROP TABLE "public"."account";
+-- drop "session" table
+DROP TABLE "public"."session";
+-- drop "user" table
+DROP TABLE "public"."user";
@@ -0,0 +1,4 @@
+-- modify "member" table
+ALTER TABLE "public"."member" DROP COLUMN "role_id";
+-- drop "role" table
+DROP TABLE "public"."role";
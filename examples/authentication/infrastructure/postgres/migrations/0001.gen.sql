@@ -0,0 +1,8 @@
+-- create "role" table
+CREATE TABLE "public"."role" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "description" text NULL, "name" text NOT NULL, "organization_id" uuid NOT NULL, "permissions" text[] NOT NULL DEFAULT '{}', PRIMARY KEY ("id"), CONSTRAINT "role_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "public"."organization" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "idx_role_name" to table: "role"
+CREATE INDEX "idx_role_name" ON "public"."role" ("name");
+-- create index "idx_role_organization_id" to table: "role"
+CREATE INDEX "idx_role_organization_id" ON "public"."role" ("organization_id");
+-- modify "member" table
+ALTER TABLE "public"."member" ADD COLUMN "role_id" uuid NULL, ADD CONSTRAINT "member_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "public"."role" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
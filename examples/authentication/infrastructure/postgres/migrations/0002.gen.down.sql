@@ -0,0 +1,2 @@
+-- drop "event_outbox" table
+DROP TABLE "public"."event_outbox";
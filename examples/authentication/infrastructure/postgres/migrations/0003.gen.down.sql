@@ -0,0 +1,2 @@
+-- modify "event_outbox" table
+ALTER TABLE "public"."event_outbox" DROP COLUMN "dead_lettered_at";
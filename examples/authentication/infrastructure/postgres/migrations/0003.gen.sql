@@ -0,0 +1,2 @@
+-- modify "event_outbox" table
+ALTER TABLE "public"."event_outbox" ADD COLUMN "dead_lettered_at" timestamptz NULL;
@@ -0,0 +1,6 @@
+-- modify "member" table
+ALTER TABLE "public"."member" DROP COLUMN "deleted_at";
+-- drop index "idx_organization_slug" from table: "organization"
+DROP INDEX "public"."idx_organization_slug";
+-- modify "organization" table
+ALTER TABLE "public"."organization" DROP COLUMN "deleted_at";
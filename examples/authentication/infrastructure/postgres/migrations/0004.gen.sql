@@ -0,0 +1,8 @@
+-- blocking-index: creates index "idx_organization_slug" without CONCURRENTLY, which blocks writes to "organization" while it builds
+
+-- modify "member" table
+ALTER TABLE "public"."member" ADD COLUMN "deleted_at" timestamptz NULL;
+-- modify "organization" table
+ALTER TABLE "public"."organization" ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "idx_organization_slug" to table: "organization"
+CREATE UNIQUE INDEX "idx_organization_slug" ON "public"."organization" ("slug") WHERE (deleted_at IS NULL);
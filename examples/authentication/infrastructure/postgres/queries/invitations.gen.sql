@@ -26,18 +26,6 @@ WHERE
 LIMIT
   1;
 
--- name: ListInvitations :many
-SELECT
-  *
-FROM
-  invitation
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountInvitations :one
 SELECT
   COUNT(*)
@@ -58,7 +46,7 @@ WHERE
 RETURNING
   *;
 
--- name: DeleteInvitation :exec
+-- name: DeleteInvitation :execrows
 DELETE FROM invitation
 WHERE
   id = sqlc.arg('id');
@@ -24,26 +24,17 @@ FROM
   organization
 WHERE
   id = sqlc.arg('id')
+  AND deleted_at IS NULL
 LIMIT
   1;
 
--- name: ListOrganizations :many
-SELECT
-  *
-FROM
-  organization
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountOrganizations :one
 SELECT
   COUNT(*)
 FROM
-  organization;
+  organization
+WHERE
+  deleted_at IS NULL;
 
 -- name: UpdateOrganization :one
 UPDATE organization
@@ -57,13 +48,31 @@ SET
   stripe_customer_identifier = COALESCE(sqlc.narg('stripe_customer_identifier'), stripe_customer_identifier)
 WHERE
   id = sqlc.arg('id')
+  AND deleted_at IS NULL
 RETURNING
   *;
 
--- name: DeleteOrganization :exec
+-- name: DeleteOrganization :execrows
+UPDATE organization
+SET
+  deleted_at = CURRENT_TIMESTAMP
+WHERE
+  id = sqlc.arg('id')
+  AND deleted_at IS NULL;
+
+-- name: RestoreOrganization :execrows
+UPDATE organization
+SET
+  deleted_at = NULL
+WHERE
+  id = sqlc.arg('id')
+  AND deleted_at IS NOT NULL;
+
+-- name: PurgeOrganizations :execrows
 DELETE FROM organization
 WHERE
-  id = sqlc.arg('id');
+  deleted_at IS NOT NULL
+  AND deleted_at < sqlc.arg('before')::timestamptz;
 
 -- name: GetOrganizationBySlug :one
 SELECT
@@ -71,7 +80,8 @@ SELECT
 FROM
   organization
 WHERE
-  slug = sqlc.arg('slug')
+  slug = sqlc.arg('slug') AND
+  deleted_at IS NULL
 LIMIT
   1;
 -- name: GetOrganizationByStripeCustomerID :one
@@ -80,6 +90,7 @@ SELECT
 FROM
   organization
 WHERE
-  stripe_customer_identifier = sqlc.arg('stripe_customer_identifier')
+  stripe_customer_identifier = sqlc.arg('stripe_customer_identifier') AND
+  deleted_at IS NULL
 LIMIT
   1;
\ No newline at end of file
@@ -90,7 +90,7 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 	return i, err
 }
 
-const deleteAccount = `-- name: DeleteAccount :exec
+const deleteAccount = `-- name: DeleteAccount :execrows
 DELETE FROM account
 WHERE
   id = $1
@@ -100,9 +100,12 @@ type DeleteAccountParams struct {
 	ID uuid.UUID
 }
 
-func (q *Queries) DeleteAccount(ctx context.Context, arg DeleteAccountParams) error {
-	_, err := q.db.Exec(ctx, deleteAccount, arg.ID)
-	return err
+func (q *Queries) DeleteAccount(ctx context.Context, arg DeleteAccountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAccount, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getAccount = `-- name: GetAccount :one
@@ -177,57 +180,6 @@ func (q *Queries) GetAccountByProvider(ctx context.Context, arg GetAccountByProv
 	return i, err
 }
 
-const listAccounts = `-- name: ListAccounts :many
-SELECT
-  id, created_at, updated_at, access_token, access_token_expires_at, account_identifier, id_token, provider, refresh_token, refresh_token_expires_at, scope, user_id
-FROM
-  account
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListAccountsParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
-	rows, err := q.db.Query(ctx, listAccounts, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Account
-	for rows.Next() {
-		var i Account
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.AccessToken,
-			&i.AccessTokenExpiresAt,
-			&i.AccountIdentifier,
-			&i.IDToken,
-			&i.Provider,
-			&i.RefreshToken,
-			&i.RefreshTokenExpiresAt,
-			&i.Scope,
-			&i.UserID,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listAccountsByUserID = `-- name: ListAccountsByUserID :many
 SELECT
   id, created_at, updated_at, access_token, access_token_expires_at, account_identifier, id_token, provider, refresh_token, refresh_token_expires_at, scope, user_id
@@ -17,10 +17,16 @@ SELECT
   COUNT(*)
 FROM
   api_key
+WHERE
+  organization_id = $1
 `
 
-func (q *Queries) CountAPIKeys(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countAPIKeys)
+type CountAPIKeysParams struct {
+	TenantID uuid.UUID
+}
+
+func (q *Queries) CountAPIKeys(ctx context.Context, arg CountAPIKeysParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAPIKeys, arg.TenantID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -90,19 +96,24 @@ func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (API
 	return i, err
 }
 
-const deleteAPIKey = `-- name: DeleteAPIKey :exec
+const deleteAPIKey = `-- name: DeleteAPIKey :execrows
 DELETE FROM api_key
 WHERE
   id = $1
+  AND organization_id = $2
 `
 
 type DeleteAPIKeyParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
-func (q *Queries) DeleteAPIKey(ctx context.Context, arg DeleteAPIKeyParams) error {
-	_, err := q.db.Exec(ctx, deleteAPIKey, arg.ID)
-	return err
+func (q *Queries) DeleteAPIKey(ctx context.Context, arg DeleteAPIKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAPIKey, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getAPIKey = `-- name: GetAPIKey :one
@@ -112,16 +123,18 @@ FROM
   api_key
 WHERE
   id = $1
+  AND organization_id = $2
 LIMIT
   1
 `
 
 type GetAPIKeyParams struct {
-	ID uuid.UUID
+	ID       uuid.UUID
+	TenantID uuid.UUID
 }
 
 func (q *Queries) GetAPIKey(ctx context.Context, arg GetAPIKeyParams) (APIKey, error) {
-	row := q.db.QueryRow(ctx, getAPIKey, arg.ID)
+	row := q.db.QueryRow(ctx, getAPIKey, arg.ID, arg.TenantID)
 	var i APIKey
 	err := row.Scan(
 		&i.ID,
@@ -140,57 +153,6 @@ func (q *Queries) GetAPIKey(ctx context.Context, arg GetAPIKeyParams) (APIKey, e
 	return i, err
 }
 
-const listAPIKeys = `-- name: ListAPIKeys :many
-SELECT
-  id, created_at, updated_at, expires_at, key_hash, last_used_at, name, organization_id, prefix, rate_limit, scopes, user_id
-FROM
-  api_key
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListAPIKeysParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListAPIKeys(ctx context.Context, arg ListAPIKeysParams) ([]APIKey, error) {
-	rows, err := q.db.Query(ctx, listAPIKeys, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []APIKey
-	for rows.Next() {
-		var i APIKey
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.ExpiresAt,
-			&i.KeyHash,
-			&i.LastUsedAt,
-			&i.Name,
-			&i.OrganizationID,
-			&i.Prefix,
-			&i.RateLimit,
-			&i.Scopes,
-			&i.UserID,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const updateAPIKey = `-- name: UpdateAPIKey :one
 UPDATE api_key
 SET
@@ -198,28 +160,28 @@ SET
   key_hash = COALESCE($2, key_hash),
   last_used_at = COALESCE($3, last_used_at),
   name = COALESCE($4, name),
-  organization_id = COALESCE($5, organization_id),
-  prefix = COALESCE($6, prefix),
-  rate_limit = COALESCE($7, rate_limit),
-  scopes = COALESCE($8, scopes),
-  user_id = COALESCE($9, user_id)
+  prefix = COALESCE($5, prefix),
+  rate_limit = COALESCE($6, rate_limit),
+  scopes = COALESCE($7, scopes),
+  user_id = COALESCE($8, user_id)
 WHERE
-  id = $10
+  id = $9
+  AND organization_id = $10
 RETURNING
   id, created_at, updated_at, expires_at, key_hash, last_used_at, name, organization_id, prefix, rate_limit, scopes, user_id
 `
 
 type UpdateAPIKeyParams struct {
-	ExpiresAt      *time.Time
-	KeyHash        *string
-	LastUsedAt     *time.Time
-	Name           *string
-	OrganizationID *uuid.UUID
-	Prefix         *string
-	RateLimit      *int32
-	Scopes         []string
-	UserID         *uuid.UUID
-	ID             uuid.UUID
+	ExpiresAt  *time.Time
+	KeyHash    *string
+	LastUsedAt *time.Time
+	Name       *string
+	Prefix     *string
+	RateLimit  *int32
+	Scopes     []string
+	UserID     *uuid.UUID
+	ID         uuid.UUID
+	TenantID   uuid.UUID
 }
 
 func (q *Queries) UpdateAPIKey(ctx context.Context, arg UpdateAPIKeyParams) (APIKey, error) {
@@ -228,12 +190,12 @@ func (q *Queries) UpdateAPIKey(ctx context.Context, arg UpdateAPIKeyParams) (API
 		arg.KeyHash,
 		arg.LastUsedAt,
 		arg.Name,
-		arg.OrganizationID,
 		arg.Prefix,
 		arg.RateLimit,
 		arg.Scopes,
 		arg.UserID,
 		arg.ID,
+		arg.TenantID,
 	)
 	var i APIKey
 	err := row.Scan(
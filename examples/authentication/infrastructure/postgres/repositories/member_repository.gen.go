@@ -7,25 +7,40 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresMemberRepository implements MemberRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresMemberRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresMemberRepository creates a new PostgreSQL repository.
 func NewPostgresMemberRepository(db *pgxpool.Pool) *PostgresMemberRepository {
 	return &PostgresMemberRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresMemberRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Member operations
 
 // Create creates a new member
@@ -34,13 +49,24 @@ func (r *PostgresMemberRepository) Create(ctx context.Context, entity *models.Me
 		ID:             entity.ID,
 		OrganizationID: entity.OrganizationID,
 		Role:           string(entity.Role),
+		RoleID:         entity.RoleID,
 		UserID:         entity.UserID,
 	}
 
-	result, err := r.queries.CreateMember(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create member: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewMemberCreatedEvent(entity.ID))
+
+	var result Member
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateMember(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create member: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapMemberFromDB(&result), nil
 }
@@ -51,7 +77,7 @@ func (r *PostgresMemberRepository) Get(ctx context.Context, id uuid.UUID) (*mode
 		ID: id,
 	}
 
-	result, err := r.queries.GetMember(ctx, params)
+	result, err := r.queriesFor(ctx).GetMember(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrMemberNotFound
@@ -67,47 +93,109 @@ func (r *PostgresMemberRepository) Update(ctx context.Context, id uuid.UUID, ent
 
 	roleStr := string(entity.Role)
 	params := UpdateMemberParams{
-		ID:   id,
-		Role: &roleStr,
+		ID:     id,
+		Role:   &roleStr,
+		RoleID: entity.RoleID,
 	}
 
-	result, err := r.queries.UpdateMember(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrMemberNotFound
+	pending := events.Ensure(entity.Events(), models.NewMemberUpdatedEvent(id))
+
+	var result Member
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateMember(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrMemberNotFound
+			}
+			return fmt.Errorf("failed to update member: %w", err)
 		}
-		return nil, fmt.Errorf("failed to update member: %w", err)
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapMemberFromDB(&result), nil
 }
 
-// Delete removes a member
+// Delete marks a member as deleted
 func (r *PostgresMemberRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	params := DeleteMemberParams{
 		ID: id,
 	}
 
-	err := r.queries.DeleteMember(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteMember(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete member: %w", err)
+		}
+		if n == 0 {
+			return models.ErrMemberNotFound
+		}
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewMemberDeletedEvent(id)})
+	})
+}
+
+// Restore clears the deletion mark of a deleted member
+func (r *PostgresMemberRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	params := RestoreMemberParams{
+		ID: id,
+	}
+
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).RestoreMember(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to restore member: %w", err)
+		}
+		if n == 0 {
 			return models.ErrMemberNotFound
 		}
-		return fmt.Errorf("failed to delete member: %w", err)
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewMemberUpdatedEvent(id)})
+	})
+}
+
+// List returns a filtered, sorted and paginated list of members that are not deleted
+func (r *PostgresMemberRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error) {
+	opts.Deleted = database.DeletedExclude
+	return r.list(ctx, opts)
+}
+
+// ListDeleted returns a filtered, sorted and paginated list of deleted members
+func (r *PostgresMemberRepository) ListDeleted(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error) {
+	opts.Deleted = database.DeletedOnly
+	return r.list(ctx, opts)
+}
+
+// Purge removes the members deleted before the given time, in all organizations.
+// Purged rows cannot be restored and record no events.
+func (r *PostgresMemberRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	n, err := r.queriesFor(ctx).PurgeMembers(ctx, PurgeMembersParams{Before: before})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge members: %w", err)
 	}
-	return nil
+	return n, nil
 }
 
-// List returns a paginated list of members
-func (r *PostgresMemberRepository) List(ctx context.Context, limit, offset int32) ([]*models.Member, int64, error) {
-	listParams := ListMembersParams{
-		Limit:  limit,
-		Offset: offset,
+func (r *PostgresMemberRepository) list(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error) {
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "member", memberColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListMembers(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list members: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list members: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Member])
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list members: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count members: %w", err)
 	}
 
 	items := make([]*models.Member, len(results))
@@ -115,11 +203,7 @@ func (r *PostgresMemberRepository) List(ctx context.Context, limit, offset int32
 		items[i] = mapMemberFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
-
-	return items, count, nil
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // ListMembersByOrganization retrieves multiple Members by organizationID
@@ -128,7 +212,7 @@ func (r *PostgresMemberRepository) ListMembersByOrganization(ctx context.Context
 		OrganizationID: uuid.MustParse(organizationID),
 	}
 
-	result, err := r.queries.ListMembersByOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).ListMembersByOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrMemberNotFound
@@ -149,7 +233,7 @@ func (r *PostgresMemberRepository) ListMembersByUser(ctx context.Context, userID
 		UserID: uuid.MustParse(userID),
 	}
 
-	result, err := r.queries.ListMembersByUser(ctx, params)
+	result, err := r.queriesFor(ctx).ListMembersByUser(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrMemberNotFound
@@ -171,7 +255,7 @@ func (r *PostgresMemberRepository) GetMemberByUserAndOrganization(ctx context.Co
 		OrganizationID: uuid.MustParse(organizationID),
 	}
 
-	result, err := r.queries.GetMemberByUserAndOrganization(ctx, params)
+	result, err := r.queriesFor(ctx).GetMemberByUserAndOrganization(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrMemberNotFound
@@ -183,6 +267,17 @@ func (r *PostgresMemberRepository) GetMemberByUserAndOrganization(ctx context.Co
 
 }
 
+// memberColumns maps Member fields to the columns List can filter and sort on.
+var memberColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"role":           {Name: "role", Kind: database.ColumnString},
+	"roleID":         {Name: "role_id", Kind: database.ColumnUUID},
+	"userID":         {Name: "user_id", Kind: database.ColumnUUID},
+}
+
 func mapMemberFromDB(db *Member) *models.Member {
 	if db == nil {
 		return nil
@@ -194,6 +289,7 @@ func mapMemberFromDB(db *Member) *models.Member {
 		UpdatedAt:      db.UpdatedAt,
 		OrganizationID: db.OrganizationID,
 		Role:           models.MemberRole(db.Role),
+		RoleID:         db.RoleID,
 		UserID:         db.UserID,
 	}
 
@@ -40,6 +40,18 @@ type Account struct {
 	UserID                uuid.UUID
 }
 
+type EventOutbox struct {
+	ID             string
+	EventType      string
+	OrganizationID *uuid.UUID
+	Payload        []byte
+	CreatedAt      time.Time
+	PublishedAt    *time.Time
+	Attempts       int32
+	LastError      *string
+	DeadLetteredAt *time.Time
+}
+
 type Invitation struct {
 	ID             uuid.UUID
 	CreatedAt      time.Time
@@ -59,6 +71,8 @@ type Member struct {
 	OrganizationID uuid.UUID
 	Role           string
 	UserID         uuid.UUID
+	RoleID         *uuid.UUID
+	DeletedAt      *time.Time
 }
 
 type Organization struct {
@@ -72,6 +86,17 @@ type Organization struct {
 	Plan                     string
 	Slug                     string
 	StripeCustomerIdentifier string
+	DeletedAt                *time.Time
+}
+
+type Role struct {
+	ID             uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Description    *string
+	Name           string
+	OrganizationID uuid.UUID
+	Permissions    []string
 }
 
 type Session struct {
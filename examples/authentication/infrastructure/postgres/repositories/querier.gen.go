@@ -9,11 +9,12 @@ import (
 )
 
 type Querier interface {
-	CountAPIKeys(ctx context.Context) (int64, error)
+	CountAPIKeys(ctx context.Context, arg CountAPIKeysParams) (int64, error)
 	CountAccounts(ctx context.Context) (int64, error)
 	CountInvitations(ctx context.Context) (int64, error)
 	CountMembers(ctx context.Context) (int64, error)
 	CountOrganizations(ctx context.Context) (int64, error)
+	CountRoles(ctx context.Context, arg CountRolesParams) (int64, error)
 	CountSessions(ctx context.Context) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
 	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (APIKey, error)
@@ -21,15 +22,17 @@ type Querier interface {
 	CreateInvitation(ctx context.Context, arg CreateInvitationParams) (Invitation, error)
 	CreateMember(ctx context.Context, arg CreateMemberParams) (Member, error)
 	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error)
+	CreateRole(ctx context.Context, arg CreateRoleParams) (Role, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeleteAPIKey(ctx context.Context, arg DeleteAPIKeyParams) error
-	DeleteAccount(ctx context.Context, arg DeleteAccountParams) error
-	DeleteInvitation(ctx context.Context, arg DeleteInvitationParams) error
-	DeleteMember(ctx context.Context, arg DeleteMemberParams) error
-	DeleteOrganization(ctx context.Context, arg DeleteOrganizationParams) error
-	DeleteSession(ctx context.Context, arg DeleteSessionParams) error
-	DeleteUser(ctx context.Context, arg DeleteUserParams) error
+	DeleteAPIKey(ctx context.Context, arg DeleteAPIKeyParams) (int64, error)
+	DeleteAccount(ctx context.Context, arg DeleteAccountParams) (int64, error)
+	DeleteInvitation(ctx context.Context, arg DeleteInvitationParams) (int64, error)
+	DeleteMember(ctx context.Context, arg DeleteMemberParams) (int64, error)
+	DeleteOrganization(ctx context.Context, arg DeleteOrganizationParams) (int64, error)
+	DeleteRole(ctx context.Context, arg DeleteRoleParams) (int64, error)
+	DeleteSession(ctx context.Context, arg DeleteSessionParams) (int64, error)
+	DeleteUser(ctx context.Context, arg DeleteUserParams) (int64, error)
 	GetAPIKey(ctx context.Context, arg GetAPIKeyParams) (APIKey, error)
 	GetAccount(ctx context.Context, arg GetAccountParams) (Account, error)
 	GetAccountByProvider(ctx context.Context, arg GetAccountByProviderParams) (Account, error)
@@ -40,27 +43,26 @@ type Querier interface {
 	GetOrganization(ctx context.Context, arg GetOrganizationParams) (Organization, error)
 	GetOrganizationBySlug(ctx context.Context, arg GetOrganizationBySlugParams) (Organization, error)
 	GetOrganizationByStripeCustomerID(ctx context.Context, arg GetOrganizationByStripeCustomerIDParams) (Organization, error)
+	GetRole(ctx context.Context, arg GetRoleParams) (Role, error)
 	GetSession(ctx context.Context, arg GetSessionParams) (Session, error)
 	GetUser(ctx context.Context, arg GetUserParams) (User, error)
 	GetUserByEmail(ctx context.Context, arg GetUserByEmailParams) (User, error)
 	GetUserBySessionID(ctx context.Context, arg GetUserBySessionIDParams) (User, error)
-	ListAPIKeys(ctx context.Context, arg ListAPIKeysParams) ([]APIKey, error)
-	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
 	ListAccountsByUserID(ctx context.Context, arg ListAccountsByUserIDParams) ([]Account, error)
-	ListInvitations(ctx context.Context, arg ListInvitationsParams) ([]Invitation, error)
 	ListInvitationsByInviter(ctx context.Context, arg ListInvitationsByInviterParams) ([]Invitation, error)
 	ListInvitationsByOrganization(ctx context.Context, arg ListInvitationsByOrganizationParams) ([]Invitation, error)
-	ListMembers(ctx context.Context, arg ListMembersParams) ([]Member, error)
 	ListMembersByOrganization(ctx context.Context, arg ListMembersByOrganizationParams) ([]Member, error)
 	ListMembersByUser(ctx context.Context, arg ListMembersByUserParams) ([]Member, error)
-	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Organization, error)
-	ListSessions(ctx context.Context, arg ListSessionsParams) ([]Session, error)
-	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	PurgeMembers(ctx context.Context, arg PurgeMembersParams) (int64, error)
+	PurgeOrganizations(ctx context.Context, arg PurgeOrganizationsParams) (int64, error)
+	RestoreMember(ctx context.Context, arg RestoreMemberParams) (int64, error)
+	RestoreOrganization(ctx context.Context, arg RestoreOrganizationParams) (int64, error)
 	UpdateAPIKey(ctx context.Context, arg UpdateAPIKeyParams) (APIKey, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
 	UpdateInvitation(ctx context.Context, arg UpdateInvitationParams) (Invitation, error)
 	UpdateMember(ctx context.Context, arg UpdateMemberParams) (Member, error)
 	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organization, error)
+	UpdateRole(ctx context.Context, arg UpdateRoleParams) (Role, error)
 	UpdateSession(ctx context.Context, arg UpdateSessionParams) (Session, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 }
@@ -289,10 +289,20 @@ table "member" {
     default = "basic"
   }
 
+  column "role_id" {
+    null = true
+    type = sql("uuid")
+  }
+
   column "user_id" {
     null = false
     type = sql("uuid")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("timestamptz")
+  }
   primary_key {
     columns = [column.id]
   }
@@ -308,6 +318,12 @@ table "member" {
     on_update   = "NO_ACTION"
     on_delete   = "CASCADE"
   }
+  foreign_key "member_role_id_fkey" {
+    columns     = [column.role_id]
+    ref_columns = [table.role.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "SET_NULL"
+  }
   index "idx_member_organization_id" {
     columns = [column.organization_id]
   }
@@ -376,14 +392,82 @@ table "organization" {
     null = false
     type = sql("text")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("timestamptz")
+  }
   primary_key {
     columns = [column.id]
   }
+  index "idx_organization_slug" {
+    unique  = true
+    columns = [column.slug]
+    where   = "(deleted_at IS NULL)"
+  }
   check "organization_plan_check" {
     expr = "(plan = ANY (ARRAY['FREE'::text, 'BASIC'::text, 'STANDARD'::text, 'PREMIUM'::text, 'UNLIMITED'::text]))"
   }
 }
 
+table "role" {
+  schema = schema.public
+
+  column "id" {
+    null    = false
+    type    = sql("uuid")
+    default = sql("gen_random_uuid()")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "updated_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "description" {
+    null = true
+    type = sql("text")
+  }
+
+  column "name" {
+    null = false
+    type = sql("text")
+  }
+
+  column "organization_id" {
+    null = false
+    type = sql("uuid")
+  }
+
+  column "permissions" {
+    null    = false
+    type    = sql("text[]")
+    default = "{}"
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "role_organization_id_fkey" {
+    columns     = [column.organization_id]
+    ref_columns = [table.organization.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "CASCADE"
+  }
+  index "idx_role_name" {
+    columns = [column.name]
+  }
+  index "idx_role_organization_id" {
+    columns = [column.organization_id]
+  }
+}
+
 table "session" {
   schema = schema.public
 
@@ -511,6 +595,65 @@ table "user" {
 }
 
 
+table "event_outbox" {
+  schema = schema.public
+
+  column "id" {
+    null = false
+    type = sql("text")
+  }
+
+  column "event_type" {
+    null = false
+    type = sql("text")
+  }
+
+  column "organization_id" {
+    null = true
+    type = sql("uuid")
+  }
+
+  column "payload" {
+    null = false
+    type = sql("jsonb")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "published_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+
+  column "attempts" {
+    null    = false
+    type    = sql("integer")
+    default = 0
+  }
+
+  column "last_error" {
+    null = true
+    type = sql("text")
+  }
+
+  column "dead_lettered_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_event_outbox_pending" {
+    columns = [column.created_at, column.id]
+    where   = "(published_at IS NULL)"
+  }
+}
+
+
 schema "public" {
   comment = "standard public schema"
 }
\ No newline at end of file
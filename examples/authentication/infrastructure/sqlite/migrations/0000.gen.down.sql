@@ -0,0 +1,18 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "account" table
+DROP TABLE `account`;
+-- drop "api_key" table
+DROP TABLE `api_key`;
+-- drop "invitation" table
+DROP TABLE `invitation`;
+-- drop "member" table
+DROP TABLE `member`;
+-- drop "organization" table
+DROP TABLE `organization`;
+-- drop "session" table
+DROP TABLE `session`;
+-- drop "user" table
+DROP TABLE `user`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
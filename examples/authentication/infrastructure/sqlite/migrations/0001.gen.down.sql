@@ -0,0 +1,18 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_member" table
+CREATE TABLE `new_member` (`id` text NOT NULL DEFAULT (lower(hex(randomblob(16)))), `created_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `updated_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `organization_id` text NOT NULL, `role` text NOT NULL DEFAULT 'basic', `user_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `member_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `member_organization_id_fkey` FOREIGN KEY (`organization_id`) REFERENCES `organization` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- copy rows from old table "member" to new temporary table "new_member"
+INSERT INTO `new_member` (`id`, `created_at`, `updated_at`, `organization_id`, `role`, `user_id`) SELECT `id`, `created_at`, `updated_at`, `organization_id`, `role`, `user_id` FROM `member`;
+-- drop "member" table after copying rows
+DROP TABLE `member`;
+-- rename temporary table "new_member" to "member"
+ALTER TABLE `new_member` RENAME TO `member`;
+-- create index "idx_member_organization_id" to table: "member"
+CREATE INDEX `idx_member_organization_id` ON `member` (`organization_id`);
+-- create index "idx_member_user_id" to table: "member"
+CREATE INDEX `idx_member_user_id` ON `member` (`user_id`);
+-- drop "role" table
+DROP TABLE `role`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
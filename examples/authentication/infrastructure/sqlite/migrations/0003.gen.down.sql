@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_event_outbox" table
+CREATE TABLE `new_event_outbox` (`id` text NOT NULL, `event_type` text NOT NULL, `organization_id` text NULL, `payload` text NOT NULL, `created_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `published_at` text NULL, `attempts` integer NOT NULL DEFAULT 0, `last_error` text NULL, PRIMARY KEY (`id`));
+-- copy rows from old table "event_outbox" to new temporary table "new_event_outbox"
+INSERT INTO `new_event_outbox` (`id`, `event_type`, `organization_id`, `payload`, `created_at`, `published_at`, `attempts`, `last_error`) SELECT `id`, `event_type`, `organization_id`, `payload`, `created_at`, `published_at`, `attempts`, `last_error` FROM `event_outbox`;
+-- drop "event_outbox" table after copying rows
+DROP TABLE `event_outbox`;
+-- rename temporary table "new_event_outbox" to "event_outbox"
+ALTER TABLE `new_event_outbox` RENAME TO `event_outbox`;
+-- create index "idx_event_outbox_pending" to table: "event_outbox"
+CREATE INDEX `idx_event_outbox_pending` ON `event_outbox` (`created_at`, `id`) WHERE (published_at IS NULL);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- add column "deleted_at" to table: "organization"
+ALTER TABLE `organization` ADD COLUMN `deleted_at` text NULL;
+-- create index "idx_organization_slug" to table: "organization"
+CREATE UNIQUE INDEX `idx_organization_slug` ON `organization` (`slug`) WHERE (deleted_at IS NULL);
+-- add column "deleted_at" to table: "member"
+ALTER TABLE `member` ADD COLUMN `deleted_at` text NULL;
@@ -26,26 +26,17 @@ FROM
   api_key
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 LIMIT
   1;
 
--- name: ListAPIKeys :many
-SELECT
-  *
-FROM
-  api_key
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountAPIKeys :one
 SELECT
   COUNT(*)
 FROM
-  api_key;
+  api_key
+WHERE
+  organization_id = sqlc.arg('tenant_id');
 
 -- name: UpdateAPIKey :one
 UPDATE api_key
@@ -54,17 +45,18 @@ SET
   key_hash = COALESCE(sqlc.narg('key_hash'), key_hash),
   last_used_at = COALESCE(sqlc.narg('last_used_at'), last_used_at),
   name = COALESCE(sqlc.narg('name'), name),
-  organization_id = COALESCE(sqlc.narg('organization_id'), organization_id),
   prefix = COALESCE(sqlc.narg('prefix'), prefix),
   rate_limit = COALESCE(sqlc.narg('rate_limit'), rate_limit),
   scopes = COALESCE(sqlc.narg('scopes'), scopes),
   user_id = COALESCE(sqlc.narg('user_id'), user_id)
 WHERE
   id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id')
 RETURNING
   *;
 
--- name: DeleteAPIKey :exec
+-- name: DeleteAPIKey :execrows
 DELETE FROM api_key
 WHERE
-  id = sqlc.arg('id');
\ No newline at end of file
+  id = sqlc.arg('id')
+  AND organization_id = sqlc.arg('tenant_id');
\ No newline at end of file
@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 )
 
 // SQLiteAccountRepository implements AccountRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteAccountRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteAccountRepository creates a new SQLite repository.
 func NewSQLiteAccountRepository(db *sql.DB) *SQLiteAccountRepository {
 	return &SQLiteAccountRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,50 +33,226 @@ func NewSQLiteAccountRepository(db *sql.DB) *SQLiteAccountRepository {
 // Account operations
 
 // Create creates a new account
-func (r *SQLiteAccountRepository) Create(ctx context.Context, account *models.Account) (*models.Account, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per account
-	return nil, fmt.Errorf("CreateAccount not yet implemented - requires custom mapping")
+func (r *SQLiteAccountRepository) Create(ctx context.Context, entity *models.Account) (*models.Account, error) {
+	pending := events.Ensure(entity.Events(), models.NewAccountCreatedEvent(entity.ID))
+
+	var result *models.Account
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "account" (id, created_at, updated_at, access_token, access_token_expires_at, account_identifier, id_token, provider, refresh_token, refresh_token_expires_at, scope, user_id)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, access_token, access_token_expires_at, account_identifier, id_token, provider, refresh_token, refresh_token_expires_at, scope, user_id`,
+			entity.ID, now, now,
+			entity.AccessToken,
+			database.SQLiteNullTimeValue(entity.AccessTokenExpiresAt),
+			entity.AccountIdentifier,
+			entity.IDToken,
+			entity.Provider,
+			entity.RefreshToken,
+			database.SQLiteNullTimeValue(entity.RefreshTokenExpiresAt),
+			entity.Scope,
+			entity.UserID,
+		)
+
+		created, err := scanAccount(row)
+		if err != nil {
+			return fmt.Errorf("failed to create account: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a account by ID
 func (r *SQLiteAccountRepository) Get(ctx context.Context, id uuid.UUID) (*models.Account, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per account
-	return nil, fmt.Errorf("GetAccount not yet implemented - requires custom mapping")
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, access_token, access_token_expires_at, account_identifier, id_token, provider, refresh_token, refresh_token_expires_at, scope, user_id FROM "account" WHERE id = ?`,
+		id.String(),
+	)
+
+	result, err := scanAccount(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing account
-func (r *SQLiteAccountRepository) Update(ctx context.Context, id uuid.UUID, account *models.Account) (*models.Account, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per account
-	return nil, fmt.Errorf("UpdateAccount not yet implemented - requires custom mapping")
+// Update updates an existing account. Fields that are nil are left unchanged.
+func (r *SQLiteAccountRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Account) (*models.Account, error) {
+	pending := events.Ensure(entity.Events(), models.NewAccountUpdatedEvent(id))
+
+	var result *models.Account
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "account"
+			SET access_token = COALESCE(?, access_token), access_token_expires_at = COALESCE(?, access_token_expires_at), id_token = COALESCE(?, id_token), refresh_token = COALESCE(?, refresh_token), refresh_token_expires_at = COALESCE(?, refresh_token_expires_at), scope = COALESCE(?, scope)
+			WHERE id = ?
+			RETURNING id, created_at, updated_at, access_token, access_token_expires_at, account_identifier, id_token, provider, refresh_token, refresh_token_expires_at, scope, user_id`,
+			entity.AccessToken,
+			database.SQLiteNullTimeValue(entity.AccessTokenExpiresAt),
+			entity.IDToken,
+			entity.RefreshToken,
+			database.SQLiteNullTimeValue(entity.RefreshTokenExpiresAt),
+			entity.Scope,
+			id.String(),
+		)
+
+		updated, err := scanAccount(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to update account: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a account
 func (r *SQLiteAccountRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per account
-	return fmt.Errorf("DeleteAccount not yet implemented - requires custom mapping")
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "account" WHERE id = ?`,
+			id.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete account: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete account: %w", err)
+		}
+		if n == 0 {
+			return models.ErrAccountNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewAccountDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of accounts
-func (r *SQLiteAccountRepository) List(ctx context.Context, limit, offset int32) ([]*models.Account, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per account
-	return nil, 0, fmt.Errorf("ListAccounts not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of accounts
+func (r *SQLiteAccountRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Account, database.PageInfo, error) {
+	opts.Select = accountSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "account", accountColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Account
+	for rows.Next() {
+		item, err := scanAccount(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list accounts: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count accounts: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // Additional methods
 
 // GetAccountByProvider retrieves a single account by provider and accountIdentifier
 func (r *SQLiteAccountRepository) GetAccountByProvider(ctx context.Context, provider string, accountIdentifier string) (*models.Account, error) {
-	// TODO: Implement GetAccountByProvider
-	return nil, fmt.Errorf("GetAccountByProvider not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, access_token, access_token_expires_at, account_identifier, id_token, provider, refresh_token, refresh_token_expires_at, scope, user_id FROM "account" WHERE provider = ? AND account_identifier = ? LIMIT 1`
+	result, err := scanAccount(database.SQLConn(ctx, r.db).QueryRowContext(ctx, query, provider, accountIdentifier))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to GetAccountByProvider: %w", err)
+	}
+	return result, nil
 }
 
 // ListAccountsByUserID retrieves multiple accounts by userID
 func (r *SQLiteAccountRepository) ListAccountsByUserID(ctx context.Context, userID string) ([]*models.Account, error) {
-	// TODO: Implement ListAccountsByUserID
-	return nil, fmt.Errorf("ListAccountsByUserID not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, access_token, access_token_expires_at, account_identifier, id_token, provider, refresh_token, refresh_token_expires_at, scope, user_id FROM "account" WHERE user_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListAccountsByUserID: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Account
+	for rows.Next() {
+		item, err := scanAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListAccountsByUserID: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListAccountsByUserID: %w", err)
+	}
+	return items, nil
+}
+
+// accountSelectColumns are the columns scanAccount reads, in order.
+var accountSelectColumns = []string{"id", "created_at", "updated_at", "access_token", "access_token_expires_at", "account_identifier", "id_token", "provider", "refresh_token", "refresh_token_expires_at", "scope", "user_id"}
+
+// accountColumns maps Account fields to the columns List can filter and sort on.
+var accountColumns = database.Columns{
+	"id":                    {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":             {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":             {Name: "updated_at", Kind: database.ColumnTime},
+	"accessToken":           {Name: "access_token", Kind: database.ColumnString},
+	"accessTokenExpiresAt":  {Name: "access_token_expires_at", Kind: database.ColumnTime},
+	"accountIdentifier":     {Name: "account_identifier", Kind: database.ColumnString},
+	"idToken":               {Name: "id_token", Kind: database.ColumnString},
+	"provider":              {Name: "provider", Kind: database.ColumnString},
+	"refreshToken":          {Name: "refresh_token", Kind: database.ColumnString},
+	"refreshTokenExpiresAt": {Name: "refresh_token_expires_at", Kind: database.ColumnTime},
+	"scope":                 {Name: "scope", Kind: database.ColumnString},
+	"userID":                {Name: "user_id", Kind: database.ColumnUUID},
+}
+
+// scanAccount reads a account row of accountSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanAccount(row interface{ Scan(dest ...any) error }) (*models.Account, error) {
+	var entity models.Account
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.AccessToken,
+		database.SQLiteNullTime(&entity.AccessTokenExpiresAt),
+		&entity.AccountIdentifier,
+		&entity.IDToken,
+		&entity.Provider,
+		&entity.RefreshToken,
+		database.SQLiteNullTime(&entity.RefreshTokenExpiresAt),
+		&entity.Scope,
+		&entity.UserID,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
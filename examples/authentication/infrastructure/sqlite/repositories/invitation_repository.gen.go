@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 )
 
 // SQLiteInvitationRepository implements InvitationRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteInvitationRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteInvitationRepository creates a new SQLite repository.
 func NewSQLiteInvitationRepository(db *sql.DB) *SQLiteInvitationRepository {
 	return &SQLiteInvitationRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,56 +33,238 @@ func NewSQLiteInvitationRepository(db *sql.DB) *SQLiteInvitationRepository {
 // Invitation operations
 
 // Create creates a new invitation
-func (r *SQLiteInvitationRepository) Create(ctx context.Context, invitation *models.Invitation) (*models.Invitation, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per invitation
-	return nil, fmt.Errorf("CreateInvitation not yet implemented - requires custom mapping")
+func (r *SQLiteInvitationRepository) Create(ctx context.Context, entity *models.Invitation) (*models.Invitation, error) {
+	pending := events.Ensure(entity.Events(), models.NewInvitationCreatedEvent(entity.ID))
+
+	var result *models.Invitation
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "invitation" (id, created_at, updated_at, email, expires_at, inviter_id, organization_id, role, status)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, email, expires_at, inviter_id, organization_id, role, status`,
+			entity.ID, now, now,
+			entity.Email,
+			database.SQLiteTimeValue(entity.ExpiresAt),
+			entity.InviterID,
+			entity.OrganizationID,
+			entity.Role,
+			entity.Status,
+		)
+
+		created, err := scanInvitation(row)
+		if err != nil {
+			return fmt.Errorf("failed to create invitation: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a invitation by ID
 func (r *SQLiteInvitationRepository) Get(ctx context.Context, id uuid.UUID) (*models.Invitation, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per invitation
-	return nil, fmt.Errorf("GetInvitation not yet implemented - requires custom mapping")
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, email, expires_at, inviter_id, organization_id, role, status FROM "invitation" WHERE id = ?`,
+		id.String(),
+	)
+
+	result, err := scanInvitation(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing invitation
-func (r *SQLiteInvitationRepository) Update(ctx context.Context, id uuid.UUID, invitation *models.Invitation) (*models.Invitation, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per invitation
-	return nil, fmt.Errorf("UpdateInvitation not yet implemented - requires custom mapping")
+// Update updates an existing invitation. Fields that are nil are left unchanged.
+func (r *SQLiteInvitationRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Invitation) (*models.Invitation, error) {
+	pending := events.Ensure(entity.Events(), models.NewInvitationUpdatedEvent(id))
+
+	var result *models.Invitation
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "invitation"
+			SET email = COALESCE(?, email), expires_at = COALESCE(?, expires_at), role = COALESCE(?, role), status = COALESCE(?, status)
+			WHERE id = ?
+			RETURNING id, created_at, updated_at, email, expires_at, inviter_id, organization_id, role, status`,
+			entity.Email,
+			database.SQLiteTimeValue(entity.ExpiresAt),
+			entity.Role,
+			entity.Status,
+			id.String(),
+		)
+
+		updated, err := scanInvitation(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrInvitationNotFound
+			}
+			return fmt.Errorf("failed to update invitation: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a invitation
 func (r *SQLiteInvitationRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per invitation
-	return fmt.Errorf("DeleteInvitation not yet implemented - requires custom mapping")
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "invitation" WHERE id = ?`,
+			id.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete invitation: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete invitation: %w", err)
+		}
+		if n == 0 {
+			return models.ErrInvitationNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewInvitationDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of invitations
-func (r *SQLiteInvitationRepository) List(ctx context.Context, limit, offset int32) ([]*models.Invitation, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per invitation
-	return nil, 0, fmt.Errorf("ListInvitations not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of invitations
+func (r *SQLiteInvitationRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Invitation, database.PageInfo, error) {
+	opts.Select = invitationSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "invitation", invitationColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list invitations: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Invitation
+	for rows.Next() {
+		item, err := scanInvitation(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list invitations: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count invitations: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // Additional methods
 
 // ListInvitationsByOrganization retrieves multiple invitations by organizationID
 func (r *SQLiteInvitationRepository) ListInvitationsByOrganization(ctx context.Context, organizationID string) ([]*models.Invitation, error) {
-	// TODO: Implement ListInvitationsByOrganization
-	return nil, fmt.Errorf("ListInvitationsByOrganization not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, email, expires_at, inviter_id, organization_id, role, status FROM "invitation" WHERE organization_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListInvitationsByOrganization: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Invitation
+	for rows.Next() {
+		item, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListInvitationsByOrganization: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListInvitationsByOrganization: %w", err)
+	}
+	return items, nil
 }
 
 // GetInvitationByEmail retrieves a single invitation by email and organizationID
 func (r *SQLiteInvitationRepository) GetInvitationByEmail(ctx context.Context, email string, organizationID string) (*models.Invitation, error) {
-	// TODO: Implement GetInvitationByEmail
-	return nil, fmt.Errorf("GetInvitationByEmail not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, email, expires_at, inviter_id, organization_id, role, status FROM "invitation" WHERE email = ? AND organization_id = ? LIMIT 1`
+	result, err := scanInvitation(database.SQLConn(ctx, r.db).QueryRowContext(ctx, query, email, organizationID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to GetInvitationByEmail: %w", err)
+	}
+	return result, nil
 }
 
 // ListInvitationsByInviter retrieves multiple invitations by inviterID
 func (r *SQLiteInvitationRepository) ListInvitationsByInviter(ctx context.Context, inviterID string) ([]*models.Invitation, error) {
-	// TODO: Implement ListInvitationsByInviter
-	return nil, fmt.Errorf("ListInvitationsByInviter not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, email, expires_at, inviter_id, organization_id, role, status FROM "invitation" WHERE inviter_id = ? ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, inviterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListInvitationsByInviter: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Invitation
+	for rows.Next() {
+		item, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListInvitationsByInviter: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListInvitationsByInviter: %w", err)
+	}
+	return items, nil
+}
+
+// invitationSelectColumns are the columns scanInvitation reads, in order.
+var invitationSelectColumns = []string{"id", "created_at", "updated_at", "email", "expires_at", "inviter_id", "organization_id", "role", "status"}
+
+// invitationColumns maps Invitation fields to the columns List can filter and sort on.
+var invitationColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"email":          {Name: "email", Kind: database.ColumnString},
+	"expiresAt":      {Name: "expires_at", Kind: database.ColumnTime},
+	"inviterID":      {Name: "inviter_id", Kind: database.ColumnUUID},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"role":           {Name: "role", Kind: database.ColumnString},
+	"status":         {Name: "status", Kind: database.ColumnString},
+}
+
+// scanInvitation reads a invitation row of invitationSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanInvitation(row interface{ Scan(dest ...any) error }) (*models.Invitation, error) {
+	var entity models.Invitation
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.Email,
+		database.SQLiteTime(&entity.ExpiresAt),
+		&entity.InviterID,
+		&entity.OrganizationID,
+		&entity.Role,
+		&entity.Status,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
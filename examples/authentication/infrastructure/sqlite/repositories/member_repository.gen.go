@@ -5,20 +5,28 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 )
 
 // SQLiteMemberRepository implements MemberRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteMemberRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteMemberRepository creates a new SQLite repository.
 func NewSQLiteMemberRepository(db *sql.DB) *SQLiteMemberRepository {
 	return &SQLiteMemberRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,56 +34,268 @@ func NewSQLiteMemberRepository(db *sql.DB) *SQLiteMemberRepository {
 // Member operations
 
 // Create creates a new member
-func (r *SQLiteMemberRepository) Create(ctx context.Context, member *models.Member) (*models.Member, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per member
-	return nil, fmt.Errorf("CreateMember not yet implemented - requires custom mapping")
+func (r *SQLiteMemberRepository) Create(ctx context.Context, entity *models.Member) (*models.Member, error) {
+	pending := events.Ensure(entity.Events(), models.NewMemberCreatedEvent(entity.ID))
+
+	var result *models.Member
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "member" (id, created_at, updated_at, organization_id, role, role_id, user_id)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, organization_id, role, role_id, user_id`,
+			entity.ID, now, now,
+			entity.OrganizationID,
+			entity.Role,
+			entity.RoleID,
+			entity.UserID,
+		)
+
+		created, err := scanMember(row)
+		if err != nil {
+			return fmt.Errorf("failed to create member: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a member by ID
 func (r *SQLiteMemberRepository) Get(ctx context.Context, id uuid.UUID) (*models.Member, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per member
-	return nil, fmt.Errorf("GetMember not yet implemented - requires custom mapping")
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, organization_id, role, role_id, user_id FROM "member" WHERE id = ? AND deleted_at IS NULL`,
+		id.String(),
+	)
+
+	result, err := scanMember(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to get member: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing member
-func (r *SQLiteMemberRepository) Update(ctx context.Context, id uuid.UUID, member *models.Member) (*models.Member, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per member
-	return nil, fmt.Errorf("UpdateMember not yet implemented - requires custom mapping")
+// Update updates an existing member. Fields that are nil are left unchanged.
+func (r *SQLiteMemberRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Member) (*models.Member, error) {
+	pending := events.Ensure(entity.Events(), models.NewMemberUpdatedEvent(id))
+
+	var result *models.Member
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "member"
+			SET role = COALESCE(?, role), role_id = COALESCE(?, role_id)
+			WHERE id = ? AND deleted_at IS NULL
+			RETURNING id, created_at, updated_at, organization_id, role, role_id, user_id`,
+			entity.Role,
+			entity.RoleID,
+			id.String(),
+		)
+
+		updated, err := scanMember(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrMemberNotFound
+			}
+			return fmt.Errorf("failed to update member: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
-// Delete removes a member
+// Delete marks a member as deleted
 func (r *SQLiteMemberRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per member
-	return fmt.Errorf("DeleteMember not yet implemented - requires custom mapping")
+	return r.setDeletedAt(ctx, id, "CURRENT_TIMESTAMP", "deleted_at IS NULL", models.NewMemberDeletedEvent(id))
+}
+
+// Restore clears the deletion mark of a deleted member
+func (r *SQLiteMemberRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	return r.setDeletedAt(ctx, id, "NULL", "deleted_at IS NOT NULL", models.NewMemberUpdatedEvent(id))
+}
+
+// setDeletedAt updates the deleted_at column of a member in the given state and records event.
+func (r *SQLiteMemberRepository) setDeletedAt(ctx context.Context, id uuid.UUID, value, state string, event events.Event) error {
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`UPDATE "member" SET deleted_at = `+value+` WHERE id = ? AND `+state,
+			id.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update member: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to update member: %w", err)
+		}
+		if n == 0 {
+			return models.ErrMemberNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{event})
+	})
+}
+
+// List returns a filtered, sorted and paginated list of members that are not deleted
+func (r *SQLiteMemberRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error) {
+	opts.Deleted = database.DeletedExclude
+	return r.list(ctx, opts)
 }
 
-// List returns a paginated list of members
-func (r *SQLiteMemberRepository) List(ctx context.Context, limit, offset int32) ([]*models.Member, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per member
-	return nil, 0, fmt.Errorf("ListMembers not yet implemented - requires custom mapping")
+// ListDeleted returns a filtered, sorted and paginated list of deleted members
+func (r *SQLiteMemberRepository) ListDeleted(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error) {
+	opts.Deleted = database.DeletedOnly
+	return r.list(ctx, opts)
+}
+
+// Purge removes the members deleted before the given time, in all organizations.
+// Purged rows cannot be restored and record no events.
+func (r *SQLiteMemberRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	res, err := database.SQLConn(ctx, r.db).ExecContext(ctx,
+		`DELETE FROM "member" WHERE deleted_at IS NOT NULL AND deleted_at < ?`,
+		database.SQLiteTimeValue(before),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge members: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge members: %w", err)
+	}
+	return n, nil
+}
+
+func (r *SQLiteMemberRepository) list(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error) {
+	opts.Select = memberSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "member", memberColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list members: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Member
+	for rows.Next() {
+		item, err := scanMember(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list members: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list members: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count members: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // Additional methods
 
 // ListMembersByOrganization retrieves multiple members by organizationID
 func (r *SQLiteMemberRepository) ListMembersByOrganization(ctx context.Context, organizationID string) ([]*models.Member, error) {
-	// TODO: Implement ListMembersByOrganization
-	return nil, fmt.Errorf("ListMembersByOrganization not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, organization_id, role, role_id, user_id FROM "member" WHERE organization_id = ? AND deleted_at IS NULL ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListMembersByOrganization: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Member
+	for rows.Next() {
+		item, err := scanMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListMembersByOrganization: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListMembersByOrganization: %w", err)
+	}
+	return items, nil
 }
 
 // ListMembersByUser retrieves multiple members by userID
 func (r *SQLiteMemberRepository) ListMembersByUser(ctx context.Context, userID string) ([]*models.Member, error) {
-	// TODO: Implement ListMembersByUser
-	return nil, fmt.Errorf("ListMembersByUser not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, organization_id, role, role_id, user_id FROM "member" WHERE user_id = ? AND deleted_at IS NULL ORDER BY created_at DESC`
+	rows, err := database.SQLConn(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListMembersByUser: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Member
+	for rows.Next() {
+		item, err := scanMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ListMembersByUser: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to ListMembersByUser: %w", err)
+	}
+	return items, nil
 }
 
 // GetMemberByUserAndOrganization retrieves a single member by userID and organizationID
 func (r *SQLiteMemberRepository) GetMemberByUserAndOrganization(ctx context.Context, userID string, organizationID string) (*models.Member, error) {
-	// TODO: Implement GetMemberByUserAndOrganization
-	return nil, fmt.Errorf("GetMemberByUserAndOrganization not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, organization_id, role, role_id, user_id FROM "member" WHERE user_id = ? AND organization_id = ? AND deleted_at IS NULL LIMIT 1`
+	result, err := scanMember(database.SQLConn(ctx, r.db).QueryRowContext(ctx, query, userID, organizationID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to GetMemberByUserAndOrganization: %w", err)
+	}
+	return result, nil
+}
+
+// memberSelectColumns are the columns scanMember reads, in order.
+var memberSelectColumns = []string{"id", "created_at", "updated_at", "organization_id", "role", "role_id", "user_id"}
+
+// memberColumns maps Member fields to the columns List can filter and sort on.
+var memberColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"role":           {Name: "role", Kind: database.ColumnString},
+	"roleID":         {Name: "role_id", Kind: database.ColumnUUID},
+	"userID":         {Name: "user_id", Kind: database.ColumnUUID},
+}
+
+// scanMember reads a member row of memberSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanMember(row interface{ Scan(dest ...any) error }) (*models.Member, error) {
+	var entity models.Member
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.OrganizationID,
+		&entity.Role,
+		&entity.RoleID,
+		&entity.UserID,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 )
 
 // SQLiteSessionRepository implements SessionRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteSessionRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteSessionRepository creates a new SQLite repository.
 func NewSQLiteSessionRepository(db *sql.DB) *SQLiteSessionRepository {
 	return &SQLiteSessionRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,36 +33,183 @@ func NewSQLiteSessionRepository(db *sql.DB) *SQLiteSessionRepository {
 // Session operations
 
 // Create creates a new session
-func (r *SQLiteSessionRepository) Create(ctx context.Context, session *models.Session) (*models.Session, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per session
-	return nil, fmt.Errorf("CreateSession not yet implemented - requires custom mapping")
+func (r *SQLiteSessionRepository) Create(ctx context.Context, entity *models.Session) (*models.Session, error) {
+	pending := events.Ensure(entity.Events(), models.NewSessionCreatedEvent(entity.ID))
+
+	var result *models.Session
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "session" (id, created_at, updated_at, auth_method, auth_provider, expires_at, ip_address, organization_id, token, user_agent, user_id)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, auth_method, auth_provider, expires_at, ip_address, organization_id, token, user_agent, user_id`,
+			entity.ID, now, now,
+			entity.AuthMethod,
+			entity.AuthProvider,
+			database.SQLiteTimeValue(entity.ExpiresAt),
+			entity.IPAddress,
+			entity.OrganizationID,
+			entity.Token,
+			entity.UserAgent,
+			entity.UserID,
+		)
+
+		created, err := scanSession(row)
+		if err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a session by ID
 func (r *SQLiteSessionRepository) Get(ctx context.Context, id uuid.UUID) (*models.Session, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per session
-	return nil, fmt.Errorf("GetSession not yet implemented - requires custom mapping")
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, auth_method, auth_provider, expires_at, ip_address, organization_id, token, user_agent, user_id FROM "session" WHERE id = ?`,
+		id.String(),
+	)
+
+	result, err := scanSession(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing session
-func (r *SQLiteSessionRepository) Update(ctx context.Context, id uuid.UUID, session *models.Session) (*models.Session, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per session
-	return nil, fmt.Errorf("UpdateSession not yet implemented - requires custom mapping")
+// Update updates an existing session. Fields that are nil are left unchanged.
+func (r *SQLiteSessionRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Session) (*models.Session, error) {
+	pending := events.Ensure(entity.Events(), models.NewSessionUpdatedEvent(id))
+
+	var result *models.Session
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "session"
+			SET auth_method = COALESCE(?, auth_method), auth_provider = COALESCE(?, auth_provider), expires_at = COALESCE(?, expires_at), organization_id = COALESCE(?, organization_id)
+			WHERE id = ?
+			RETURNING id, created_at, updated_at, auth_method, auth_provider, expires_at, ip_address, organization_id, token, user_agent, user_id`,
+			entity.AuthMethod,
+			entity.AuthProvider,
+			database.SQLiteTimeValue(entity.ExpiresAt),
+			entity.OrganizationID,
+			id.String(),
+		)
+
+		updated, err := scanSession(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrSessionNotFound
+			}
+			return fmt.Errorf("failed to update session: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a session
 func (r *SQLiteSessionRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per session
-	return fmt.Errorf("DeleteSession not yet implemented - requires custom mapping")
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "session" WHERE id = ?`,
+			id.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete session: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete session: %w", err)
+		}
+		if n == 0 {
+			return models.ErrSessionNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewSessionDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of sessions
-func (r *SQLiteSessionRepository) List(ctx context.Context, limit, offset int32) ([]*models.Session, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per session
-	return nil, 0, fmt.Errorf("ListSessions not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of sessions
+func (r *SQLiteSessionRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Session, database.PageInfo, error) {
+	opts.Select = sessionSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "session", sessionColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Session
+	for rows.Next() {
+		item, err := scanSession(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list sessions: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count sessions: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
+}
+
+// sessionSelectColumns are the columns scanSession reads, in order.
+var sessionSelectColumns = []string{"id", "created_at", "updated_at", "auth_method", "auth_provider", "expires_at", "ip_address", "organization_id", "token", "user_agent", "user_id"}
+
+// sessionColumns maps Session fields to the columns List can filter and sort on.
+var sessionColumns = database.Columns{
+	"id":             {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":      {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":      {Name: "updated_at", Kind: database.ColumnTime},
+	"authMethod":     {Name: "auth_method", Kind: database.ColumnString},
+	"authProvider":   {Name: "auth_provider", Kind: database.ColumnString},
+	"expiresAt":      {Name: "expires_at", Kind: database.ColumnTime},
+	"ipAddress":      {Name: "ip_address", Kind: database.ColumnString},
+	"organizationID": {Name: "organization_id", Kind: database.ColumnUUID},
+	"token":          {Name: "token", Kind: database.ColumnString},
+	"userAgent":      {Name: "user_agent", Kind: database.ColumnString},
+	"userID":         {Name: "user_id", Kind: database.ColumnUUID},
+}
+
+// scanSession reads a session row of sessionSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanSession(row interface{ Scan(dest ...any) error }) (*models.Session, error) {
+	var entity models.Session
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.AuthMethod,
+		&entity.AuthProvider,
+		database.SQLiteTime(&entity.ExpiresAt),
+		&entity.IPAddress,
+		&entity.OrganizationID,
+		&entity.Token,
+		&entity.UserAgent,
+		&entity.UserID,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
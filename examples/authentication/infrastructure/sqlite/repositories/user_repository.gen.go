@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/google/uuid"
 )
 
 // SQLiteUserRepository implements UserRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteUserRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteUserRepository creates a new SQLite repository.
 func NewSQLiteUserRepository(db *sql.DB) *SQLiteUserRepository {
 	return &SQLiteUserRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,50 +33,199 @@ func NewSQLiteUserRepository(db *sql.DB) *SQLiteUserRepository {
 // User operations
 
 // Create creates a new user
-func (r *SQLiteUserRepository) Create(ctx context.Context, user *models.User) (*models.User, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per user
-	return nil, fmt.Errorf("CreateUser not yet implemented - requires custom mapping")
+func (r *SQLiteUserRepository) Create(ctx context.Context, entity *models.User) (*models.User, error) {
+	pending := events.Ensure(entity.Events(), models.NewUserCreatedEvent(entity.ID))
+
+	var result *models.User
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "user" (id, created_at, updated_at, email, email_verified, image, name)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, email, email_verified, image, name`,
+			entity.ID, now, now,
+			entity.Email,
+			entity.EmailVerified,
+			entity.Image,
+			entity.Name,
+		)
+
+		created, err := scanUser(row)
+		if err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a user by ID
 func (r *SQLiteUserRepository) Get(ctx context.Context, id uuid.UUID) (*models.User, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per user
-	return nil, fmt.Errorf("GetUser not yet implemented - requires custom mapping")
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, email, email_verified, image, name FROM "user" WHERE id = ?`,
+		id.String(),
+	)
+
+	result, err := scanUser(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing user
-func (r *SQLiteUserRepository) Update(ctx context.Context, id uuid.UUID, user *models.User) (*models.User, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per user
-	return nil, fmt.Errorf("UpdateUser not yet implemented - requires custom mapping")
+// Update updates an existing user. Fields that are nil are left unchanged.
+func (r *SQLiteUserRepository) Update(ctx context.Context, id uuid.UUID, entity *models.User) (*models.User, error) {
+	pending := events.Ensure(entity.Events(), models.NewUserUpdatedEvent(id))
+
+	var result *models.User
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "user"
+			SET email = COALESCE(?, email), email_verified = COALESCE(?, email_verified), image = COALESCE(?, image), name = COALESCE(?, name)
+			WHERE id = ?
+			RETURNING id, created_at, updated_at, email, email_verified, image, name`,
+			entity.Email,
+			entity.EmailVerified,
+			entity.Image,
+			entity.Name,
+			id.String(),
+		)
+
+		updated, err := scanUser(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrUserNotFound
+			}
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a user
 func (r *SQLiteUserRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per user
-	return fmt.Errorf("DeleteUser not yet implemented - requires custom mapping")
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "user" WHERE id = ?`,
+			id.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		if n == 0 {
+			return models.ErrUserNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewUserDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of users
-func (r *SQLiteUserRepository) List(ctx context.Context, limit, offset int32) ([]*models.User, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per user
-	return nil, 0, fmt.Errorf("ListUsers not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of users
+func (r *SQLiteUserRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.User, database.PageInfo, error) {
+	opts.Select = userSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "user", userColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.User
+	for rows.Next() {
+		item, err := scanUser(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list users: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
 }
 
 // Additional methods
 
 // GetUserByEmail retrieves a single user by email
 func (r *SQLiteUserRepository) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
-	// TODO: Implement GetUserByEmail
-	return nil, fmt.Errorf("GetUserByEmail not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, email, email_verified, image, name FROM "user" WHERE email = ? LIMIT 1`
+	result, err := scanUser(database.SQLConn(ctx, r.db).QueryRowContext(ctx, query, email))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to GetUserByEmail: %w", err)
+	}
+	return result, nil
 }
 
 // GetUserBySessionID retrieves a single user by sessionID
 func (r *SQLiteUserRepository) GetUserBySessionID(ctx context.Context, sessionID string) (*models.User, error) {
-	// TODO: Implement GetUserBySessionID
-	return nil, fmt.Errorf("GetUserBySessionID not yet implemented - requires custom mapping")
+	query := `SELECT id, created_at, updated_at, email, email_verified, image, name FROM "user" WHERE id = (SELECT user_id FROM "session" WHERE id = ?) LIMIT 1`
+	result, err := scanUser(database.SQLConn(ctx, r.db).QueryRowContext(ctx, query, sessionID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to GetUserBySessionID: %w", err)
+	}
+	return result, nil
+}
+
+// userSelectColumns are the columns scanUser reads, in order.
+var userSelectColumns = []string{"id", "created_at", "updated_at", "email", "email_verified", "image", "name"}
+
+// userColumns maps User fields to the columns List can filter and sort on.
+var userColumns = database.Columns{
+	"id":            {Name: "id", Kind: database.ColumnUUID},
+	"createdAt":     {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt":     {Name: "updated_at", Kind: database.ColumnTime},
+	"email":         {Name: "email", Kind: database.ColumnString},
+	"emailVerified": {Name: "email_verified", Kind: database.ColumnBool},
+	"image":         {Name: "image", Kind: database.ColumnString},
+	"name":          {Name: "name", Kind: database.ColumnString},
+}
+
+// scanUser reads a user row of userSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanUser(row interface{ Scan(dest ...any) error }) (*models.User, error) {
+	var entity models.User
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.Email,
+		&entity.EmailVerified,
+		&entity.Image,
+		&entity.Name,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
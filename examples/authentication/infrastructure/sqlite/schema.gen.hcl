@@ -280,10 +280,20 @@ table "member" {
     default = "basic"
   }
 
+  column "role_id" {
+    null = true
+    type = sql("TEXT")
+  }
+
   column "user_id" {
     null = false
     type = sql("TEXT")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("TEXT")
+  }
   primary_key {
     columns = [column.id]
   }
@@ -299,6 +309,12 @@ table "member" {
     on_update   = "NO_ACTION"
     on_delete   = "CASCADE"
   }
+  foreign_key "member_role_id_fkey" {
+    columns     = [column.role_id]
+    ref_columns = [table.role.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "SET_NULL"
+  }
   index "idx_member_organization_id" {
     columns = [column.organization_id]
   }
@@ -364,9 +380,77 @@ table "organization" {
     null = false
     type = sql("TEXT")
   }
+
+  column "deleted_at" {
+    null = true
+    type = sql("TEXT")
+  }
   primary_key {
     columns = [column.id]
   }
+  index "idx_organization_slug" {
+    unique  = true
+    columns = [column.slug]
+    where   = "(deleted_at IS NULL)"
+  }
+}
+
+table "role" {
+  schema = schema.main
+
+  column "id" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("lower(hex(randomblob(16)))")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "updated_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "description" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "name" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "organization_id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "permissions" {
+    null    = false
+    type    = sql("TEXT")
+    default = "[]"
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "role_organization_id_fkey" {
+    columns     = [column.organization_id]
+    ref_columns = [table.organization.column.id]
+    on_update   = "NO_ACTION"
+    on_delete   = "CASCADE"
+  }
+  index "idx_role_name" {
+    columns = [column.name]
+  }
+  index "idx_role_organization_id" {
+    columns = [column.organization_id]
+  }
 }
 
 table "session" {
@@ -493,5 +577,64 @@ table "user" {
 }
 
 
+table "event_outbox" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "event_type" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "organization_id" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "payload" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "published_at" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "attempts" {
+    null    = false
+    type    = sql("INTEGER")
+    default = 0
+  }
+
+  column "last_error" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "dead_lettered_at" {
+    null = true
+    type = sql("TEXT")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_event_outbox_pending" {
+    columns = [column.created_at, column.id]
+    where   = "(published_at IS NULL)"
+  }
+}
+
+
 schema "main" {
 }
\ No newline at end of file
@@ -1032,7 +1032,7 @@ export const registerBodyNameRegExp = new RegExp('^[\\w\\s\\-.,!?()@#+/]*$');
 export const registerBodyEmailMax = 255;
 
 
-export const registerBodyEmailRegExp = new RegExp('^(?!\\.)(?!.*\\.\\.)([A-Za-z0-9_\'+\\-\\.]*)[A-Za-z0-9_+-]@([A-Za-z0-9][A-Za-z0-9\\-]*\\.)+[A-Za-z]{2,}$');
+export const registerBodyEmailRegExp = new RegExp('^([A-Za-z0-9_\'+\\-]+\\.)*[A-Za-z0-9_\'+\\-]*[A-Za-z0-9_+\\-]@([A-Za-z0-9][A-Za-z0-9\\-]*\\.)+[A-Za-z]{2,}$');
 export const registerBodyPasswordMin = 8;
 export const registerBodyPasswordMax = 72;
 
@@ -1230,7 +1230,7 @@ export const deleteSessionParams = zod.object({
 
 
 /**
- * This endpoint will update the active organization for the current session
+ * This endpoint will update the active organization for the current session and issue tokens scoped to it. The caller must be a member of the organization.
  * @summary Update Session
  */
 export const updateSessionPathIdMin = 36;
@@ -1283,6 +1283,14 @@ export const updateSessionResponseDataUserIDMax = 36;
 
 
 
+export const updateSessionResponseAccessTokenMax = 4096;
+
+export const updateSessionResponseRefreshTokenMax = 4096;
+
+export const updateSessionResponseExpiresInMax = 2147483647;
+
+
+
 export const updateSessionResponse = zod.object({
   "data": zod.object({
   "createdAt": zod.string().datetime({}).min(1).max(updateSessionResponseDataCreatedAtMax).describe('The date and time when the resource was created'),
@@ -1298,6 +1306,10 @@ export const updateSessionResponse = zod.object({
   "userAgent": zod.string().min(1).max(updateSessionResponseDataUserAgentMax).regex(updateSessionResponseDataUserAgentRegExp).nullable().describe('The user agent of the session'),
   "userID": zod.string().uuid().min(updateSessionResponseDataUserIDMin).max(updateSessionResponseDataUserIDMax).describe('The user who owns this session')
 })).describe('Schema for Session entity')
+,
+  "accessToken": zod.string().min(1).max(updateSessionResponseAccessTokenMax).describe('Access token scoped to the active organization of the session'),
+  "refreshToken": zod.string().min(1).max(updateSessionResponseRefreshTokenMax).describe('Refresh token of the session'),
+  "expiresIn": zod.number().min(1).max(updateSessionResponseExpiresInMax).describe('Seconds until the access token expires')
 })
 
 
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"time"
 
 	"github.com/archesai/archesai/pkg/config"
@@ -24,6 +25,7 @@ type App struct {
 	db        *database.Database
 	apiServer *server.APIServer
 	handlers  *Handlers
+	relay     *events.OutboxRelay
 }
 
 // NewApp creates a new App.
@@ -57,8 +59,10 @@ func (a *App) Initialize() error {
 	services := &Services{
 		DB:        db,
 		Publisher: events.NewNoOpPublisher(),
+		TxManager: database.NewTxManager(db),
 	}
 	a.handlers = NewHandlers(services)
+	a.relay = events.NewOutboxRelay(db, services.Publisher, events.DefaultRelayConfig())
 
 	// Create API server
 	a.apiServer = server.NewAPIServer(&server.APIConfig{
@@ -77,6 +81,20 @@ func (a *App) Initialize() error {
 
 // Start starts the application and blocks until shutdown signal is received.
 func (a *App) Start() error {
+	// Run background workers until shutdown
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	var workers sync.WaitGroup
+
+	// Publish the events repositories wrote to the outbox
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		if err := a.relay.Run(workerCtx); err != nil {
+			slog.Error("outbox relay error", "error", err)
+		}
+	}()
+
 	// Start server in goroutine
 	go func() {
 		slog.Info("starting server", "port", a.config.Config.API.Port)
@@ -102,9 +120,13 @@ func (a *App) Start() error {
 		return err
 	}
 
+	// Stop the workers and wait for in-flight work
+	stopWorkers()
+	workers.Wait()
+
 	// Close database
 	if a.db != nil {
-		a.db.SQLDB().Close()
+		a.db.Close()
 	}
 
 	slog.Info("server shutdown complete")
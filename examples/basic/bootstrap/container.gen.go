@@ -6,12 +6,16 @@ import (
 	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/events"
 	serverbootstrap "github.com/archesai/archesai/pkg/server/bootstrap"
+	postgresrepos "github.com/archesai/examples/basic/infrastructure/postgres/repositories"
+	sqliterepos "github.com/archesai/examples/basic/infrastructure/sqlite/repositories"
 )
 
 // Services holds shared dependencies for all handlers.
 type Services struct {
 	DB        *database.Database
 	Publisher events.Publisher
+	// TxManager runs the writes of several repositories in one transaction.
+	TxManager *database.TxManager
 }
 
 // NewHandlers creates all handlers with the given services.
@@ -21,6 +25,9 @@ func NewHandlers(services *Services) *Handlers {
 
 	if services.DB.IsSQLite() {
 		return &Handlers{
+			App: NewHTTPHandlers(NewApplicationHandlers(
+				sqliterepos.NewSQLiteTodoRepository(db),
+			)),
 			Server: serverbootstrap.NewHTTPHandlers(serverbootstrap.NewApplicationHandlers()),
 		}
 	}
@@ -28,6 +35,9 @@ func NewHandlers(services *Services) *Handlers {
 	// PostgreSQL
 	pool := services.DB.PgxPool()
 	return &Handlers{
+		App: NewHTTPHandlers(NewApplicationHandlers(
+			postgresrepos.NewPostgresTodoRepository(pool),
+		)),
 		Server: serverbootstrap.NewHTTPHandlers(serverbootstrap.NewApplicationHandlers()),
 	}
 }
@@ -6,9 +6,22 @@ import (
 	"log/slog"
 	"net/http"
 
+	serverbootstrap "github.com/archesai/archesai/pkg/server/bootstrap"
 	"github.com/archesai/examples/basic/routes"
 )
 
+// Handlers composes all handlers from internal packages.
+type Handlers struct {
+	App    *HTTPHandlers
+	Server *serverbootstrap.HTTPHandlers
+}
+
+// RegisterRoutes registers all routes from all internal packages.
+func RegisterRoutes(mux *http.ServeMux, handlers *Handlers) {
+	registerAppRoutes(mux, handlers.App)
+	serverbootstrap.RegisterRoutes(mux, handlers.Server)
+}
+
 // HTTPHandlers holds all HTTP handlers for this package.
 type HTTPHandlers struct {
 	GetTodo *routes.GetTodoHandler
@@ -21,8 +34,8 @@ func NewHTTPHandlers(appHandlers *ApplicationHandlers) *HTTPHandlers {
 	}
 }
 
-// RegisterRoutes registers all routes for this package with the http.ServeMux.
-func RegisterRoutes(mux *http.ServeMux, handlers *HTTPHandlers) {
+// registerAppRoutes registers all routes for this package with the http.ServeMux.
+func registerAppRoutes(mux *http.ServeMux, handlers *HTTPHandlers) {
 	slog.Info("registering route", "method", "GET", "path", "/todos")
 	routes.RegisterGetTodoRoute(mux, handlers.GetTodo)
 }
@@ -0,0 +1,49 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/archesai/archesai/pkg/apiclient"
+)
+
+// ============================================================================
+// GetHealth - GET /health
+// ============================================================================
+
+// GetHealthResponseServices defines the services structure of GetHealthResponse.
+type GetHealthResponseServices struct {
+	Database string `json:"database"`
+	Email    string `json:"email"`
+	Redis    string `json:"redis"`
+}
+
+// GetHealthResponse is the 200 response of GetHealth.
+type GetHealthResponse struct {
+	Services  GetHealthResponseServices `json:"services"`
+	Timestamp time.Time                 `json:"timestamp"`
+	Uptime    int64                     `json:"uptime"`
+}
+
+// GetHealth calls GET /health.
+//
+// Get health status
+func (c *Client) GetHealth(ctx context.Context) (*GetHealthResponse, error) {
+	req := apiclient.Request{
+		Method: http.MethodGet,
+		Path:   "/health",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	var resp GetHealthResponse
+	if err := c.Do(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetHealth: %w", err)
+	}
+	return &resp, nil
+}
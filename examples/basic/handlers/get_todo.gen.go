@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/examples/basic/models"
 	"github.com/archesai/examples/basic/repositories"
 )
@@ -23,6 +24,9 @@ type GetTodoInput struct {
 // GetTodoOutput represents the output for the GetTodo operation.
 type GetTodoOutput struct {
 	Data []models.Todo `json:"data,omitempty"`
+
+	// ETag is the entity tag of the returned todo
+	ETag string `json:"-"`
 }
 
 // GetTodo defines the interface for the GetTodo operation.
@@ -54,9 +58,8 @@ func (h *GetTodoImpl) Execute(ctx context.Context, input *GetTodoInput) (*GetTod
 
 	// Map to output
 	output := &GetTodoOutput{
-		// TODO: Map result fields to output
+		ETag: database.FormatETag(result.Version),
 	}
-	_ = result
 
 	return output, nil
 }
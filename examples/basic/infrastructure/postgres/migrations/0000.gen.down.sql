@@ -0,0 +1,2 @@
+-- drop "todo" table
+DROP TABLE "public"."todo";
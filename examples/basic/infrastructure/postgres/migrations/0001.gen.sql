@@ -0,0 +1,4 @@
+-- create "event_outbox" table
+CREATE TABLE "public"."event_outbox" ("id" text NOT NULL, "event_type" text NOT NULL, "organization_id" uuid NULL, "payload" jsonb NOT NULL, "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "published_at" timestamptz NULL, "attempts" integer NOT NULL DEFAULT 0, "last_error" text NULL, "dead_lettered_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "idx_event_outbox_pending" to table: "event_outbox"
+CREATE INDEX "idx_event_outbox_pending" ON "public"."event_outbox" ("created_at", "id") WHERE (published_at IS NULL);
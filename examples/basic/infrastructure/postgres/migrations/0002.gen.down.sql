@@ -0,0 +1,2 @@
+-- modify "todo" table
+ALTER TABLE "public"."todo" DROP COLUMN "version";
@@ -0,0 +1,2 @@
+-- modify "todo" table
+ALTER TABLE "public"."todo" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,104 @@
+table "todo" {
+  schema = schema.public
+
+  column "id" {
+    null    = false
+    type    = sql("uuid")
+    default = sql("gen_random_uuid()")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "updated_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "completed" {
+    null = false
+    type = sql("boolean")
+  }
+
+  column "title" {
+    null = false
+    type = sql("text")
+  }
+
+  column "version" {
+    null    = false
+    type    = sql("bigint")
+    default = 1
+  }
+  primary_key {
+    columns = [column.id]
+  }
+}
+
+
+table "event_outbox" {
+  schema = schema.public
+
+  column "id" {
+    null = false
+    type = sql("text")
+  }
+
+  column "event_type" {
+    null = false
+    type = sql("text")
+  }
+
+  column "organization_id" {
+    null = true
+    type = sql("uuid")
+  }
+
+  column "payload" {
+    null = false
+    type = sql("jsonb")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("timestamptz")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "published_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+
+  column "attempts" {
+    null    = false
+    type    = sql("integer")
+    default = 0
+  }
+
+  column "last_error" {
+    null = true
+    type = sql("text")
+  }
+
+  column "dead_lettered_at" {
+    null = true
+    type = sql("timestamptz")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_event_outbox_pending" {
+    columns = [column.created_at, column.id]
+    where   = "(published_at IS NULL)"
+  }
+}
+
+
+schema "public" {
+  comment = "standard public schema"
+}
\ No newline at end of file
@@ -10,10 +10,23 @@ import (
 	"github.com/google/uuid"
 )
 
+type EventOutbox struct {
+	ID             string
+	EventType      string
+	OrganizationID *uuid.UUID
+	Payload        []byte
+	CreatedAt      time.Time
+	PublishedAt    *time.Time
+	Attempts       int32
+	LastError      *string
+	DeadLetteredAt *time.Time
+}
+
 type Todo struct {
 	ID        uuid.UUID
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Completed bool
 	Title     string
+	Version   int64
 }
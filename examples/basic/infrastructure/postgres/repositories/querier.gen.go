@@ -11,9 +11,8 @@ import (
 type Querier interface {
 	CountTodos(ctx context.Context) (int64, error)
 	CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, error)
-	DeleteTodo(ctx context.Context, arg DeleteTodoParams) error
+	DeleteTodo(ctx context.Context, arg DeleteTodoParams) (int64, error)
 	GetTodo(ctx context.Context, arg GetTodoParams) (Todo, error)
-	ListTodos(ctx context.Context, arg ListTodosParams) ([]Todo, error)
 	UpdateTodo(ctx context.Context, arg UpdateTodoParams) (Todo, error)
 }
 
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/examples/basic/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,17 +17,29 @@ import (
 )
 
 // PostgresTodoRepository implements TodoRepository using PostgreSQL.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type PostgresTodoRepository struct {
+	db      *pgxpool.Pool
 	queries *Queries
 }
 
 // NewPostgresTodoRepository creates a new PostgreSQL repository.
 func NewPostgresTodoRepository(db *pgxpool.Pool) *PostgresTodoRepository {
 	return &PostgresTodoRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
 
+// queriesFor returns the queries bound to the transaction of ctx, if any.
+func (r *PostgresTodoRepository) queriesFor(ctx context.Context) *Queries {
+	if tx, ok := database.PgxTxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Todo operations
 
 // Create creates a new todo
@@ -36,10 +50,20 @@ func (r *PostgresTodoRepository) Create(ctx context.Context, entity *models.Todo
 		Title:     entity.Title,
 	}
 
-	result, err := r.queries.CreateTodo(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create todo: %w", err)
+	pending := events.Ensure(entity.Events(), models.NewTodoCreatedEvent(entity.ID))
+
+	var result Todo
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		created, err := r.queries.WithTx(tx).CreateTodo(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create todo: %w", err)
+		}
+		result = created
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapTodoFromDB(&result), nil
 }
@@ -50,7 +74,7 @@ func (r *PostgresTodoRepository) Get(ctx context.Context, id uuid.UUID) (*models
 		ID: id,
 	}
 
-	result, err := r.queries.GetTodo(ctx, params)
+	result, err := r.queriesFor(ctx).GetTodo(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
 			return nil, models.ErrTodoNotFound
@@ -68,15 +92,32 @@ func (r *PostgresTodoRepository) Update(ctx context.Context, id uuid.UUID, entit
 		ID:        id,
 		Completed: &entity.Completed,
 		Title:     &entity.Title,
+		Version:   entity.Version,
 	}
 
-	result, err := r.queries.UpdateTodo(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
-			return nil, models.ErrTodoNotFound
+	pending := events.Ensure(entity.Events(), models.NewTodoUpdatedEvent(id))
+
+	var result Todo
+	if err := database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		updated, err := r.queries.WithTx(tx).UpdateTodo(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+				return models.ErrTodoNotFound
+			}
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		result = updated
+		return events.WritePgxOutbox(ctx, tx, pending)
+	}); err != nil {
+		if errors.Is(err, models.ErrTodoNotFound) {
+			// The row exists but was updated since entity.Version was read
+			if _, getErr := r.Get(ctx, id); getErr == nil {
+				return nil, database.ErrVersionConflict
+			}
 		}
-		return nil, fmt.Errorf("failed to update todo: %w", err)
+		return nil, err
 	}
+	entity.ClearEvents()
 
 	return mapTodoFromDB(&result), nil
 }
@@ -87,26 +128,38 @@ func (r *PostgresTodoRepository) Delete(ctx context.Context, id uuid.UUID) error
 		ID: id,
 	}
 
-	err := r.queries.DeleteTodo(ctx, params)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) || err == sql.ErrNoRows {
+	return database.RunPgxTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := r.queries.WithTx(tx).DeleteTodo(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to delete todo: %w", err)
+		}
+		if n == 0 {
 			return models.ErrTodoNotFound
 		}
-		return fmt.Errorf("failed to delete todo: %w", err)
-	}
-	return nil
+		return events.WritePgxOutbox(ctx, tx, []events.Event{models.NewTodoDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of todos
-func (r *PostgresTodoRepository) List(ctx context.Context, limit, offset int32) ([]*models.Todo, int64, error) {
-	listParams := ListTodosParams{
-		Limit:  limit,
-		Offset: offset,
+// List returns a filtered, sorted and paginated list of todos
+func (r *PostgresTodoRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Todo, database.PageInfo, error) {
+	query, err := database.BuildListQuery(database.TypePostgreSQL, "todo", todoColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
 	}
 
-	results, err := r.queries.ListTodos(ctx, listParams)
+	conn := database.PgxConn(ctx, r.db)
+	rows, err := conn.Query(ctx, query.SQL, query.Args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list todos: %w", err)
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list todos: %w", err)
+	}
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Todo])
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list todos: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count todos: %w", err)
 	}
 
 	items := make([]*models.Todo, len(results))
@@ -114,11 +167,16 @@ func (r *PostgresTodoRepository) List(ctx context.Context, limit, offset int32)
 		items[i] = mapTodoFromDB(&result)
 	}
 
-	// For now, return the count as the length of results
-	// In production, you'd want a separate count query
-	count := int64(len(results))
+	return items, database.PageInfo{Total: total}, nil
+}
 
-	return items, count, nil
+// todoColumns maps Todo fields to the columns List can filter and sort on.
+var todoColumns = database.Columns{
+	"id":        {Name: "id", Kind: database.ColumnUUID},
+	"createdAt": {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt": {Name: "updated_at", Kind: database.ColumnTime},
+	"completed": {Name: "completed", Kind: database.ColumnBool},
+	"title":     {Name: "title", Kind: database.ColumnString},
 }
 
 func mapTodoFromDB(db *Todo) *models.Todo {
@@ -132,6 +190,7 @@ func mapTodoFromDB(db *Todo) *models.Todo {
 		UpdatedAt: db.UpdatedAt,
 		Completed: db.Completed,
 		Title:     db.Title,
+		Version:   db.Version,
 	}
 
 	return result
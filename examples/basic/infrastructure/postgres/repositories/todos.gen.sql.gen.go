@@ -35,7 +35,7 @@ VALUES
     $3
   )
 RETURNING
-  id, created_at, updated_at, completed, title
+  id, created_at, updated_at, completed, title, version
 `
 
 type CreateTodoParams struct {
@@ -53,11 +53,12 @@ func (q *Queries) CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, e
 		&i.UpdatedAt,
 		&i.Completed,
 		&i.Title,
+		&i.Version,
 	)
 	return i, err
 }
 
-const deleteTodo = `-- name: DeleteTodo :exec
+const deleteTodo = `-- name: DeleteTodo :execrows
 DELETE FROM todo
 WHERE
   id = $1
@@ -67,14 +68,17 @@ type DeleteTodoParams struct {
 	ID uuid.UUID
 }
 
-func (q *Queries) DeleteTodo(ctx context.Context, arg DeleteTodoParams) error {
-	_, err := q.db.Exec(ctx, deleteTodo, arg.ID)
-	return err
+func (q *Queries) DeleteTodo(ctx context.Context, arg DeleteTodoParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTodo, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getTodo = `-- name: GetTodo :one
 SELECT
-  id, created_at, updated_at, completed, title
+  id, created_at, updated_at, completed, title, version
 FROM
   todo
 WHERE
@@ -96,73 +100,38 @@ func (q *Queries) GetTodo(ctx context.Context, arg GetTodoParams) (Todo, error)
 		&i.UpdatedAt,
 		&i.Completed,
 		&i.Title,
+		&i.Version,
 	)
 	return i, err
 }
 
-const listTodos = `-- name: ListTodos :many
-SELECT
-  id, created_at, updated_at, completed, title
-FROM
-  todo
-ORDER BY
-  created_at DESC
-LIMIT
-  $2
-OFFSET
-  $1
-`
-
-type ListTodosParams struct {
-	Offset int32
-	Limit  int32
-}
-
-func (q *Queries) ListTodos(ctx context.Context, arg ListTodosParams) ([]Todo, error) {
-	rows, err := q.db.Query(ctx, listTodos, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Todo
-	for rows.Next() {
-		var i Todo
-		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.Completed,
-			&i.Title,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const updateTodo = `-- name: UpdateTodo :one
 UPDATE todo
 SET
   completed = COALESCE($1, completed),
-  title = COALESCE($2, title)
+  title = COALESCE($2, title),
+  version = version + 1
 WHERE
   id = $3
+  AND version = $4
 RETURNING
-  id, created_at, updated_at, completed, title
+  id, created_at, updated_at, completed, title, version
 `
 
 type UpdateTodoParams struct {
 	Completed *bool
 	Title     *string
 	ID        uuid.UUID
+	Version   int64
 }
 
 func (q *Queries) UpdateTodo(ctx context.Context, arg UpdateTodoParams) (Todo, error) {
-	row := q.db.QueryRow(ctx, updateTodo, arg.Completed, arg.Title, arg.ID)
+	row := q.db.QueryRow(ctx, updateTodo,
+		arg.Completed,
+		arg.Title,
+		arg.ID,
+		arg.Version,
+	)
 	var i Todo
 	err := row.Scan(
 		&i.ID,
@@ -170,6 +139,7 @@ func (q *Queries) UpdateTodo(ctx context.Context, arg UpdateTodoParams) (Todo, e
 		&i.UpdatedAt,
 		&i.Completed,
 		&i.Title,
+		&i.Version,
 	)
 	return i, err
 }
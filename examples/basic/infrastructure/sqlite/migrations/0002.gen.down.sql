@@ -0,0 +1,12 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_todo" table
+CREATE TABLE `new_todo` (`id` text NOT NULL DEFAULT (lower(hex(randomblob(16)))), `created_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `updated_at` text NOT NULL DEFAULT (CURRENT_TIMESTAMP), `completed` integer NOT NULL, `title` text NOT NULL, PRIMARY KEY (`id`));
+-- copy rows from old table "todo" to new temporary table "new_todo"
+INSERT INTO `new_todo` (`id`, `created_at`, `updated_at`, `completed`, `title`) SELECT `id`, `created_at`, `updated_at`, `completed`, `title` FROM `todo`;
+-- drop "todo" table after copying rows
+DROP TABLE `todo`;
+-- rename temporary table "new_todo" to "todo"
+ALTER TABLE `new_todo` RENAME TO `todo`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
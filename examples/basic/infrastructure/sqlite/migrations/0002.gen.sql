@@ -0,0 +1,2 @@
+-- add column "version" to table: "todo"
+ALTER TABLE `todo` ADD COLUMN `version` integer NOT NULL DEFAULT 1;
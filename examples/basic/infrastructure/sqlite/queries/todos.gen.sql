@@ -22,18 +22,6 @@ WHERE
 LIMIT
   1;
 
--- name: ListTodos :many
-SELECT
-  *
-FROM
-  todo
-ORDER BY
-  created_at DESC
-LIMIT
-  sqlc.arg('limit')
-OFFSET
-  sqlc.arg('offset');
-
 -- name: CountTodos :one
 SELECT
   COUNT(*)
@@ -44,13 +32,15 @@ FROM
 UPDATE todo
 SET
   completed = COALESCE(sqlc.narg('completed'), completed),
-  title = COALESCE(sqlc.narg('title'), title)
+  title = COALESCE(sqlc.narg('title'), title),
+  version = version + 1
 WHERE
   id = sqlc.arg('id')
+  AND version = sqlc.arg('version')
 RETURNING
   *;
 
--- name: DeleteTodo :exec
+-- name: DeleteTodo :execrows
 DELETE FROM todo
 WHERE
   id = sqlc.arg('id');
\ No newline at end of file
@@ -5,20 +5,27 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/examples/basic/models"
 	"github.com/google/uuid"
 )
 
 // SQLiteTodoRepository implements TodoRepository using SQLite.
+// Writes record their events in the outbox within the same transaction, and
+// all queries run in the transaction of the context when it carries one.
 type SQLiteTodoRepository struct {
+	db      *sql.DB
 	queries *Queries
 }
 
 // NewSQLiteTodoRepository creates a new SQLite repository.
 func NewSQLiteTodoRepository(db *sql.DB) *SQLiteTodoRepository {
 	return &SQLiteTodoRepository{
+		db:      db,
 		queries: New(db),
 	}
 }
@@ -26,36 +33,171 @@ func NewSQLiteTodoRepository(db *sql.DB) *SQLiteTodoRepository {
 // Todo operations
 
 // Create creates a new todo
-func (r *SQLiteTodoRepository) Create(ctx context.Context, todo *models.Todo) (*models.Todo, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per todo
-	return nil, fmt.Errorf("CreateTodo not yet implemented - requires custom mapping")
+func (r *SQLiteTodoRepository) Create(ctx context.Context, entity *models.Todo) (*models.Todo, error) {
+	pending := events.Ensure(entity.Events(), models.NewTodoCreatedEvent(entity.ID))
+
+	var result *models.Todo
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		now := database.SQLiteNow()
+		row := tx.QueryRowContext(ctx,
+			`INSERT INTO "todo" (id, created_at, updated_at, completed, title)
+			VALUES (?, ?, ?, ?, ?)
+			RETURNING id, created_at, updated_at, completed, title, version`,
+			entity.ID, now, now,
+			entity.Completed,
+			entity.Title,
+		)
+
+		created, err := scanTodo(row)
+		if err != nil {
+			return fmt.Errorf("failed to create todo: %w", err)
+		}
+		result = created
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Get retrieves a todo by ID
 func (r *SQLiteTodoRepository) Get(ctx context.Context, id uuid.UUID) (*models.Todo, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per todo
-	return nil, fmt.Errorf("GetTodo not yet implemented - requires custom mapping")
+	row := database.SQLConn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, created_at, updated_at, completed, title, version FROM "todo" WHERE id = ?`,
+		id.String(),
+	)
+
+	result, err := scanTodo(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrTodoNotFound
+		}
+		return nil, fmt.Errorf("failed to get todo: %w", err)
+	}
+	return result, nil
 }
 
-// Update updates an existing todo
-func (r *SQLiteTodoRepository) Update(ctx context.Context, id uuid.UUID, todo *models.Todo) (*models.Todo, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per todo
-	return nil, fmt.Errorf("UpdateTodo not yet implemented - requires custom mapping")
+// Update updates an existing todo. Fields that are nil are left unchanged.
+func (r *SQLiteTodoRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Todo) (*models.Todo, error) {
+	pending := events.Ensure(entity.Events(), models.NewTodoUpdatedEvent(id))
+
+	var result *models.Todo
+	if err := database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx,
+			`UPDATE "todo"
+			SET completed = COALESCE(?, completed), title = COALESCE(?, title), version = version + 1
+			WHERE id = ? AND version = ?
+			RETURNING id, created_at, updated_at, completed, title, version`,
+			entity.Completed,
+			entity.Title,
+			id.String(),
+			entity.Version,
+		)
+
+		updated, err := scanTodo(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrTodoNotFound
+			}
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		result = updated
+		return events.WriteSQLOutbox(ctx, tx, pending)
+	}); err != nil {
+		if errors.Is(err, models.ErrTodoNotFound) {
+			// The row exists but was updated since entity.Version was read
+			if _, getErr := r.Get(ctx, id); getErr == nil {
+				return nil, database.ErrVersionConflict
+			}
+		}
+		return nil, err
+	}
+	entity.ClearEvents()
+	return result, nil
 }
 
 // Delete removes a todo
 func (r *SQLiteTodoRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per todo
-	return fmt.Errorf("DeleteTodo not yet implemented - requires custom mapping")
+	return database.RunSQLTx(ctx, r.db, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM "todo" WHERE id = ?`,
+			id.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete todo: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete todo: %w", err)
+		}
+		if n == 0 {
+			return models.ErrTodoNotFound
+		}
+		return events.WriteSQLOutbox(ctx, tx, []events.Event{models.NewTodoDeletedEvent(id)})
+	})
 }
 
-// List returns a paginated list of todos
-func (r *SQLiteTodoRepository) List(ctx context.Context, limit, offset int32) ([]*models.Todo, int64, error) {
-	// For now, return a basic implementation
-	// Actual implementation would need to be customized per todo
-	return nil, 0, fmt.Errorf("ListTodos not yet implemented - requires custom mapping")
+// List returns a filtered, sorted and paginated list of todos
+func (r *SQLiteTodoRepository) List(ctx context.Context, opts database.ListOptions) ([]*models.Todo, database.PageInfo, error) {
+	opts.Select = todoSelectColumns
+	query, err := database.BuildListQuery(database.TypeSQLite, "todo", todoColumns, opts)
+	if err != nil {
+		return nil, database.PageInfo{}, err
+	}
+
+	conn := database.SQLConn(ctx, r.db)
+	rows, err := conn.QueryContext(ctx, query.SQL, query.Args...)
+	if err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list todos: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*models.Todo
+	for rows.Next() {
+		item, err := scanTodo(rows)
+		if err != nil {
+			return nil, database.PageInfo{}, fmt.Errorf("failed to list todos: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to list todos: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, query.CountSQL, query.CountArgs...).Scan(&total); err != nil {
+		return nil, database.PageInfo{}, fmt.Errorf("failed to count todos: %w", err)
+	}
+
+	return items, database.PageInfo{Total: total}, nil
+}
+
+// todoSelectColumns are the columns scanTodo reads, in order.
+var todoSelectColumns = []string{"id", "created_at", "updated_at", "completed", "title", "version"}
+
+// todoColumns maps Todo fields to the columns List can filter and sort on.
+var todoColumns = database.Columns{
+	"id":        {Name: "id", Kind: database.ColumnUUID},
+	"createdAt": {Name: "created_at", Kind: database.ColumnTime},
+	"updatedAt": {Name: "updated_at", Kind: database.ColumnTime},
+	"completed": {Name: "completed", Kind: database.ColumnBool},
+	"title":     {Name: "title", Kind: database.ColumnString},
+}
+
+// scanTodo reads a todo row of todoSelectColumns. Selecting the
+// columns by name keeps it independent of their order in the table.
+func scanTodo(row interface{ Scan(dest ...any) error }) (*models.Todo, error) {
+	var entity models.Todo
+	if err := row.Scan(
+		&entity.ID,
+		database.SQLiteTime(&entity.CreatedAt),
+		database.SQLiteTime(&entity.UpdatedAt),
+		&entity.Completed,
+		&entity.Title,
+		&entity.Version,
+	); err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
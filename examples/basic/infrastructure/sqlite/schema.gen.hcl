@@ -28,11 +28,76 @@ table "todo" {
     null = false
     type = sql("TEXT")
   }
+
+  column "version" {
+    null    = false
+    type    = sql("INTEGER")
+    default = 1
+  }
   primary_key {
     columns = [column.id]
   }
 }
 
 
+table "event_outbox" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "event_type" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "organization_id" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "payload" {
+    null = false
+    type = sql("TEXT")
+  }
+
+  column "created_at" {
+    null    = false
+    type    = sql("TEXT")
+    default = sql("CURRENT_TIMESTAMP")
+  }
+
+  column "published_at" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "attempts" {
+    null    = false
+    type    = sql("INTEGER")
+    default = 0
+  }
+
+  column "last_error" {
+    null = true
+    type = sql("TEXT")
+  }
+
+  column "dead_lettered_at" {
+    null = true
+    type = sql("TEXT")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_event_outbox_pending" {
+    columns = [column.created_at, column.id]
+    where   = "(published_at IS NULL)"
+  }
+}
+
+
 schema "main" {
 }
\ No newline at end of file
@@ -0,0 +1,336 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/examples/basic/models"
+	"github.com/archesai/examples/basic/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.TodoRepository = (*TodoRepository)(nil)
+
+// TodoRepository is a mock of repositories.TodoRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type TodoRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Todo) (*models.Todo, error)
+
+	createCalls   []TodoRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Todo) (*models.Todo, error)
+	createReturns func(context.Context, *models.Todo) (*models.Todo, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Todo, error)
+
+	getCalls   []TodoRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Todo, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Todo, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Todo) (*models.Todo, error)
+
+	updateCalls   []TodoRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Todo) (*models.Todo, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Todo) (*models.Todo, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []TodoRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Todo, database.PageInfo, error)
+
+	listCalls   []TodoRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Todo, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Todo, database.PageInfo, error)
+}
+
+// TodoRepositoryCreateCall holds the arguments of a call to Create.
+type TodoRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Todo
+}
+
+// Create records the call and returns the configured result.
+func (m *TodoRepository) Create(ctx context.Context, entity *models.Todo) (r0 *models.Todo, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, TodoRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *TodoRepository) CreateReturns(r0 *models.Todo, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Todo) (*models.Todo, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *TodoRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Todo) (*models.Todo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Todo) (*models.Todo, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *TodoRepository) CreateCalls() []TodoRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// TodoRepositoryGetCall holds the arguments of a call to Get.
+type TodoRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *TodoRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Todo, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, TodoRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *TodoRepository) GetReturns(r0 *models.Todo, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Todo, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *TodoRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Todo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Todo, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *TodoRepository) GetCalls() []TodoRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// TodoRepositoryUpdateCall holds the arguments of a call to Update.
+type TodoRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Todo
+}
+
+// Update records the call and returns the configured result.
+func (m *TodoRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Todo) (r0 *models.Todo, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, TodoRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *TodoRepository) UpdateReturns(r0 *models.Todo, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Todo) (*models.Todo, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *TodoRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Todo) (*models.Todo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Todo) (*models.Todo, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *TodoRepository) UpdateCalls() []TodoRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// TodoRepositoryDeleteCall holds the arguments of a call to Delete.
+type TodoRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *TodoRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, TodoRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *TodoRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *TodoRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *TodoRepository) DeleteCalls() []TodoRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// TodoRepositoryListCall holds the arguments of a call to List.
+type TodoRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *TodoRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Todo, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, TodoRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *TodoRepository) ListReturns(r0 []*models.Todo, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Todo, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *TodoRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Todo, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Todo, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *TodoRepository) ListCalls() []TodoRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
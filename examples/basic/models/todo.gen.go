@@ -29,6 +29,9 @@ type Todo struct {
 	Completed bool      `json:"completed" yaml:"completed"`
 	Title     string    `json:"title" yaml:"title"`
 
+	// Version is the row version read from the repository, exposed to clients as an ETag
+	Version int64 `json:"-" yaml:"-"`
+
 	events []events.Event `json:"-" yaml:"-"`
 }
 
@@ -5,6 +5,7 @@ package repositories
 import (
 	"context"
 
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/examples/basic/models"
 	"github.com/google/uuid"
 )
@@ -16,5 +17,5 @@ type TodoRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Todo, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Todo) (*models.Todo, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Todo, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Todo, database.PageInfo, error)
 }
@@ -59,10 +59,8 @@ func (h *GetTodoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Execute
 	result, err := h.getTodo.Execute(ctx, input)
 	if err != nil {
-		errorResp := GetTodo500Response{
-			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
-		}
-		if err := errorResp.VisitGetTodoResponse(w); err != nil {
+		problem := server.NewInternalServerErrorResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
@@ -71,6 +69,9 @@ func (h *GetTodoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Map output to response
 	response := GetTodo200Response{}
 	response.Data = result.Data
+	if result.ETag != "" {
+		w.Header().Set("ETag", result.ETag)
+	}
 
 	if err := response.VisitGetTodoResponse(w); err != nil {
 		fmt.Fprintf(w, "error writing response: %v", err)
@@ -0,0 +1,103 @@
+package codegen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/archesai/archesai/pkg/storage"
+)
+
+// FileDiff is a generated file whose content differs from the file on disk.
+type FileDiff struct {
+	Path string
+	// New is true when the file does not exist on disk yet.
+	New bool
+	// Deleted is true when the file is an orphan that generation removes.
+	Deleted bool
+	// Unified is the unified diff from the disk content to the generated content.
+	Unified string
+}
+
+// DiffStorage compares every file rendered into generated against the same
+// path in disk and returns the files that would change, followed by the
+// removal of each orphan.
+func DiffStorage(
+	generated *storage.MemoryStorage,
+	disk storage.Storage,
+	orphans []string,
+) ([]FileDiff, error) {
+	files := generated.GetFiles()
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var diffs []FileDiff
+	for _, path := range paths {
+		want := files[path]
+		have, err := disk.ReadFile(path)
+		isNew := errors.Is(err, fs.ErrNotExist)
+		if err != nil && !isNew {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if !isNew && bytes.Equal(have, want) {
+			continue
+		}
+
+		from := "a/" + path
+		if isNew {
+			from = "/dev/null"
+		}
+		unified, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(have),
+			B:        splitLines(want),
+			FromFile: from,
+			ToFile:   "b/" + path,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff %s: %w", path, err)
+		}
+		diffs = append(diffs, FileDiff{Path: path, New: isNew, Unified: unified})
+	}
+
+	for _, path := range orphans {
+		have, err := disk.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		unified, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(have),
+			FromFile: "a/" + path,
+			ToFile:   "/dev/null",
+			Context:  3,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff %s: %w", path, err)
+		}
+		diffs = append(diffs, FileDiff{Path: path, Deleted: true, Unified: unified})
+	}
+	return diffs, nil
+}
+
+// splitLines splits data into newline-terminated lines. Unlike
+// difflib.SplitLines it does not add an empty line after a final newline.
+func splitLines(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	if last := len(lines) - 1; lines[last] == "" {
+		lines = lines[:last]
+	} else {
+		lines[last] += "\n"
+	}
+	return lines
+}
@@ -0,0 +1,148 @@
+package codegen
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/storage"
+)
+
+// newDiffFixture writes the files on disk and renders the generated files
+// into memory. The disk holds a current, a stale and an orphaned file; the
+// generated files add one that does not exist yet.
+func newDiffFixture(t *testing.T) (string, *storage.MemoryStorage) {
+	t.Helper()
+	dir := t.TempDir()
+	disk := map[string]string{
+		"current.go":        "package app\n",
+		"models/stale.go":   "package models\n\nconst A = 1\n",
+		"models/orphan.go":  "package models\n",
+		"hand/written.impl": "kept\n",
+	}
+	for path, content := range disk {
+		full := filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+		require.NoError(t, os.WriteFile(full, []byte(content), 0644))
+	}
+
+	generated := storage.NewMemoryStorageWithBaseDir(dir)
+	for path, content := range map[string]string{
+		"current.go":      "package app\n",
+		"models/stale.go": "package models\n\nconst A = 2\n",
+		"models/new.go":   "package models\n",
+	} {
+		require.NoError(t, generated.WriteFile(path, []byte(content), 0644))
+	}
+	return dir, generated
+}
+
+func TestDiffStorage(t *testing.T) {
+	dir, generated := newDiffFixture(t)
+
+	diffs, err := DiffStorage(generated, storage.NewDiskStorage(dir), []string{"models/orphan.go"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		path    string
+		new     bool
+		deleted bool
+		unified string
+	}{
+		{
+			path: "models/new.go",
+			new:  true,
+			unified: "--- /dev/null\n+++ b/models/new.go\n" +
+				"@@ -0,0 +1 @@\n+package models\n",
+		},
+		{
+			path: "models/stale.go",
+			unified: "--- a/models/stale.go\n+++ b/models/stale.go\n" +
+				"@@ -1,3 +1,3 @@\n package models\n \n-const A = 1\n+const A = 2\n",
+		},
+		{
+			// Orphans follow the generated files
+			path:    "models/orphan.go",
+			deleted: true,
+			unified: "--- a/models/orphan.go\n+++ /dev/null\n" +
+				"@@ -1 +0,0 @@\n-package models\n",
+		},
+	}
+
+	require.Len(t, diffs, len(tests))
+	for i, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.path, diffs[i].Path)
+			assert.Equal(t, tt.new, diffs[i].New)
+			assert.Equal(t, tt.deleted, diffs[i].Deleted)
+			assert.Equal(t, tt.unified, diffs[i].Unified)
+		})
+	}
+
+	t.Run("missing orphan", func(t *testing.T) {
+		_, err := DiffStorage(generated, storage.NewDiskStorage(dir), []string{"models/gone.go"})
+		assert.ErrorContains(t, err, "failed to read models/gone.go")
+	})
+}
+
+func TestReportDryRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		current bool
+		want    string
+		wantErr string
+	}{
+		{
+			name: "dry run prints the diff",
+			opts: Options{DryRun: true},
+			want: "--- /dev/null\n+++ b/models/new.go\n",
+		},
+		{
+			name:    "check lists stale files",
+			opts:    Options{Check: true},
+			want:    "new      models/new.go\nmodified models/stale.go\ndeleted  models/orphan.go\n",
+			wantErr: "3 generated file(s) out of date",
+		},
+		{
+			name:    "check passes when current",
+			opts:    Options{Check: true},
+			current: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, generated := newDiffFixture(t)
+			orphans := []string{"models/orphan.go"}
+			if tt.current {
+				generated = storage.NewMemoryStorageWithBaseDir(dir)
+				require.NoError(t, generated.WriteFile("current.go", []byte("package app\n"), 0644))
+				orphans = nil
+			}
+			tt.opts.OutputPath = dir
+
+			var out bytes.Buffer
+			err := reportDryRun(&out, tt.opts, generated, orphans)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.opts.DryRun {
+				assert.Contains(t, out.String(), tt.want)
+			} else {
+				assert.Equal(t, tt.want, out.String())
+			}
+		})
+	}
+
+	t.Run("requires memory storage", func(t *testing.T) {
+		err := reportDryRun(&bytes.Buffer{}, Options{Check: true}, storage.NewDiskStorage(t.TempDir()), nil)
+		assert.ErrorContains(t, err, "dry run requires memory storage")
+	})
+}
@@ -4,11 +4,21 @@ package generators
 import (
 	"fmt"
 	"path/filepath"
+	"slices"
 )
 
 // AppTemplateData holds the data for rendering the app bootstrap template.
 type AppTemplateData struct {
 	ProjectName string
+	// Webhooks is set when the app composes the webhooks package, whose
+	// dispatcher runs alongside the server.
+	Webhooks bool
+	// Auth is set when the app composes the auth package, whose middleware
+	// authenticates requests.
+	Auth bool
+	// Purge is set when the spec has entities with soft delete, whose
+	// deleted rows a job purges after the retention.
+	Purge bool
 }
 
 // AppGenerator generates the app bootstrap code.
@@ -30,6 +40,9 @@ func (g *AppGenerator) Generate(ctx *GeneratorContext) error {
 
 	data := &AppTemplateData{
 		ProjectName: ctx.ProjectName,
+		Webhooks:    slices.Contains(composedPkgs, "webhooks"),
+		Auth:        slices.Contains(composedPkgs, "auth"),
+		Purge:       len(softDeleteEntities(ctx)) > 0,
 	}
 
 	outputPath := filepath.Join("bootstrap", "app.gen.go")
@@ -10,10 +10,9 @@ import (
 
 // BootstrapHandlersTemplateData holds the data for rendering the handlers bootstrap template.
 type BootstrapHandlersTemplateData struct {
-	Operations     []spec.Operation
-	Repositories   []string
-	ProjectName    string
-	NeedsPublisher bool
+	Operations   []spec.Operation
+	Repositories []string
+	ProjectName  string
 }
 
 // BootstrapHandlersGenerator generates handler initialization code.
@@ -37,7 +36,22 @@ func (g *BootstrapHandlersGenerator) Generate(ctx *GeneratorContext) error {
 		return operations[i].ID < operations[j].ID
 	})
 
-	// Collect unique repositories (skip custom handlers which don't need repos)
+	data := &BootstrapHandlersTemplateData{
+		Operations:   operations,
+		Repositories: operationRepositories(operations),
+		ProjectName:  ctx.ProjectName,
+	}
+
+	outputPath := filepath.Join("bootstrap", "handlers.gen.go")
+	if err := ctx.RenderToFile("handlers.go.tmpl", outputPath, data); err != nil {
+		return fmt.Errorf("failed to generate bootstrap handlers: %w", err)
+	}
+	return nil
+}
+
+// operationRepositories returns the sorted repositories the handlers of
+// operations are created with. Custom handlers need none.
+func operationRepositories(operations []spec.Operation) []string {
 	repoMap := make(map[string]bool)
 	for _, op := range operations {
 		if op.XCodegenCustomHandler {
@@ -51,26 +65,5 @@ func (g *BootstrapHandlersGenerator) Generate(ctx *GeneratorContext) error {
 		repositories = append(repositories, repo)
 	}
 	sort.Strings(repositories)
-
-	// Check if we need a publisher (any non-GET, non-custom operations)
-	needsPublisher := false
-	for _, op := range operations {
-		if !op.XCodegenCustomHandler && op.Method != "GET" {
-			needsPublisher = true
-			break
-		}
-	}
-
-	data := &BootstrapHandlersTemplateData{
-		Operations:     operations,
-		Repositories:   repositories,
-		ProjectName:    ctx.ProjectName,
-		NeedsPublisher: needsPublisher,
-	}
-
-	outputPath := filepath.Join("bootstrap", "handlers.gen.go")
-	if err := ctx.RenderToFile("handlers.go.tmpl", outputPath, data); err != nil {
-		return fmt.Errorf("failed to generate bootstrap handlers: %w", err)
-	}
-	return nil
+	return repositories
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/archesai/archesai/pkg/executor"
+	"github.com/archesai/archesai/pkg/storage"
 )
 
 // OrvalInput is the input structure for the Orval TypeScript client generator.
@@ -31,6 +32,11 @@ func (g *ClientGenerator) Priority() int { return PriorityNormal }
 
 // Generate creates TypeScript API client code from the OpenAPI spec.
 func (g *ClientGenerator) Generate(ctx *GeneratorContext) error {
+	// Skip client generation for memory storage, Orval writes to disk
+	if _, isMemory := ctx.Storage.(*storage.MemoryStorage); isMemory {
+		return nil
+	}
+
 	specContent, err := os.ReadFile(ctx.SpecPath)
 	if err != nil {
 		return fmt.Errorf("failed to read OpenAPI spec: %w", err)
@@ -4,12 +4,21 @@ import (
 	"fmt"
 	"path/filepath"
 	"sort"
+
+	"github.com/archesai/archesai/internal/spec"
 )
 
 // ContainerTemplateData holds the data for rendering the container template.
 type ContainerTemplateData struct {
 	InternalPackages []InternalPackage
 	ProjectName      string
+	// Operations are the app's own operations, served next to the composed
+	// packages with the Repositories they need.
+	Operations   []spec.Operation
+	Repositories []string
+	// SoftDeleteEntities are the entities whose deleted rows the purge job
+	// removes.
+	SoftDeleteEntities []string
 }
 
 // ContainerGenerator generates dependency injection container code.
@@ -24,26 +33,22 @@ func (g *ContainerGenerator) Priority() int { return PriorityNormal }
 // Generate creates the container code for the application.
 func (g *ContainerGenerator) Generate(ctx *GeneratorContext) error {
 	// Only generate for composition apps (apps that compose internal packages)
-	composedPkgs := ctx.ComposedPackages()
-	if len(composedPkgs) == 0 {
+	internalPackages := composedInternalPackages(ctx)
+	if len(internalPackages) == 0 || ctx.IsInternalPackage() {
 		return nil
 	}
 
-	var internalPackages []InternalPackage
-	for _, pkgName := range composedPkgs {
-		internalPackages = append(internalPackages, InternalPackage{
-			Name:       pkgName,
-			Alias:      pkgName,
-			ImportPath: InternalPackageImportPath(pkgName),
-		})
-	}
-	sort.Slice(internalPackages, func(i, j int) bool {
-		return internalPackages[i].Name < internalPackages[j].Name
+	operations := ctx.OwnOperations()
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].ID < operations[j].ID
 	})
 
 	data := &ContainerTemplateData{
-		InternalPackages: internalPackages,
-		ProjectName:      ctx.ProjectName,
+		InternalPackages:   internalPackages,
+		ProjectName:        ctx.ProjectName,
+		Operations:         operations,
+		Repositories:       operationRepositories(operations),
+		SoftDeleteEntities: softDeleteEntities(ctx),
 	}
 
 	outputPath := filepath.Join("bootstrap", "container.gen.go")
@@ -52,3 +57,15 @@ func (g *ContainerGenerator) Generate(ctx *GeneratorContext) error {
 	}
 	return nil
 }
+
+// softDeleteEntities returns the sorted names of the entities with soft delete.
+func softDeleteEntities(ctx *GeneratorContext) []string {
+	var names []string
+	for _, schema := range ctx.Spec.Schemas {
+		if schema.XCodegenSchemaType == spec.XCodegenSchemaTypeEntity && schema.UsesSoftDelete() {
+			names = append(names, schema.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
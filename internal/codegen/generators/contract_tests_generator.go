@@ -0,0 +1,311 @@
+package generators
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/archesai/archesai/internal/spec"
+	"github.com/archesai/archesai/internal/strutil"
+)
+
+// ContractTestsTemplateData holds the data for rendering the repository
+// contract tests of one entity.
+type ContractTestsTemplateData struct {
+	RepositoriesTemplateData
+	Fields      []ContractField
+	UpdateField *ContractField
+	Methods     []ContractMethod
+	// Skip explains why the entity cannot be created in tests, if it can't.
+	Skip string
+}
+
+// ContractField is a field of an entity set by the test fixture.
+type ContractField struct {
+	Name        string // Go field name
+	Value       string // Go expression of the fixture value
+	UpdateValue string // Go expression of the value set by the update case
+}
+
+// ContractMethod is an additional repository method called with the values
+// of a fixture named entity.
+type ContractMethod struct {
+	Name     string
+	Args     string
+	Multiple bool
+}
+
+// ContractTestsSetupTemplateData holds the data for rendering the shared
+// setup of the contract tests.
+type ContractTestsSetupTemplateData struct {
+	ProjectName string
+	// Tenant is the Go expression creating the tenant of tenant-scoped
+	// entities, or empty if the spec has none.
+	Tenant string
+}
+
+// ContractTestsGenerator generates table-driven tests that run the same
+// contract against the SQLite and PostgreSQL repositories of every entity.
+type ContractTestsGenerator struct{}
+
+// Name returns the generator name.
+func (g *ContractTestsGenerator) Name() string { return "contract_tests" }
+
+// Priority returns the generator priority.
+func (g *ContractTestsGenerator) Priority() int { return PriorityNormal }
+
+// Generate creates a contract test per entity and the setup they share.
+func (g *ContractTestsGenerator) Generate(ctx *GeneratorContext) error {
+	entities := make(map[string]*spec.Schema)
+	for _, schema := range ctx.Spec.Schemas {
+		if schema.XCodegenSchemaType == spec.XCodegenSchemaTypeEntity {
+			entities[strutil.SnakeCase(schema.Name)] = schema
+		}
+	}
+	if len(entities) == 0 {
+		return nil
+	}
+
+	tenant, err := contractTenant(ctx.Spec.Schemas, entities)
+	if err != nil {
+		return err
+	}
+	setup := &ContractTestsSetupTemplateData{ProjectName: ctx.ProjectName, Tenant: tenant}
+	setupPath := filepath.Join("infrastructure", "contract", "contract.gen_test.go")
+	if err := ctx.RenderToFile("contract_setup_test.go.tmpl", setupPath, setup); err != nil {
+		return fmt.Errorf("failed to generate contract test setup: %w", err)
+	}
+
+	for _, schema := range ctx.Spec.Schemas {
+		if schema.XCodegenSchemaType != spec.XCodegenSchemaTypeEntity {
+			continue
+		}
+
+		modelImportPath, repositoryInterface := getRepositoryImportPaths(ctx, schema)
+		data := &ContractTestsTemplateData{
+			RepositoriesTemplateData: RepositoriesTemplateData{
+				Entity:              schema,
+				ProjectName:         ctx.ProjectName,
+				ModelImportPath:     modelImportPath,
+				RepositoryInterface: repositoryInterface,
+			},
+		}
+		data.Fields, data.Skip, err = contractFields(schema, entities)
+		if err != nil {
+			return err
+		}
+		for i := range data.Fields {
+			if data.Fields[i].UpdateValue != "" {
+				data.UpdateField = &data.Fields[i]
+				break
+			}
+		}
+		data.Methods = contractMethods(schema, data.Fields)
+
+		outputPath := filepath.Join(
+			"infrastructure",
+			"contract",
+			strings.ToLower(schema.Name)+"_repository.gen_test.go",
+		)
+		if err := ctx.RenderToFile("contract_test.go.tmpl", outputPath, data); err != nil {
+			return fmt.Errorf("failed to generate contract test for %s: %w", schema.Name, err)
+		}
+	}
+	return nil
+}
+
+// contractTenant returns the Go expression creating the tenant of the
+// tenant-scoped entities. The tenant is a row of the entity referenced by the
+// tenant field, or a random ID if the field is not a foreign key.
+func contractTenant(schemas []*spec.Schema, entities map[string]*spec.Schema) (string, error) {
+	for _, schema := range schemas {
+		if schema.XCodegenSchemaType != spec.XCodegenSchemaTypeEntity {
+			continue
+		}
+		tenant, err := schema.GetTenantField()
+		if err != nil {
+			return "", fmt.Errorf("failed to get tenant field of %s: %w", schema.Name, err)
+		}
+		if tenant == nil {
+			continue
+		}
+		for _, rel := range schema.GetForeignKeyRelations() {
+			if strutil.PascalCase(rel.Field) != tenant.Name {
+				continue
+			}
+			parent, ok := entities[rel.References]
+			if !ok {
+				break
+			}
+			column := "ID"
+			if rel.ReferencesField != nil {
+				column = strutil.PascalCase(*rel.ReferencesField)
+			}
+			return fmt.Sprintf("create%s(t, s).%s", parent.Name, column), nil
+		}
+		return "uuid.New()", nil
+	}
+	return "", nil
+}
+
+// contractFields returns the fixture fields of an entity, which are those
+// written by Create. Foreign keys are set to rows created by the fixture of
+// the referenced entity, and the tenant field to the tenant of the store. The
+// returned reason is set when a required reference cannot be created.
+func contractFields(entity *spec.Schema, entities map[string]*spec.Schema) ([]ContractField, string, error) {
+	tenant, err := entity.GetTenantField()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get tenant field of %s: %w", entity.Name, err)
+	}
+
+	foreignKeys := make(map[string]spec.XCodegenExtensionRepositoryRelationsItem)
+	for _, rel := range entity.GetForeignKeyRelations() {
+		foreignKeys[strutil.PascalCase(rel.Field)] = rel
+	}
+
+	updatable := make(map[string]bool)
+	for _, prop := range entity.GetUpdateFields() {
+		updatable[prop.Name] = true
+	}
+
+	unique := make(map[string]bool)
+	for _, field := range entity.GetRepositoryUniqueIndices() {
+		unique[field] = true
+	}
+
+	var fields []ContractField
+	for _, prop := range entity.GetCreateFields() {
+		if tenant != nil && prop.Name == tenant.Name {
+			fields = append(fields, ContractField{Name: prop.Name, Value: "s.tenantID(t)"})
+			continue
+		}
+		rel, isForeignKey := foreignKeys[prop.Name]
+		if !isForeignKey {
+			if value := prop.GetFixtureValue(entity); value != "" {
+				if unique[prop.JSONTag] {
+					value = uniqueFixtureValue(prop, value)
+				}
+				field := ContractField{Name: prop.Name, Value: value}
+				if updatable[prop.Name] {
+					field.UpdateValue = prop.GetFixtureUpdateValue(entity)
+				}
+				fields = append(fields, field)
+			}
+			continue
+		}
+
+		if prop.NeedsPointer() {
+			continue // Optional references are left unset
+		}
+		parent, ok := entities[rel.References]
+		if !ok {
+			return nil, fmt.Sprintf("%s references %s, which is not an entity of this spec", prop.Name, rel.References), nil
+		}
+		if hasRequiredReferenceTo(parent, entity, entities, map[string]bool{}) {
+			return nil, fmt.Sprintf("%s is part of a cycle of required references", prop.Name), nil
+		}
+		column := "ID"
+		if rel.ReferencesField != nil {
+			column = strutil.PascalCase(*rel.ReferencesField)
+		}
+		fields = append(fields, ContractField{
+			Name:  prop.Name,
+			Value: fmt.Sprintf("create%s(t, s).%s", parent.Name, column),
+		})
+	}
+	return fields, "", nil
+}
+
+// uniqueFixtureValue suffixes the fixture value of a string field with a
+// unique index, so that the rows of one test do not collide.
+func uniqueFixtureValue(prop *spec.Schema, value string) string {
+	const suffix = 9 // "-" and eight hex digits
+	if prop.GoType != "string" || prop.NeedsPointer() {
+		return value
+	}
+	if prop.Schema != nil && prop.Schema.MaxLength != nil &&
+		int64(len(value)-2+suffix) > *prop.Schema.MaxLength {
+		return value
+	}
+	return value + ` + "-" + uuid.NewString()[:8]`
+}
+
+// hasRequiredReferenceTo returns true if creating from requires a row of to.
+func hasRequiredReferenceTo(from, to *spec.Schema, entities map[string]*spec.Schema, seen map[string]bool) bool {
+	if from == to {
+		return true
+	}
+	if seen[from.Name] {
+		return false
+	}
+	seen[from.Name] = true
+	for _, rel := range from.GetForeignKeyRelations() {
+		prop := findProperty(from, strutil.PascalCase(rel.Field))
+		if prop == nil || prop.NeedsPointer() {
+			continue
+		}
+		if next, ok := entities[rel.References]; ok && hasRequiredReferenceTo(next, to, entities, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// contractMethods returns the additional methods whose parameters all match
+// fields set by the fixture, with arguments read from a fixture named entity.
+func contractMethods(entity *spec.Schema, fields []ContractField) []ContractMethod {
+	if entity.XCodegen == nil || entity.XCodegen.Repository == nil {
+		return nil
+	}
+	set := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		set[f.Name] = true
+	}
+
+	var methods []ContractMethod
+	for _, m := range entity.XCodegen.Repository.AdditionalMethods {
+		args := make([]string, 0, len(m.Params))
+		for _, p := range m.Params {
+			name := strutil.PascalCase(p.Name)
+			prop := findProperty(entity, name)
+			if prop == nil || !set[name] {
+				break
+			}
+			args = append(args, contractArg(entity, prop, p.Type))
+		}
+		if len(args) != len(m.Params) {
+			continue // Parameters that are not fields, such as a session ID
+		}
+		methods = append(methods, ContractMethod{
+			Name:     m.Name,
+			Args:     strings.Join(args, ", "),
+			Multiple: m.Returns == "multiple",
+		})
+	}
+	return methods
+}
+
+// contractArg converts a fixture field to the type of a method parameter.
+func contractArg(entity, prop *spec.Schema, paramType string) string {
+	value := "entity." + prop.Name
+	if prop.NeedsPointer() {
+		value = "*" + value
+	}
+	switch {
+	case prop.Format == spec.FormatUUID && paramType == "string":
+		return value + ".String()"
+	case paramType == prop.GetBaseType(entity):
+		return value
+	default:
+		return fmt.Sprintf("%s(%s)", paramType, value)
+	}
+}
+
+func findProperty(entity *spec.Schema, name string) *spec.Schema {
+	for _, prop := range entity.GetSortedProperties() {
+		if prop.Name == name {
+			return prop
+		}
+	}
+	return nil
+}
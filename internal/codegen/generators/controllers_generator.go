@@ -11,6 +11,7 @@ import (
 // ControllerTemplateData holds the data for rendering controller templates.
 type ControllerTemplateData struct {
 	Operation   *spec.Operation
+	Entity      *spec.Schema // Entity named by the operation tag, if any
 	ProjectName string
 }
 
@@ -30,6 +31,9 @@ func (g *ControllersGenerator) Generate(ctx *GeneratorContext) error {
 		if op.IsInternal(internalContext) {
 			continue
 		}
+		if err := op.CheckPatterns(); err != nil {
+			return err
+		}
 
 		outputPath := filepath.Join(
 			"routes",
@@ -37,6 +41,7 @@ func (g *ControllersGenerator) Generate(ctx *GeneratorContext) error {
 		)
 		data := &ControllerTemplateData{
 			Operation:   &op,
+			Entity:      ctx.Spec.GetEntity(op.Tag),
 			ProjectName: ctx.ProjectName,
 		}
 
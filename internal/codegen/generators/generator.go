@@ -49,6 +49,11 @@ type GeneratorContext struct {
 	Renderer    *templates.Renderer
 	Storage     storage.Storage
 	ProjectName string
+
+	// Manifest records the files written through WriteFile. It may be nil.
+	Manifest *Manifest
+	// Generator is the name of the generator using this context.
+	Generator string
 }
 
 // InternalContext returns the last segment of the project name.
@@ -76,6 +81,18 @@ func (ctx *GeneratorContext) OwnOperations() []spec.Operation {
 	return operations
 }
 
+// IsInternalPackage reports whether the spec is that of an internal package,
+// whose operations carry its own x-internal, rather than that of an app.
+func (ctx *GeneratorContext) IsInternalPackage() bool {
+	internalContext := ctx.InternalContext()
+	for _, op := range ctx.Spec.Operations {
+		if internalContext != "" && op.XInternal == internalContext {
+			return true
+		}
+	}
+	return false
+}
+
 // ComposedPackages returns the unique x-internal package names from operations
 // that belong to OTHER packages (not this one).
 func (ctx *GeneratorContext) ComposedPackages() []string {
@@ -101,6 +118,23 @@ type InternalPackage struct {
 	ImportPath string
 }
 
+// WriteFile writes a generated file and records it in the manifest.
+// The write is skipped when the stored content is already identical.
+func (ctx *GeneratorContext) WriteFile(outputPath string, content []byte) error {
+	hash := HashContent(content)
+	if ctx.Manifest != nil && !IsUserOwned(outputPath) {
+		ctx.Manifest.Record(filepath.ToSlash(filepath.Clean(outputPath)), hash, ctx.Generator)
+	}
+
+	if existing, err := ctx.Storage.ReadFile(outputPath); err == nil && HashContent(existing) == hash {
+		return nil
+	}
+	if err := ctx.Storage.WriteFile(outputPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outputPath, err)
+	}
+	return nil
+}
+
 // RenderToFile renders a template and writes it to the specified path.
 // This replaces the common pattern of creating a buffer, rendering, and writing.
 func (ctx *GeneratorContext) RenderToFile(templateName, outputPath string, data any) error {
@@ -108,14 +142,12 @@ func (ctx *GeneratorContext) RenderToFile(templateName, outputPath string, data
 	if err := ctx.Renderer.Render(&buf, templateName, data); err != nil {
 		return fmt.Errorf("failed to render %s: %w", templateName, err)
 	}
-	if err := ctx.Storage.WriteFile(outputPath, buf.Bytes(), 0644); err != nil {
-		return fmt.Errorf("failed to write %s: %w", outputPath, err)
-	}
-	return nil
+	return ctx.WriteFile(outputPath, buf.Bytes())
 }
 
 // RenderToFileIfNotExists renders a template only if the file doesn't exist.
-// Useful for stub files that should not be overwritten.
+// Useful for stub files that should not be overwritten. These files belong to
+// the user once written, so they are not recorded in the manifest.
 func (ctx *GeneratorContext) RenderToFileIfNotExists(
 	templateName, outputPath string,
 	data any,
@@ -124,7 +156,14 @@ func (ctx *GeneratorContext) RenderToFileIfNotExists(
 	if _, err := os.Stat(fullPath); err == nil {
 		return nil // File exists, skip
 	}
-	return ctx.RenderToFile(templateName, outputPath, data)
+	var buf bytes.Buffer
+	if err := ctx.Renderer.Render(&buf, templateName, data); err != nil {
+		return fmt.Errorf("failed to render %s: %w", templateName, err)
+	}
+	if err := ctx.Storage.WriteFile(outputPath, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outputPath, err)
+	}
+	return nil
 }
 
 // OwnEntitySchemas returns entity schemas that belong to this package.
@@ -152,11 +191,14 @@ func DefaultGenerators() []Generator {
 		&RepositoriesGenerator{},
 		&PostgresGenerator{},
 		&SQLiteGenerator{},
+		&ContractTestsGenerator{},
+		&MocksGenerator{},
 		&HandlersGenerator{},
 		&ControllersGenerator{},
 		&HCLGenerator{},
 		&SQLCGenerator{},
 		&ClientGenerator{},
+		&GoClientGenerator{},
 		&MainGenerator{},
 		&AppGenerator{},
 		&RoutesGenerator{},
@@ -0,0 +1,126 @@
+package generators
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/archesai/archesai/internal/spec"
+	"github.com/archesai/archesai/internal/strutil"
+)
+
+// GoClientTemplateData holds the data for rendering the Go client package template.
+type GoClientTemplateData struct {
+	ProjectName string
+	Operations  []spec.Operation
+}
+
+// GoClientOperationTemplateData holds the data for rendering a Go client operation template.
+type GoClientOperationTemplateData struct {
+	Operation        *spec.Operation
+	ProjectName      string
+	ModelsImportPath string // Models package of the operation's types
+	PathExpr         string // Go expression that builds the request path
+	ItemType         string // Element type of Data for paginated list operations, empty otherwise
+}
+
+// GoClientGenerator generates a typed Go client package for the API.
+type GoClientGenerator struct{}
+
+// Name returns the generator name.
+func (g *GoClientGenerator) Name() string { return "go-client" }
+
+// Priority returns the generator priority.
+func (g *GoClientGenerator) Priority() int { return PriorityNormal }
+
+// Generate creates the client package and one file per API operation.
+func (g *GoClientGenerator) Generate(ctx *GeneratorContext) error {
+	operations := append([]spec.Operation(nil), ctx.Spec.Operations...)
+	if len(operations) == 0 {
+		return nil
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].ID < operations[j].ID
+	})
+
+	data := &GoClientTemplateData{
+		ProjectName: ctx.ProjectName,
+		Operations:  operations,
+	}
+	if err := ctx.RenderToFile("go_client.go.tmpl", filepath.Join("client", "client.gen.go"), data); err != nil {
+		return fmt.Errorf("failed to generate go client: %w", err)
+	}
+
+	for _, op := range operations {
+		outputPath := filepath.Join(
+			"client",
+			fmt.Sprintf("%s.gen.go", strutil.SnakeCase(op.ID)),
+		)
+		opData := &GoClientOperationTemplateData{
+			Operation:        &op,
+			ProjectName:      ctx.ProjectName,
+			ModelsImportPath: goClientModelsImportPath(ctx, &op),
+			PathExpr:         goClientPathExpr(op.Path),
+			ItemType:         goClientItemType(&op),
+		}
+		if err := ctx.RenderToFile("go_client_operation.go.tmpl", outputPath, opData); err != nil {
+			return fmt.Errorf("failed to generate go client for %s: %w", op.ID, err)
+		}
+	}
+	return nil
+}
+
+// goClientModelsImportPath returns the models package of an operation, which
+// is that of the internal package it belongs to when it is composed from one.
+func goClientModelsImportPath(ctx *GeneratorContext, op *spec.Operation) string {
+	if op.IsInternal(ctx.InternalContext()) && op.XInternal != "" {
+		return InternalPackageModelsPath(op.XInternal)
+	}
+	return ctx.ProjectName + "/models"
+}
+
+// goClientPathExpr turns a path template such as /users/{id} into a Go
+// expression that substitutes the escaped path parameter variables.
+func goClientPathExpr(path string) string {
+	var parts []string
+	for path != "" {
+		start := strings.Index(path, "{")
+		end := strings.Index(path, "}")
+		if start < 0 || end < start {
+			parts = append(parts, fmt.Sprintf("%q", path))
+			break
+		}
+		if start > 0 {
+			parts = append(parts, fmt.Sprintf("%q", path[:start]))
+		}
+		name := strutil.CamelCase(path[start+1 : end])
+		parts = append(parts, fmt.Sprintf("url.PathEscape(apiclient.FormatParam(%s))", name))
+		path = path[end+1:]
+	}
+	if len(parts) == 0 {
+		return `"/"`
+	}
+	return strings.Join(parts, " + ")
+}
+
+// goClientItemType returns the item type of a list operation that takes a
+// page parameter and answers with data and pagination meta.
+func goClientItemType(op *spec.Operation) string {
+	hasPage := false
+	for _, p := range op.GetQueryParams() {
+		if p.Name == "Page" {
+			hasPage = true
+		}
+	}
+	resp := op.GetSuccessResponse()
+	if !hasPage || resp == nil || resp.Schema == nil ||
+		!resp.HasProperty("Data") || !resp.HasProperty("Meta") {
+		return ""
+	}
+	data := resp.Properties["Data"]
+	if !strings.HasPrefix(data.GoType, "[]") {
+		return ""
+	}
+	return strings.TrimPrefix(data.GoType, "[]")
+}
@@ -0,0 +1,53 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/archesai/archesai/internal/spec"
+)
+
+func TestGoClientModelsImportPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		projectName string
+		xInternal   string
+		want        string
+	}{
+		{
+			name:        "own operation",
+			projectName: "github.com/acme/todo",
+			want:        "github.com/acme/todo/models",
+		},
+		{
+			name:        "composed operation",
+			projectName: "github.com/archesai/archesai/apps/studio",
+			xInternal:   "auth",
+			want:        "github.com/archesai/archesai/pkg/auth/models",
+		},
+		{
+			name:        "operation of the package itself",
+			projectName: "github.com/archesai/archesai/pkg/auth",
+			xInternal:   "auth",
+			want:        "github.com/archesai/archesai/pkg/auth/models",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &GeneratorContext{ProjectName: tt.projectName}
+			op := &spec.Operation{ID: "GetMember", XInternal: tt.xInternal}
+			assert.Equal(t, tt.want, goClientModelsImportPath(ctx, op))
+		})
+	}
+}
+
+func TestGoClientPathExpr(t *testing.T) {
+	assert.Equal(t, `"/"`, goClientPathExpr(""))
+	assert.Equal(t, `"/pipelines"`, goClientPathExpr("/pipelines"))
+	assert.Equal(t,
+		`"/organizations/" + url.PathEscape(apiclient.FormatParam(organizationID)) + "/members/" + url.PathEscape(apiclient.FormatParam(id))`,
+		goClientPathExpr("/organizations/{organizationID}/members/{id}"),
+	)
+}
@@ -11,6 +11,7 @@ import (
 // ApplicationTemplateData holds the data for rendering application handler templates.
 type ApplicationTemplateData struct {
 	Operation   *spec.Operation
+	Entity      *spec.Schema // Entity named by the operation tag, if any
 	ProjectName string
 }
 
@@ -56,6 +57,7 @@ func generateHandlerGenFile(ctx *GeneratorContext, op spec.Operation) error {
 
 	data := &ApplicationTemplateData{
 		Operation:   &op,
+		Entity:      ctx.Spec.GetEntity(op.Tag),
 		ProjectName: ctx.ProjectName,
 	}
 
@@ -23,7 +23,18 @@ type HCLTemplateData struct {
 }
 
 // HCLGenerator generates Atlas HCL schema files for database migrations.
-type HCLGenerator struct{}
+type HCLGenerator struct {
+	// MigrationMode selects how the schema of the existing PostgreSQL
+	// migrations is obtained. The zero value uses a testcontainer.
+	MigrationMode MigrationMode
+
+	// DevURL is the database the migrations are replayed in with MigrationModeDev.
+	DevURL string
+
+	// AllowDestructive lets migrations contain changes that fail the
+	// migration checks without an x-codegen allowDestructive attribute.
+	AllowDestructive bool
+}
 
 // Name returns the generator name.
 func (g *HCLGenerator) Name() string { return "hcl" }
@@ -71,38 +82,85 @@ func (g *HCLGenerator) Generate(ctx *GeneratorContext) error {
 	}
 
 	// Check Docker availability
-	if err := exec.Command("docker", "version").Run(); err != nil {
-		return fmt.Errorf("docker not available, skipping migrations: %w", err)
+	if g.MigrationMode == "" || g.MigrationMode == MigrationModeContainer {
+		if err := exec.Command("docker", "version").Run(); err != nil {
+			return fmt.Errorf(
+				"docker not available, skipping migrations; set codegen.migrations to %s or %s to generate them without it: %w",
+				MigrationModeOffline, MigrationModeDev, err,
+			)
+		}
 	}
 
-	// Generate migrations in parallel
-	bgCtx := context.Background()
-	eg2 := &errgroup.Group{}
-
-	eg2.Go(func() error {
-		return runMigrationGenerator(bgCtx, ctx.Storage.BaseDir(), database.TypePostgreSQL)
-	})
-
-	eg2.Go(func() error {
-		return runMigrationGenerator(bgCtx, ctx.Storage.BaseDir(), database.TypeSQLite)
-	})
-
-	return eg2.Wait()
+	policy := NewMigrationPolicy(entities, g.AllowDestructive)
+	search, err := sqliteSearchObjects(entities)
+	if err != nil {
+		return err
+	}
+	return g.generateMigrations(ctx.Storage.BaseDir(), policy, search)
 }
 
-func runMigrationGenerator(ctx context.Context, outputDir string, dbType database.Type) error {
-	m := &MigrationGenerator{outputDir: outputDir}
-	if err := m.Start(ctx, dbType); err != nil {
-		return err
+// generateMigrations plans the migrations of both dialects in parallel, and
+// writes them only once both passed the migration checks.
+func (g *HCLGenerator) generateMigrations(
+	outputDir string,
+	policy *MigrationPolicy,
+	search map[string][]searchObject,
+) error {
+	bgCtx := context.Background()
+	generators := []*MigrationGenerator{
+		g.migrationGenerator(outputDir, policy, nil),
+		g.migrationGenerator(outputDir, policy, search),
 	}
+	dbTypes := []database.Type{database.TypePostgreSQL, database.TypeSQLite}
 	defer func() {
-		if err := m.Stop(ctx); err != nil {
-			slog.Error(
-				"Failed to stop database",
-				slog.String("error", err.Error()),
-				slog.String("type", dbType.String()),
-			)
+		for i, m := range generators {
+			if err := m.Stop(bgCtx); err != nil {
+				slog.Error(
+					"Failed to stop database",
+					slog.String("error", err.Error()),
+					slog.String("type", dbTypes[i].String()),
+				)
+			}
 		}
 	}()
-	return m.GenerateMigration(ctx)
+
+	pending := make([]*pendingMigration, len(generators))
+	eg := &errgroup.Group{}
+	for i, m := range generators {
+		eg.Go(func() error {
+			if err := m.Start(bgCtx, dbTypes[i]); err != nil {
+				return err
+			}
+			p, err := m.planMigration(bgCtx)
+			if err != nil {
+				return err
+			}
+			pending[i] = p
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	for i, m := range generators {
+		if err := m.writeMigration(pending[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *HCLGenerator) migrationGenerator(
+	outputDir string,
+	policy *MigrationPolicy,
+	search map[string][]searchObject,
+) *MigrationGenerator {
+	return &MigrationGenerator{
+		outputDir: outputDir,
+		mode:      g.MigrationMode,
+		devURL:    g.DevURL,
+		policy:    policy,
+		search:    search,
+	}
 }
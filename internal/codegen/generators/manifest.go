@@ -0,0 +1,122 @@
+package generators
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/storage"
+)
+
+// ManifestFile is the name of the manifest written to the output directory.
+const ManifestFile = ".archesai-manifest.json"
+
+// manifestVersion is bumped when the manifest format changes.
+const manifestVersion = 1
+
+// ManifestEntry records a generated file.
+type ManifestEntry struct {
+	Path      string `json:"path"`
+	Hash      string `json:"hash"`
+	Generator string `json:"generator"`
+}
+
+// Manifest tracks the files produced by a generation run. It is safe for
+// concurrent use by generators running in parallel.
+type Manifest struct {
+	mu    sync.Mutex
+	files map[string]ManifestEntry
+}
+
+type manifestJSON struct {
+	Version int             `json:"version"`
+	Files   []ManifestEntry `json:"files"`
+}
+
+// NewManifest creates an empty manifest.
+func NewManifest() *Manifest {
+	return &Manifest{files: make(map[string]ManifestEntry)}
+}
+
+// ReadManifest loads the manifest from s. A missing manifest yields an
+// empty one.
+func ReadManifest(s storage.Storage) (*Manifest, error) {
+	m := NewManifest()
+	data, err := s.ReadFile(ManifestFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", ManifestFile, err)
+	}
+
+	var doc manifestJSON
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", ManifestFile, err)
+	}
+	if doc.Version != manifestVersion {
+		return nil, fmt.Errorf("unsupported %s version %d", ManifestFile, doc.Version)
+	}
+	for _, e := range doc.Files {
+		m.files[e.Path] = e
+	}
+	return m, nil
+}
+
+// Write stores the manifest in s, sorted by path so the file is stable.
+func (m *Manifest) Write(s storage.Storage) error {
+	doc := manifestJSON{Version: manifestVersion, Files: m.Entries()}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", ManifestFile, err)
+	}
+	if err := s.WriteFile(ManifestFile, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", ManifestFile, err)
+	}
+	return nil
+}
+
+// Record adds or replaces the entry for path.
+func (m *Manifest) Record(path, hash, generator string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[path] = ManifestEntry{Path: path, Hash: hash, Generator: generator}
+}
+
+// Get returns the entry for path.
+func (m *Manifest) Get(path string) (ManifestEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.files[path]
+	return e, ok
+}
+
+// Entries returns all entries sorted by path.
+func (m *Manifest) Entries() []ManifestEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entries := make([]ManifestEntry, 0, len(m.files))
+	for _, e := range m.files {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	return entries
+}
+
+// HashContent returns the manifest hash of data.
+func HashContent(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// IsUserOwned reports whether path belongs to the user. Such files are
+// scaffolded once and never tracked, overwritten or removed.
+func IsUserOwned(path string) bool {
+	return strings.HasSuffix(path, ".impl.go")
+}
@@ -0,0 +1,270 @@
+package generators
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+
+	"github.com/archesai/archesai/internal/spec"
+	"github.com/archesai/archesai/internal/strutil"
+	"github.com/archesai/archesai/pkg/database"
+)
+
+// Checks run on every planned migration
+const (
+	CheckDataLoss      = "data-loss"      // Drops a table or column
+	CheckTableRewrite  = "table-rewrite"  // Changes a column type, rewriting the table on PostgreSQL
+	CheckNotNull       = "not-null"       // Requires values that existing rows may not have
+	CheckBlockingIndex = "blocking-index" // Builds an index on PostgreSQL while blocking writes
+)
+
+// MigrationIssue is a change of a planned migration that can lose data, fail
+// on existing rows, or block writes while it runs.
+type MigrationIssue struct {
+	Check   string
+	Table   string
+	Column  string // Empty for changes to a whole table
+	Message string
+	Hint    string
+}
+
+// String returns the check and message of the issue, followed by its hint.
+func (i MigrationIssue) String() string {
+	s := i.Check + ": " + i.Message
+	if i.Hint != "" {
+		s += " (" + i.Hint + ")"
+	}
+	return s
+}
+
+// MigrationPolicy holds the renamed columns and the destructive changes a
+// migration may contain. It is read from the x-codegen renamedFrom and
+// allowDestructive attributes of the entities and their properties.
+type MigrationPolicy struct {
+	AllowDestructive bool                         // Allow every destructive change
+	AllowedTables    map[string]bool              // Tables whose changes are all allowed
+	AllowedColumns   map[string]bool              // Columns whose changes are allowed, as "table.column"
+	Renames          map[string]map[string]string // Previous column name by column, by table
+}
+
+// NewMigrationPolicy builds the policy of the entities. allowDestructive
+// allows every destructive change, as the --allow-destructive flag does.
+func NewMigrationPolicy(entities []*spec.Schema, allowDestructive bool) *MigrationPolicy {
+	p := &MigrationPolicy{
+		AllowDestructive: allowDestructive,
+		AllowedTables:    make(map[string]bool),
+		AllowedColumns:   make(map[string]bool),
+		Renames:          make(map[string]map[string]string),
+	}
+	for _, entity := range entities {
+		table := strutil.SnakeCase(entity.Name)
+		if entity.AllowsDestructiveMigrations() {
+			p.AllowedTables[table] = true
+		}
+		for _, prop := range entity.GetSortedProperties() {
+			column := strutil.SnakeCase(prop.Name)
+			if prop.AllowsDestructiveMigrations() {
+				p.AllowedColumns[table+"."+column] = true
+			}
+			if previous := prop.GetRenamedFrom(); previous != "" {
+				if p.Renames[table] == nil {
+					p.Renames[table] = make(map[string]string)
+				}
+				p.Renames[table][column] = strutil.SnakeCase(strutil.PascalCase(previous))
+			}
+		}
+	}
+	return p
+}
+
+// Allows returns true if the issue may be part of a migration.
+func (p *MigrationPolicy) Allows(issue MigrationIssue) bool {
+	if p == nil {
+		return false
+	}
+	return p.AllowDestructive || p.AllowedTables[issue.Table] ||
+		(issue.Column != "" && p.AllowedColumns[issue.Table+"."+issue.Column])
+}
+
+// applyRenames renames the columns of current that the desired schema
+// renamed, so that diffing them does not drop and re-add the columns. It
+// returns the changes renaming them, to be planned before the diff.
+func (p *MigrationPolicy) applyRenames(current, desired *schema.Schema) []schema.Change {
+	if p == nil {
+		return nil
+	}
+	var changes []schema.Change
+	for _, table := range current.Tables {
+		target, ok := desired.Table(table.Name)
+		if !ok {
+			continue
+		}
+		var renames []schema.Change
+		for _, column := range slices.Sorted(maps.Keys(p.Renames[table.Name])) {
+			old, ok := table.Column(p.Renames[table.Name][column])
+			if !ok {
+				continue // Already renamed by an earlier migration
+			}
+			if _, exists := table.Column(column); exists {
+				continue
+			}
+			if _, wanted := target.Column(column); !wanted {
+				continue
+			}
+			from := *old
+			old.Name = column
+			renames = append(renames, &schema.RenameColumn{From: &from, To: old})
+		}
+		if len(renames) > 0 {
+			changes = append(changes, &schema.ModifyTable{T: table, Changes: renames})
+		}
+	}
+	return changes
+}
+
+// reverseRenames returns the changes undoing the renames of applyRenames.
+func reverseRenames(renames []schema.Change) []schema.Change {
+	var changes []schema.Change
+	for _, change := range renames {
+		modify := change.(*schema.ModifyTable)
+		var reversed []schema.Change
+		for _, c := range modify.Changes {
+			rename := c.(*schema.RenameColumn)
+			reversed = append(reversed, &schema.RenameColumn{From: rename.To, To: rename.From})
+		}
+		changes = append(changes, &schema.ModifyTable{T: modify.T, Changes: reversed})
+	}
+	return changes
+}
+
+// analyzeMigration returns the issues of the changes of a migration.
+func analyzeMigration(dbType database.Type, changes []schema.Change) []MigrationIssue {
+	var issues []MigrationIssue
+	for _, change := range changes {
+		switch c := change.(type) {
+		case *schema.DropTable:
+			issues = append(issues, MigrationIssue{
+				Check:   CheckDataLoss,
+				Table:   c.T.Name,
+				Message: fmt.Sprintf("drops table %q and all its rows", c.T.Name),
+			})
+		case *schema.ModifyTable:
+			issues = append(issues, analyzeTableChanges(dbType, c)...)
+		}
+	}
+	return issues
+}
+
+func analyzeTableChanges(dbType database.Type, modify *schema.ModifyTable) []MigrationIssue {
+	table := modify.T.Name
+	var added []string
+	for _, change := range modify.Changes {
+		if c, ok := change.(*schema.AddColumn); ok {
+			added = append(added, c.C.Name)
+		}
+	}
+
+	var issues []MigrationIssue
+	for _, change := range modify.Changes {
+		switch c := change.(type) {
+		case *schema.DropColumn:
+			issue := MigrationIssue{
+				Check:   CheckDataLoss,
+				Table:   table,
+				Column:  c.C.Name,
+				Message: fmt.Sprintf("drops column %q.%q and its values", table, c.C.Name),
+			}
+			if len(added) > 0 {
+				issue.Hint = fmt.Sprintf(
+					"if it was renamed to %s, set x-codegen.renamedFrom on the new property",
+					strings.Join(added, " or "),
+				)
+			}
+			issues = append(issues, issue)
+		case *schema.AddColumn:
+			if hasAttr[*schema.GeneratedExpr](c.C.Attrs) {
+				// Generated columns compute a value for existing rows, by
+				// rewriting the table on PostgreSQL
+				if dbType == database.TypePostgreSQL {
+					issues = append(issues, MigrationIssue{
+						Check:  CheckTableRewrite,
+						Table:  table,
+						Column: c.C.Name,
+						Message: fmt.Sprintf(
+							"adds stored generated column %q.%q, which rewrites the table under an exclusive lock",
+							table, c.C.Name,
+						),
+					})
+				}
+				break
+			}
+			if !c.C.Type.Null && c.C.Default == nil {
+				issues = append(issues, MigrationIssue{
+					Check:   CheckNotNull,
+					Table:   table,
+					Column:  c.C.Name,
+					Message: fmt.Sprintf("adds NOT NULL column %q.%q without a default, which fails if the table has rows", table, c.C.Name),
+					Hint:    "give the property a default or make it nullable",
+				})
+			}
+		case *schema.ModifyColumn:
+			if c.Change.Is(schema.ChangeType) && dbType == database.TypePostgreSQL {
+				issues = append(issues, MigrationIssue{
+					Check:  CheckTableRewrite,
+					Table:  table,
+					Column: c.To.Name,
+					Message: fmt.Sprintf(
+						"changes the type of column %q.%q from %s to %s, which may rewrite the table under an exclusive lock and fails on values that do not convert",
+						table, c.To.Name, columnType(c.From), columnType(c.To),
+					),
+				})
+			}
+			if c.Change.Is(schema.ChangeNull) && c.From.Type.Null && !c.To.Type.Null {
+				issues = append(issues, MigrationIssue{
+					Check:   CheckNotNull,
+					Table:   table,
+					Column:  c.To.Name,
+					Message: fmt.Sprintf("makes column %q.%q NOT NULL, which fails if rows hold NULL", table, c.To.Name),
+				})
+			}
+		case *schema.AddIndex:
+			if dbType == database.TypePostgreSQL && !hasAttr[*postgres.Concurrently](c.Extra) {
+				issue := MigrationIssue{
+					Check:   CheckBlockingIndex,
+					Table:   table,
+					Message: fmt.Sprintf("creates index %q without CONCURRENTLY, which blocks writes to %q while it builds", c.I.Name, table),
+					Hint:    "on large tables, create it by hand with CREATE INDEX CONCURRENTLY before migrating",
+				}
+				if len(c.I.Parts) == 1 && c.I.Parts[0].C != nil {
+					issue.Column = c.I.Parts[0].C.Name
+				}
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues
+}
+
+// columnType returns the PostgreSQL type of the column.
+func columnType(c *schema.Column) string {
+	if c.Type == nil || c.Type.Type == nil {
+		return "unknown"
+	}
+	if t, err := postgres.FormatType(c.Type.Type); err == nil {
+		return t
+	}
+	return c.Type.Raw
+}
+
+func hasAttr[T, A any](attrs []A) bool {
+	for _, a := range attrs {
+		if _, ok := any(a).(T); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package generators
+
+import (
+	"testing"
+
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/database"
+)
+
+func todoTable() *schema.Table {
+	return schema.NewTable("todo").AddColumns(
+		schema.NewStringColumn("id", "text"),
+		schema.NewStringColumn("title", "text"),
+		schema.NewNullStringColumn("notes", "text"),
+	)
+}
+
+func TestAnalyzeMigration(t *testing.T) {
+	index := schema.NewIndex("idx_todo_title").AddColumns(todoTable().Columns[1])
+	tests := []struct {
+		name    string
+		dbType  database.Type
+		changes []schema.Change
+		want    []string // Check and column of each issue
+	}{
+		{
+			name:    "new table",
+			dbType:  database.TypePostgreSQL,
+			changes: []schema.Change{&schema.AddTable{T: todoTable()}},
+		},
+		{
+			name:    "dropped table",
+			dbType:  database.TypePostgreSQL,
+			changes: []schema.Change{&schema.DropTable{T: todoTable()}},
+			want:    []string{"data-loss todo."},
+		},
+		{
+			name:   "dropped and added column",
+			dbType: database.TypeSQLite,
+			changes: []schema.Change{&schema.ModifyTable{T: todoTable(), Changes: []schema.Change{
+				&schema.DropColumn{C: schema.NewStringColumn("title", "text")},
+				&schema.AddColumn{C: schema.NewStringColumn("name", "text")},
+			}}},
+			want: []string{"data-loss todo.title", "not-null todo.name"},
+		},
+		{
+			name:   "added nullable column and column with default",
+			dbType: database.TypePostgreSQL,
+			changes: []schema.Change{&schema.ModifyTable{T: todoTable(), Changes: []schema.Change{
+				&schema.AddColumn{C: schema.NewNullStringColumn("due", "text")},
+				&schema.AddColumn{C: schema.NewBoolColumn("done", "boolean").SetDefault(&schema.RawExpr{X: "false"})},
+			}}},
+		},
+		{
+			name:   "added generated column",
+			dbType: database.TypePostgreSQL,
+			changes: []schema.Change{&schema.ModifyTable{T: todoTable(), Changes: []schema.Change{
+				&schema.AddColumn{C: schema.NewColumn("search_vector").
+					SetType(&postgres.TextSearchType{T: postgres.TypeTSVector}).
+					SetGeneratedExpr(&schema.GeneratedExpr{Expr: "to_tsvector('english'::regconfig, title)", Type: "STORED"})},
+			}}},
+			want: []string{"table-rewrite todo.search_vector"},
+		},
+		{
+			name:   "changed type",
+			dbType: database.TypePostgreSQL,
+			changes: []schema.Change{&schema.ModifyTable{T: todoTable(), Changes: []schema.Change{
+				&schema.ModifyColumn{
+					From:   schema.NewStringColumn("title", "text"),
+					To:     schema.NewIntColumn("title", "integer"),
+					Change: schema.ChangeType,
+				},
+			}}},
+			want: []string{"table-rewrite todo.title"},
+		},
+		{
+			name:   "changed type on SQLite",
+			dbType: database.TypeSQLite,
+			changes: []schema.Change{&schema.ModifyTable{T: todoTable(), Changes: []schema.Change{
+				&schema.ModifyColumn{
+					From:   schema.NewStringColumn("title", "text"),
+					To:     schema.NewIntColumn("title", "integer"),
+					Change: schema.ChangeType,
+				},
+			}}},
+		},
+		{
+			name:   "made not null",
+			dbType: database.TypePostgreSQL,
+			changes: []schema.Change{&schema.ModifyTable{T: todoTable(), Changes: []schema.Change{
+				&schema.ModifyColumn{
+					From:   schema.NewNullStringColumn("notes", "text"),
+					To:     schema.NewStringColumn("notes", "text"),
+					Change: schema.ChangeNull,
+				},
+			}}},
+			want: []string{"not-null todo.notes"},
+		},
+		{
+			name:   "added index",
+			dbType: database.TypePostgreSQL,
+			changes: []schema.Change{&schema.ModifyTable{T: todoTable(), Changes: []schema.Change{
+				&schema.AddIndex{I: index},
+			}}},
+			want: []string{"blocking-index todo.title"},
+		},
+		{
+			name:   "added index concurrently",
+			dbType: database.TypePostgreSQL,
+			changes: []schema.Change{&schema.ModifyTable{T: todoTable(), Changes: []schema.Change{
+				&schema.AddIndex{I: index, Extra: []schema.Clause{&postgres.Concurrently{}}},
+			}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, issue := range analyzeMigration(tt.dbType, tt.changes) {
+				got = append(got, issue.Check+" "+issue.Table+"."+issue.Column)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMigrationPolicyAllows(t *testing.T) {
+	policy := &MigrationPolicy{
+		AllowedTables:  map[string]bool{"label": true},
+		AllowedColumns: map[string]bool{"todo.title": true},
+	}
+	assert.True(t, policy.Allows(MigrationIssue{Table: "label", Column: "name"}))
+	assert.True(t, policy.Allows(MigrationIssue{Table: "todo", Column: "title"}))
+	assert.False(t, policy.Allows(MigrationIssue{Table: "todo", Column: "notes"}))
+	assert.False(t, policy.Allows(MigrationIssue{Table: "todo"}))
+	assert.False(t, (*MigrationPolicy)(nil).Allows(MigrationIssue{Table: "todo"}))
+
+	policy.AllowDestructive = true
+	assert.True(t, policy.Allows(MigrationIssue{Table: "todo"}))
+}
+
+func TestMigrationPolicyApplyRenames(t *testing.T) {
+	policy := &MigrationPolicy{Renames: map[string]map[string]string{"todo": {"name": "title"}}}
+	current := schema.New("public").AddTables(todoTable())
+	desired := schema.New("public").AddTables(schema.NewTable("todo").AddColumns(
+		schema.NewStringColumn("id", "text"),
+		schema.NewStringColumn("name", "text"),
+		schema.NewNullStringColumn("notes", "text"),
+	))
+
+	changes := policy.applyRenames(current, desired)
+	require.Len(t, changes, 1)
+	modify := changes[0].(*schema.ModifyTable)
+	require.Len(t, modify.Changes, 1)
+	rename := modify.Changes[0].(*schema.RenameColumn)
+	assert.Equal(t, "title", rename.From.Name)
+	assert.Equal(t, "name", rename.To.Name)
+
+	// The current schema now has the new name, so diffing finds nothing and
+	// applying the renames again does nothing.
+	diff, err := postgres.DefaultDiff.SchemaDiff(current, desired)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+	assert.Empty(t, policy.applyRenames(current, desired))
+}
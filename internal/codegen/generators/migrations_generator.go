@@ -2,13 +2,13 @@ package generators
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
 	"os"
 	"path/filepath"
-	"regexp"
-	"sort"
-	"strconv"
+	"slices"
 
 	"ariga.io/atlas/sql/migrate"
 	"ariga.io/atlas/sql/postgres"
@@ -26,19 +26,51 @@ import (
 const (
 	postgresSchemaName = "public"
 	sqliteSchemaName   = "main"
+
+	// schemaSnapshotFile is the copy of the HCL schema recorded in the
+	// PostgreSQL migration directory whenever migrations are generated. It
+	// is the schema the migrations produce, which offline mode diffs against.
+	schemaSnapshotFile = "schema.snapshot.hcl"
+)
+
+// MigrationMode selects how the schema of the existing PostgreSQL migrations
+// is obtained. SQLite migrations are always replayed in memory.
+type MigrationMode string
+
+// Valid MigrationMode values
+const (
+	MigrationModeContainer MigrationMode = "container" // Replay them in a testcontainer
+	MigrationModeDev       MigrationMode = "dev"       // Replay them in the database at DevURL
+	MigrationModeOffline   MigrationMode = "offline"   // Read the schema snapshot
 )
 
 // MigrationGenerator handles database migration generation using Atlas.
 type MigrationGenerator struct {
 	container *testpostgres.PostgresContainer
 	database  *database.Database
+	dbType    database.Type
+	mode      MigrationMode
+	devURL    string
 	outputDir string
+	policy    *MigrationPolicy
+
+	// search holds the FTS5 tables and triggers of the searchable tables,
+	// which SQLite migrations create outside of Atlas.
+	search map[string][]searchObject
 }
 
-// Start spins up a database for migration generation.
+// Start spins up a database for migration generation. In offline mode no
+// PostgreSQL database is needed.
 func (m *MigrationGenerator) Start(ctx context.Context, dbType database.Type) error {
+	m.dbType = dbType
 	switch dbType {
 	case database.TypePostgreSQL:
+		switch m.mode {
+		case MigrationModeOffline:
+			return nil
+		case MigrationModeDev:
+			return m.openDevDatabase(ctx)
+		}
 		db, container, err := database.StartPostgreSQL(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to start PostgreSQL container: %w", err)
@@ -57,10 +89,46 @@ func (m *MigrationGenerator) Start(ctx context.Context, dbType database.Type) er
 	return nil
 }
 
-// Stop terminates the database container.
+// openDevDatabase connects to the database at devURL, which must be empty so
+// that replaying the migrations reproduces their schema.
+func (m *MigrationGenerator) openDevDatabase(ctx context.Context) error {
+	if m.devURL == "" {
+		return fmt.Errorf("codegen.devURL is required when codegen.migrations is %s", MigrationModeDev)
+	}
+	db, err := database.Open(database.TypePostgreSQL.String(), m.devURL)
+	if err != nil {
+		return err
+	}
+	driver, err := postgres.Open(db.SQLDB())
+	if err != nil {
+		_ = db.Close()
+		return fmt.Errorf("failed to connect to dev database: %w", err)
+	}
+	current, err := driver.InspectSchema(ctx, postgresSchemaName, &schema.InspectOptions{})
+	if err != nil {
+		_ = db.Close()
+		return fmt.Errorf("failed to inspect dev database: %w", err)
+	}
+	if len(current.Tables) > 0 || len(current.Views) > 0 || len(current.Funcs) > 0 || len(current.Objects) > 0 {
+		_ = db.Close()
+		return fmt.Errorf("dev database schema %q must be empty", postgresSchemaName)
+	}
+	m.database = db
+	return nil
+}
+
+// Stop terminates the database container, or empties the dev database.
 func (m *MigrationGenerator) Stop(ctx context.Context) error {
+	if m.mode == MigrationModeDev && m.dbType == database.TypePostgreSQL && m.database != nil {
+		slog.Debug("Emptying dev database")
+		if _, err := m.database.SQLDB().ExecContext(ctx, fmt.Sprintf(
+			"DROP SCHEMA %[1]q CASCADE; CREATE SCHEMA %[1]q", postgresSchemaName,
+		)); err != nil {
+			slog.Warn("Failed to empty dev database", slog.String("error", err.Error()))
+		}
+	}
 	if m.database != nil && m.database.SQLDB() != nil {
-		if err := m.database.SQLDB().Close(); err != nil {
+		if err := m.database.Close(); err != nil {
 			slog.Warn("Failed to close database connection", slog.String("error", err.Error()))
 		}
 	}
@@ -71,69 +139,110 @@ func (m *MigrationGenerator) Stop(ctx context.Context) error {
 	return nil
 }
 
-// GenerateMigration generates a migration by comparing the current database state
-// with the desired state defined in the HCL schema.
-func (m *MigrationGenerator) GenerateMigration(ctx context.Context) error {
-	if m.database == nil {
-		return fmt.Errorf("database not initialized, call Start() first")
+// pendingMigration is a planned migration that has not been written yet.
+type pendingMigration struct {
+	dir     string
+	hcl     []byte
+	allowed []MigrationIssue
+	up      *migrate.Plan
+	down    *migrate.Plan
+}
+
+// planMigration computes the migration from the current database state to
+// the desired state defined in the HCL schema, and runs the migration checks
+// on it. It writes nothing, so that a migration blocked in one dialect leaves
+// the migrations of the others untouched.
+func (m *MigrationGenerator) planMigration(ctx context.Context) (*pendingMigration, error) {
+	offline := m.dbType == database.TypePostgreSQL && m.mode == MigrationModeOffline
+	if m.database == nil && !offline {
+		return nil, fmt.Errorf("database not initialized, call Start() first")
 	}
 
-	var migrationDir, schemaName string
-	switch m.database.Type() {
+	var migrationDir string
+	switch m.dbType {
 	case database.TypePostgreSQL:
 		migrationDir = filepath.Join(m.outputDir, "infrastructure", "postgres", "migrations")
-		schemaName = postgresSchemaName
 	case database.TypeSQLite:
 		migrationDir = filepath.Join(m.outputDir, "infrastructure", "sqlite", "migrations")
-		schemaName = sqliteSchemaName
 	default:
-		return fmt.Errorf("unsupported database type: %s", m.database.Type())
-	}
-
-	if err := os.MkdirAll(migrationDir, 0755); err != nil {
-		return fmt.Errorf("failed to create migration directory: %w", err)
-	}
-
-	parentDir := filepath.Dir(migrationDir)
-	migrationsFS := os.DirFS(parentDir)
-
-	runner := database.NewMigrationRunner(m.database, migrationsFS)
-	if err := runner.Up(); err != nil {
-		slog.Debug(
-			"No existing migrations to apply or error applying them",
-			slog.String("error", err.Error()),
-		)
-	} else {
-		slog.Debug("Existing migrations applied successfully to dev database")
+		return nil, fmt.Errorf("unsupported database type: %s", m.dbType)
 	}
 
-	var driver migrate.Driver
+	var differ schema.Differ
+	var planner migrate.PlanApplier
+	var currentSchema *schema.Schema
 	var err error
-	switch m.database.Type() {
-	case database.TypePostgreSQL:
-		driver, err = postgres.Open(m.database.SQLDB())
-	case database.TypeSQLite:
-		driver, err = sqlite.Open(m.database.SQLDB())
-	default:
-		return fmt.Errorf("unsupported database type: %s", m.database.Type())
+	if offline {
+		differ, planner = postgres.DefaultDiff, postgres.DefaultPlan
+		currentSchema, err = m.loadSnapshot(migrationDir)
+	} else {
+		var driver migrate.Driver
+		driver, currentSchema, err = m.replayMigrations(ctx, migrationDir)
+		differ, planner = driver, driver
 	}
 	if err != nil {
-		return fmt.Errorf("failed to create atlas driver: %w", err)
+		return nil, err
 	}
 
-	currentSchema, err := driver.InspectSchema(ctx, schemaName, &schema.InspectOptions{})
+	hclData, desiredSchema, err := m.loadHCLSchema()
 	if err != nil {
-		return fmt.Errorf("failed to inspect current schema: %w", err)
+		return nil, fmt.Errorf("failed to load HCL schema: %w", err)
 	}
 
-	desiredSchema, err := m.loadHCLSchema()
+	// Renames are planned first, as their own changes, since planners emit
+	// them after the other changes of a table.
+	renames := m.policy.applyRenames(currentSchema, desiredSchema)
+	diff, err := differ.SchemaDiff(currentSchema, desiredSchema)
 	if err != nil {
-		return fmt.Errorf("failed to load HCL schema: %w", err)
+		return nil, fmt.Errorf("failed to compute schema diff: %w", err)
 	}
+	changes := append(slices.Clip(renames), diff...)
 
-	changes, err := driver.SchemaDiff(currentSchema, desiredSchema)
+	allowed, err := m.checkMigration(changes)
 	if err != nil {
-		return fmt.Errorf("failed to compute schema diff: %w", err)
+		return nil, err
+	}
+
+	plan, downPlan := &migrate.Plan{}, &migrate.Plan{}
+	if len(changes) > 0 {
+		if plan, err = planner.PlanChanges(ctx, "", changes); err != nil {
+			return nil, fmt.Errorf("failed to plan changes: %w", err)
+		}
+
+		// The down migration undoes the diff, then the renames
+		reverse, err := differ.SchemaDiff(desiredSchema, currentSchema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute reverse schema diff: %w", err)
+		}
+		downPlan, err = planner.PlanChanges(ctx, "", append(reverse, reverseRenames(renames)...))
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan down migration: %w", err)
+		}
+	}
+	if m.dbType == database.TypeSQLite {
+		current, err := inspectSearchObjects(ctx, m.database.SQLDB())
+		if err != nil {
+			return nil, err
+		}
+		planSearchChanges(plan, current, m.search)
+		planSearchChanges(downPlan, m.search, current)
+	}
+
+	return &pendingMigration{
+		dir:     migrationDir,
+		hcl:     hclData,
+		allowed: allowed,
+		up:      plan,
+		down:    downPlan,
+	}, nil
+}
+
+// writeMigration writes a planned migration, if it has changes, along with
+// the embed file of the migrations and, for PostgreSQL, the schema snapshot.
+func (m *MigrationGenerator) writeMigration(p *pendingMigration) error {
+	migrationDir := p.dir
+	if err := os.MkdirAll(migrationDir, 0755); err != nil {
+		return fmt.Errorf("failed to create migration directory: %w", err)
 	}
 
 	packageName := filepath.Base(filepath.Dir(migrationDir))
@@ -155,99 +264,237 @@ var Migrations embed.FS
 
 	slog.Debug("Migrations embed file created", slog.String("path", migrationsGoPath))
 
-	if len(changes) == 0 {
+	if len(p.up.Changes) > 0 {
+		var migrationSQL string
+		for _, issue := range p.allowed {
+			migrationSQL += "-- " + issue.Check + ": " + issue.Message + "\n"
+		}
+		if migrationSQL != "" {
+			migrationSQL += "\n"
+		}
+		migrationSQL += m.formatMigrationSQL(p.up)
+
+		nextVersion := database.NextMigrationVersion(migrationDir)
+		migrationFile := fmt.Sprintf("%04d.gen.sql", nextVersion)
+		migrationPath := filepath.Join(migrationDir, migrationFile)
+
+		if err := os.WriteFile(migrationPath, []byte(migrationSQL), 0644); err != nil {
+			return fmt.Errorf("failed to write migration file: %w", err)
+		}
+		downPath := filepath.Join(migrationDir, fmt.Sprintf("%04d.gen.down.sql", nextVersion))
+		if err := os.WriteFile(downPath, []byte(m.formatMigrationSQL(p.down)), 0644); err != nil {
+			return fmt.Errorf("failed to write down migration file: %w", err)
+		}
+
+		// Keep a checksum file recorded with `archesai db migrate hash` valid
+		if _, err := os.Stat(filepath.Join(migrationDir, migrate.HashFileName)); err == nil {
+			if err := database.WriteMigrationSum(migrationDir); err != nil {
+				return err
+			}
+		}
+
+		slog.Info("Migration file created", slog.String("path", migrationPath))
+	} else {
 		slog.Debug("No schema changes detected")
-		return nil
 	}
 
-	plan, err := driver.PlanChanges(ctx, "", changes)
+	if m.dbType == database.TypePostgreSQL {
+		snapshotPath := filepath.Join(migrationDir, schemaSnapshotFile)
+		if err := os.WriteFile(snapshotPath, p.hcl, 0644); err != nil {
+			return fmt.Errorf("failed to write schema snapshot: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkMigration runs the migration checks on the changes. It returns the
+// issues the policy allows, and an error listing the others if there are any.
+func (m *MigrationGenerator) checkMigration(changes []schema.Change) ([]MigrationIssue, error) {
+	var allowed, blocked []MigrationIssue
+	for _, issue := range analyzeMigration(m.dbType, changes) {
+		if m.policy.Allows(issue) {
+			slog.Warn(
+				"Destructive migration change allowed",
+				slog.String("type", m.dbType.String()),
+				slog.String("check", issue.Check),
+				slog.String("change", issue.Message),
+			)
+			allowed = append(allowed, issue)
+		} else {
+			blocked = append(blocked, issue)
+		}
+	}
+	if len(blocked) == 0 {
+		return allowed, nil
+	}
+
+	msg := fmt.Sprintf("%s migration blocked by %d destructive changes:", m.dbType, len(blocked))
+	for _, issue := range blocked {
+		msg += "\n  " + issue.String()
+	}
+	msg += "\nset x-codegen.allowDestructive on the affected schema or property, or pass --allow-destructive, to generate it anyway"
+	return nil, errors.New(msg)
+}
+
+// replayMigrations applies the existing migrations to the database and
+// returns its schema, along with the driver used to plan the next migration.
+func (m *MigrationGenerator) replayMigrations(
+	ctx context.Context,
+	migrationDir string,
+) (migrate.Driver, *schema.Schema, error) {
+	migrationsFS := os.DirFS(filepath.Dir(migrationDir))
+
+	runner := database.NewMigrationRunner(m.database, migrationsFS)
+	if err := runner.Up(); err != nil {
+		slog.Debug(
+			"No existing migrations to apply or error applying them",
+			slog.String("error", err.Error()),
+		)
+	} else {
+		slog.Debug("Existing migrations applied successfully to dev database")
+	}
+
+	var driver migrate.Driver
+	var schemaName string
+	var err error
+	switch m.dbType {
+	case database.TypePostgreSQL:
+		driver, err = postgres.Open(m.database.SQLDB())
+		schemaName = postgresSchemaName
+	case database.TypeSQLite:
+		driver, err = sqlite.Open(m.database.SQLDB())
+		schemaName = sqliteSchemaName
+	default:
+		return nil, nil, fmt.Errorf("unsupported database type: %s", m.dbType)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to plan changes: %w", err)
+		return nil, nil, fmt.Errorf("failed to create atlas driver: %w", err)
 	}
 
-	migrationSQL := m.formatMigrationSQL(plan)
+	exclude := []string{database.RevisionsTable}
+	if m.dbType == database.TypeSQLite {
+		virtual, err := sqliteVirtualTables(ctx, m.database.SQLDB())
+		if err != nil {
+			return nil, nil, err
+		}
+		exclude = append(exclude, virtual...)
+	}
+	currentSchema, err := driver.InspectSchema(ctx, schemaName, &schema.InspectOptions{
+		Exclude: exclude,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to inspect current schema: %w", err)
+	}
+	return driver, currentSchema, nil
+}
 
-	nextVersion := m.getNextMigrationVersion(migrationDir)
-	migrationFile := fmt.Sprintf("%04d.gen.sql", nextVersion)
-	migrationPath := filepath.Join(migrationDir, migrationFile)
+// RecordSchemaSnapshot replays the PostgreSQL migrations in migrationDir and
+// records the schema they produce as its schema snapshot, which offline mode
+// diffs against. They are replayed in the empty database at devURL, or in a
+// testcontainer if devURL is empty. It returns the path of the snapshot.
+func RecordSchemaSnapshot(ctx context.Context, migrationDir, devURL string) (path string, err error) {
+	if database.NextMigrationVersion(migrationDir) == 0 {
+		return "", fmt.Errorf("no migrations in %s", migrationDir)
+	}
+	m := &MigrationGenerator{mode: MigrationModeContainer, devURL: devURL}
+	if devURL != "" {
+		m.mode = MigrationModeDev
+	}
+	if err := m.Start(ctx, database.TypePostgreSQL); err != nil {
+		return "", err
+	}
+	defer func() {
+		if stopErr := m.Stop(ctx); stopErr != nil && err == nil {
+			err = fmt.Errorf("failed to stop database: %w", stopErr)
+		}
+	}()
 
-	migrationContent := migrationSQL
+	// Replaying ignores failed migrations, which the snapshot must not
+	runner := database.NewMigrationRunner(m.database, os.DirFS(filepath.Dir(migrationDir)))
+	if err := runner.Up(); err != nil {
+		return "", fmt.Errorf("failed to apply migrations: %w", err)
+	}
+	_, current, err := m.replayMigrations(ctx, migrationDir)
+	if err != nil {
+		return "", err
+	}
+	hclData, err := postgres.MarshalHCL(current)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal schema snapshot: %w", err)
+	}
 
-	if err := os.WriteFile(migrationPath, []byte(migrationContent), 0644); err != nil {
-		return fmt.Errorf("failed to write migration file: %w", err)
+	path = filepath.Join(migrationDir, schemaSnapshotFile)
+	if err := os.WriteFile(path, hclData, 0644); err != nil {
+		return "", fmt.Errorf("failed to write schema snapshot: %w", err)
 	}
+	return path, nil
+}
 
-	slog.Info("Migration file created", slog.String("path", migrationPath))
-	return nil
+// loadSnapshot returns the schema the PostgreSQL migrations in migrationDir
+// produce, as recorded in its schema snapshot. Without migrations the schema
+// is empty.
+func (m *MigrationGenerator) loadSnapshot(migrationDir string) (*schema.Schema, error) {
+	snapshotPath := filepath.Join(migrationDir, schemaSnapshotFile)
+	hclData, err := os.ReadFile(snapshotPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		if database.NextMigrationVersion(migrationDir) > 0 {
+			return nil, fmt.Errorf(
+				"no schema snapshot at %s: run `archesai db migrate snapshot` to record it",
+				snapshotPath,
+			)
+		}
+		return schema.New(postgresSchemaName), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema snapshot: %w", err)
+	}
+
+	var s schema.Schema
+	if err := postgres.EvalHCLBytes(hclData, &s, nil); err != nil {
+		return nil, fmt.Errorf("failed to parse schema snapshot %s: %w", snapshotPath, err)
+	}
+	s.Name = postgresSchemaName
+	return &s, nil
 }
 
-func (m *MigrationGenerator) loadHCLSchema() (*schema.Schema, error) {
+// loadHCLSchema reads the generated HCL schema and returns its contents and
+// the schema it defines.
+func (m *MigrationGenerator) loadHCLSchema() ([]byte, *schema.Schema, error) {
 	var hclSchemaFile string
-	switch m.database.Type() {
+	switch m.dbType {
 	case database.TypePostgreSQL:
 		hclSchemaFile = filepath.Join(m.outputDir, "infrastructure", "postgres", "schema.gen.hcl")
 	case database.TypeSQLite:
 		hclSchemaFile = filepath.Join(m.outputDir, "infrastructure", "sqlite", "schema.gen.hcl")
 	default:
-		return nil, fmt.Errorf("unsupported database type for HCL schema: %s", m.database.Type())
+		return nil, nil, fmt.Errorf("unsupported database type for HCL schema: %s", m.dbType)
 	}
 
 	hclData, err := os.ReadFile(hclSchemaFile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read HCL file: %w", err)
+		return nil, nil, fmt.Errorf("failed to read HCL file: %w", err)
 	}
 
 	var s schema.Schema
 	var defaultSchemaName string
 
-	switch m.database.Type() {
+	switch m.dbType {
 	case database.TypePostgreSQL:
 		defaultSchemaName = postgresSchemaName
 		if err := postgres.EvalHCLBytes(hclData, &s, nil); err != nil {
-			return nil, fmt.Errorf("failed to parse HCL schema: %w", err)
+			return nil, nil, fmt.Errorf("failed to parse HCL schema: %w", err)
 		}
 	case database.TypeSQLite:
 		defaultSchemaName = sqliteSchemaName
 		if err := sqlite.EvalHCLBytes(hclData, &s, nil); err != nil {
-			return nil, fmt.Errorf("failed to parse HCL schema: %w", err)
+			return nil, nil, fmt.Errorf("failed to parse HCL schema: %w", err)
 		}
 	default:
-		return nil, fmt.Errorf("unsupported database type: %s", m.database.Type())
+		return nil, nil, fmt.Errorf("unsupported database type: %s", m.dbType)
 	}
 
 	s.Name = defaultSchemaName
-	return &s, nil
-}
-
-// getNextMigrationVersion scans the migration directory and returns the next version number.
-func (m *MigrationGenerator) getNextMigrationVersion(migrationDir string) int {
-	entries, err := os.ReadDir(migrationDir)
-	if err != nil {
-		return 1
-	}
-
-	// Match files like "0000001.gen.sql" or "0000001.sql"
-	versionRegex := regexp.MustCompile(`^(\d+)\.(?:gen\.)?sql$`)
-
-	var versions []int
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
-		}
-		matches := versionRegex.FindStringSubmatch(entry.Name())
-		if len(matches) == 2 {
-			if v, err := strconv.Atoi(matches[1]); err == nil {
-				versions = append(versions, v)
-			}
-		}
-	}
-
-	if len(versions) == 0 {
-		return 0
-	}
-
-	sort.Ints(versions)
-	return versions[len(versions)-1] + 1
+	return hclData, &s, nil
 }
 
 func (m *MigrationGenerator) formatMigrationSQL(plan *migrate.Plan) string {
@@ -0,0 +1,259 @@
+package generators
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/database/databasetest"
+)
+
+// todoHCL returns the HCL schema of a todo table in schemaName, with an
+// index on its title if indexed is set.
+func todoHCL(schemaName string, indexed bool) string {
+	hcl := `schema "` + schemaName + `" {}
+
+table "todo" {
+  schema = schema.` + schemaName + `
+  column "id" {
+    null = false
+    type = text
+  }
+  column "title" {
+    null = false
+    type = text
+  }
+  primary_key {
+    columns = [column.id]
+  }
+`
+	if indexed {
+		hcl += `  index "idx_todo_title" {
+    columns = [column.title]
+  }
+`
+	}
+	return hcl + "}\n"
+}
+
+// writeTodoProject writes a project whose migrations create the todo table,
+// and whose schema indexes its title.
+func writeTodoProject(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"infrastructure/postgres/migrations/0000.gen.sql":          `CREATE TABLE "todo" ("id" text NOT NULL, "title" text NOT NULL, PRIMARY KEY ("id"));`,
+		"infrastructure/postgres/migrations/" + schemaSnapshotFile: todoHCL(postgresSchemaName, false),
+		"infrastructure/postgres/schema.gen.hcl":                   todoHCL(postgresSchemaName, true),
+		"infrastructure/sqlite/migrations/0000.gen.sql":            "CREATE TABLE `todo` (`id` text NOT NULL, `title` text NOT NULL, PRIMARY KEY (`id`));",
+		"infrastructure/sqlite/schema.gen.hcl":                     todoHCL(sqliteSchemaName, true),
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestHCLGeneratorGenerateMigrations(t *testing.T) {
+	migrations := func(dir, dialect string) []string {
+		t.Helper()
+		names, err := filepath.Glob(filepath.Join(dir, "infrastructure", dialect, "migrations", "*.sql"))
+		require.NoError(t, err)
+		for i, name := range names {
+			names[i] = filepath.Base(name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		name             string
+		allowDestructive bool
+		wantErr          string
+		want             []string
+	}{
+		{
+			// The index blocks writes on PostgreSQL only, and no dialect
+			// gets a migration
+			name:    "blocked in one dialect",
+			wantErr: "blocking-index",
+			want:    []string{"0000.gen.sql"},
+		},
+		{
+			name:             "allowed",
+			allowDestructive: true,
+			want:             []string{"0000.gen.sql", "0001.gen.down.sql", "0001.gen.sql"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeTodoProject(t)
+			g := &HCLGenerator{MigrationMode: MigrationModeOffline}
+
+			err := g.generateMigrations(dir, NewMigrationPolicy(nil, tt.allowDestructive), nil)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, migrations(dir, "postgres"))
+			assert.Equal(t, tt.want, migrations(dir, "sqlite"))
+		})
+	}
+}
+
+func TestMigrationGeneratorOffline(t *testing.T) {
+	const migration = `CREATE TABLE "todo" ("id" text NOT NULL, "title" text NOT NULL, PRIMARY KEY ("id"));`
+
+	tests := []struct {
+		name string
+		// files are written below infrastructure/postgres
+		files map[string]string
+
+		wantErr  string
+		want     []string
+		wantUp   string
+		wantDown string
+	}{
+		{
+			name: "first migration",
+			files: map[string]string{
+				"schema.gen.hcl": todoHCL(postgresSchemaName, false),
+			},
+			want:     []string{"0000.gen.down.sql", "0000.gen.sql"},
+			wantUp:   `CREATE TABLE "public"."todo"`,
+			wantDown: `DROP TABLE "public"."todo"`,
+		},
+		{
+			name: "schema unchanged",
+			files: map[string]string{
+				"migrations/0000.gen.sql":          migration,
+				"migrations/" + schemaSnapshotFile: todoHCL(postgresSchemaName, false),
+				"schema.gen.hcl":                   todoHCL(postgresSchemaName, false),
+			},
+			want: []string{"0000.gen.sql"},
+		},
+		{
+			// The snapshot rather than the migrations is diffed against
+			name: "schema changed",
+			files: map[string]string{
+				"migrations/0000.gen.sql":          migration,
+				"migrations/" + schemaSnapshotFile: todoHCL(postgresSchemaName, false),
+				"schema.gen.hcl":                   todoHCL(postgresSchemaName, true),
+			},
+			want:     []string{"0000.gen.sql", "0001.gen.down.sql", "0001.gen.sql"},
+			wantUp:   `CREATE INDEX "idx_todo_title" ON "public"."todo" ("title");`,
+			wantDown: `DROP INDEX "public"."idx_todo_title";`,
+		},
+		{
+			name: "migrations without a snapshot",
+			files: map[string]string{
+				"migrations/0000.gen.sql": migration,
+				"schema.gen.hcl":          todoHCL(postgresSchemaName, false),
+			},
+			wantErr: "run `archesai db migrate snapshot` to record it",
+		},
+		{
+			name: "invalid snapshot",
+			files: map[string]string{
+				"migrations/0000.gen.sql":          migration,
+				"migrations/" + schemaSnapshotFile: `table "todo" {`,
+				"schema.gen.hcl":                   todoHCL(postgresSchemaName, false),
+			},
+			wantErr: "failed to parse schema snapshot",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			postgresDir := filepath.Join(dir, "infrastructure", "postgres")
+			for name, content := range tt.files {
+				path := filepath.Join(postgresDir, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+			}
+
+			// No database is started in offline mode
+			m := (&HCLGenerator{MigrationMode: MigrationModeOffline}).
+				migrationGenerator(dir, NewMigrationPolicy(nil, true), nil)
+			require.NoError(t, m.Start(context.Background(), database.TypePostgreSQL))
+			assert.Nil(t, m.database)
+
+			p, err := m.planMigration(context.Background())
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, m.writeMigration(p))
+
+			migrationDir := filepath.Join(postgresDir, "migrations")
+			names, err := filepath.Glob(filepath.Join(migrationDir, "*.sql"))
+			require.NoError(t, err)
+			for i, name := range names {
+				names[i] = filepath.Base(name)
+			}
+			assert.Equal(t, tt.want, names)
+
+			if tt.wantUp != "" {
+				last := tt.want[len(tt.want)-1]
+				up, err := os.ReadFile(filepath.Join(migrationDir, last))
+				require.NoError(t, err)
+				assert.Contains(t, string(up), tt.wantUp)
+				down, err := os.ReadFile(filepath.Join(migrationDir, strings.Replace(last, ".gen.sql", ".gen.down.sql", 1)))
+				require.NoError(t, err)
+				assert.Contains(t, string(down), tt.wantDown)
+			}
+
+			// The snapshot records the schema the migrations now produce
+			snapshot, err := os.ReadFile(filepath.Join(migrationDir, schemaSnapshotFile))
+			require.NoError(t, err)
+			hcl, err := os.ReadFile(filepath.Join(postgresDir, "schema.gen.hcl"))
+			require.NoError(t, err)
+			assert.Equal(t, string(hcl), string(snapshot))
+		})
+	}
+}
+
+func TestMigrationGeneratorStartDevWithoutURL(t *testing.T) {
+	m := (&HCLGenerator{MigrationMode: MigrationModeDev}).migrationGenerator(t.TempDir(), nil, nil)
+	err := m.Start(context.Background(), database.TypePostgreSQL)
+	assert.EqualError(t, err, "codegen.devURL is required when codegen.migrations is dev")
+}
+
+func TestRecordSchemaSnapshotWithoutMigrations(t *testing.T) {
+	migrationDir := filepath.Join(t.TempDir(), "migrations")
+	_, err := RecordSchemaSnapshot(context.Background(), migrationDir, "")
+	assert.EqualError(t, err, "no migrations in "+migrationDir)
+}
+
+// A snapshot recorded from migrations that predate it lets offline mode plan
+// the next migration.
+func TestRecordSchemaSnapshot(t *testing.T) {
+	devURL := databasetest.PostgresURL(t)
+	dir := writeTodoProject(t)
+	migrationDir := filepath.Join(dir, "infrastructure", "postgres", "migrations")
+	require.NoError(t, os.Remove(filepath.Join(migrationDir, schemaSnapshotFile)))
+
+	path, err := RecordSchemaSnapshot(context.Background(), migrationDir, devURL)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(migrationDir, schemaSnapshotFile), path)
+
+	m := (&HCLGenerator{MigrationMode: MigrationModeOffline}).
+		migrationGenerator(dir, NewMigrationPolicy(nil, true), nil)
+	require.NoError(t, m.Start(context.Background(), database.TypePostgreSQL))
+	p, err := m.planMigration(context.Background())
+	require.NoError(t, err)
+	require.Len(t, p.up.Changes, 1, "only the index is planned")
+	assert.Contains(t, m.formatMigrationSQL(p.up), `CREATE INDEX "idx_todo_title"`)
+}
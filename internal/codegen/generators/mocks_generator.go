@@ -0,0 +1,204 @@
+package generators
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/archesai/archesai/internal/spec"
+	"github.com/archesai/archesai/internal/strutil"
+)
+
+// MocksTemplateData holds the data for rendering the mock of one interface.
+type MocksTemplateData struct {
+	Name        string // Mock type name
+	Interface   string // Qualified name of the mocked interface
+	Methods     []MockMethod
+	ProjectName string
+}
+
+// MockMethod is a method of a mocked interface.
+type MockMethod struct {
+	Name    string
+	Params  []MockParam
+	Results []string
+}
+
+// MockParam is a parameter of a mocked method.
+type MockParam struct {
+	Name string
+	Type string
+}
+
+// Field returns the name of the call record field holding the parameter.
+func (p MockParam) Field() string {
+	return strutil.PascalCase(p.Name)
+}
+
+// Signature returns the parameter list, such as "ctx context.Context, id uuid.UUID".
+func (m MockMethod) Signature() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Name + " " + p.Type
+	}
+	return strings.Join(params, ", ")
+}
+
+// ParamTypes returns the parameter list without names.
+func (m MockMethod) ParamTypes() string {
+	types := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		types[i] = p.Type
+	}
+	return strings.Join(types, ", ")
+}
+
+// Args returns the parameter names as call arguments.
+func (m MockMethod) Args() string {
+	names := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		names[i] = p.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// ResultTypes returns the result list without names, such as "(*models.User, error)".
+func (m MockMethod) ResultTypes() string {
+	if len(m.Results) == 1 {
+		return m.Results[0]
+	}
+	return "(" + strings.Join(m.Results, ", ") + ")"
+}
+
+// NamedResults returns the result list with the names r0, r1, ...
+func (m MockMethod) NamedResults() string {
+	results := make([]string, len(m.Results))
+	for i, r := range m.Results {
+		results[i] = fmt.Sprintf("r%d %s", i, r)
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+// ResultNames returns the result names r0, r1, ...
+func (m MockMethod) ResultNames() string {
+	names := make([]string, len(m.Results))
+	for i := range m.Results {
+		names[i] = fmt.Sprintf("r%d", i)
+	}
+	return strings.Join(names, ", ")
+}
+
+// MocksGenerator generates configurable mocks of the handler and repository
+// interfaces into a mocks package.
+type MocksGenerator struct{}
+
+// Name returns the generator name.
+func (g *MocksGenerator) Name() string { return "mocks" }
+
+// Priority returns the generator priority.
+func (g *MocksGenerator) Priority() int { return PriorityNormal }
+
+// Generate creates a mock per handler and repository interface.
+func (g *MocksGenerator) Generate(ctx *GeneratorContext) error {
+	internalContext := ctx.InternalContext()
+	for _, op := range ctx.Spec.Operations {
+		if op.IsInternal(internalContext) {
+			continue
+		}
+		data := &MocksTemplateData{
+			Name:        op.ID,
+			Interface:   "handlers." + op.ID,
+			Methods:     []MockMethod{handlerMockMethod(op)},
+			ProjectName: ctx.ProjectName,
+		}
+		outputPath := filepath.Join("mocks", strutil.SnakeCase(op.ID)+".gen.go")
+		if err := ctx.RenderToFile("mock.go.tmpl", outputPath, data); err != nil {
+			return fmt.Errorf("failed to generate mock for %s: %w", op.ID, err)
+		}
+	}
+
+	for _, schema := range ctx.OwnEntitySchemas() {
+		data := &MocksTemplateData{
+			Name:        schema.Name + "Repository",
+			Interface:   "repositories." + schema.Name + "Repository",
+			Methods:     repositoryMockMethods(schema),
+			ProjectName: ctx.ProjectName,
+		}
+		outputPath := filepath.Join("mocks", strings.ToLower(schema.Name)+"_repository.gen.go")
+		if err := ctx.RenderToFile("mock.go.tmpl", outputPath, data); err != nil {
+			return fmt.Errorf("failed to generate repository mock for %s: %w", schema.Name, err)
+		}
+	}
+	return nil
+}
+
+// handlerMockMethod returns the Execute method of the handler interface of
+// op, as declared by application_handler.go.tmpl.
+func handlerMockMethod(op spec.Operation) MockMethod {
+	m := MockMethod{
+		Name: "Execute",
+		Params: []MockParam{
+			{Name: "ctx", Type: "context.Context"},
+			{Name: "input", Type: "*handlers." + op.ID + "Input"},
+		},
+		Results: []string{"*handlers." + op.ID + "Output", "error"},
+	}
+	if resp := op.GetSuccessResponse(); resp != nil && resp.StatusCode == "204" {
+		m.Results = []string{"error"}
+	}
+	return m
+}
+
+// repositoryMockMethods returns the methods of the repository interface of
+// entity, as declared by repository.go.tmpl. The generated mocks assert that
+// they implement the interface, so the two cannot drift apart unnoticed.
+func repositoryMockMethods(entity *spec.Schema) []MockMethod {
+	ctxParam := MockParam{Name: "ctx", Type: "context.Context"}
+	idParam := MockParam{Name: "id", Type: "uuid.UUID"}
+	entityType := "*models." + entity.Name
+	list := MockMethod{
+		Params:  []MockParam{ctxParam, {Name: "opts", Type: "database.ListOptions"}},
+		Results: []string{"[]" + entityType, "database.PageInfo", "error"},
+	}
+
+	methods := []MockMethod{
+		{Name: "Create", Params: []MockParam{ctxParam, {Name: "entity", Type: entityType}}, Results: []string{entityType, "error"}},
+		{Name: "Get", Params: []MockParam{ctxParam, idParam}, Results: []string{entityType, "error"}},
+		{Name: "Update", Params: []MockParam{ctxParam, idParam, {Name: "entity", Type: entityType}}, Results: []string{entityType, "error"}},
+		{Name: "Delete", Params: []MockParam{ctxParam, idParam}, Results: []string{"error"}},
+	}
+	list.Name = "List"
+	methods = append(methods, list)
+	if entity.UsesSoftDelete() {
+		list.Name = "ListDeleted"
+		methods = append(methods,
+			MockMethod{Name: "Restore", Params: []MockParam{ctxParam, idParam}, Results: []string{"error"}},
+			list,
+			MockMethod{Name: "Purge", Params: []MockParam{ctxParam, {Name: "before", Type: "time.Time"}}, Results: []string{"int64", "error"}},
+		)
+	}
+
+	for _, rel := range entity.GetManyToManyRelations() {
+		relatedID := MockParam{Name: strutil.CamelCase(rel.Entity) + "ID", Type: "uuid.UUID"}
+		methods = append(methods,
+			MockMethod{Name: "Add" + rel.Entity, Params: []MockParam{ctxParam, idParam, relatedID}, Results: []string{"error"}},
+			MockMethod{Name: "Remove" + rel.Entity, Params: []MockParam{ctxParam, idParam, relatedID}, Results: []string{"error"}},
+			MockMethod{Name: "List" + rel.Name, Params: []MockParam{ctxParam, idParam}, Results: []string{"[]*models." + rel.Entity, "error"}},
+		)
+	}
+
+	if entity.XCodegen != nil && entity.XCodegen.Repository != nil {
+		for _, am := range entity.XCodegen.Repository.AdditionalMethods {
+			m := MockMethod{Name: am.Name, Params: []MockParam{ctxParam}}
+			for _, p := range am.Params {
+				m.Params = append(m.Params, MockParam{Name: p.Name, Type: p.Type})
+			}
+			m.Results = []string{entityType, "error"}
+			if am.Returns == "multiple" {
+				m.Results[0] = "[]" + entityType
+			}
+			methods = append(methods, m)
+		}
+	}
+	return methods
+}
@@ -0,0 +1,216 @@
+package generators
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/archesai/archesai/internal/spec"
+	"github.com/archesai/archesai/internal/strutil"
+	"github.com/archesai/archesai/internal/typeconv"
+)
+
+// nativeClientDir is where the TypeScript client is written, matching the
+// layout of the Orval client so the application's fetcher.ts keeps working.
+const nativeClientDir = "src/lib/client"
+
+// NativeClientSchemasTemplateData holds the data for rendering the TypeScript types.
+type NativeClientSchemasTemplateData struct {
+	Schemas    []*spec.Schema
+	Operations []NativeClientOperation
+}
+
+// NativeClientTagTemplateData holds the data for rendering the functions of one tag.
+type NativeClientTagTemplateData struct {
+	Tag        string
+	Operations []NativeClientOperation
+	Types      []string // Types imported from orval.schemas
+	Helpers    []string // Helpers imported from query
+}
+
+// NativeClientOperation describes how one operation is called from TypeScript.
+type NativeClientOperation struct {
+	Operation      *spec.Operation
+	FuncName       string // e.g. listLabels
+	PathExpr       string // TypeScript template literal that builds the path
+	URLParams      string // Parameter list of the URL builder
+	URLArgs        string // Arguments passed to the URL builder
+	FuncParams     string // Parameter list of the operation function
+	HasParams      bool   // Whether the operation takes query or header parameters
+	ParamsRequired bool   // Whether any query or header parameter is required
+	HasResponse    bool   // Whether the success response has a body
+}
+
+// NativeClientGenerator generates the TypeScript API client from the spec
+// without Node or Docker. It replaces ClientGenerator when codegen.client is
+// set to native.
+type NativeClientGenerator struct{}
+
+// Name returns the generator name.
+func (g *NativeClientGenerator) Name() string { return "client" }
+
+// Priority returns the generator priority.
+func (g *NativeClientGenerator) Priority() int { return PriorityNormal }
+
+// Generate creates the TypeScript types, query helpers and one module per tag.
+func (g *NativeClientGenerator) Generate(ctx *GeneratorContext) error {
+	if len(ctx.Spec.Operations) == 0 {
+		return nil
+	}
+
+	byTag := make(map[string][]NativeClientOperation)
+	var operations []NativeClientOperation
+	for i := range ctx.Spec.Operations {
+		op := &ctx.Spec.Operations[i]
+		nop := newNativeClientOperation(op)
+		operations = append(operations, nop)
+		tag := nativeClientTagDir(op.Tag)
+		byTag[tag] = append(byTag[tag], nop)
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].Operation.ID < operations[j].Operation.ID
+	})
+
+	schemas := make([]*spec.Schema, 0, len(ctx.Spec.Schemas))
+	seen := make(map[string]bool)
+	for _, schema := range ctx.Spec.Schemas {
+		if schema.Name == "" || seen[schema.Name] {
+			continue
+		}
+		seen[schema.Name] = true
+		schemas = append(schemas, schema)
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Name < schemas[j].Name })
+
+	schemaData := &NativeClientSchemasTemplateData{Schemas: schemas, Operations: operations}
+	if err := ctx.RenderToFile("ts_schemas.ts.tmpl", filepath.Join(nativeClientDir, "orval.schemas.ts"), schemaData); err != nil {
+		return fmt.Errorf("failed to generate client types: %w", err)
+	}
+	if err := ctx.RenderToFile("ts_query.ts.tmpl", filepath.Join(nativeClientDir, "query.ts"), nil); err != nil {
+		return fmt.Errorf("failed to generate client query helpers: %w", err)
+	}
+
+	for tag, ops := range byTag {
+		sort.Slice(ops, func(i, j int) bool { return ops[i].Operation.ID < ops[j].Operation.ID })
+		data := &NativeClientTagTemplateData{Tag: tag, Operations: ops}
+		data.Types, data.Helpers = nativeClientImports(ops)
+		outputPath := filepath.Join(nativeClientDir, tag, tag+".ts")
+		if err := ctx.RenderToFile("ts_client.ts.tmpl", outputPath, data); err != nil {
+			return fmt.Errorf("failed to generate client for tag %s: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+func newNativeClientOperation(op *spec.Operation) NativeClientOperation {
+	nop := NativeClientOperation{
+		Operation: op,
+		FuncName:  strutil.CamelCase(op.ID),
+		PathExpr:  nativeClientPathExpr(op.Path),
+	}
+	for _, p := range op.Parameters {
+		if p.In != "query" && p.In != "header" {
+			continue
+		}
+		nop.HasParams = true
+		if p.IsPropertyRequired(p.Name) {
+			nop.ParamsRequired = true
+		}
+	}
+	if resp := op.GetSuccessResponse(); resp != nil && resp.StatusCode != "204" && resp.Schema != nil {
+		nop.HasResponse = true
+	}
+
+	var urlParams, urlArgs, funcParams []string
+	for _, p := range op.GetPathParams() {
+		name := strutil.CamelCase(p.Name)
+		param := name + ": " + typeconv.SchemaToTSType(p.Schema)
+		urlParams = append(urlParams, param)
+		urlArgs = append(urlArgs, name)
+		funcParams = append(funcParams, param)
+	}
+	if op.RequestBody != nil {
+		funcParams = append(funcParams, "body: "+op.ID+"Body")
+	}
+	if nop.HasParams {
+		param := "params?: " + op.ID + "Params"
+		if nop.ParamsRequired {
+			param = "params: " + op.ID + "Params"
+		}
+		if len(op.GetQueryParams()) > 0 {
+			urlParams = append(urlParams, param)
+			urlArgs = append(urlArgs, "params")
+		}
+		funcParams = append(funcParams, param)
+	}
+	funcParams = append(funcParams, "options?: RequestInit")
+	nop.URLParams = strings.Join(urlParams, ", ")
+	nop.URLArgs = strings.Join(urlArgs, ", ")
+	nop.FuncParams = strings.Join(funcParams, ", ")
+	return nop
+}
+
+// nativeClientTagDir returns the directory of a tag, such as apikey for APIKey.
+func nativeClientTagDir(tag string) string {
+	if tag == "" {
+		return "default"
+	}
+	return strings.ToLower(strutil.PascalCase(tag))
+}
+
+// nativeClientPathExpr turns a path template such as /users/{id} into a
+// TypeScript template literal that substitutes the encoded path parameters.
+func nativeClientPathExpr(path string) string {
+	var b strings.Builder
+	b.WriteString("`")
+	for path != "" {
+		start := strings.Index(path, "{")
+		end := strings.Index(path, "}")
+		if start < 0 || end < start {
+			b.WriteString(path)
+			break
+		}
+		b.WriteString(path[:start])
+		name := strutil.CamelCase(path[start+1 : end])
+		fmt.Fprintf(&b, "${encodeURIComponent(String(%s))}", name)
+		path = path[end+1:]
+	}
+	b.WriteString("`")
+	return b.String()
+}
+
+// nativeClientImports lists the types and query helpers used by the
+// operations of one tag.
+func nativeClientImports(ops []NativeClientOperation) (types, helpers []string) {
+	for _, op := range ops {
+		id := op.Operation.ID
+		if op.HasParams {
+			types = append(types, id+"Params")
+		}
+		if op.Operation.RequestBody != nil {
+			types = append(types, id+"Body")
+		}
+		if op.HasResponse {
+			types = append(types, id+"Response")
+		}
+		queryParams := op.Operation.GetQueryParams()
+		if len(queryParams) > 0 {
+			helpers = append(helpers, "withQuery")
+		}
+		for _, p := range queryParams {
+			switch p.Name {
+			case "Filter":
+				helpers = append(helpers, "appendDeepObject")
+			case "Page":
+				helpers = append(helpers, "appendPage")
+			default:
+				helpers = append(helpers, "appendParam")
+			}
+		}
+	}
+	sort.Strings(types)
+	sort.Strings(helpers)
+	return types, slices.Compact(helpers)
+}
@@ -0,0 +1,160 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/archesai/archesai/internal/spec"
+)
+
+func TestNewNativeClientOperation(t *testing.T) {
+	param := func(name, in string, required bool) spec.Param {
+		p := spec.Param{Schema: &spec.Schema{Name: name, Type: spec.SchemaTypeString}, SpecName: name, In: in}
+		if required {
+			p.Required = []string{name}
+		}
+		return p
+	}
+	ok := []spec.ResponseDef{{StatusCode: "200", Schema: &spec.Schema{Name: "Label"}}}
+
+	tests := []struct {
+		name      string
+		operation spec.Operation
+
+		wantURLParams  string
+		wantURLArgs    string
+		wantFuncParams string
+		wantResponse   bool
+		wantTypes      []string
+		wantHelpers    []string
+	}{
+		{
+			name: "path parameters",
+			operation: spec.Operation{
+				ID:         "GetLabel",
+				Path:       "/labels/{id}",
+				Parameters: []spec.Param{param("ID", "path", true)},
+				Responses:  ok,
+			},
+			wantURLParams:  "id: string",
+			wantURLArgs:    "id",
+			wantFuncParams: "id: string, options?: RequestInit",
+			wantResponse:   true,
+			wantTypes:      []string{"GetLabelResponse"},
+		},
+		{
+			name: "optional query parameters",
+			operation: spec.Operation{
+				ID:   "ListLabels",
+				Path: "/labels",
+				Parameters: []spec.Param{
+					param("Filter", "query", false),
+					param("Page", "query", false),
+					param("Sort", "query", false),
+				},
+				Responses: ok,
+			},
+			wantURLParams:  "params?: ListLabelsParams",
+			wantURLArgs:    "params",
+			wantFuncParams: "params?: ListLabelsParams, options?: RequestInit",
+			wantResponse:   true,
+			wantTypes:      []string{"ListLabelsParams", "ListLabelsResponse"},
+			wantHelpers:    []string{"appendDeepObject", "appendPage", "appendParam", "withQuery"},
+		},
+		{
+			name: "required query parameter",
+			operation: spec.Operation{
+				ID:         "SearchLabels",
+				Path:       "/labels/search",
+				Parameters: []spec.Param{param("Query", "query", true)},
+				Responses:  ok,
+			},
+			wantURLParams:  "params: SearchLabelsParams",
+			wantURLArgs:    "params",
+			wantFuncParams: "params: SearchLabelsParams, options?: RequestInit",
+			wantResponse:   true,
+			wantTypes:      []string{"SearchLabelsParams", "SearchLabelsResponse"},
+			wantHelpers:    []string{"appendParam", "withQuery"},
+		},
+		{
+			// Headers are passed to the function but not to the URL builder
+			name: "header parameter and body",
+			operation: spec.Operation{
+				ID:          "UpdateLabel",
+				Path:        "/labels/{id}",
+				Parameters:  []spec.Param{param("ID", "path", true), param("If-Match", "header", false)},
+				RequestBody: &spec.RequestBody{Schema: &spec.Schema{}, Required: true},
+				Responses:   ok,
+			},
+			wantURLParams:  "id: string",
+			wantURLArgs:    "id",
+			wantFuncParams: "id: string, body: UpdateLabelBody, params?: UpdateLabelParams, options?: RequestInit",
+			wantResponse:   true,
+			wantTypes:      []string{"UpdateLabelBody", "UpdateLabelParams", "UpdateLabelResponse"},
+		},
+		{
+			name: "no content",
+			operation: spec.Operation{
+				ID:         "DeleteLabel",
+				Path:       "/labels/{id}",
+				Parameters: []spec.Param{param("ID", "path", true)},
+				Responses:  []spec.ResponseDef{{StatusCode: "204"}},
+			},
+			wantURLParams:  "id: string",
+			wantURLArgs:    "id",
+			wantFuncParams: "id: string, options?: RequestInit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := newNativeClientOperation(&tt.operation)
+			assert.Equal(t, tt.wantURLParams, op.URLParams)
+			assert.Equal(t, tt.wantURLArgs, op.URLArgs)
+			assert.Equal(t, tt.wantFuncParams, op.FuncParams)
+			assert.Equal(t, tt.wantResponse, op.HasResponse)
+
+			types, helpers := nativeClientImports([]NativeClientOperation{op})
+			assert.Equal(t, tt.wantTypes, types)
+			assert.Equal(t, tt.wantHelpers, helpers)
+		})
+	}
+}
+
+func TestNativeClientPathExpr(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/labels", want: "`/labels`"},
+		{path: "/labels/{id}", want: "`/labels/${encodeURIComponent(String(id))}`"},
+		{
+			path: "/organizations/{organizationID}/members/{id}",
+			want: "`/organizations/${encodeURIComponent(String(organizationID))}/members/${encodeURIComponent(String(id))}`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, nativeClientPathExpr(tt.path))
+		})
+	}
+}
+
+func TestNativeClientTagDir(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{tag: "", want: "default"},
+		{tag: "Label", want: "label"},
+		{tag: "APIKey", want: "apikey"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, nativeClientTagDir(tt.tag))
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package generators
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/archesai/archesai/internal/spec"
+)
+
+// PluginProtocolVersion is the version of the JSON documents exchanged with
+// plugins. It changes whenever a field is removed or changes meaning.
+const PluginProtocolVersion = 1
+
+// DefaultPluginTimeout is how long a plugin may run when its configuration
+// sets no timeout.
+const DefaultPluginTimeout = time.Minute
+
+// PluginRequest is the JSON document written to a plugin's stdin.
+type PluginRequest struct {
+	// Version is the PluginProtocolVersion of the request.
+	Version     int    `json:"version"`
+	ProjectName string `json:"projectName"`
+	// OutputDir is the absolute project directory. The plugin runs in it and
+	// the paths it returns are relative to it.
+	OutputDir string `json:"outputDir"`
+	// SpecPath is the absolute path of the bundled OpenAPI document.
+	SpecPath string `json:"specPath"`
+	// Spec is the spec the built-in generators work from: the operations and
+	// schemas extracted from the bundled document.
+	Spec *spec.Spec `json:"spec"`
+}
+
+// PluginResponse is the JSON document a plugin writes to stdout.
+type PluginResponse struct {
+	// Version is the PluginProtocolVersion the plugin answers with. It must
+	// match the version of the request.
+	Version int          `json:"version"`
+	Files   []PluginFile `json:"files"`
+	// Error reports a failure the plugin detected itself.
+	Error string `json:"error,omitempty"`
+}
+
+// PluginFile is a file a plugin asks to write, relative to the output directory.
+type PluginFile struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+// PluginGenerator runs an external executable as a generator. The command
+// runs in the project directory, receives a PluginRequest on stdin and
+// answers with a PluginResponse on stdout. Anything written to stderr is
+// included in errors.
+type PluginGenerator struct {
+	name     string
+	command  string
+	args     []string
+	priority int
+	timeout  time.Duration
+}
+
+// NewPluginGenerator creates a generator that runs command with args and
+// stops it after timeout. A command containing a path separator is resolved
+// against the project directory, a bare name against PATH.
+func NewPluginGenerator(
+	name, command string,
+	args []string,
+	priority int,
+	timeout time.Duration,
+) *PluginGenerator {
+	if timeout <= 0 {
+		timeout = DefaultPluginTimeout
+	}
+	return &PluginGenerator{
+		name:     name,
+		command:  command,
+		args:     args,
+		priority: priority,
+		timeout:  timeout,
+	}
+}
+
+// Name returns the generator name.
+func (g *PluginGenerator) Name() string { return g.name }
+
+// Priority returns the generator priority.
+func (g *PluginGenerator) Priority() int { return g.priority }
+
+// Generate runs the plugin and writes the files it returns.
+func (g *PluginGenerator) Generate(ctx *GeneratorContext) error {
+	projectDir, err := filepath.Abs(ctx.Storage.BaseDir())
+	if err != nil {
+		return fmt.Errorf("failed to resolve project directory: %w", err)
+	}
+	specPath, err := filepath.Abs(ctx.SpecPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve spec path: %w", err)
+	}
+
+	input, err := json.Marshal(PluginRequest{
+		Version:     PluginProtocolVersion,
+		ProjectName: ctx.ProjectName,
+		OutputDir:   projectDir,
+		SpecPath:    specPath,
+		Spec:        ctx.Spec,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode plugin request: %w", err)
+	}
+
+	command := g.command
+	if strings.ContainsRune(command, '/') || strings.ContainsRune(command, filepath.Separator) {
+		if !filepath.IsAbs(command) {
+			command = filepath.Join(projectDir, command)
+		}
+	}
+
+	runCtx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(runCtx, command, g.args...)
+	cmd.Dir = projectDir
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Children left holding the output pipes do not keep the run waiting
+	cmd.WaitDelay = time.Second
+	if err := cmd.Run(); err != nil {
+		if errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("plugin %s timed out after %s", g.command, g.timeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("plugin %s failed: %w: %s", g.command, err, msg)
+		}
+		return fmt.Errorf("plugin %s failed: %w", g.command, err)
+	}
+
+	var resp PluginResponse
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return fmt.Errorf("plugin %s returned invalid JSON: %w", g.command, err)
+	}
+	if resp.Version != PluginProtocolVersion {
+		return fmt.Errorf(
+			"plugin %s answered with protocol version %d, want %d",
+			g.command, resp.Version, PluginProtocolVersion,
+		)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("plugin %s: %s", g.command, resp.Error)
+	}
+
+	for _, file := range resp.Files {
+		path := filepath.Clean(filepath.FromSlash(file.Path))
+		if file.Path == "" || filepath.IsAbs(path) || path == ".." ||
+			strings.HasPrefix(path, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("plugin %s returned path outside the output directory: %q", g.command, file.Path)
+		}
+		if IsUserOwned(path) || path == ManifestFile {
+			return fmt.Errorf("plugin %s may not write %q", g.command, file.Path)
+		}
+		if err := ctx.WriteFile(path, []byte(file.Content)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
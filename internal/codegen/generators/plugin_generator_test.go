@@ -0,0 +1,150 @@
+package generators
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/internal/spec"
+	"github.com/archesai/archesai/pkg/storage"
+)
+
+const pluginSpec = `openapi: 3.1.0
+info:
+  title: Plugins
+  version: v0.0.0
+paths: {}
+`
+
+// newPluginProject writes a project with the bundled spec and the plugin
+// script tools/plugin.sh, and returns a context generating into it.
+func newPluginProject(t *testing.T, script string) (string, *GeneratorContext) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts need a POSIX shell")
+	}
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "api", "openapi.bundled.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(specPath), 0755))
+	require.NoError(t, os.WriteFile(specPath, []byte(pluginSpec), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "tools"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tools", "plugin.sh"), []byte("#!/bin/sh\n"+script), 0755))
+
+	return dir, &GeneratorContext{
+		Spec: &spec.Spec{
+			ProjectName: "github.com/acme/todo",
+			Schemas:     []*spec.Schema{{Name: "Todo", Type: "object"}},
+		},
+		ProjectName: "github.com/acme/todo",
+		SpecPath:    specPath,
+		Storage:     storage.NewMemoryStorageWithBaseDir(dir),
+		Generator:   "docs",
+	}
+}
+
+func TestPluginGenerator(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		timeout time.Duration
+		wantErr string
+	}{
+		{
+			// The command is resolved against, and runs in, the project
+			// directory rather than the working directory of the test
+			name:   "writes files",
+			script: `cat > request.json; echo '{"version":1,"files":[{"path":"docs/api.md","content":"# API"}]}'`,
+		},
+		{
+			name:    "unversioned response",
+			script:  `echo '{"files":[]}'`,
+			wantErr: "answered with protocol version 0, want 1",
+		},
+		{
+			name:    "newer response",
+			script:  `echo '{"version":2,"files":[]}'`,
+			wantErr: "answered with protocol version 2, want 1",
+		},
+		{
+			name:    "reported error",
+			script:  `echo '{"version":1,"error":"no schemas"}'`,
+			wantErr: "plugin ./tools/plugin.sh: no schemas",
+		},
+		{
+			name:    "invalid JSON",
+			script:  `echo 'done'`,
+			wantErr: "returned invalid JSON",
+		},
+		{
+			name:    "failure with stderr",
+			script:  `echo 'missing template' >&2; exit 3`,
+			wantErr: "exit status 3: missing template",
+		},
+		{
+			name:    "path outside the output directory",
+			script:  `echo '{"version":1,"files":[{"path":"../escape.md","content":""}]}'`,
+			wantErr: `returned path outside the output directory: "../escape.md"`,
+		},
+		{
+			name:    "manifest",
+			script:  `echo '{"version":1,"files":[{"path":".archesai-manifest.json","content":"{}"}]}'`,
+			wantErr: `may not write ".archesai-manifest.json"`,
+		},
+		{
+			name:    "timeout",
+			script:  `exec sleep 5`,
+			timeout: 100 * time.Millisecond,
+			wantErr: "plugin ./tools/plugin.sh timed out after 100ms",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, ctx := newPluginProject(t, tt.script)
+			g := NewPluginGenerator("docs", "./tools/plugin.sh", nil, PriorityNormal, tt.timeout)
+
+			err := g.Generate(ctx)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			content, err := ctx.Storage.ReadFile("docs/api.md")
+			require.NoError(t, err)
+			assert.Equal(t, "# API", string(content))
+
+			data, err := os.ReadFile(filepath.Join(dir, "request.json"))
+			require.NoError(t, err)
+			var req PluginRequest
+			require.NoError(t, json.Unmarshal(data, &req))
+			assert.Equal(t, PluginProtocolVersion, req.Version)
+			assert.Equal(t, "github.com/acme/todo", req.ProjectName)
+			assert.Equal(t, dir, req.OutputDir)
+			assert.Equal(t, ctx.SpecPath, req.SpecPath)
+			require.NotNil(t, req.Spec)
+			assert.Equal(t, ctx.Spec.ProjectName, req.Spec.ProjectName)
+			require.Len(t, req.Spec.Schemas, 1)
+			assert.Equal(t, "Todo", req.Spec.Schemas[0].Name)
+		})
+	}
+}
+
+func TestPluginGeneratorCommandLookup(t *testing.T) {
+	_, ctx := newPluginProject(t, "")
+
+	// Bare names are looked up in PATH
+	g := NewPluginGenerator("docs", "sh", []string{"-c", `echo '{"version":1,"files":[{"path":"out.txt","content":"ok"}]}'`}, PriorityNormal, 0)
+	require.NoError(t, g.Generate(ctx))
+	content, err := ctx.Storage.ReadFile("out.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", string(content))
+	assert.Equal(t, DefaultPluginTimeout, g.timeout)
+}
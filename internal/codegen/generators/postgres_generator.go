@@ -28,12 +28,9 @@ func (g *PostgresGenerator) Generate(ctx *GeneratorContext) error {
 			return fmt.Errorf("failed to generate PostgreSQL queries for %s: %w", schema.Name, err)
 		}
 
-		modelImportPath, repositoryInterface := getRepositoryImportPaths(ctx, schema)
-		data := &RepositoriesTemplateData{
-			Entity:              schema,
-			ProjectName:         ctx.ProjectName,
-			ModelImportPath:     modelImportPath,
-			RepositoryInterface: repositoryInterface,
+		data, err := newRepositoriesTemplateData(ctx, schema)
+		if err != nil {
+			return err
 		}
 
 		outputPath := filepath.Join(
@@ -58,12 +55,9 @@ func generateSQLQueriesForSchema(
 	schema *spec.Schema,
 	dbType string,
 ) error {
-	modelImportPath, repositoryInterface := getRepositoryImportPaths(ctx, schema)
-	data := &RepositoriesTemplateData{
-		Entity:              schema,
-		ProjectName:         ctx.ProjectName,
-		ModelImportPath:     modelImportPath,
-		RepositoryInterface: repositoryInterface,
+	data, err := newRepositoriesTemplateData(ctx, schema)
+	if err != nil {
+		return err
 	}
 
 	outputPath := filepath.Join(
@@ -14,6 +14,7 @@ type RepositoriesTemplateData struct {
 	ProjectName         string
 	ModelImportPath     string
 	RepositoryInterface string
+	Relations           []spec.ManyToManyRelation
 }
 
 // RepositoriesGenerator generates repository interface code for entities.
@@ -28,12 +29,9 @@ func (g *RepositoriesGenerator) Priority() int { return PriorityNormal }
 // Generate creates repository interface code for each entity schema.
 func (g *RepositoriesGenerator) Generate(ctx *GeneratorContext) error {
 	for _, schema := range ctx.OwnEntitySchemas() {
-		modelImportPath, repositoryInterface := getRepositoryImportPaths(ctx, schema)
-		data := &RepositoriesTemplateData{
-			Entity:              schema,
-			ProjectName:         ctx.ProjectName,
-			ModelImportPath:     modelImportPath,
-			RepositoryInterface: repositoryInterface,
+		data, err := newRepositoriesTemplateData(ctx, schema)
+		if err != nil {
+			return err
 		}
 
 		outputPath := filepath.Join("repositories", strings.ToLower(schema.Name)+".gen.go")
@@ -48,6 +46,24 @@ func (g *RepositoriesGenerator) Generate(ctx *GeneratorContext) error {
 	return nil
 }
 
+// newRepositoriesTemplateData returns the repository template data of an
+// entity schema, failing if one of its many-to-many relations cannot be
+// generated.
+func newRepositoriesTemplateData(ctx *GeneratorContext, schema *spec.Schema) (*RepositoriesTemplateData, error) {
+	relations, err := ctx.Spec.GetManyToManyRelations(schema)
+	if err != nil {
+		return nil, err
+	}
+	modelImportPath, repositoryInterface := getRepositoryImportPaths(ctx, schema)
+	return &RepositoriesTemplateData{
+		Entity:              schema,
+		ProjectName:         ctx.ProjectName,
+		ModelImportPath:     modelImportPath,
+		RepositoryInterface: repositoryInterface,
+		Relations:           relations,
+	}, nil
+}
+
 func getRepositoryImportPaths(
 	ctx *GeneratorContext,
 	schema *spec.Schema,
@@ -39,37 +39,39 @@ func (g *RoutesGenerator) Generate(ctx *GeneratorContext) error {
 }
 
 func (g *RoutesGenerator) buildTemplateData(ctx *GeneratorContext) *RoutesTemplateData {
-	// Check for internal package (has own operations)
+	// Internal packages have operations of their own, composition apps compose
+	// other packages, and an app can do both.
 	operations := ctx.OwnOperations()
-	if len(operations) > 0 {
-		sort.Slice(operations, func(i, j int) bool {
-			return operations[i].ID < operations[j].ID
-		})
-		return &RoutesTemplateData{
-			Operations:  operations,
-			ProjectName: ctx.ProjectName,
-		}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].ID < operations[j].ID
+	})
+	var internalPackages []InternalPackage
+	if !ctx.IsInternalPackage() {
+		internalPackages = composedInternalPackages(ctx)
+	}
+	if len(operations) == 0 && len(internalPackages) == 0 {
+		return nil
+	}
+	return &RoutesTemplateData{
+		Operations:       operations,
+		ProjectName:      ctx.ProjectName,
+		InternalPackages: internalPackages,
 	}
+}
 
-	// Check for composition app (composes other packages)
-	composedPkgs := ctx.ComposedPackages()
-	if len(composedPkgs) > 0 {
-		var internalPackages []InternalPackage
-		for _, pkgName := range composedPkgs {
-			internalPackages = append(internalPackages, InternalPackage{
-				Name:       pkgName,
-				Alias:      pkgName,
-				ImportPath: InternalPackageImportPath(pkgName),
-			})
-		}
-		sort.Slice(internalPackages, func(i, j int) bool {
-			return internalPackages[i].Name < internalPackages[j].Name
+// composedInternalPackages returns the packages the app composes, sorted by
+// name.
+func composedInternalPackages(ctx *GeneratorContext) []InternalPackage {
+	var internalPackages []InternalPackage
+	for _, pkgName := range ctx.ComposedPackages() {
+		internalPackages = append(internalPackages, InternalPackage{
+			Name:       pkgName,
+			Alias:      pkgName,
+			ImportPath: InternalPackageImportPath(pkgName),
 		})
-		return &RoutesTemplateData{
-			ProjectName:      ctx.ProjectName,
-			InternalPackages: internalPackages,
-		}
 	}
-
-	return nil
+	sort.Slice(internalPackages, func(i, j int) bool {
+		return internalPackages[i].Name < internalPackages[j].Name
+	})
+	return internalPackages
 }
@@ -0,0 +1,68 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/internal/spec"
+)
+
+func TestRoutesTemplateData(t *testing.T) {
+	server := InternalPackage{
+		Name:       "server",
+		Alias:      "server",
+		ImportPath: InternalPackageImportPath("server"),
+	}
+	tests := []struct {
+		name        string
+		projectName string
+		operations  []spec.Operation
+		wantOps     []string
+		wantPkgs    []InternalPackage
+	}{
+		{
+			name:        "internal package",
+			projectName: "github.com/archesai/archesai/pkg/storage",
+			operations: []spec.Operation{
+				{ID: "ListArtifacts", XInternal: "storage"},
+				{ID: "GetHealth", XInternal: "server"},
+			},
+			wantOps: []string{"ListArtifacts"},
+		},
+		{
+			name:        "composition app",
+			projectName: "github.com/archesai/archesai/apps/studio",
+			operations:  []spec.Operation{{ID: "GetHealth", XInternal: "server"}},
+			wantPkgs:    []InternalPackage{server},
+		},
+		{
+			name:        "app with operations of its own",
+			projectName: "github.com/archesai/examples/basic",
+			operations: []spec.Operation{
+				{ID: "GetTodo"},
+				{ID: "GetHealth", XInternal: "server"},
+			},
+			wantOps:  []string{"GetTodo"},
+			wantPkgs: []InternalPackage{server},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &GeneratorContext{
+				ProjectName: tt.projectName,
+				Spec:        &spec.Spec{Operations: tt.operations},
+			}
+			data := (&RoutesGenerator{}).buildTemplateData(ctx)
+			require.NotNil(t, data)
+			var ops []string
+			for _, op := range data.Operations {
+				ops = append(ops, op.ID)
+			}
+			assert.Equal(t, tt.wantOps, ops)
+			assert.Equal(t, tt.wantPkgs, data.InternalPackages)
+		})
+	}
+}
@@ -5,16 +5,35 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	sqlc "github.com/sqlc-dev/sqlc/pkg/cli"
 
+	"github.com/archesai/archesai/internal/spec"
+	"github.com/archesai/archesai/internal/strutil"
 	"github.com/archesai/archesai/pkg/storage"
 )
 
 // SQLCGenerator generates type-safe Go code from SQL queries using sqlc.
 type SQLCGenerator struct{}
 
+// SQLCTemplateData is the data of the sqlc configuration template.
+type SQLCTemplateData struct {
+	OutputDir   string
+	JSONColumns []SQLCJSONColumn
+}
+
+// SQLCJSONColumn overrides the Go type of a jsonb column that stores a
+// model, so that sqlc reads and writes the model instead of raw bytes.
+type SQLCJSONColumn struct {
+	Column  string // Qualified column, e.g. "pipeline_step.config"
+	Import  string // Import path of the models package
+	Package string // Name the models package is imported as
+	Type    string // Go type of the model, e.g. "StepConfig"
+	Pointer bool   // The column is nullable
+}
+
 // Name returns the generator name.
 func (g *SQLCGenerator) Name() string { return "sqlc" }
 
@@ -24,13 +43,16 @@ func (g *SQLCGenerator) Priority() int { return PriorityFinal }
 // Generate runs sqlc to generate Go code from SQL queries.
 func (g *SQLCGenerator) Generate(ctx *GeneratorContext) error {
 	// Generate sqlc.gen.yaml for postgres
-	data := map[string]string{"OutputDir": ctx.Storage.BaseDir()}
+	data := SQLCTemplateData{
+		OutputDir:   ctx.Storage.BaseDir(),
+		JSONColumns: sqlcJSONColumns(ctx),
+	}
 	var buf bytes.Buffer
 	if err := ctx.Renderer.Render(&buf, "sqlc_postgres.yaml.tmpl", data); err != nil {
 		return fmt.Errorf("failed to render sqlc.yaml: %w", err)
 	}
 	sqlcPath := filepath.Join("infrastructure", "postgres", "sqlc.gen.yaml")
-	if err := ctx.Storage.WriteFile(sqlcPath, buf.Bytes(), 0644); err != nil {
+	if err := ctx.WriteFile(sqlcPath, buf.Bytes()); err != nil {
 		return fmt.Errorf("failed to write sqlc.yaml: %w", err)
 	}
 
@@ -46,7 +68,7 @@ import "embed"
 var Migrations embed.FS
 `, dbName)
 		path := filepath.Join("infrastructure", dbName, "migrations.gen.go")
-		if err := ctx.Storage.WriteFile(path, []byte(content), 0644); err != nil {
+		if err := ctx.WriteFile(path, []byte(content)); err != nil {
 			return fmt.Errorf("failed to write migrations.gen.go: %w", err)
 		}
 	}
@@ -99,3 +121,39 @@ var Migrations embed.FS
 
 	return nil
 }
+
+// sqlcJSONColumns returns the overrides of the entity properties that hold
+// a model in a jsonb column.
+func sqlcJSONColumns(ctx *GeneratorContext) []SQLCJSONColumn {
+	var columns []SQLCJSONColumn
+	for _, schema := range ctx.Spec.Schemas {
+		if schema.XCodegenSchemaType != spec.XCodegenSchemaTypeEntity {
+			continue
+		}
+		modelImportPath, _ := getRepositoryImportPaths(ctx, schema)
+		// Models of internal packages are imported under the package name,
+		// as in the composed app
+		modelPackage := "models"
+		if modelImportPath != ctx.ProjectName+"/models" {
+			modelPackage = schema.XInternal + "models"
+		}
+		for _, prop := range schema.GetSortedProperties() {
+			// Free-form objects keep their map type
+			if prop.Type != spec.SchemaTypeObject || strings.HasPrefix(prop.GoType, "map[") ||
+				prop.GoType == "any" {
+				continue
+			}
+			columns = append(columns, SQLCJSONColumn{
+				Column:  strutil.SnakeCase(schema.Name) + "." + strutil.SnakeCase(prop.Name),
+				Import:  modelImportPath,
+				Package: modelPackage,
+				Type:    prop.GoType,
+				Pointer: prop.NeedsPointer(),
+			})
+		}
+	}
+	slices.SortFunc(columns, func(a, b SQLCJSONColumn) int {
+		return strings.Compare(a.Column, b.Column)
+	})
+	return columns
+}
@@ -34,12 +34,9 @@ func (g *SQLiteGenerator) Generate(ctx *GeneratorContext) error {
 			return fmt.Errorf("failed to generate SQLite queries for %s: %w", schema.Name, err)
 		}
 
-		modelImportPath, repositoryInterface := getRepositoryImportPaths(ctx, schema)
-		data := &RepositoriesTemplateData{
-			Entity:              schema,
-			ProjectName:         ctx.ProjectName,
-			ModelImportPath:     modelImportPath,
-			RepositoryInterface: repositoryInterface,
+		data, err := newRepositoriesTemplateData(ctx, schema)
+		if err != nil {
+			return err
 		}
 
 		outputPath := filepath.Join(
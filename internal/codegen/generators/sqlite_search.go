@@ -0,0 +1,217 @@
+package generators
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/schema"
+
+	"github.com/archesai/archesai/internal/spec"
+	"github.com/archesai/archesai/internal/strutil"
+	"github.com/archesai/archesai/pkg/database"
+)
+
+// Atlas cannot describe virtual tables or triggers on SQLite, so the FTS5
+// table indexing a searchable table and the triggers keeping it in sync are
+// kept out of the HCL schema. The migration generator compares them with the
+// search objects of the replayed migrations itself, and recreates them along
+// with the changes Atlas plans.
+
+// searchObject is an FTS5 table or trigger of a searchable SQLite table.
+type searchObject struct {
+	Type string // "table" or "trigger"
+	Name string
+	SQL  string
+}
+
+// drop returns the statement dropping the object.
+func (o searchObject) drop() string {
+	if o.Type == "trigger" {
+		return fmt.Sprintf("DROP TRIGGER IF EXISTS %q", o.Name)
+	}
+	return fmt.Sprintf("DROP TABLE IF EXISTS %q", o.Name)
+}
+
+// sqliteSearchObjects returns the search objects of each searchable entity,
+// keyed by table.
+func sqliteSearchObjects(entities []*spec.Schema) (map[string][]searchObject, error) {
+	objects := make(map[string][]searchObject)
+	for _, entity := range entities {
+		fields, err := entity.GetSearchableFields()
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		columns := make([]string, len(fields))
+		for i, field := range fields {
+			columns[i] = strutil.SnakeCase(field.Name)
+		}
+		table := strutil.SnakeCase(entity.Name)
+		objects[table] = searchObjectsFor(table, columns)
+	}
+	return objects, nil
+}
+
+// searchObjectsFor returns the external content FTS5 table indexing columns
+// of table and the triggers that keep it in sync.
+func searchObjectsFor(table string, columns []string) []searchObject {
+	fts := database.SearchTable(table)
+	quoted := make([]string, len(columns))
+	oldValues := make([]string, len(columns))
+	newValues := make([]string, len(columns))
+	for i, col := range columns {
+		quoted[i] = fmt.Sprintf("%q", col)
+		oldValues[i] = "old." + quoted[i]
+		newValues[i] = "new." + quoted[i]
+	}
+	cols := strings.Join(quoted, ", ")
+	insert := fmt.Sprintf(
+		"  INSERT INTO %q (rowid, %s) VALUES (new.rowid, %s);\n",
+		fts, cols, strings.Join(newValues, ", "),
+	)
+	remove := fmt.Sprintf(
+		"  INSERT INTO %[1]q (%[1]q, rowid, %[2]s) VALUES ('delete', old.rowid, %[3]s);\n",
+		fts, cols, strings.Join(oldValues, ", "),
+	)
+	trigger := func(suffix, event, body string) searchObject {
+		name := fts + "_" + suffix
+		return searchObject{
+			Type: "trigger",
+			Name: name,
+			SQL:  fmt.Sprintf("CREATE TRIGGER %q AFTER %s ON %q BEGIN\n%sEND", name, event, table, body),
+		}
+	}
+	return []searchObject{
+		{
+			Type: "table",
+			Name: fts,
+			SQL: fmt.Sprintf(
+				"CREATE VIRTUAL TABLE %q USING fts5(%s, content='%s', content_rowid='rowid', tokenize='porter unicode61')",
+				fts, cols, table,
+			),
+		},
+		trigger("ai", "INSERT", insert),
+		trigger("ad", "DELETE", remove),
+		trigger("au", "UPDATE", remove+insert),
+	}
+}
+
+// inspectSearchObjects returns the FTS5 tables of db and the triggers named
+// after them, keyed by the table they index. Objects of a table come in the
+// order searchObjectsFor creates them.
+func inspectSearchObjects(ctx context.Context, db *sql.DB) (map[string][]searchObject, error) {
+	rows, err := db.QueryContext(ctx, `SELECT type, name, tbl_name, sql FROM sqlite_master
+		WHERE (type = 'table' AND sql LIKE 'CREATE VIRTUAL TABLE%USING fts5%')
+		OR (type = 'trigger' AND name LIKE '%\_fts\_a_' ESCAPE '\')
+		ORDER BY type, name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect search tables: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	objects := make(map[string][]searchObject)
+	for rows.Next() {
+		var o searchObject
+		var table string
+		if err := rows.Scan(&o.Type, &o.Name, &table, &o.SQL); err != nil {
+			return nil, fmt.Errorf("failed to inspect search tables: %w", err)
+		}
+		if o.Type == "table" {
+			table = strings.TrimSuffix(o.Name, "_fts")
+		} else if !strings.HasPrefix(o.Name, database.SearchTable(table)+"_") {
+			continue // A trigger of the user's own
+		}
+		objects[table] = append(objects[table], o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to inspect search tables: %w", err)
+	}
+	// The table first, then the triggers in the order they are created
+	order := func(o searchObject) int {
+		return strings.Index("_ai_ad_au", o.Name[strings.LastIndex(o.Name, "_"):])
+	}
+	for _, objs := range objects {
+		sort.SliceStable(objs, func(i, j int) bool { return order(objs[i]) < order(objs[j]) })
+	}
+	return objects, nil
+}
+
+// sqliteVirtualTables returns the patterns matching the virtual tables of db
+// and their shadow tables, which Atlas cannot inspect.
+func sqliteVirtualTables(ctx context.Context, db *sql.DB) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `SELECT name FROM pragma_table_list WHERE schema = 'main' AND type = 'virtual'`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual tables: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var patterns []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to list virtual tables: %w", err)
+		}
+		patterns = append(patterns, name, name+"_*")
+	}
+	return patterns, rows.Err()
+}
+
+// planSearchChanges adds the changes that take the search objects from those
+// in from to those in to to plan. The objects of a table are dropped before
+// the planned changes and created after them, then its index is rebuilt,
+// whenever they differ or the plan rebuilds the table, which drops its
+// triggers and renumbers its rows.
+func planSearchChanges(plan *migrate.Plan, from, to map[string][]searchObject) {
+	rebuilt := make(map[string]bool)
+	for _, c := range plan.Changes {
+		if modify, ok := c.Source.(*schema.ModifyTable); ok && strings.HasPrefix(c.Cmd, "DROP TABLE") {
+			rebuilt[modify.T.Name] = true
+		}
+	}
+
+	tables := make([]string, 0, len(from)+len(to))
+	for table := range from {
+		tables = append(tables, table)
+	}
+	for table := range to {
+		if _, ok := from[table]; !ok {
+			tables = append(tables, table)
+		}
+	}
+	sort.Strings(tables)
+
+	var before, after []*migrate.Change
+	for _, table := range tables {
+		if !rebuilt[table] && slices.Equal(from[table], to[table]) {
+			continue
+		}
+		objs := from[table]
+		for i := len(objs) - 1; i >= 0; i-- {
+			before = append(before, &migrate.Change{
+				Cmd:     objs[i].drop(),
+				Comment: fmt.Sprintf("drop search %s %q", objs[i].Type, objs[i].Name),
+			})
+		}
+		for _, o := range to[table] {
+			after = append(after, &migrate.Change{
+				Cmd:     o.SQL,
+				Comment: fmt.Sprintf("create search %s %q", o.Type, o.Name),
+			})
+		}
+		if len(to[table]) > 0 {
+			fts := database.SearchTable(table)
+			after = append(after, &migrate.Change{
+				Cmd:     fmt.Sprintf("INSERT INTO %[1]q (%[1]q) VALUES ('rebuild')", fts),
+				Comment: fmt.Sprintf("index the rows of %q", table),
+			})
+		}
+	}
+	plan.Changes = append(append(before, plan.Changes...), after...)
+}
@@ -7,4 +7,11 @@ type Options struct {
 	Lint       bool
 	Only       string
 	TUI        bool
+	// DryRun renders into memory and prints a unified diff against disk.
+	DryRun bool
+	// Check fails when any generated file differs from disk. Nothing is written.
+	Check bool
+	// AllowDestructive lets generated migrations drop, retype or tighten
+	// columns without an x-codegen allowDestructive attribute.
+	AllowDestructive bool
 }
@@ -25,6 +25,8 @@ type Orchestrator struct {
 	generators       []generators.Generator
 	onlyFilter       map[string]bool
 	progressCallback ProgressCallback
+	templateOptions  templates.LoadOptions
+	orphans          []string
 }
 
 // NewOrchestrator creates a new code generator instance.
@@ -43,6 +45,40 @@ func (o *Orchestrator) WithStorage(s storage.Storage) *Orchestrator {
 	return o
 }
 
+// WithGenerators registers additional generators, such as external plugins.
+// Names must not collide with registered generators.
+func (o *Orchestrator) WithGenerators(gens ...generators.Generator) (*Orchestrator, error) {
+	for _, g := range gens {
+		for _, existing := range o.generators {
+			if existing.Name() == g.Name() {
+				return nil, fmt.Errorf("generator %q is already registered", g.Name())
+			}
+		}
+		o.generators = append(o.generators, g)
+	}
+	return o, nil
+}
+
+// WithReplacedGenerator swaps the registered generator with the same name
+// for g, such as an alternative implementation selected by configuration.
+func (o *Orchestrator) WithReplacedGenerator(g generators.Generator) *Orchestrator {
+	for i, existing := range o.generators {
+		if existing.Name() == g.Name() {
+			o.generators[i] = g
+			return o
+		}
+	}
+	o.generators = append(o.generators, g)
+	return o
+}
+
+// WithTemplateOptions sets the template overrides and extra templates
+// applied on top of the embedded templates by Initialize.
+func (o *Orchestrator) WithTemplateOptions(opts templates.LoadOptions) *Orchestrator {
+	o.templateOptions = opts
+	return o
+}
+
 // WithOnly sets which generators to run (comma-separated names).
 func (o *Orchestrator) WithOnly(only string) *Orchestrator {
 	o.onlyFilter = make(map[string]bool)
@@ -62,6 +98,13 @@ func (o *Orchestrator) WithProgress(callback ProgressCallback) *Orchestrator {
 	return o
 }
 
+// Orphans returns the generated files of the last run that are no longer
+// produced. They are removed from disk unless the storage is in memory or
+// the file was edited after it was generated.
+func (o *Orchestrator) Orphans() []string {
+	return o.orphans
+}
+
 // GetStorage returns the current storage implementation.
 func (o *Orchestrator) GetStorage() storage.Storage {
 	return o.storage
@@ -69,7 +112,7 @@ func (o *Orchestrator) GetStorage() storage.Storage {
 
 // Initialize sets up the generator with templates and renderer.
 func (o *Orchestrator) Initialize() error {
-	template, err := templates.LoadTemplates()
+	template, err := templates.LoadTemplatesWith(o.templateOptions)
 	if err != nil {
 		return fmt.Errorf("failed to load templates: %w", err)
 	}
@@ -106,15 +149,66 @@ func (o *Orchestrator) Generate(specPath string) error {
 		slog.String("project", spec.ProjectName),
 		"duration", time.Since(totalStart))
 
+	// The previous manifest is read from disk so dry runs compare against
+	// what is actually there.
+	previous, err := generators.ReadManifest(storage.NewDiskStorage(o.storage.BaseDir()))
+	if err != nil {
+		return err
+	}
+
 	ctx := &generators.GeneratorContext{
 		Spec:        spec,
 		SpecPath:    absSpecPath,
 		Renderer:    o.renderer,
 		Storage:     o.storage,
 		ProjectName: spec.ProjectName,
+		Manifest:    generators.NewManifest(),
 	}
 
-	return o.runGenerators(ctx)
+	if err := o.runGenerators(ctx); err != nil {
+		return err
+	}
+
+	return o.updateManifest(previous, ctx.Manifest)
+}
+
+// updateManifest carries over the entries of generators that did not run,
+// removes the files that generators which did run no longer produce, and
+// writes the new manifest.
+func (o *Orchestrator) updateManifest(previous, current *generators.Manifest) error {
+	_, isMemory := o.storage.(*storage.MemoryStorage)
+	disk := storage.NewDiskStorage(o.storage.BaseDir())
+	o.orphans = nil
+
+	for _, entry := range previous.Entries() {
+		if _, ok := current.Get(entry.Path); ok || generators.IsUserOwned(entry.Path) {
+			continue
+		}
+		if !o.shouldRun(entry.Generator) {
+			current.Record(entry.Path, entry.Hash, entry.Generator)
+			continue
+		}
+
+		content, err := disk.ReadFile(entry.Path)
+		if err != nil {
+			continue // Already gone
+		}
+		o.orphans = append(o.orphans, entry.Path)
+		if isMemory {
+			continue
+		}
+		if generators.HashContent(content) != entry.Hash {
+			slog.Warn("Orphaned file was modified since generation, not removing",
+				slog.String("path", entry.Path))
+			continue
+		}
+		if err := o.storage.Remove(entry.Path); err != nil {
+			return fmt.Errorf("failed to remove orphaned file %s: %w", entry.Path, err)
+		}
+		slog.Info("Removed orphaned file", slog.String("path", entry.Path))
+	}
+
+	return current.Write(o.storage)
 }
 
 // emitProgress sends a progress event if a callback is registered.
@@ -224,9 +318,12 @@ func (o *Orchestrator) runGroup(
 			TotalCount:    totalCount,
 		})
 
+		genCtx := *ctx
+		genCtx.Generator = g.Name()
+
 		eg.Go(func() error {
 			start := time.Now()
-			if err := g.Generate(ctx); err != nil {
+			if err := g.Generate(&genCtx); err != nil {
 				return fmt.Errorf("%s: %w", g.Name(), err)
 			}
 			slog.Debug("Generator completed",
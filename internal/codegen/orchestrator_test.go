@@ -0,0 +1,165 @@
+package codegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/internal/codegen/generators"
+	"github.com/archesai/archesai/pkg/storage"
+)
+
+func TestOrchestratorUpdateManifest(t *testing.T) {
+	const content = "package models\n"
+
+	tests := []struct {
+		name string
+		// path is recorded in the previous manifest by generator, and
+		// written to disk with content unless missing is set
+		path      string
+		generator string
+		content   string
+		missing   bool
+		// memory generates into memory storage, as dry runs do
+		memory bool
+
+		wantOrphan   bool
+		wantOnDisk   bool
+		wantRecorded bool
+	}{
+		{
+			name:       "unchanged orphan is removed",
+			path:       "models/old.gen.go",
+			generator:  "models",
+			content:    content,
+			wantOrphan: true,
+		},
+		{
+			name:       "edited orphan is kept",
+			path:       "models/old.gen.go",
+			generator:  "models",
+			content:    content + "// edited\n",
+			wantOrphan: true,
+			wantOnDisk: true,
+		},
+		{
+			name:       "dry run only reports the orphan",
+			path:       "models/old.gen.go",
+			generator:  "models",
+			content:    content,
+			memory:     true,
+			wantOrphan: true,
+			wantOnDisk: true,
+		},
+		{
+			name:      "orphan already gone",
+			path:      "models/old.gen.go",
+			generator: "models",
+			missing:   true,
+		},
+		{
+			// Generators excluded by --only keep their files
+			name:         "generator that did not run",
+			path:         "routes/old.gen.go",
+			generator:    "routes",
+			content:      content,
+			wantOnDisk:   true,
+			wantRecorded: true,
+		},
+		{
+			name:       "user-owned file",
+			path:       "handlers/create.impl.go",
+			generator:  "models",
+			content:    content,
+			wantOnDisk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if !tt.missing {
+				full := filepath.Join(dir, tt.path)
+				require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+				require.NoError(t, os.WriteFile(full, []byte(tt.content), 0644))
+			}
+
+			previous := generators.NewManifest()
+			previous.Record(tt.path, generators.HashContent([]byte(content)), tt.generator)
+			current := generators.NewManifest()
+			current.Record("models/user.gen.go", generators.HashContent([]byte(content)), "models")
+
+			o := NewOrchestrator(dir).WithOnly("models")
+			if tt.memory {
+				o = o.WithStorage(storage.NewMemoryStorageWithBaseDir(dir))
+			}
+			require.NoError(t, o.updateManifest(previous, current))
+
+			if tt.wantOrphan {
+				assert.Equal(t, []string{tt.path}, o.Orphans())
+			} else {
+				assert.Empty(t, o.Orphans())
+			}
+			_, err := os.Stat(filepath.Join(dir, tt.path))
+			assert.Equal(t, tt.wantOnDisk, err == nil, "file on disk")
+
+			written, err := generators.ReadManifest(o.GetStorage())
+			require.NoError(t, err)
+			_, recorded := written.Get(tt.path)
+			assert.Equal(t, tt.wantRecorded, recorded, "file in manifest")
+			_, ok := written.Get("models/user.gen.go")
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []generators.ManifestEntry
+		wantErr string
+	}{
+		{
+			name: "missing",
+		},
+		{
+			name: "entries",
+			data: `{"version":1,"files":[{"path":"a.go","hash":"sha256:00","generator":"models"}]}`,
+			want: []generators.ManifestEntry{{Path: "a.go", Hash: "sha256:00", Generator: "models"}},
+		},
+		{
+			name:    "other version",
+			data:    `{"version":2,"files":[]}`,
+			wantErr: "unsupported .archesai-manifest.json version 2",
+		},
+		{
+			name:    "invalid",
+			data:    `{`,
+			wantErr: "failed to parse .archesai-manifest.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := storage.NewMemoryStorage()
+			if tt.data != "" {
+				require.NoError(t, s.WriteFile(generators.ManifestFile, []byte(tt.data), 0644))
+			}
+			m, err := generators.ReadManifest(s)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Empty(t, m.Entries())
+			} else {
+				assert.Equal(t, tt.want, m.Entries())
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package codegen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/storage"
+)
+
+// relationSpec is the format of a spec whose Todo entity declares a
+// relation and whose Tag entity has the given repository options.
+const relationSpec = `openapi: 3.1.0
+x-project-name: github.com/acme/todo
+info:
+  title: Todo
+  version: 1.0.0
+components:
+  schemas:
+    Todo:
+      title: Todo
+      x-codegen-schema-type: entity
+      x-codegen:
+        repository:
+          relations:
+%[1]s
+      type: object
+      required: [id, title]
+      properties:
+        id:
+          type: string
+          format: uuid
+        title:
+          type: string
+    Tag:
+      title: Tag
+      x-codegen-schema-type: entity
+      x-codegen:
+        repository: %[2]s
+      type: object
+      required: [id, organizationID, name]
+      properties:
+        id:
+          type: string
+          format: uuid
+        organizationID:
+          type: string
+          format: uuid
+        name:
+          type: string
+paths: {}
+`
+
+func TestGenerateManyToManyRelations(t *testing.T) {
+	tests := []struct {
+		name     string
+		relation string
+		tag      string
+
+		// want maps generated files to fragments they must contain
+		want    map[string][]string
+		notWant map[string][]string
+		wantErr string
+	}{
+		{
+			name: "join table named after the relation",
+			relation: `            - field: tags
+              kind: manyToMany
+              references: tag`,
+			want: map[string][]string{
+				"repositories/todo.gen.go": {
+					"AddTag(ctx context.Context, id uuid.UUID, tagID uuid.UUID) error",
+					"RemoveTag(ctx context.Context, id uuid.UUID, tagID uuid.UUID) error",
+					"ListTags(ctx context.Context, id uuid.UUID) ([]*models.Tag, error)",
+				},
+				"infrastructure/postgres/queries/todos.gen.sql": {
+					"-- name: AddTodoTag :execrows",
+					"todo_tags (todo_id, tag_id)",
+					"-- name: RemoveTodoTag :exec",
+					"-- name: ListTodoTags :many",
+				},
+				"infrastructure/sqlite/queries/todos.gen.sql": {"todo_tags (todo_id, tag_id)"},
+			},
+			notWant: map[string][]string{
+				"infrastructure/postgres/queries/todos.gen.sql": {"sqlc.arg('tenant_id')", "r.deleted_at IS NULL"},
+			},
+		},
+		{
+			name: "join table named by through",
+			relation: `            - field: tags
+              kind: manyToMany
+              references: tag
+              through: todo_tagging`,
+			want: map[string][]string{
+				"infrastructure/postgres/queries/todos.gen.sql": {"todo_tagging (todo_id, tag_id)"},
+			},
+		},
+		{
+			// Tags of other organizations and deleted tags cannot be
+			// associated
+			name: "tenant-scoped related entity",
+			relation: `            - field: tags
+              kind: manyToMany
+              references: tag`,
+			tag: "{tenantField: organizationID, softDelete: true}",
+			want: map[string][]string{
+				"infrastructure/postgres/queries/todos.gen.sql": {
+					"AND r.organization_id = sqlc.arg('tenant_id')",
+					"AND r.deleted_at IS NULL",
+				},
+			},
+		},
+		{
+			name: "self-referencing relation",
+			relation: `            - field: related
+              kind: manyToMany
+              references: todo`,
+			want: map[string][]string{
+				"repositories/todo.gen.go": {"ListRelated(ctx context.Context, id uuid.UUID) ([]*models.Todo, error)"},
+				"infrastructure/postgres/queries/todos.gen.sql": {
+					"todo_related (todo_id, related_todo_id)",
+				},
+			},
+		},
+		{
+			name: "unknown related entity",
+			relation: `            - field: labels
+              kind: manyToMany
+              references: label`,
+			wantErr: `relation Labels of Todo references "label", which is not an entity of the spec`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			tag := tt.tag
+			if tag == "" {
+				tag = "{}"
+			}
+			specPath := filepath.Join(dir, "openapi.yaml")
+			content := fmt.Sprintf(relationSpec, tt.relation, tag)
+			require.NoError(t, os.WriteFile(specPath, []byte(content), 0644))
+
+			generated := storage.NewMemoryStorageWithBaseDir(dir)
+			o := NewOrchestrator(dir).WithStorage(generated).WithOnly("repositories,postgres,sqlite")
+			require.NoError(t, o.Initialize())
+			err := o.Generate(specPath)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for path, fragments := range tt.want {
+				content, err := generated.ReadFile(path)
+				require.NoError(t, err)
+				for _, fragment := range fragments {
+					assert.Contains(t, string(content), fragment, path)
+				}
+			}
+			for path, fragments := range tt.notWant {
+				content, err := generated.ReadFile(path)
+				require.NoError(t, err)
+				for _, fragment := range fragments {
+					assert.NotContains(t, string(content), fragment, path)
+				}
+			}
+		})
+	}
+}
@@ -2,9 +2,17 @@ package codegen
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/archesai/archesai/internal/codegen/generators"
 	"github.com/archesai/archesai/internal/openapi"
+	"github.com/archesai/archesai/internal/templates"
+	"github.com/archesai/archesai/pkg/config"
+	configmodels "github.com/archesai/archesai/pkg/config/models"
+	"github.com/archesai/archesai/pkg/storage"
 )
 
 // PreparedGeneration contains the bundled spec path and configured orchestrator
@@ -12,20 +20,44 @@ import (
 type PreparedGeneration struct {
 	Orchestrator *Orchestrator
 	BundledPath  string
+	// tempDir holds the bundled spec of dry runs
+	tempDir string
+}
+
+// Cleanup removes the temporary directory the spec of a dry run was bundled
+// into. It does nothing for other runs.
+func (p *PreparedGeneration) Cleanup() {
+	if p.tempDir != "" {
+		_ = os.RemoveAll(p.tempDir)
+	}
 }
 
 // prepareGeneration bundles the OpenAPI spec and configures the orchestrator.
 // This shared function is used by both Run() and RunTUI() to avoid duplication.
-func prepareGeneration(opts Options) (*PreparedGeneration, error) {
+// The caller cleans up the returned generation.
+func prepareGeneration(opts Options) (_ *PreparedGeneration, err error) {
 	// Parse spec
 	parser := openapi.NewParser()
-	_, err := parser.Parse(opts.SpecPath)
+	_, err = parser.Parse(opts.SpecPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse OpenAPI spec: %w", err)
 	}
 
-	// Write bundled spec to file (Parse already bundles internally)
+	// Write bundled spec to file (Parse already bundles internally).
+	// Dry runs bundle into a temporary directory so nothing on disk changes.
 	dir := filepath.Dir(opts.SpecPath)
+	var tempDir string
+	if opts.DryRun || opts.Check {
+		if tempDir, err = os.MkdirTemp("", "archesai-bundle-"); err != nil {
+			return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				_ = os.RemoveAll(tempDir)
+			}
+		}()
+		dir = tempDir
+	}
 	bundledPath := filepath.Join(dir, "openapi.bundled.yaml")
 	_, err = parser.RenderToFile(bundledPath)
 	if err != nil {
@@ -34,22 +66,90 @@ func prepareGeneration(opts Options) (*PreparedGeneration, error) {
 
 	// Setup orchestrator
 	orch := NewOrchestrator(opts.OutputPath)
+	if opts.DryRun || opts.Check {
+		orch = orch.WithStorage(storage.NewMemoryStorageWithBaseDir(opts.OutputPath))
+	}
 	if opts.Only != "" {
 		orch = orch.WithOnly(opts.Only)
 	}
 
+	codegenCfg, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	if codegenCfg.Templates != nil && *codegenCfg.Templates != "" {
+		orch = orch.WithTemplateOptions(templates.LoadOptions{OverrideDir: *codegenCfg.Templates})
+	}
+	if codegenCfg.Client != nil && *codegenCfg.Client == configmodels.CodegenConfigClientNative {
+		orch = orch.WithReplacedGenerator(&generators.NativeClientGenerator{})
+	}
+	hcl := &generators.HCLGenerator{AllowDestructive: opts.AllowDestructive}
+	if codegenCfg.Migrations != nil {
+		if !codegenCfg.Migrations.IsValid() {
+			return nil, fmt.Errorf("invalid codegen.migrations: %s", *codegenCfg.Migrations)
+		}
+		hcl.MigrationMode = generators.MigrationMode(*codegenCfg.Migrations)
+	}
+	if codegenCfg.DevURL != nil {
+		hcl.DevURL = *codegenCfg.DevURL
+	}
+	orch = orch.WithReplacedGenerator(hcl)
+	plugins, err := pluginGenerators(codegenCfg)
+	if err != nil {
+		return nil, err
+	}
+	if orch, err = orch.WithGenerators(plugins...); err != nil {
+		return nil, err
+	}
+
 	return &PreparedGeneration{
 		Orchestrator: orch,
 		BundledPath:  bundledPath,
+		tempDir:      tempDir,
 	}, nil
 }
 
+// LoadConfig reads the codegen section of the configuration file.
+// It returns an empty section when none is configured.
+func LoadConfig() (*configmodels.CodegenConfig, error) {
+	cfg, err := config.NewParser[configmodels.Config]().Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.Config.Codegen == nil {
+		return &configmodels.CodegenConfig{}, nil
+	}
+	return cfg.Config.Codegen, nil
+}
+
+// pluginGenerators creates the external generators listed under
+// codegen.generators.
+func pluginGenerators(cfg *configmodels.CodegenConfig) ([]generators.Generator, error) {
+	var plugins []generators.Generator
+	for _, p := range cfg.Generators {
+		if p.Name == "" || p.Command == "" {
+			return nil, fmt.Errorf("codegen generator requires a name and a command")
+		}
+		priority := generators.PriorityNormal
+		if p.Priority != nil {
+			priority = int(*p.Priority)
+		}
+		var timeout time.Duration
+		if p.TimeoutSeconds != nil {
+			timeout = time.Duration(*p.TimeoutSeconds) * time.Second
+		}
+		plugins = append(plugins, generators.NewPluginGenerator(p.Name, p.Command, p.Args, priority, timeout))
+	}
+	return plugins, nil
+}
+
 // Run executes code generation with standard output.
 func Run(opts Options) error {
 	prep, err := prepareGeneration(opts)
 	if err != nil {
 		return err
 	}
+	defer prep.Cleanup()
 
 	if err := prep.Orchestrator.Initialize(); err != nil {
 		return fmt.Errorf("failed to initialize code generator: %w", err)
@@ -59,5 +159,48 @@ func Run(opts Options) error {
 		return fmt.Errorf("code generation failed: %w", err)
 	}
 
+	if opts.DryRun || opts.Check {
+		return reportDryRun(os.Stdout, opts, prep.Orchestrator.GetStorage(), prep.Orchestrator.Orphans())
+	}
+
+	return nil
+}
+
+// reportDryRun compares the files rendered into memory with the output
+// directory. With DryRun it prints a unified diff, with Check it lists the
+// stale files and fails if there are any. The report is written to w.
+func reportDryRun(w io.Writer, opts Options, generated storage.Storage, orphans []string) error {
+	mem, ok := generated.(*storage.MemoryStorage)
+	if !ok {
+		return fmt.Errorf("dry run requires memory storage, got %T", generated)
+	}
+
+	diffs, err := DiffStorage(mem, storage.NewDiskStorage(opts.OutputPath), orphans)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range diffs {
+		if opts.DryRun {
+			_, _ = fmt.Fprint(w, d.Unified)
+			continue
+		}
+		status := "modified"
+		switch {
+		case d.New:
+			status = "new"
+		case d.Deleted:
+			status = "deleted"
+		}
+		_, _ = fmt.Fprintf(w, "%-8s %s\n", status, d.Path)
+	}
+
+	if opts.Check && len(diffs) > 0 {
+		return fmt.Errorf(
+			"%d generated file(s) out of date in %s; run archesai generate",
+			len(diffs),
+			opts.OutputPath,
+		)
+	}
 	return nil
 }
@@ -24,6 +24,11 @@ func RunTUI(opts Options) error {
 	}
 
 	var prep *PreparedGeneration
+	defer func() {
+		if prep != nil {
+			prep.Cleanup()
+		}
+	}()
 
 	err := runner.Steps("Code Generation", steps, func(stepID string) (string, error) {
 		switch stepID {
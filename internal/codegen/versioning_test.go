@@ -0,0 +1,182 @@
+package codegen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/storage"
+)
+
+// versionedSpec is the format of a spec with a Todo entity, whose repository
+// options are the argument, and operations to get and update it.
+const versionedSpec = `openapi: 3.1.0
+x-project-name: github.com/acme/todo
+info:
+  title: Todo
+  version: 1.0.0
+components:
+  schemas:
+    Todo:
+      title: Todo
+      x-codegen-schema-type: entity
+      x-codegen:
+        repository: %s
+      type: object
+      required: [id, title]
+      properties:
+        id:
+          type: string
+          format: uuid
+        title:
+          type: string
+paths:
+  /todos/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        schema:
+          type: string
+          format: uuid
+    get:
+      operationId: GetTodo
+      summary: Get a todo
+      description: Get a todo
+      tags: [Todo]
+      responses:
+        '200':
+          description: The todo
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [data]
+                properties:
+                  data:
+                    $ref: '#/components/schemas/Todo'
+    patch:
+      operationId: UpdateTodo
+      summary: Update a todo
+      description: Update a todo
+      tags: [Todo]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                title:
+                  type: string
+      responses:
+        '200':
+          description: The updated todo
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [data]
+                properties:
+                  data:
+                    $ref: '#/components/schemas/Todo'
+`
+
+func TestGenerateVersionedEntity(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+
+		// want maps generated files to fragments they must contain
+		want    map[string][]string
+		notWant map[string][]string
+	}{
+		{
+			name:       "versioned",
+			repository: "{versioned: true}",
+			want: map[string][]string{
+				"routes/update_todo.gen.go": {
+					// A missing If-Match is 428, a stale version 412
+					`r.Header.Get("If-Match")`,
+					`server.NewPreconditionRequiredResponse("Missing required header: If-Match", r.URL.Path)`,
+					"version, err := database.ParseETag(ifMatch)",
+					"input.ExpectedVersion = version",
+					"if errors.Is(err, database.ErrVersionConflict) {",
+					`server.NewPreconditionFailedResponse("If-Match does not match the current todo", r.URL.Path)`,
+					`w.Header().Set("ETag", result.ETag)`,
+				},
+				"routes/get_todo.gen.go": {`w.Header().Set("ETag", result.ETag)`},
+				"handlers/update_todo.gen.go": {
+					"ExpectedVersion int64",
+					"ETag string `json:\"-\"`",
+					"existing.Version = input.ExpectedVersion",
+					"ETag: database.FormatETag(updated.Version),",
+				},
+				"models/todo.gen.go": {"Version int64 `json:\"-\" yaml:\"-\"`"},
+				"infrastructure/postgres/queries/todos.gen.sql": {
+					"version = version + 1",
+					"AND version = sqlc.arg('version')",
+				},
+				"infrastructure/postgres/repositories/todo_repository.gen.go": {
+					"Version: entity.Version,",
+					"return nil, database.ErrVersionConflict",
+				},
+				"infrastructure/sqlite/repositories/todo_repository.gen.go": {
+					"version = version + 1",
+					"AND version = ?",
+					"return nil, database.ErrVersionConflict",
+				},
+				"infrastructure/contract/todo_repository.gen_test.go": {
+					`name: "update with stale version",`,
+					"assert.ErrorIs(t, err, database.ErrVersionConflict)",
+				},
+			},
+			notWant: map[string][]string{
+				"routes/get_todo.gen.go": {"If-Match"},
+			},
+		},
+		{
+			name:       "unversioned",
+			repository: "{}",
+			notWant: map[string][]string{
+				"routes/update_todo.gen.go":                                 {"If-Match", "ETag"},
+				"handlers/update_todo.gen.go":                               {"ExpectedVersion", "ETag"},
+				"infrastructure/postgres/queries/todos.gen.sql":             {"version"},
+				"infrastructure/sqlite/repositories/todo_repository.gen.go": {"ErrVersionConflict"},
+				"infrastructure/contract/todo_repository.gen_test.go":       {"ErrVersionConflict"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			specPath := filepath.Join(dir, "openapi.yaml")
+			content := fmt.Sprintf(versionedSpec, tt.repository)
+			require.NoError(t, os.WriteFile(specPath, []byte(content), 0644))
+
+			generated := storage.NewMemoryStorageWithBaseDir(dir)
+			o := NewOrchestrator(dir).WithStorage(generated).WithOnly("models,routes,handlers,repositories,postgres,sqlite,contract_tests")
+			require.NoError(t, o.Initialize())
+			require.NoError(t, o.Generate(specPath))
+
+			for path, fragments := range tt.want {
+				content, err := generated.ReadFile(path)
+				require.NoError(t, err)
+				for _, fragment := range fragments {
+					assert.Contains(t, string(content), fragment, path)
+				}
+			}
+			for path, fragments := range tt.notWant {
+				content, err := generated.ReadFile(path)
+				require.NoError(t, err)
+				for _, fragment := range fragments {
+					assert.NotContains(t, string(content), fragment, path)
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package openapi
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -114,6 +116,11 @@ func extractOperations(doc *v3.Document) ([]spec.Operation, error) {
 				)
 			}
 
+			permissions, err := extractXCodegenPermissions(op)
+			if err != nil {
+				return nil, fmt.Errorf("invalid x-codegen-permissions for %s %s: %w", method, path, err)
+			}
+
 			operationDef := spec.Operation{
 				Method:                strings.ToUpper(method),
 				Path:                  path,
@@ -124,10 +131,30 @@ func extractOperations(doc *v3.Document) ([]spec.Operation, error) {
 				Security:              extractSecurityRequirements(doc, op),
 				Responses:             responses,
 				XCodegenCustomHandler: extractXCodegenCustomHandler(op),
+				XCodegenPermissions:   permissions,
 				XInternal:             extractXInternal(op),
 				RequestBody:           requestBody,
 			}
 
+			if permissions != nil && !operationDef.HasBearerAuth() && !operationDef.HasCookieAuth() {
+				return nil, fmt.Errorf(
+					"operation %s %s declares x-codegen-permissions but no bearer or cookie security",
+					method,
+					path,
+				)
+			}
+			if permissions != nil && permissions.OrganizationParam != "" &&
+				!slices.ContainsFunc(operationDef.GetPathParams(), func(p spec.Param) bool {
+					return p.SpecName == permissions.OrganizationParam
+				}) {
+				return nil, fmt.Errorf(
+					"operation %s %s declares x-codegen-permissions organizationParam %q, which is not a path parameter",
+					method,
+					path,
+					permissions.OrganizationParam,
+				)
+			}
+
 			operations = append(operations, operationDef)
 		}
 	}
@@ -194,8 +221,9 @@ func extractParameters(op *v3.Operation, pathItem *v3.PathItem) []spec.Param {
 
 		// Create Param with embedded Schema
 		paramDef := spec.Param{
-			Schema: schemaDef,
-			In:     param.In,
+			Schema:   schemaDef,
+			SpecName: param.Name,
+			In:       param.In,
 		}
 		if param.Style != "" {
 			paramDef.Style = param.Style
@@ -476,6 +504,29 @@ func extractXCodegenCustomHandler(op *v3.Operation) bool {
 	return false
 }
 
+// permissionPattern matches a permission of the form "resource:action".
+var permissionPattern = regexp.MustCompile(`^[a-z][a-z-]*:[a-z][a-z-]*$`)
+
+// extractXCodegenPermissions extracts the x-codegen-permissions extension,
+// which lists what the caller's membership must allow.
+func extractXCodegenPermissions(op *v3.Operation) (*spec.Permissions, error) {
+	if op.Extensions == nil {
+		return nil, nil
+	}
+	val, ok := op.Extensions.Get("x-codegen-permissions")
+	if !ok {
+		return nil, nil
+	}
+	var permissions spec.Permissions
+	if err := val.Decode(&permissions); err != nil {
+		return nil, err
+	}
+	if permissions.Permission != "" && !permissionPattern.MatchString(permissions.Permission) {
+		return nil, fmt.Errorf("permission %q must have the form resource:action", permissions.Permission)
+	}
+	return &permissions, nil
+}
+
 // extractXInternal extracts the x-internal extension value (e.g., "server", "config")
 // When set, the operation should be imported from another package instead of generated
 func extractXInternal(op *v3.Operation) string {
@@ -16,6 +16,7 @@ import (
 	"github.com/archesai/archesai/pkg/pipelines"
 	"github.com/archesai/archesai/pkg/server"
 	"github.com/archesai/archesai/pkg/storage"
+	"github.com/archesai/archesai/pkg/webhooks"
 )
 
 // IncludeFS is an interface for embedded filesystems used by includes.
@@ -56,6 +57,7 @@ func NewDefaultIncludeMerger() *IncludeMerger {
 	merger.RegisterInclude("storage", storage.APISpec)
 	merger.RegisterInclude("pipelines", pipelines.APISpec)
 	merger.RegisterInclude("executor", executor.APISpec)
+	merger.RegisterInclude("webhooks", webhooks.APISpec)
 	return merger
 }
 
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 
@@ -154,6 +155,16 @@ func (p *JSONSchemaParser) extractSchemaRecursive(
 	result := p.buildBasicSchema(schema, schemaType)
 	p.extractSchemaType(schema, result)
 
+	// Top-level polymorphic schemas become tagged unions named after the schema
+	if parentName == "" && schemaType != spec.XCodegenSchemaTypeEntity && result.Name != "" {
+		if union := p.extractUnion(schema); union != nil {
+			result.Type = spec.SchemaTypeObject
+			result.Union = union
+			result.GoType = result.Name
+			return result, nil
+		}
+	}
+
 	// Handle different types
 	switch result.Type {
 	case spec.SchemaTypeObject:
@@ -226,6 +237,116 @@ func (p *JSONSchemaParser) extractSchemaType(schema *base.Schema, result *spec.S
 	}
 }
 
+// extractUnion builds the Union of a oneOf/anyOf schema whose alternatives
+// all reference component schemas. Other shapes, such as a value that may be
+// a string or an integer, return nil and keep their generic Go type.
+func (p *JSONSchemaParser) extractUnion(schema *base.Schema) *spec.Union {
+	kind, proxies := spec.UnionOneOf, schema.OneOf
+	if len(proxies) == 0 {
+		kind, proxies = spec.UnionAnyOf, schema.AnyOf
+	}
+	if len(proxies) == 0 {
+		return nil
+	}
+
+	union := &spec.Union{Kind: kind}
+	if schema.Discriminator != nil {
+		union.Discriminator = schema.Discriminator.PropertyName
+	}
+
+	refNames := make([]string, 0, len(proxies))
+	for _, proxy := range proxies {
+		refString := proxy.GetReference()
+		if !strings.HasPrefix(refString, "#/components/schemas/") {
+			return nil
+		}
+		refName := strings.TrimPrefix(refString, "#/components/schemas/")
+		refName = strings.TrimSuffix(refName, ".yaml")
+
+		variant := spec.UnionVariant{GoType: refName, Validates: true}
+		if resolved, err := p.resolveReferencedSchema(refName); err == nil {
+			if resolved.Title != "" {
+				variant.GoType = resolved.Title
+			}
+			variant.Required = unionVariantRequired(resolved)
+			// Only value objects generate a Validate method
+			if resolved.Extensions != nil {
+				if ext, ok := resolved.Extensions.Get("x-codegen-schema-type"); ok {
+					var schemaType string
+					if err := ext.Decode(&schemaType); err == nil {
+						variant.Validates = schemaType != string(spec.XCodegenSchemaTypeEntity)
+					}
+				}
+			}
+		}
+		refNames = append(refNames, refName)
+		union.Variants = append(union.Variants, variant)
+	}
+
+	if union.Discriminator == "" {
+		return union
+	}
+
+	// Explicit mappings win; other variants are selected by their schema name
+	mapped := make(map[int]bool)
+	if schema.Discriminator.Mapping != nil {
+		for pair := schema.Discriminator.Mapping.First(); pair != nil; pair = pair.Next() {
+			target := strings.TrimSuffix(strings.TrimPrefix(pair.Value(), "#/components/schemas/"), ".yaml")
+			for i, refName := range refNames {
+				if refName == target {
+					union.Variants[i].Values = append(union.Variants[i].Values, pair.Key())
+					mapped[i] = true
+				}
+			}
+		}
+	}
+	for i, refName := range refNames {
+		if !mapped[i] {
+			union.Variants[i].Values = []string{refName}
+		}
+	}
+	return union
+}
+
+// unionVariantRequired returns the required properties of a union variant,
+// including those of the schemas it composes with allOf.
+func unionVariantRequired(schema *base.Schema) []string {
+	required := slices.Clone(schema.Required)
+	for _, proxy := range schema.AllOf {
+		if part := proxy.Schema(); part != nil {
+			required = append(required, part.Required...)
+		}
+	}
+	sort.Strings(required)
+	return slices.Compact(required)
+}
+
+// nullableReference returns the reference of a property declared as oneOf
+// or anyOf a single $ref and null, the OpenAPI 3.1 spelling of a nullable
+// reference.
+func nullableReference(schema *base.Schema) (string, bool) {
+	proxies := schema.OneOf
+	if len(proxies) == 0 {
+		proxies = schema.AnyOf
+	}
+	if len(proxies) != 2 {
+		return "", false
+	}
+
+	refString, null := "", false
+	for _, proxy := range proxies {
+		if ref := proxy.GetReference(); ref != "" {
+			refString = ref
+		} else if alt := proxy.Schema(); alt != nil && slices.Equal(alt.Type, []string{"null"}) {
+			null = true
+		}
+	}
+	if refString == "" || !null {
+		return "", false
+	}
+	return refString, true
+}
+
 // processObjectSchema processes object type schemas
 func (p *JSONSchemaParser) processObjectSchema(
 	schema *base.Schema,
@@ -310,7 +431,7 @@ func (p *JSONSchemaParser) processArraySchema(
 	}
 
 	if refString := itemProxy.GetReference(); refString != "" {
-		p.processArrayItemReference(refString, result, schemaName, schemaType)
+		p.processArrayItemReference(refString, result, schemaName)
 	} else {
 		p.processArrayItemInline(itemSchema, result, parentName, schemaName, schemaType)
 	}
@@ -321,7 +442,6 @@ func (p *JSONSchemaParser) processArrayItemReference(
 	refString string,
 	result *spec.Schema,
 	schemaName string,
-	schemaType spec.XCodegenSchemaType,
 ) {
 	if !strings.HasPrefix(refString, "#/components/schemas/") {
 		return
@@ -330,7 +450,7 @@ func (p *JSONSchemaParser) processArrayItemReference(
 	refSchemaName := strings.TrimPrefix(refString, "#/components/schemas/")
 	refSchemaName = strings.TrimSuffix(refSchemaName, ".yaml")
 
-	goType, itemSchemaType, format := p.resolvePropertyType(refSchemaName, schemaName, schemaType)
+	goType, itemSchemaType, format := p.resolvePropertyType(refSchemaName, schemaName)
 	result.Items = &spec.Schema{
 		Name:   refSchemaName,
 		GoType: goType,
@@ -387,7 +507,6 @@ func (p *JSONSchemaParser) processSimpleSchema(schema *base.Schema, result *spec
 func (p *JSONSchemaParser) resolvePropertyType(
 	refSchemaName string,
 	currentSchemaName string,
-	currentSchemaType spec.XCodegenSchemaType,
 ) (goType string, schemaType string, format string) {
 	resolvedSchema, err := p.resolveReferencedSchema(refSchemaName)
 	if err != nil {
@@ -400,16 +519,8 @@ func (p *JSONSchemaParser) resolvePropertyType(
 		actualTypeName = refSchemaName
 	}
 
-	// Determine the target package type from the referenced schema
-	targetPackage := string(spec.XCodegenSchemaTypeEntity) // Default to entity
 	xInternal := ""
 	if resolvedSchema.Extensions != nil {
-		if ext, ok := resolvedSchema.Extensions.Get("x-codegen-schema-type"); ok {
-			var schemaTypeStr string
-			if err := ext.Decode(&schemaTypeStr); err == nil {
-				targetPackage = schemaTypeStr
-			}
-		}
 		// Check for x-internal extension - marks schema as imported from another package
 		if ext, ok := resolvedSchema.Extensions.Get("x-internal"); ok {
 			var xinternalStr string
@@ -424,22 +535,22 @@ func (p *JSONSchemaParser) resolvePropertyType(
 	if xInternal == "server" {
 		goType = "servermodels." + actualTypeName
 	} else {
-		// Response schemas always qualify model types
-		isResponseSchema := currentSchemaName != "" && strings.HasSuffix(currentSchemaName, "Response")
-		if isResponseSchema {
+		// Request body and response schemas always qualify model types
+		isOperationSchema := strings.HasSuffix(currentSchemaName, "Response") ||
+			strings.HasSuffix(currentSchemaName, "RequestBody")
+		if isOperationSchema {
 			goType = "models." + actualTypeName
-		} else if string(currentSchemaType) == targetPackage {
-			// Within the same package, no qualification needed
-			goType = actualTypeName
 		} else {
-			// Different package, always qualify
-			goType = "models." + actualTypeName
+			// Entities and value objects share the models package
+			goType = actualTypeName
 		}
 	}
 
 	// Copy type info from resolved schema
 	if len(resolvedSchema.Type) > 0 {
 		schemaType = resolvedSchema.Type[0]
+	} else if len(resolvedSchema.OneOf) > 0 || len(resolvedSchema.AnyOf) > 0 {
+		schemaType = spec.SchemaTypeObject
 	}
 	if resolvedSchema.Format != "" {
 		format = resolvedSchema.Format
@@ -476,7 +587,12 @@ func (p *JSONSchemaParser) extractPropertiesInto(
 		fieldName := strutil.PascalCase(propName)
 
 		// Check if it's a reference
-		if refString := propProxy.GetReference(); refString != "" {
+		refString := propProxy.GetReference()
+		nullable := false
+		if refString == "" {
+			refString, nullable = nullableReference(propSchema)
+		}
+		if refString != "" {
 			// Handle reference property (inline processPropertyReference)
 			if strings.HasPrefix(refString, "#/components/schemas/") {
 				schemaName := strings.TrimPrefix(refString, "#/components/schemas/")
@@ -484,17 +600,22 @@ func (p *JSONSchemaParser) extractPropertiesInto(
 
 				// Create a simple Schema for the reference
 				refDef := &spec.Schema{
-					Name:    fieldName,
-					JSONTag: propName,
-					YAMLTag: propName,
-					Schema:  propSchema,
+					Name:     fieldName,
+					JSONTag:  propName,
+					YAMLTag:  propName,
+					Schema:   propSchema,
+					Nullable: nullable,
+				}
+				// A plain $ref carries the schema of its target, while a
+				// nullable reference describes the property itself
+				if nullable {
+					refDef.Description = propSchema.Description
 				}
 
 				// Resolve the reference to get type info
 				goType, schemaType, format := p.resolvePropertyType(
 					schemaName,
 					currentSchemaName,
-					currentSchemaType,
 				)
 				refDef.GoType = goType
 				refDef.Type = schemaType
@@ -558,6 +679,13 @@ func (p *JSONSchemaParser) extractPropertiesInto(
 				}
 			}
 
+			// Entities are also response bodies, so writeOnly properties such as
+			// secrets are kept out of their JSON
+			if currentSchemaType == spec.XCodegenSchemaTypeEntity &&
+				propSchema.WriteOnly != nil && *propSchema.WriteOnly {
+				propDef.JSONTag = "-"
+			}
+
 			parent.Properties[fieldName] = propDef
 
 			// If this is a nested object with properties, set its GoType to the prefixed name
@@ -0,0 +1,139 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/internal/spec"
+)
+
+const unionSpec = `openapi: 3.1.0
+info:
+  title: Unions
+  version: v0.0.0
+paths: {}
+components:
+  schemas:
+    Base:
+      type: object
+      properties:
+        id:
+          type: string
+          format: uuid
+      required:
+        - id
+    Step:
+      title: Step
+      x-codegen-schema-type: entity
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          properties:
+            config:
+              description: How the step runs
+              oneOf:
+                - $ref: '#/components/schemas/StepConfig'
+                - type: 'null'
+          required:
+            - config
+    StepConfig:
+      title: StepConfig
+      oneOf:
+        - $ref: '#/components/schemas/HTTPStepConfig'
+        - $ref: '#/components/schemas/ScriptStepConfig'
+        - $ref: '#/components/schemas/Step'
+      discriminator:
+        propertyName: kind
+        mapping:
+          http: '#/components/schemas/HTTPStepConfig'
+          webhook: '#/components/schemas/HTTPStepConfig'
+    HTTPStepConfig:
+      title: HTTPStepConfig
+      type: object
+      properties:
+        url:
+          type: string
+        method:
+          type: string
+      required:
+        - url
+        - method
+    ScriptStepConfig:
+      title: ScriptStepConfig
+      type: object
+      properties:
+        code:
+          type: string
+    ScriptSource:
+      title: ScriptSource
+      anyOf:
+        - $ref: '#/components/schemas/ScriptStepConfig'
+        - $ref: '#/components/schemas/Step'
+`
+
+func TestJSONSchemaParserUnions(t *testing.T) {
+	doc, err := NewParser().ParseBytes([]byte(unionSpec))
+	require.NoError(t, err)
+	parser := NewJSONSchemaParser(doc)
+
+	parse := func(t *testing.T, name string) *spec.Schema {
+		t.Helper()
+		proxy, ok := doc.Components.Schemas.Get(name)
+		require.True(t, ok)
+		schema, err := parser.ParseBase(proxy.Schema())
+		require.NoError(t, err)
+		return schema
+	}
+
+	tests := []struct {
+		name   string
+		schema string
+		want   *spec.Union
+	}{
+		{
+			// Mapping keys select their target, other variants their schema name
+			name:   "oneOf with discriminator mapping",
+			schema: "StepConfig",
+			want: &spec.Union{
+				Kind:          spec.UnionOneOf,
+				Discriminator: "kind",
+				Variants: []spec.UnionVariant{
+					{GoType: "HTTPStepConfig", Values: []string{"http", "webhook"}, Required: []string{"method", "url"}, Validates: true},
+					{GoType: "ScriptStepConfig", Values: []string{"ScriptStepConfig"}, Validates: true},
+					{GoType: "Step", Values: []string{"Step"}, Required: []string{"config", "id"}},
+				},
+			},
+		},
+		{
+			name:   "anyOf",
+			schema: "ScriptSource",
+			want: &spec.Union{
+				Kind: spec.UnionAnyOf,
+				Variants: []spec.UnionVariant{
+					{GoType: "ScriptStepConfig", Validates: true},
+					{GoType: "Step", Required: []string{"config", "id"}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := parse(t, tt.schema)
+			require.True(t, schema.IsUnion())
+			assert.Equal(t, tt.want, schema.Union)
+			assert.Equal(t, tt.schema, schema.GoType)
+		})
+	}
+
+	t.Run("nullable reference", func(t *testing.T) {
+		config := parse(t, "Step").Properties["Config"]
+		require.NotNil(t, config)
+		assert.Equal(t, "StepConfig", config.GoType)
+		assert.True(t, config.Nullable)
+		assert.Equal(t, "How the step runs", config.Description)
+		assert.Equal(t, "*StepConfig", config.GetFieldType(nil))
+	})
+}
@@ -40,3 +40,15 @@ const (
 	GoTypeMapString = "map[string]any"
 	GoTypeSliceAny  = "[]any"
 )
+
+// Pagination mode constants for x-codegen repository.pagination
+const (
+	PaginationOffset = "offset"
+	PaginationCursor = "cursor"
+)
+
+// Relation kind constants for x-codegen repository.relations
+const (
+	RelationBelongsTo  = "belongsTo"
+	RelationManyToMany = "manyToMany"
+)
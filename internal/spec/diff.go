@@ -0,0 +1,549 @@
+package spec
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/archesai/archesai/internal/strutil"
+)
+
+// Severity tells whether a change can break existing API consumers
+type Severity string
+
+// Valid Severity values
+const (
+	SeverityBreaking    Severity = "breaking"
+	SeverityNonBreaking Severity = "non-breaking"
+)
+
+// ChangeKind identifies what changed between two specs
+type ChangeKind string
+
+// Valid ChangeKind values
+const (
+	ChangeOperationAdded     ChangeKind = "operation-added"
+	ChangeOperationRemoved   ChangeKind = "operation-removed"
+	ChangeOperationRenamed   ChangeKind = "operation-renamed"
+	ChangeParamAdded         ChangeKind = "param-added"
+	ChangeParamRemoved       ChangeKind = "param-removed"
+	ChangeRequestBodyAdded   ChangeKind = "request-body-added"
+	ChangeRequestBodyRemoved ChangeKind = "request-body-removed"
+	ChangeResponseAdded      ChangeKind = "response-added"
+	ChangeResponseRemoved    ChangeKind = "response-removed"
+	ChangePropertyAdded      ChangeKind = "property-added"
+	ChangePropertyRemoved    ChangeKind = "property-removed"
+	ChangeMadeRequired       ChangeKind = "made-required"
+	ChangeMadeOptional       ChangeKind = "made-optional"
+	ChangeTypeChanged        ChangeKind = "type-changed"
+	ChangeEnumNarrowed       ChangeKind = "enum-narrowed"
+	ChangeEnumWidened        ChangeKind = "enum-widened"
+)
+
+// Change is a difference between two specs
+type Change struct {
+	Severity  Severity   `json:"severity"`
+	Kind      ChangeKind `json:"kind"`
+	Operation string     `json:"operation"`          // Method and path, such as "GET /users/{id}"
+	Location  string     `json:"location,omitempty"` // Part of the operation, such as "response 200 data.email"
+	Message   string     `json:"message"`
+}
+
+// SpecDiff lists the changes from one spec to another, in operation order
+type SpecDiff struct {
+	Changes []Change `json:"changes"`
+}
+
+// HasBreaking returns true if any change can break existing consumers
+func (d *SpecDiff) HasBreaking() bool {
+	return len(d.Breaking()) > 0
+}
+
+// Breaking returns the breaking changes
+func (d *SpecDiff) Breaking() []Change {
+	return d.filter(SeverityBreaking)
+}
+
+// NonBreaking returns the non-breaking changes
+func (d *SpecDiff) NonBreaking() []Change {
+	return d.filter(SeverityNonBreaking)
+}
+
+func (d *SpecDiff) filter(severity Severity) []Change {
+	var changes []Change
+	for _, c := range d.Changes {
+		if c.Severity == severity {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// WriteText writes a plain text report of the changes
+func (d *SpecDiff) WriteText(w io.Writer) error {
+	if len(d.Changes) == 0 {
+		_, err := fmt.Fprintln(w, "No changes")
+		return err
+	}
+	var b strings.Builder
+	for _, group := range d.groups() {
+		if len(group.changes) == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s (%d):\n", group.title, len(group.changes))
+		for _, c := range group.changes {
+			fmt.Fprintf(&b, "  %s", c.Operation)
+			if c.Location != "" {
+				fmt.Fprintf(&b, " %s", c.Location)
+			}
+			fmt.Fprintf(&b, ": %s [%s]\n", c.Message, c.Kind)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMarkdown writes a Markdown report of the changes, suitable for a pull
+// request comment
+func (d *SpecDiff) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("# API changes\n")
+	if len(d.Changes) == 0 {
+		b.WriteString("\nNo changes.\n")
+	}
+	for _, group := range d.groups() {
+		if len(group.changes) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", group.title, len(group.changes))
+		b.WriteString("| Operation | Location | Change | Kind |\n")
+		b.WriteString("| --- | --- | --- | --- |\n")
+		for _, c := range group.changes {
+			fmt.Fprintf(&b, "| `%s` | %s | %s | `%s` |\n",
+				c.Operation, markdownCode(c.Location), markdownEscape(c.Message), c.Kind)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+type changeGroup struct {
+	title   string
+	changes []Change
+}
+
+func (d *SpecDiff) groups() []changeGroup {
+	return []changeGroup{
+		{title: "Breaking changes", changes: d.Breaking()},
+		{title: "Non-breaking changes", changes: d.NonBreaking()},
+	}
+}
+
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + s + "`"
+}
+
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+// Diff compares two specs and classifies every change to their operations.
+// Operations are matched by method and path, ignoring the names of path
+// parameters. Changes consumers could notice are breaking: removed
+// operations, new required inputs, narrowed request enums, type changes, and
+// removed or no longer guaranteed response fields.
+func Diff(oldSpec, newSpec *Spec) *SpecDiff {
+	d := &differ{
+		oldSchemas: schemasByName(oldSpec),
+		newSchemas: schemasByName(newSpec),
+		diff:       &SpecDiff{Changes: []Change{}},
+	}
+
+	newOps := make(map[string]*Operation, len(newSpec.Operations))
+	for i := range newSpec.Operations {
+		op := &newSpec.Operations[i]
+		newOps[operationKey(op)] = op
+	}
+	matched := make(map[string]bool, len(oldSpec.Operations))
+	for i := range oldSpec.Operations {
+		oldOp := &oldSpec.Operations[i]
+		key := operationKey(oldOp)
+		newOp, ok := newOps[key]
+		if !ok {
+			d.add(SeverityBreaking, ChangeOperationRemoved, oldOp, "", "operation %s was removed", oldOp.ID)
+			continue
+		}
+		matched[key] = true
+		d.compareOperations(oldOp, newOp)
+	}
+	for i := range newSpec.Operations {
+		newOp := &newSpec.Operations[i]
+		if !matched[operationKey(newOp)] {
+			d.add(SeverityNonBreaking, ChangeOperationAdded, newOp, "", "operation %s was added", newOp.ID)
+		}
+	}
+	return d.diff
+}
+
+var pathParamPattern = regexp.MustCompile(`\{[^}]*\}`)
+
+func operationKey(op *Operation) string {
+	return strings.ToUpper(op.Method) + " " + pathParamPattern.ReplaceAllString(op.Path, "{}")
+}
+
+func schemasByName(s *Spec) map[string]*Schema {
+	schemas := make(map[string]*Schema, len(s.Schemas))
+	for _, schema := range s.Schemas {
+		schemas[schema.Name] = schema
+	}
+	return schemas
+}
+
+// direction tells which side of the API a schema is read by. Changes to
+// requests break clients when they accept less, changes to responses when
+// they promise less.
+type direction int
+
+const (
+	request direction = iota
+	response
+)
+
+type differ struct {
+	oldSchemas map[string]*Schema
+	newSchemas map[string]*Schema
+	op         *Operation
+	diff       *SpecDiff
+}
+
+func (d *differ) add(severity Severity, kind ChangeKind, op *Operation, location, format string, args ...any) {
+	d.diff.Changes = append(d.diff.Changes, Change{
+		Severity:  severity,
+		Kind:      kind,
+		Operation: strings.ToUpper(op.Method) + " " + op.Path,
+		Location:  location,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+func (d *differ) compareOperations(oldOp, newOp *Operation) {
+	d.op = newOp
+	if oldOp.ID != newOp.ID {
+		d.add(SeverityBreaking, ChangeOperationRenamed, newOp, "",
+			"operation ID changed from %s to %s, renaming generated client methods", oldOp.ID, newOp.ID)
+	}
+	d.compareParams(oldOp, newOp)
+	d.compareRequestBodies(oldOp.RequestBody, newOp.RequestBody)
+	d.compareResponses(oldOp.Responses, newOp.Responses)
+}
+
+// compareParams compares the parameters of two operations. Path parameters
+// are matched by position, since their names are not sent.
+func (d *differ) compareParams(oldOp, newOp *Operation) {
+	oldIndex := paramsByKey(oldOp)
+	newIndex := paramsByKey(newOp)
+
+	for _, oldParam := range oldOp.Parameters {
+		location := oldParam.In + " parameter " + oldParam.Name
+		newParam, ok := newIndex[paramKey(oldOp, oldParam)]
+		if !ok {
+			d.add(SeverityNonBreaking, ChangeParamRemoved, d.op, location, "parameter was removed")
+			continue
+		}
+		d.compareRequired(request, location, paramRequired(oldParam), paramRequired(newParam))
+		d.compareSchemas(request, location, "", oldParam.Schema, newParam.Schema, nil)
+	}
+	for _, newParam := range newOp.Parameters {
+		if _, ok := oldIndex[paramKey(newOp, newParam)]; ok {
+			continue
+		}
+		location := newParam.In + " parameter " + newParam.Name
+		if paramRequired(newParam) {
+			d.add(SeverityBreaking, ChangeParamAdded, d.op, location, "required parameter was added")
+		} else {
+			d.add(SeverityNonBreaking, ChangeParamAdded, d.op, location, "optional parameter was added")
+		}
+	}
+}
+
+func paramsByKey(op *Operation) map[string]Param {
+	params := make(map[string]Param, len(op.Parameters))
+	for _, p := range op.Parameters {
+		params[paramKey(op, p)] = p
+	}
+	return params
+}
+
+// paramKey identifies a parameter of an operation by location and name, or
+// by position for path parameters
+func paramKey(op *Operation, p Param) string {
+	if p.In == "path" {
+		for i, match := range pathParamPattern.FindAllString(op.Path, -1) {
+			if strutil.PascalCase(strings.Trim(match, "{}")) == p.Name {
+				return fmt.Sprintf("path %d", i)
+			}
+		}
+	}
+	return p.In + " " + p.Name
+}
+
+func (d *differ) compareRequestBodies(oldBody, newBody *RequestBody) {
+	const location = "request body"
+	switch {
+	case oldBody == nil && newBody == nil:
+		return
+	case oldBody == nil:
+		if newBody.Required {
+			d.add(SeverityBreaking, ChangeRequestBodyAdded, d.op, location, "required request body was added")
+		} else {
+			d.add(SeverityNonBreaking, ChangeRequestBodyAdded, d.op, location, "optional request body was added")
+		}
+		return
+	case newBody == nil:
+		d.add(SeverityNonBreaking, ChangeRequestBodyRemoved, d.op, location, "request body was removed")
+		return
+	}
+	d.compareRequired(request, location, oldBody.Required, newBody.Required)
+	d.compareSchemas(request, location, "", oldBody.Schema, newBody.Schema, nil)
+}
+
+func (d *differ) compareResponses(oldResponses, newResponses []ResponseDef) {
+	find := func(responses []ResponseDef, status string) *ResponseDef {
+		for i := range responses {
+			if responses[i].StatusCode == status {
+				return &responses[i]
+			}
+		}
+		return nil
+	}
+
+	for i := range oldResponses {
+		oldResp := &oldResponses[i]
+		location := "response " + oldResp.StatusCode
+		newResp := find(newResponses, oldResp.StatusCode)
+		if newResp == nil {
+			if oldResp.IsSuccess() {
+				d.add(SeverityBreaking, ChangeResponseRemoved, d.op, location, "success response was removed")
+			} else {
+				d.add(SeverityNonBreaking, ChangeResponseRemoved, d.op, location, "error response was removed")
+			}
+			continue
+		}
+		d.compareSchemas(response, location, "", oldResp.Schema, newResp.Schema, nil)
+	}
+	for i := range newResponses {
+		newResp := &newResponses[i]
+		if find(oldResponses, newResp.StatusCode) != nil {
+			continue
+		}
+		location := "response " + newResp.StatusCode
+		if newResp.IsSuccess() {
+			d.add(SeverityNonBreaking, ChangeResponseAdded, d.op, location, "success response was added")
+		} else {
+			d.add(SeverityNonBreaking, ChangeResponseAdded, d.op, location, "error response was added")
+		}
+	}
+}
+
+// compareRequired reports a value that became required or optional
+func (d *differ) compareRequired(dir direction, location string, oldRequired, newRequired bool) {
+	switch {
+	case !oldRequired && newRequired:
+		severity := SeverityNonBreaking
+		if dir == request {
+			severity = SeverityBreaking
+		}
+		d.add(severity, ChangeMadeRequired, d.op, location, "became required")
+	case oldRequired && !newRequired:
+		severity := SeverityNonBreaking
+		if dir == response {
+			severity = SeverityBreaking
+		}
+		d.add(severity, ChangeMadeOptional, d.op, location, "became optional")
+	}
+}
+
+// compareSchemas compares two schemas found at the field path of the part
+// of the operation named by where, descending into properties, array items
+// and referenced component schemas. seen holds the pairs of component
+// schemas being compared, which ends recursive types.
+func (d *differ) compareSchemas(dir direction, where, path string, oldSchema, newSchema *Schema, seen map[string]bool) {
+	if oldSchema == nil || newSchema == nil {
+		return
+	}
+	location := joinLocation(where, path)
+	oldSchema, oldRef := d.resolve(oldSchema, d.oldSchemas)
+	newSchema, newRef := d.resolve(newSchema, d.newSchemas)
+	if oldRef != "" && newRef != "" {
+		pair := oldRef + " " + newRef
+		if seen[pair] {
+			return
+		}
+		seen = cloneSeen(seen)
+		seen[pair] = true
+	}
+
+	if oldType, newType := schemaType(oldSchema), schemaType(newSchema); oldType != "" && newType != "" && oldType != newType {
+		d.add(SeverityBreaking, ChangeTypeChanged, d.op, location, "type changed from %s to %s", oldType, newType)
+		return
+	}
+
+	d.compareEnums(dir, location, oldSchema.getEnum(), newSchema.getEnum())
+
+	if oldSchema.Items != nil && newSchema.Items != nil {
+		d.compareSchemas(dir, where, path+"[]", oldSchema.Items, newSchema.Items, seen)
+	}
+	d.compareProperties(dir, where, path, oldSchema, newSchema, seen)
+}
+
+func (d *differ) compareProperties(dir direction, where, path string, oldSchema, newSchema *Schema, seen map[string]bool) {
+	if len(oldSchema.Properties) == 0 && len(newSchema.Properties) == 0 {
+		return
+	}
+	newProps := propertiesByJSONName(newSchema)
+	oldProps := propertiesByJSONName(oldSchema)
+
+	for _, oldProp := range oldSchema.GetSortedProperties() {
+		name := propertyName(oldProp)
+		propPath := joinPath(path, name)
+		propLocation := joinLocation(where, propPath)
+		newProp, ok := newProps[name]
+		if !ok {
+			if dir == response {
+				d.add(SeverityBreaking, ChangePropertyRemoved, d.op, propLocation, "field was removed")
+			} else {
+				d.add(SeverityNonBreaking, ChangePropertyRemoved, d.op, propLocation, "field was removed")
+			}
+			continue
+		}
+		d.compareRequired(dir, propLocation, oldSchema.IsPropertyRequired(name), newSchema.IsPropertyRequired(name))
+		d.compareSchemas(dir, where, propPath, oldProp, newProp, seen)
+	}
+	for _, newProp := range newSchema.GetSortedProperties() {
+		name := propertyName(newProp)
+		if _, ok := oldProps[name]; ok {
+			continue
+		}
+		propLocation := joinLocation(where, joinPath(path, name))
+		if dir == request && newSchema.IsPropertyRequired(name) {
+			d.add(SeverityBreaking, ChangePropertyAdded, d.op, propLocation, "required field was added")
+		} else {
+			d.add(SeverityNonBreaking, ChangePropertyAdded, d.op, propLocation, "field was added")
+		}
+	}
+}
+
+// compareEnums reports removed and added enum values. Requests that accept
+// fewer values and responses that return more both break consumers.
+func (d *differ) compareEnums(dir direction, location string, oldEnum, newEnum []string) {
+	if len(oldEnum) == 0 || len(newEnum) == 0 {
+		return
+	}
+	var removed, added []string
+	for _, v := range oldEnum {
+		if !slices.Contains(newEnum, v) {
+			removed = append(removed, v)
+		}
+	}
+	for _, v := range newEnum {
+		if !slices.Contains(oldEnum, v) {
+			added = append(added, v)
+		}
+	}
+	if len(removed) > 0 {
+		severity := SeverityNonBreaking
+		if dir == request {
+			severity = SeverityBreaking
+		}
+		d.add(severity, ChangeEnumNarrowed, d.op, location, "enum values removed: %s", strings.Join(removed, ", "))
+	}
+	if len(added) > 0 {
+		severity := SeverityNonBreaking
+		if dir == response {
+			severity = SeverityBreaking
+		}
+		d.add(severity, ChangeEnumWidened, d.op, location, "enum values added: %s", strings.Join(added, ", "))
+	}
+}
+
+// resolve returns the component schema a property without its own
+// properties refers to, and the name of that component. Other schemas are
+// returned as they are.
+func (d *differ) resolve(s *Schema, schemas map[string]*Schema) (*Schema, string) {
+	if len(s.Properties) > 0 || (s.Type != "" && s.Type != SchemaTypeObject) {
+		return s, ""
+	}
+	name := s.GoType
+	name = strings.TrimLeft(name, "*")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	if component, ok := schemas[name]; ok {
+		return component, name
+	}
+	return s, ""
+}
+
+// schemaType returns the type and format of a schema, such as "string" or
+// "string (uuid)", or "" if it is unknown
+func schemaType(s *Schema) string {
+	if s.Type == "" {
+		return ""
+	}
+	if s.Format != "" {
+		return s.Type + " (" + s.Format + ")"
+	}
+	return s.Type
+}
+
+func propertiesByJSONName(s *Schema) map[string]*Schema {
+	props := make(map[string]*Schema, len(s.Properties))
+	for _, prop := range s.GetSortedProperties() {
+		props[propertyName(prop)] = prop
+	}
+	return props
+}
+
+// propertyName returns the name of a property on the wire
+func propertyName(prop *Schema) string {
+	if name := prop.JSONName(); name != "" {
+		return name
+	}
+	return prop.Name
+}
+
+func paramRequired(p Param) bool {
+	return p.IsPropertyRequired(p.Name)
+}
+
+// joinPath appends a property name to a field path, such as "data[].email"
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// joinLocation returns the location of a field path in a part of an operation
+func joinLocation(where, path string) string {
+	if path == "" {
+		return where
+	}
+	return where + " " + path
+}
+
+func cloneSeen(seen map[string]bool) map[string]bool {
+	clone := make(map[string]bool, len(seen)+1)
+	for k, v := range seen {
+		clone[k] = v
+	}
+	return clone
+}
@@ -0,0 +1,214 @@
+package spec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// userSpec returns a spec with a GET /users/{id} operation returning a User,
+// and a PATCH /users/{id} operation taking an optional status.
+func userSpec() *Spec {
+	user := &Schema{
+		Name:   "User",
+		Type:   SchemaTypeObject,
+		GoType: "User",
+		Properties: map[string]*Schema{
+			"Email": {Name: "Email", Type: SchemaTypeString, Format: "email", JSONTag: "email"},
+			"Age":   {Name: "Age", Type: SchemaTypeInteger, JSONTag: "age,omitempty"},
+		},
+		Required: []string{"email"},
+	}
+	return &Spec{
+		Schemas: []*Schema{user},
+		Operations: []Operation{
+			{
+				ID:     "GetUser",
+				Method: "GET",
+				Path:   "/users/{id}",
+				Parameters: []Param{
+					{Schema: &Schema{Name: "ID", Type: SchemaTypeString, Format: "uuid", Required: []string{"ID"}}, In: "path"},
+				},
+				Responses: []ResponseDef{{
+					StatusCode: "200",
+					Schema: &Schema{
+						Type: SchemaTypeObject,
+						Properties: map[string]*Schema{
+							"Data": {Name: "Data", GoType: "models.User", JSONTag: "data"},
+						},
+						Required: []string{"data"},
+					},
+				}},
+			},
+			{
+				ID:     "UpdateUser",
+				Method: "PATCH",
+				Path:   "/users/{id}",
+				RequestBody: &RequestBody{Schema: &Schema{
+					Type: SchemaTypeObject,
+					Properties: map[string]*Schema{
+						"Status": {
+							Name:    "Status",
+							Type:    SchemaTypeString,
+							Enum:    []string{"active", "disabled"},
+							JSONTag: "status,omitempty",
+						},
+					},
+				}},
+			},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(s *Spec)
+		want   []Change
+	}{
+		{
+			name:   "no changes",
+			change: func(*Spec) {},
+		},
+		{
+			name: "removed operation",
+			change: func(s *Spec) {
+				s.Operations = s.Operations[:1]
+			},
+			want: []Change{{SeverityBreaking, ChangeOperationRemoved, "PATCH /users/{id}", "", "operation UpdateUser was removed"}},
+		},
+		{
+			name: "renamed path parameter",
+			change: func(s *Spec) {
+				s.Operations[0].Path = "/users/{userId}"
+				s.Operations[0].Parameters[0].Name = "UserID"
+				s.Operations[0].Parameters[0].Required = []string{"UserID"}
+			},
+		},
+		{
+			name: "new required parameter",
+			change: func(s *Spec) {
+				s.Operations[0].Parameters = append(s.Operations[0].Parameters, Param{
+					Schema: &Schema{Name: "Fields", Type: SchemaTypeString, Required: []string{"Fields"}},
+					In:     "query",
+				})
+			},
+			want: []Change{{SeverityBreaking, ChangeParamAdded, "GET /users/{id}", "query parameter Fields", "required parameter was added"}},
+		},
+		{
+			name: "new optional parameter",
+			change: func(s *Spec) {
+				s.Operations[0].Parameters = append(s.Operations[0].Parameters, Param{
+					Schema: &Schema{Name: "Fields", Type: SchemaTypeString},
+					In:     "query",
+				})
+			},
+			want: []Change{{SeverityNonBreaking, ChangeParamAdded, "GET /users/{id}", "query parameter Fields", "optional parameter was added"}},
+		},
+		{
+			name: "narrowed request enum",
+			change: func(s *Spec) {
+				s.Operations[1].RequestBody.Properties["Status"].Enum = []string{"active"}
+			},
+			want: []Change{{SeverityBreaking, ChangeEnumNarrowed, "PATCH /users/{id}", "request body status", "enum values removed: disabled"}},
+		},
+		{
+			name: "widened request enum",
+			change: func(s *Spec) {
+				s.Operations[1].RequestBody.Properties["Status"].Enum = []string{"active", "disabled", "banned"}
+			},
+			want: []Change{{SeverityNonBreaking, ChangeEnumWidened, "PATCH /users/{id}", "request body status", "enum values added: banned"}},
+		},
+		{
+			name: "required request field",
+			change: func(s *Spec) {
+				s.Operations[1].RequestBody.Schema.Required = []string{"status"}
+			},
+			want: []Change{{SeverityBreaking, ChangeMadeRequired, "PATCH /users/{id}", "request body status", "became required"}},
+		},
+		{
+			name: "type change in referenced schema",
+			change: func(s *Spec) {
+				s.Schemas[0].Properties["Age"] = &Schema{Name: "Age", Type: SchemaTypeString, JSONTag: "age,omitempty"}
+			},
+			want: []Change{{SeverityBreaking, ChangeTypeChanged, "GET /users/{id}", "response 200 data.age", "type changed from integer to string"}},
+		},
+		{
+			name: "removed response field",
+			change: func(s *Spec) {
+				delete(s.Schemas[0].Properties, "Email")
+				s.Schemas[0].Required = nil
+			},
+			want: []Change{{SeverityBreaking, ChangePropertyRemoved, "GET /users/{id}", "response 200 data.email", "field was removed"}},
+		},
+		{
+			name: "added response field",
+			change: func(s *Spec) {
+				s.Schemas[0].Properties["Name"] = &Schema{Name: "Name", Type: SchemaTypeString, JSONTag: "name"}
+			},
+			want: []Change{{SeverityNonBreaking, ChangePropertyAdded, "GET /users/{id}", "response 200 data.name", "field was added"}},
+		},
+		{
+			name: "optional response field",
+			change: func(s *Spec) {
+				s.Schemas[0].Required = nil
+			},
+			want: []Change{{SeverityBreaking, ChangeMadeOptional, "GET /users/{id}", "response 200 data.email", "became optional"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newSpec := userSpec()
+			tt.change(newSpec)
+
+			diff := Diff(userSpec(), newSpec)
+			if tt.want == nil {
+				tt.want = []Change{}
+			}
+			assert.Equal(t, tt.want, diff.Changes)
+			assert.Equal(t, len(diff.Breaking()) > 0, diff.HasBreaking())
+		})
+	}
+}
+
+// A schema that refers to itself is not compared again below itself, so the
+// comparison ends.
+func TestDiffRecursiveSchema(t *testing.T) {
+	oldSpec := userSpec()
+	oldSpec.Schemas[0].Properties["Manager"] = &Schema{Name: "Manager", GoType: "*User", JSONTag: "manager,omitempty"}
+	newSpec := userSpec()
+	newSpec.Schemas[0].Properties["Manager"] = &Schema{Name: "Manager", GoType: "*User", JSONTag: "manager,omitempty"}
+	delete(newSpec.Schemas[0].Properties, "Age")
+
+	diff := Diff(oldSpec, newSpec)
+	var locations []string
+	for _, c := range diff.Changes {
+		locations = append(locations, c.Location)
+	}
+	assert.Equal(t, []string{"response 200 data.age"}, locations)
+}
+
+func TestSpecDiffReports(t *testing.T) {
+	newSpec := userSpec()
+	newSpec.Operations = newSpec.Operations[:1]
+	newSpec.Schemas[0].Properties["Name"] = &Schema{Name: "Name", Type: SchemaTypeString, JSONTag: "name"}
+	diff := Diff(userSpec(), newSpec)
+
+	var text bytes.Buffer
+	require.NoError(t, diff.WriteText(&text))
+	assert.Equal(t, `Breaking changes (1):
+  PATCH /users/{id}: operation UpdateUser was removed [operation-removed]
+
+Non-breaking changes (1):
+  GET /users/{id} response 200 data.name: field was added [property-added]
+`, text.String())
+
+	var markdown bytes.Buffer
+	require.NoError(t, diff.WriteMarkdown(&markdown))
+	assert.Contains(t, markdown.String(), "## Breaking changes (1)")
+	assert.Contains(t, markdown.String(),
+		"| `GET /users/{id}` | `response 200 data.name` | field was added | `property-added` |")
+}
@@ -0,0 +1,164 @@
+package spec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GetFixtureValue returns a Go expression of a valid value of this field of
+// entity for repository tests, or an empty string if the field is left at
+// its zero value. Values come from the schema example when it has one.
+// Optional and nullable fields are wrapped in a call to ptr.
+func (s *Schema) GetFixtureValue(entity *Schema) string {
+	value := s.fixtureValue(entity, s.fixtureLiteral())
+	if value == "" || !s.NeedsPointer() {
+		return value
+	}
+	return "ptr(" + value + ")"
+}
+
+// GetFixtureUpdateValue returns a Go expression of a valid value of this
+// field of entity that differs from the one of GetFixtureValue, or an empty
+// string if no such value is known. Only fields that are not pointers have
+// update values.
+func (s *Schema) GetFixtureUpdateValue(entity *Schema) string {
+	if s.NeedsPointer() {
+		return ""
+	}
+	current := s.fixtureLiteral()
+	switch {
+	case s.IsEnum():
+		for _, v := range s.Enum {
+			if v != current {
+				return s.fixtureValue(entity, v)
+			}
+		}
+	case s.Type == SchemaTypeBoolean:
+		return strconv.FormatBool(current != "true")
+	case s.Type == SchemaTypeInteger:
+		n, err := strconv.ParseInt(current, 10, 64)
+		if err != nil {
+			return ""
+		}
+		if s.Schema != nil && s.Schema.Maximum != nil && float64(n+1) > *s.Schema.Maximum {
+			return ""
+		}
+		return s.fixtureValue(entity, strconv.FormatInt(n+1, 10))
+	case s.Type == SchemaTypeString && s.Format == "" && s.fixturePatternFree():
+		updated := current + "-updated"
+		if s.Schema != nil && s.Schema.MaxLength != nil && int64(len(updated)) > *s.Schema.MaxLength {
+			return ""
+		}
+		return s.fixtureValue(entity, updated)
+	}
+	return ""
+}
+
+// fixtureValue returns literal as a Go expression of the field's type, or an
+// empty string for fields without fixture values.
+func (s *Schema) fixtureValue(entity *Schema, literal string) string {
+	if strings.HasPrefix(s.GoType, "*") || strings.HasPrefix(s.GoType, "[]") ||
+		strings.HasPrefix(s.GoType, "map") ||
+		s.Type == SchemaTypeObject || s.Type == SchemaTypeArray {
+		return "" // Structured values are left empty
+	}
+
+	if s.IsEnum() {
+		return fmt.Sprintf("models.%s(%q)", s.GetBaseType(entity), literal)
+	}
+	switch s.Format {
+	case FormatUUID:
+		return "uuid.New()"
+	case FormatDateTime:
+		return "time.Now().UTC().Truncate(time.Microsecond)"
+	case FormatDate:
+		return "time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)"
+	}
+
+	switch s.Type {
+	case SchemaTypeString:
+		return strconv.Quote(literal)
+	case SchemaTypeInteger, SchemaTypeNumber:
+		if s.GoType == "int" || s.GoType == "float64" {
+			return literal
+		}
+		return fmt.Sprintf("%s(%s)", s.GoType, literal)
+	case SchemaTypeBoolean:
+		return literal
+	}
+	return ""
+}
+
+// fixtureLiteral returns the example of the schema as a literal, falling
+// back to a value that satisfies its format, enum and bounds.
+func (s *Schema) fixtureLiteral() string {
+	example := s.fixtureExample()
+	switch {
+	case s.IsEnum():
+		for _, v := range s.Enum {
+			if v == example {
+				return v
+			}
+		}
+		return s.Enum[0]
+	case s.Type == SchemaTypeBoolean:
+		if example == "false" {
+			return example
+		}
+		return "true"
+	case s.Type == SchemaTypeInteger:
+		if _, err := strconv.ParseInt(example, 10, 64); err == nil {
+			return example
+		}
+		if s.Schema != nil && s.Schema.Minimum != nil {
+			return strconv.FormatInt(int64(*s.Schema.Minimum), 10)
+		}
+		return "1"
+	case s.Type == SchemaTypeNumber:
+		if _, err := strconv.ParseFloat(example, 64); err == nil {
+			return example
+		}
+		if s.Schema != nil && s.Schema.Minimum != nil {
+			return strconv.FormatFloat(*s.Schema.Minimum, 'f', -1, 64)
+		}
+		return "1.5"
+	}
+
+	if example != "" {
+		return example
+	}
+	switch s.Format {
+	case "email":
+		return "user@example.com"
+	case "uri", "url":
+		return "https://example.com"
+	}
+	n := int64(1)
+	if s.Schema != nil && s.Schema.MinLength != nil && *s.Schema.MinLength > n {
+		n = *s.Schema.MinLength
+	}
+	return strings.Repeat("a", int(n))
+}
+
+// fixtureExample returns the scalar example of the schema, if any.
+func (s *Schema) fixtureExample() string {
+	if s.Schema == nil {
+		return ""
+	}
+	if node := s.Schema.Example; node != nil && len(node.Content) == 0 {
+		return node.Value
+	}
+	for _, node := range s.Schema.Examples {
+		if node != nil && len(node.Content) == 0 {
+			return node.Value
+		}
+	}
+	return ""
+}
+
+// fixturePatternFree returns true if the schema constrains strings only by
+// length, so that suffixes keep values valid.
+func (s *Schema) fixturePatternFree() bool {
+	return s.Schema == nil || s.Schema.Pattern == ""
+}
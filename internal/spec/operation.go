@@ -14,9 +14,23 @@ type Operation struct {
 	Security              []Security    // Security requirements
 	RequestBody           *RequestBody  // Processed request body schema
 	XCodegenCustomHandler bool          // Whether this operation has a custom handler implementation
+	XCodegenPermissions   *Permissions  // What the caller's membership must allow, if checked
 	XInternal             string        // When set (e.g., "server", "config"), this operation should be imported not generated
 }
 
+// Permissions is what an operation requires of the caller's membership in
+// the organization, declared with x-codegen-permissions. Empty fields only
+// require a membership.
+type Permissions struct {
+	// Permission the caller's roles must grant, such as "pipelines:write"
+	Permission string `json:"permission,omitempty" yaml:"permission,omitempty"`
+	// MinRole is the lowest built-in role allowed, such as "admin"
+	MinRole string `json:"minRole,omitempty" yaml:"minRole,omitempty"`
+	// OrganizationParam names the path parameter holding the organization,
+	// for operations on an organization itself, such as "id"
+	OrganizationParam string `json:"organizationParam,omitempty" yaml:"organizationParam,omitempty"`
+}
+
 // IsInternal returns true if this operation should be imported from another package instead of generated.
 // If context is empty, it returns true whenever XInternal is set.
 // If context is provided, it returns true only when XInternal is set AND doesn't match the context.
@@ -34,10 +48,11 @@ func (o *Operation) IsInternal(context string) bool {
 
 // Param represents a parameter in an operation
 type Param struct {
-	*Schema        // Embed schema definition
-	In      string // Location (path, query, header, cookie)
-	Style   string // Parameter style (form, simple, etc.)
-	Explode bool   // Whether to explode array/object parameters
+	*Schema         // Embed schema definition
+	SpecName string // Name as written in the spec, which requests use
+	In       string // Location (path, query, header, cookie)
+	Style    string // Parameter style (form, simple, etc.)
+	Explode  bool   // Whether to explode array/object parameters
 }
 
 // RequestBody represents the request body definition for an API operation
@@ -98,6 +113,29 @@ func (o *Operation) GetPathParams() []Param {
 	return pathParams
 }
 
+// GetOrganizationField returns the field of entity that scopes its rows to the
+// organizationID path parameter of the operation, or nil if the operation has
+// no such parameter. Tenant-scoped entities are scoped by the tenant instead,
+// so they have no such field either.
+func (o *Operation) GetOrganizationField(entity *Schema) *Schema {
+	if entity == nil || entity.XCodegen != nil && entity.XCodegen.Repository != nil &&
+		entity.XCodegen.Repository.TenantField != nil {
+		return nil
+	}
+	for _, p := range o.GetPathParams() {
+		if p.Name != "OrganizationID" {
+			continue
+		}
+		// GetSortedProperties names the properties, which templates print
+		for _, field := range entity.GetSortedProperties() {
+			if field.Name == "OrganizationID" && field.Format == FormatUUID && !field.NeedsPointer() {
+				return field
+			}
+		}
+	}
+	return nil
+}
+
 // GetHeaderParams returns only the header parameters
 func (o *Operation) GetHeaderParams() []Param {
 	var headerParams []Param
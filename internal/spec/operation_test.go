@@ -0,0 +1,29 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Properties are only named once GetSortedProperties has run, which the
+// organization field must not depend on.
+func TestOperationGetOrganizationField(t *testing.T) {
+	member := &Schema{
+		Name: "Member",
+		Properties: map[string]*Schema{
+			"OrganizationID": {Format: FormatUUID, JSONTag: "organizationID"},
+		},
+	}
+	scoped := &Operation{ID: "CreateMember", Parameters: []Param{{
+		Schema: &Schema{Name: "OrganizationID"}, SpecName: "organizationID", In: "path",
+	}}}
+
+	field := scoped.GetOrganizationField(member)
+	require.NotNil(t, field)
+	assert.Equal(t, "OrganizationID", field.Name)
+
+	assert.Nil(t, (&Operation{ID: "ListMembers"}).GetOrganizationField(member))
+	assert.Nil(t, scoped.GetOrganizationField(nil))
+}
@@ -0,0 +1,104 @@
+package spec
+
+import (
+	"fmt"
+
+	"github.com/archesai/archesai/internal/strutil"
+)
+
+// ManyToManyRelation describes a many-to-many relation of an entity and the
+// join table that stores it.
+type ManyToManyRelation struct {
+	Name          string // Relation name in PascalCase, e.g. "Labels"
+	Entity        string // Related entity name, e.g. "Label"
+	Table         string // Join table name, e.g. "artifact_labels"
+	OwnerTable    string // Table of the entity declaring the relation
+	OwnerColumn   string // Join column referencing the owner, e.g. "artifact_id"
+	RelatedTable  string // Table of the related entity
+	RelatedColumn string // Join column referencing the related entity, e.g. "label_id"
+
+	// Set by Spec.GetManyToManyRelations from the related entity
+	RelatedTenantColumn string // Tenant column of the related table, if it is tenant-scoped
+	RelatedSoftDelete   bool   // Whether related rows are soft-deleted
+}
+
+// IsManyToMany returns true if the relation is stored in a join table.
+func (r XCodegenExtensionRepositoryRelationsItem) IsManyToMany() bool {
+	return r.Kind != nil && *r.Kind == RelationManyToMany
+}
+
+// GetForeignKeyRelations returns the relations stored as a foreign key
+// column on the entity's own table
+func (s *Schema) GetForeignKeyRelations() []XCodegenExtensionRepositoryRelationsItem {
+	var result []XCodegenExtensionRepositoryRelationsItem
+	for _, rel := range s.GetRepositoryRelations() {
+		if !rel.IsManyToMany() {
+			result = append(result, rel)
+		}
+	}
+	return result
+}
+
+// GetManyToManyRelations returns the relations stored in join tables
+func (s *Schema) GetManyToManyRelations() []ManyToManyRelation {
+	var result []ManyToManyRelation
+	ownerTable := strutil.SnakeCase(s.Name)
+	for _, rel := range s.GetRepositoryRelations() {
+		if !rel.IsManyToMany() {
+			continue
+		}
+		m := ManyToManyRelation{
+			Name:          strutil.PascalCase(rel.Field),
+			Entity:        strutil.PascalCase(rel.References),
+			Table:         ownerTable + "_" + strutil.SnakeCase(rel.Field),
+			OwnerTable:    ownerTable,
+			OwnerColumn:   ownerTable + "_id",
+			RelatedTable:  rel.References,
+			RelatedColumn: rel.References + "_id",
+		}
+		if rel.Through != nil && *rel.Through != "" {
+			m.Table = *rel.Through
+		}
+		// Self-referencing relations need distinct join columns
+		if m.RelatedColumn == m.OwnerColumn {
+			m.RelatedColumn = "related_" + m.RelatedColumn
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// GetManyToManyRelations returns the many-to-many relations of entity, with
+// the tenant column and soft delete of each related entity, so related rows of
+// other tenants can be neither associated nor listed. It fails if a relation
+// references an entity that is not in the spec or that belongs to another
+// package, since the generated repository reads related rows into the models
+// of its own package.
+func (s *Spec) GetManyToManyRelations(entity *Schema) ([]ManyToManyRelation, error) {
+	relations := entity.GetManyToManyRelations()
+	for i, rel := range relations {
+		related := s.GetEntity(rel.Entity)
+		if related == nil {
+			return nil, fmt.Errorf(
+				"relation %s of %s references %q, which is not an entity of the spec",
+				rel.Name, entity.Name, rel.RelatedTable,
+			)
+		}
+		if related.XInternal != entity.XInternal {
+			return nil, fmt.Errorf(
+				"relation %s of %s references %s of another package (x-internal %q, not %q); "+
+					"many-to-many relations must stay within one package",
+				rel.Name, entity.Name, related.Name, related.XInternal, entity.XInternal,
+			)
+		}
+		tenant, err := related.GetTenantField()
+		if err != nil {
+			return nil, err
+		}
+		if tenant != nil {
+			relations[i].RelatedTenantColumn = strutil.SnakeCase(tenant.Name)
+		}
+		relations[i].RelatedSoftDelete = related.UsesSoftDelete()
+	}
+	return relations, nil
+}
@@ -0,0 +1,113 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecGetManyToManyRelations(t *testing.T) {
+	entity := func(name, xInternal string, relations ...XCodegenExtensionRepositoryRelationsItem) *Schema {
+		return &Schema{
+			Name:               name,
+			XCodegenSchemaType: XCodegenSchemaTypeEntity,
+			XInternal:          xInternal,
+			XCodegen: &XCodegenExtension{
+				Repository: &XCodegenExtensionRepository{Relations: relations},
+			},
+		}
+	}
+	tenantScoped := func(s *Schema) *Schema {
+		tenantField, softDelete := "organizationID", true
+		s.Properties = map[string]*Schema{
+			"OrganizationID": {Format: FormatUUID, JSONTag: "organizationID"},
+		}
+		s.XCodegen.Repository.TenantField = &tenantField
+		s.XCodegen.Repository.SoftDelete = &softDelete
+		return s
+	}
+	kind := RelationManyToMany
+	manyToMany := func(field, references string) XCodegenExtensionRepositoryRelationsItem {
+		return XCodegenExtensionRepositoryRelationsItem{Field: field, Kind: &kind, References: references}
+	}
+
+	tests := []struct {
+		name    string
+		schemas []*Schema
+		want    []ManyToManyRelation
+		wantErr string
+	}{
+		{
+			name: "same package",
+			schemas: []*Schema{
+				entity("Artifact", "storage", manyToMany("labels", "label")),
+				entity("Label", "storage"),
+			},
+			want: []ManyToManyRelation{{
+				Name:          "Labels",
+				Entity:        "Label",
+				Table:         "artifact_labels",
+				OwnerTable:    "artifact",
+				OwnerColumn:   "artifact_id",
+				RelatedTable:  "label",
+				RelatedColumn: "label_id",
+			}},
+		},
+		{
+			name: "tenant-scoped related entity",
+			schemas: []*Schema{
+				entity("Artifact", "storage", manyToMany("labels", "label")),
+				tenantScoped(entity("Label", "storage")),
+			},
+			want: []ManyToManyRelation{{
+				Name:                "Labels",
+				Entity:              "Label",
+				Table:               "artifact_labels",
+				OwnerTable:          "artifact",
+				OwnerColumn:         "artifact_id",
+				RelatedTable:        "label",
+				RelatedColumn:       "label_id",
+				RelatedTenantColumn: "organization_id",
+				RelatedSoftDelete:   true,
+			}},
+		},
+		{
+			name: "belongsTo relations are ignored",
+			schemas: []*Schema{
+				entity("Artifact", "storage", XCodegenExtensionRepositoryRelationsItem{
+					Field: "organizationID", References: "organization",
+				}),
+			},
+		},
+		{
+			name: "unknown entity",
+			schemas: []*Schema{
+				entity("Artifact", "storage", manyToMany("tags", "tag")),
+			},
+			wantErr: `relation Tags of Artifact references "tag", which is not an entity of the spec`,
+		},
+		{
+			name: "other package",
+			schemas: []*Schema{
+				entity("Artifact", "storage", manyToMany("members", "member")),
+				entity("Member", "auth"),
+			},
+			wantErr: `relation Members of Artifact references Member of another package (x-internal "auth", not "storage")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Spec{Schemas: tt.schemas}
+			got, err := s.GetManyToManyRelations(tt.schemas[0])
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,10 +2,13 @@ package spec
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+
+	"github.com/archesai/archesai/internal/strutil"
 )
 
 // XCodegenSchemaType represents the enumeration of valid values for SchemaType
@@ -34,6 +37,9 @@ type Schema struct {
 	// For arrays: item schema
 	Items *Schema
 
+	// For oneOf/anyOf: the variants and how to tell them apart
+	Union *Union
+
 	// For all types
 	Enum         []string
 	DefaultValue any
@@ -50,7 +56,7 @@ type Schema struct {
 	XInternal          string // When set (e.g., "server", "config"), this schema should be imported not generated
 
 	// Original OpenAPI schema reference
-	Schema *base.Schema
+	Schema *base.Schema `json:"-"`
 }
 
 // IsInternal returns true if this schema should be imported from another package instead of generated.
@@ -95,6 +101,14 @@ func (s *Schema) GetRepositoryIndices() []string {
 	return s.XCodegen.Repository.Indices
 }
 
+// GetRepositoryUniqueIndices returns the repository unique indices
+func (s *Schema) GetRepositoryUniqueIndices() []string {
+	if s.XCodegen == nil || s.XCodegen.Repository == nil {
+		return nil
+	}
+	return s.XCodegen.Repository.UniqueIndices
+}
+
 // GetRepositoryRelations returns the repository relations
 func (s *Schema) GetRepositoryRelations() []XCodegenExtensionRepositoryRelationsItem {
 	if s.XCodegen == nil || s.XCodegen.Repository == nil {
@@ -103,6 +117,104 @@ func (s *Schema) GetRepositoryRelations() []XCodegenExtensionRepositoryRelations
 	return s.XCodegen.Repository.Relations
 }
 
+// UsesCursorPagination returns true if list operations use keyset (cursor) pagination
+func (s *Schema) UsesCursorPagination() bool {
+	return s.XCodegen != nil && s.XCodegen.Repository != nil &&
+		s.XCodegen.Repository.Pagination != nil &&
+		*s.XCodegen.Repository.Pagination == PaginationCursor
+}
+
+// UsesSoftDelete returns true if Delete marks rows with deleted_at instead of removing them
+func (s *Schema) UsesSoftDelete() bool {
+	return s.XCodegen != nil && s.XCodegen.Repository != nil &&
+		s.XCodegen.Repository.SoftDelete != nil && *s.XCodegen.Repository.SoftDelete
+}
+
+// NeedsDeletedAtColumn returns true if the deleted_at column is added to the
+// table rather than declared as a DeletedAt property in the spec
+func (s *Schema) NeedsDeletedAtColumn() bool {
+	return s.UsesSoftDelete() && !s.HasProperty("DeletedAt")
+}
+
+// UsesVersioning returns true if updates are conditional on a version column
+// that is exposed to clients as an ETag
+func (s *Schema) UsesVersioning() bool {
+	return s.XCodegen != nil && s.XCodegen.Repository != nil &&
+		s.XCodegen.Repository.Versioned != nil && *s.XCodegen.Repository.Versioned
+}
+
+// GetTenantField returns the property that scopes rows to the organization
+// in the request context, or nil if the entity is not tenant-scoped. It fails
+// if tenantField does not name a required, non-nullable UUID property.
+func (s *Schema) GetTenantField() (*Schema, error) {
+	if s.XCodegen == nil || s.XCodegen.Repository == nil || s.XCodegen.Repository.TenantField == nil {
+		return nil, nil
+	}
+	name := *s.XCodegen.Repository.TenantField
+	for _, prop := range s.GetSortedProperties() {
+		if prop.Name != name && prop.Name != strutil.PascalCase(name) {
+			continue
+		}
+		if prop.Format != FormatUUID || prop.NeedsPointer() {
+			return nil, fmt.Errorf("tenantField %s of %s must be a required, non-nullable uuid", name, s.Name)
+		}
+		return prop, nil
+	}
+	return nil, fmt.Errorf("tenantField %s is not a property of %s", name, s.Name)
+}
+
+// GetSearchableFields returns the properties indexed for full-text search, in
+// the order they are declared, or nil if the entity is not searchable. It
+// fails if searchable names a property that is not stored as text, or if the entity
+// uses cursor pagination, whose keyset order cannot follow search rank.
+func (s *Schema) GetSearchableFields() ([]*Schema, error) {
+	if s.XCodegen == nil || s.XCodegen.Repository == nil || len(s.XCodegen.Repository.Searchable) == 0 {
+		return nil, nil
+	}
+	if s.UsesCursorPagination() {
+		return nil, fmt.Errorf("searchable %s cannot use cursor pagination", s.Name)
+	}
+	fields := make([]*Schema, 0, len(s.XCodegen.Repository.Searchable))
+	for _, name := range s.XCodegen.Repository.Searchable {
+		var field *Schema
+		for _, prop := range s.GetSortedProperties() {
+			if prop.Name == name || prop.Name == strutil.PascalCase(name) {
+				field = prop
+				break
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("searchable field %s is not a property of %s", name, s.Name)
+		}
+		switch {
+		case field.Type != SchemaTypeString, field.Format == FormatUUID,
+			field.Format == FormatDate, field.Format == FormatDateTime:
+			return nil, fmt.Errorf("searchable field %s of %s must be a text string", name, s.Name)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// IsSearchable returns true if the entity declares searchable fields
+func (s *Schema) IsSearchable() bool {
+	return s.XCodegen != nil && s.XCodegen.Repository != nil && len(s.XCodegen.Repository.Searchable) > 0
+}
+
+// AllowsDestructiveMigrations returns true if generated migrations may drop,
+// retype or tighten the columns of this schema or property
+func (s *Schema) AllowsDestructiveMigrations() bool {
+	return s.XCodegen != nil && s.XCodegen.AllowDestructive != nil && *s.XCodegen.AllowDestructive
+}
+
+// GetRenamedFrom returns the previous name of this property, or an empty string
+func (s *Schema) GetRenamedFrom() string {
+	if s.XCodegen == nil || s.XCodegen.RenamedFrom == nil {
+		return ""
+	}
+	return *s.XCodegen.RenamedFrom
+}
+
 // GetRequiredProperties returns only the required properties
 func (s *Schema) GetRequiredProperties() map[string]*Schema {
 	required := make(map[string]*Schema)
@@ -460,3 +572,144 @@ func (s *Schema) GetDBMapValue(dbVar string, parentSchema *Schema) string {
 	// Everything else - pass through
 	return fieldAccess
 }
+
+// JSONName returns the JSON property name without tag options
+func (s *Schema) JSONName() string {
+	name, _, _ := strings.Cut(s.JSONTag, ",")
+	return name
+}
+
+// GetListColumnKind returns the database.ColumnKind expression used when
+// filtering and sorting on this field, or "" if the field is not filterable
+func (s *Schema) GetListColumnKind() string {
+	switch s.Format {
+	case FormatUUID:
+		return "database.ColumnUUID"
+	case FormatDateTime, FormatDate:
+		return "database.ColumnTime"
+	case "binary":
+		return ""
+	}
+	// Embeddings are stored as vectors
+	if strings.Contains(strings.ToLower(s.Name), "embedding") {
+		return ""
+	}
+	switch s.Type {
+	case SchemaTypeString:
+		return "database.ColumnString"
+	case SchemaTypeInteger:
+		return "database.ColumnInteger"
+	case SchemaTypeNumber:
+		return "database.ColumnNumber"
+	case SchemaTypeBoolean:
+		return "database.ColumnBool"
+	default:
+		return ""
+	}
+}
+
+// GetSQLColumns returns the columns the generated repositories select for
+// the entity, in the order its properties are scanned. The deleted_at column
+// added for soft deletes is not read into the entity, so it is left out.
+func (s *Schema) GetSQLColumns() []string {
+	var columns []string
+	for _, prop := range s.GetSortedProperties() {
+		columns = append(columns, strutil.SnakeCase(prop.Name))
+	}
+	if s.UsesVersioning() {
+		columns = append(columns, "version")
+	}
+	return columns
+}
+
+// GetSQLColumnList returns GetSQLColumns as the column list of a SELECT or
+// RETURNING clause.
+func (s *Schema) GetSQLColumnList() string {
+	return strings.Join(s.GetSQLColumns(), ", ")
+}
+
+// GetSQLiteScanTarget returns the Go expression passed to Scan when reading
+// this field from a SQLite row into entityVar
+func (s *Schema) GetSQLiteScanTarget(entityVar string) string {
+	fieldAccess := fmt.Sprintf("&%s.%s", entityVar, s.Name)
+
+	// Timestamps are stored as TEXT
+	if s.Format == FormatDateTime || s.Format == FormatDate {
+		if s.NeedsPointer() {
+			return fmt.Sprintf("database.SQLiteNullTime(%s)", fieldAccess)
+		}
+		return fmt.Sprintf("database.SQLiteTime(%s)", fieldAccess)
+	}
+
+	// Objects and arrays are stored as JSON TEXT
+	if s.Type == SchemaTypeObject || s.Type == SchemaTypeArray {
+		return fmt.Sprintf("database.JSONColumn(%s)", fieldAccess)
+	}
+
+	return fieldAccess
+}
+
+// GetSQLiteValue returns the Go expression passed as a query argument when
+// writing this field of entityVar to a SQLite row
+func (s *Schema) GetSQLiteValue(entityVar string) string {
+	fieldAccess := fmt.Sprintf("%s.%s", entityVar, s.Name)
+
+	// Timestamps are stored as fixed width TEXT
+	if s.Format == FormatDateTime || s.Format == FormatDate {
+		if s.NeedsPointer() {
+			return fmt.Sprintf("database.SQLiteNullTimeValue(%s)", fieldAccess)
+		}
+		return fmt.Sprintf("database.SQLiteTimeValue(%s)", fieldAccess)
+	}
+
+	// Objects and arrays are stored as JSON TEXT
+	if s.Type == SchemaTypeObject || s.Type == SchemaTypeArray {
+		return fmt.Sprintf("database.JSONValue(%s)", fieldAccess)
+	}
+
+	return fieldAccess
+}
+
+// GetCreateFields returns the properties written by Create: all but the
+// special fields and those listed in excludeFromCreate
+func (s *Schema) GetCreateFields() []*Schema {
+	var excluded []string
+	if s.XCodegen != nil && s.XCodegen.Repository != nil {
+		excluded = s.XCodegen.Repository.ExcludeFromCreate
+	}
+	return s.writableFields(excluded)
+}
+
+// GetUpdateFields returns the properties written by Update: all but the
+// special fields, the tenant field and those listed in excludeFromUpdate
+func (s *Schema) GetUpdateFields() []*Schema {
+	var excluded []string
+	if s.XCodegen != nil && s.XCodegen.Repository != nil {
+		excluded = s.XCodegen.Repository.ExcludeFromUpdate
+		// Rows never move to another tenant
+		if tenant := s.XCodegen.Repository.TenantField; tenant != nil {
+			excluded = append(slices.Clip(excluded), *tenant)
+		}
+	}
+	return s.writableFields(excluded)
+}
+
+func (s *Schema) writableFields(excluded []string) []*Schema {
+	var fields []*Schema
+	for _, field := range s.GetSortedProperties() {
+		if field.IsSpecialField() {
+			continue
+		}
+		isExcluded := false
+		for _, name := range excluded {
+			if name == field.Name || strutil.PascalCase(name) == field.Name {
+				isExcluded = true
+				break
+			}
+		}
+		if !isExcluded {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
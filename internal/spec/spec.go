@@ -12,7 +12,7 @@ type Spec struct {
 	Schemas         []*Schema    // All schemas defined in the spec
 	ProjectName     string       // Project name from x-project-name extension
 	EnabledIncludes []string     // Names of enabled x-include-* extensions
-	Document        *v3.Document // The underlying OpenAPI document
+	Document        *v3.Document `json:"-"` // The underlying OpenAPI document
 
 }
 
@@ -20,3 +20,13 @@ type Spec struct {
 func (s *Spec) HasInclude(name string) bool {
 	return slices.Contains(s.EnabledIncludes, name)
 }
+
+// GetEntity returns the entity schema with the given name, or nil if there is none
+func (s *Spec) GetEntity(name string) *Schema {
+	for _, schema := range s.Schemas {
+		if schema.Name == name && schema.XCodegenSchemaType == XCodegenSchemaTypeEntity {
+			return schema
+		}
+	}
+	return nil
+}
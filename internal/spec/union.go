@@ -0,0 +1,33 @@
+package spec
+
+// Union kinds
+const (
+	UnionOneOf = "oneOf"
+	UnionAnyOf = "anyOf"
+)
+
+// Union describes a polymorphic schema built from oneOf or anyOf
+// references to other component schemas.
+type Union struct {
+	Kind          string         // UnionOneOf or UnionAnyOf
+	Discriminator string         // JSON property that selects the variant, empty if none
+	Variants      []UnionVariant // Alternatives in spec order
+}
+
+// UnionVariant is one alternative of a Union.
+type UnionVariant struct {
+	GoType    string   // Go type of the variant, e.g. "HTTPStepConfig"
+	Values    []string // Discriminator values that select this variant
+	Required  []string // JSON properties a payload must carry to match this variant
+	Validates bool     // The variant is a value object with a Validate method
+}
+
+// IsUnion returns true if the schema is a oneOf/anyOf union.
+func (s *Schema) IsUnion() bool {
+	return s.Union != nil && len(s.Union.Variants) > 0
+}
+
+// IsOneOf returns true if exactly one variant may match.
+func (u *Union) IsOneOf() bool {
+	return u.Kind == UnionOneOf
+}
@@ -0,0 +1,269 @@
+package spec
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/archesai/archesai/internal/strutil"
+)
+
+// GetValidationChecks returns Go statements that check value against the
+// constraints of the schema: minLength, maxLength, pattern, format, enum,
+// minimum, maximum, minItems and maxItems. The statements call methods of a
+// *server.Validator named v and report violations at pointer. isPointer
+// tells whether value is a pointer that may be nil.
+func (s *Schema) GetValidationChecks(value, pointer string, isPointer bool) []string {
+	return s.validationChecks(value, strconv.Quote(pointer), isPointer)
+}
+
+// GetParamValidationChecks returns the checks of the path, header and query
+// parameters of the operation, read from the fields of a handler input named
+// input. Violations are reported at /<location>/<name>, with the name as
+// written in the spec.
+func (o *Operation) GetParamValidationChecks() []string {
+	var checks []string
+	for _, p := range o.Parameters {
+		switch p.In {
+		case "path", "header":
+		case "query":
+			if p.Name == "Filter" || p.Name == "Page" || p.Name == "Sort" {
+				continue // Bound and checked by the server package
+			}
+		default:
+			continue
+		}
+		value := "input." + strutil.PascalCase(p.Name)
+		isPointer := strings.HasPrefix(p.GoType, "*") || (p.In == "query" && p.NeedsPointer())
+		checks = append(checks, p.GetValidationChecks(value, "/"+p.In+"/"+p.SpecName, isPointer)...)
+	}
+	return checks
+}
+
+// GetBodyValidationChecks returns the checks of the request body members,
+// read from a request body named body decoded from the bytes named data.
+func (o *Operation) GetBodyValidationChecks() []string {
+	if o.RequestBody == nil || o.RequestBody.Schema == nil {
+		return nil
+	}
+	var checks []string
+	if required := o.RequestBody.Schema.Required; len(required) > 0 {
+		checks = append(checks, fmt.Sprintf("v.RequireFields(data, \"\", %s)", quoteAll(required)))
+	}
+	for _, prop := range o.RequestBody.GetSortedProperties() {
+		if prop.JSONName() == "" {
+			continue
+		}
+		checks = append(checks, prop.GetValidationChecks("body."+prop.Name, "/"+prop.JSONName(), prop.NeedsPointer())...)
+	}
+	return checks
+}
+
+// CheckPatterns returns an error if a pattern of the parameters or request
+// body of the operation is not a valid RE2 regular expression, which Go
+// cannot check requests against.
+func (o *Operation) CheckPatterns() error {
+	seen := make(map[*Schema]bool)
+	for _, p := range o.Parameters {
+		if err := p.checkPatterns("/"+p.In+"/"+p.SpecName, seen); err != nil {
+			return fmt.Errorf("operation %s: %w", o.ID, err)
+		}
+	}
+	if o.RequestBody != nil && o.RequestBody.Schema != nil {
+		if err := o.RequestBody.checkPatterns("", seen); err != nil {
+			return fmt.Errorf("operation %s: %w", o.ID, err)
+		}
+	}
+	return nil
+}
+
+// checkPatterns compiles the patterns of the schema, its properties and its
+// items. seen breaks cycles of recursive schemas.
+func (s *Schema) checkPatterns(pointer string, seen map[*Schema]bool) error {
+	if s == nil || seen[s] {
+		return nil
+	}
+	seen[s] = true
+	if s.Schema != nil && s.Schema.Pattern != "" {
+		if _, err := regexp.Compile(s.Schema.Pattern); err != nil {
+			return fmt.Errorf("pattern of %s is not supported by Go: %w", pointerOrRoot(pointer), err)
+		}
+	}
+	for _, prop := range s.GetSortedProperties() {
+		if err := prop.checkPatterns(pointer+"/"+prop.JSONName(), seen); err != nil {
+			return err
+		}
+	}
+	return s.Items.checkPatterns(pointer+"/items", seen)
+}
+
+// pointerOrRoot returns pointer, or "/" for the root of a request body.
+func pointerOrRoot(pointer string) string {
+	if pointer == "" {
+		return "/"
+	}
+	return pointer
+}
+
+// validationChecks is GetValidationChecks with pointer as a Go expression.
+func (s *Schema) validationChecks(value, pointer string, isPointer bool) []string {
+	goType := strings.TrimPrefix(s.GoType, "*")
+	if isPointer {
+		deref := "*" + value
+		if len(s.Properties) > 0 {
+			deref = value // Members of struct pointers are reached without dereferencing
+		}
+		checks := s.validationChecks(deref, pointer, false)
+		if len(checks) == 0 {
+			return nil
+		}
+		return []string{fmt.Sprintf("if %s != nil { %s }", value, strings.Join(checks, "; "))}
+	}
+
+	var checks []string
+	switch {
+	case s.Type == SchemaTypeArray:
+		checks = s.itemsChecks(value, pointer)
+	case len(s.Properties) > 0 && !strings.Contains(goType, "."):
+		// Inline objects are checked member by member
+		for _, prop := range s.GetSortedProperties() {
+			member := fmt.Sprintf("%s + %q", pointer, "/"+prop.JSONName())
+			if prop.JSONName() == "" {
+				continue
+			}
+			checks = append(checks, prop.validationChecks(value+"."+prop.Name, member, prop.NeedsPointer())...)
+		}
+	case s.Type == SchemaTypeString:
+		checks = s.stringChecks(value, pointer, goType)
+	case s.Type == SchemaTypeInteger || s.Type == SchemaTypeNumber:
+		checks = s.numberChecks(value, pointer)
+	}
+	return checks
+}
+
+// stringChecks returns the checks of a string value. Values of other Go
+// types, such as uuid.UUID or time.Time, were already parsed when decoded.
+func (s *Schema) stringChecks(value, pointer, goType string) []string {
+	if goType != "string" {
+		// Named enum types are checked through their string value
+		isEnumType := !strings.Contains(goType, ".") ||
+			strings.HasPrefix(goType, "models.") || strings.HasPrefix(goType, "servermodels.")
+		if enum := s.getEnum(); len(enum) > 0 && isEnumType {
+			return []string{fmt.Sprintf("v.Enum(%s, string(%s), %s)", pointer, value, quoteAll(enum))}
+		}
+		return nil
+	}
+
+	var checks []string
+	if base := s.Schema; base != nil {
+		if base.MinLength != nil && *base.MinLength > 0 {
+			checks = append(checks, fmt.Sprintf("v.MinLength(%s, %s, %d)", pointer, value, *base.MinLength))
+		}
+		if base.MaxLength != nil {
+			checks = append(checks, fmt.Sprintf("v.MaxLength(%s, %s, %d)", pointer, value, *base.MaxLength))
+		}
+		if base.Pattern != "" {
+			checks = append(checks, fmt.Sprintf("v.Pattern(%s, %s, %s)", pointer, value, goStringLiteral(base.Pattern)))
+		}
+	}
+	if s.Format != "" {
+		checks = append(checks, fmt.Sprintf("v.Format(%s, %s, %q)", pointer, value, s.Format))
+	}
+	if enum := s.getEnum(); len(enum) > 0 {
+		checks = append(checks, fmt.Sprintf("v.Enum(%s, %s, %s)", pointer, value, quoteAll(enum)))
+	}
+	return checks
+}
+
+// numberChecks returns the minimum and maximum checks of a number.
+func (s *Schema) numberChecks(value, pointer string) []string {
+	base := s.Schema
+	if base == nil {
+		return nil
+	}
+	var checks []string
+	minimum, exclusiveMin := base.Minimum, false
+	if base.ExclusiveMinimum != nil {
+		if base.ExclusiveMinimum.IsB() {
+			minimum, exclusiveMin = &base.ExclusiveMinimum.B, true
+		} else {
+			exclusiveMin = base.ExclusiveMinimum.A
+		}
+	}
+	if minimum != nil {
+		checks = append(checks, fmt.Sprintf("v.Minimum(%s, float64(%s), %v, %t)", pointer, value, *minimum, exclusiveMin))
+	}
+	maximum, exclusiveMax := base.Maximum, false
+	if base.ExclusiveMaximum != nil {
+		if base.ExclusiveMaximum.IsB() {
+			maximum, exclusiveMax = &base.ExclusiveMaximum.B, true
+		} else {
+			exclusiveMax = base.ExclusiveMaximum.A
+		}
+	}
+	if maximum != nil {
+		checks = append(checks, fmt.Sprintf("v.Maximum(%s, float64(%s), %v, %t)", pointer, value, *maximum, exclusiveMax))
+	}
+	return checks
+}
+
+// itemsChecks returns the minItems and maxItems checks of a list and the
+// checks of its items. Absent lists decode to nil and are not checked against
+// minItems; required ones are reported missing by RequireFields.
+func (s *Schema) itemsChecks(value, pointer string) []string {
+	var checks []string
+	if base := s.Schema; base != nil {
+		if base.MinItems != nil && *base.MinItems > 0 {
+			checks = append(checks, fmt.Sprintf(
+				"if %s != nil { v.MinItems(%s, len(%s), %d) }",
+				value,
+				pointer,
+				value,
+				*base.MinItems,
+			))
+		}
+		if base.MaxItems != nil {
+			checks = append(checks, fmt.Sprintf("v.MaxItems(%s, len(%s), %d)", pointer, value, *base.MaxItems))
+		}
+	}
+	if s.Items != nil {
+		itemPointer := fmt.Sprintf("%s + \"/\" + strconv.Itoa(i)", pointer)
+		if itemChecks := s.Items.validationChecks("item", itemPointer, false); len(itemChecks) > 0 {
+			checks = append(checks, fmt.Sprintf("for i, item := range %s { %s }", value, strings.Join(itemChecks, "; ")))
+		}
+	}
+	return checks
+}
+
+// getEnum returns the enum values of the schema, falling back to the
+// referenced OpenAPI schema.
+func (s *Schema) getEnum() []string {
+	if len(s.Enum) > 0 || s.Schema == nil {
+		return s.Enum
+	}
+	var values []string
+	for _, node := range s.Schema.Enum {
+		if node != nil {
+			values = append(values, node.Value)
+		}
+	}
+	return values
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// goStringLiteral returns a raw string literal when possible, which keeps
+// regular expressions readable.
+func goStringLiteral(s string) string {
+	if !strings.Contains(s, "`") {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
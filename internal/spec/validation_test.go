@@ -0,0 +1,131 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/internal/strutil"
+)
+
+func TestOperationCheckPatterns(t *testing.T) {
+	str := func(name, pattern string) *Schema {
+		return &Schema{Name: name, Type: SchemaTypeString, JSONTag: name, Schema: &base.Schema{Pattern: pattern}}
+	}
+	body := func(props ...*Schema) *RequestBody {
+		s := &Schema{Type: SchemaTypeObject, Properties: map[string]*Schema{}}
+		for _, p := range props {
+			s.Properties[p.Name] = p
+		}
+		return &RequestBody{Schema: s}
+	}
+
+	tests := []struct {
+		name    string
+		op      Operation
+		wantErr string
+	}{
+		{
+			name: "valid patterns",
+			op: Operation{
+				ID:          "Register",
+				Parameters:  []Param{{Schema: str("RedirectURI", `^https://`), SpecName: "redirect_uri", In: "query"}},
+				RequestBody: body(str("email", `^[a-z]+@[a-z.]+$`)),
+			},
+		},
+		{
+			name: "lookahead in body",
+			op: Operation{
+				ID:          "Register",
+				RequestBody: body(str("email", `^(?!\.)[a-z.]+$`)),
+			},
+			wantErr: "operation Register: pattern of /email is not supported by Go",
+		},
+		{
+			name: "backreference in query parameter",
+			op: Operation{
+				ID:         "Search",
+				Parameters: []Param{{Schema: str("Q", `^(a)\1$`), SpecName: "q", In: "query"}},
+			},
+			wantErr: "operation Search: pattern of /query/q is not supported by Go",
+		},
+		{
+			name: "array items",
+			op: Operation{
+				ID: "Tag",
+				RequestBody: body(&Schema{
+					Name:    "tags",
+					Type:    SchemaTypeArray,
+					JSONTag: "tags",
+					Items:   str("", `(?<=#)\w+`),
+				}),
+			},
+			wantErr: "operation Tag: pattern of /tags/items is not supported by Go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.op.CheckPatterns()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestOperationGetParamValidationChecks(t *testing.T) {
+	maxLength := int64(10)
+	param := func(specName, in, goType string) Param {
+		return Param{
+			Schema: &Schema{
+				Name:   strutil.PascalCase(specName),
+				Type:   SchemaTypeString,
+				GoType: goType,
+				Schema: &base.Schema{MaxLength: &maxLength},
+			},
+			SpecName: specName,
+			In:       in,
+		}
+	}
+	op := Operation{Parameters: []Param{
+		param("redirect_uri", "query", "*string"),
+		param("X-Confirm", "header", "string"),
+		param("organizationID", "path", "string"),
+	}}
+
+	assert.Equal(t, []string{
+		`if input.RedirectURI != nil { v.MaxLength("/query/redirect_uri", *input.RedirectURI, 10) }`,
+		`v.MaxLength("/header/X-Confirm", input.XConfirm, 10)`,
+		`v.MaxLength("/path/organizationID", input.OrganizationID, 10)`,
+	}, op.GetParamValidationChecks())
+}
+
+func TestOperationGetBodyValidationChecks(t *testing.T) {
+	minItems, maxItems := int64(1), int64(5)
+	op := Operation{RequestBody: &RequestBody{Schema: &Schema{
+		Type: SchemaTypeObject,
+		Properties: map[string]*Schema{
+			"Tags": {
+				Name:    "Tags",
+				Type:    SchemaTypeArray,
+				GoType:  "[]string",
+				JSONTag: "tags",
+				Schema:  &base.Schema{MinItems: &minItems, MaxItems: &maxItems},
+			},
+		},
+		Required: []string{"tags"},
+	}}}
+
+	// Lists left out of a body are nil and only required ones are reported
+	assert.Equal(t, []string{
+		`v.RequireFields(data, "", "tags")`,
+		`if body.Tags != nil { v.MinItems("/tags", len(body.Tags), 1) }`,
+		`v.MaxItems("/tags", len(body.Tags), 5)`,
+	}, op.GetBodyValidationChecks())
+}
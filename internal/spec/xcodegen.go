@@ -3,6 +3,12 @@ package spec
 // XCodegenExtension represents Configuration for code generation from OpenAPI schemas
 type XCodegenExtension struct {
 
+	// AllowDestructive Allow generated migrations to drop, retype or tighten the columns of this schema or property
+	AllowDestructive *bool `json:"allowDestructive,omitempty" yaml:"allowDestructive,omitempty"`
+
+	// RenamedFrom Previous name of this property, so migrations rename its column instead of dropping it
+	RenamedFrom *string `json:"renamedFrom,omitempty" yaml:"renamedFrom,omitempty"`
+
 	// Repository Repository generation configuration
 	Repository *XCodegenExtensionRepository `json:"repository,omitempty" yaml:"repository,omitempty"`
 }
@@ -22,8 +28,26 @@ type XCodegenExtensionRepository struct {
 	// Indices Database indices to create
 	Indices []string `json:"indices,omitempty" yaml:"indices,omitempty"`
 
+	// Pagination Pagination mode for list operations (offset or cursor, defaults to offset)
+	Pagination *string `json:"pagination,omitempty" yaml:"pagination,omitempty"`
+
 	// Relations Foreign key relationships to other entities
 	Relations []XCodegenExtensionRepositoryRelationsItem `json:"relations,omitempty" yaml:"relations,omitempty"`
+
+	// Searchable String fields indexed for full-text search; list endpoints accept a q parameter matching them
+	Searchable []string `json:"searchable,omitempty" yaml:"searchable,omitempty"`
+
+	// SoftDelete Mark rows deleted with a deleted_at timestamp instead of removing them
+	SoftDelete *bool `json:"softDelete,omitempty" yaml:"softDelete,omitempty"`
+
+	// TenantField Property holding the owning organization; queries are scoped to the organization in the request context
+	TenantField *string `json:"tenantField,omitempty" yaml:"tenantField,omitempty"`
+
+	// UniqueIndices Fields whose values are unique; with soft delete only among rows that are not deleted
+	UniqueIndices []string `json:"uniqueIndices,omitempty" yaml:"uniqueIndices,omitempty"`
+
+	// Versioned Add a version column and make updates conditional on the expected version
+	Versioned *bool `json:"versioned,omitempty" yaml:"versioned,omitempty"`
 }
 
 // XCodegenExtensionRepositoryAdditionalMethodsItem represents a nested type for XCodegenExtension
@@ -55,9 +79,12 @@ type XCodegenExtensionRepositoryAdditionalMethodsItemParamsItem struct {
 // XCodegenExtensionRepositoryRelationsItem represents a nested type for XCodegenExtension
 type XCodegenExtensionRepositoryRelationsItem struct {
 
-	// Field The field name in this entity that references another entity
+	// Field The field name in this entity that references another entity, or the relation name for manyToMany
 	Field string `json:"field" yaml:"field"`
 
+	// Kind Relation kind (belongsTo or manyToMany, defaults to belongsTo)
+	Kind *string `json:"kind,omitempty" yaml:"kind,omitempty"`
+
 	// OnDelete Foreign key ON DELETE action
 	OnDelete *string `json:"onDelete,omitempty" yaml:"onDelete,omitempty"`
 
@@ -69,4 +96,7 @@ type XCodegenExtensionRepositoryRelationsItem struct {
 
 	// ReferencesField The field in the referenced table (defaults to 'id')
 	ReferencesField *string `json:"referencesField,omitempty" yaml:"referencesField,omitempty"`
+
+	// Through The join table of a manyToMany relation (defaults to <table>_<field>)
+	Through *string `json:"through,omitempty" yaml:"through,omitempty"`
 }
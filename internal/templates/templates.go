@@ -4,6 +4,10 @@ package templates
 import (
 	"embed"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -16,11 +20,106 @@ var templatesFS embed.FS
 
 // LoadTemplates loads all templates and returns them as a map
 func LoadTemplates() (*template.Template, error) {
-	template, err := template.New("root").Funcs(TemplateFuncs()).ParseFS(templatesFS, "tmpl/*.tmpl")
+	return LoadTemplatesWith(LoadOptions{})
+}
+
+// LoadOptions customizes the embedded template set.
+type LoadOptions struct {
+	// OverrideDir holds *.tmpl files parsed after the embedded templates. A
+	// file shadows the embedded template with the same name, other files and
+	// their {{define}} blocks are added as new templates and partials.
+	OverrideDir string
+
+	// Extra maps template names to template text, parsed last.
+	Extra map[string]string
+}
+
+// LoadTemplatesWith loads the embedded templates and applies opts.
+func LoadTemplatesWith(opts LoadOptions) (*template.Template, error) {
+	tmpl, err := template.New("root").Funcs(TemplateFuncs()).ParseFS(templatesFS, "tmpl/*.tmpl")
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse templates: %w", err)
 	}
-	return template, nil
+
+	if opts.OverrideDir != "" {
+		if _, err := os.Stat(opts.OverrideDir); err != nil {
+			return nil, fmt.Errorf("template override directory: %w", err)
+		}
+		files, err := filepath.Glob(filepath.Join(opts.OverrideDir, "*.tmpl"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list template overrides: %w", err)
+		}
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read template override: %w", err)
+			}
+			if _, err := tmpl.New(filepath.Base(file)).Parse(string(content)); err != nil {
+				return nil, fmt.Errorf("failed to parse template override %s: %w", file, err)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(opts.Extra))
+	for name := range opts.Extra {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := tmpl.New(name).Parse(opts.Extra[name]); err != nil {
+			return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
+		}
+	}
+
+	return tmpl, nil
+}
+
+// DefaultNames returns the names of the embedded templates.
+func DefaultNames() ([]string, error) {
+	entries, err := fs.ReadDir(templatesFS, "tmpl")
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names, nil
+}
+
+// DefaultTemplate returns the source of the embedded template name.
+func DefaultTemplate(name string) ([]byte, error) {
+	content, err := templatesFS.ReadFile("tmpl/" + name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown template %q", name)
+	}
+	return content, nil
+}
+
+// Eject copies the embedded templates names into dir so they can be edited
+// as overrides, and returns the paths written. Existing files are only
+// replaced when force is set.
+func Eject(dir string, names []string, force bool) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		content, err := DefaultTemplate(name)
+		if err != nil {
+			return paths, err
+		}
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil && !force {
+			return paths, fmt.Errorf("%s already exists; use --force to overwrite", path)
+		}
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			return paths, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
 }
 
 // TemplateFuncs returns common template functions used across all generators.
@@ -57,5 +156,9 @@ func TemplateFuncs() template.FuncMap {
 		"mapToSQLiteHCLType":     typeconv.SchemaToSQLiteHCLType,
 		"formatHCLDefault":       typeconv.FormatHCLDefault,
 		"formatSQLiteHCLDefault": typeconv.FormatSQLiteHCLDefault,
+
+		// TypeScript generation helpers
+		"tsType":        typeconv.SchemaToTSType,
+		"tsDeclaration": typeconv.SchemaToTSDeclaration,
 	}
 }
@@ -0,0 +1,195 @@
+package templates
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTemplatesWith(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		extra     map[string]string
+		// missingDir points OverrideDir at a directory that does not exist
+		missingDir bool
+
+		// template is executed with a Name field and must render want
+		template string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "embedded partial",
+			template: "header",
+			want:     "// Code generated by archesai. DO NOT EDIT.\n\n",
+		},
+		{
+			name:      "override shadows an embedded template",
+			overrides: map[string]string{"schema.go.tmpl": "custom {{ .Name }}"},
+			template:  "schema.go.tmpl",
+			want:      "custom todo",
+		},
+		{
+			name:      "override redefines a partial",
+			overrides: map[string]string{"partials.tmpl": `{{ define "header" }}// {{ .Name }}{{ end }}`},
+			template:  "header",
+			want:      "// todo",
+		},
+		{
+			name:      "override adds a template",
+			overrides: map[string]string{"notes.md.tmpl": "# {{ .Name | pascalCase }}"},
+			template:  "notes.md.tmpl",
+			want:      "# Todo",
+		},
+		{
+			name:      "files other than templates are ignored",
+			overrides: map[string]string{"schema.go.tmpl.orig": "ignored"},
+			template:  "header",
+			want:      "// Code generated by archesai. DO NOT EDIT.\n\n",
+		},
+		{
+			name:      "extra templates are parsed after overrides",
+			overrides: map[string]string{"notes.md.tmpl": "override"},
+			extra:     map[string]string{"notes.md.tmpl": "extra {{ .Name }}"},
+			template:  "notes.md.tmpl",
+			want:      "extra todo",
+		},
+		{
+			name:       "missing override directory",
+			missingDir: true,
+			wantErr:    "template override directory:",
+		},
+		{
+			name:      "invalid override",
+			overrides: map[string]string{"schema.go.tmpl": "{{ .Name "},
+			wantErr:   "failed to parse template override",
+		},
+		{
+			name:    "invalid extra template",
+			extra:   map[string]string{"notes.md.tmpl": "{{ end }}"},
+			wantErr: "failed to parse template notes.md.tmpl",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := LoadOptions{Extra: tt.extra}
+			if tt.overrides != nil || tt.missingDir {
+				opts.OverrideDir = t.TempDir()
+			}
+			if tt.missingDir {
+				opts.OverrideDir = filepath.Join(opts.OverrideDir, "missing")
+			}
+			for name, content := range tt.overrides {
+				require.NoError(t, os.WriteFile(filepath.Join(opts.OverrideDir, name), []byte(content), 0644))
+			}
+
+			tmpl, err := LoadTemplatesWith(opts)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, tmpl.ExecuteTemplate(&buf, tt.template, map[string]string{"Name": "todo"}))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestEject(t *testing.T) {
+	const edited = "edited"
+
+	tests := []struct {
+		name  string
+		names []string
+		force bool
+		// existing is written to the destination before ejecting
+		existing map[string]string
+
+		wantPaths []string
+		wantErr   string
+	}{
+		{
+			name:      "writes the embedded source",
+			names:     []string{"header.tmpl", "schema.go.tmpl"},
+			wantPaths: []string{"header.tmpl", "schema.go.tmpl"},
+		},
+		{
+			name:     "keeps an ejected template",
+			names:    []string{"header.tmpl"},
+			existing: map[string]string{"header.tmpl": edited},
+			wantErr:  "header.tmpl already exists; use --force to overwrite",
+		},
+		{
+			name:      "force overwrites an ejected template",
+			names:     []string{"header.tmpl"},
+			force:     true,
+			existing:  map[string]string{"header.tmpl": edited},
+			wantPaths: []string{"header.tmpl"},
+		},
+		{
+			// Templates before the failing one are still ejected
+			name:      "unknown template",
+			names:     []string{"header.tmpl", "missing.tmpl"},
+			wantPaths: []string{"header.tmpl"},
+			wantErr:   `unknown template "missing.tmpl"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The destination is created when it does not exist
+			dir := filepath.Join(t.TempDir(), "templates")
+			for name, content := range tt.existing {
+				require.NoError(t, os.MkdirAll(dir, 0755))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+			}
+
+			paths, err := Eject(dir, tt.names, tt.force)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			want := make([]string, 0, len(tt.wantPaths))
+			for _, name := range tt.wantPaths {
+				want = append(want, filepath.Join(dir, name))
+			}
+			assert.Equal(t, want, paths)
+			for _, path := range paths {
+				embedded, err := DefaultTemplate(filepath.Base(path))
+				require.NoError(t, err)
+				content, err := os.ReadFile(path)
+				require.NoError(t, err)
+				assert.Equal(t, string(embedded), string(content))
+			}
+			for name, content := range tt.existing {
+				if !tt.force {
+					got, err := os.ReadFile(filepath.Join(dir, name))
+					require.NoError(t, err)
+					assert.Equal(t, content, string(got))
+				}
+			}
+		})
+	}
+}
+
+func TestDefaultNames(t *testing.T) {
+	names, err := DefaultNames()
+	require.NoError(t, err)
+	assert.Contains(t, names, "header.tmpl")
+
+	// Every listed name can be ejected
+	for _, name := range names {
+		_, err := DefaultTemplate(name)
+		assert.NoError(t, err, name)
+	}
+}
@@ -0,0 +1,158 @@
+package typeconv
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/archesai/archesai/internal/spec"
+)
+
+// TypeScript type constants
+const (
+	tsTypeString  = "string"
+	tsTypeNumber  = "number"
+	tsTypeBoolean = "boolean"
+	tsTypeUnknown = "unknown"
+	tsTypeRecord  = "Record<string, unknown>"
+)
+
+// SchemaToTSType converts a Schema to a TypeScript type expression.
+// References to component schemas use the component name, inline objects
+// and enums are spelled out.
+func SchemaToTSType(field *spec.Schema) string {
+	if field == nil {
+		return tsTypeUnknown
+	}
+	tsType := schemaToTSType(field)
+	if field.Nullable {
+		return tsType + " | null"
+	}
+	return tsType
+}
+
+// SchemaToTSDeclaration converts a component schema to the right-hand side
+// of a TypeScript type declaration, spelling out its own structure rather
+// than referring to it by name.
+func SchemaToTSDeclaration(schema *spec.Schema) string {
+	if schema.IsUnion() || schema.IsEnum() || len(schema.Properties) > 0 {
+		return SchemaToTSType(schema)
+	}
+	switch schema.Type {
+	case spec.SchemaTypeObject:
+		return tsTypeRecord
+	case spec.SchemaTypeArray:
+		return tsArrayType(SchemaToTSType(schema.Items))
+	}
+	return tsPrimitiveType(schema.Type)
+}
+
+func schemaToTSType(field *spec.Schema) string {
+	// Unions name their variants
+	if field.IsUnion() {
+		variants := make([]string, 0, len(field.Union.Variants))
+		for _, v := range field.Union.Variants {
+			variants = append(variants, tsTypeName(v.GoType))
+		}
+		return strings.Join(variants, " | ")
+	}
+
+	// Inline objects and enums are spelled out
+	if len(field.Properties) > 0 {
+		return tsObjectType(field)
+	}
+	if field.IsEnum() {
+		values := make([]string, 0, len(field.Enum))
+		for _, v := range field.Enum {
+			values = append(values, fmt.Sprintf("%q", v))
+		}
+		return strings.Join(values, " | ")
+	}
+
+	if field.Type == spec.SchemaTypeArray {
+		return tsArrayType(SchemaToTSType(field.Items))
+	}
+
+	// References to component schemas keep their name
+	if name := tsTypeName(field.GoType); name != "" {
+		return name
+	}
+
+	if field.Type == spec.SchemaTypeObject {
+		return tsTypeRecord
+	}
+	return tsPrimitiveType(field.Type)
+}
+
+// tsObjectType spells out an object type, marking optional properties.
+func tsObjectType(schema *spec.Schema) string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]string, 0, len(names))
+	for _, name := range names {
+		prop := schema.Properties[name]
+		key := prop.JSONName()
+		if key == "" {
+			key = name
+		}
+		optional := ""
+		if !schema.IsPropertyRequired(key) {
+			optional = "?"
+		}
+		fields = append(fields, fmt.Sprintf("%s%s: %s", tsPropertyKey(key), optional, SchemaToTSType(prop)))
+	}
+	return "{ " + strings.Join(fields, "; ") + " }"
+}
+
+// tsArrayType wraps a TypeScript element type in an array type.
+func tsArrayType(elem string) string {
+	if strings.Contains(elem, " | ") && !strings.HasPrefix(elem, "{") {
+		return "(" + elem + ")[]"
+	}
+	return elem + "[]"
+}
+
+// tsTypeName returns the TypeScript name of a Go type that names a component
+// schema, such as models.User, or an empty string for builtin Go types.
+func tsTypeName(goType string) string {
+	name := strings.TrimLeft(goType, "*[]")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		pkg := name[:i]
+		if pkg != "models" && pkg != "servermodels" {
+			return ""
+		}
+		name = name[i+1:]
+	}
+	if name == "" || name[0] < 'A' || name[0] > 'Z' {
+		return ""
+	}
+	return name
+}
+
+// tsPrimitiveType maps an OpenAPI primitive type to TypeScript.
+func tsPrimitiveType(schemaType string) string {
+	switch schemaType {
+	case spec.SchemaTypeString:
+		return tsTypeString
+	case spec.SchemaTypeInteger, spec.SchemaTypeNumber:
+		return tsTypeNumber
+	case spec.SchemaTypeBoolean:
+		return tsTypeBoolean
+	}
+	return tsTypeUnknown
+}
+
+// tsPropertyKey quotes property names that are not valid identifiers.
+func tsPropertyKey(name string) string {
+	for i, r := range name {
+		isLetter := r == '_' || r == '$' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !isLetter && (i == 0 || r < '0' || r > '9') {
+			return fmt.Sprintf("%q", name)
+		}
+	}
+	return name
+}
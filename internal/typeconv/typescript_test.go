@@ -0,0 +1,76 @@
+package typeconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/archesai/archesai/internal/spec"
+)
+
+func TestSchemaToTSType(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *spec.Schema
+		want   string
+	}{
+		{name: "missing schema", want: "unknown"},
+		{name: "string", schema: &spec.Schema{Type: spec.SchemaTypeString}, want: "string"},
+		{name: "integer", schema: &spec.Schema{Type: spec.SchemaTypeInteger}, want: "number"},
+		{
+			name:   "nullable",
+			schema: &spec.Schema{Type: spec.SchemaTypeBoolean, Nullable: true},
+			want:   "boolean | null",
+		},
+		{
+			name:   "enum",
+			schema: &spec.Schema{Type: spec.SchemaTypeString, Enum: []string{"admin", "member"}},
+			want:   `"admin" | "member"`,
+		},
+		{
+			name:   "component reference",
+			schema: &spec.Schema{Type: spec.SchemaTypeObject, GoType: "*models.Label"},
+			want:   "Label",
+		},
+		{
+			name: "array of references",
+			schema: &spec.Schema{
+				Type:  spec.SchemaTypeArray,
+				Items: &spec.Schema{Type: spec.SchemaTypeObject, GoType: "models.Label"},
+			},
+			want: "Label[]",
+		},
+		{
+			name: "array of nullable values",
+			schema: &spec.Schema{
+				Type:  spec.SchemaTypeArray,
+				Items: &spec.Schema{Type: spec.SchemaTypeString, Nullable: true},
+			},
+			want: "(string | null)[]",
+		},
+		{
+			name:   "free-form object",
+			schema: &spec.Schema{Type: spec.SchemaTypeObject, GoType: "map[string]any"},
+			want:   "Record<string, unknown>",
+		},
+		{
+			// Optional properties are marked and invalid identifiers quoted
+			name: "inline object",
+			schema: &spec.Schema{
+				Type: spec.SchemaTypeObject,
+				Properties: map[string]*spec.Schema{
+					"Name":        {Type: spec.SchemaTypeString, JSONTag: "name"},
+					"ContentType": {Type: spec.SchemaTypeString, JSONTag: "content-type"},
+				},
+				Required: []string{"name"},
+			},
+			want: `{ "content-type"?: string; name: string }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SchemaToTSType(tt.schema))
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Package apiclient provides the runtime shared by generated Go API clients.
+//
+// Generated clients embed a Client and add one typed method per operation.
+// This package handles authentication, request encoding and decoding of
+// RFC 7807 problem details into errors.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RequestEditor modifies a request before it is sent.
+type RequestEditor func(ctx context.Context, req *http.Request) error
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithBearerToken authenticates requests with an Authorization bearer token.
+func WithBearerToken(token string) Option {
+	return WithRequestEditor(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// WithCookie sends a cookie, such as a session cookie, with every request.
+func WithCookie(name, value string) Option {
+	return WithRequestEditor(func(_ context.Context, req *http.Request) error {
+		req.AddCookie(&http.Cookie{Name: name, Value: value})
+		return nil
+	})
+}
+
+// WithAPIKey sends an API key in the given header with every request.
+func WithAPIKey(header, key string) Option {
+	return WithRequestEditor(func(_ context.Context, req *http.Request) error {
+		req.Header.Set(header, key)
+		return nil
+	})
+}
+
+// WithRequestEditor adds a function that is called on every request.
+func WithRequestEditor(fn RequestEditor) Option {
+	return func(c *Client) {
+		c.editors = append(c.editors, fn)
+	}
+}
+
+// Client sends requests to an API served at a base URL.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	editors    []RequestEditor
+}
+
+// New creates a client for the API served at baseURL.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Request describes a single API call.
+type Request struct {
+	Method string
+	Path   string // Path with parameters already substituted
+	Query  url.Values
+	Header http.Header
+	Body   any // Encoded as JSON when not nil
+}
+
+// Do sends the request and decodes a successful JSON response into out,
+// which may be nil. Responses outside the 2xx range are returned as *Error.
+func (c *Client) Do(ctx context.Context, r Request, out any) error {
+	var body io.Reader
+	if r.Body != nil {
+		data, err := json.Marshal(r.Body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	target := c.baseURL + r.Path
+	if len(r.Query) > 0 {
+		target += "?" + r.Query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, r.Method, target, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for key, values := range r.Header {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if r.Body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json, application/problem+json")
+	for _, edit := range c.editors {
+		if err := edit(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newError(resp)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// Error is a response outside the 2xx range. The problem details fields are
+// set when the server answered with an RFC 7807 body.
+type Error struct {
+	StatusCode int          `json:"-"`
+	Type       string       `json:"type"`
+	Title      string       `json:"title"`
+	Status     int          `json:"status"`
+	Detail     string       `json:"detail,omitempty"`
+	Instance   string       `json:"instance,omitempty"`
+	Errors     []FieldError `json:"errors,omitempty"` // Set when the request failed validation
+	Body       []byte       `json:"-"`                // Raw response body
+}
+
+// FieldError describes one request value rejected by the server's validation.
+type FieldError struct {
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func newError(resp *http.Response) *Error {
+	e := &Error{StatusCode: resp.StatusCode}
+	e.Body, _ = io.ReadAll(resp.Body)
+	_ = json.Unmarshal(e.Body, e)
+	return e
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	msg := e.Title
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	return fmt.Sprintf("api error %d: %s", e.StatusCode, msg)
+}
@@ -0,0 +1,142 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/server"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+func TestClientDo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/items":
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{"name": body["name"]})
+		default:
+			_ = server.WriteProblem(w, server.NewNotFoundResponse("no such item", r.URL.Path))
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL+"/", WithBearerToken("secret"))
+
+	t.Run("decodes success", func(t *testing.T) {
+		var out struct {
+			Name string `json:"name"`
+		}
+		err := c.Do(context.Background(), Request{
+			Method: http.MethodPost,
+			Path:   "/items",
+			Body:   map[string]string{"name": "a"},
+		}, &out)
+		require.NoError(t, err)
+		assert.Equal(t, "a", out.Name)
+	})
+
+	t.Run("decodes problem details", func(t *testing.T) {
+		err := c.Do(context.Background(), Request{Method: http.MethodGet, Path: "/missing"}, nil)
+		var apiErr *Error
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Equal(t, "Not Found", apiErr.Title)
+		assert.Equal(t, "no such item", apiErr.Detail)
+		assert.Equal(t, "api error 404: Not Found: no such item", err.Error())
+	})
+}
+
+func TestSetFilter(t *testing.T) {
+	values := url.Values{}
+	SetFilter(values, "filter", map[string]any{
+		"type": "or",
+		"children": []map[string]any{
+			{"type": "eq", "field": "name", "value": "a"},
+			{"type": "gt", "field": "count", "value": 3},
+		},
+	})
+
+	got, err := server.BindFilterQuery(values, "filter")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"type": "or",
+		"children": []any{
+			map[string]any{"type": "eq", "field": "name", "value": "a"},
+			map[string]any{"type": "gt", "field": "count", "value": "3"},
+		},
+	}, got)
+}
+
+func TestPaginate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	t.Run("offset", func(t *testing.T) {
+		limit := int32(2)
+		var calls int
+		fetch := func(_ context.Context, page servermodels.Page) ([]int, servermodels.PaginationMeta, error) {
+			calls++
+			start := 0
+			if page.Offset != nil {
+				start = int(*page.Offset)
+			}
+			end := min(start+int(*page.Limit), len(items))
+			return items[start:end], servermodels.PaginationMeta{Total: int32(len(items))}, nil
+		}
+
+		var got []int
+		for item, err := range Paginate(context.Background(), &servermodels.Page{Limit: &limit}, fetch) {
+			require.NoError(t, err)
+			got = append(got, item)
+		}
+		assert.Equal(t, items, got)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		fetch := func(_ context.Context, page servermodels.Page) ([]int, servermodels.PaginationMeta, error) {
+			start := 0
+			if page.Cursor != nil {
+				start, _ = strconv.Atoi(*page.Cursor)
+			}
+			end := min(start+2, len(items))
+			meta := servermodels.PaginationMeta{Total: int32(len(items))}
+			if end < len(items) {
+				next := strconv.Itoa(end)
+				meta.Next = &next
+			}
+			return items[start:end], meta, nil
+		}
+
+		var got []int
+		for item, err := range Paginate(context.Background(), nil, fetch) {
+			require.NoError(t, err)
+			got = append(got, item)
+		}
+		assert.Equal(t, items, got)
+	})
+
+	t.Run("stops on error", func(t *testing.T) {
+		fetch := func(context.Context, servermodels.Page) ([]int, servermodels.PaginationMeta, error) {
+			return nil, servermodels.PaginationMeta{}, errors.New("boom")
+		}
+
+		var errs int
+		for _, err := range Paginate(context.Background(), nil, fetch) {
+			require.Error(t, err)
+			errs++
+		}
+		assert.Equal(t, 1, errs)
+	})
+}
@@ -0,0 +1,57 @@
+package apiclient
+
+import (
+	"context"
+	"iter"
+
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// PageFetcher requests a single page of a list endpoint.
+type PageFetcher[T any] func(ctx context.Context, page servermodels.Page) ([]T, servermodels.PaginationMeta, error)
+
+// Paginate returns an iterator over the items of every page of a list
+// endpoint, starting at page, which may be nil. Cursor pagination follows
+// the next cursor of each response; offset pagination advances the offset
+// until the total number of items has been returned. Iteration stops after
+// the first error.
+func Paginate[T any](ctx context.Context, page *servermodels.Page, fetch PageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var p servermodels.Page
+		if page != nil {
+			p = *page
+		}
+		cursorMode := p.Cursor != nil
+
+		for {
+			items, meta, err := fetch(ctx, p)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if meta.Next != nil {
+				p.Cursor, p.Offset = meta.Next, nil
+				cursorMode = true
+				continue
+			}
+			if cursorMode || meta.Prev != nil || len(items) == 0 {
+				return
+			}
+			offset := int32(len(items))
+			if p.Offset != nil {
+				offset += *p.Offset
+			}
+			if offset >= meta.Total {
+				return
+			}
+			p.Offset = &offset
+		}
+	}
+}
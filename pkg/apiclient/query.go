@@ -0,0 +1,76 @@
+package apiclient
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// FormatParam formats a path, query or header parameter value.
+func FormatParam(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// SetFilter encodes a filter as the deepObject query parameter read by
+// server.BindFilterQuery, for example filter[type]=eq&filter[field]=name.
+// Group conditions are listed by index under children.
+func SetFilter(values url.Values, name string, filter map[string]any) {
+	for key, v := range filter {
+		setDeep(values, name+"["+key+"]", v)
+	}
+}
+
+func setDeep(values url.Values, key string, v any) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		for _, k := range rv.MapKeys() {
+			setDeep(values, key+"["+fmt.Sprint(k.Interface())+"]", rv.MapIndex(k).Interface())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range rv.Len() {
+			setDeep(values, key+"["+strconv.Itoa(i)+"]", rv.Index(i).Interface())
+		}
+	case reflect.Invalid:
+	default:
+		values.Set(key, FormatParam(v))
+	}
+}
+
+// SetPage encodes a page as the limit, offset and cursor query parameters.
+func SetPage(values url.Values, page *servermodels.Page) {
+	if page == nil {
+		return
+	}
+	if page.Limit != nil {
+		values.Set("limit", strconv.Itoa(int(*page.Limit)))
+	}
+	if page.Offset != nil {
+		values.Set("offset", strconv.Itoa(int(*page.Offset)))
+	}
+	if page.Cursor != nil {
+		values.Set("cursor", *page.Cursor)
+	}
+}
+
+// SetSort encodes sort entries such as "name", "-createdAt" or
+// "createdAt:asc" as a comma separated query parameter.
+func SetSort(values url.Values, name string, sort []string) {
+	if len(sort) > 0 {
+		values.Set(name, strings.Join(sort, ","))
+	}
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyPrefix starts every API key, which tells them apart from access
+// tokens in the Authorization header.
+const APIKeyPrefix = "ak_"
+
+// ErrInvalidAPIKey is returned when an API key is malformed, unknown or
+// expired.
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// NewAPIKeySecret generates an API key for an organization. The key is shown
+// to its owner once; only its hash is stored, in APIKey.KeyHash. The
+// organization ID is part of the key, so that it can be looked up in the
+// organization's rows.
+func NewAPIKeySecret(organizationID uuid.UUID) (key string, hash string, err error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	key = APIKeyPrefix + hex.EncodeToString(organizationID[:]) + "_" + hex.EncodeToString(secret)
+	return key, HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hash of an API key stored in APIKey.KeyHash.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAPIKey reports whether a bearer token is an API key.
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// parseAPIKey returns the organization an API key belongs to.
+func parseAPIKey(key string) (uuid.UUID, error) {
+	org, _, ok := strings.Cut(strings.TrimPrefix(key, APIKeyPrefix), "_")
+	if !IsAPIKey(key) || !ok {
+		return uuid.Nil, ErrInvalidAPIKey
+	}
+	b, err := hex.DecodeString(org)
+	if err != nil {
+		return uuid.Nil, ErrInvalidAPIKey
+	}
+	id, err := uuid.FromBytes(b)
+	if err != nil {
+		return uuid.Nil, ErrInvalidAPIKey
+	}
+	return id, nil
+}
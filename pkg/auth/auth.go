@@ -3,6 +3,7 @@ package auth
 import (
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
+	configmodels "github.com/archesai/archesai/pkg/config/models"
 )
 
 const (
@@ -21,6 +22,12 @@ type Session = models.Session
 // Account is an alias for the account model.
 type Account = models.Account
 
+// Member is an alias for the member model.
+type Member = models.Member
+
+// APIKey is an alias for the API key model.
+type APIKey = models.APIKey
+
 // SessionAuthProvider is an alias for the session auth provider model.
 type SessionAuthProvider = models.SessionAuthProvider
 
@@ -33,6 +40,9 @@ type UserRepository = repositories.UserRepository
 // AccountRepository is an alias for the account repository interface.
 type AccountRepository = repositories.AccountRepository
 
+// APIKeyRepository is an alias for the API key repository interface.
+type APIKeyRepository = repositories.APIKeyRepository
+
 // NewUser creates a new user with the given parameters.
 func NewUser(email string, emailVerified bool, image *string, name string) (*models.User, error) {
 	return models.NewUser(email, emailVerified, image, name)
@@ -83,3 +93,54 @@ type LocalAuthConfig struct {
 	Enabled   bool
 	JWTSecret string
 }
+
+// NewConfig builds the configuration of the authentication service from the
+// application configuration. Providers missing from it are disabled.
+func NewConfig(cfg *configmodels.Config) *Config {
+	c := &Config{
+		API:      &APIConfig{},
+		Platform: &PlatformConfig{},
+		Auth:     &ProvidersConfig{Local: &LocalAuthConfig{}},
+	}
+	if cfg.API != nil {
+		c.API.Host = cfg.API.Host
+		c.API.Port = uint16(cfg.API.Port)
+	}
+	if cfg.Platform != nil {
+		c.Platform.URL = cfg.Platform.URL
+	}
+	if cfg.Auth == nil {
+		return c
+	}
+	if local := cfg.Auth.Local; local != nil {
+		c.Auth.Local = &LocalAuthConfig{Enabled: local.Enabled, JWTSecret: local.JWTSecret}
+	}
+	if google := cfg.Auth.Google; google != nil {
+		c.Auth.Google = &OAuthProviderConfig{
+			Enabled:      google.Enabled,
+			ClientID:     google.ClientID,
+			ClientSecret: google.ClientSecret,
+			RedirectURL:  google.RedirectURL,
+		}
+	}
+	if github := cfg.Auth.Github; github != nil {
+		c.Auth.Github = &OAuthProviderConfig{
+			Enabled:      github.Enabled,
+			ClientID:     github.ClientID,
+			ClientSecret: github.ClientSecret,
+			RedirectURL:  github.RedirectURL,
+		}
+	}
+	if microsoft := cfg.Auth.Microsoft; microsoft != nil {
+		c.Auth.Microsoft = &OAuthProviderConfig{
+			Enabled:      microsoft.Enabled,
+			ClientSecret: microsoft.ClientSecret,
+			RedirectURL:  microsoft.RedirectURL,
+		}
+		if microsoft.ClientID != nil {
+			clientID := microsoft.ClientID.String()
+			c.Auth.Microsoft.ClientID = &clientID
+		}
+	}
+	return c
+}
@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+)
+
+var (
+	// ErrUnauthenticated is returned by Authorize when the caller is not signed in.
+	ErrUnauthenticated = errors.New("authentication required")
+
+	// ErrForbidden is returned by Authorize when the caller is not a member of
+	// the organization or their roles do not meet the requirement.
+	ErrForbidden = errors.New("insufficient permissions")
+)
+
+// MemberRole is an alias for the built-in role of a membership.
+type MemberRole = models.MemberRole
+
+// Built-in roles, from least to most privileged.
+const (
+	MemberRoleBasic = models.MemberRoleBasic
+	MemberRoleAdmin = models.MemberRoleAdmin
+	MemberRoleOwner = models.MemberRoleOwner
+)
+
+// Permissions is an alias for the effective permissions of a user in an
+// organization.
+type Permissions = models.Permissions
+
+// RoleRepository is an alias for the custom role repository interface.
+type RoleRepository = repositories.RoleRepository
+
+// MemberRepository is an alias for the member repository interface.
+type MemberRepository = repositories.MemberRepository
+
+// rolePermissions are the permissions granted by each built-in role. Custom
+// roles add to them.
+var rolePermissions = map[MemberRole][]string{
+	MemberRoleBasic: {"*:read"},
+	MemberRoleAdmin: {"*"},
+	MemberRoleOwner: {"*"},
+}
+
+// roleRank orders the built-in roles for minimum role checks.
+var roleRank = map[MemberRole]int{
+	MemberRoleBasic: 1,
+	MemberRoleAdmin: 2,
+	MemberRoleOwner: 3,
+}
+
+// Requirement is what an operation requires of the caller, declared with
+// x-codegen-permissions. Empty fields only require a membership.
+type Requirement struct {
+	// Permission must be granted by the caller's roles, such as "pipelines:write".
+	Permission string
+	// MinRole is the lowest built-in role allowed.
+	MinRole MemberRole
+	// OrganizationID is the organization named by the request path. When nil,
+	// the tenant of the context is used.
+	OrganizationID uuid.UUID
+}
+
+// Authorizer resolves the effective permissions of a user in an organization.
+type Authorizer interface {
+	// Permissions returns ErrForbidden if the user is not a member.
+	Permissions(ctx context.Context, userID, organizationID uuid.UUID) (*Permissions, error)
+}
+
+// MembershipAuthorizer resolves permissions from organization memberships and
+// the custom roles assigned to them.
+type MembershipAuthorizer struct {
+	members MemberRepository
+	roles   RoleRepository
+}
+
+var _ Authorizer = (*MembershipAuthorizer)(nil)
+
+// NewMembershipAuthorizer creates an authorizer backed by the given repositories.
+func NewMembershipAuthorizer(members MemberRepository, roles RoleRepository) *MembershipAuthorizer {
+	return &MembershipAuthorizer{
+		members: members,
+		roles:   roles,
+	}
+}
+
+// Permissions returns the built-in role of the user's membership and the
+// permissions granted by it and by the custom role of the membership, if any.
+func (a *MembershipAuthorizer) Permissions(
+	ctx context.Context,
+	userID, organizationID uuid.UUID,
+) (*Permissions, error) {
+	member, err := a.members.GetMemberByUserAndOrganization(ctx, userID.String(), organizationID.String())
+	if errors.Is(err, models.ErrMemberNotFound) {
+		return nil, ErrForbidden
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get membership: %w", err)
+	}
+
+	permissions := slices.Clone(rolePermissions[member.Role])
+	if member.RoleID != nil {
+		role, err := a.roles.Get(database.WithTenant(ctx, organizationID), *member.RoleID)
+		switch {
+		case errors.Is(err, models.ErrRoleNotFound):
+			// The role was deleted; the built-in role still applies
+		case err != nil:
+			return nil, fmt.Errorf("failed to get role: %w", err)
+		default:
+			permissions = append(permissions, role.Permissions...)
+		}
+	}
+
+	return &Permissions{
+		OrganizationID: organizationID,
+		Role:           models.PermissionsRole(member.Role),
+		RoleID:         member.RoleID,
+		Permissions:    permissions,
+	}, nil
+}
+
+// Authorize checks a requirement against the permissions of a user, in the
+// organization of the requirement or else the tenant of the context. A nil
+// authorizer denies every requirement.
+func Authorize(
+	ctx context.Context,
+	authorizer Authorizer,
+	userID uuid.UUID,
+	req Requirement,
+) (*Permissions, error) {
+	if userID == uuid.Nil {
+		return nil, ErrUnauthenticated
+	}
+	organizationID := req.OrganizationID
+	if organizationID == uuid.Nil {
+		id, err := database.RequireTenant(ctx)
+		if err != nil {
+			return nil, err
+		}
+		organizationID = id
+	}
+	if authorizer == nil {
+		return nil, ErrForbidden
+	}
+
+	permissions, err := authorizer.Permissions(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if req.MinRole != "" && roleRank[MemberRole(permissions.Role)] < roleRank[req.MinRole] {
+		return nil, ErrForbidden
+	}
+	if req.Permission != "" && !Grants(permissions, req.Permission) {
+		return nil, ErrForbidden
+	}
+	return permissions, nil
+}
+
+// Grants reports whether the permissions include the given one. A granted
+// permission may use "*" for its resource or action, and "*" alone grants
+// everything.
+func Grants(permissions *Permissions, permission string) bool {
+	resource, action, _ := strings.Cut(permission, ":")
+	for _, granted := range permissions.Permissions {
+		if granted == "*" {
+			return true
+		}
+		r, a, _ := strings.Cut(granted, ":")
+		if (r == "*" || r == resource) && (a == "*" || a == action) {
+			return true
+		}
+	}
+	return false
+}
+
+type permissionsContextKey struct{}
+
+// WithPermissions returns a context carrying the permissions the caller was
+// authorized with.
+func WithPermissions(ctx context.Context, permissions *Permissions) context.Context {
+	return context.WithValue(ctx, permissionsContextKey{}, permissions)
+}
+
+// PermissionsFromContext returns the permissions the caller was authorized
+// with, if the operation checked any.
+func PermissionsFromContext(ctx context.Context) (*Permissions, bool) {
+	permissions, ok := ctx.Value(permissionsContextKey{}).(*Permissions)
+	return permissions, ok
+}
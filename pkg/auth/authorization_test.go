@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
+)
+
+type staticAuthorizer map[uuid.UUID]*Permissions
+
+func (a staticAuthorizer) Permissions(_ context.Context, _, organizationID uuid.UUID) (*Permissions, error) {
+	permissions, ok := a[organizationID]
+	if !ok {
+		return nil, ErrForbidden
+	}
+	return permissions, nil
+}
+
+func TestAuthorize(t *testing.T) {
+	userID := uuid.New()
+	orgID := uuid.New()
+	otherOrgID := uuid.New()
+	authorizer := staticAuthorizer{
+		orgID: {
+			OrganizationID: orgID,
+			Role:           models.PermissionsRoleBasic,
+			Permissions:    []string{"*:read", "pipelines:write", "executors:*"},
+		},
+	}
+	scoped := database.WithTenant(context.Background(), orgID)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		userID  uuid.UUID
+		req     Requirement
+		wantErr error
+	}{
+		{name: "membership only", ctx: scoped, userID: userID},
+		{name: "wildcard action", ctx: scoped, userID: userID, req: Requirement{Permission: "artifacts:read"}},
+		{name: "granted by custom role", ctx: scoped, userID: userID, req: Requirement{Permission: "pipelines:write"}},
+		{name: "wildcard resource action", ctx: scoped, userID: userID, req: Requirement{Permission: "executors:execute"}},
+		{
+			name:    "not granted",
+			ctx:     scoped,
+			userID:  userID,
+			req:     Requirement{Permission: "artifacts:write"},
+			wantErr: ErrForbidden,
+		},
+		{
+			name:    "role too low",
+			ctx:     scoped,
+			userID:  userID,
+			req:     Requirement{Permission: "pipelines:write", MinRole: MemberRoleAdmin},
+			wantErr: ErrForbidden,
+		},
+		{
+			name:   "organization from path",
+			ctx:    context.Background(),
+			userID: userID,
+			req:    Requirement{Permission: "members:read", OrganizationID: orgID},
+		},
+		{
+			name:    "not a member",
+			ctx:     scoped,
+			userID:  userID,
+			req:     Requirement{OrganizationID: otherOrgID},
+			wantErr: ErrForbidden,
+		},
+		{name: "no tenant", ctx: context.Background(), userID: userID, wantErr: database.ErrNoTenant},
+		{name: "anonymous", ctx: scoped, wantErr: ErrUnauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Authorize(tt.ctx, authorizer, tt.userID, tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, orgID, got.OrganizationID)
+		})
+	}
+
+	_, err := Authorize(scoped, nil, userID, Requirement{})
+	require.ErrorIs(t, err, ErrForbidden)
+}
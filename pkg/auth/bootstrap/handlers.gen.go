@@ -5,7 +5,6 @@ package bootstrap
 import (
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ApplicationHandlers holds all application-layer handlers for this package.
@@ -17,20 +16,24 @@ type ApplicationHandlers struct {
 	CreateInvitation         handlers.CreateInvitation
 	CreateMember             handlers.CreateMember
 	CreateOrganization       handlers.CreateOrganization
+	CreateRole               handlers.CreateRole
 	DeleteAPIKey             handlers.DeleteAPIKey
 	DeleteAccount            handlers.DeleteAccount
 	DeleteCurrentUser        handlers.DeleteCurrentUser
 	DeleteInvitation         handlers.DeleteInvitation
 	DeleteMember             handlers.DeleteMember
 	DeleteOrganization       handlers.DeleteOrganization
+	DeleteRole               handlers.DeleteRole
 	DeleteSession            handlers.DeleteSession
 	DeleteUser               handlers.DeleteUser
 	GetAPIKey                handlers.GetAPIKey
 	GetAccount               handlers.GetAccount
+	GetCurrentPermissions    handlers.GetCurrentPermissions
 	GetCurrentUser           handlers.GetCurrentUser
 	GetInvitation            handlers.GetInvitation
 	GetMember                handlers.GetMember
 	GetOrganization          handlers.GetOrganization
+	GetRole                  handlers.GetRole
 	GetSession               handlers.GetSession
 	GetUser                  handlers.GetUser
 	LinkAccount              handlers.LinkAccount
@@ -39,6 +42,7 @@ type ApplicationHandlers struct {
 	ListInvitations          handlers.ListInvitations
 	ListMembers              handlers.ListMembers
 	ListOrganizations        handlers.ListOrganizations
+	ListRoles                handlers.ListRoles
 	ListSessions             handlers.ListSessions
 	ListUsers                handlers.ListUsers
 	Login                    handlers.Login
@@ -51,12 +55,15 @@ type ApplicationHandlers struct {
 	RequestEmailVerification handlers.RequestEmailVerification
 	RequestMagicLink         handlers.RequestMagicLink
 	RequestPasswordReset     handlers.RequestPasswordReset
+	RestoreMember            handlers.RestoreMember
+	RestoreOrganization      handlers.RestoreOrganization
 	UpdateAPIKey             handlers.UpdateAPIKey
 	UpdateAccount            handlers.UpdateAccount
 	UpdateCurrentUser        handlers.UpdateCurrentUser
 	UpdateInvitation         handlers.UpdateInvitation
 	UpdateMember             handlers.UpdateMember
 	UpdateOrganization       handlers.UpdateOrganization
+	UpdateRole               handlers.UpdateRole
 	UpdateSession            handlers.UpdateSession
 	UpdateUser               handlers.UpdateUser
 	VerifyMagicLink          handlers.VerifyMagicLink
@@ -69,32 +76,36 @@ func NewApplicationHandlers(
 	invitationRepo repositories.InvitationRepository,
 	memberRepo repositories.MemberRepository,
 	organizationRepo repositories.OrganizationRepository,
+	roleRepo repositories.RoleRepository,
 	sessionRepo repositories.SessionRepository,
 	userRepo repositories.UserRepository,
-	publisher events.Publisher,
 ) *ApplicationHandlers {
 	return &ApplicationHandlers{
 		ConfirmEmailChange:       handlers.NewConfirmEmailChange(),
 		ConfirmEmailVerification: handlers.NewConfirmEmailVerification(),
 		ConfirmPasswordReset:     handlers.NewConfirmPasswordReset(),
-		CreateAPIKey:             handlers.NewCreateAPIKey(apikeyRepo, publisher),
-		CreateInvitation:         handlers.NewCreateInvitation(invitationRepo, publisher),
-		CreateMember:             handlers.NewCreateMember(memberRepo, publisher),
-		CreateOrganization:       handlers.NewCreateOrganization(organizationRepo, publisher),
-		DeleteAPIKey:             handlers.NewDeleteAPIKey(apikeyRepo, publisher),
+		CreateAPIKey:             handlers.NewCreateAPIKey(),
+		CreateInvitation:         handlers.NewCreateInvitation(invitationRepo),
+		CreateMember:             handlers.NewCreateMember(memberRepo),
+		CreateOrganization:       handlers.NewCreateOrganization(organizationRepo),
+		CreateRole:               handlers.NewCreateRole(),
+		DeleteAPIKey:             handlers.NewDeleteAPIKey(apikeyRepo),
 		DeleteAccount:            handlers.NewDeleteAccount(),
 		DeleteCurrentUser:        handlers.NewDeleteCurrentUser(),
-		DeleteInvitation:         handlers.NewDeleteInvitation(invitationRepo, publisher),
-		DeleteMember:             handlers.NewDeleteMember(memberRepo, publisher),
-		DeleteOrganization:       handlers.NewDeleteOrganization(organizationRepo, publisher),
+		DeleteInvitation:         handlers.NewDeleteInvitation(invitationRepo),
+		DeleteMember:             handlers.NewDeleteMember(memberRepo),
+		DeleteOrganization:       handlers.NewDeleteOrganization(organizationRepo),
+		DeleteRole:               handlers.NewDeleteRole(roleRepo),
 		DeleteSession:            handlers.NewDeleteSession(),
-		DeleteUser:               handlers.NewDeleteUser(userRepo, publisher),
+		DeleteUser:               handlers.NewDeleteUser(userRepo),
 		GetAPIKey:                handlers.NewGetAPIKey(apikeyRepo),
 		GetAccount:               handlers.NewGetAccount(accountRepo),
+		GetCurrentPermissions:    handlers.NewGetCurrentPermissions(),
 		GetCurrentUser:           handlers.NewGetCurrentUser(),
 		GetInvitation:            handlers.NewGetInvitation(invitationRepo),
 		GetMember:                handlers.NewGetMember(memberRepo),
 		GetOrganization:          handlers.NewGetOrganization(organizationRepo),
+		GetRole:                  handlers.NewGetRole(roleRepo),
 		GetSession:               handlers.NewGetSession(sessionRepo),
 		GetUser:                  handlers.NewGetUser(userRepo),
 		LinkAccount:              handlers.NewLinkAccount(),
@@ -102,7 +113,8 @@ func NewApplicationHandlers(
 		ListAccounts:             handlers.NewListAccounts(accountRepo),
 		ListInvitations:          handlers.NewListInvitations(invitationRepo),
 		ListMembers:              handlers.NewListMembers(memberRepo),
-		ListOrganizations:        handlers.NewListOrganizations(organizationRepo),
+		ListOrganizations:        handlers.NewListOrganizations(),
+		ListRoles:                handlers.NewListRoles(roleRepo),
 		ListSessions:             handlers.NewListSessions(sessionRepo),
 		ListUsers:                handlers.NewListUsers(userRepo),
 		Login:                    handlers.NewLogin(),
@@ -115,14 +127,17 @@ func NewApplicationHandlers(
 		RequestEmailVerification: handlers.NewRequestEmailVerification(),
 		RequestMagicLink:         handlers.NewRequestMagicLink(),
 		RequestPasswordReset:     handlers.NewRequestPasswordReset(),
-		UpdateAPIKey:             handlers.NewUpdateAPIKey(apikeyRepo, publisher),
+		RestoreMember:            handlers.NewRestoreMember(memberRepo),
+		RestoreOrganization:      handlers.NewRestoreOrganization(organizationRepo),
+		UpdateAPIKey:             handlers.NewUpdateAPIKey(apikeyRepo),
 		UpdateAccount:            handlers.NewUpdateAccount(),
 		UpdateCurrentUser:        handlers.NewUpdateCurrentUser(),
-		UpdateInvitation:         handlers.NewUpdateInvitation(invitationRepo, publisher),
-		UpdateMember:             handlers.NewUpdateMember(memberRepo, publisher),
-		UpdateOrganization:       handlers.NewUpdateOrganization(organizationRepo, publisher),
+		UpdateInvitation:         handlers.NewUpdateInvitation(invitationRepo),
+		UpdateMember:             handlers.NewUpdateMember(),
+		UpdateOrganization:       handlers.NewUpdateOrganization(organizationRepo),
+		UpdateRole:               handlers.NewUpdateRole(roleRepo),
 		UpdateSession:            handlers.NewUpdateSession(),
-		UpdateUser:               handlers.NewUpdateUser(userRepo, publisher),
+		UpdateUser:               handlers.NewUpdateUser(userRepo),
 		VerifyMagicLink:          handlers.NewVerifyMagicLink(),
 	}
 }
@@ -0,0 +1,258 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package bootstrap
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/auth/routes"
+)
+
+// HTTPHandlers holds all HTTP handlers for this package.
+type HTTPHandlers struct {
+	ConfirmEmailChange       *routes.ConfirmEmailChangeHandler
+	ConfirmEmailVerification *routes.ConfirmEmailVerificationHandler
+	ConfirmPasswordReset     *routes.ConfirmPasswordResetHandler
+	CreateAPIKey             *routes.CreateAPIKeyHandler
+	CreateInvitation         *routes.CreateInvitationHandler
+	CreateMember             *routes.CreateMemberHandler
+	CreateOrganization       *routes.CreateOrganizationHandler
+	CreateRole               *routes.CreateRoleHandler
+	DeleteAPIKey             *routes.DeleteAPIKeyHandler
+	DeleteAccount            *routes.DeleteAccountHandler
+	DeleteCurrentUser        *routes.DeleteCurrentUserHandler
+	DeleteInvitation         *routes.DeleteInvitationHandler
+	DeleteMember             *routes.DeleteMemberHandler
+	DeleteOrganization       *routes.DeleteOrganizationHandler
+	DeleteRole               *routes.DeleteRoleHandler
+	DeleteSession            *routes.DeleteSessionHandler
+	DeleteUser               *routes.DeleteUserHandler
+	GetAPIKey                *routes.GetAPIKeyHandler
+	GetAccount               *routes.GetAccountHandler
+	GetCurrentPermissions    *routes.GetCurrentPermissionsHandler
+	GetCurrentUser           *routes.GetCurrentUserHandler
+	GetInvitation            *routes.GetInvitationHandler
+	GetMember                *routes.GetMemberHandler
+	GetOrganization          *routes.GetOrganizationHandler
+	GetRole                  *routes.GetRoleHandler
+	GetSession               *routes.GetSessionHandler
+	GetUser                  *routes.GetUserHandler
+	LinkAccount              *routes.LinkAccountHandler
+	ListAPIKeys              *routes.ListAPIKeysHandler
+	ListAccounts             *routes.ListAccountsHandler
+	ListInvitations          *routes.ListInvitationsHandler
+	ListMembers              *routes.ListMembersHandler
+	ListOrganizations        *routes.ListOrganizationsHandler
+	ListRoles                *routes.ListRolesHandler
+	ListSessions             *routes.ListSessionsHandler
+	ListUsers                *routes.ListUsersHandler
+	Login                    *routes.LoginHandler
+	Logout                   *routes.LogoutHandler
+	LogoutAll                *routes.LogoutAllHandler
+	OauthAuthorize           *routes.OauthAuthorizeHandler
+	OauthCallback            *routes.OauthCallbackHandler
+	Register                 *routes.RegisterHandler
+	RequestEmailChange       *routes.RequestEmailChangeHandler
+	RequestEmailVerification *routes.RequestEmailVerificationHandler
+	RequestMagicLink         *routes.RequestMagicLinkHandler
+	RequestPasswordReset     *routes.RequestPasswordResetHandler
+	RestoreMember            *routes.RestoreMemberHandler
+	RestoreOrganization      *routes.RestoreOrganizationHandler
+	UpdateAPIKey             *routes.UpdateAPIKeyHandler
+	UpdateAccount            *routes.UpdateAccountHandler
+	UpdateCurrentUser        *routes.UpdateCurrentUserHandler
+	UpdateInvitation         *routes.UpdateInvitationHandler
+	UpdateMember             *routes.UpdateMemberHandler
+	UpdateOrganization       *routes.UpdateOrganizationHandler
+	UpdateRole               *routes.UpdateRoleHandler
+	UpdateSession            *routes.UpdateSessionHandler
+	UpdateUser               *routes.UpdateUserHandler
+	VerifyMagicLink          *routes.VerifyMagicLinkHandler
+}
+
+// NewHTTPHandlers creates all HTTP handlers from the given application handlers.
+// Operations that declare x-codegen-permissions are checked with the authorizer.
+func NewHTTPHandlers(appHandlers *ApplicationHandlers, authorizer auth.Authorizer) *HTTPHandlers {
+	return &HTTPHandlers{
+		ConfirmEmailChange:       routes.NewConfirmEmailChangeHandler(appHandlers.ConfirmEmailChange),
+		ConfirmEmailVerification: routes.NewConfirmEmailVerificationHandler(appHandlers.ConfirmEmailVerification),
+		ConfirmPasswordReset:     routes.NewConfirmPasswordResetHandler(appHandlers.ConfirmPasswordReset),
+		CreateAPIKey:             routes.NewCreateAPIKeyHandler(appHandlers.CreateAPIKey, authorizer),
+		CreateInvitation:         routes.NewCreateInvitationHandler(appHandlers.CreateInvitation, authorizer),
+		CreateMember:             routes.NewCreateMemberHandler(appHandlers.CreateMember, authorizer),
+		CreateOrganization:       routes.NewCreateOrganizationHandler(appHandlers.CreateOrganization),
+		CreateRole:               routes.NewCreateRoleHandler(appHandlers.CreateRole, authorizer),
+		DeleteAPIKey:             routes.NewDeleteAPIKeyHandler(appHandlers.DeleteAPIKey, authorizer),
+		DeleteAccount:            routes.NewDeleteAccountHandler(appHandlers.DeleteAccount),
+		DeleteCurrentUser:        routes.NewDeleteCurrentUserHandler(appHandlers.DeleteCurrentUser),
+		DeleteInvitation:         routes.NewDeleteInvitationHandler(appHandlers.DeleteInvitation, authorizer),
+		DeleteMember:             routes.NewDeleteMemberHandler(appHandlers.DeleteMember, authorizer),
+		DeleteOrganization:       routes.NewDeleteOrganizationHandler(appHandlers.DeleteOrganization, authorizer),
+		DeleteRole:               routes.NewDeleteRoleHandler(appHandlers.DeleteRole, authorizer),
+		DeleteSession:            routes.NewDeleteSessionHandler(appHandlers.DeleteSession),
+		DeleteUser:               routes.NewDeleteUserHandler(appHandlers.DeleteUser),
+		GetAPIKey:                routes.NewGetAPIKeyHandler(appHandlers.GetAPIKey, authorizer),
+		GetAccount:               routes.NewGetAccountHandler(appHandlers.GetAccount),
+		GetCurrentPermissions:    routes.NewGetCurrentPermissionsHandler(appHandlers.GetCurrentPermissions, authorizer),
+		GetCurrentUser:           routes.NewGetCurrentUserHandler(appHandlers.GetCurrentUser),
+		GetInvitation:            routes.NewGetInvitationHandler(appHandlers.GetInvitation, authorizer),
+		GetMember:                routes.NewGetMemberHandler(appHandlers.GetMember, authorizer),
+		GetOrganization:          routes.NewGetOrganizationHandler(appHandlers.GetOrganization, authorizer),
+		GetRole:                  routes.NewGetRoleHandler(appHandlers.GetRole, authorizer),
+		GetSession:               routes.NewGetSessionHandler(appHandlers.GetSession),
+		GetUser:                  routes.NewGetUserHandler(appHandlers.GetUser),
+		LinkAccount:              routes.NewLinkAccountHandler(appHandlers.LinkAccount),
+		ListAPIKeys:              routes.NewListAPIKeysHandler(appHandlers.ListAPIKeys, authorizer),
+		ListAccounts:             routes.NewListAccountsHandler(appHandlers.ListAccounts),
+		ListInvitations:          routes.NewListInvitationsHandler(appHandlers.ListInvitations, authorizer),
+		ListMembers:              routes.NewListMembersHandler(appHandlers.ListMembers, authorizer),
+		ListOrganizations:        routes.NewListOrganizationsHandler(appHandlers.ListOrganizations),
+		ListRoles:                routes.NewListRolesHandler(appHandlers.ListRoles, authorizer),
+		ListSessions:             routes.NewListSessionsHandler(appHandlers.ListSessions),
+		ListUsers:                routes.NewListUsersHandler(appHandlers.ListUsers),
+		Login:                    routes.NewLoginHandler(appHandlers.Login),
+		Logout:                   routes.NewLogoutHandler(appHandlers.Logout),
+		LogoutAll:                routes.NewLogoutAllHandler(appHandlers.LogoutAll),
+		OauthAuthorize:           routes.NewOauthAuthorizeHandler(appHandlers.OauthAuthorize),
+		OauthCallback:            routes.NewOauthCallbackHandler(appHandlers.OauthCallback),
+		Register:                 routes.NewRegisterHandler(appHandlers.Register),
+		RequestEmailChange:       routes.NewRequestEmailChangeHandler(appHandlers.RequestEmailChange),
+		RequestEmailVerification: routes.NewRequestEmailVerificationHandler(appHandlers.RequestEmailVerification),
+		RequestMagicLink:         routes.NewRequestMagicLinkHandler(appHandlers.RequestMagicLink),
+		RequestPasswordReset:     routes.NewRequestPasswordResetHandler(appHandlers.RequestPasswordReset),
+		RestoreMember:            routes.NewRestoreMemberHandler(appHandlers.RestoreMember, authorizer),
+		RestoreOrganization:      routes.NewRestoreOrganizationHandler(appHandlers.RestoreOrganization, authorizer),
+		UpdateAPIKey:             routes.NewUpdateAPIKeyHandler(appHandlers.UpdateAPIKey, authorizer),
+		UpdateAccount:            routes.NewUpdateAccountHandler(appHandlers.UpdateAccount),
+		UpdateCurrentUser:        routes.NewUpdateCurrentUserHandler(appHandlers.UpdateCurrentUser),
+		UpdateInvitation:         routes.NewUpdateInvitationHandler(appHandlers.UpdateInvitation, authorizer),
+		UpdateMember:             routes.NewUpdateMemberHandler(appHandlers.UpdateMember, authorizer),
+		UpdateOrganization:       routes.NewUpdateOrganizationHandler(appHandlers.UpdateOrganization, authorizer),
+		UpdateRole:               routes.NewUpdateRoleHandler(appHandlers.UpdateRole, authorizer),
+		UpdateSession:            routes.NewUpdateSessionHandler(appHandlers.UpdateSession),
+		UpdateUser:               routes.NewUpdateUserHandler(appHandlers.UpdateUser),
+		VerifyMagicLink:          routes.NewVerifyMagicLinkHandler(appHandlers.VerifyMagicLink),
+	}
+}
+
+// RegisterRoutes registers all routes for this package with the http.ServeMux.
+func RegisterRoutes(mux *http.ServeMux, handlers *HTTPHandlers) {
+	slog.Info("registering route", "method", "POST", "path", "/auth/confirm-email")
+	routes.RegisterConfirmEmailChangeRoute(mux, handlers.ConfirmEmailChange)
+	slog.Info("registering route", "method", "POST", "path", "/auth/verify-email")
+	routes.RegisterConfirmEmailVerificationRoute(mux, handlers.ConfirmEmailVerification)
+	slog.Info("registering route", "method", "POST", "path", "/auth/reset-password")
+	routes.RegisterConfirmPasswordResetRoute(mux, handlers.ConfirmPasswordReset)
+	slog.Info("registering route", "method", "POST", "path", "/api-keys")
+	routes.RegisterCreateAPIKeyRoute(mux, handlers.CreateAPIKey)
+	slog.Info("registering route", "method", "POST", "path", "/organizations/{organizationID}/invitations")
+	routes.RegisterCreateInvitationRoute(mux, handlers.CreateInvitation)
+	slog.Info("registering route", "method", "POST", "path", "/organizations/{organizationID}/members")
+	routes.RegisterCreateMemberRoute(mux, handlers.CreateMember)
+	slog.Info("registering route", "method", "POST", "path", "/organizations")
+	routes.RegisterCreateOrganizationRoute(mux, handlers.CreateOrganization)
+	slog.Info("registering route", "method", "POST", "path", "/roles")
+	routes.RegisterCreateRoleRoute(mux, handlers.CreateRole)
+	slog.Info("registering route", "method", "DELETE", "path", "/api-keys/{id}")
+	routes.RegisterDeleteAPIKeyRoute(mux, handlers.DeleteAPIKey)
+	slog.Info("registering route", "method", "DELETE", "path", "/auth/accounts/{id}")
+	routes.RegisterDeleteAccountRoute(mux, handlers.DeleteAccount)
+	slog.Info("registering route", "method", "DELETE", "path", "/auth/me")
+	routes.RegisterDeleteCurrentUserRoute(mux, handlers.DeleteCurrentUser)
+	slog.Info("registering route", "method", "DELETE", "path", "/organizations/{organizationID}/invitations/{id}")
+	routes.RegisterDeleteInvitationRoute(mux, handlers.DeleteInvitation)
+	slog.Info("registering route", "method", "DELETE", "path", "/organizations/{organizationID}/members/{id}")
+	routes.RegisterDeleteMemberRoute(mux, handlers.DeleteMember)
+	slog.Info("registering route", "method", "DELETE", "path", "/organizations/{id}")
+	routes.RegisterDeleteOrganizationRoute(mux, handlers.DeleteOrganization)
+	slog.Info("registering route", "method", "DELETE", "path", "/roles/{id}")
+	routes.RegisterDeleteRoleRoute(mux, handlers.DeleteRole)
+	slog.Info("registering route", "method", "DELETE", "path", "/auth/sessions/{id}")
+	routes.RegisterDeleteSessionRoute(mux, handlers.DeleteSession)
+	slog.Info("registering route", "method", "DELETE", "path", "/users/{id}")
+	routes.RegisterDeleteUserRoute(mux, handlers.DeleteUser)
+	slog.Info("registering route", "method", "GET", "path", "/api-keys/{id}")
+	routes.RegisterGetAPIKeyRoute(mux, handlers.GetAPIKey)
+	slog.Info("registering route", "method", "GET", "path", "/auth/accounts/{id}")
+	routes.RegisterGetAccountRoute(mux, handlers.GetAccount)
+	slog.Info("registering route", "method", "GET", "path", "/auth/permissions")
+	routes.RegisterGetCurrentPermissionsRoute(mux, handlers.GetCurrentPermissions)
+	slog.Info("registering route", "method", "GET", "path", "/auth/me")
+	routes.RegisterGetCurrentUserRoute(mux, handlers.GetCurrentUser)
+	slog.Info("registering route", "method", "GET", "path", "/organizations/{organizationID}/invitations/{id}")
+	routes.RegisterGetInvitationRoute(mux, handlers.GetInvitation)
+	slog.Info("registering route", "method", "GET", "path", "/organizations/{organizationID}/members/{id}")
+	routes.RegisterGetMemberRoute(mux, handlers.GetMember)
+	slog.Info("registering route", "method", "GET", "path", "/organizations/{id}")
+	routes.RegisterGetOrganizationRoute(mux, handlers.GetOrganization)
+	slog.Info("registering route", "method", "GET", "path", "/roles/{id}")
+	routes.RegisterGetRoleRoute(mux, handlers.GetRole)
+	slog.Info("registering route", "method", "GET", "path", "/auth/sessions/{id}")
+	routes.RegisterGetSessionRoute(mux, handlers.GetSession)
+	slog.Info("registering route", "method", "GET", "path", "/users/{id}")
+	routes.RegisterGetUserRoute(mux, handlers.GetUser)
+	slog.Info("registering route", "method", "POST", "path", "/auth/link")
+	routes.RegisterLinkAccountRoute(mux, handlers.LinkAccount)
+	slog.Info("registering route", "method", "GET", "path", "/api-keys")
+	routes.RegisterListAPIKeysRoute(mux, handlers.ListAPIKeys)
+	slog.Info("registering route", "method", "GET", "path", "/auth/accounts")
+	routes.RegisterListAccountsRoute(mux, handlers.ListAccounts)
+	slog.Info("registering route", "method", "GET", "path", "/organizations/{organizationID}/invitations")
+	routes.RegisterListInvitationsRoute(mux, handlers.ListInvitations)
+	slog.Info("registering route", "method", "GET", "path", "/organizations/{organizationID}/members")
+	routes.RegisterListMembersRoute(mux, handlers.ListMembers)
+	slog.Info("registering route", "method", "GET", "path", "/organizations")
+	routes.RegisterListOrganizationsRoute(mux, handlers.ListOrganizations)
+	slog.Info("registering route", "method", "GET", "path", "/roles")
+	routes.RegisterListRolesRoute(mux, handlers.ListRoles)
+	slog.Info("registering route", "method", "GET", "path", "/auth/sessions")
+	routes.RegisterListSessionsRoute(mux, handlers.ListSessions)
+	slog.Info("registering route", "method", "GET", "path", "/users")
+	routes.RegisterListUsersRoute(mux, handlers.ListUsers)
+	slog.Info("registering route", "method", "POST", "path", "/auth/login")
+	routes.RegisterLoginRoute(mux, handlers.Login)
+	slog.Info("registering route", "method", "POST", "path", "/auth/logout")
+	routes.RegisterLogoutRoute(mux, handlers.Logout)
+	slog.Info("registering route", "method", "POST", "path", "/auth/logout-all")
+	routes.RegisterLogoutAllRoute(mux, handlers.LogoutAll)
+	slog.Info("registering route", "method", "GET", "path", "/auth/oauth/{provider}/authorize")
+	routes.RegisterOauthAuthorizeRoute(mux, handlers.OauthAuthorize)
+	slog.Info("registering route", "method", "GET", "path", "/auth/oauth/{provider}/callback")
+	routes.RegisterOauthCallbackRoute(mux, handlers.OauthCallback)
+	slog.Info("registering route", "method", "POST", "path", "/auth/register")
+	routes.RegisterRegisterRoute(mux, handlers.Register)
+	slog.Info("registering route", "method", "POST", "path", "/auth/change-email")
+	routes.RegisterRequestEmailChangeRoute(mux, handlers.RequestEmailChange)
+	slog.Info("registering route", "method", "POST", "path", "/auth/request-verification")
+	routes.RegisterRequestEmailVerificationRoute(mux, handlers.RequestEmailVerification)
+	slog.Info("registering route", "method", "POST", "path", "/auth/magic-links/request")
+	routes.RegisterRequestMagicLinkRoute(mux, handlers.RequestMagicLink)
+	slog.Info("registering route", "method", "POST", "path", "/auth/forgot-password")
+	routes.RegisterRequestPasswordResetRoute(mux, handlers.RequestPasswordReset)
+	slog.Info("registering route", "method", "POST", "path", "/organizations/{organizationID}/members/{id}/restore")
+	routes.RegisterRestoreMemberRoute(mux, handlers.RestoreMember)
+	slog.Info("registering route", "method", "POST", "path", "/organizations/{id}/restore")
+	routes.RegisterRestoreOrganizationRoute(mux, handlers.RestoreOrganization)
+	slog.Info("registering route", "method", "PATCH", "path", "/api-keys/{id}")
+	routes.RegisterUpdateAPIKeyRoute(mux, handlers.UpdateAPIKey)
+	slog.Info("registering route", "method", "PATCH", "path", "/auth/accounts/{id}")
+	routes.RegisterUpdateAccountRoute(mux, handlers.UpdateAccount)
+	slog.Info("registering route", "method", "PATCH", "path", "/auth/me")
+	routes.RegisterUpdateCurrentUserRoute(mux, handlers.UpdateCurrentUser)
+	slog.Info("registering route", "method", "PATCH", "path", "/organizations/{organizationID}/invitations/{id}")
+	routes.RegisterUpdateInvitationRoute(mux, handlers.UpdateInvitation)
+	slog.Info("registering route", "method", "PATCH", "path", "/organizations/{organizationID}/members/{id}")
+	routes.RegisterUpdateMemberRoute(mux, handlers.UpdateMember)
+	slog.Info("registering route", "method", "PATCH", "path", "/organizations/{id}")
+	routes.RegisterUpdateOrganizationRoute(mux, handlers.UpdateOrganization)
+	slog.Info("registering route", "method", "PATCH", "path", "/roles/{id}")
+	routes.RegisterUpdateRoleRoute(mux, handlers.UpdateRole)
+	slog.Info("registering route", "method", "PATCH", "path", "/auth/sessions/{id}")
+	routes.RegisterUpdateSessionRoute(mux, handlers.UpdateSession)
+	slog.Info("registering route", "method", "PATCH", "path", "/users/{id}")
+	routes.RegisterUpdateUserRoute(mux, handlers.UpdateUser)
+	slog.Info("registering route", "method", "POST", "path", "/auth/magic-links/verify")
+	routes.RegisterVerifyMagicLinkRoute(mux, handlers.VerifyMagicLink)
+}
@@ -1,4 +1,4 @@
-//go:generate go run ../../cmd/archesai generate --spec ./api/openapi.yaml --output . --only models,routes,handlers,repositories,bootstrap_handlers,bootstrap_routes --pretty
+//go:generate go run ../../cmd/archesai generate --spec ./api/openapi.yaml --output . --only models,routes,handlers,repositories,mocks,bootstrap_handlers,bootstrap_routes --pretty
 package auth
 
 import "embed"
@@ -4,14 +4,11 @@ package handlers
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/archesai/archesai/pkg/auth/models"
-	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -20,66 +17,20 @@ import (
 
 // CreateAPIKeyInput represents the input for the CreateAPIKey operation.
 type CreateAPIKeyInput struct {
-	SessionID      uuid.UUID
-	ExpiresAt      *time.Time
-	Name           *string
-	OrganizationID uuid.UUID
-	RateLimit      *int32
-	Scopes         []string
+	SessionID uuid.UUID
+	ExpiresAt *time.Time
+	Name      *string
+	RateLimit *int32
+	Scopes    []string
 }
 
 // CreateAPIKeyOutput represents the output for the CreateAPIKey operation.
 type CreateAPIKeyOutput struct {
 	Data models.APIKey `json:"data"`
+	Key  string        `json:"key"`
 }
 
 // CreateAPIKey defines the interface for the CreateAPIKey operation.
 type CreateAPIKey interface {
 	Execute(ctx context.Context, input *CreateAPIKeyInput) (*CreateAPIKeyOutput, error)
 }
-
-// CreateAPIKeyImpl is the default implementation of CreateAPIKey.
-type CreateAPIKeyImpl struct {
-	repo      repositories.APIKeyRepository
-	publisher events.Publisher
-}
-
-// NewCreateAPIKey creates a new CreateAPIKey handler.
-func NewCreateAPIKey(
-	repo repositories.APIKeyRepository,
-	publisher events.Publisher,
-) CreateAPIKey {
-	return &CreateAPIKeyImpl{
-		repo:      repo,
-		publisher: publisher,
-	}
-}
-
-// Execute performs the CreateAPIKey operation.
-func (h *CreateAPIKeyImpl) Execute(ctx context.Context, input *CreateAPIKeyInput) (*CreateAPIKeyOutput, error) {
-	// Create entity
-	entity := &models.APIKey{
-		ID:        uuid.New(),
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
-		// TODO: Map input fields to entity
-	}
-
-	// Save to repository
-	created, err := h.repo.Create(ctx, entity)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create apikey: %w", err)
-	}
-
-	// Publish event
-	event := models.NewAPIKeyCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
-	// Map to output
-	output := &CreateAPIKeyOutput{
-		// TODO: Map created entity to output
-	}
-	_ = created
-
-	return output, nil
-}
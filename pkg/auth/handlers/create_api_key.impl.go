@@ -0,0 +1,104 @@
+package handlers
+
+// NOTE: This file is user-editable. The generator will not overwrite it.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/server"
+)
+
+// defaultAPIKeyRateLimit is the requests per minute of keys created without
+// a rate limit.
+const defaultAPIKeyRateLimit = 60
+
+// Ensure CreateAPIKeyImpl implements CreateAPIKey
+var _ CreateAPIKey = (*CreateAPIKeyImpl)(nil)
+
+// CreateAPIKeyImpl implements the CreateAPIKey interface.
+type CreateAPIKeyImpl struct {
+	repo repositories.APIKeyRepository
+}
+
+// NewCreateAPIKey creates a new CreateAPIKey implementation. It has no
+// repository until the app replaces it with NewCreateAPIKeyWith.
+func NewCreateAPIKey() CreateAPIKey {
+	return &CreateAPIKeyImpl{}
+}
+
+// NewCreateAPIKeyWith creates a CreateAPIKey implementation that stores keys
+// in repo.
+func NewCreateAPIKeyWith(repo repositories.APIKeyRepository) CreateAPIKey {
+	return &CreateAPIKeyImpl{repo: repo}
+}
+
+// Execute creates an API key for the caller in their current organization.
+// Only the hash of the key is stored; the key itself is returned once, in the
+// output.
+func (h *CreateAPIKeyImpl) Execute(
+	ctx context.Context,
+	input *CreateAPIKeyInput,
+) (*CreateAPIKeyOutput, error) {
+	if h.repo == nil {
+		return nil, fmt.Errorf("not implemented")
+	}
+	organizationID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	v := server.NewValidator()
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		v.Add("/expiresAt", "must be in the future")
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	key, hash, err := auth.NewAPIKeySecret(organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate API key: %w", err)
+	}
+	rateLimit := int32(defaultAPIKeyRateLimit)
+	if input.RateLimit != nil {
+		rateLimit = *input.RateLimit
+	}
+	scopes := input.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	prefix := strings.TrimSuffix(auth.APIKeyPrefix, "_")
+	entity, err := models.NewAPIKey(
+		input.ExpiresAt,
+		hash,
+		nil,
+		input.Name,
+		organizationID,
+		&prefix,
+		rateLimit,
+		scopes,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create apikey: %w", err)
+	}
+
+	created, err := h.repo.Create(ctx, entity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create apikey: %w", err)
+	}
+	return &CreateAPIKeyOutput{Data: *created, Key: key}, nil
+}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -38,18 +37,15 @@ type CreateInvitation interface {
 
 // CreateInvitationImpl is the default implementation of CreateInvitation.
 type CreateInvitationImpl struct {
-	repo      repositories.InvitationRepository
-	publisher events.Publisher
+	repo repositories.InvitationRepository
 }
 
 // NewCreateInvitation creates a new CreateInvitation handler.
 func NewCreateInvitation(
 	repo repositories.InvitationRepository,
-	publisher events.Publisher,
 ) CreateInvitation {
 	return &CreateInvitationImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -57,9 +53,10 @@ func NewCreateInvitation(
 func (h *CreateInvitationImpl) Execute(ctx context.Context, input *CreateInvitationInput) (*CreateInvitationOutput, error) {
 	// Create entity
 	entity := &models.Invitation{
-		ID:        uuid.New(),
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ID:             uuid.New(),
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+		OrganizationID: input.OrganizationID,
 		// TODO: Map input fields to entity
 	}
 
@@ -69,15 +66,10 @@ func (h *CreateInvitationImpl) Execute(ctx context.Context, input *CreateInvitat
 		return nil, fmt.Errorf("failed to create invitation: %w", err)
 	}
 
-	// Publish event
-	event := models.NewInvitationCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &CreateInvitationOutput{
-		// TODO: Map created entity to output
+		Data: *created,
 	}
-	_ = created
 
 	return output, nil
 }
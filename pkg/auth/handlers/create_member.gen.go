@@ -11,7 +11,6 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -37,18 +36,15 @@ type CreateMember interface {
 
 // CreateMemberImpl is the default implementation of CreateMember.
 type CreateMemberImpl struct {
-	repo      repositories.MemberRepository
-	publisher events.Publisher
+	repo repositories.MemberRepository
 }
 
 // NewCreateMember creates a new CreateMember handler.
 func NewCreateMember(
 	repo repositories.MemberRepository,
-	publisher events.Publisher,
 ) CreateMember {
 	return &CreateMemberImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -56,9 +52,10 @@ func NewCreateMember(
 func (h *CreateMemberImpl) Execute(ctx context.Context, input *CreateMemberInput) (*CreateMemberOutput, error) {
 	// Create entity
 	entity := &models.Member{
-		ID:        uuid.New(),
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ID:             uuid.New(),
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+		OrganizationID: input.OrganizationID,
 		// TODO: Map input fields to entity
 	}
 
@@ -68,15 +65,10 @@ func (h *CreateMemberImpl) Execute(ctx context.Context, input *CreateMemberInput
 		return nil, fmt.Errorf("failed to create member: %w", err)
 	}
 
-	// Publish event
-	event := models.NewMemberCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &CreateMemberOutput{
-		// TODO: Map created entity to output
+		Data: *created,
 	}
-	_ = created
 
 	return output, nil
 }
@@ -11,7 +11,6 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -37,18 +36,15 @@ type CreateOrganization interface {
 
 // CreateOrganizationImpl is the default implementation of CreateOrganization.
 type CreateOrganizationImpl struct {
-	repo      repositories.OrganizationRepository
-	publisher events.Publisher
+	repo repositories.OrganizationRepository
 }
 
 // NewCreateOrganization creates a new CreateOrganization handler.
 func NewCreateOrganization(
 	repo repositories.OrganizationRepository,
-	publisher events.Publisher,
 ) CreateOrganization {
 	return &CreateOrganizationImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -68,15 +64,10 @@ func (h *CreateOrganizationImpl) Execute(ctx context.Context, input *CreateOrgan
 		return nil, fmt.Errorf("failed to create organization: %w", err)
 	}
 
-	// Publish event
-	event := models.NewOrganizationCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &CreateOrganizationOutput{
-		// TODO: Map created entity to output
+		Data: *created,
 	}
-	_ = created
 
 	return output, nil
 }
@@ -0,0 +1,53 @@
+package handlers
+
+// NOTE: This file is user-editable. The generator will not overwrite it.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+)
+
+// Ensure CreateRoleImpl implements CreateRole
+var _ CreateRole = (*CreateRoleImpl)(nil)
+
+// CreateRoleImpl implements the CreateRole interface.
+type CreateRoleImpl struct {
+	repo repositories.RoleRepository
+}
+
+// NewCreateRole creates a new CreateRole implementation. It has no
+// repository until the app replaces it with NewCreateRoleWith.
+func NewCreateRole() CreateRole {
+	return &CreateRoleImpl{}
+}
+
+// NewCreateRoleWith creates a CreateRole implementation that stores roles in
+// repo.
+func NewCreateRoleWith(repo repositories.RoleRepository) CreateRole {
+	return &CreateRoleImpl{repo: repo}
+}
+
+// Execute creates a custom role in the caller's current organization.
+func (h *CreateRoleImpl) Execute(ctx context.Context, input *CreateRoleInput) (*CreateRoleOutput, error) {
+	if h.repo == nil {
+		return nil, fmt.Errorf("not implemented")
+	}
+	organizationID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := models.NewRole(input.Description, input.Name, organizationID, input.Permissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+	created, err := h.repo.Create(ctx, entity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+	return &CreateRoleOutput{Data: *created}, nil
+}
@@ -8,9 +8,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -30,18 +28,15 @@ type DeleteAPIKey interface {
 
 // DeleteAPIKeyImpl is the default implementation of DeleteAPIKey.
 type DeleteAPIKeyImpl struct {
-	repo      repositories.APIKeyRepository
-	publisher events.Publisher
+	repo repositories.APIKeyRepository
 }
 
 // NewDeleteAPIKey creates a new DeleteAPIKey handler.
 func NewDeleteAPIKey(
 	repo repositories.APIKeyRepository,
-	publisher events.Publisher,
 ) DeleteAPIKey {
 	return &DeleteAPIKeyImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -52,9 +47,5 @@ func (h *DeleteAPIKeyImpl) Execute(ctx context.Context, input *DeleteAPIKeyInput
 		return fmt.Errorf("failed to delete apikey: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewAPIKeyDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
@@ -10,7 +10,6 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -31,31 +30,33 @@ type DeleteInvitation interface {
 
 // DeleteInvitationImpl is the default implementation of DeleteInvitation.
 type DeleteInvitationImpl struct {
-	repo      repositories.InvitationRepository
-	publisher events.Publisher
+	repo repositories.InvitationRepository
 }
 
 // NewDeleteInvitation creates a new DeleteInvitation handler.
 func NewDeleteInvitation(
 	repo repositories.InvitationRepository,
-	publisher events.Publisher,
 ) DeleteInvitation {
 	return &DeleteInvitationImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
 // Execute performs the DeleteInvitation operation.
 func (h *DeleteInvitationImpl) Execute(ctx context.Context, input *DeleteInvitationInput) error {
+	// Only delete invitations of the organization in the path
+	existing, err := h.repo.Get(ctx, input.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get invitation: %w", err)
+	}
+	if existing.OrganizationID != input.OrganizationID {
+		return fmt.Errorf("failed to get invitation: %w", models.ErrInvitationNotFound)
+	}
+
 	// Delete from repository
 	if err := h.repo.Delete(ctx, input.ID); err != nil {
 		return fmt.Errorf("failed to delete invitation: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewInvitationDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
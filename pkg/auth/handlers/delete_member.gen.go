@@ -10,7 +10,6 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -31,31 +30,33 @@ type DeleteMember interface {
 
 // DeleteMemberImpl is the default implementation of DeleteMember.
 type DeleteMemberImpl struct {
-	repo      repositories.MemberRepository
-	publisher events.Publisher
+	repo repositories.MemberRepository
 }
 
 // NewDeleteMember creates a new DeleteMember handler.
 func NewDeleteMember(
 	repo repositories.MemberRepository,
-	publisher events.Publisher,
 ) DeleteMember {
 	return &DeleteMemberImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
 // Execute performs the DeleteMember operation.
 func (h *DeleteMemberImpl) Execute(ctx context.Context, input *DeleteMemberInput) error {
+	// Only delete members of the organization in the path
+	existing, err := h.repo.Get(ctx, input.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get member: %w", err)
+	}
+	if existing.OrganizationID != input.OrganizationID {
+		return fmt.Errorf("failed to get member: %w", models.ErrMemberNotFound)
+	}
+
 	// Delete from repository
 	if err := h.repo.Delete(ctx, input.ID); err != nil {
 		return fmt.Errorf("failed to delete member: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewMemberDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
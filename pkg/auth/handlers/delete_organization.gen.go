@@ -8,9 +8,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -30,18 +28,15 @@ type DeleteOrganization interface {
 
 // DeleteOrganizationImpl is the default implementation of DeleteOrganization.
 type DeleteOrganizationImpl struct {
-	repo      repositories.OrganizationRepository
-	publisher events.Publisher
+	repo repositories.OrganizationRepository
 }
 
 // NewDeleteOrganization creates a new DeleteOrganization handler.
 func NewDeleteOrganization(
 	repo repositories.OrganizationRepository,
-	publisher events.Publisher,
 ) DeleteOrganization {
 	return &DeleteOrganizationImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -52,9 +47,5 @@ func (h *DeleteOrganizationImpl) Execute(ctx context.Context, input *DeleteOrgan
 		return fmt.Errorf("failed to delete organization: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewOrganizationDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
@@ -8,9 +8,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -30,18 +28,15 @@ type DeleteUser interface {
 
 // DeleteUserImpl is the default implementation of DeleteUser.
 type DeleteUserImpl struct {
-	repo      repositories.UserRepository
-	publisher events.Publisher
+	repo repositories.UserRepository
 }
 
 // NewDeleteUser creates a new DeleteUser handler.
 func NewDeleteUser(
 	repo repositories.UserRepository,
-	publisher events.Publisher,
 ) DeleteUser {
 	return &DeleteUserImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -52,9 +47,5 @@ func (h *DeleteUserImpl) Execute(ctx context.Context, input *DeleteUserInput) er
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewUserDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
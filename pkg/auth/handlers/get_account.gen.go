@@ -56,9 +56,8 @@ func (h *GetAccountImpl) Execute(ctx context.Context, input *GetAccountInput) (*
 
 	// Map to output
 	output := &GetAccountOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
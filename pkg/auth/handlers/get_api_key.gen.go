@@ -56,9 +56,8 @@ func (h *GetAPIKeyImpl) Execute(ctx context.Context, input *GetAPIKeyInput) (*Ge
 
 	// Map to output
 	output := &GetAPIKeyOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
@@ -0,0 +1,35 @@
+package handlers
+
+// NOTE: This file is user-editable. The generator will not overwrite it.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archesai/archesai/pkg/auth"
+)
+
+// Ensure GetCurrentPermissionsImpl implements GetCurrentPermissions
+var _ GetCurrentPermissions = (*GetCurrentPermissionsImpl)(nil)
+
+// GetCurrentPermissionsImpl implements the GetCurrentPermissions interface.
+type GetCurrentPermissionsImpl struct{}
+
+// NewGetCurrentPermissions creates a new GetCurrentPermissions implementation.
+func NewGetCurrentPermissions() GetCurrentPermissions {
+	return &GetCurrentPermissionsImpl{}
+}
+
+// Execute returns the permissions the controller authorized the caller with.
+// The operation declares an empty x-codegen-permissions, so any member of the
+// current organization gets here.
+func (h *GetCurrentPermissionsImpl) Execute(
+	ctx context.Context,
+	_ *GetCurrentPermissionsInput,
+) (*GetCurrentPermissionsOutput, error) {
+	permissions, ok := auth.PermissionsFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no permissions in context")
+	}
+	return &GetCurrentPermissionsOutput{Data: *permissions}, nil
+}
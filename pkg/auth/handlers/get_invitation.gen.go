@@ -54,12 +54,14 @@ func (h *GetInvitationImpl) Execute(ctx context.Context, input *GetInvitationInp
 	if err != nil {
 		return nil, fmt.Errorf("failed to get invitation: %w", err)
 	}
+	if result.OrganizationID != input.OrganizationID {
+		return nil, fmt.Errorf("failed to get invitation: %w", models.ErrInvitationNotFound)
+	}
 
 	// Map to output
 	output := &GetInvitationOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
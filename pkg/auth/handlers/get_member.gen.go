@@ -54,12 +54,14 @@ func (h *GetMemberImpl) Execute(ctx context.Context, input *GetMemberInput) (*Ge
 	if err != nil {
 		return nil, fmt.Errorf("failed to get member: %w", err)
 	}
+	if result.OrganizationID != input.OrganizationID {
+		return nil, fmt.Errorf("failed to get member: %w", models.ErrMemberNotFound)
+	}
 
 	// Map to output
 	output := &GetMemberOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
@@ -56,9 +56,8 @@ func (h *GetOrganizationImpl) Execute(ctx context.Context, input *GetOrganizatio
 
 	// Map to output
 	output := &GetOrganizationOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
@@ -56,9 +56,8 @@ func (h *GetSessionImpl) Execute(ctx context.Context, input *GetSessionInput) (*
 
 	// Map to output
 	output := &GetSessionOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
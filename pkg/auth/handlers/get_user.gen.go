@@ -56,9 +56,8 @@ func (h *GetUserImpl) Execute(ctx context.Context, input *GetUserInput) (*GetUse
 
 	// Map to output
 	output := &GetUserOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
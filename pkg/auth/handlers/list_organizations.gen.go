@@ -4,12 +4,10 @@ package handlers
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/google/uuid"
 
 	"github.com/archesai/archesai/pkg/auth/models"
-	"github.com/archesai/archesai/pkg/auth/repositories"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
 )
 
@@ -35,37 +33,3 @@ type ListOrganizationsOutput struct {
 type ListOrganizations interface {
 	Execute(ctx context.Context, input *ListOrganizationsInput) (*ListOrganizationsOutput, error)
 }
-
-// ListOrganizationsImpl is the default implementation of ListOrganizations.
-type ListOrganizationsImpl struct {
-	repo repositories.OrganizationRepository
-}
-
-// NewListOrganizations creates a new ListOrganizations handler.
-func NewListOrganizations(
-	repo repositories.OrganizationRepository,
-) ListOrganizations {
-	return &ListOrganizationsImpl{
-		repo: repo,
-	}
-}
-
-// Execute performs the ListOrganizations operation.
-func (h *ListOrganizationsImpl) Execute(ctx context.Context, input *ListOrganizationsInput) (*ListOrganizationsOutput, error) {
-	// List from repository
-	results, total, err := h.repo.List(ctx, 100, 0) // TODO: Use pagination from input
-	if err != nil {
-		return nil, fmt.Errorf("failed to list organizations: %w", err)
-	}
-
-	// Map to output
-	output := &ListOrganizationsOutput{
-		// TODO: Map results to output structure
-		// Data: results,
-		// Total: total,
-	}
-	_ = results
-	_ = total
-
-	return output, nil
-}
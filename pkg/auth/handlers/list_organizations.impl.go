@@ -0,0 +1,99 @@
+package handlers
+
+// NOTE: This file is user-editable. The generator will not overwrite it.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/server"
+	servermodels "github.com/archesai/archesai/pkg/server/models"
+)
+
+// Ensure ListOrganizationsImpl implements ListOrganizations
+var _ ListOrganizations = (*ListOrganizationsImpl)(nil)
+
+// ListOrganizationsImpl implements the ListOrganizations interface.
+type ListOrganizationsImpl struct {
+	organizations repositories.OrganizationRepository
+	members       repositories.MemberRepository
+}
+
+// NewListOrganizations creates a new ListOrganizations implementation. It has
+// no repositories until the app replaces it with NewListOrganizationsWith.
+func NewListOrganizations() ListOrganizations {
+	return &ListOrganizationsImpl{}
+}
+
+// NewListOrganizationsWith creates a ListOrganizations implementation that
+// lists organizations from organizations and memberships from members.
+func NewListOrganizationsWith(
+	organizations repositories.OrganizationRepository,
+	members repositories.MemberRepository,
+) ListOrganizations {
+	return &ListOrganizationsImpl{organizations: organizations, members: members}
+}
+
+// Execute lists the organizations the caller is a member of, narrowed by the
+// filter of the input.
+func (h *ListOrganizationsImpl) Execute(
+	ctx context.Context,
+	input *ListOrganizationsInput,
+) (*ListOrganizationsOutput, error) {
+	if h.organizations == nil || h.members == nil {
+		return nil, fmt.Errorf("not implemented")
+	}
+	opts, err := database.NewListOptions(input.Filter, input.Page, input.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+	members, err := h.members.ListMembersByUser(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memberships: %w", err)
+	}
+	output := &ListOrganizationsOutput{Data: []models.Organization{}}
+	if len(members) == 0 {
+		return output, nil
+	}
+
+	memberships := database.Filter{Type: database.FilterOr}
+	for _, member := range members {
+		memberships.Children = append(memberships.Children, database.Filter{
+			Type:  database.FilterEq,
+			Field: "id",
+			Value: member.OrganizationID.String(),
+		})
+	}
+	if opts.Filter != nil {
+		memberships = database.Filter{
+			Type:     database.FilterAnd,
+			Children: []database.Filter{*opts.Filter, memberships},
+		}
+	}
+	opts.Filter = &memberships
+
+	results, info, err := h.organizations.List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	for _, result := range results {
+		output.Data = append(output.Data, *result)
+	}
+	output.Meta = servermodels.PaginationMeta{
+		Total: int32(info.Total),
+		Next:  info.Next,
+		Prev:  info.Prev,
+	}
+	return output, nil
+}
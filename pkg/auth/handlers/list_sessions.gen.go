@@ -10,6 +10,7 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
 )
 
@@ -51,20 +52,30 @@ func NewListSessions(
 
 // Execute performs the ListSessions operation.
 func (h *ListSessionsImpl) Execute(ctx context.Context, input *ListSessionsInput) (*ListSessionsOutput, error) {
+	opts, err := database.NewListOptions(nil, input.Page, input.Sort)
+	if err != nil {
+		return nil, err
+	}
+
 	// List from repository
-	results, total, err := h.repo.List(ctx, 100, 0) // TODO: Use pagination from input
+	results, info, err := h.repo.List(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list sessions: %w", err)
 	}
 
 	// Map to output
+	data := make([]models.Session, len(results))
+	for i, result := range results {
+		data[i] = *result
+	}
 	output := &ListSessionsOutput{
-		// TODO: Map results to output structure
-		// Data: results,
-		// Total: total,
+		Data: data,
+		Meta: servermodels.PaginationMeta{
+			Total: int32(info.Total),
+			Next:  info.Next,
+			Prev:  info.Prev,
+		},
 	}
-	_ = results
-	_ = total
 
 	return output, nil
 }
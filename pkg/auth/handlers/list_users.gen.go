@@ -10,6 +10,7 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
 )
 
@@ -52,20 +53,30 @@ func NewListUsers(
 
 // Execute performs the ListUsers operation.
 func (h *ListUsersImpl) Execute(ctx context.Context, input *ListUsersInput) (*ListUsersOutput, error) {
+	opts, err := database.NewListOptions(input.Filter, input.Page, input.Sort)
+	if err != nil {
+		return nil, err
+	}
+
 	// List from repository
-	results, total, err := h.repo.List(ctx, 100, 0) // TODO: Use pagination from input
+	results, info, err := h.repo.List(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list users: %w", err)
 	}
 
 	// Map to output
+	data := make([]models.User, len(results))
+	for i, result := range results {
+		data[i] = *result
+	}
 	output := &ListUsersOutput{
-		// TODO: Map results to output structure
-		// Data: results,
-		// Total: total,
+		Data: data,
+		Meta: servermodels.PaginationMeta{
+			Total: int32(info.Total),
+			Next:  info.Next,
+			Prev:  info.Prev,
+		},
 	}
-	_ = results
-	_ = total
 
 	return output, nil
 }
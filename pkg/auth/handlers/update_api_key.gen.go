@@ -11,7 +11,6 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -40,18 +39,15 @@ type UpdateAPIKey interface {
 
 // UpdateAPIKeyImpl is the default implementation of UpdateAPIKey.
 type UpdateAPIKeyImpl struct {
-	repo      repositories.APIKeyRepository
-	publisher events.Publisher
+	repo repositories.APIKeyRepository
 }
 
 // NewUpdateAPIKey creates a new UpdateAPIKey handler.
 func NewUpdateAPIKey(
 	repo repositories.APIKeyRepository,
-	publisher events.Publisher,
 ) UpdateAPIKey {
 	return &UpdateAPIKeyImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -73,15 +69,10 @@ func (h *UpdateAPIKeyImpl) Execute(ctx context.Context, input *UpdateAPIKeyInput
 		return nil, fmt.Errorf("failed to update apikey: %w", err)
 	}
 
-	// Publish event
-	event := models.NewAPIKeyUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdateAPIKeyOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
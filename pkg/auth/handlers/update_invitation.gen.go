@@ -11,7 +11,6 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -39,18 +38,15 @@ type UpdateInvitation interface {
 
 // UpdateInvitationImpl is the default implementation of UpdateInvitation.
 type UpdateInvitationImpl struct {
-	repo      repositories.InvitationRepository
-	publisher events.Publisher
+	repo repositories.InvitationRepository
 }
 
 // NewUpdateInvitation creates a new UpdateInvitation handler.
 func NewUpdateInvitation(
 	repo repositories.InvitationRepository,
-	publisher events.Publisher,
 ) UpdateInvitation {
 	return &UpdateInvitationImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -61,6 +57,9 @@ func (h *UpdateInvitationImpl) Execute(ctx context.Context, input *UpdateInvitat
 	if err != nil {
 		return nil, fmt.Errorf("failed to get invitation: %w", err)
 	}
+	if existing.OrganizationID != input.OrganizationID {
+		return nil, fmt.Errorf("failed to get invitation: %w", models.ErrInvitationNotFound)
+	}
 
 	// Update fields
 	// TODO: Map input fields to entity
@@ -72,15 +71,10 @@ func (h *UpdateInvitationImpl) Execute(ctx context.Context, input *UpdateInvitat
 		return nil, fmt.Errorf("failed to update invitation: %w", err)
 	}
 
-	// Publish event
-	event := models.NewInvitationUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdateInvitationOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
@@ -4,14 +4,10 @@ package handlers
 
 import (
 	"context"
-	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/archesai/archesai/pkg/auth/models"
-	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -24,6 +20,7 @@ type UpdateMemberInput struct {
 	OrganizationID uuid.UUID
 	ID             uuid.UUID
 	Role           *string
+	RoleID         *uuid.UUID
 }
 
 // UpdateMemberOutput represents the output for the UpdateMember operation.
@@ -35,51 +32,3 @@ type UpdateMemberOutput struct {
 type UpdateMember interface {
 	Execute(ctx context.Context, input *UpdateMemberInput) (*UpdateMemberOutput, error)
 }
-
-// UpdateMemberImpl is the default implementation of UpdateMember.
-type UpdateMemberImpl struct {
-	repo      repositories.MemberRepository
-	publisher events.Publisher
-}
-
-// NewUpdateMember creates a new UpdateMember handler.
-func NewUpdateMember(
-	repo repositories.MemberRepository,
-	publisher events.Publisher,
-) UpdateMember {
-	return &UpdateMemberImpl{
-		repo:      repo,
-		publisher: publisher,
-	}
-}
-
-// Execute performs the UpdateMember operation.
-func (h *UpdateMemberImpl) Execute(ctx context.Context, input *UpdateMemberInput) (*UpdateMemberOutput, error) {
-	// Get existing entity
-	existing, err := h.repo.Get(ctx, input.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get member: %w", err)
-	}
-
-	// Update fields
-	// TODO: Map input fields to entity
-	existing.UpdatedAt = time.Now().UTC()
-
-	// Save to repository
-	updated, err := h.repo.Update(ctx, input.ID, existing)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update member: %w", err)
-	}
-
-	// Publish event
-	event := models.NewMemberUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
-	// Map to output
-	output := &UpdateMemberOutput{
-		// TODO: Map updated entity to output
-	}
-	_ = updated
-
-	return output, nil
-}
@@ -0,0 +1,91 @@
+package handlers
+
+// NOTE: This file is user-editable. The generator will not overwrite it.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/server"
+)
+
+// Ensure UpdateMemberImpl implements UpdateMember
+var _ UpdateMember = (*UpdateMemberImpl)(nil)
+
+// UpdateMemberImpl implements the UpdateMember interface.
+type UpdateMemberImpl struct {
+	members repositories.MemberRepository
+	roles   repositories.RoleRepository
+}
+
+// NewUpdateMember creates a new UpdateMember implementation. It has no
+// repositories until the app replaces it with NewUpdateMemberWith.
+func NewUpdateMember() UpdateMember {
+	return &UpdateMemberImpl{}
+}
+
+// NewUpdateMemberWith creates an UpdateMember implementation that stores
+// members in members and looks custom roles up in roles.
+func NewUpdateMemberWith(
+	members repositories.MemberRepository,
+	roles repositories.RoleRepository,
+) UpdateMember {
+	return &UpdateMemberImpl{members: members, roles: roles}
+}
+
+// Execute changes the built-in role and the custom role of a member of the
+// organization. Only owners may make a member an owner or change an owner's
+// role, and a custom role must belong to the organization.
+func (h *UpdateMemberImpl) Execute(
+	ctx context.Context,
+	input *UpdateMemberInput,
+) (*UpdateMemberOutput, error) {
+	if h.members == nil || h.roles == nil {
+		return nil, fmt.Errorf("not implemented")
+	}
+	ctx = database.WithTenant(ctx, input.OrganizationID)
+
+	existing, err := h.members.Get(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member: %w", err)
+	}
+	if existing.OrganizationID != input.OrganizationID {
+		return nil, fmt.Errorf("failed to get member: %w", models.ErrMemberNotFound)
+	}
+
+	if input.Role != nil {
+		role := models.MemberRole(*input.Role)
+		if role == models.MemberRoleOwner || existing.Role == models.MemberRoleOwner {
+			permissions, ok := auth.PermissionsFromContext(ctx)
+			if !ok || permissions.Role != models.PermissionsRoleOwner {
+				return nil, auth.ErrForbidden
+			}
+		}
+		existing.Role = role
+	}
+	if input.RoleID != nil {
+		role, err := h.roles.Get(ctx, *input.RoleID)
+		if err != nil && !errors.Is(err, models.ErrRoleNotFound) {
+			return nil, fmt.Errorf("failed to get role: %w", err)
+		}
+		if err != nil || role.OrganizationID != input.OrganizationID {
+			v := server.NewValidator()
+			v.Add("/roleID", "must be a role of the organization")
+			return nil, v.Err()
+		}
+		existing.RoleID = input.RoleID
+	}
+	existing.UpdatedAt = time.Now().UTC()
+
+	updated, err := h.members.Update(ctx, input.ID, existing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update member: %w", err)
+	}
+	return &UpdateMemberOutput{Data: *updated}, nil
+}
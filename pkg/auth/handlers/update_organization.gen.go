@@ -11,7 +11,6 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -38,18 +37,15 @@ type UpdateOrganization interface {
 
 // UpdateOrganizationImpl is the default implementation of UpdateOrganization.
 type UpdateOrganizationImpl struct {
-	repo      repositories.OrganizationRepository
-	publisher events.Publisher
+	repo repositories.OrganizationRepository
 }
 
 // NewUpdateOrganization creates a new UpdateOrganization handler.
 func NewUpdateOrganization(
 	repo repositories.OrganizationRepository,
-	publisher events.Publisher,
 ) UpdateOrganization {
 	return &UpdateOrganizationImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -71,15 +67,10 @@ func (h *UpdateOrganizationImpl) Execute(ctx context.Context, input *UpdateOrgan
 		return nil, fmt.Errorf("failed to update organization: %w", err)
 	}
 
-	// Publish event
-	event := models.NewOrganizationUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdateOrganizationOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
@@ -23,7 +23,10 @@ type UpdateSessionInput struct {
 
 // UpdateSessionOutput represents the output for the UpdateSession operation.
 type UpdateSessionOutput struct {
-	Data models.Session `json:"data"`
+	AccessToken  string         `json:"accessToken"`
+	Data         models.Session `json:"data"`
+	ExpiresIn    int32          `json:"expiresIn"`
+	RefreshToken string         `json:"refreshToken"`
 }
 
 // UpdateSession defines the interface for the UpdateSession operation.
@@ -5,27 +5,62 @@ package handlers
 import (
 	"context"
 	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/auth"
 )
 
 // Ensure UpdateSessionImpl implements UpdateSession
 var _ UpdateSession = (*UpdateSessionImpl)(nil)
 
+// OrganizationSwitcher changes the active organization of a session, which
+// *auth.Service does.
+type OrganizationSwitcher interface {
+	SwitchOrganization(
+		ctx context.Context,
+		sessionID, organizationID uuid.UUID,
+	) (*auth.Session, *auth.Tokens, error)
+}
+
 // UpdateSessionImpl implements the UpdateSession interface.
 type UpdateSessionImpl struct {
-	// TODO: Add your dependencies here
+	switcher OrganizationSwitcher
 }
 
-// NewUpdateSession creates a new UpdateSession implementation.
-func NewUpdateSession(
-// TODO: Add your dependencies here
-) UpdateSession {
-	return &UpdateSessionImpl{
-		// TODO: Initialize dependencies
-	}
+// NewUpdateSession creates a new UpdateSession implementation. It has no
+// OrganizationSwitcher until the app replaces it with NewUpdateSessionWith.
+func NewUpdateSession() UpdateSession {
+	return &UpdateSessionImpl{}
 }
 
-// Execute performs the UpdateSession operation.
-func (h *UpdateSessionImpl) Execute(_ context.Context, _ *UpdateSessionInput) (*UpdateSessionOutput, error) {
-	// TODO: Implement UpdateSession logic
-	return nil, fmt.Errorf("not implemented")
+// NewUpdateSessionWith creates an UpdateSession implementation that switches
+// organizations with switcher.
+func NewUpdateSessionWith(switcher OrganizationSwitcher) UpdateSession {
+	return &UpdateSessionImpl{switcher: switcher}
+}
+
+// Execute makes the requested organization the active one of the caller's
+// session and returns tokens scoped to it. Callers can only update their own
+// session.
+func (h *UpdateSessionImpl) Execute(
+	ctx context.Context,
+	input *UpdateSessionInput,
+) (*UpdateSessionOutput, error) {
+	if h.switcher == nil {
+		return nil, fmt.Errorf("not implemented")
+	}
+	if input.ID != input.SessionID {
+		return nil, auth.ErrForbidden
+	}
+	session, tokens, err := h.switcher.SwitchOrganization(ctx, input.SessionID, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateSessionOutput{
+		Data:         *session,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    int32(tokens.ExpiresIn),
+	}, nil
 }
@@ -11,7 +11,6 @@ import (
 
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/repositories"
-	"github.com/archesai/archesai/pkg/events"
 )
 
 // ============================================================================
@@ -38,18 +37,15 @@ type UpdateUser interface {
 
 // UpdateUserImpl is the default implementation of UpdateUser.
 type UpdateUserImpl struct {
-	repo      repositories.UserRepository
-	publisher events.Publisher
+	repo repositories.UserRepository
 }
 
 // NewUpdateUser creates a new UpdateUser handler.
 func NewUpdateUser(
 	repo repositories.UserRepository,
-	publisher events.Publisher,
 ) UpdateUser {
 	return &UpdateUserImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -71,15 +67,10 @@ func (h *UpdateUserImpl) Execute(ctx context.Context, input *UpdateUserInput) (*
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
-	// Publish event
-	event := models.NewUserUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdateUserOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
@@ -0,0 +1,462 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/google/uuid"
+)
+
+var _ repositories.AccountRepository = (*AccountRepository)(nil)
+
+// AccountRepository is a mock of repositories.AccountRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type AccountRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Account) (*models.Account, error)
+
+	createCalls   []AccountRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Account) (*models.Account, error)
+	createReturns func(context.Context, *models.Account) (*models.Account, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Account, error)
+
+	getCalls   []AccountRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Account, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Account, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Account) (*models.Account, error)
+
+	updateCalls   []AccountRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Account) (*models.Account, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Account) (*models.Account, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []AccountRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Account, database.PageInfo, error)
+
+	listCalls   []AccountRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Account, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Account, database.PageInfo, error)
+
+	// GetAccountByProviderFunc, if set, is called by GetAccountByProvider.
+	GetAccountByProviderFunc func(ctx context.Context, provider string, accountIdentifier string) (*models.Account, error)
+
+	getAccountByProviderCalls   []AccountRepositoryGetAccountByProviderCall
+	getAccountByProviderOnCall  map[int]func(context.Context, string, string) (*models.Account, error)
+	getAccountByProviderReturns func(context.Context, string, string) (*models.Account, error)
+
+	// ListAccountsByUserIDFunc, if set, is called by ListAccountsByUserID.
+	ListAccountsByUserIDFunc func(ctx context.Context, userID string) ([]*models.Account, error)
+
+	listAccountsByUserIDCalls   []AccountRepositoryListAccountsByUserIDCall
+	listAccountsByUserIDOnCall  map[int]func(context.Context, string) ([]*models.Account, error)
+	listAccountsByUserIDReturns func(context.Context, string) ([]*models.Account, error)
+}
+
+// AccountRepositoryCreateCall holds the arguments of a call to Create.
+type AccountRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Account
+}
+
+// Create records the call and returns the configured result.
+func (m *AccountRepository) Create(ctx context.Context, entity *models.Account) (r0 *models.Account, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, AccountRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *AccountRepository) CreateReturns(r0 *models.Account, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Account) (*models.Account, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *AccountRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Account) (*models.Account, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Account) (*models.Account, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *AccountRepository) CreateCalls() []AccountRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// AccountRepositoryGetCall holds the arguments of a call to Get.
+type AccountRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *AccountRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Account, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, AccountRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *AccountRepository) GetReturns(r0 *models.Account, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Account, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *AccountRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Account, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Account, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *AccountRepository) GetCalls() []AccountRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// AccountRepositoryUpdateCall holds the arguments of a call to Update.
+type AccountRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Account
+}
+
+// Update records the call and returns the configured result.
+func (m *AccountRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Account) (r0 *models.Account, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, AccountRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *AccountRepository) UpdateReturns(r0 *models.Account, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Account) (*models.Account, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *AccountRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Account) (*models.Account, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Account) (*models.Account, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *AccountRepository) UpdateCalls() []AccountRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// AccountRepositoryDeleteCall holds the arguments of a call to Delete.
+type AccountRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *AccountRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, AccountRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *AccountRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *AccountRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *AccountRepository) DeleteCalls() []AccountRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// AccountRepositoryListCall holds the arguments of a call to List.
+type AccountRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *AccountRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Account, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, AccountRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *AccountRepository) ListReturns(r0 []*models.Account, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Account, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *AccountRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Account, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Account, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *AccountRepository) ListCalls() []AccountRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// AccountRepositoryGetAccountByProviderCall holds the arguments of a call to GetAccountByProvider.
+type AccountRepositoryGetAccountByProviderCall struct {
+	Ctx               context.Context
+	Provider          string
+	AccountIdentifier string
+}
+
+// GetAccountByProvider records the call and returns the configured result.
+func (m *AccountRepository) GetAccountByProvider(ctx context.Context, provider string, accountIdentifier string) (r0 *models.Account, r1 error) {
+	m.mu.Lock()
+	m.getAccountByProviderCalls = append(m.getAccountByProviderCalls, AccountRepositoryGetAccountByProviderCall{
+		Ctx:               ctx,
+		Provider:          provider,
+		AccountIdentifier: accountIdentifier,
+	})
+	fn := m.getAccountByProviderOnCall[len(m.getAccountByProviderCalls)-1]
+	if fn == nil {
+		fn = m.GetAccountByProviderFunc
+	}
+	if fn == nil {
+		fn = m.getAccountByProviderReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, provider, accountIdentifier)
+}
+
+// GetAccountByProviderReturns makes calls to GetAccountByProvider return the given values.
+func (m *AccountRepository) GetAccountByProviderReturns(r0 *models.Account, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getAccountByProviderReturns = func(context.Context, string, string) (*models.Account, error) {
+		return r0, r1
+	}
+}
+
+// OnGetAccountByProviderCall makes the call to GetAccountByProvider with index i, counting from
+// zero, return the result of fn.
+func (m *AccountRepository) OnGetAccountByProviderCall(i int, fn func(ctx context.Context, provider string, accountIdentifier string) (*models.Account, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getAccountByProviderOnCall == nil {
+		m.getAccountByProviderOnCall = make(map[int]func(context.Context, string, string) (*models.Account, error))
+	}
+	m.getAccountByProviderOnCall[i] = fn
+}
+
+// GetAccountByProviderCalls returns the calls made to GetAccountByProvider, in order.
+func (m *AccountRepository) GetAccountByProviderCalls() []AccountRepositoryGetAccountByProviderCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getAccountByProviderCalls)
+}
+
+// AccountRepositoryListAccountsByUserIDCall holds the arguments of a call to ListAccountsByUserID.
+type AccountRepositoryListAccountsByUserIDCall struct {
+	Ctx    context.Context
+	UserID string
+}
+
+// ListAccountsByUserID records the call and returns the configured result.
+func (m *AccountRepository) ListAccountsByUserID(ctx context.Context, userID string) (r0 []*models.Account, r1 error) {
+	m.mu.Lock()
+	m.listAccountsByUserIDCalls = append(m.listAccountsByUserIDCalls, AccountRepositoryListAccountsByUserIDCall{
+		Ctx:    ctx,
+		UserID: userID,
+	})
+	fn := m.listAccountsByUserIDOnCall[len(m.listAccountsByUserIDCalls)-1]
+	if fn == nil {
+		fn = m.ListAccountsByUserIDFunc
+	}
+	if fn == nil {
+		fn = m.listAccountsByUserIDReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, userID)
+}
+
+// ListAccountsByUserIDReturns makes calls to ListAccountsByUserID return the given values.
+func (m *AccountRepository) ListAccountsByUserIDReturns(r0 []*models.Account, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listAccountsByUserIDReturns = func(context.Context, string) ([]*models.Account, error) {
+		return r0, r1
+	}
+}
+
+// OnListAccountsByUserIDCall makes the call to ListAccountsByUserID with index i, counting from
+// zero, return the result of fn.
+func (m *AccountRepository) OnListAccountsByUserIDCall(i int, fn func(ctx context.Context, userID string) ([]*models.Account, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listAccountsByUserIDOnCall == nil {
+		m.listAccountsByUserIDOnCall = make(map[int]func(context.Context, string) ([]*models.Account, error))
+	}
+	m.listAccountsByUserIDOnCall[i] = fn
+}
+
+// ListAccountsByUserIDCalls returns the calls made to ListAccountsByUserID, in order.
+func (m *AccountRepository) ListAccountsByUserIDCalls() []AccountRepositoryListAccountsByUserIDCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listAccountsByUserIDCalls)
+}
@@ -0,0 +1,336 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/google/uuid"
+)
+
+var _ repositories.APIKeyRepository = (*APIKeyRepository)(nil)
+
+// APIKeyRepository is a mock of repositories.APIKeyRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type APIKeyRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.APIKey) (*models.APIKey, error)
+
+	createCalls   []APIKeyRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.APIKey) (*models.APIKey, error)
+	createReturns func(context.Context, *models.APIKey) (*models.APIKey, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.APIKey, error)
+
+	getCalls   []APIKeyRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.APIKey, error)
+	getReturns func(context.Context, uuid.UUID) (*models.APIKey, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.APIKey) (*models.APIKey, error)
+
+	updateCalls   []APIKeyRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.APIKey) (*models.APIKey, error)
+	updateReturns func(context.Context, uuid.UUID, *models.APIKey) (*models.APIKey, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []APIKeyRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.APIKey, database.PageInfo, error)
+
+	listCalls   []APIKeyRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.APIKey, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.APIKey, database.PageInfo, error)
+}
+
+// APIKeyRepositoryCreateCall holds the arguments of a call to Create.
+type APIKeyRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.APIKey
+}
+
+// Create records the call and returns the configured result.
+func (m *APIKeyRepository) Create(ctx context.Context, entity *models.APIKey) (r0 *models.APIKey, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, APIKeyRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *APIKeyRepository) CreateReturns(r0 *models.APIKey, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.APIKey) (*models.APIKey, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *APIKeyRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.APIKey) (*models.APIKey, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.APIKey) (*models.APIKey, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *APIKeyRepository) CreateCalls() []APIKeyRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// APIKeyRepositoryGetCall holds the arguments of a call to Get.
+type APIKeyRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *APIKeyRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.APIKey, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, APIKeyRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *APIKeyRepository) GetReturns(r0 *models.APIKey, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.APIKey, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *APIKeyRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.APIKey, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.APIKey, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *APIKeyRepository) GetCalls() []APIKeyRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// APIKeyRepositoryUpdateCall holds the arguments of a call to Update.
+type APIKeyRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.APIKey
+}
+
+// Update records the call and returns the configured result.
+func (m *APIKeyRepository) Update(ctx context.Context, id uuid.UUID, entity *models.APIKey) (r0 *models.APIKey, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, APIKeyRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *APIKeyRepository) UpdateReturns(r0 *models.APIKey, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.APIKey) (*models.APIKey, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *APIKeyRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.APIKey) (*models.APIKey, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.APIKey) (*models.APIKey, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *APIKeyRepository) UpdateCalls() []APIKeyRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// APIKeyRepositoryDeleteCall holds the arguments of a call to Delete.
+type APIKeyRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *APIKeyRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, APIKeyRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *APIKeyRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *APIKeyRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *APIKeyRepository) DeleteCalls() []APIKeyRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// APIKeyRepositoryListCall holds the arguments of a call to List.
+type APIKeyRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *APIKeyRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.APIKey, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, APIKeyRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *APIKeyRepository) ListReturns(r0 []*models.APIKey, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.APIKey, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *APIKeyRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.APIKey, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.APIKey, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *APIKeyRepository) ListCalls() []APIKeyRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
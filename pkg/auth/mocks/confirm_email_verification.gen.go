@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.ConfirmEmailVerification = (*ConfirmEmailVerification)(nil)
+
+// ConfirmEmailVerification is a mock of handlers.ConfirmEmailVerification that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type ConfirmEmailVerification struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.ConfirmEmailVerificationInput) (*handlers.ConfirmEmailVerificationOutput, error)
+
+	executeCalls   []ConfirmEmailVerificationExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.ConfirmEmailVerificationInput) (*handlers.ConfirmEmailVerificationOutput, error)
+	executeReturns func(context.Context, *handlers.ConfirmEmailVerificationInput) (*handlers.ConfirmEmailVerificationOutput, error)
+}
+
+// ConfirmEmailVerificationExecuteCall holds the arguments of a call to Execute.
+type ConfirmEmailVerificationExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.ConfirmEmailVerificationInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *ConfirmEmailVerification) Execute(ctx context.Context, input *handlers.ConfirmEmailVerificationInput) (r0 *handlers.ConfirmEmailVerificationOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, ConfirmEmailVerificationExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *ConfirmEmailVerification) ExecuteReturns(r0 *handlers.ConfirmEmailVerificationOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.ConfirmEmailVerificationInput) (*handlers.ConfirmEmailVerificationOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *ConfirmEmailVerification) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.ConfirmEmailVerificationInput) (*handlers.ConfirmEmailVerificationOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.ConfirmEmailVerificationInput) (*handlers.ConfirmEmailVerificationOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *ConfirmEmailVerification) ExecuteCalls() []ConfirmEmailVerificationExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
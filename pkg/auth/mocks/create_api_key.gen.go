@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.CreateAPIKey = (*CreateAPIKey)(nil)
+
+// CreateAPIKey is a mock of handlers.CreateAPIKey that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type CreateAPIKey struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.CreateAPIKeyInput) (*handlers.CreateAPIKeyOutput, error)
+
+	executeCalls   []CreateAPIKeyExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.CreateAPIKeyInput) (*handlers.CreateAPIKeyOutput, error)
+	executeReturns func(context.Context, *handlers.CreateAPIKeyInput) (*handlers.CreateAPIKeyOutput, error)
+}
+
+// CreateAPIKeyExecuteCall holds the arguments of a call to Execute.
+type CreateAPIKeyExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.CreateAPIKeyInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *CreateAPIKey) Execute(ctx context.Context, input *handlers.CreateAPIKeyInput) (r0 *handlers.CreateAPIKeyOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, CreateAPIKeyExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *CreateAPIKey) ExecuteReturns(r0 *handlers.CreateAPIKeyOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.CreateAPIKeyInput) (*handlers.CreateAPIKeyOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *CreateAPIKey) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.CreateAPIKeyInput) (*handlers.CreateAPIKeyOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.CreateAPIKeyInput) (*handlers.CreateAPIKeyOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *CreateAPIKey) ExecuteCalls() []CreateAPIKeyExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.DeleteCurrentUser = (*DeleteCurrentUser)(nil)
+
+// DeleteCurrentUser is a mock of handlers.DeleteCurrentUser that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type DeleteCurrentUser struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.DeleteCurrentUserInput) error
+
+	executeCalls   []DeleteCurrentUserExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.DeleteCurrentUserInput) error
+	executeReturns func(context.Context, *handlers.DeleteCurrentUserInput) error
+}
+
+// DeleteCurrentUserExecuteCall holds the arguments of a call to Execute.
+type DeleteCurrentUserExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.DeleteCurrentUserInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *DeleteCurrentUser) Execute(ctx context.Context, input *handlers.DeleteCurrentUserInput) (r0 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, DeleteCurrentUserExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *DeleteCurrentUser) ExecuteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.DeleteCurrentUserInput) error {
+		return r0
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *DeleteCurrentUser) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.DeleteCurrentUserInput) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.DeleteCurrentUserInput) error)
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *DeleteCurrentUser) ExecuteCalls() []DeleteCurrentUserExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
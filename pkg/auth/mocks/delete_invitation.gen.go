@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.DeleteInvitation = (*DeleteInvitation)(nil)
+
+// DeleteInvitation is a mock of handlers.DeleteInvitation that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type DeleteInvitation struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.DeleteInvitationInput) error
+
+	executeCalls   []DeleteInvitationExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.DeleteInvitationInput) error
+	executeReturns func(context.Context, *handlers.DeleteInvitationInput) error
+}
+
+// DeleteInvitationExecuteCall holds the arguments of a call to Execute.
+type DeleteInvitationExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.DeleteInvitationInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *DeleteInvitation) Execute(ctx context.Context, input *handlers.DeleteInvitationInput) (r0 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, DeleteInvitationExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *DeleteInvitation) ExecuteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.DeleteInvitationInput) error {
+		return r0
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *DeleteInvitation) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.DeleteInvitationInput) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.DeleteInvitationInput) error)
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *DeleteInvitation) ExecuteCalls() []DeleteInvitationExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.GetAccount = (*GetAccount)(nil)
+
+// GetAccount is a mock of handlers.GetAccount that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type GetAccount struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.GetAccountInput) (*handlers.GetAccountOutput, error)
+
+	executeCalls   []GetAccountExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.GetAccountInput) (*handlers.GetAccountOutput, error)
+	executeReturns func(context.Context, *handlers.GetAccountInput) (*handlers.GetAccountOutput, error)
+}
+
+// GetAccountExecuteCall holds the arguments of a call to Execute.
+type GetAccountExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.GetAccountInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *GetAccount) Execute(ctx context.Context, input *handlers.GetAccountInput) (r0 *handlers.GetAccountOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, GetAccountExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *GetAccount) ExecuteReturns(r0 *handlers.GetAccountOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.GetAccountInput) (*handlers.GetAccountOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *GetAccount) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.GetAccountInput) (*handlers.GetAccountOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.GetAccountInput) (*handlers.GetAccountOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *GetAccount) ExecuteCalls() []GetAccountExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.GetOrganization = (*GetOrganization)(nil)
+
+// GetOrganization is a mock of handlers.GetOrganization that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type GetOrganization struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.GetOrganizationInput) (*handlers.GetOrganizationOutput, error)
+
+	executeCalls   []GetOrganizationExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.GetOrganizationInput) (*handlers.GetOrganizationOutput, error)
+	executeReturns func(context.Context, *handlers.GetOrganizationInput) (*handlers.GetOrganizationOutput, error)
+}
+
+// GetOrganizationExecuteCall holds the arguments of a call to Execute.
+type GetOrganizationExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.GetOrganizationInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *GetOrganization) Execute(ctx context.Context, input *handlers.GetOrganizationInput) (r0 *handlers.GetOrganizationOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, GetOrganizationExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *GetOrganization) ExecuteReturns(r0 *handlers.GetOrganizationOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.GetOrganizationInput) (*handlers.GetOrganizationOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *GetOrganization) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.GetOrganizationInput) (*handlers.GetOrganizationOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.GetOrganizationInput) (*handlers.GetOrganizationOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *GetOrganization) ExecuteCalls() []GetOrganizationExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,524 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/google/uuid"
+)
+
+var _ repositories.InvitationRepository = (*InvitationRepository)(nil)
+
+// InvitationRepository is a mock of repositories.InvitationRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type InvitationRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Invitation) (*models.Invitation, error)
+
+	createCalls   []InvitationRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Invitation) (*models.Invitation, error)
+	createReturns func(context.Context, *models.Invitation) (*models.Invitation, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Invitation, error)
+
+	getCalls   []InvitationRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Invitation, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Invitation, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Invitation) (*models.Invitation, error)
+
+	updateCalls   []InvitationRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Invitation) (*models.Invitation, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Invitation) (*models.Invitation, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []InvitationRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Invitation, database.PageInfo, error)
+
+	listCalls   []InvitationRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Invitation, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Invitation, database.PageInfo, error)
+
+	// ListInvitationsByOrganizationFunc, if set, is called by ListInvitationsByOrganization.
+	ListInvitationsByOrganizationFunc func(ctx context.Context, organizationID string) ([]*models.Invitation, error)
+
+	listInvitationsByOrganizationCalls   []InvitationRepositoryListInvitationsByOrganizationCall
+	listInvitationsByOrganizationOnCall  map[int]func(context.Context, string) ([]*models.Invitation, error)
+	listInvitationsByOrganizationReturns func(context.Context, string) ([]*models.Invitation, error)
+
+	// GetInvitationByEmailFunc, if set, is called by GetInvitationByEmail.
+	GetInvitationByEmailFunc func(ctx context.Context, email string, organizationID string) (*models.Invitation, error)
+
+	getInvitationByEmailCalls   []InvitationRepositoryGetInvitationByEmailCall
+	getInvitationByEmailOnCall  map[int]func(context.Context, string, string) (*models.Invitation, error)
+	getInvitationByEmailReturns func(context.Context, string, string) (*models.Invitation, error)
+
+	// ListInvitationsByInviterFunc, if set, is called by ListInvitationsByInviter.
+	ListInvitationsByInviterFunc func(ctx context.Context, inviterID string) ([]*models.Invitation, error)
+
+	listInvitationsByInviterCalls   []InvitationRepositoryListInvitationsByInviterCall
+	listInvitationsByInviterOnCall  map[int]func(context.Context, string) ([]*models.Invitation, error)
+	listInvitationsByInviterReturns func(context.Context, string) ([]*models.Invitation, error)
+}
+
+// InvitationRepositoryCreateCall holds the arguments of a call to Create.
+type InvitationRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Invitation
+}
+
+// Create records the call and returns the configured result.
+func (m *InvitationRepository) Create(ctx context.Context, entity *models.Invitation) (r0 *models.Invitation, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, InvitationRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *InvitationRepository) CreateReturns(r0 *models.Invitation, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Invitation) (*models.Invitation, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *InvitationRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Invitation) (*models.Invitation, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Invitation) (*models.Invitation, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *InvitationRepository) CreateCalls() []InvitationRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// InvitationRepositoryGetCall holds the arguments of a call to Get.
+type InvitationRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *InvitationRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Invitation, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, InvitationRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *InvitationRepository) GetReturns(r0 *models.Invitation, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Invitation, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *InvitationRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Invitation, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Invitation, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *InvitationRepository) GetCalls() []InvitationRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// InvitationRepositoryUpdateCall holds the arguments of a call to Update.
+type InvitationRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Invitation
+}
+
+// Update records the call and returns the configured result.
+func (m *InvitationRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Invitation) (r0 *models.Invitation, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, InvitationRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *InvitationRepository) UpdateReturns(r0 *models.Invitation, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Invitation) (*models.Invitation, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *InvitationRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Invitation) (*models.Invitation, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Invitation) (*models.Invitation, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *InvitationRepository) UpdateCalls() []InvitationRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// InvitationRepositoryDeleteCall holds the arguments of a call to Delete.
+type InvitationRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *InvitationRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, InvitationRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *InvitationRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *InvitationRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *InvitationRepository) DeleteCalls() []InvitationRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// InvitationRepositoryListCall holds the arguments of a call to List.
+type InvitationRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *InvitationRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Invitation, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, InvitationRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *InvitationRepository) ListReturns(r0 []*models.Invitation, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Invitation, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *InvitationRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Invitation, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Invitation, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *InvitationRepository) ListCalls() []InvitationRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// InvitationRepositoryListInvitationsByOrganizationCall holds the arguments of a call to ListInvitationsByOrganization.
+type InvitationRepositoryListInvitationsByOrganizationCall struct {
+	Ctx            context.Context
+	OrganizationID string
+}
+
+// ListInvitationsByOrganization records the call and returns the configured result.
+func (m *InvitationRepository) ListInvitationsByOrganization(ctx context.Context, organizationID string) (r0 []*models.Invitation, r1 error) {
+	m.mu.Lock()
+	m.listInvitationsByOrganizationCalls = append(m.listInvitationsByOrganizationCalls, InvitationRepositoryListInvitationsByOrganizationCall{
+		Ctx:            ctx,
+		OrganizationID: organizationID,
+	})
+	fn := m.listInvitationsByOrganizationOnCall[len(m.listInvitationsByOrganizationCalls)-1]
+	if fn == nil {
+		fn = m.ListInvitationsByOrganizationFunc
+	}
+	if fn == nil {
+		fn = m.listInvitationsByOrganizationReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, organizationID)
+}
+
+// ListInvitationsByOrganizationReturns makes calls to ListInvitationsByOrganization return the given values.
+func (m *InvitationRepository) ListInvitationsByOrganizationReturns(r0 []*models.Invitation, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listInvitationsByOrganizationReturns = func(context.Context, string) ([]*models.Invitation, error) {
+		return r0, r1
+	}
+}
+
+// OnListInvitationsByOrganizationCall makes the call to ListInvitationsByOrganization with index i, counting from
+// zero, return the result of fn.
+func (m *InvitationRepository) OnListInvitationsByOrganizationCall(i int, fn func(ctx context.Context, organizationID string) ([]*models.Invitation, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listInvitationsByOrganizationOnCall == nil {
+		m.listInvitationsByOrganizationOnCall = make(map[int]func(context.Context, string) ([]*models.Invitation, error))
+	}
+	m.listInvitationsByOrganizationOnCall[i] = fn
+}
+
+// ListInvitationsByOrganizationCalls returns the calls made to ListInvitationsByOrganization, in order.
+func (m *InvitationRepository) ListInvitationsByOrganizationCalls() []InvitationRepositoryListInvitationsByOrganizationCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listInvitationsByOrganizationCalls)
+}
+
+// InvitationRepositoryGetInvitationByEmailCall holds the arguments of a call to GetInvitationByEmail.
+type InvitationRepositoryGetInvitationByEmailCall struct {
+	Ctx            context.Context
+	Email          string
+	OrganizationID string
+}
+
+// GetInvitationByEmail records the call and returns the configured result.
+func (m *InvitationRepository) GetInvitationByEmail(ctx context.Context, email string, organizationID string) (r0 *models.Invitation, r1 error) {
+	m.mu.Lock()
+	m.getInvitationByEmailCalls = append(m.getInvitationByEmailCalls, InvitationRepositoryGetInvitationByEmailCall{
+		Ctx:            ctx,
+		Email:          email,
+		OrganizationID: organizationID,
+	})
+	fn := m.getInvitationByEmailOnCall[len(m.getInvitationByEmailCalls)-1]
+	if fn == nil {
+		fn = m.GetInvitationByEmailFunc
+	}
+	if fn == nil {
+		fn = m.getInvitationByEmailReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, email, organizationID)
+}
+
+// GetInvitationByEmailReturns makes calls to GetInvitationByEmail return the given values.
+func (m *InvitationRepository) GetInvitationByEmailReturns(r0 *models.Invitation, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getInvitationByEmailReturns = func(context.Context, string, string) (*models.Invitation, error) {
+		return r0, r1
+	}
+}
+
+// OnGetInvitationByEmailCall makes the call to GetInvitationByEmail with index i, counting from
+// zero, return the result of fn.
+func (m *InvitationRepository) OnGetInvitationByEmailCall(i int, fn func(ctx context.Context, email string, organizationID string) (*models.Invitation, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getInvitationByEmailOnCall == nil {
+		m.getInvitationByEmailOnCall = make(map[int]func(context.Context, string, string) (*models.Invitation, error))
+	}
+	m.getInvitationByEmailOnCall[i] = fn
+}
+
+// GetInvitationByEmailCalls returns the calls made to GetInvitationByEmail, in order.
+func (m *InvitationRepository) GetInvitationByEmailCalls() []InvitationRepositoryGetInvitationByEmailCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getInvitationByEmailCalls)
+}
+
+// InvitationRepositoryListInvitationsByInviterCall holds the arguments of a call to ListInvitationsByInviter.
+type InvitationRepositoryListInvitationsByInviterCall struct {
+	Ctx       context.Context
+	InviterID string
+}
+
+// ListInvitationsByInviter records the call and returns the configured result.
+func (m *InvitationRepository) ListInvitationsByInviter(ctx context.Context, inviterID string) (r0 []*models.Invitation, r1 error) {
+	m.mu.Lock()
+	m.listInvitationsByInviterCalls = append(m.listInvitationsByInviterCalls, InvitationRepositoryListInvitationsByInviterCall{
+		Ctx:       ctx,
+		InviterID: inviterID,
+	})
+	fn := m.listInvitationsByInviterOnCall[len(m.listInvitationsByInviterCalls)-1]
+	if fn == nil {
+		fn = m.ListInvitationsByInviterFunc
+	}
+	if fn == nil {
+		fn = m.listInvitationsByInviterReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, inviterID)
+}
+
+// ListInvitationsByInviterReturns makes calls to ListInvitationsByInviter return the given values.
+func (m *InvitationRepository) ListInvitationsByInviterReturns(r0 []*models.Invitation, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listInvitationsByInviterReturns = func(context.Context, string) ([]*models.Invitation, error) {
+		return r0, r1
+	}
+}
+
+// OnListInvitationsByInviterCall makes the call to ListInvitationsByInviter with index i, counting from
+// zero, return the result of fn.
+func (m *InvitationRepository) OnListInvitationsByInviterCall(i int, fn func(ctx context.Context, inviterID string) ([]*models.Invitation, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listInvitationsByInviterOnCall == nil {
+		m.listInvitationsByInviterOnCall = make(map[int]func(context.Context, string) ([]*models.Invitation, error))
+	}
+	m.listInvitationsByInviterOnCall[i] = fn
+}
+
+// ListInvitationsByInviterCalls returns the calls made to ListInvitationsByInviter, in order.
+func (m *InvitationRepository) ListInvitationsByInviterCalls() []InvitationRepositoryListInvitationsByInviterCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listInvitationsByInviterCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.ListAccounts = (*ListAccounts)(nil)
+
+// ListAccounts is a mock of handlers.ListAccounts that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type ListAccounts struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.ListAccountsInput) (*handlers.ListAccountsOutput, error)
+
+	executeCalls   []ListAccountsExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.ListAccountsInput) (*handlers.ListAccountsOutput, error)
+	executeReturns func(context.Context, *handlers.ListAccountsInput) (*handlers.ListAccountsOutput, error)
+}
+
+// ListAccountsExecuteCall holds the arguments of a call to Execute.
+type ListAccountsExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.ListAccountsInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *ListAccounts) Execute(ctx context.Context, input *handlers.ListAccountsInput) (r0 *handlers.ListAccountsOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, ListAccountsExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *ListAccounts) ExecuteReturns(r0 *handlers.ListAccountsOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.ListAccountsInput) (*handlers.ListAccountsOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *ListAccounts) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.ListAccountsInput) (*handlers.ListAccountsOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.ListAccountsInput) (*handlers.ListAccountsOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *ListAccounts) ExecuteCalls() []ListAccountsExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
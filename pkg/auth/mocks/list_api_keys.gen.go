@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.ListAPIKeys = (*ListAPIKeys)(nil)
+
+// ListAPIKeys is a mock of handlers.ListAPIKeys that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type ListAPIKeys struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.ListAPIKeysInput) (*handlers.ListAPIKeysOutput, error)
+
+	executeCalls   []ListAPIKeysExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.ListAPIKeysInput) (*handlers.ListAPIKeysOutput, error)
+	executeReturns func(context.Context, *handlers.ListAPIKeysInput) (*handlers.ListAPIKeysOutput, error)
+}
+
+// ListAPIKeysExecuteCall holds the arguments of a call to Execute.
+type ListAPIKeysExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.ListAPIKeysInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *ListAPIKeys) Execute(ctx context.Context, input *handlers.ListAPIKeysInput) (r0 *handlers.ListAPIKeysOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, ListAPIKeysExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *ListAPIKeys) ExecuteReturns(r0 *handlers.ListAPIKeysOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.ListAPIKeysInput) (*handlers.ListAPIKeysOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *ListAPIKeys) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.ListAPIKeysInput) (*handlers.ListAPIKeysOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.ListAPIKeysInput) (*handlers.ListAPIKeysOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *ListAPIKeys) ExecuteCalls() []ListAPIKeysExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.Login = (*Login)(nil)
+
+// Login is a mock of handlers.Login that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type Login struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.LoginInput) (*handlers.LoginOutput, error)
+
+	executeCalls   []LoginExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.LoginInput) (*handlers.LoginOutput, error)
+	executeReturns func(context.Context, *handlers.LoginInput) (*handlers.LoginOutput, error)
+}
+
+// LoginExecuteCall holds the arguments of a call to Execute.
+type LoginExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.LoginInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *Login) Execute(ctx context.Context, input *handlers.LoginInput) (r0 *handlers.LoginOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, LoginExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *Login) ExecuteReturns(r0 *handlers.LoginOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.LoginInput) (*handlers.LoginOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *Login) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.LoginInput) (*handlers.LoginOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.LoginInput) (*handlers.LoginOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *Login) ExecuteCalls() []LoginExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.Logout = (*Logout)(nil)
+
+// Logout is a mock of handlers.Logout that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type Logout struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.LogoutInput) (*handlers.LogoutOutput, error)
+
+	executeCalls   []LogoutExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.LogoutInput) (*handlers.LogoutOutput, error)
+	executeReturns func(context.Context, *handlers.LogoutInput) (*handlers.LogoutOutput, error)
+}
+
+// LogoutExecuteCall holds the arguments of a call to Execute.
+type LogoutExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.LogoutInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *Logout) Execute(ctx context.Context, input *handlers.LogoutInput) (r0 *handlers.LogoutOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, LogoutExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *Logout) ExecuteReturns(r0 *handlers.LogoutOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.LogoutInput) (*handlers.LogoutOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *Logout) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.LogoutInput) (*handlers.LogoutOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.LogoutInput) (*handlers.LogoutOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *Logout) ExecuteCalls() []LogoutExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
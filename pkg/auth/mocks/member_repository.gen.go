@@ -0,0 +1,711 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/google/uuid"
+)
+
+var _ repositories.MemberRepository = (*MemberRepository)(nil)
+
+// MemberRepository is a mock of repositories.MemberRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type MemberRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Member) (*models.Member, error)
+
+	createCalls   []MemberRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Member) (*models.Member, error)
+	createReturns func(context.Context, *models.Member) (*models.Member, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Member, error)
+
+	getCalls   []MemberRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Member, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Member, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Member) (*models.Member, error)
+
+	updateCalls   []MemberRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Member) (*models.Member, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Member) (*models.Member, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []MemberRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error)
+
+	listCalls   []MemberRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Member, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Member, database.PageInfo, error)
+
+	// RestoreFunc, if set, is called by Restore.
+	RestoreFunc func(ctx context.Context, id uuid.UUID) error
+
+	restoreCalls   []MemberRepositoryRestoreCall
+	restoreOnCall  map[int]func(context.Context, uuid.UUID) error
+	restoreReturns func(context.Context, uuid.UUID) error
+
+	// ListDeletedFunc, if set, is called by ListDeleted.
+	ListDeletedFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error)
+
+	listDeletedCalls   []MemberRepositoryListDeletedCall
+	listDeletedOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Member, database.PageInfo, error)
+	listDeletedReturns func(context.Context, database.ListOptions) ([]*models.Member, database.PageInfo, error)
+
+	// PurgeFunc, if set, is called by Purge.
+	PurgeFunc func(ctx context.Context, before time.Time) (int64, error)
+
+	purgeCalls   []MemberRepositoryPurgeCall
+	purgeOnCall  map[int]func(context.Context, time.Time) (int64, error)
+	purgeReturns func(context.Context, time.Time) (int64, error)
+
+	// ListMembersByOrganizationFunc, if set, is called by ListMembersByOrganization.
+	ListMembersByOrganizationFunc func(ctx context.Context, organizationID string) ([]*models.Member, error)
+
+	listMembersByOrganizationCalls   []MemberRepositoryListMembersByOrganizationCall
+	listMembersByOrganizationOnCall  map[int]func(context.Context, string) ([]*models.Member, error)
+	listMembersByOrganizationReturns func(context.Context, string) ([]*models.Member, error)
+
+	// ListMembersByUserFunc, if set, is called by ListMembersByUser.
+	ListMembersByUserFunc func(ctx context.Context, userID string) ([]*models.Member, error)
+
+	listMembersByUserCalls   []MemberRepositoryListMembersByUserCall
+	listMembersByUserOnCall  map[int]func(context.Context, string) ([]*models.Member, error)
+	listMembersByUserReturns func(context.Context, string) ([]*models.Member, error)
+
+	// GetMemberByUserAndOrganizationFunc, if set, is called by GetMemberByUserAndOrganization.
+	GetMemberByUserAndOrganizationFunc func(ctx context.Context, userID string, organizationID string) (*models.Member, error)
+
+	getMemberByUserAndOrganizationCalls   []MemberRepositoryGetMemberByUserAndOrganizationCall
+	getMemberByUserAndOrganizationOnCall  map[int]func(context.Context, string, string) (*models.Member, error)
+	getMemberByUserAndOrganizationReturns func(context.Context, string, string) (*models.Member, error)
+}
+
+// MemberRepositoryCreateCall holds the arguments of a call to Create.
+type MemberRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Member
+}
+
+// Create records the call and returns the configured result.
+func (m *MemberRepository) Create(ctx context.Context, entity *models.Member) (r0 *models.Member, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, MemberRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *MemberRepository) CreateReturns(r0 *models.Member, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Member) (*models.Member, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Member) (*models.Member, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Member) (*models.Member, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *MemberRepository) CreateCalls() []MemberRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// MemberRepositoryGetCall holds the arguments of a call to Get.
+type MemberRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *MemberRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Member, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, MemberRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *MemberRepository) GetReturns(r0 *models.Member, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Member, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Member, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Member, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *MemberRepository) GetCalls() []MemberRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// MemberRepositoryUpdateCall holds the arguments of a call to Update.
+type MemberRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Member
+}
+
+// Update records the call and returns the configured result.
+func (m *MemberRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Member) (r0 *models.Member, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, MemberRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *MemberRepository) UpdateReturns(r0 *models.Member, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Member) (*models.Member, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Member) (*models.Member, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Member) (*models.Member, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *MemberRepository) UpdateCalls() []MemberRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// MemberRepositoryDeleteCall holds the arguments of a call to Delete.
+type MemberRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *MemberRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, MemberRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *MemberRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *MemberRepository) DeleteCalls() []MemberRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// MemberRepositoryListCall holds the arguments of a call to List.
+type MemberRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *MemberRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Member, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, MemberRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *MemberRepository) ListReturns(r0 []*models.Member, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Member, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Member, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *MemberRepository) ListCalls() []MemberRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// MemberRepositoryRestoreCall holds the arguments of a call to Restore.
+type MemberRepositoryRestoreCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Restore records the call and returns the configured result.
+func (m *MemberRepository) Restore(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.restoreCalls = append(m.restoreCalls, MemberRepositoryRestoreCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.restoreOnCall[len(m.restoreCalls)-1]
+	if fn == nil {
+		fn = m.RestoreFunc
+	}
+	if fn == nil {
+		fn = m.restoreReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// RestoreReturns makes calls to Restore return the given values.
+func (m *MemberRepository) RestoreReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.restoreReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnRestoreCall makes the call to Restore with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnRestoreCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.restoreOnCall == nil {
+		m.restoreOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.restoreOnCall[i] = fn
+}
+
+// RestoreCalls returns the calls made to Restore, in order.
+func (m *MemberRepository) RestoreCalls() []MemberRepositoryRestoreCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.restoreCalls)
+}
+
+// MemberRepositoryListDeletedCall holds the arguments of a call to ListDeleted.
+type MemberRepositoryListDeletedCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// ListDeleted records the call and returns the configured result.
+func (m *MemberRepository) ListDeleted(ctx context.Context, opts database.ListOptions) (r0 []*models.Member, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listDeletedCalls = append(m.listDeletedCalls, MemberRepositoryListDeletedCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listDeletedOnCall[len(m.listDeletedCalls)-1]
+	if fn == nil {
+		fn = m.ListDeletedFunc
+	}
+	if fn == nil {
+		fn = m.listDeletedReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListDeletedReturns makes calls to ListDeleted return the given values.
+func (m *MemberRepository) ListDeletedReturns(r0 []*models.Member, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listDeletedReturns = func(context.Context, database.ListOptions) ([]*models.Member, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListDeletedCall makes the call to ListDeleted with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnListDeletedCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listDeletedOnCall == nil {
+		m.listDeletedOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Member, database.PageInfo, error))
+	}
+	m.listDeletedOnCall[i] = fn
+}
+
+// ListDeletedCalls returns the calls made to ListDeleted, in order.
+func (m *MemberRepository) ListDeletedCalls() []MemberRepositoryListDeletedCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listDeletedCalls)
+}
+
+// MemberRepositoryPurgeCall holds the arguments of a call to Purge.
+type MemberRepositoryPurgeCall struct {
+	Ctx    context.Context
+	Before time.Time
+}
+
+// Purge records the call and returns the configured result.
+func (m *MemberRepository) Purge(ctx context.Context, before time.Time) (r0 int64, r1 error) {
+	m.mu.Lock()
+	m.purgeCalls = append(m.purgeCalls, MemberRepositoryPurgeCall{
+		Ctx:    ctx,
+		Before: before,
+	})
+	fn := m.purgeOnCall[len(m.purgeCalls)-1]
+	if fn == nil {
+		fn = m.PurgeFunc
+	}
+	if fn == nil {
+		fn = m.purgeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, before)
+}
+
+// PurgeReturns makes calls to Purge return the given values.
+func (m *MemberRepository) PurgeReturns(r0 int64, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purgeReturns = func(context.Context, time.Time) (int64, error) {
+		return r0, r1
+	}
+}
+
+// OnPurgeCall makes the call to Purge with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnPurgeCall(i int, fn func(ctx context.Context, before time.Time) (int64, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.purgeOnCall == nil {
+		m.purgeOnCall = make(map[int]func(context.Context, time.Time) (int64, error))
+	}
+	m.purgeOnCall[i] = fn
+}
+
+// PurgeCalls returns the calls made to Purge, in order.
+func (m *MemberRepository) PurgeCalls() []MemberRepositoryPurgeCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.purgeCalls)
+}
+
+// MemberRepositoryListMembersByOrganizationCall holds the arguments of a call to ListMembersByOrganization.
+type MemberRepositoryListMembersByOrganizationCall struct {
+	Ctx            context.Context
+	OrganizationID string
+}
+
+// ListMembersByOrganization records the call and returns the configured result.
+func (m *MemberRepository) ListMembersByOrganization(ctx context.Context, organizationID string) (r0 []*models.Member, r1 error) {
+	m.mu.Lock()
+	m.listMembersByOrganizationCalls = append(m.listMembersByOrganizationCalls, MemberRepositoryListMembersByOrganizationCall{
+		Ctx:            ctx,
+		OrganizationID: organizationID,
+	})
+	fn := m.listMembersByOrganizationOnCall[len(m.listMembersByOrganizationCalls)-1]
+	if fn == nil {
+		fn = m.ListMembersByOrganizationFunc
+	}
+	if fn == nil {
+		fn = m.listMembersByOrganizationReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, organizationID)
+}
+
+// ListMembersByOrganizationReturns makes calls to ListMembersByOrganization return the given values.
+func (m *MemberRepository) ListMembersByOrganizationReturns(r0 []*models.Member, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listMembersByOrganizationReturns = func(context.Context, string) ([]*models.Member, error) {
+		return r0, r1
+	}
+}
+
+// OnListMembersByOrganizationCall makes the call to ListMembersByOrganization with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnListMembersByOrganizationCall(i int, fn func(ctx context.Context, organizationID string) ([]*models.Member, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listMembersByOrganizationOnCall == nil {
+		m.listMembersByOrganizationOnCall = make(map[int]func(context.Context, string) ([]*models.Member, error))
+	}
+	m.listMembersByOrganizationOnCall[i] = fn
+}
+
+// ListMembersByOrganizationCalls returns the calls made to ListMembersByOrganization, in order.
+func (m *MemberRepository) ListMembersByOrganizationCalls() []MemberRepositoryListMembersByOrganizationCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listMembersByOrganizationCalls)
+}
+
+// MemberRepositoryListMembersByUserCall holds the arguments of a call to ListMembersByUser.
+type MemberRepositoryListMembersByUserCall struct {
+	Ctx    context.Context
+	UserID string
+}
+
+// ListMembersByUser records the call and returns the configured result.
+func (m *MemberRepository) ListMembersByUser(ctx context.Context, userID string) (r0 []*models.Member, r1 error) {
+	m.mu.Lock()
+	m.listMembersByUserCalls = append(m.listMembersByUserCalls, MemberRepositoryListMembersByUserCall{
+		Ctx:    ctx,
+		UserID: userID,
+	})
+	fn := m.listMembersByUserOnCall[len(m.listMembersByUserCalls)-1]
+	if fn == nil {
+		fn = m.ListMembersByUserFunc
+	}
+	if fn == nil {
+		fn = m.listMembersByUserReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, userID)
+}
+
+// ListMembersByUserReturns makes calls to ListMembersByUser return the given values.
+func (m *MemberRepository) ListMembersByUserReturns(r0 []*models.Member, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listMembersByUserReturns = func(context.Context, string) ([]*models.Member, error) {
+		return r0, r1
+	}
+}
+
+// OnListMembersByUserCall makes the call to ListMembersByUser with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnListMembersByUserCall(i int, fn func(ctx context.Context, userID string) ([]*models.Member, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listMembersByUserOnCall == nil {
+		m.listMembersByUserOnCall = make(map[int]func(context.Context, string) ([]*models.Member, error))
+	}
+	m.listMembersByUserOnCall[i] = fn
+}
+
+// ListMembersByUserCalls returns the calls made to ListMembersByUser, in order.
+func (m *MemberRepository) ListMembersByUserCalls() []MemberRepositoryListMembersByUserCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listMembersByUserCalls)
+}
+
+// MemberRepositoryGetMemberByUserAndOrganizationCall holds the arguments of a call to GetMemberByUserAndOrganization.
+type MemberRepositoryGetMemberByUserAndOrganizationCall struct {
+	Ctx            context.Context
+	UserID         string
+	OrganizationID string
+}
+
+// GetMemberByUserAndOrganization records the call and returns the configured result.
+func (m *MemberRepository) GetMemberByUserAndOrganization(ctx context.Context, userID string, organizationID string) (r0 *models.Member, r1 error) {
+	m.mu.Lock()
+	m.getMemberByUserAndOrganizationCalls = append(m.getMemberByUserAndOrganizationCalls, MemberRepositoryGetMemberByUserAndOrganizationCall{
+		Ctx:            ctx,
+		UserID:         userID,
+		OrganizationID: organizationID,
+	})
+	fn := m.getMemberByUserAndOrganizationOnCall[len(m.getMemberByUserAndOrganizationCalls)-1]
+	if fn == nil {
+		fn = m.GetMemberByUserAndOrganizationFunc
+	}
+	if fn == nil {
+		fn = m.getMemberByUserAndOrganizationReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, userID, organizationID)
+}
+
+// GetMemberByUserAndOrganizationReturns makes calls to GetMemberByUserAndOrganization return the given values.
+func (m *MemberRepository) GetMemberByUserAndOrganizationReturns(r0 *models.Member, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getMemberByUserAndOrganizationReturns = func(context.Context, string, string) (*models.Member, error) {
+		return r0, r1
+	}
+}
+
+// OnGetMemberByUserAndOrganizationCall makes the call to GetMemberByUserAndOrganization with index i, counting from
+// zero, return the result of fn.
+func (m *MemberRepository) OnGetMemberByUserAndOrganizationCall(i int, fn func(ctx context.Context, userID string, organizationID string) (*models.Member, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getMemberByUserAndOrganizationOnCall == nil {
+		m.getMemberByUserAndOrganizationOnCall = make(map[int]func(context.Context, string, string) (*models.Member, error))
+	}
+	m.getMemberByUserAndOrganizationOnCall[i] = fn
+}
+
+// GetMemberByUserAndOrganizationCalls returns the calls made to GetMemberByUserAndOrganization, in order.
+func (m *MemberRepository) GetMemberByUserAndOrganizationCalls() []MemberRepositoryGetMemberByUserAndOrganizationCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getMemberByUserAndOrganizationCalls)
+}
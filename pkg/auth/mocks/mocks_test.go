@@ -0,0 +1,109 @@
+package mocks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+	"github.com/archesai/archesai/pkg/auth/models"
+)
+
+func TestUserRepositoryResults(t *testing.T) {
+	ctx := context.Background()
+	errCanned := errors.New("canned")
+	errFunc := errors.New("func")
+	errOnCall := errors.New("on call")
+
+	tests := []struct {
+		name      string
+		configure func(m *UserRepository)
+		want      []error // Error returned by each of the calls
+	}{
+		{
+			name:      "zero values",
+			configure: func(*UserRepository) {},
+			want:      []error{nil, nil},
+		},
+		{
+			name: "returns",
+			configure: func(m *UserRepository) {
+				m.GetReturns(nil, errCanned)
+			},
+			want: []error{errCanned, errCanned},
+		},
+		{
+			name: "func overrides returns",
+			configure: func(m *UserRepository) {
+				m.GetReturns(nil, errCanned)
+				m.GetFunc = func(context.Context, uuid.UUID) (*models.User, error) {
+					return nil, errFunc
+				}
+			},
+			want: []error{errFunc, errFunc},
+		},
+		{
+			name: "on call overrides func for one call",
+			configure: func(m *UserRepository) {
+				m.GetFunc = func(context.Context, uuid.UUID) (*models.User, error) {
+					return nil, errFunc
+				}
+				m.OnGetCall(1, func(context.Context, uuid.UUID) (*models.User, error) {
+					return nil, errOnCall
+				})
+			},
+			want: []error{errFunc, errOnCall, errFunc},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &UserRepository{}
+			tt.configure(m)
+			for i, want := range tt.want {
+				_, err := m.Get(ctx, uuid.New())
+				assert.ErrorIs(t, err, want, "call %d", i)
+				if want == nil {
+					assert.NoError(t, err, "call %d", i)
+				}
+			}
+			assert.Len(t, m.GetCalls(), len(tt.want))
+		})
+	}
+}
+
+func TestUserRepositoryRecordsCalls(t *testing.T) {
+	ctx := context.Background()
+	m := &UserRepository{}
+	user := &models.User{ID: uuid.New()}
+	m.GetUserByEmailReturns(user, nil)
+
+	got, err := m.GetUserByEmail(ctx, "user@example.com")
+	require.NoError(t, err)
+	assert.Same(t, user, got)
+
+	_, _ = m.GetUserByEmail(ctx, "other@example.com")
+	calls := m.GetUserByEmailCalls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "user@example.com", calls[0].Email)
+	assert.Equal(t, "other@example.com", calls[1].Email)
+	assert.Empty(t, m.GetCalls())
+}
+
+func TestHandlerMock(t *testing.T) {
+	var h handlers.GetUser = &GetUser{}
+	m := h.(*GetUser)
+	output := &handlers.GetUserOutput{}
+	m.ExecuteReturns(output, nil)
+
+	input := &handlers.GetUserInput{ID: uuid.New()}
+	got, err := h.Execute(context.Background(), input)
+	require.NoError(t, err)
+	assert.Same(t, output, got)
+	require.Len(t, m.ExecuteCalls(), 1)
+	assert.Same(t, input, m.ExecuteCalls()[0].Input)
+}
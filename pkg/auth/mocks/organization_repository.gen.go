@@ -0,0 +1,647 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/google/uuid"
+)
+
+var _ repositories.OrganizationRepository = (*OrganizationRepository)(nil)
+
+// OrganizationRepository is a mock of repositories.OrganizationRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type OrganizationRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Organization) (*models.Organization, error)
+
+	createCalls   []OrganizationRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Organization) (*models.Organization, error)
+	createReturns func(context.Context, *models.Organization) (*models.Organization, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Organization, error)
+
+	getCalls   []OrganizationRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Organization, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Organization, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Organization) (*models.Organization, error)
+
+	updateCalls   []OrganizationRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Organization) (*models.Organization, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Organization) (*models.Organization, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []OrganizationRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error)
+
+	listCalls   []OrganizationRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Organization, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Organization, database.PageInfo, error)
+
+	// RestoreFunc, if set, is called by Restore.
+	RestoreFunc func(ctx context.Context, id uuid.UUID) error
+
+	restoreCalls   []OrganizationRepositoryRestoreCall
+	restoreOnCall  map[int]func(context.Context, uuid.UUID) error
+	restoreReturns func(context.Context, uuid.UUID) error
+
+	// ListDeletedFunc, if set, is called by ListDeleted.
+	ListDeletedFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error)
+
+	listDeletedCalls   []OrganizationRepositoryListDeletedCall
+	listDeletedOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Organization, database.PageInfo, error)
+	listDeletedReturns func(context.Context, database.ListOptions) ([]*models.Organization, database.PageInfo, error)
+
+	// PurgeFunc, if set, is called by Purge.
+	PurgeFunc func(ctx context.Context, before time.Time) (int64, error)
+
+	purgeCalls   []OrganizationRepositoryPurgeCall
+	purgeOnCall  map[int]func(context.Context, time.Time) (int64, error)
+	purgeReturns func(context.Context, time.Time) (int64, error)
+
+	// GetOrganizationBySlugFunc, if set, is called by GetOrganizationBySlug.
+	GetOrganizationBySlugFunc func(ctx context.Context, slug string) (*models.Organization, error)
+
+	getOrganizationBySlugCalls   []OrganizationRepositoryGetOrganizationBySlugCall
+	getOrganizationBySlugOnCall  map[int]func(context.Context, string) (*models.Organization, error)
+	getOrganizationBySlugReturns func(context.Context, string) (*models.Organization, error)
+
+	// GetOrganizationByStripeCustomerIDFunc, if set, is called by GetOrganizationByStripeCustomerID.
+	GetOrganizationByStripeCustomerIDFunc func(ctx context.Context, stripeCustomerIdentifier string) (*models.Organization, error)
+
+	getOrganizationByStripeCustomerIDCalls   []OrganizationRepositoryGetOrganizationByStripeCustomerIDCall
+	getOrganizationByStripeCustomerIDOnCall  map[int]func(context.Context, string) (*models.Organization, error)
+	getOrganizationByStripeCustomerIDReturns func(context.Context, string) (*models.Organization, error)
+}
+
+// OrganizationRepositoryCreateCall holds the arguments of a call to Create.
+type OrganizationRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Organization
+}
+
+// Create records the call and returns the configured result.
+func (m *OrganizationRepository) Create(ctx context.Context, entity *models.Organization) (r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, OrganizationRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *OrganizationRepository) CreateReturns(r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Organization) (*models.Organization, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Organization) (*models.Organization, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Organization) (*models.Organization, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *OrganizationRepository) CreateCalls() []OrganizationRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// OrganizationRepositoryGetCall holds the arguments of a call to Get.
+type OrganizationRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *OrganizationRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, OrganizationRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *OrganizationRepository) GetReturns(r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Organization, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Organization, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Organization, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *OrganizationRepository) GetCalls() []OrganizationRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// OrganizationRepositoryUpdateCall holds the arguments of a call to Update.
+type OrganizationRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Organization
+}
+
+// Update records the call and returns the configured result.
+func (m *OrganizationRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Organization) (r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, OrganizationRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *OrganizationRepository) UpdateReturns(r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Organization) (*models.Organization, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Organization) (*models.Organization, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Organization) (*models.Organization, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *OrganizationRepository) UpdateCalls() []OrganizationRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// OrganizationRepositoryDeleteCall holds the arguments of a call to Delete.
+type OrganizationRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *OrganizationRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, OrganizationRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *OrganizationRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *OrganizationRepository) DeleteCalls() []OrganizationRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// OrganizationRepositoryListCall holds the arguments of a call to List.
+type OrganizationRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *OrganizationRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Organization, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, OrganizationRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *OrganizationRepository) ListReturns(r0 []*models.Organization, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Organization, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Organization, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *OrganizationRepository) ListCalls() []OrganizationRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// OrganizationRepositoryRestoreCall holds the arguments of a call to Restore.
+type OrganizationRepositoryRestoreCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Restore records the call and returns the configured result.
+func (m *OrganizationRepository) Restore(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.restoreCalls = append(m.restoreCalls, OrganizationRepositoryRestoreCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.restoreOnCall[len(m.restoreCalls)-1]
+	if fn == nil {
+		fn = m.RestoreFunc
+	}
+	if fn == nil {
+		fn = m.restoreReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// RestoreReturns makes calls to Restore return the given values.
+func (m *OrganizationRepository) RestoreReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.restoreReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnRestoreCall makes the call to Restore with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnRestoreCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.restoreOnCall == nil {
+		m.restoreOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.restoreOnCall[i] = fn
+}
+
+// RestoreCalls returns the calls made to Restore, in order.
+func (m *OrganizationRepository) RestoreCalls() []OrganizationRepositoryRestoreCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.restoreCalls)
+}
+
+// OrganizationRepositoryListDeletedCall holds the arguments of a call to ListDeleted.
+type OrganizationRepositoryListDeletedCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// ListDeleted records the call and returns the configured result.
+func (m *OrganizationRepository) ListDeleted(ctx context.Context, opts database.ListOptions) (r0 []*models.Organization, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listDeletedCalls = append(m.listDeletedCalls, OrganizationRepositoryListDeletedCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listDeletedOnCall[len(m.listDeletedCalls)-1]
+	if fn == nil {
+		fn = m.ListDeletedFunc
+	}
+	if fn == nil {
+		fn = m.listDeletedReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListDeletedReturns makes calls to ListDeleted return the given values.
+func (m *OrganizationRepository) ListDeletedReturns(r0 []*models.Organization, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listDeletedReturns = func(context.Context, database.ListOptions) ([]*models.Organization, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListDeletedCall makes the call to ListDeleted with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnListDeletedCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listDeletedOnCall == nil {
+		m.listDeletedOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Organization, database.PageInfo, error))
+	}
+	m.listDeletedOnCall[i] = fn
+}
+
+// ListDeletedCalls returns the calls made to ListDeleted, in order.
+func (m *OrganizationRepository) ListDeletedCalls() []OrganizationRepositoryListDeletedCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listDeletedCalls)
+}
+
+// OrganizationRepositoryPurgeCall holds the arguments of a call to Purge.
+type OrganizationRepositoryPurgeCall struct {
+	Ctx    context.Context
+	Before time.Time
+}
+
+// Purge records the call and returns the configured result.
+func (m *OrganizationRepository) Purge(ctx context.Context, before time.Time) (r0 int64, r1 error) {
+	m.mu.Lock()
+	m.purgeCalls = append(m.purgeCalls, OrganizationRepositoryPurgeCall{
+		Ctx:    ctx,
+		Before: before,
+	})
+	fn := m.purgeOnCall[len(m.purgeCalls)-1]
+	if fn == nil {
+		fn = m.PurgeFunc
+	}
+	if fn == nil {
+		fn = m.purgeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, before)
+}
+
+// PurgeReturns makes calls to Purge return the given values.
+func (m *OrganizationRepository) PurgeReturns(r0 int64, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purgeReturns = func(context.Context, time.Time) (int64, error) {
+		return r0, r1
+	}
+}
+
+// OnPurgeCall makes the call to Purge with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnPurgeCall(i int, fn func(ctx context.Context, before time.Time) (int64, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.purgeOnCall == nil {
+		m.purgeOnCall = make(map[int]func(context.Context, time.Time) (int64, error))
+	}
+	m.purgeOnCall[i] = fn
+}
+
+// PurgeCalls returns the calls made to Purge, in order.
+func (m *OrganizationRepository) PurgeCalls() []OrganizationRepositoryPurgeCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.purgeCalls)
+}
+
+// OrganizationRepositoryGetOrganizationBySlugCall holds the arguments of a call to GetOrganizationBySlug.
+type OrganizationRepositoryGetOrganizationBySlugCall struct {
+	Ctx  context.Context
+	Slug string
+}
+
+// GetOrganizationBySlug records the call and returns the configured result.
+func (m *OrganizationRepository) GetOrganizationBySlug(ctx context.Context, slug string) (r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	m.getOrganizationBySlugCalls = append(m.getOrganizationBySlugCalls, OrganizationRepositoryGetOrganizationBySlugCall{
+		Ctx:  ctx,
+		Slug: slug,
+	})
+	fn := m.getOrganizationBySlugOnCall[len(m.getOrganizationBySlugCalls)-1]
+	if fn == nil {
+		fn = m.GetOrganizationBySlugFunc
+	}
+	if fn == nil {
+		fn = m.getOrganizationBySlugReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, slug)
+}
+
+// GetOrganizationBySlugReturns makes calls to GetOrganizationBySlug return the given values.
+func (m *OrganizationRepository) GetOrganizationBySlugReturns(r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getOrganizationBySlugReturns = func(context.Context, string) (*models.Organization, error) {
+		return r0, r1
+	}
+}
+
+// OnGetOrganizationBySlugCall makes the call to GetOrganizationBySlug with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnGetOrganizationBySlugCall(i int, fn func(ctx context.Context, slug string) (*models.Organization, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOrganizationBySlugOnCall == nil {
+		m.getOrganizationBySlugOnCall = make(map[int]func(context.Context, string) (*models.Organization, error))
+	}
+	m.getOrganizationBySlugOnCall[i] = fn
+}
+
+// GetOrganizationBySlugCalls returns the calls made to GetOrganizationBySlug, in order.
+func (m *OrganizationRepository) GetOrganizationBySlugCalls() []OrganizationRepositoryGetOrganizationBySlugCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getOrganizationBySlugCalls)
+}
+
+// OrganizationRepositoryGetOrganizationByStripeCustomerIDCall holds the arguments of a call to GetOrganizationByStripeCustomerID.
+type OrganizationRepositoryGetOrganizationByStripeCustomerIDCall struct {
+	Ctx                      context.Context
+	StripeCustomerIdentifier string
+}
+
+// GetOrganizationByStripeCustomerID records the call and returns the configured result.
+func (m *OrganizationRepository) GetOrganizationByStripeCustomerID(ctx context.Context, stripeCustomerIdentifier string) (r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	m.getOrganizationByStripeCustomerIDCalls = append(m.getOrganizationByStripeCustomerIDCalls, OrganizationRepositoryGetOrganizationByStripeCustomerIDCall{
+		Ctx:                      ctx,
+		StripeCustomerIdentifier: stripeCustomerIdentifier,
+	})
+	fn := m.getOrganizationByStripeCustomerIDOnCall[len(m.getOrganizationByStripeCustomerIDCalls)-1]
+	if fn == nil {
+		fn = m.GetOrganizationByStripeCustomerIDFunc
+	}
+	if fn == nil {
+		fn = m.getOrganizationByStripeCustomerIDReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, stripeCustomerIdentifier)
+}
+
+// GetOrganizationByStripeCustomerIDReturns makes calls to GetOrganizationByStripeCustomerID return the given values.
+func (m *OrganizationRepository) GetOrganizationByStripeCustomerIDReturns(r0 *models.Organization, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getOrganizationByStripeCustomerIDReturns = func(context.Context, string) (*models.Organization, error) {
+		return r0, r1
+	}
+}
+
+// OnGetOrganizationByStripeCustomerIDCall makes the call to GetOrganizationByStripeCustomerID with index i, counting from
+// zero, return the result of fn.
+func (m *OrganizationRepository) OnGetOrganizationByStripeCustomerIDCall(i int, fn func(ctx context.Context, stripeCustomerIdentifier string) (*models.Organization, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOrganizationByStripeCustomerIDOnCall == nil {
+		m.getOrganizationByStripeCustomerIDOnCall = make(map[int]func(context.Context, string) (*models.Organization, error))
+	}
+	m.getOrganizationByStripeCustomerIDOnCall[i] = fn
+}
+
+// GetOrganizationByStripeCustomerIDCalls returns the calls made to GetOrganizationByStripeCustomerID, in order.
+func (m *OrganizationRepository) GetOrganizationByStripeCustomerIDCalls() []OrganizationRepositoryGetOrganizationByStripeCustomerIDCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getOrganizationByStripeCustomerIDCalls)
+}
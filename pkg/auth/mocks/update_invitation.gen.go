@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+)
+
+var _ handlers.UpdateInvitation = (*UpdateInvitation)(nil)
+
+// UpdateInvitation is a mock of handlers.UpdateInvitation that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type UpdateInvitation struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.UpdateInvitationInput) (*handlers.UpdateInvitationOutput, error)
+
+	executeCalls   []UpdateInvitationExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.UpdateInvitationInput) (*handlers.UpdateInvitationOutput, error)
+	executeReturns func(context.Context, *handlers.UpdateInvitationInput) (*handlers.UpdateInvitationOutput, error)
+}
+
+// UpdateInvitationExecuteCall holds the arguments of a call to Execute.
+type UpdateInvitationExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.UpdateInvitationInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *UpdateInvitation) Execute(ctx context.Context, input *handlers.UpdateInvitationInput) (r0 *handlers.UpdateInvitationOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, UpdateInvitationExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *UpdateInvitation) ExecuteReturns(r0 *handlers.UpdateInvitationOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.UpdateInvitationInput) (*handlers.UpdateInvitationOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *UpdateInvitation) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.UpdateInvitationInput) (*handlers.UpdateInvitationOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.UpdateInvitationInput) (*handlers.UpdateInvitationOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *UpdateInvitation) ExecuteCalls() []UpdateInvitationExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,460 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/auth/repositories"
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/google/uuid"
+)
+
+var _ repositories.UserRepository = (*UserRepository)(nil)
+
+// UserRepository is a mock of repositories.UserRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type UserRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.User) (*models.User, error)
+
+	createCalls   []UserRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.User) (*models.User, error)
+	createReturns func(context.Context, *models.User) (*models.User, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.User, error)
+
+	getCalls   []UserRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.User, error)
+	getReturns func(context.Context, uuid.UUID) (*models.User, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.User) (*models.User, error)
+
+	updateCalls   []UserRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.User) (*models.User, error)
+	updateReturns func(context.Context, uuid.UUID, *models.User) (*models.User, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []UserRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.User, database.PageInfo, error)
+
+	listCalls   []UserRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.User, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.User, database.PageInfo, error)
+
+	// GetUserByEmailFunc, if set, is called by GetUserByEmail.
+	GetUserByEmailFunc func(ctx context.Context, email string) (*models.User, error)
+
+	getUserByEmailCalls   []UserRepositoryGetUserByEmailCall
+	getUserByEmailOnCall  map[int]func(context.Context, string) (*models.User, error)
+	getUserByEmailReturns func(context.Context, string) (*models.User, error)
+
+	// GetUserBySessionIDFunc, if set, is called by GetUserBySessionID.
+	GetUserBySessionIDFunc func(ctx context.Context, sessionID string) (*models.User, error)
+
+	getUserBySessionIDCalls   []UserRepositoryGetUserBySessionIDCall
+	getUserBySessionIDOnCall  map[int]func(context.Context, string) (*models.User, error)
+	getUserBySessionIDReturns func(context.Context, string) (*models.User, error)
+}
+
+// UserRepositoryCreateCall holds the arguments of a call to Create.
+type UserRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.User
+}
+
+// Create records the call and returns the configured result.
+func (m *UserRepository) Create(ctx context.Context, entity *models.User) (r0 *models.User, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, UserRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *UserRepository) CreateReturns(r0 *models.User, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.User) (*models.User, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *UserRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.User) (*models.User, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.User) (*models.User, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *UserRepository) CreateCalls() []UserRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// UserRepositoryGetCall holds the arguments of a call to Get.
+type UserRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *UserRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.User, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, UserRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *UserRepository) GetReturns(r0 *models.User, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.User, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *UserRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.User, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.User, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *UserRepository) GetCalls() []UserRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// UserRepositoryUpdateCall holds the arguments of a call to Update.
+type UserRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.User
+}
+
+// Update records the call and returns the configured result.
+func (m *UserRepository) Update(ctx context.Context, id uuid.UUID, entity *models.User) (r0 *models.User, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, UserRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *UserRepository) UpdateReturns(r0 *models.User, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.User) (*models.User, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *UserRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.User) (*models.User, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.User) (*models.User, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *UserRepository) UpdateCalls() []UserRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// UserRepositoryDeleteCall holds the arguments of a call to Delete.
+type UserRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *UserRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, UserRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *UserRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *UserRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *UserRepository) DeleteCalls() []UserRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// UserRepositoryListCall holds the arguments of a call to List.
+type UserRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *UserRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.User, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, UserRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *UserRepository) ListReturns(r0 []*models.User, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.User, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *UserRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.User, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.User, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *UserRepository) ListCalls() []UserRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// UserRepositoryGetUserByEmailCall holds the arguments of a call to GetUserByEmail.
+type UserRepositoryGetUserByEmailCall struct {
+	Ctx   context.Context
+	Email string
+}
+
+// GetUserByEmail records the call and returns the configured result.
+func (m *UserRepository) GetUserByEmail(ctx context.Context, email string) (r0 *models.User, r1 error) {
+	m.mu.Lock()
+	m.getUserByEmailCalls = append(m.getUserByEmailCalls, UserRepositoryGetUserByEmailCall{
+		Ctx:   ctx,
+		Email: email,
+	})
+	fn := m.getUserByEmailOnCall[len(m.getUserByEmailCalls)-1]
+	if fn == nil {
+		fn = m.GetUserByEmailFunc
+	}
+	if fn == nil {
+		fn = m.getUserByEmailReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, email)
+}
+
+// GetUserByEmailReturns makes calls to GetUserByEmail return the given values.
+func (m *UserRepository) GetUserByEmailReturns(r0 *models.User, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getUserByEmailReturns = func(context.Context, string) (*models.User, error) {
+		return r0, r1
+	}
+}
+
+// OnGetUserByEmailCall makes the call to GetUserByEmail with index i, counting from
+// zero, return the result of fn.
+func (m *UserRepository) OnGetUserByEmailCall(i int, fn func(ctx context.Context, email string) (*models.User, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getUserByEmailOnCall == nil {
+		m.getUserByEmailOnCall = make(map[int]func(context.Context, string) (*models.User, error))
+	}
+	m.getUserByEmailOnCall[i] = fn
+}
+
+// GetUserByEmailCalls returns the calls made to GetUserByEmail, in order.
+func (m *UserRepository) GetUserByEmailCalls() []UserRepositoryGetUserByEmailCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getUserByEmailCalls)
+}
+
+// UserRepositoryGetUserBySessionIDCall holds the arguments of a call to GetUserBySessionID.
+type UserRepositoryGetUserBySessionIDCall struct {
+	Ctx       context.Context
+	SessionID string
+}
+
+// GetUserBySessionID records the call and returns the configured result.
+func (m *UserRepository) GetUserBySessionID(ctx context.Context, sessionID string) (r0 *models.User, r1 error) {
+	m.mu.Lock()
+	m.getUserBySessionIDCalls = append(m.getUserBySessionIDCalls, UserRepositoryGetUserBySessionIDCall{
+		Ctx:       ctx,
+		SessionID: sessionID,
+	})
+	fn := m.getUserBySessionIDOnCall[len(m.getUserBySessionIDCalls)-1]
+	if fn == nil {
+		fn = m.GetUserBySessionIDFunc
+	}
+	if fn == nil {
+		fn = m.getUserBySessionIDReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, sessionID)
+}
+
+// GetUserBySessionIDReturns makes calls to GetUserBySessionID return the given values.
+func (m *UserRepository) GetUserBySessionIDReturns(r0 *models.User, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getUserBySessionIDReturns = func(context.Context, string) (*models.User, error) {
+		return r0, r1
+	}
+}
+
+// OnGetUserBySessionIDCall makes the call to GetUserBySessionID with index i, counting from
+// zero, return the result of fn.
+func (m *UserRepository) OnGetUserBySessionIDCall(i int, fn func(ctx context.Context, sessionID string) (*models.User, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getUserBySessionIDOnCall == nil {
+		m.getUserBySessionIDOnCall = make(map[int]func(context.Context, string) (*models.User, error))
+	}
+	m.getUserBySessionIDOnCall[i] = fn
+}
+
+// GetUserBySessionIDCalls returns the calls made to GetUserBySessionID, in order.
+func (m *UserRepository) GetUserBySessionIDCalls() []UserRepositoryGetUserBySessionIDCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getUserBySessionIDCalls)
+}
@@ -71,6 +71,9 @@ type Member struct {
 	// Role The role of the member
 	Role MemberRole `json:"role" yaml:"role"`
 
+	// RoleID A custom role granting permissions beyond the built-in role
+	RoleID *uuid.UUID `json:"roleID" yaml:"roleID"`
+
 	// UserID The user who is a member of the organization
 	UserID uuid.UUID `json:"userID" yaml:"userID"`
 
@@ -82,6 +85,7 @@ type Member struct {
 func NewMember(
 	organizationID uuid.UUID,
 	role MemberRole,
+	roleID *uuid.UUID,
 	userID uuid.UUID,
 ) (*Member, error) {
 	// Validate required fields
@@ -102,6 +106,7 @@ func NewMember(
 		UpdatedAt:      now,
 		OrganizationID: organizationID,
 		Role:           role,
+		RoleID:         roleID,
 		UserID:         userID,
 		events:         []events.Event{},
 	}
@@ -141,6 +146,11 @@ func (e *Member) GetRole() MemberRole {
 	return e.Role
 }
 
+// GetRoleID returns the RoleID
+func (e *Member) GetRoleID() *uuid.UUID {
+	return e.RoleID
+}
+
 // GetUserID returns the UserID
 func (e *Member) GetUserID() uuid.UUID {
 	return e.UserID
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/google/uuid"
 )
 
@@ -16,7 +17,7 @@ type AccountRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Account, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Account) (*models.Account, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Account, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Account, database.PageInfo, error)
 
 	// GetAccountByProvider retrieves a single account by provider and accountIdentifier
 	GetAccountByProvider(ctx context.Context, provider string, accountIdentifier string) (*models.Account, error)
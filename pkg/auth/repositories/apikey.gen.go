@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/google/uuid"
 )
 
@@ -16,5 +17,5 @@ type APIKeyRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.APIKey, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.APIKey) (*models.APIKey, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.APIKey, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.APIKey, database.PageInfo, error)
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/google/uuid"
 )
 
@@ -16,7 +17,7 @@ type InvitationRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Invitation, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Invitation) (*models.Invitation, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Invitation, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Invitation, database.PageInfo, error)
 
 	// ListInvitationsByOrganization retrieves multiple invitations by organizationID
 	ListInvitationsByOrganization(ctx context.Context, organizationID string) ([]*models.Invitation, error)
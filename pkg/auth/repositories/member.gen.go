@@ -4,8 +4,10 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/google/uuid"
 )
 
@@ -16,7 +18,12 @@ type MemberRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Member, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Member) (*models.Member, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Member, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error)
+
+	// Soft delete operations
+	Restore(ctx context.Context, id uuid.UUID) error
+	ListDeleted(ctx context.Context, opts database.ListOptions) ([]*models.Member, database.PageInfo, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
 
 	// ListMembersByOrganization retrieves multiple members by organizationID
 	ListMembersByOrganization(ctx context.Context, organizationID string) ([]*models.Member, error)
@@ -4,8 +4,10 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/google/uuid"
 )
 
@@ -16,7 +18,12 @@ type OrganizationRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Organization, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Organization) (*models.Organization, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Organization, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error)
+
+	// Soft delete operations
+	Restore(ctx context.Context, id uuid.UUID) error
+	ListDeleted(ctx context.Context, opts database.ListOptions) ([]*models.Organization, database.PageInfo, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
 
 	// GetOrganizationBySlug retrieves a single organization by slug
 	GetOrganizationBySlug(ctx context.Context, slug string) (*models.Organization, error)
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/google/uuid"
 )
 
@@ -16,7 +17,7 @@ type UserRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.User, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.User) (*models.User, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.User, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.User, database.PageInfo, error)
 
 	// GetUserByEmail retrieves a single user by email
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
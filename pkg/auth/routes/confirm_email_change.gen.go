@@ -4,7 +4,9 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -136,8 +138,18 @@ func (h *ConfirmEmailChangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	input.SessionID = sessionID
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := ConfirmEmailChange400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitConfirmEmailChangeResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &ConfirmEmailChangeRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := ConfirmEmailChange400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -146,12 +158,37 @@ func (h *ConfirmEmailChangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "newEmail", "token", "userID")
+	v.MinLength("/newEmail", body.NewEmail, 1)
+	v.MaxLength("/newEmail", body.NewEmail, 255)
+	v.Format("/newEmail", body.NewEmail, "email")
+	v.MinLength("/token", body.Token, 1)
+	v.MaxLength("/token", body.Token, 512)
+	v.Pattern("/token", body.Token, `^[A-Za-z0-9_-]+$`)
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.NewEmail = body.NewEmail
 	input.Token = body.Token
 	input.UserID = body.UserID
 
 	// Execute
 	if err := h.confirmEmailChange.Execute(ctx, input); err != nil {
+		var invalid *server.ValidationError
+		if errors.As(err, &invalid) {
+			problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := ConfirmEmailChange500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
@@ -4,7 +4,9 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -138,8 +140,18 @@ func (h *ConfirmEmailVerificationHandler) ServeHTTP(w http.ResponseWriter, r *ht
 	input.SessionID = sessionID
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := ConfirmEmailVerification400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitConfirmEmailVerificationResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &ConfirmEmailVerificationRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := ConfirmEmailVerification400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -148,10 +160,32 @@ func (h *ConfirmEmailVerificationHandler) ServeHTTP(w http.ResponseWriter, r *ht
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "token")
+	v.MinLength("/token", body.Token, 1)
+	v.MaxLength("/token", body.Token, 512)
+	v.Pattern("/token", body.Token, `^[A-Za-z0-9_-]+$`)
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Token = body.Token
 
 	// Execute
 	result, err := h.confirmEmailVerification.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := ConfirmEmailVerification500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
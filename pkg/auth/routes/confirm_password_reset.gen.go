@@ -4,7 +4,9 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/archesai/archesai/pkg/auth/handlers"
@@ -120,8 +122,18 @@ func (h *ConfirmPasswordResetHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	input := &handlers.ConfirmPasswordResetInput{}
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := ConfirmPasswordReset400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitConfirmPasswordResetResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &ConfirmPasswordResetRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := ConfirmPasswordReset400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -130,11 +142,36 @@ func (h *ConfirmPasswordResetHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "newPassword", "token")
+	v.MinLength("/newPassword", body.NewPassword, 8)
+	v.MaxLength("/newPassword", body.NewPassword, 72)
+	v.Pattern("/newPassword", body.NewPassword, `^[\w\s\-.,!?()@#+/]*$`)
+	v.MinLength("/token", body.Token, 1)
+	v.MaxLength("/token", body.Token, 512)
+	v.Pattern("/token", body.Token, `^[A-Za-z0-9_-]+$`)
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.NewPassword = body.NewPassword
 	input.Token = body.Token
 
 	// Execute
 	if err := h.confirmPasswordReset.Execute(ctx, input); err != nil {
+		var invalid *server.ValidationError
+		if errors.As(err, &invalid) {
+			problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := ConfirmPasswordReset500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
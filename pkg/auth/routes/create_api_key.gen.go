@@ -4,14 +4,19 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -22,11 +27,15 @@ import (
 // CreateAPIKeyHandler is the HTTP handler for CreateAPIKey.
 type CreateAPIKeyHandler struct {
 	createAPIKey handlers.CreateAPIKey
+	authorizer   auth.Authorizer
 }
 
 // NewCreateAPIKeyHandler creates a new HTTP handler.
-func NewCreateAPIKeyHandler(createAPIKey handlers.CreateAPIKey) *CreateAPIKeyHandler {
-	return &CreateAPIKeyHandler{createAPIKey: createAPIKey}
+func NewCreateAPIKeyHandler(createAPIKey handlers.CreateAPIKey, authorizer auth.Authorizer) *CreateAPIKeyHandler {
+	return &CreateAPIKeyHandler{
+		createAPIKey: createAPIKey,
+		authorizer:   authorizer,
+	}
 }
 
 // RegisterCreateAPIKeyRoute registers the HTTP route for CreateAPIKey.
@@ -38,11 +47,10 @@ func RegisterCreateAPIKeyRoute(mux *http.ServeMux, handler *CreateAPIKeyHandler)
 
 // CreateAPIKeyRequestBody defines the request body for CreateAPIKey
 type CreateAPIKeyRequestBody struct {
-	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
-	Name           *string    `json:"name,omitempty"`
-	OrganizationID uuid.UUID  `json:"organizationID"`
-	RateLimit      *int32     `json:"rateLimit,omitempty"`
-	Scopes         []string   `json:"scopes,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+	RateLimit *int32     `json:"rateLimit,omitempty"`
+	Scopes    []string   `json:"scopes,omitempty"`
 }
 
 // Response types
@@ -53,6 +61,7 @@ type CreateAPIKeyResponse interface {
 
 type CreateAPIKey201Response struct {
 	Data models.APIKey `json:"data"`
+	Key  string        `json:"key"`
 }
 
 func (response CreateAPIKey201Response) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
@@ -81,6 +90,16 @@ func (response CreateAPIKey401Response) VisitCreateAPIKeyResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type CreateAPIKey403Response struct {
+	server.ProblemDetails
+}
+
+func (response CreateAPIKey403Response) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type CreateAPIKey422Response struct {
 	server.ProblemDetails
 }
@@ -115,25 +134,50 @@ func (response CreateAPIKey500Response) VisitCreateAPIKeyResponse(w http.Respons
 func (h *CreateAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := CreateAPIKey401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitCreateAPIKeyResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.CreateAPIKeyInput{}
 	input.SessionID = sessionID
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "apikeys:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := CreateAPIKey400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitCreateAPIKeyResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &CreateAPIKeyRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := CreateAPIKey400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -142,14 +186,59 @@ func (h *CreateAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Name != nil {
+		v.MinLength("/name", *body.Name, 1)
+		v.MaxLength("/name", *body.Name, 255)
+		v.Pattern("/name", *body.Name, `^[\w\s\-.,!?()@#+/']+$`)
+	}
+	if body.RateLimit != nil {
+		v.Minimum("/rateLimit", float64(*body.RateLimit), 1, false)
+		v.Maximum("/rateLimit", float64(*body.RateLimit), 2.147483647e+09, false)
+	}
+	v.MaxItems("/scopes", len(body.Scopes), 1000)
+	for i, item := range body.Scopes {
+		v.MaxLength("/scopes"+"/"+strconv.Itoa(i), item, 255)
+		v.Pattern("/scopes"+"/"+strconv.Itoa(i), item, `^[a-z]+:[a-z]+$`)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.ExpiresAt = body.ExpiresAt
 	input.Name = body.Name
-	input.OrganizationID = body.OrganizationID
 	input.RateLimit = body.RateLimit
 	input.Scopes = body.Scopes
 
 	// Execute
 	result, err := h.createAPIKey.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, auth.ErrForbidden) {
+		problem := server.NewForbiddenResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := CreateAPIKey500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -163,6 +252,7 @@ func (h *CreateAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	// Map output to response
 	response := CreateAPIKey201Response{}
 	response.Data = result.Data
+	response.Key = result.Key
 
 	if err := response.VisitCreateAPIKeyResponse(w); err != nil {
 		fmt.Fprintf(w, "error writing response: %v", err)
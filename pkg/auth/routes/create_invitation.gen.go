@@ -5,11 +5,13 @@ package routes
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +24,15 @@ import (
 // CreateInvitationHandler is the HTTP handler for CreateInvitation.
 type CreateInvitationHandler struct {
 	createInvitation handlers.CreateInvitation
+	authorizer       auth.Authorizer
 }
 
 // NewCreateInvitationHandler creates a new HTTP handler.
-func NewCreateInvitationHandler(createInvitation handlers.CreateInvitation) *CreateInvitationHandler {
-	return &CreateInvitationHandler{createInvitation: createInvitation}
+func NewCreateInvitationHandler(createInvitation handlers.CreateInvitation, authorizer auth.Authorizer) *CreateInvitationHandler {
+	return &CreateInvitationHandler{
+		createInvitation: createInvitation,
+		authorizer:       authorizer,
+	}
 }
 
 // RegisterCreateInvitationRoute registers the HTTP route for CreateInvitation.
@@ -78,6 +84,16 @@ func (response CreateInvitation401Response) VisitCreateInvitationResponse(w http
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type CreateInvitation403Response struct {
+	server.ProblemDetails
+}
+
+func (response CreateInvitation403Response) VisitCreateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type CreateInvitation422Response struct {
 	server.ProblemDetails
 }
@@ -112,17 +128,18 @@ func (response CreateInvitation500Response) VisitCreateInvitationResponse(w http
 func (h *CreateInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := CreateInvitation401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitCreateInvitationResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.CreateInvitationInput{}
@@ -141,9 +158,35 @@ func (h *CreateInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 	input.OrganizationID = organizationID
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission:     "members:write",
+		MinRole:        auth.MemberRoleAdmin,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := CreateInvitation400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitCreateInvitationResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &CreateInvitationRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := CreateInvitation400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -152,6 +195,21 @@ func (h *CreateInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "email", "role")
+	v.MinLength("/email", body.Email, 1)
+	v.MaxLength("/email", body.Email, 255)
+	v.Pattern("/email", body.Email, `^[\w\s\-.,!?()@#+/]*$`)
+	v.Enum("/role", body.Role, "admin", "owner", "member")
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Email = body.Email
 	input.Role = body.Role
 
@@ -5,11 +5,13 @@ package routes
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +24,15 @@ import (
 // CreateMemberHandler is the HTTP handler for CreateMember.
 type CreateMemberHandler struct {
 	createMember handlers.CreateMember
+	authorizer   auth.Authorizer
 }
 
 // NewCreateMemberHandler creates a new HTTP handler.
-func NewCreateMemberHandler(createMember handlers.CreateMember) *CreateMemberHandler {
-	return &CreateMemberHandler{createMember: createMember}
+func NewCreateMemberHandler(createMember handlers.CreateMember, authorizer auth.Authorizer) *CreateMemberHandler {
+	return &CreateMemberHandler{
+		createMember: createMember,
+		authorizer:   authorizer,
+	}
 }
 
 // RegisterCreateMemberRoute registers the HTTP route for CreateMember.
@@ -77,6 +83,16 @@ func (response CreateMember401Response) VisitCreateMemberResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type CreateMember403Response struct {
+	server.ProblemDetails
+}
+
+func (response CreateMember403Response) VisitCreateMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type CreateMember422Response struct {
 	server.ProblemDetails
 }
@@ -111,17 +127,18 @@ func (response CreateMember500Response) VisitCreateMemberResponse(w http.Respons
 func (h *CreateMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := CreateMember401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitCreateMemberResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.CreateMemberInput{}
@@ -140,9 +157,35 @@ func (h *CreateMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 	input.OrganizationID = organizationID
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission:     "members:write",
+		MinRole:        auth.MemberRoleAdmin,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := CreateMember400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitCreateMemberResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &CreateMemberRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := CreateMember400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -151,6 +194,18 @@ func (h *CreateMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "role")
+	v.Enum("/role", body.Role, "admin", "owner", "member")
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Role = body.Role
 
 	// Execute
@@ -5,6 +5,7 @@ package routes
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -111,25 +112,36 @@ func (response CreateOrganization500Response) VisitCreateOrganizationResponse(w
 func (h *CreateOrganizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := CreateOrganization401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitCreateOrganizationResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.CreateOrganizationInput{}
 	input.SessionID = sessionID
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := CreateOrganization400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitCreateOrganizationResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &CreateOrganizationRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := CreateOrganization400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -138,6 +150,20 @@ func (h *CreateOrganizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "billingEmail", "organizationID")
+	v.MinLength("/billingEmail", body.BillingEmail, 1)
+	v.MaxLength("/billingEmail", body.BillingEmail, 255)
+	v.Format("/billingEmail", body.BillingEmail, "email")
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.BillingEmail = body.BillingEmail
 	input.OrganizationID = body.OrganizationID
 
@@ -4,6 +4,7 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -144,6 +145,14 @@ func (h *DeleteAccountHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	// Execute
 	if err := h.deleteAccount.Execute(ctx, input); err != nil {
+		var invalid *server.ValidationError
+		if errors.As(err, &invalid) {
+			problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := DeleteAccount500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
@@ -4,13 +4,17 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
+	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -21,11 +25,15 @@ import (
 // DeleteAPIKeyHandler is the HTTP handler for DeleteAPIKey.
 type DeleteAPIKeyHandler struct {
 	deleteAPIKey handlers.DeleteAPIKey
+	authorizer   auth.Authorizer
 }
 
 // NewDeleteAPIKeyHandler creates a new HTTP handler.
-func NewDeleteAPIKeyHandler(deleteAPIKey handlers.DeleteAPIKey) *DeleteAPIKeyHandler {
-	return &DeleteAPIKeyHandler{deleteAPIKey: deleteAPIKey}
+func NewDeleteAPIKeyHandler(deleteAPIKey handlers.DeleteAPIKey, authorizer auth.Authorizer) *DeleteAPIKeyHandler {
+	return &DeleteAPIKeyHandler{
+		deleteAPIKey: deleteAPIKey,
+		authorizer:   authorizer,
+	}
 }
 
 // RegisterDeleteAPIKeyRoute registers the HTTP route for DeleteAPIKey.
@@ -69,6 +77,16 @@ func (response DeleteAPIKey401Response) VisitDeleteAPIKeyResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type DeleteAPIKey403Response struct {
+	server.ProblemDetails
+}
+
+func (response DeleteAPIKey403Response) VisitDeleteAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type DeleteAPIKey404Response struct {
 	server.ProblemDetails
 }
@@ -113,17 +131,18 @@ func (response DeleteAPIKey500Response) VisitDeleteAPIKeyResponse(w http.Respons
 func (h *DeleteAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := DeleteAPIKey401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitDeleteAPIKeyResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.DeleteAPIKeyInput{}
@@ -142,8 +161,36 @@ func (h *DeleteAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "apikeys:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	if err := h.deleteAPIKey.Execute(ctx, input); err != nil {
+		if errors.Is(err, database.ErrNoTenant) {
+			problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
+		if errors.Is(err, models.ErrAPIKeyNotFound) {
+			problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := DeleteAPIKey500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
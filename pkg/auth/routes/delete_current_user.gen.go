@@ -4,6 +4,7 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -118,11 +119,11 @@ func (h *DeleteCurrentUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	input := &handlers.DeleteCurrentUserInput{}
 	input.SessionID = sessionID
 
-	// Header parameter "XConfirm"
-	xconfirm := r.Header.Get("XConfirm")
+	// Header parameter "X-Confirm"
+	xconfirm := r.Header.Get("X-Confirm")
 	if xconfirm == "" {
 		errorResp := DeleteCurrentUser400Response{
-			ProblemDetails: server.NewBadRequestResponse("Missing required header: XConfirm", r.URL.Path),
+			ProblemDetails: server.NewBadRequestResponse("Missing required header: X-Confirm", r.URL.Path),
 		}
 		if err := errorResp.VisitDeleteCurrentUserResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
@@ -131,8 +132,29 @@ func (h *DeleteCurrentUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	}
 	input.XConfirm = xconfirm
 
+	// Validate parameters
+	v := server.NewValidator()
+	v.Enum("/header/X-Confirm", input.XConfirm, "DELETE_MY_ACCOUNT")
+	if !v.Valid() {
+		errorResp := DeleteCurrentUser400Response{
+			ProblemDetails: server.NewValidationProblem(http.StatusBadRequest, v, r.URL.Path),
+		}
+		if err := errorResp.VisitDeleteCurrentUserResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+
 	// Execute
 	if err := h.deleteCurrentUser.Execute(ctx, input); err != nil {
+		var invalid *server.ValidationError
+		if errors.As(err, &invalid) {
+			problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := DeleteCurrentUser500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
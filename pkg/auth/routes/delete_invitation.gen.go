@@ -4,13 +4,16 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
+	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -21,11 +24,15 @@ import (
 // DeleteInvitationHandler is the HTTP handler for DeleteInvitation.
 type DeleteInvitationHandler struct {
 	deleteInvitation handlers.DeleteInvitation
+	authorizer       auth.Authorizer
 }
 
 // NewDeleteInvitationHandler creates a new HTTP handler.
-func NewDeleteInvitationHandler(deleteInvitation handlers.DeleteInvitation) *DeleteInvitationHandler {
-	return &DeleteInvitationHandler{deleteInvitation: deleteInvitation}
+func NewDeleteInvitationHandler(deleteInvitation handlers.DeleteInvitation, authorizer auth.Authorizer) *DeleteInvitationHandler {
+	return &DeleteInvitationHandler{
+		deleteInvitation: deleteInvitation,
+		authorizer:       authorizer,
+	}
 }
 
 // RegisterDeleteInvitationRoute registers the HTTP route for DeleteInvitation.
@@ -69,6 +76,16 @@ func (response DeleteInvitation401Response) VisitDeleteInvitationResponse(w http
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type DeleteInvitation403Response struct {
+	server.ProblemDetails
+}
+
+func (response DeleteInvitation403Response) VisitDeleteInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type DeleteInvitation404Response struct {
 	server.ProblemDetails
 }
@@ -113,17 +130,18 @@ func (response DeleteInvitation500Response) VisitDeleteInvitationResponse(w http
 func (h *DeleteInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := DeleteInvitation401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitDeleteInvitationResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.DeleteInvitationInput{}
@@ -155,8 +173,31 @@ func (h *DeleteInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission:     "members:write",
+		MinRole:        auth.MemberRoleAdmin,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	if err := h.deleteInvitation.Execute(ctx, input); err != nil {
+		if errors.Is(err, models.ErrInvitationNotFound) {
+			problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := DeleteInvitation500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/server"
 )
@@ -21,11 +22,15 @@ import (
 // DeleteOrganizationHandler is the HTTP handler for DeleteOrganization.
 type DeleteOrganizationHandler struct {
 	deleteOrganization handlers.DeleteOrganization
+	authorizer         auth.Authorizer
 }
 
 // NewDeleteOrganizationHandler creates a new HTTP handler.
-func NewDeleteOrganizationHandler(deleteOrganization handlers.DeleteOrganization) *DeleteOrganizationHandler {
-	return &DeleteOrganizationHandler{deleteOrganization: deleteOrganization}
+func NewDeleteOrganizationHandler(deleteOrganization handlers.DeleteOrganization, authorizer auth.Authorizer) *DeleteOrganizationHandler {
+	return &DeleteOrganizationHandler{
+		deleteOrganization: deleteOrganization,
+		authorizer:         authorizer,
+	}
 }
 
 // RegisterDeleteOrganizationRoute registers the HTTP route for DeleteOrganization.
@@ -69,6 +74,16 @@ func (response DeleteOrganization401Response) VisitDeleteOrganizationResponse(w
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type DeleteOrganization403Response struct {
+	server.ProblemDetails
+}
+
+func (response DeleteOrganization403Response) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type DeleteOrganization404Response struct {
 	server.ProblemDetails
 }
@@ -113,17 +128,18 @@ func (response DeleteOrganization500Response) VisitDeleteOrganizationResponse(w
 func (h *DeleteOrganizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := DeleteOrganization401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitDeleteOrganizationResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.DeleteOrganizationInput{}
@@ -142,6 +158,21 @@ func (h *DeleteOrganizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		MinRole:        auth.MemberRoleOwner,
+		OrganizationID: id,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	if err := h.deleteOrganization.Execute(ctx, input); err != nil {
 		errorResp := DeleteOrganization500Response{
@@ -4,14 +4,17 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -21,12 +24,16 @@ import (
 
 // GetAPIKeyHandler is the HTTP handler for GetAPIKey.
 type GetAPIKeyHandler struct {
-	getAPIKey handlers.GetAPIKey
+	getAPIKey  handlers.GetAPIKey
+	authorizer auth.Authorizer
 }
 
 // NewGetAPIKeyHandler creates a new HTTP handler.
-func NewGetAPIKeyHandler(getAPIKey handlers.GetAPIKey) *GetAPIKeyHandler {
-	return &GetAPIKeyHandler{getAPIKey: getAPIKey}
+func NewGetAPIKeyHandler(getAPIKey handlers.GetAPIKey, authorizer auth.Authorizer) *GetAPIKeyHandler {
+	return &GetAPIKeyHandler{
+		getAPIKey:  getAPIKey,
+		authorizer: authorizer,
+	}
 }
 
 // RegisterGetAPIKeyRoute registers the HTTP route for GetAPIKey.
@@ -72,6 +79,16 @@ func (response GetAPIKey401Response) VisitGetAPIKeyResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type GetAPIKey403Response struct {
+	server.ProblemDetails
+}
+
+func (response GetAPIKey403Response) VisitGetAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type GetAPIKey404Response struct {
 	server.ProblemDetails
 }
@@ -116,17 +133,18 @@ func (response GetAPIKey500Response) VisitGetAPIKeyResponse(w http.ResponseWrite
 func (h *GetAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := GetAPIKey401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitGetAPIKeyResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.GetAPIKeyInput{}
@@ -145,8 +163,36 @@ func (h *GetAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "apikeys:read",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	result, err := h.getAPIKey.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrAPIKeyNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := GetAPIKey500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,6 +4,7 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -113,6 +114,14 @@ func (h *GetCurrentUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	// Execute
 	result, err := h.getCurrentUser.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := GetCurrentUser500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
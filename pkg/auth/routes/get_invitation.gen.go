@@ -4,12 +4,14 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +24,15 @@ import (
 // GetInvitationHandler is the HTTP handler for GetInvitation.
 type GetInvitationHandler struct {
 	getInvitation handlers.GetInvitation
+	authorizer    auth.Authorizer
 }
 
 // NewGetInvitationHandler creates a new HTTP handler.
-func NewGetInvitationHandler(getInvitation handlers.GetInvitation) *GetInvitationHandler {
-	return &GetInvitationHandler{getInvitation: getInvitation}
+func NewGetInvitationHandler(getInvitation handlers.GetInvitation, authorizer auth.Authorizer) *GetInvitationHandler {
+	return &GetInvitationHandler{
+		getInvitation: getInvitation,
+		authorizer:    authorizer,
+	}
 }
 
 // RegisterGetInvitationRoute registers the HTTP route for GetInvitation.
@@ -72,6 +78,16 @@ func (response GetInvitation401Response) VisitGetInvitationResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type GetInvitation403Response struct {
+	server.ProblemDetails
+}
+
+func (response GetInvitation403Response) VisitGetInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type GetInvitation404Response struct {
 	server.ProblemDetails
 }
@@ -116,17 +132,18 @@ func (response GetInvitation500Response) VisitGetInvitationResponse(w http.Respo
 func (h *GetInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := GetInvitation401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitGetInvitationResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.GetInvitationInput{}
@@ -158,8 +175,30 @@ func (h *GetInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission:     "members:read",
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	result, err := h.getInvitation.Execute(ctx, input)
+	if errors.Is(err, models.ErrInvitationNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := GetInvitation500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,12 +4,14 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -21,12 +23,16 @@ import (
 
 // GetMemberHandler is the HTTP handler for GetMember.
 type GetMemberHandler struct {
-	getMember handlers.GetMember
+	getMember  handlers.GetMember
+	authorizer auth.Authorizer
 }
 
 // NewGetMemberHandler creates a new HTTP handler.
-func NewGetMemberHandler(getMember handlers.GetMember) *GetMemberHandler {
-	return &GetMemberHandler{getMember: getMember}
+func NewGetMemberHandler(getMember handlers.GetMember, authorizer auth.Authorizer) *GetMemberHandler {
+	return &GetMemberHandler{
+		getMember:  getMember,
+		authorizer: authorizer,
+	}
 }
 
 // RegisterGetMemberRoute registers the HTTP route for GetMember.
@@ -72,6 +78,16 @@ func (response GetMember401Response) VisitGetMemberResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type GetMember403Response struct {
+	server.ProblemDetails
+}
+
+func (response GetMember403Response) VisitGetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type GetMember404Response struct {
 	server.ProblemDetails
 }
@@ -116,17 +132,18 @@ func (response GetMember500Response) VisitGetMemberResponse(w http.ResponseWrite
 func (h *GetMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := GetMember401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitGetMemberResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.GetMemberInput{}
@@ -158,8 +175,30 @@ func (h *GetMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission:     "members:read",
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	result, err := h.getMember.Execute(ctx, input)
+	if errors.Is(err, models.ErrMemberNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := GetMember500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
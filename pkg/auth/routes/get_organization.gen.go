@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +23,15 @@ import (
 // GetOrganizationHandler is the HTTP handler for GetOrganization.
 type GetOrganizationHandler struct {
 	getOrganization handlers.GetOrganization
+	authorizer      auth.Authorizer
 }
 
 // NewGetOrganizationHandler creates a new HTTP handler.
-func NewGetOrganizationHandler(getOrganization handlers.GetOrganization) *GetOrganizationHandler {
-	return &GetOrganizationHandler{getOrganization: getOrganization}
+func NewGetOrganizationHandler(getOrganization handlers.GetOrganization, authorizer auth.Authorizer) *GetOrganizationHandler {
+	return &GetOrganizationHandler{
+		getOrganization: getOrganization,
+		authorizer:      authorizer,
+	}
 }
 
 // RegisterGetOrganizationRoute registers the HTTP route for GetOrganization.
@@ -72,6 +77,16 @@ func (response GetOrganization401Response) VisitGetOrganizationResponse(w http.R
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type GetOrganization403Response struct {
+	server.ProblemDetails
+}
+
+func (response GetOrganization403Response) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type GetOrganization404Response struct {
 	server.ProblemDetails
 }
@@ -116,17 +131,18 @@ func (response GetOrganization500Response) VisitGetOrganizationResponse(w http.R
 func (h *GetOrganizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := GetOrganization401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitGetOrganizationResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.GetOrganizationInput{}
@@ -145,6 +161,20 @@ func (h *GetOrganizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		OrganizationID: id,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	result, err := h.getOrganization.Execute(ctx, input)
 	if err != nil {
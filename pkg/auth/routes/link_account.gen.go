@@ -4,7 +4,9 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -137,8 +139,18 @@ func (h *LinkAccountHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	input.SessionID = sessionID
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := LinkAccount400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitLinkAccountResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &LinkAccountRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := LinkAccount400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -147,11 +159,35 @@ func (h *LinkAccountHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "provider")
+	v.Enum("/provider", body.Provider, "google", "github", "microsoft", "apple")
+	if body.RedirectURL != nil {
+		v.MaxLength("/redirectUrl", *body.RedirectURL, 2048)
+		v.Format("/redirectUrl", *body.RedirectURL, "uri")
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Provider = body.Provider
 	input.RedirectURL = body.RedirectURL
 
 	// Execute
 	result, err := h.linkAccount.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := LinkAccount500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,14 +4,16 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
-	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
 )
@@ -23,11 +25,15 @@ import (
 // ListAPIKeysHandler is the HTTP handler for ListAPIKeys.
 type ListAPIKeysHandler struct {
 	listAPIKeys handlers.ListAPIKeys
+	authorizer  auth.Authorizer
 }
 
 // NewListAPIKeysHandler creates a new HTTP handler.
-func NewListAPIKeysHandler(listAPIKeys handlers.ListAPIKeys) *ListAPIKeysHandler {
-	return &ListAPIKeysHandler{listAPIKeys: listAPIKeys}
+func NewListAPIKeysHandler(listAPIKeys handlers.ListAPIKeys, authorizer auth.Authorizer) *ListAPIKeysHandler {
+	return &ListAPIKeysHandler{
+		listAPIKeys: listAPIKeys,
+		authorizer:  authorizer,
+	}
 }
 
 // RegisterListAPIKeysRoute registers the HTTP route for ListAPIKeys.
@@ -81,6 +87,16 @@ func (response ListAPIKeys401Response) VisitListAPIKeysResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type ListAPIKeys403Response struct {
+	server.ProblemDetails
+}
+
+func (response ListAPIKeys403Response) VisitListAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type ListAPIKeys422Response struct {
 	server.ProblemDetails
 }
@@ -115,26 +131,42 @@ func (response ListAPIKeys500Response) VisitListAPIKeysResponse(w http.ResponseW
 func (h *ListAPIKeysHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := ListAPIKeys401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitListAPIKeysResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.ListAPIKeysInput{}
 	input.SessionID = sessionID
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "apikeys:read",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Query parameters
 
 	// Optional query parameter "filter"
-	if err := runtime.BindQueryParameter("deepObject", true, false, "filter", r.URL.Query(), &input.Filter); err != nil {
+	filter, err := server.BindFilterQuery(r.URL.Query(), "filter")
+	if err != nil {
 		errorResp := ListAPIKeys400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter filter: %s", err), r.URL.Path),
 		}
@@ -143,9 +175,11 @@ func (h *ListAPIKeysHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	input.Filter = filter
 
 	// Optional query parameter "page"
-	if err := runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &input.Page); err != nil {
+	page, err := server.BindPageQuery(r.URL.Query())
+	if err != nil {
 		errorResp := ListAPIKeys400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter page: %s", err), r.URL.Path),
 		}
@@ -154,9 +188,11 @@ func (h *ListAPIKeysHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	input.Page = page
 
 	// Optional query parameter "sort"
-	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &input.Sort); err != nil {
+	sort, err := server.BindSortQuery(r.URL.Query(), "sort")
+	if err != nil {
 		errorResp := ListAPIKeys400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter sort: %s", err), r.URL.Path),
 		}
@@ -165,9 +201,26 @@ func (h *ListAPIKeysHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	input.Sort = sort
 
 	// Execute
 	result, err := h.listAPIKeys.Execute(ctx, input)
+	if errors.Is(err, database.ErrInvalidListOptions) {
+		errorResp := ListAPIKeys400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitListAPIKeysResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := ListAPIKeys500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
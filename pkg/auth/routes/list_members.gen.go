@@ -4,14 +4,17 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
 )
@@ -23,11 +26,15 @@ import (
 // ListMembersHandler is the HTTP handler for ListMembers.
 type ListMembersHandler struct {
 	listMembers handlers.ListMembers
+	authorizer  auth.Authorizer
 }
 
 // NewListMembersHandler creates a new HTTP handler.
-func NewListMembersHandler(listMembers handlers.ListMembers) *ListMembersHandler {
-	return &ListMembersHandler{listMembers: listMembers}
+func NewListMembersHandler(listMembers handlers.ListMembers, authorizer auth.Authorizer) *ListMembersHandler {
+	return &ListMembersHandler{
+		listMembers: listMembers,
+		authorizer:  authorizer,
+	}
 }
 
 // RegisterListMembersRoute registers the HTTP route for ListMembers.
@@ -81,6 +88,16 @@ func (response ListMembers401Response) VisitListMembersResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type ListMembers403Response struct {
+	server.ProblemDetails
+}
+
+func (response ListMembers403Response) VisitListMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type ListMembers422Response struct {
 	server.ProblemDetails
 }
@@ -115,17 +132,18 @@ func (response ListMembers500Response) VisitListMembersResponse(w http.ResponseW
 func (h *ListMembersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := ListMembers401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitListMembersResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.ListMembersInput{}
@@ -144,10 +162,26 @@ func (h *ListMembersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	input.OrganizationID = organizationID
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission:     "members:read",
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Query parameters
 
 	// Optional query parameter "filter"
-	if err := runtime.BindQueryParameter("deepObject", true, false, "filter", r.URL.Query(), &input.Filter); err != nil {
+	filter, err := server.BindFilterQuery(r.URL.Query(), "filter")
+	if err != nil {
 		errorResp := ListMembers400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter filter: %s", err), r.URL.Path),
 		}
@@ -156,9 +190,11 @@ func (h *ListMembersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	input.Filter = filter
 
 	// Optional query parameter "page"
-	if err := runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &input.Page); err != nil {
+	page, err := server.BindPageQuery(r.URL.Query())
+	if err != nil {
 		errorResp := ListMembers400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter page: %s", err), r.URL.Path),
 		}
@@ -167,9 +203,11 @@ func (h *ListMembersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	input.Page = page
 
 	// Optional query parameter "sort"
-	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &input.Sort); err != nil {
+	sort, err := server.BindSortQuery(r.URL.Query(), "sort")
+	if err != nil {
 		errorResp := ListMembers400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter sort: %s", err), r.URL.Path),
 		}
@@ -178,9 +216,19 @@ func (h *ListMembersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	input.Sort = sort
 
 	// Execute
 	result, err := h.listMembers.Execute(ctx, input)
+	if errors.Is(err, database.ErrInvalidListOptions) {
+		errorResp := ListMembers400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitListMembersResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := ListMembers500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
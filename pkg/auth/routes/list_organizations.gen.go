@@ -4,14 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
-	"github.com/oapi-codegen/runtime"
 
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
 )
@@ -134,7 +135,8 @@ func (h *ListOrganizationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	// Query parameters
 
 	// Optional query parameter "filter"
-	if err := runtime.BindQueryParameter("deepObject", true, false, "filter", r.URL.Query(), &input.Filter); err != nil {
+	filter, err := server.BindFilterQuery(r.URL.Query(), "filter")
+	if err != nil {
 		errorResp := ListOrganizations400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter filter: %s", err), r.URL.Path),
 		}
@@ -143,9 +145,11 @@ func (h *ListOrganizationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		}
 		return
 	}
+	input.Filter = filter
 
 	// Optional query parameter "page"
-	if err := runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &input.Page); err != nil {
+	page, err := server.BindPageQuery(r.URL.Query())
+	if err != nil {
 		errorResp := ListOrganizations400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter page: %s", err), r.URL.Path),
 		}
@@ -154,9 +158,11 @@ func (h *ListOrganizationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		}
 		return
 	}
+	input.Page = page
 
 	// Optional query parameter "sort"
-	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &input.Sort); err != nil {
+	sort, err := server.BindSortQuery(r.URL.Query(), "sort")
+	if err != nil {
 		errorResp := ListOrganizations400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter sort: %s", err), r.URL.Path),
 		}
@@ -165,9 +171,27 @@ func (h *ListOrganizationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		}
 		return
 	}
+	input.Sort = sort
 
 	// Execute
 	result, err := h.listOrganizations.Execute(ctx, input)
+	if errors.Is(err, database.ErrInvalidListOptions) {
+		errorResp := ListOrganizations400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitListOrganizationsResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := ListOrganizations500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
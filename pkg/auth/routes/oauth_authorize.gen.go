@@ -4,6 +4,7 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -162,10 +163,10 @@ func (h *OauthAuthorizeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	// Query parameters
 
-	// Optional query parameter "redirecturi"
-	if err := runtime.BindQueryParameter("form", true, false, "redirecturi", r.URL.Query(), &input.RedirectURI); err != nil {
+	// Optional query parameter "redirect_uri"
+	if err := runtime.BindQueryParameter("form", true, false, "redirect_uri", r.URL.Query(), &input.RedirectURI); err != nil {
 		errorResp := OauthAuthorize400Response{
-			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter redirecturi: %s", err), r.URL.Path),
+			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter redirect_uri: %s", err), r.URL.Path),
 		}
 		if err := errorResp.VisitOauthAuthorizeResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
@@ -195,8 +196,41 @@ func (h *OauthAuthorizeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// Validate parameters
+	v := server.NewValidator()
+	v.Enum("/path/provider", input.Provider, "google", "github", "microsoft")
+	if input.RedirectURI != nil {
+		v.MaxLength("/query/redirect_uri", *input.RedirectURI, 2048)
+		v.Format("/query/redirect_uri", *input.RedirectURI, "uri")
+	}
+	if input.Scope != nil {
+		v.MaxLength("/query/scope", *input.Scope, 255)
+		v.Pattern("/query/scope", *input.Scope, `^[\w\s\-.,!?()@#+/:]*$`)
+	}
+	if input.State != nil {
+		v.MaxLength("/query/state", *input.State, 255)
+		v.Pattern("/query/state", *input.State, `^[\w\-]+$`)
+	}
+	if !v.Valid() {
+		errorResp := OauthAuthorize400Response{
+			ProblemDetails: server.NewValidationProblem(http.StatusBadRequest, v, r.URL.Path),
+		}
+		if err := errorResp.VisitOauthAuthorizeResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+
 	// Execute
 	result, err := h.oauthAuthorize.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := OauthAuthorize500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
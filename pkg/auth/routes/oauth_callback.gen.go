@@ -4,6 +4,7 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -207,10 +208,39 @@ func (h *OauthCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Optional query parameter "errordescription"
-	if err := runtime.BindQueryParameter("form", true, false, "errordescription", r.URL.Query(), &input.ErrorDescription); err != nil {
+	// Optional query parameter "error_description"
+	if err := runtime.BindQueryParameter("form", true, false, "error_description", r.URL.Query(), &input.ErrorDescription); err != nil {
 		errorResp := OauthCallback400Response{
-			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter errordescription: %s", err), r.URL.Path),
+			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter error_description: %s", err), r.URL.Path),
+		}
+		if err := errorResp.VisitOauthCallbackResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+
+	// Validate parameters
+	v := server.NewValidator()
+	v.Enum("/path/provider", input.Provider, "google", "github", "microsoft")
+	if input.Code != nil {
+		v.MaxLength("/query/code", *input.Code, 1024)
+		v.Pattern("/query/code", *input.Code, `^[\w\-]+$`)
+	}
+	if input.State != nil {
+		v.MaxLength("/query/state", *input.State, 255)
+		v.Pattern("/query/state", *input.State, `^[\w\-]+$`)
+	}
+	if input.Error != nil {
+		v.MaxLength("/query/error", *input.Error, 100)
+		v.Pattern("/query/error", *input.Error, `^[\w\-_]+$`)
+	}
+	if input.ErrorDescription != nil {
+		v.MaxLength("/query/error_description", *input.ErrorDescription, 500)
+		v.Pattern("/query/error_description", *input.ErrorDescription, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if !v.Valid() {
+		errorResp := OauthCallback400Response{
+			ProblemDetails: server.NewValidationProblem(http.StatusBadRequest, v, r.URL.Path),
 		}
 		if err := errorResp.VisitOauthCallbackResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
@@ -220,6 +250,14 @@ func (h *OauthCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	// Execute
 	result, err := h.oauthCallback.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := OauthCallback500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -5,6 +5,7 @@ package routes
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -126,8 +127,18 @@ func (h *RegisterHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	input := &handlers.RegisterInput{}
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := Register400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitRegisterResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &RegisterRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := Register400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -136,6 +147,27 @@ func (h *RegisterHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "email", "name", "password")
+	v.MinLength("/email", body.Email, 1)
+	v.MaxLength("/email", body.Email, 255)
+	v.Pattern("/email", body.Email, `^([A-Za-z0-9_'+\-]+\.)*[A-Za-z0-9_'+\-]*[A-Za-z0-9_+\-]@([A-Za-z0-9][A-Za-z0-9\-]*\.)+[A-Za-z]{2,}$`)
+	v.Format("/email", body.Email, "email")
+	v.MinLength("/name", body.Name, 1)
+	v.MaxLength("/name", body.Name, 255)
+	v.Pattern("/name", body.Name, `^[\w\s\-.,!?()@#+/]*$`)
+	v.MinLength("/password", body.Password, 8)
+	v.MaxLength("/password", body.Password, 72)
+	v.Pattern("/password", body.Password, `^[\w\s\-.,!?()@#+/]*$`)
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Email = body.Email
 	input.Name = body.Name
 	input.Password = body.Password
@@ -0,0 +1,75 @@
+package routes_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/auth/handlers"
+	"github.com/archesai/archesai/pkg/auth/mocks"
+	"github.com/archesai/archesai/pkg/auth/routes"
+	"github.com/archesai/archesai/pkg/server"
+)
+
+func TestRegisterRoute(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantErrors []server.FieldError
+	}{
+		{
+			name:       "registers",
+			body:       `{"email":"jane.o'neil+test@mail.example.com","name":"Jane","password":"secure-password-123"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "email ending with an apostrophe",
+			body:       `{"email":"jane'@example.com","name":"Jane","password":"secure-password-123"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErrors: []server.FieldError{{
+				Pointer: "/email",
+				Message: "must match pattern ^([A-Za-z0-9_'+\\-]+\\.)*[A-Za-z0-9_'+\\-]*[A-Za-z0-9_+\\-]@([A-Za-z0-9][A-Za-z0-9\\-]*\\.)+[A-Za-z]{2,}$",
+			}},
+		},
+		{
+			name:       "short password",
+			body:       `{"email":"jane@example.com","name":"Jane","password":"short"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErrors: []server.FieldError{{Pointer: "/password", Message: "must be at least 8 characters"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			register := &mocks.Register{}
+			register.ExecuteFunc = func(_ context.Context, input *handlers.RegisterInput) (*handlers.RegisterOutput, error) {
+				return &handlers.RegisterOutput{ID: uuid.New(), Token: "token"}, nil
+			}
+			mux := http.NewServeMux()
+			routes.RegisterRegisterRoute(mux, routes.NewRegisterHandler(register))
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/register", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantStatus == http.StatusCreated {
+				require.Len(t, register.ExecuteCalls(), 1)
+				assert.Equal(t, "Jane", register.ExecuteCalls()[0].Input.Name)
+				return
+			}
+			assert.Empty(t, register.ExecuteCalls())
+			var problem server.ProblemDetails
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			assert.Equal(t, tt.wantErrors, problem.Errors)
+		})
+	}
+}
@@ -4,7 +4,9 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/archesai/archesai/pkg/auth/handlers"
@@ -117,8 +119,18 @@ func (h *RequestMagicLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	input := &handlers.RequestMagicLinkInput{}
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := RequestMagicLink400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitRequestMagicLinkResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &RequestMagicLinkRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := RequestMagicLink400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -127,12 +139,42 @@ func (h *RequestMagicLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "identifier")
+	if body.DeliveryMethod != nil {
+		v.Enum("/deliveryMethod", *body.DeliveryMethod, "email", "console", "otp", "webhook")
+	}
+	v.MinLength("/identifier", body.Identifier, 1)
+	v.MaxLength("/identifier", body.Identifier, 255)
+	v.Pattern("/identifier", body.Identifier, `^[\w\s\-.,!?()@#+/]*$`)
+	if body.RedirectURL != nil {
+		v.MinLength("/redirectUrl", *body.RedirectURL, 1)
+		v.MaxLength("/redirectUrl", *body.RedirectURL, 2048)
+		v.Format("/redirectUrl", *body.RedirectURL, "uri")
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.DeliveryMethod = body.DeliveryMethod
 	input.Identifier = body.Identifier
 	input.RedirectURL = body.RedirectURL
 
 	// Execute
 	result, err := h.requestMagicLink.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := RequestMagicLink500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,7 +4,9 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -153,8 +155,18 @@ func (h *UpdateAccountHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	input.ID = id
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdateAccount400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateAccountResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdateAccountRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdateAccount400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -163,12 +175,42 @@ func (h *UpdateAccountHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Provider != nil {
+		v.MaxLength("/provider", *body.Provider, 255)
+		v.Pattern("/provider", *body.Provider, `^[a-z][a-z0-9-]*$`)
+	}
+	if body.ProviderAccountIdentifier != nil {
+		v.MaxLength("/providerAccountIdentifier", *body.ProviderAccountIdentifier, 255)
+		v.Pattern("/providerAccountIdentifier", *body.ProviderAccountIdentifier, `^[a-z][a-z0-9-]*$`)
+	}
+	if body.Type != nil {
+		v.MaxLength("/type", *body.Type, 255)
+		v.Pattern("/type", *body.Type, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Provider = body.Provider
 	input.ProviderAccountIdentifier = body.ProviderAccountIdentifier
 	input.Type = body.Type
 
 	// Execute
 	result, err := h.updateAccount.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := UpdateAccount500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,15 +4,20 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -23,11 +28,15 @@ import (
 // UpdateAPIKeyHandler is the HTTP handler for UpdateAPIKey.
 type UpdateAPIKeyHandler struct {
 	updateAPIKey handlers.UpdateAPIKey
+	authorizer   auth.Authorizer
 }
 
 // NewUpdateAPIKeyHandler creates a new HTTP handler.
-func NewUpdateAPIKeyHandler(updateAPIKey handlers.UpdateAPIKey) *UpdateAPIKeyHandler {
-	return &UpdateAPIKeyHandler{updateAPIKey: updateAPIKey}
+func NewUpdateAPIKeyHandler(updateAPIKey handlers.UpdateAPIKey, authorizer auth.Authorizer) *UpdateAPIKeyHandler {
+	return &UpdateAPIKeyHandler{
+		updateAPIKey: updateAPIKey,
+		authorizer:   authorizer,
+	}
 }
 
 // RegisterUpdateAPIKeyRoute registers the HTTP route for UpdateAPIKey.
@@ -81,6 +90,16 @@ func (response UpdateAPIKey401Response) VisitUpdateAPIKeyResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type UpdateAPIKey403Response struct {
+	server.ProblemDetails
+}
+
+func (response UpdateAPIKey403Response) VisitUpdateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type UpdateAPIKey404Response struct {
 	server.ProblemDetails
 }
@@ -125,17 +144,18 @@ func (response UpdateAPIKey500Response) VisitUpdateAPIKeyResponse(w http.Respons
 func (h *UpdateAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := UpdateAPIKey401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitUpdateAPIKeyResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.UpdateAPIKeyInput{}
@@ -154,9 +174,33 @@ func (h *UpdateAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "apikeys:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdateAPIKey400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateAPIKeyResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdateAPIKeyRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdateAPIKey400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -165,6 +209,30 @@ func (h *UpdateAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Name != nil {
+		v.MinLength("/name", *body.Name, 1)
+		v.MaxLength("/name", *body.Name, 255)
+		v.Pattern("/name", *body.Name, `^[\w\s\-.,!?()@#+/']+$`)
+	}
+	if body.RateLimit != nil {
+		v.Minimum("/rateLimit", float64(*body.RateLimit), 1, false)
+		v.Maximum("/rateLimit", float64(*body.RateLimit), 2.147483647e+09, false)
+	}
+	v.MaxItems("/scopes", len(body.Scopes), 1000)
+	for i, item := range body.Scopes {
+		v.MaxLength("/scopes"+"/"+strconv.Itoa(i), item, 255)
+		v.Pattern("/scopes"+"/"+strconv.Itoa(i), item, `^[a-z]+:[a-z]+$`)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.ExpiresAt = body.ExpiresAt
 	input.Name = body.Name
 	input.RateLimit = body.RateLimit
@@ -172,6 +240,20 @@ func (h *UpdateAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	// Execute
 	result, err := h.updateAPIKey.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrAPIKeyNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := UpdateAPIKey500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
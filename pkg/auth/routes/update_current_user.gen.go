@@ -4,7 +4,9 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -128,8 +130,18 @@ func (h *UpdateCurrentUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	input.SessionID = sessionID
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdateCurrentUser400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateCurrentUserResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdateCurrentUserRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdateCurrentUser400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -138,11 +150,39 @@ func (h *UpdateCurrentUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Image != nil {
+		v.MinLength("/image", *body.Image, 5)
+		v.MaxLength("/image", *body.Image, 2048)
+		v.Format("/image", *body.Image, "uri")
+	}
+	if body.Name != nil {
+		v.MinLength("/name", *body.Name, 1)
+		v.MaxLength("/name", *body.Name, 255)
+		v.Pattern("/name", *body.Name, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Image = body.Image
 	input.Name = body.Name
 
 	// Execute
 	result, err := h.updateCurrentUser.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := UpdateCurrentUser500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,12 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +25,15 @@ import (
 // UpdateInvitationHandler is the HTTP handler for UpdateInvitation.
 type UpdateInvitationHandler struct {
 	updateInvitation handlers.UpdateInvitation
+	authorizer       auth.Authorizer
 }
 
 // NewUpdateInvitationHandler creates a new HTTP handler.
-func NewUpdateInvitationHandler(updateInvitation handlers.UpdateInvitation) *UpdateInvitationHandler {
-	return &UpdateInvitationHandler{updateInvitation: updateInvitation}
+func NewUpdateInvitationHandler(updateInvitation handlers.UpdateInvitation, authorizer auth.Authorizer) *UpdateInvitationHandler {
+	return &UpdateInvitationHandler{
+		updateInvitation: updateInvitation,
+		authorizer:       authorizer,
+	}
 }
 
 // RegisterUpdateInvitationRoute registers the HTTP route for UpdateInvitation.
@@ -78,6 +85,16 @@ func (response UpdateInvitation401Response) VisitUpdateInvitationResponse(w http
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type UpdateInvitation403Response struct {
+	server.ProblemDetails
+}
+
+func (response UpdateInvitation403Response) VisitUpdateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type UpdateInvitation404Response struct {
 	server.ProblemDetails
 }
@@ -122,17 +139,18 @@ func (response UpdateInvitation500Response) VisitUpdateInvitationResponse(w http
 func (h *UpdateInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := UpdateInvitation401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitUpdateInvitationResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.UpdateInvitationInput{}
@@ -164,9 +182,35 @@ func (h *UpdateInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission:     "members:write",
+		MinRole:        auth.MemberRoleAdmin,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdateInvitation400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateInvitationResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdateInvitationRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdateInvitation400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -175,11 +219,35 @@ func (h *UpdateInvitationHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Email != nil {
+		v.MaxLength("/email", *body.Email, 255)
+		v.Pattern("/email", *body.Email, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if body.Role != nil {
+		v.Enum("/role", *body.Role, "admin", "owner", "member")
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Email = body.Email
 	input.Role = body.Role
 
 	// Execute
 	result, err := h.updateInvitation.Execute(ctx, input)
+	if errors.Is(err, models.ErrInvitationNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := UpdateInvitation500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
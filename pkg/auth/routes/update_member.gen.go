@@ -4,12 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +25,15 @@ import (
 // UpdateMemberHandler is the HTTP handler for UpdateMember.
 type UpdateMemberHandler struct {
 	updateMember handlers.UpdateMember
+	authorizer   auth.Authorizer
 }
 
 // NewUpdateMemberHandler creates a new HTTP handler.
-func NewUpdateMemberHandler(updateMember handlers.UpdateMember) *UpdateMemberHandler {
-	return &UpdateMemberHandler{updateMember: updateMember}
+func NewUpdateMemberHandler(updateMember handlers.UpdateMember, authorizer auth.Authorizer) *UpdateMemberHandler {
+	return &UpdateMemberHandler{
+		updateMember: updateMember,
+		authorizer:   authorizer,
+	}
 }
 
 // RegisterUpdateMemberRoute registers the HTTP route for UpdateMember.
@@ -38,7 +45,8 @@ func RegisterUpdateMemberRoute(mux *http.ServeMux, handler *UpdateMemberHandler)
 
 // UpdateMemberRequestBody defines the request body for UpdateMember
 type UpdateMemberRequestBody struct {
-	Role *string `json:"role,omitempty"`
+	Role   *string    `json:"role,omitempty"`
+	RoleID *uuid.UUID `json:"roleID,omitempty"`
 }
 
 // Response types
@@ -77,6 +85,16 @@ func (response UpdateMember401Response) VisitUpdateMemberResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type UpdateMember403Response struct {
+	server.ProblemDetails
+}
+
+func (response UpdateMember403Response) VisitUpdateMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type UpdateMember404Response struct {
 	server.ProblemDetails
 }
@@ -121,17 +139,18 @@ func (response UpdateMember500Response) VisitUpdateMemberResponse(w http.Respons
 func (h *UpdateMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := UpdateMember401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitUpdateMemberResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.UpdateMemberInput{}
@@ -163,9 +182,35 @@ func (h *UpdateMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission:     "members:write",
+		MinRole:        auth.MemberRoleAdmin,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdateMember400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateMemberResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdateMemberRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdateMember400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -174,10 +219,46 @@ func (h *UpdateMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Role != nil {
+		v.Enum("/role", *body.Role, "admin", "owner", "basic")
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Role = body.Role
+	input.RoleID = body.RoleID
 
 	// Execute
 	result, err := h.updateMember.Execute(ctx, input)
+	if errors.Is(err, models.ErrMemberNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, auth.ErrForbidden) {
+		problem := server.NewForbiddenResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := UpdateMember500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
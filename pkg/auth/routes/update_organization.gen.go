@@ -5,11 +5,13 @@ package routes
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +24,15 @@ import (
 // UpdateOrganizationHandler is the HTTP handler for UpdateOrganization.
 type UpdateOrganizationHandler struct {
 	updateOrganization handlers.UpdateOrganization
+	authorizer         auth.Authorizer
 }
 
 // NewUpdateOrganizationHandler creates a new HTTP handler.
-func NewUpdateOrganizationHandler(updateOrganization handlers.UpdateOrganization) *UpdateOrganizationHandler {
-	return &UpdateOrganizationHandler{updateOrganization: updateOrganization}
+func NewUpdateOrganizationHandler(updateOrganization handlers.UpdateOrganization, authorizer auth.Authorizer) *UpdateOrganizationHandler {
+	return &UpdateOrganizationHandler{
+		updateOrganization: updateOrganization,
+		authorizer:         authorizer,
+	}
 }
 
 // RegisterUpdateOrganizationRoute registers the HTTP route for UpdateOrganization.
@@ -78,6 +84,16 @@ func (response UpdateOrganization401Response) VisitUpdateOrganizationResponse(w
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type UpdateOrganization403Response struct {
+	server.ProblemDetails
+}
+
+func (response UpdateOrganization403Response) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type UpdateOrganization404Response struct {
 	server.ProblemDetails
 }
@@ -122,17 +138,18 @@ func (response UpdateOrganization500Response) VisitUpdateOrganizationResponse(w
 func (h *UpdateOrganizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Extract session ID from context for authenticated operations
-	sessionID, ok := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
-	if !ok {
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
 		errorResp := UpdateOrganization401Response{
-			ProblemDetails: server.NewUnauthorizedResponse("session required", r.URL.Path),
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
 		}
 		if err := errorResp.VisitUpdateOrganizationResponse(w); err != nil {
 			fmt.Fprintf(w, "error writing response: %v", err)
 		}
 		return
 	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
 
 	// Build input from request
 	input := &handlers.UpdateOrganizationInput{}
@@ -151,9 +168,34 @@ func (h *UpdateOrganizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission:     "organizations:write",
+		OrganizationID: id,
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdateOrganization400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateOrganizationResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdateOrganizationRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdateOrganization400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -162,6 +204,20 @@ func (h *UpdateOrganizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.BillingEmail != nil {
+		v.MaxLength("/billingEmail", *body.BillingEmail, 255)
+		v.Format("/billingEmail", *body.BillingEmail, "email")
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.BillingEmail = body.BillingEmail
 	input.OrganizationID = body.OrganizationID
 
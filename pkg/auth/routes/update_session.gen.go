@@ -4,12 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/auth/handlers"
 	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -48,7 +51,10 @@ type UpdateSessionResponse interface {
 }
 
 type UpdateSession200Response struct {
-	Data models.Session `json:"data"`
+	AccessToken  string         `json:"accessToken"`
+	Data         models.Session `json:"data"`
+	ExpiresIn    int32          `json:"expiresIn"`
+	RefreshToken string         `json:"refreshToken"`
 }
 
 func (response UpdateSession200Response) VisitUpdateSessionResponse(w http.ResponseWriter) error {
@@ -77,6 +83,16 @@ func (response UpdateSession401Response) VisitUpdateSessionResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type UpdateSession403Response struct {
+	server.ProblemDetails
+}
+
+func (response UpdateSession403Response) VisitUpdateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type UpdateSession404Response struct {
 	server.ProblemDetails
 }
@@ -151,8 +167,18 @@ func (h *UpdateSessionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	input.ID = id
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdateSession400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateSessionResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdateSessionRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdateSession400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -161,10 +187,36 @@ func (h *UpdateSessionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "organizationID")
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.OrganizationID = body.OrganizationID
 
 	// Execute
 	result, err := h.updateSession.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, auth.ErrForbidden) {
+		problem := server.NewForbiddenResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := UpdateSession500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -177,7 +229,10 @@ func (h *UpdateSessionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	// Map output to response
 	response := UpdateSession200Response{}
+	response.AccessToken = result.AccessToken
 	response.Data = result.Data
+	response.ExpiresIn = result.ExpiresIn
+	response.RefreshToken = result.RefreshToken
 
 	if err := response.VisitUpdateSessionResponse(w); err != nil {
 		fmt.Fprintf(w, "error writing response: %v", err)
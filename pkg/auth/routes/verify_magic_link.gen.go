@@ -4,7 +4,9 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -136,8 +138,18 @@ func (h *VerifyMagicLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	input := &handlers.VerifyMagicLinkInput{}
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := VerifyMagicLink400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitVerifyMagicLinkResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &VerifyMagicLinkRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := VerifyMagicLink400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -146,12 +158,45 @@ func (h *VerifyMagicLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Code != nil {
+		v.MinLength("/code", *body.Code, 6)
+		v.MaxLength("/code", *body.Code, 6)
+		v.Pattern("/code", *body.Code, `^[0-9]{6}$`)
+	}
+	if body.Identifier != nil {
+		v.MinLength("/identifier", *body.Identifier, 1)
+		v.MaxLength("/identifier", *body.Identifier, 255)
+		v.Pattern("/identifier", *body.Identifier, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if body.Token != nil {
+		v.MinLength("/token", *body.Token, 32)
+		v.MaxLength("/token", *body.Token, 512)
+		v.Pattern("/token", *body.Token, `^[A-Za-z0-9_-]+$`)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Code = body.Code
 	input.Identifier = body.Identifier
 	input.Token = body.Token
 
 	// Execute
 	result, err := h.verifyMagicLink.Execute(ctx, input)
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := VerifyMagicLink500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -9,8 +9,10 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/archesai/archesai/pkg/auth/models"
 	"github.com/archesai/archesai/pkg/auth/oauth"
 	"github.com/archesai/archesai/pkg/cache"
+	"github.com/archesai/archesai/pkg/database"
 )
 
 // Service provides authentication functionality across all transport layers.
@@ -20,6 +22,9 @@ type Service struct {
 	sessionRepo        SessionRepository
 	userRepo           UserRepository
 	accountRepo        AccountRepository
+	memberRepo         MemberRepository
+	apiKeyRepo         APIKeyRepository
+	txManager          *database.TxManager
 	tokenManager       *TokenManager
 	magicLink          *MagicLinkProvider
 	oauthProviders     map[string]oauth.Provider
@@ -39,12 +44,18 @@ type OTPDeliverer interface {
 	Deliver(ctx context.Context, token *MagicLinkToken, baseURL string) error
 }
 
-// NewService creates a new authentication service.
+// NewService creates a new authentication service. Writes spanning several
+// repositories run in a transaction of txManager; a nil txManager runs them
+// without one. Memberships decide the organizations a session can act in,
+// and API keys authenticate requests without a session.
 func NewService(
 	cfg *Config,
 	sessionRepo SessionRepository,
 	userRepo UserRepository,
 	accountRepo AccountRepository,
+	memberRepo MemberRepository,
+	apiKeyRepo APIKeyRepository,
+	txManager *database.TxManager,
 	cacheService cache.Cache[string],
 	magicLinkDeliverer MagicLinkDeliverer,
 	otpDeliverer OTPDeliverer,
@@ -60,6 +71,9 @@ func NewService(
 		sessionRepo:        sessionRepo,
 		userRepo:           userRepo,
 		accountRepo:        accountRepo,
+		memberRepo:         memberRepo,
+		apiKeyRepo:         apiKeyRepo,
+		txManager:          txManager,
 		tokenManager:       NewTokenManager(cfg.Auth.Local.JWTSecret),
 		magicLink:          NewMagicLinkProvider(cfg.Auth.Local.JWTSecret, baseURL),
 		oauthProviders:     make(map[string]oauth.Provider),
@@ -275,7 +289,9 @@ func (s *Service) AuthenticateWithPassword(
 	return s.generateTokens(user, session)
 }
 
-// RefreshToken validates a refresh token and issues new tokens.
+// RefreshToken validates a refresh token and issues new tokens. Like
+// SwitchOrganization, it checks that the user is still a member of the
+// session's organization; if not, the new tokens are scoped to none.
 func (s *Service) RefreshToken(
 	ctx context.Context,
 	refreshToken string,
@@ -298,6 +314,22 @@ func (s *Service) RefreshToken(
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
+	// Leave the session's organization out of the tokens if the user is no
+	// longer a member of it. The check runs on every refresh, so the session
+	// itself is left as it is.
+	if session.OrganizationID != nil {
+		_, err = s.memberRepo.GetMemberByUserAndOrganization(
+			ctx,
+			session.UserID.String(),
+			session.OrganizationID.String(),
+		)
+		if errors.Is(err, models.ErrMemberNotFound) {
+			session.OrganizationID = nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get membership: %w", err)
+		}
+	}
+
 	// Generate new auth tokens
 	return s.generateTokens(user, session)
 }
@@ -379,6 +411,12 @@ func (s *Service) createSession(
 	// Convert authProvider string to SessionAuthProvider enum
 	provider := SessionAuthProvider(authProvider)
 
+	// Start in the user's first organization, if they belong to one
+	organizationID, err := s.defaultOrganization(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
 	session := &Session{
 		ID:           uuid.New(),
 		UserID:       userID,
@@ -391,6 +429,9 @@ func (s *Service) createSession(
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
+	if organizationID != uuid.Nil {
+		session.OrganizationID = &organizationID
+	}
 
 	createdSession, err := s.sessionRepo.Create(ctx, session)
 	if err != nil {
@@ -400,12 +441,30 @@ func (s *Service) createSession(
 	return createdSession, nil
 }
 
+// defaultOrganization returns the organization the user joined first, or
+// uuid.Nil if they are not a member of any.
+func (s *Service) defaultOrganization(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
+	members, err := s.memberRepo.ListMembersByUser(ctx, userID.String())
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to list memberships: %w", err)
+	}
+	if len(members) == 0 {
+		return uuid.Nil, nil
+	}
+	// Memberships are listed newest first
+	return members[len(members)-1].OrganizationID, nil
+}
+
 func (s *Service) generateTokens(
 	user *User,
 	session *Session,
 ) (*Tokens, error) {
-	// Generate access token (short-lived)
-	accessToken, err := s.tokenManager.CreateAccessToken(user.ID, session.ID)
+	// Generate access token (short-lived), scoped to the session's organization
+	var organizationID uuid.UUID
+	if session.OrganizationID != nil {
+		organizationID = *session.OrganizationID
+	}
+	accessToken, err := s.tokenManager.CreateAccessToken(user.ID, session.ID, organizationID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create access token: %w", err)
 	}
@@ -425,6 +484,76 @@ func (s *Service) generateTokens(
 	}, nil
 }
 
+// SwitchOrganization makes an organization the active one of a session and
+// issues tokens scoped to it. It returns ErrForbidden if the user of the
+// session is not a member of the organization.
+func (s *Service) SwitchOrganization(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	organizationID uuid.UUID,
+) (*Session, *Tokens, error) {
+	session, err := s.sessionRepo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("session not found: %w", err)
+	}
+
+	_, err = s.memberRepo.GetMemberByUserAndOrganization(ctx, session.UserID.String(), organizationID.String())
+	if errors.Is(err, models.ErrMemberNotFound) {
+		return nil, nil, ErrForbidden
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get membership: %w", err)
+	}
+
+	user, err := s.userRepo.Get(ctx, session.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	session.OrganizationID = &organizationID
+	session.UpdatedAt = time.Now()
+	updated, err := s.sessionRepo.Update(ctx, session.ID, session)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	tokens, err := s.generateTokens(user, updated)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, tokens, nil
+}
+
+// AuthenticateAPIKey returns the API key a bearer token is, which acts in
+// the organization it was created in. It returns ErrInvalidAPIKey if the key
+// is unknown or expired.
+func (s *Service) AuthenticateAPIKey(ctx context.Context, key string) (*APIKey, error) {
+	organizationID, err := parseAPIKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, _, err := s.apiKeyRepo.List(database.WithTenant(ctx, organizationID), database.ListOptions{
+		Filter: &database.Filter{
+			Type:  database.FilterEq,
+			Field: "keyHash",
+			Value: HashAPIKey(key),
+		},
+		Page: database.Page{Limit: 1},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up API key: %w", err)
+	}
+	if len(keys) == 0 {
+		return nil, ErrInvalidAPIKey
+	}
+	apiKey := keys[0]
+	if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now()) {
+		return nil, ErrInvalidAPIKey
+	}
+	return apiKey, nil
+}
+
 // Register creates a new user account and session
 func (s *Service) Register(
 	ctx context.Context,
@@ -448,33 +577,38 @@ func (s *Service) Register(
 		return nil, fmt.Errorf("failed to create user entity: %w", err)
 	}
 
-	// Store the user
-	createdUser, err := s.userRepo.Create(ctx, user)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
-	}
-
-	// Create a local account to store the password
-	account := &Account{
-		ID:                uuid.New(),
-		UserID:            createdUser.ID,
-		Provider:          AccountProviderLocal,
-		AccountIdentifier: email,           // Use email as account ID for local auth
-		AccessToken:       &hashedPassword, // Store password hash in AccessToken temporarily
-		CreatedAt:         time.Now(),
-		UpdatedAt:         time.Now(),
-	}
+	// The user, account and session are stored together or not at all
+	var createdUser *User
+	var session *Session
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		createdUser, err = s.userRepo.Create(ctx, user)
+		if err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
 
-	if _, err := s.accountRepo.Create(ctx, account); err != nil {
-		// If account creation fails, delete the user
-		_ = s.userRepo.Delete(ctx, createdUser.ID)
-		return nil, fmt.Errorf("failed to create account: %w", err)
-	}
+		// Create a local account to store the password
+		account := &Account{
+			ID:                uuid.New(),
+			UserID:            createdUser.ID,
+			Provider:          AccountProviderLocal,
+			AccountIdentifier: email,           // Use email as account ID for local auth
+			AccessToken:       &hashedPassword, // Store password hash in AccessToken temporarily
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+		}
+		if _, err := s.accountRepo.Create(ctx, account); err != nil {
+			return fmt.Errorf("failed to create account: %w", err)
+		}
 
-	// Create a session and generate tokens
-	session, err := s.createSession(ctx, createdUser.ID, map[string]any{})
+		// Create a session and generate tokens
+		session, err = s.createSession(ctx, createdUser.ID, map[string]any{})
+		if err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
+		return nil, err
 	}
 
 	return s.generateTokens(createdUser, session)
@@ -493,23 +627,30 @@ func (s *Service) DeleteAllUserSessions(ctx context.Context, sessionID uuid.UUID
 		return fmt.Errorf("failed to find session: %w", err)
 	}
 
-	// Delete all sessions for the user
-	// Note: We need to list all sessions and filter by user ID
-	// since there's no FindByUserID method
-	sessions, _, err := s.sessionRepo.List(ctx, 1000, 0)
-	if err != nil {
-		return fmt.Errorf("failed to list sessions: %w", err)
+	// Delete all sessions for the user, a page at a time
+	opts := database.ListOptions{
+		Filter: &database.Filter{
+			Type:  database.FilterEq,
+			Field: "userID",
+			Value: session.UserID.String(),
+		},
+		Page: database.Page{Limit: database.MaxPageLimit},
 	}
+	for {
+		sessions, _, err := s.sessionRepo.List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list sessions: %w", err)
+		}
+		if len(sessions) == 0 {
+			return nil
+		}
 
-	for _, sess := range sessions {
-		if sess.UserID == session.UserID {
+		for _, sess := range sessions {
 			if err := s.sessionRepo.Delete(ctx, sess.ID); err != nil {
 				return fmt.Errorf("failed to delete session: %w", err)
 			}
 		}
 	}
-
-	return nil
 }
 
 // RequestPasswordReset initiates a password reset flow
@@ -24,16 +24,20 @@ func NewTokenManager(jwtSecret string) *TokenManager {
 type TokenClaims struct {
 	UserID    uuid.UUID `json:"user_id"`
 	SessionID uuid.UUID `json:"session_id"`
-	TokenType string    `json:"token_type"`
+	// OrganizationID is the organization the session acts in, if any.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	TokenType      string    `json:"token_type"`
 	jwt.RegisteredClaims
 }
 
-// CreateAccessToken creates a short-lived access token.
-func (tm *TokenManager) CreateAccessToken(userID, sessionID uuid.UUID) (string, error) {
+// CreateAccessToken creates a short-lived access token. A nil organization ID
+// leaves the token without an organization.
+func (tm *TokenManager) CreateAccessToken(userID, sessionID, organizationID uuid.UUID) (string, error) {
 	claims := TokenClaims{
-		UserID:    userID,
-		SessionID: sessionID,
-		TokenType: "access",
+		UserID:         userID,
+		SessionID:      sessionID,
+		OrganizationID: organizationID,
+		TokenType:      "access",
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(15 * time.Minute)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -0,0 +1,187 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CodegenConfigClient represents the enumeration of valid values for Client
+type CodegenConfigClient string
+
+// Valid Client values
+const (
+	CodegenConfigClientOrval  CodegenConfigClient = "orval"
+	CodegenConfigClientNative CodegenConfigClient = "native"
+)
+
+// String returns the string representation
+func (e CodegenConfigClient) String() string {
+	return string(e)
+}
+
+// IsValid checks if the value is valid
+func (e CodegenConfigClient) IsValid() bool {
+	switch e {
+	case CodegenConfigClientOrval:
+		return true
+	case CodegenConfigClientNative:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseCodegenConfigClient parses a string into the enum type
+func ParseCodegenConfigClient(s string) (CodegenConfigClient, error) {
+	v := CodegenConfigClient(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Client: %s", s)
+	}
+	return v, nil
+}
+
+// CodegenConfigMigrations represents the enumeration of valid values for Migrations
+type CodegenConfigMigrations string
+
+// Valid Migrations values
+const (
+	CodegenConfigMigrationsContainer CodegenConfigMigrations = "container"
+	CodegenConfigMigrationsDev       CodegenConfigMigrations = "dev"
+	CodegenConfigMigrationsOffline   CodegenConfigMigrations = "offline"
+)
+
+// String returns the string representation
+func (e CodegenConfigMigrations) String() string {
+	return string(e)
+}
+
+// IsValid checks if the value is valid
+func (e CodegenConfigMigrations) IsValid() bool {
+	switch e {
+	case CodegenConfigMigrationsContainer:
+		return true
+	case CodegenConfigMigrationsDev:
+		return true
+	case CodegenConfigMigrationsOffline:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseCodegenConfigMigrations parses a string into the enum type
+func ParseCodegenConfigMigrations(s string) (CodegenConfigMigrations, error) {
+	v := CodegenConfigMigrations(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Migrations: %s", s)
+	}
+	return v, nil
+}
+
+// CodegenConfig represents Code generation configuration
+type CodegenConfig struct {
+
+	// Client TypeScript client generator. orval runs Orval in a Docker container, native renders the client from templates without Node or Docker.
+	Client *CodegenConfigClient `json:"client,omitempty" yaml:"client,omitempty"`
+
+	// DevURL PostgreSQL database used to replay migrations when migrations is dev. Its public schema must be empty and is recreated afterwards.
+	DevURL *string `json:"devURL,omitempty" yaml:"devURL,omitempty"`
+
+	// Generators External generator plugins run after the built-in generators of the same priority
+	Generators []CodegenGeneratorConfig `json:"generators,omitempty" yaml:"generators,omitempty"`
+
+	// Migrations How the schema of the existing PostgreSQL migrations is obtained. container replays them in a Docker container, dev replays them in the database at devURL, offline reads the schema snapshot recorded with the last migration.
+	Migrations *CodegenConfigMigrations `json:"migrations,omitempty" yaml:"migrations,omitempty"`
+
+	// Templates Directory of template overrides. Files shadow the embedded templates with the same name; other files add new templates and partials.
+	Templates *string `json:"templates,omitempty" yaml:"templates,omitempty"`
+}
+
+// NewCodegenConfig creates a new immutable CodegenConfig value object.
+// Value objects are immutable and validated upon creation.
+func NewCodegenConfig(
+	client *CodegenConfigClient,
+	devURL *string,
+	generators []CodegenGeneratorConfig,
+	migrations *CodegenConfigMigrations,
+	templates *string,
+) (CodegenConfig, error) {
+	// Validate required fields
+	return CodegenConfig{
+		Client:     client,
+		DevURL:     devURL,
+		Generators: generators,
+		Migrations: migrations,
+		Templates:  templates,
+	}, nil
+}
+
+// ZeroCodegenConfig returns the zero value for CodegenConfig.
+// This is useful for comparisons and as a default value.
+func ZeroCodegenConfig() CodegenConfig {
+	return CodegenConfig{}
+}
+
+// GetClient returns the Client value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v CodegenConfig) GetClient() *CodegenConfigClient {
+	return v.Client
+}
+
+// GetDevURL returns the DevURL value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v CodegenConfig) GetDevURL() *string {
+	return v.DevURL
+}
+
+// GetGenerators returns the Generators value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v CodegenConfig) GetGenerators() []CodegenGeneratorConfig {
+	return v.Generators
+}
+
+// GetMigrations returns the Migrations value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v CodegenConfig) GetMigrations() *CodegenConfigMigrations {
+	return v.Migrations
+}
+
+// GetTemplates returns the Templates value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v CodegenConfig) GetTemplates() *string {
+	return v.Templates
+}
+
+// Validate validates the CodegenConfig value object.
+// Returns an error if any field fails validation.
+func (v CodegenConfig) Validate() error {
+	// Optional enum - validate only if present
+	if v.Client != nil && !v.Client.IsValid() {
+		return fmt.Errorf("invalid Client: %s", v.Client)
+	}
+	// Optional enum - validate only if present
+	if v.Migrations != nil && !v.Migrations.IsValid() {
+		return fmt.Errorf("invalid Migrations: %s", v.Migrations)
+	}
+	return nil
+}
+
+// IsZero returns true if this is the zero value.
+func (v CodegenConfig) IsZero() bool {
+	zero := ZeroCodegenConfig()
+	// Compare using string representation as a simple equality check
+	return v.String() == zero.String()
+}
+
+// String returns a string representation of CodegenConfig
+func (v CodegenConfig) String() string {
+	var fields []string
+	fields = append(fields, fmt.Sprintf("Client: %v", v.Client))
+	fields = append(fields, fmt.Sprintf("DevURL: %v", v.DevURL))
+	fields = append(fields, fmt.Sprintf("Generators: %v", v.Generators))
+	fields = append(fields, fmt.Sprintf("Migrations: %v", v.Migrations))
+	fields = append(fields, fmt.Sprintf("Templates: %v", v.Templates))
+	return fmt.Sprintf("CodegenConfig{%s}", strings.Join(fields, ", "))
+}
@@ -12,6 +12,7 @@ type Config struct {
 	API          *APIConfig          `json:"api,omitempty" yaml:"api,omitempty"`
 	Auth         *AuthConfig         `json:"auth,omitempty" yaml:"auth,omitempty"`
 	Billing      *BillingConfig      `json:"billing,omitempty" yaml:"billing,omitempty"`
+	Codegen      *CodegenConfig      `json:"codegen,omitempty" yaml:"codegen,omitempty"`
 	Database     *DatabaseConfig     `json:"database,omitempty" yaml:"database,omitempty"`
 	Intelligence *IntelligenceConfig `json:"intelligence,omitempty" yaml:"intelligence,omitempty"`
 	Kubernetes   *KubernetesConfig   `json:"kubernetes,omitempty" yaml:"kubernetes,omitempty"`
@@ -27,6 +28,7 @@ func NewConfig(
 	api *APIConfig,
 	auth *AuthConfig,
 	billing *BillingConfig,
+	codegen *CodegenConfig,
 	database *DatabaseConfig,
 	intelligence *IntelligenceConfig,
 	kubernetes *KubernetesConfig,
@@ -40,6 +42,7 @@ func NewConfig(
 		API:          api,
 		Auth:         auth,
 		Billing:      billing,
+		Codegen:      codegen,
 		Database:     database,
 		Intelligence: intelligence,
 		Kubernetes:   kubernetes,
@@ -74,6 +77,12 @@ func (v Config) GetBilling() *BillingConfig {
 	return v.Billing
 }
 
+// GetCodegen returns the Codegen value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v Config) GetCodegen() *CodegenConfig {
+	return v.Codegen
+}
+
 // GetDatabase returns the Database value.
 // Value objects are immutable, so this returns a copy of the value.
 func (v Config) GetDatabase() *DatabaseConfig {
@@ -135,6 +144,7 @@ func (v Config) String() string {
 	fields = append(fields, fmt.Sprintf("API: %v", v.API))
 	fields = append(fields, fmt.Sprintf("Auth: %v", v.Auth))
 	fields = append(fields, fmt.Sprintf("Billing: %v", v.Billing))
+	fields = append(fields, fmt.Sprintf("Codegen: %v", v.Codegen))
 	fields = append(fields, fmt.Sprintf("Database: %v", v.Database))
 	fields = append(fields, fmt.Sprintf("Intelligence: %v", v.Intelligence))
 	fields = append(fields, fmt.Sprintf("Kubernetes: %v", v.Kubernetes))
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor is the decoded position of a keyset page. Backward cursors select
+// the rows that come before the position.
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"i"`
+	Backward  bool      `json:"b,omitempty"`
+}
+
+// Encode returns the opaque string form of the cursor.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode.
+func DecodeCursor(s string) (Cursor, error) {
+	var c Cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: malformed cursor", ErrInvalidListOptions)
+	}
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == uuid.Nil {
+		return Cursor{}, fmt.Errorf("%w: malformed cursor", ErrInvalidListOptions)
+	}
+	return c, nil
+}
+
+// PageInfo describes a page of List results.
+type PageInfo struct {
+	// Total is the number of rows matching the filter.
+	Total int64
+	// Next and Prev are opaque cursors for the adjacent pages. They are only
+	// set with cursor pagination, and nil when there is no such page.
+	Next *string
+	Prev *string
+	// Snippets maps the IDs of the items of a search to excerpts of their
+	// searchable fields, with matched terms between SnippetStart and
+	// SnippetEnd. It is nil when the list does not search.
+	Snippets map[uuid.UUID]string
+}
+
+// PaginateCursor trims the extra row fetched by a BuildCursorQuery query,
+// restores newest-first order and computes the next and previous cursors.
+// key returns the created_at and id of an item.
+func PaginateCursor[T any](
+	query *ListQuery,
+	items []T,
+	key func(T) (time.Time, uuid.UUID),
+) ([]T, PageInfo) {
+	var info PageInfo
+
+	hasMore := len(items) > int(query.limit)
+	if hasMore {
+		items = items[:query.limit]
+	}
+	if query.backward {
+		slices.Reverse(items)
+	}
+	if len(items) == 0 {
+		return items, info
+	}
+
+	cursorAt := func(item T, backward bool) *string {
+		createdAt, id := key(item)
+		s := Cursor{CreatedAt: createdAt, ID: id, Backward: backward}.Encode()
+		return &s
+	}
+
+	first, last := items[0], items[len(items)-1]
+	if query.backward {
+		// Paging backwards always comes from a later page.
+		info.Next = cursorAt(last, false)
+		if hasMore {
+			info.Prev = cursorAt(first, true)
+		}
+	} else {
+		if hasMore {
+			info.Next = cursorAt(last, false)
+		}
+		if query.cursor != nil {
+			info.Prev = cursorAt(first, true)
+		}
+	}
+	return items, info
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/testcontainers/testcontainers-go"
 	testpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -110,6 +111,25 @@ func (d *Database) IsSQLite() bool {
 	return d.dbType == TypeSQLite
 }
 
+// Close closes the SQL connection and, for PostgreSQL, the pool it shares.
+func (d *Database) Close() error {
+	err := d.sqlDB.Close()
+	if d.pgxPool != nil {
+		d.pgxPool.Close()
+	}
+	return err
+}
+
+// openPostgres connects to the PostgreSQL database at url. Its SQL connection
+// draws from the pgx pool, so both see the same server and settings.
+func openPostgres(ctx context.Context, url string) (*Database, error) {
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PostgreSQL database: %w", err)
+	}
+	return NewDatabase(stdlib.OpenDBFromPool(pool), pool, TypePostgreSQL), nil
+}
+
 // StartPostgreSQL starts a PostgreSQL testcontainer
 func StartPostgreSQL(ctx context.Context) (*Database, *testpostgres.PostgresContainer, error) {
 
@@ -135,15 +155,12 @@ func StartPostgreSQL(ctx context.Context) (*Database, *testpostgres.PostgresCont
 		return nil, nil, fmt.Errorf("failed to get connection string: %w", err)
 	}
 
-	// Open database connection using pgx driver
-	sqlDB, err := sql.Open("pgx", connStr)
+	// Open the pgx pool and the SQL connection on top of it
+	database, err := openPostgres(ctx, connStr)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to open database connection: %w", err)
+		return nil, nil, err
 	}
 
-	// Create database.Database instance
-	database := NewDatabase(sqlDB, nil, TypePostgreSQL)
-
 	slog.Debug("PostgreSQL testcontainer started", slog.String("url", connStr))
 
 	return database, postgresContainer, nil
@@ -177,11 +194,7 @@ func Open(dbType, url string) (*Database, error) {
 		return NewDatabase(sqlDB, nil, TypeSQLite), nil
 
 	case TypePostgreSQL:
-		sqlDB, err := sql.Open("pgx", url)
-		if err != nil {
-			return nil, fmt.Errorf("failed to open PostgreSQL database: %w", err)
-		}
-		return NewDatabase(sqlDB, nil, TypePostgreSQL), nil
+		return openPostgres(context.Background(), url)
 
 	default:
 		return nil, fmt.Errorf("unsupported database type: %s", dbType)
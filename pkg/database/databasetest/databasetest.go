@@ -0,0 +1,122 @@
+// Package databasetest opens migrated databases for repository tests.
+package databasetest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/archesai/archesai/pkg/database"
+)
+
+// PostgresURLEnv names the environment variable that holds the connection
+// string of the PostgreSQL server tests run against. PostgreSQL tests are
+// skipped when it is unset.
+const PostgresURLEnv = "ARCHESAI_TEST_POSTGRES_URL"
+
+// SQLite opens a private in-memory SQLite database with foreign keys
+// enforced and applies the migrations in migrationsFS, which holds a
+// migrations directory. The database is closed when the test ends.
+func SQLite(t testing.TB, migrationsFS fs.FS) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", "file::memory:?_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed to open SQLite database: %v", err)
+	}
+	// Every connection to :memory: opens a separate database
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+
+	runner := database.NewMigrationRunner(database.NewDatabase(db, nil, database.TypeSQLite), migrationsFS)
+	if err := runner.Up(); err != nil {
+		t.Fatalf("failed to migrate SQLite database: %v", err)
+	}
+	return db
+}
+
+// Postgres creates a database on the server named by PostgresURLEnv and
+// applies the migrations in migrationsFS, which holds a migrations directory.
+// The database is dropped when the test ends.
+func Postgres(t testing.TB, migrationsFS fs.FS) *pgxpool.Pool {
+	t.Helper()
+
+	serverURL, name := createPostgresDatabase(t)
+	config, err := pgxpool.ParseConfig(serverURL)
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", PostgresURLEnv, err)
+	}
+	config.ConnConfig.Database = name
+	pool, err := pgxpool.NewWithConfig(context.Background(), config)
+	if err != nil {
+		t.Fatalf("failed to connect to database %s: %v", name, err)
+	}
+	t.Cleanup(pool.Close)
+
+	db := stdlib.OpenDBFromPool(pool)
+	runner := database.NewMigrationRunner(database.NewDatabase(db, nil, database.TypePostgreSQL), migrationsFS)
+	if err := runner.Up(); err != nil {
+		t.Fatalf("failed to migrate PostgreSQL database: %v", err)
+	}
+	return pool
+}
+
+// PostgresURL creates an empty database on the server named by
+// PostgresURLEnv, which must then be a URL, and returns its URL. The
+// database is dropped when the test ends.
+func PostgresURL(t testing.TB) string {
+	t.Helper()
+
+	serverURL, name := createPostgresDatabase(t)
+	u, err := url.Parse(serverURL)
+	if err != nil || u.Scheme == "" {
+		t.Fatalf("%s must be a URL", PostgresURLEnv)
+	}
+	u.Path = "/" + name
+	return u.String()
+}
+
+// createPostgresDatabase creates an empty database on the server named by
+// PostgresURLEnv, and returns the connection string of the server and the
+// name of the database. The database is dropped when the test ends.
+func createPostgresDatabase(t testing.TB) (string, string) {
+	t.Helper()
+
+	serverURL := os.Getenv(PostgresURLEnv)
+	if serverURL == "" {
+		t.Skipf("%s is not set", PostgresURLEnv)
+	}
+	ctx := context.Background()
+
+	config, err := pgx.ParseConfig(serverURL)
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", PostgresURLEnv, err)
+	}
+	admin, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		t.Fatalf("failed to connect to PostgreSQL: %v", err)
+	}
+
+	name := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := admin.Exec(ctx, "CREATE DATABASE "+name); err != nil {
+		_ = admin.Close(ctx)
+		t.Fatalf("failed to create database: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s WITH (FORCE)", name)); err != nil {
+			t.Errorf("failed to drop database %s: %v", name, err)
+		}
+		_ = admin.Close(ctx)
+	})
+	return serverURL, name
+}
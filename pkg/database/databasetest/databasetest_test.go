@@ -0,0 +1,56 @@
+package databasetest
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMigrations = fstest.MapFS{
+	"migrations/0000.sql": {Data: []byte(
+		"CREATE TABLE `parent` (`id` text NOT NULL, PRIMARY KEY (`id`));\n" +
+			"CREATE TABLE `child` (`id` text NOT NULL, `parent_id` text NOT NULL, PRIMARY KEY (`id`), " +
+			"CONSTRAINT `child_parent_id_fkey` FOREIGN KEY (`parent_id`) REFERENCES `parent` (`id`) ON DELETE CASCADE);\n",
+	)},
+}
+
+func TestSQLite(t *testing.T) {
+	db := SQLite(t, testMigrations)
+
+	_, err := db.Exec("INSERT INTO parent (id) VALUES ('p')")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO child (id, parent_id) VALUES ('c', 'p')")
+	require.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO child (id, parent_id) VALUES ('orphan', 'missing')")
+	assert.Error(t, err, "foreign keys are enforced")
+
+	_, err = db.Exec("DELETE FROM parent WHERE id = 'p'")
+	require.NoError(t, err)
+	var n int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM child").Scan(&n))
+	assert.Equal(t, 0, n, "deletes cascade")
+}
+
+func TestSQLiteIsPrivate(t *testing.T) {
+	a := SQLite(t, testMigrations)
+	b := SQLite(t, testMigrations)
+
+	_, err := a.Exec("INSERT INTO parent (id) VALUES ('p')")
+	require.NoError(t, err)
+	var n int
+	require.NoError(t, b.QueryRow("SELECT COUNT(*) FROM parent").Scan(&n))
+	assert.Equal(t, 0, n)
+}
+
+func TestPostgres(t *testing.T) {
+	pool := Postgres(t, fstest.MapFS{
+		"migrations/0000.sql": {Data: []byte(`CREATE TABLE "public"."item" ("id" uuid NOT NULL, PRIMARY KEY ("id"));`)},
+	})
+
+	var n int
+	require.NoError(t, pool.QueryRow(t.Context(), `SELECT COUNT(*) FROM "item"`).Scan(&n))
+	assert.Equal(t, 0, n)
+}
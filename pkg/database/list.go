@@ -0,0 +1,258 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ErrInvalidListOptions is returned when filter, sort or page input cannot be applied.
+var ErrInvalidListOptions = errors.New("invalid list options")
+
+// Pagination defaults mirror the Page schema in the server API.
+const (
+	DefaultPageLimit int32 = 10
+	MaxPageLimit     int32 = 100
+)
+
+// FilterOp is the operation of a filter node.
+type FilterOp string
+
+// Filter operations supported by list queries.
+const (
+	FilterAnd        FilterOp = "and"
+	FilterOr         FilterOp = "or"
+	FilterEq         FilterOp = "eq"
+	FilterNe         FilterOp = "ne"
+	FilterGt         FilterOp = "gt"
+	FilterGte        FilterOp = "gte"
+	FilterLt         FilterOp = "lt"
+	FilterLte        FilterOp = "lte"
+	FilterContains   FilterOp = "contains"
+	FilterStartsWith FilterOp = "startsWith"
+	FilterEndsWith   FilterOp = "endsWith"
+)
+
+// IsGroup reports whether the operation combines child filters.
+func (op FilterOp) IsGroup() bool {
+	return op == FilterAnd || op == FilterOr
+}
+
+// Filter is a node of a filter tree. Group nodes (and, or) hold Children,
+// leaf nodes compare Field against Value.
+type Filter struct {
+	Type     FilterOp
+	Field    string
+	Value    any
+	Children []Filter
+}
+
+// SortOrder is the direction of a sort.
+type SortOrder string
+
+// Sort orders.
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// Sort orders results by a single field.
+type Sort struct {
+	Field string
+	Order SortOrder
+}
+
+// Page limits the window of results returned. Offset applies to offset
+// pagination, Cursor to keyset pagination.
+type Page struct {
+	Limit  int32
+	Offset int32
+	Cursor string
+}
+
+// DeletedScope selects rows by their deleted_at column on tables that soft
+// delete. The zero value ignores the column.
+type DeletedScope int
+
+// Deleted scopes.
+const (
+	DeletedAny DeletedScope = iota
+	DeletedExclude
+	DeletedOnly
+)
+
+// ListOptions carries the filter, search, sort and page inputs of a List
+// call. Deleted is set by soft-deleting repositories, Tenant by tenant-scoped
+// repositories, SearchIndex by searchable repositories and Select by
+// repositories that scan rows by position rather than by callers.
+type ListOptions struct {
+	Filter      *Filter
+	Search      string
+	Sort        []Sort
+	Page        Page
+	Deleted     DeletedScope
+	Tenant      *TenantScope
+	SearchIndex *SearchIndex
+	// Select lists the columns of the rows, in order. All columns of the
+	// table are selected when it is empty.
+	Select []string
+}
+
+// NewListOptions builds ListOptions from the filter, page and sort inputs of a
+// generated List handler. Any of the inputs may be nil.
+func NewListOptions(
+	filter map[string]any,
+	page map[string]any,
+	sort []map[string]any,
+) (ListOptions, error) {
+	var opts ListOptions
+	var err error
+
+	if opts.Filter, err = ParseFilter(filter); err != nil {
+		return ListOptions{}, err
+	}
+	if opts.Page, err = ParsePage(page); err != nil {
+		return ListOptions{}, err
+	}
+	if opts.Sort, err = ParseSort(sort); err != nil {
+		return ListOptions{}, err
+	}
+	return opts, nil
+}
+
+// Where narrows the filter of the options to rows whose field equals value,
+// on top of any filter the caller gave.
+func (o *ListOptions) Where(field string, value any) {
+	eq := Filter{Type: FilterEq, Field: field, Value: value}
+	if o.Filter == nil {
+		o.Filter = &eq
+		return
+	}
+	o.Filter = &Filter{Type: FilterAnd, Children: []Filter{*o.Filter, eq}}
+}
+
+// ParseFilter converts a decoded filter parameter into a Filter tree.
+func ParseFilter(m map[string]any) (*Filter, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	f, err := parseFilterNode(m)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func parseFilterNode(m map[string]any) (Filter, error) {
+	opValue, _ := m["type"].(string)
+	op := FilterOp(opValue)
+	switch op {
+	case FilterAnd, FilterOr:
+		children, ok := m["children"].([]any)
+		if !ok || len(children) == 0 {
+			return Filter{}, fmt.Errorf("%w: %s filter requires children", ErrInvalidListOptions, op)
+		}
+		f := Filter{Type: op, Children: make([]Filter, 0, len(children))}
+		for _, child := range children {
+			cm, ok := child.(map[string]any)
+			if !ok {
+				return Filter{}, fmt.Errorf("%w: malformed %s filter", ErrInvalidListOptions, op)
+			}
+			c, err := parseFilterNode(cm)
+			if err != nil {
+				return Filter{}, err
+			}
+			f.Children = append(f.Children, c)
+		}
+		return f, nil
+	case FilterEq, FilterNe, FilterGt, FilterGte, FilterLt, FilterLte,
+		FilterContains, FilterStartsWith, FilterEndsWith:
+		field, _ := m["field"].(string)
+		if field == "" {
+			return Filter{}, fmt.Errorf("%w: %s filter requires a field", ErrInvalidListOptions, op)
+		}
+		value, ok := m["value"]
+		if !ok {
+			return Filter{}, fmt.Errorf("%w: %s filter requires a value", ErrInvalidListOptions, op)
+		}
+		return Filter{Type: op, Field: field, Value: value}, nil
+	default:
+		return Filter{}, fmt.Errorf("%w: unknown filter type %q", ErrInvalidListOptions, opValue)
+	}
+}
+
+// ParsePage converts a decoded page parameter into a Page, applying the
+// default and maximum limit. The cursor is decoded when the query is built.
+func ParsePage(m map[string]any) (Page, error) {
+	page := Page{Limit: DefaultPageLimit}
+
+	if v, ok := m["limit"]; ok {
+		limit, err := toInt32(v)
+		if err != nil || limit < 1 || limit > MaxPageLimit {
+			return Page{}, fmt.Errorf(
+				"%w: page limit must be between 1 and %d",
+				ErrInvalidListOptions,
+				MaxPageLimit,
+			)
+		}
+		page.Limit = limit
+	}
+	if v, ok := m["offset"]; ok {
+		offset, err := toInt32(v)
+		if err != nil || offset < 0 {
+			return Page{}, fmt.Errorf("%w: page offset must not be negative", ErrInvalidListOptions)
+		}
+		page.Offset = offset
+	}
+	if v, ok := m["cursor"].(string); ok {
+		page.Cursor = v
+	}
+	return page, nil
+}
+
+// ParseSort converts a decoded sort parameter into Sort entries.
+func ParseSort(items []map[string]any) ([]Sort, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	sorts := make([]Sort, 0, len(items))
+	for _, item := range items {
+		field, _ := item["field"].(string)
+		if field == "" {
+			return nil, fmt.Errorf("%w: sort requires a field", ErrInvalidListOptions)
+		}
+		order := SortAsc
+		if v, ok := item["order"].(string); ok && v != "" {
+			order = SortOrder(v)
+		}
+		if order != SortAsc && order != SortDesc {
+			return nil, fmt.Errorf("%w: invalid sort order %q", ErrInvalidListOptions, order)
+		}
+		sorts = append(sorts, Sort{Field: field, Order: order})
+	}
+	return sorts, nil
+}
+
+func toInt32(v any) (int32, error) {
+	var n int64
+	switch x := v.(type) {
+	case int32:
+		return x, nil
+	case int:
+		n = int64(x)
+	case int64:
+		n = x
+	case float64:
+		n = int64(x)
+	case string:
+		i, err := strconv.ParseInt(x, 10, 32)
+		return int32(i), err
+	default:
+		return 0, fmt.Errorf("unsupported number %T", v)
+	}
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return 0, fmt.Errorf("number %d out of range", n)
+	}
+	return int32(n), nil
+}
@@ -3,23 +3,58 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"ariga.io/atlas/sql/migrate"
 	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
 	"ariga.io/atlas/sql/sqlite"
 
 	_ "github.com/jackc/pgx/v5/stdlib" // pgx driver
 	_ "modernc.org/sqlite"             // sqlite driver
 )
 
+const (
+	// downSuffix ends the name of the file that reverts the migration of the
+	// same name ending in ".sql".
+	downSuffix = ".down.sql"
+
+	// migrationLockName is the PostgreSQL advisory lock held while migrating,
+	// so that replicas starting at once apply each migration once.
+	migrationLockName    = "archesai_migrate"
+	migrationLockTimeout = 5 * time.Minute
+)
+
+// migrationFileRegex matches migration file names such as "0003.gen.sql" or
+// "0004_backfill_slugs.sql" and captures their version number.
+var migrationFileRegex = regexp.MustCompile(`^(\d+)(?:_[^.]*)?\.(?:gen\.)?sql$`)
+
+// MigrationStatus describes a migration file and whether it was applied.
+type MigrationStatus struct {
+	Version    string
+	Name       string    // Name of the migration file
+	Applied    bool      // All statements were applied
+	Error      string    // Error of the last execution, if it failed
+	ExecutedAt time.Time // Zero if never executed
+	Reversible bool      // Has a down migration
+}
+
 // MigrationRunner handles database migrations.
 type MigrationRunner struct {
 	db           *Database
-	migrationsFS fs.FS // Required migrations filesystem
+	migrationsFS fs.FS     // Required migrations filesystem
+	dryRun       io.Writer // Receives the SQL instead of the database, if set
 }
 
 // NewMigrationRunner creates a new migration runner.
@@ -30,14 +65,190 @@ func NewMigrationRunner(db *Database, migrationsFS fs.FS) *MigrationRunner {
 	}
 }
 
+// WithDryRun makes Up, Down and To write the SQL they would execute to w
+// instead of executing it.
+func (m *MigrationRunner) WithDryRun(w io.Writer) *MigrationRunner {
+	m.dryRun = w
+	return m
+}
+
+// migrationSet is the content of the migrations directory.
+type migrationSet struct {
+	dir   *migrate.MemDir
+	files []migrate.File
+	down  map[string][]byte // Down migrations by the name of their migration
+}
+
+// downFor returns the down migration of f, or nil if it has none.
+func (s *migrationSet) downFor(f migrate.File) []byte {
+	return s.down[f.Name()]
+}
+
+// fileFor returns the migration file of version.
+func (s *migrationSet) fileFor(version string) (migrate.File, bool) {
+	for _, f := range s.files {
+		if f.Version() == version {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
 // Up applies all pending migrations.
 func (m *MigrationRunner) Up() error {
 	ctx := context.Background()
+	return m.run(ctx, func(drv migrate.Driver, set *migrationSet) error {
+		ex, err := m.executor(drv, set)
+		if err != nil {
+			return err
+		}
+		if m.dryRun != nil {
+			pending, err := ex.Pending(ctx)
+			if err != nil && !errors.Is(err, migrate.ErrNoPendingFiles) {
+				return err
+			}
+			return m.printFiles(pending)
+		}
+		// Execute all pending migrations
+		if err := ex.ExecuteN(ctx, 0); err != nil && !errors.Is(err, migrate.ErrNoPendingFiles) {
+			return fmt.Errorf("failed to apply migrations: %w", err)
+		}
+		return nil
+	})
+}
+
+// Down reverts the last applied migration with its down migration.
+func (m *MigrationRunner) Down() error {
+	ctx := context.Background()
+	return m.run(ctx, func(drv migrate.Driver, set *migrationSet) error {
+		revs, err := m.revisions().ReadRevisions(ctx)
+		if err != nil {
+			return err
+		}
+		if len(revs) == 0 {
+			return errors.New("no migrations to revert")
+		}
+		return m.revert(ctx, drv, set, revs[len(revs)-1])
+	})
+}
+
+// To migrates up or down to version, which is either the version of a
+// migration file, such as "0003.gen", or its number.
+func (m *MigrationRunner) To(version string) error {
+	ctx := context.Background()
+	return m.run(ctx, func(drv migrate.Driver, set *migrationSet) error {
+		target, err := resolveVersion(set, version)
+		if err != nil {
+			return err
+		}
+		revs, err := m.revisions().ReadRevisions(ctx)
+		if err != nil {
+			return err
+		}
+		if !slices.ContainsFunc(revs, func(r *migrate.Revision) bool { return r.Version == target.Version() }) {
+			ex, err := m.executor(drv, set)
+			if err != nil {
+				return err
+			}
+			if m.dryRun != nil {
+				pending, err := ex.Pending(ctx)
+				if err != nil {
+					return err
+				}
+				idx := migrate.FilesLastIndex(pending, func(f migrate.File) bool { return f.Version() == target.Version() })
+				return m.printFiles(pending[:idx+1])
+			}
+			if err := ex.ExecuteTo(ctx, target.Version()); err != nil {
+				return fmt.Errorf("failed to apply migrations: %w", err)
+			}
+			return nil
+		}
+		for i := len(revs) - 1; i >= 0 && revs[i].Version > target.Version(); i-- {
+			if err := m.revert(ctx, drv, set, revs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Status returns every migration file and applied revision, in order.
+func (m *MigrationRunner) Status() ([]MigrationStatus, error) {
+	set, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+	revs, err := m.revisions().ReadRevisions(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[string]*migrate.Revision, len(revs))
+	for _, r := range revs {
+		byVersion[r.Version] = r
+	}
+
+	var statuses []MigrationStatus
+	for _, f := range set.files {
+		s := MigrationStatus{Version: f.Version(), Name: f.Name(), Reversible: set.downFor(f) != nil}
+		if r, ok := byVersion[f.Version()]; ok {
+			s.Applied = r.Applied == r.Total && r.Error == ""
+			s.Error = r.Error
+			s.ExecutedAt = r.ExecutedAt
+			delete(byVersion, f.Version())
+		}
+		statuses = append(statuses, s)
+	}
+	// Revisions whose file was deleted
+	for _, r := range revs {
+		if _, ok := byVersion[r.Version]; ok {
+			statuses = append(statuses, MigrationStatus{
+				Version:    r.Version,
+				Applied:    r.Applied == r.Total && r.Error == "",
+				Error:      r.Error,
+				ExecutedAt: r.ExecutedAt,
+			})
+		}
+	}
+	return statuses, nil
+}
+
+// Version returns the current migration version from Atlas's revision table.
+func (m *MigrationRunner) Version() (int64, error) {
+	var version string
+	err := m.db.sqlDB.QueryRow(
+		"SELECT version FROM atlas_schema_revisions ORDER BY executed_at DESC LIMIT 1",
+	).Scan(&version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil // No migrations applied yet
+		}
+		return 0, fmt.Errorf("failed to get version: %w", err)
+	}
+
+	// Atlas versions are strings (timestamps), we'll return 1 if any migrations exist
+	if version != "" {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// run loads the migrations and calls fn with an Atlas driver. Unless this
+// is a dry run, fn runs under the migration lock on PostgreSQL. SQLite has
+// no such lock, so processes sharing a database file must not migrate it at
+// the same time.
+func (m *MigrationRunner) run(ctx context.Context, fn func(migrate.Driver, *migrationSet) error) error {
+	set, err := m.load()
+	if err != nil {
+		return err
+	}
+	// If no migration files exist, nothing to do
+	if len(set.files) == 0 {
+		slog.Debug("No migrations to apply")
+		return nil
+	}
 
 	// Create Atlas driver based on database type
 	var driver migrate.Driver
-	var err error
-
 	switch m.db.dbType {
 	case TypePostgreSQL:
 		driver, err = postgres.Open(m.db.sqlDB)
@@ -55,95 +266,249 @@ func (m *MigrationRunner) Up() error {
 		return fmt.Errorf("unsupported database type: %s", m.db.dbType)
 	}
 
-	// Create migration directory from provided FS
-	// The migrations are expected to be in a "migrations" subdirectory
+	if locker, ok := driver.(schema.Locker); ok && m.dryRun == nil && m.db.dbType == TypePostgreSQL {
+		unlock, err := locker.Lock(ctx, migrationLockName, migrationLockTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		defer func() {
+			if err := unlock(); err != nil {
+				slog.Warn("Failed to release migration lock", slog.String("error", err.Error()))
+			}
+		}()
+	}
+	return fn(driver, set)
+}
+
+// load reads the migrations and down migrations in the "migrations"
+// subdirectory of the runner's filesystem.
+func (m *MigrationRunner) load() (*migrationSet, error) {
 	migrationsFS, err := fs.Sub(m.migrationsFS, "migrations")
 	if err != nil {
-		return fmt.Errorf("failed to create sub filesystem: %w", err)
+		return nil, fmt.Errorf("failed to create sub filesystem: %w", err)
 	}
 
 	// Create memory-based migration directory
-	dir := &migrate.MemDir{}
+	set := &migrationSet{dir: &migrate.MemDir{}, down: make(map[string][]byte)}
 
-	// Read all migration files from embed.FS and add to MemDir
 	entries, err := fs.ReadDir(migrationsFS, ".")
 	if err != nil {
-		return fmt.Errorf("failed to read migrations: %w", err)
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
 	}
 
+	var sum []byte
 	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+		if entry.IsDir() {
 			continue
 		}
-		content, err := fs.ReadFile(migrationsFS, entry.Name())
+		name := entry.Name()
+		if name != migrate.HashFileName && !strings.HasSuffix(name, ".sql") {
+			continue
+		}
+		content, err := fs.ReadFile(migrationsFS, name)
 		if err != nil {
-			return fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+			return nil, fmt.Errorf("failed to read migration %s: %w", name, err)
 		}
-		if err := dir.WriteFile(entry.Name(), content); err != nil {
-			return fmt.Errorf("failed to write migration %s: %w", entry.Name(), err)
+		switch {
+		case name == migrate.HashFileName:
+			sum = content
+		case strings.HasSuffix(name, downSuffix):
+			set.down[strings.TrimSuffix(name, downSuffix)+".sql"] = content
+		default:
+			if err := set.dir.WriteFile(name, content); err != nil {
+				return nil, fmt.Errorf("failed to write migration %s: %w", name, err)
+			}
 		}
 	}
 
-	// Generate checksum file (atlas.sum) for the migration directory
-	// This is required for Atlas to validate the migration files
-	files, err := dir.Files()
-	if err != nil {
-		return fmt.Errorf("failed to get migration files: %w", err)
+	if set.files, err = set.dir.Files(); err != nil {
+		return nil, fmt.Errorf("failed to get migration files: %w", err)
 	}
 
-	// If no migration files exist, nothing to do
-	if len(files) == 0 {
-		slog.Debug("No migrations to apply")
-		return nil
+	// Atlas validates the files against their checksum file (atlas.sum).
+	// Directories without one are trusted as they are.
+	if sum == nil {
+		hashFile, err := migrate.NewHashFile(set.files)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create hash file: %w", err)
+		}
+		if sum, err = hashFile.MarshalText(); err != nil {
+			return nil, fmt.Errorf("failed to encode hash file: %w", err)
+		}
+	}
+	if err := set.dir.WriteFile(migrate.HashFileName, sum); err != nil {
+		return nil, fmt.Errorf("failed to write sum file: %w", err)
+	}
+	if err := migrate.Validate(set.dir); err != nil {
+		return nil, fmt.Errorf("migrations do not match %s, run `archesai db migrate hash` after editing them: %w", migrate.HashFileName, err)
 	}
+	return set, nil
+}
 
-	hashFile, err := migrate.NewHashFile(files)
+func (m *MigrationRunner) revisions() *revisionStore {
+	return m.revisionsIn(m.db.sqlDB)
+}
+
+// revisionsIn returns the revision store of the runner's database that reads
+// and writes through conn.
+func (m *MigrationRunner) revisionsIn(conn SQLQuerier) *revisionStore {
+	return &revisionStore{db: conn, dbType: m.db.dbType}
+}
+
+func (m *MigrationRunner) executor(drv migrate.Driver, set *migrationSet) (*migrate.Executor, error) {
+	ex, err := migrate.NewExecutor(drv, set.dir, m.revisions())
 	if err != nil {
-		return fmt.Errorf("failed to create hash file: %w", err)
-	}
-	if err := migrate.WriteSumFile(dir, hashFile); err != nil {
-		return fmt.Errorf("failed to write sum file: %w", err)
+		return nil, fmt.Errorf("failed to create executor: %w", err)
 	}
+	return ex, nil
+}
 
-	// Create executor with NopRevisionReadWriter for one-time migration replay
-	// This is suitable for applying migrations without tracking revision history
-	executor, err := migrate.NewExecutor(driver, dir, migrate.NopRevisionReadWriter{})
+// revert executes the down migration of the revision and deletes it.
+// PostgreSQL runs the down migration and the deletion in one transaction, so
+// a failed revert leaves the revision applied. SQLite cannot, as its table
+// rebuilds toggle foreign keys, and deletes the revision once all statements
+// ran. Statements are split by the driver, which keeps the bodies of SQLite
+// triggers whole.
+func (m *MigrationRunner) revert(ctx context.Context, drv migrate.Driver, set *migrationSet, rev *migrate.Revision) error {
+	if rev.Applied != rev.Total || rev.Error != "" {
+		return fmt.Errorf(
+			"migration %s is partially applied (%d of %d statements), repair the database by hand and delete its row from %s",
+			rev.Version, rev.Applied, rev.Total, RevisionsTable,
+		)
+	}
+	f, ok := set.fileFor(rev.Version)
+	if !ok {
+		return fmt.Errorf("migration %s is applied but its file is missing", rev.Version)
+	}
+	down := set.downFor(f)
+	if down == nil {
+		return fmt.Errorf("migration %s has no down migration %s", rev.Version, strings.TrimSuffix(f.Name(), ".sql")+downSuffix)
+	}
+	stmts, err := migrate.FileStmts(drv, migrate.NewLocalFile(f.Name(), down))
 	if err != nil {
-		return fmt.Errorf("failed to create executor: %w", err)
+		return fmt.Errorf("failed to parse down migration of %s: %w", rev.Version, err)
 	}
 
-	// Execute all pending migrations
-	if err := executor.ExecuteN(ctx, 0); err != nil {
-		return fmt.Errorf("failed to apply migrations: %w", err)
+	if m.dryRun != nil {
+		_, err := fmt.Fprintf(m.dryRun, "-- revert %s\n%s\n", f.Name(), strings.Join(stmts, "\n"))
+		return err
 	}
 
+	var conn SQLQuerier = m.db.sqlDB
+	var tx *sql.Tx
+	if m.db.dbType == TypePostgreSQL {
+		if tx, err = m.db.sqlDB.BeginTx(ctx, nil); err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		defer func() { _ = tx.Rollback() }()
+		conn = tx
+	}
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to revert %s: %s: %w", rev.Version, stmt, err)
+		}
+	}
+	if err := m.revisionsIn(conn).DeleteRevision(ctx, rev.Version); err != nil {
+		return err
+	}
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to revert %s: %w", rev.Version, err)
+		}
+	}
+	slog.Info("Migration reverted", slog.String("version", rev.Version))
 	return nil
 }
 
-// Down is not supported in Atlas native migrations.
-// Atlas uses a "roll-forward" approach instead of rollback.
-func (m *MigrationRunner) Down() error {
-	return fmt.Errorf(
-		"down migrations not supported with Atlas - use roll-forward migrations instead",
-	)
+func (m *MigrationRunner) printFiles(files []migrate.File) error {
+	for _, f := range files {
+		if _, err := fmt.Fprintf(m.dryRun, "-- apply %s\n%s\n", f.Name(), strings.TrimSpace(string(f.Bytes()))); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// Version returns the current migration version from Atlas's revision table.
-func (m *MigrationRunner) Version() (int64, error) {
-	var version string
-	err := m.db.sqlDB.QueryRow(
-		"SELECT version FROM atlas_schema_revisions ORDER BY executed_at DESC LIMIT 1",
-	).Scan(&version)
+// resolveVersion returns the migration file of version, which is either its
+// Atlas version or its number.
+func resolveVersion(set *migrationSet, version string) (migrate.File, error) {
+	if f, ok := set.fileFor(version); ok {
+		return f, nil
+	}
+	if n, err := strconv.Atoi(version); err == nil {
+		for _, f := range set.files {
+			if v, ok := migrationNumber(f.Name()); ok && v == n {
+				return f, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("migration %s not found", version)
+}
+
+// migrationNumber returns the version number of a migration file name.
+func migrationNumber(name string) (int, bool) {
+	matches := migrationFileRegex.FindStringSubmatch(name)
+	if len(matches) != 2 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(matches[1])
+	return n, err == nil
+}
+
+// NextMigrationVersion returns the version number following the migrations
+// in dir, or 0 if there are none.
+func NextMigrationVersion(dir string) int {
+	entries, err := os.ReadDir(dir)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return 0, nil // No migrations applied yet
+		return 0
+	}
+	next := 0
+	for _, entry := range entries {
+		if v, ok := migrationNumber(entry.Name()); ok && !entry.IsDir() && v >= next {
+			next = v + 1
 		}
-		return 0, fmt.Errorf("failed to get version: %w", err)
 	}
+	return next
+}
 
-	// Atlas versions are strings (timestamps), we'll return 1 if any migrations exist
-	if version != "" {
-		return 1, nil
+// CreateMigration writes an empty migration named after description, and
+// its down migration, to dir. It returns the path of both files.
+func CreateMigration(dir, description string) (string, string, error) {
+	if description == "" || strings.ContainsAny(description, "./\\ ") {
+		return "", "", fmt.Errorf("invalid migration name %q, use letters, digits and underscores", description)
 	}
-	return 0, nil
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", "", fmt.Errorf("failed to create migration directory: %w", err)
+	}
+	base := fmt.Sprintf("%04d_%s", NextMigrationVersion(dir), description)
+	up := filepath.Join(dir, base+".sql")
+	down := filepath.Join(dir, base+downSuffix)
+	if err := os.WriteFile(up, []byte("-- "+description+"\n"), 0644); err != nil {
+		return "", "", fmt.Errorf("failed to write migration: %w", err)
+	}
+	if err := os.WriteFile(down, []byte("-- revert "+description+"\n"), 0644); err != nil {
+		return "", "", fmt.Errorf("failed to write down migration: %w", err)
+	}
+	return up, down, nil
+}
+
+// WriteMigrationSum records the checksums of the migrations in dir in its
+// atlas.sum file. The runner refuses migrations that no longer match it.
+func WriteMigrationSum(dir string) error {
+	local, err := migrate.NewLocalDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open migration directory: %w", err)
+	}
+	files, err := local.Files()
+	if err != nil {
+		return fmt.Errorf("failed to read migrations: %w", err)
+	}
+	files = slices.DeleteFunc(files, func(f migrate.File) bool {
+		return strings.HasSuffix(f.Name(), downSuffix)
+	})
+	hashFile, err := migrate.NewHashFile(files)
+	if err != nil {
+		return fmt.Errorf("failed to hash migrations: %w", err)
+	}
+	return migrate.WriteSumFile(local, hashFile)
 }
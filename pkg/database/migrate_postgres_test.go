@@ -0,0 +1,52 @@
+package database_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/database/databasetest"
+)
+
+// A down migration that fails on PostgreSQL leaves the migration applied:
+// its statements and the deletion of its revision are rolled back together.
+func TestMigrationRunnerRevertPostgres(t *testing.T) {
+	migrations := fstest.MapFS{
+		"migrations/0000.gen.sql": {Data: []byte("CREATE TABLE todo (id text PRIMARY KEY);\n")},
+		"migrations/0001.gen.sql": {Data: []byte("ALTER TABLE todo ADD COLUMN title text;\n")},
+		"migrations/0001.gen.down.sql": {Data: []byte(
+			"ALTER TABLE todo DROP COLUMN title;\n" +
+				"ALTER TABLE missing DROP COLUMN title;\n",
+		)},
+	}
+	pool := databasetest.Postgres(t, migrations)
+	db := database.NewDatabase(stdlib.OpenDBFromPool(pool), nil, database.TypePostgreSQL)
+	applied := func(runner *database.MigrationRunner) []string {
+		t.Helper()
+		statuses, err := runner.Status()
+		require.NoError(t, err)
+		var versions []string
+		for _, s := range statuses {
+			if s.Applied {
+				versions = append(versions, s.Version)
+			}
+		}
+		return versions
+	}
+
+	runner := database.NewMigrationRunner(db, migrations)
+	require.ErrorContains(t, runner.Down(), "failed to revert 0001.gen")
+	assert.Equal(t, []string{"0000.gen", "0001.gen"}, applied(runner))
+	_, err := db.SQLDB().Exec("INSERT INTO todo (id, title) VALUES ('1', 'first')")
+	require.NoError(t, err, "title column was kept")
+
+	// A working down migration reverts the migration and deletes its revision
+	migrations["migrations/0001.gen.down.sql"] = &fstest.MapFile{Data: []byte("ALTER TABLE todo DROP COLUMN title;\n")}
+	runner = database.NewMigrationRunner(db, migrations)
+	require.NoError(t, runner.Down())
+	assert.Equal(t, []string{"0000.gen"}, applied(runner))
+}
@@ -0,0 +1,141 @@
+package database
+
+import (
+	"bytes"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMigrations() fstest.MapFS {
+	return fstest.MapFS{
+		"migrations/0000.gen.sql":      {Data: []byte("CREATE TABLE todo (id text PRIMARY KEY);\n")},
+		"migrations/0000.gen.down.sql": {Data: []byte("DROP TABLE todo;\n")},
+		"migrations/0001.gen.sql":      {Data: []byte("ALTER TABLE todo ADD COLUMN title text;\n")},
+		"migrations/0001.gen.down.sql": {Data: []byte("ALTER TABLE todo DROP COLUMN title;\n")},
+		"migrations/0002_seed.sql":     {Data: []byte("INSERT INTO todo (id, title) VALUES ('1', 'first');\n")},
+	}
+}
+
+func openTestSQLite(t *testing.T) *Database {
+	t.Helper()
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	return NewDatabase(db, nil, TypeSQLite)
+}
+
+func appliedVersions(t *testing.T, runner *MigrationRunner) []string {
+	t.Helper()
+	statuses, err := runner.Status()
+	require.NoError(t, err)
+	var versions []string
+	for _, s := range statuses {
+		if s.Applied {
+			versions = append(versions, s.Version)
+		}
+	}
+	return versions
+}
+
+func TestMigrationRunner(t *testing.T) {
+	db := openTestSQLite(t)
+	runner := NewMigrationRunner(db, testMigrations())
+
+	require.NoError(t, runner.Up())
+	assert.Equal(t, []string{"0000.gen", "0001.gen", "0002"}, appliedVersions(t, runner))
+	require.NoError(t, runner.Up(), "nothing pending")
+
+	err := runner.Down()
+	require.ErrorContains(t, err, "migration 0002 has no down migration 0002_seed.down.sql")
+
+	require.NoError(t, runner.To("2"), "already at 0002")
+	require.ErrorContains(t, runner.To("0001.gen"), "migration 0002 has no down migration")
+
+	// Without the seed migration, 0001 can be reverted
+	migrations := testMigrations()
+	delete(migrations, "migrations/0002_seed.sql")
+	runner = NewMigrationRunner(db, migrations)
+	_, err = db.SQLDB().Exec("DELETE FROM " + RevisionsTable + " WHERE version = '0002'")
+	require.NoError(t, err)
+
+	require.NoError(t, runner.Down())
+	assert.Equal(t, []string{"0000.gen"}, appliedVersions(t, runner))
+	_, err = db.SQLDB().Exec("INSERT INTO todo (id, title) VALUES ('1', 'first')")
+	require.Error(t, err, "title column was dropped")
+
+	require.NoError(t, runner.To("1"))
+	assert.Equal(t, []string{"0000.gen", "0001.gen"}, appliedVersions(t, runner))
+	require.NoError(t, runner.To("0"))
+	assert.Equal(t, []string{"0000.gen"}, appliedVersions(t, runner))
+	require.ErrorContains(t, runner.To("7"), "migration 7 not found")
+}
+
+func TestMigrationRunnerRevertsTriggers(t *testing.T) {
+	db := openTestSQLite(t)
+	migrations := fstest.MapFS{
+		"migrations/0000.gen.sql": {Data: []byte("CREATE TABLE todo (id text PRIMARY KEY, title text);\n")},
+		"migrations/0001.gen.sql": {Data: []byte("ALTER TABLE todo DROP COLUMN title;\n")},
+		"migrations/0001.gen.down.sql": {Data: []byte(
+			"ALTER TABLE todo ADD COLUMN title text;\n" +
+				"CREATE TRIGGER todo_ai AFTER INSERT ON todo BEGIN\n" +
+				"  UPDATE todo SET title = 'untitled' WHERE id = new.id AND title IS NULL;\n" +
+				"END;\n",
+		)},
+	}
+	runner := NewMigrationRunner(db, migrations)
+
+	require.NoError(t, runner.Up())
+	require.NoError(t, runner.Down())
+
+	_, err := db.SQLDB().Exec("INSERT INTO todo (id) VALUES ('1')")
+	require.NoError(t, err)
+	var title string
+	require.NoError(t, db.SQLDB().QueryRow("SELECT title FROM todo").Scan(&title))
+	assert.Equal(t, "untitled", title)
+}
+
+func TestMigrationRunnerDryRun(t *testing.T) {
+	db := openTestSQLite(t)
+	var out bytes.Buffer
+	runner := NewMigrationRunner(db, testMigrations()).WithDryRun(&out)
+
+	require.NoError(t, runner.To("1"))
+	assert.Equal(t, `-- apply 0000.gen.sql
+CREATE TABLE todo (id text PRIMARY KEY);
+-- apply 0001.gen.sql
+ALTER TABLE todo ADD COLUMN title text;
+`, out.String())
+	assert.Empty(t, appliedVersions(t, runner))
+
+	var tables int
+	require.NoError(t, db.SQLDB().QueryRow("SELECT count(*) FROM sqlite_master WHERE type = 'table'").Scan(&tables))
+	assert.Zero(t, tables, "dry runs leave the database untouched")
+}
+
+func TestMigrationSum(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "migrations")
+	up, down, err := CreateMigration(dir, "create_todo")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "0000_create_todo.sql"), up)
+	assert.Equal(t, filepath.Join(dir, "0000_create_todo.down.sql"), down)
+	require.NoError(t, os.WriteFile(up, []byte("CREATE TABLE todo (id text PRIMARY KEY);\n"), 0644))
+	require.NoError(t, WriteMigrationSum(dir))
+	assert.Equal(t, 1, NextMigrationVersion(dir))
+
+	runner := NewMigrationRunner(openTestSQLite(t), os.DirFS(root))
+	require.NoError(t, runner.WithDryRun(&bytes.Buffer{}).Up())
+
+	require.NoError(t, os.WriteFile(up, []byte("CREATE TABLE todos (id text PRIMARY KEY);\n"), 0644))
+	require.ErrorContains(t, runner.Up(), "migrations do not match atlas.sum")
+
+	_, _, err = CreateMigration(dir, "bad name")
+	require.Error(t, err)
+}
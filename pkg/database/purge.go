@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Purger permanently removes soft-deleted rows. The repositories of entities
+// with soft delete implement it.
+type Purger interface {
+	// Purge removes the rows deleted before the given time and returns how
+	// many were removed.
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// PurgeConfig controls how the PurgeJob removes soft-deleted rows.
+type PurgeConfig struct {
+	// Retention is how long deleted rows can be restored before they are
+	// purged.
+	Retention time.Duration
+
+	// Interval is the time between purges.
+	Interval time.Duration
+}
+
+// DefaultPurgeConfig keeps deleted rows for 30 days and purges every hour.
+func DefaultPurgeConfig() PurgeConfig {
+	return PurgeConfig{
+		Retention: 30 * 24 * time.Hour,
+		Interval:  time.Hour,
+	}
+}
+
+// PurgeJob periodically removes the rows that were soft-deleted longer ago
+// than the retention, in all organizations. Purged rows cannot be restored.
+type PurgeJob struct {
+	purgers []Purger
+	config  PurgeConfig
+}
+
+// NewPurgeJob creates a job that purges the rows of the given repositories.
+func NewPurgeJob(config PurgeConfig, purgers ...Purger) *PurgeJob {
+	return &PurgeJob{
+		purgers: purgers,
+		config:  config,
+	}
+}
+
+// Run purges every interval until the context is done.
+func (j *PurgeJob) Run(ctx context.Context) error {
+	ticker := time.NewTicker(j.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.Purge(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("purge job error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge removes the rows deleted before the retention of every repository
+// and returns how many were removed. A failing repository does not stop the
+// others.
+func (j *PurgeJob) Purge(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-j.config.Retention)
+
+	var total int64
+	var errs []error
+	for _, p := range j.purgers {
+		n, err := p.Purge(ctx, before)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		total += n
+	}
+	if total > 0 {
+		slog.Info("purged deleted rows", "count", total, "before", before)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return total, fmt.Errorf("failed to purge deleted rows: %w", err)
+	}
+	return total, nil
+}
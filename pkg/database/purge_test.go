@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type purgerFunc func(ctx context.Context, before time.Time) (int64, error)
+
+func (f purgerFunc) Purge(ctx context.Context, before time.Time) (int64, error) {
+	return f(ctx, before)
+}
+
+func TestPurgeJob(t *testing.T) {
+	var cutoffs []time.Time
+	purged := func(n int64, err error) Purger {
+		return purgerFunc(func(_ context.Context, before time.Time) (int64, error) {
+			cutoffs = append(cutoffs, before)
+			return n, err
+		})
+	}
+
+	tests := []struct {
+		name    string
+		purgers []Purger
+		want    int64
+		wantErr string
+	}{
+		{
+			name: "no repositories",
+		},
+		{
+			name:    "sums the purged rows",
+			purgers: []Purger{purged(2, nil), purged(3, nil)},
+			want:    5,
+		},
+		{
+			name:    "a failing repository does not stop the others",
+			purgers: []Purger{purged(0, errors.New("locked")), purged(3, nil)},
+			want:    3,
+			wantErr: "failed to purge deleted rows: locked",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cutoffs = nil
+			job := NewPurgeJob(DefaultPurgeConfig(), tt.purgers...)
+
+			start := time.Now()
+			got, err := job.Purge(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			require.Len(t, cutoffs, len(tt.purgers))
+			for _, before := range cutoffs {
+				assert.WithinDuration(t, start.Add(-30*24*time.Hour), before, time.Minute)
+			}
+		})
+	}
+}
@@ -0,0 +1,443 @@
+package database
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ColumnKind describes how filter values are coerced for a column.
+type ColumnKind int
+
+// Column kinds.
+const (
+	ColumnString ColumnKind = iota
+	ColumnInteger
+	ColumnNumber
+	ColumnBool
+	ColumnUUID
+	ColumnTime
+)
+
+// Column describes a filterable and sortable database column.
+type Column struct {
+	Name string
+	Kind ColumnKind
+}
+
+// Columns maps API field names to database columns. Only fields present in
+// the map may be referenced by filters and sorts.
+type Columns map[string]Column
+
+// SQLiteTimeLayout is the layout used to store timestamps in SQLite TEXT
+// columns. It is fixed width so values compare correctly as strings.
+const SQLiteTimeLayout = "2006-01-02 15:04:05.000000000"
+
+// ListQuery is a SELECT statement built from ListOptions together with the
+// matching COUNT statement. When the options search, SnippetSQL selects the
+// id and snippet of each row of the page, for CollectSnippets.
+type ListQuery struct {
+	SQL         string
+	Args        []any
+	CountSQL    string
+	CountArgs   []any
+	SnippetSQL  string
+	SnippetArgs []any
+
+	// Keyset pagination state, set by BuildCursorQuery.
+	limit    int32
+	cursor   *Cursor
+	backward bool
+}
+
+// BuildListQuery renders the SELECT and COUNT statements for opts against
+// table using offset pagination. Field names are resolved through columns,
+// so user input never reaches the SQL text.
+func BuildListQuery(dialect Type, table string, columns Columns, opts ListOptions) (*ListQuery, error) {
+	if opts.Page.Cursor != "" {
+		return nil, fmt.Errorf("%w: cursor pagination is not enabled", ErrInvalidListOptions)
+	}
+
+	b := &queryBuilder{dialect: dialect, columns: columns}
+	search, err := b.search(table, opts)
+	if err != nil {
+		return nil, err
+	}
+	where, err := b.where(opts)
+	if err != nil {
+		return nil, err
+	}
+	var rank string
+	if search != nil {
+		where = andWhere(where, search.cond)
+		rank = search.rank
+	}
+	orderBy, err := b.orderBy(opts.Sort, rank)
+	if err != nil {
+		return nil, err
+	}
+
+	page := opts.Page
+	if page.Limit <= 0 {
+		page.Limit = DefaultPageLimit
+	}
+
+	selectAll, from := "SELECT "+selectList(table, opts.Select), " FROM "+quoteIdent(table)
+	if search != nil && search.join != "" {
+		if len(opts.Select) == 0 {
+			selectAll = "SELECT " + quoteIdent(table) + ".*"
+		}
+		from += search.join
+	}
+	countArgs := append([]any(nil), b.args...)
+	limit := b.bind(page.Limit)
+	offset := b.bind(page.Offset)
+	tail := where + " ORDER BY " + orderBy + " LIMIT " + limit + " OFFSET " + offset
+
+	query := &ListQuery{
+		SQL:       selectAll + from + tail,
+		Args:      b.args,
+		CountSQL:  "SELECT COUNT(*)" + from + where,
+		CountArgs: countArgs,
+	}
+	if search != nil {
+		// The snippet join binds the same terms at the same position as the
+		// join it replaces, so both statements share their arguments.
+		snippetFrom := " FROM " + quoteIdent(table) + search.snippetJoin
+		query.SnippetSQL = "SELECT " + quoteIdent(table) + "." + quoteIdent("id") + ", " + search.snippet +
+			snippetFrom + tail
+		query.SnippetArgs = b.args
+	}
+	return query, nil
+}
+
+// BuildCursorQuery renders the SELECT and COUNT statements for opts against
+// table using keyset pagination on (created_at, id), newest first. The
+// SELECT fetches one extra row so PaginateCursor can tell whether another
+// page follows. Sorting and offsets are not supported in this mode.
+func BuildCursorQuery(dialect Type, table string, columns Columns, opts ListOptions) (*ListQuery, error) {
+	if len(opts.Sort) > 0 {
+		return nil, fmt.Errorf("%w: sort is not supported with cursor pagination", ErrInvalidListOptions)
+	}
+	if opts.Page.Offset > 0 {
+		return nil, fmt.Errorf("%w: offset is not supported with cursor pagination", ErrInvalidListOptions)
+	}
+	if len(searchTerms(opts.Search)) > 0 {
+		return nil, fmt.Errorf("%w: search is not supported with cursor pagination", ErrInvalidListOptions)
+	}
+
+	var cursor *Cursor
+	if opts.Page.Cursor != "" {
+		c, err := DecodeCursor(opts.Page.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = &c
+	}
+
+	b := &queryBuilder{dialect: dialect, columns: columns}
+	where, err := b.where(opts)
+	if err != nil {
+		return nil, err
+	}
+	countArgs := append([]any(nil), b.args...)
+	countWhere := where
+
+	order := "DESC"
+	if cursor != nil {
+		op := "<"
+		if cursor.Backward {
+			op, order = ">", "ASC"
+		}
+		// Placeholders are bound per use since SQLite's "?" cannot repeat.
+		keyset := fmt.Sprintf(
+			`("created_at" %s %s OR ("created_at" = %s AND "id" %s %s))`,
+			op, b.bind(b.timeValue(cursor.CreatedAt)),
+			b.bind(b.timeValue(cursor.CreatedAt)), op, b.bind(b.uuidValue(cursor.ID)),
+		)
+		where = andWhere(where, keyset)
+	}
+
+	page := opts.Page
+	if page.Limit <= 0 {
+		page.Limit = DefaultPageLimit
+	}
+	from := " FROM " + quoteIdent(table)
+	limit := b.bind(page.Limit + 1)
+
+	return &ListQuery{
+		SQL: "SELECT " + selectList(table, opts.Select) + from + where +
+			` ORDER BY "created_at" ` + order + `, "id" ` + order + " LIMIT " + limit,
+		Args:      b.args,
+		CountSQL:  "SELECT COUNT(*)" + from + countWhere,
+		CountArgs: countArgs,
+		limit:     page.Limit,
+		cursor:    cursor,
+		backward:  cursor != nil && cursor.Backward,
+	}, nil
+}
+
+// selectList returns the columns of table selected by a list query, qualified
+// with the table name since searches join other tables.
+func selectList(table string, columns []string) string {
+	if len(columns) == 0 {
+		return "*"
+	}
+	qualified := make([]string, len(columns))
+	for i, col := range columns {
+		qualified[i] = quoteIdent(table) + "." + quoteIdent(col)
+	}
+	return strings.Join(qualified, ", ")
+}
+
+type queryBuilder struct {
+	dialect Type
+	columns Columns
+	args    []any
+}
+
+// bind records a parameter and returns its placeholder.
+func (b *queryBuilder) bind(v any) string {
+	b.args = append(b.args, v)
+	if b.dialect == TypeSQLite {
+		return "?"
+	}
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+func (b *queryBuilder) where(opts ListOptions) (string, error) {
+	var conds []string
+	if opts.Filter != nil {
+		cond, err := b.condition(*opts.Filter)
+		if err != nil {
+			return "", err
+		}
+		conds = append(conds, cond)
+	}
+	if opts.Tenant != nil {
+		conds = append(conds, quoteIdent(opts.Tenant.Column)+" = "+b.bind(b.uuidValue(opts.Tenant.ID)))
+	}
+	switch opts.Deleted {
+	case DeletedExclude:
+		conds = append(conds, quoteIdent("deleted_at")+" IS NULL")
+	case DeletedOnly:
+		conds = append(conds, quoteIdent("deleted_at")+" IS NOT NULL")
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), nil
+}
+
+// andWhere adds cond to a WHERE clause rendered by where.
+func andWhere(where, cond string) string {
+	switch {
+	case cond == "":
+		return where
+	case where == "":
+		return " WHERE " + cond
+	default:
+		return where + " AND " + cond
+	}
+}
+
+func (b *queryBuilder) timeValue(t time.Time) any {
+	if b.dialect == TypeSQLite {
+		return t.UTC().Format(SQLiteTimeLayout)
+	}
+	return t
+}
+
+func (b *queryBuilder) uuidValue(id uuid.UUID) any {
+	if b.dialect == TypeSQLite {
+		return id.String()
+	}
+	return id
+}
+
+func (b *queryBuilder) column(field string) (Column, error) {
+	col, ok := b.columns[field]
+	if !ok {
+		return Column{}, fmt.Errorf("%w: unknown field %q", ErrInvalidListOptions, field)
+	}
+	return col, nil
+}
+
+func (b *queryBuilder) condition(f Filter) (string, error) {
+	if f.Type.IsGroup() {
+		// An empty group would render as "()", which is not a condition
+		if len(f.Children) == 0 {
+			return "", fmt.Errorf("%w: %s filter requires children", ErrInvalidListOptions, f.Type)
+		}
+		parts := make([]string, 0, len(f.Children))
+		for _, child := range f.Children {
+			part, err := b.condition(child)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		sep := " AND "
+		if f.Type == FilterOr {
+			sep = " OR "
+		}
+		return "(" + strings.Join(parts, sep) + ")", nil
+	}
+
+	col, err := b.column(f.Field)
+	if err != nil {
+		return "", err
+	}
+	ident := quoteIdent(col.Name)
+
+	switch f.Type {
+	case FilterContains, FilterStartsWith, FilterEndsWith:
+		if col.Kind != ColumnString {
+			return "", fmt.Errorf(
+				"%w: %s is not supported on field %q",
+				ErrInvalidListOptions,
+				f.Type,
+				f.Field,
+			)
+		}
+		pattern := escapeLike(fmt.Sprint(f.Value))
+		switch f.Type {
+		case FilterContains:
+			pattern = "%" + pattern + "%"
+		case FilterStartsWith:
+			pattern += "%"
+		default:
+			pattern = "%" + pattern
+		}
+		like := "ILIKE"
+		if b.dialect == TypeSQLite {
+			like = "LIKE"
+		}
+		return ident + " " + like + " " + b.bind(pattern) + ` ESCAPE '\'`, nil
+	}
+
+	value, err := b.coerce(col, f.Value)
+	if err != nil {
+		return "", fmt.Errorf("%w: field %q: %w", ErrInvalidListOptions, f.Field, err)
+	}
+
+	var op string
+	switch f.Type {
+	case FilterEq:
+		op = "="
+	case FilterNe:
+		op = "<>"
+	case FilterGt:
+		op = ">"
+	case FilterGte:
+		op = ">="
+	case FilterLt:
+		op = "<"
+	case FilterLte:
+		op = "<="
+	default:
+		return "", fmt.Errorf("%w: unknown filter type %q", ErrInvalidListOptions, f.Type)
+	}
+	return ident + " " + op + " " + b.bind(value), nil
+}
+
+// coerce converts a decoded filter value to the Go type the driver expects
+// for the column.
+func (b *queryBuilder) coerce(col Column, v any) (any, error) {
+	s, isString := v.(string)
+
+	switch col.Kind {
+	case ColumnInteger:
+		if isString {
+			return strconv.ParseInt(s, 10, 64)
+		}
+		switch n := v.(type) {
+		case int, int32, int64:
+			return n, nil
+		case float64:
+			return int64(n), nil
+		}
+	case ColumnNumber:
+		if isString {
+			return strconv.ParseFloat(s, 64)
+		}
+		switch n := v.(type) {
+		case int, int32, int64, float64:
+			return n, nil
+		}
+	case ColumnBool:
+		if isString {
+			return strconv.ParseBool(s)
+		}
+		if bv, ok := v.(bool); ok {
+			return bv, nil
+		}
+	case ColumnUUID:
+		if isString {
+			id, err := uuid.Parse(s)
+			if err != nil {
+				return nil, err
+			}
+			return b.uuidValue(id), nil
+		}
+	case ColumnTime:
+		if isString {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, err
+			}
+			return b.timeValue(t), nil
+		}
+	default:
+		return fmt.Sprint(v), nil
+	}
+	return nil, fmt.Errorf("unsupported value %v", v)
+}
+
+// orderBy renders the ORDER BY clause of sorts. Without sorts, rows are
+// ordered by rank, when searching, and then newest first.
+func (b *queryBuilder) orderBy(sorts []Sort, rank string) (string, error) {
+	if len(sorts) == 0 {
+		order := quoteIdent("created_at") + " DESC, " + quoteIdent("id") + " DESC"
+		if rank != "" {
+			order = rank + ", " + order
+		}
+		return order, nil
+	}
+
+	parts := make([]string, 0, len(sorts)+1)
+	hasID := false
+	for _, s := range sorts {
+		col, err := b.column(s.Field)
+		if err != nil {
+			return "", err
+		}
+		if col.Name == "id" {
+			hasID = true
+		}
+		parts = append(parts, quoteIdent(col.Name)+" "+sortKeyword(s.Order))
+	}
+	// Break ties on the primary key so pages are stable.
+	if !hasID {
+		parts = append(parts, quoteIdent("id")+" "+sortKeyword(sorts[len(sorts)-1].Order))
+	}
+	return strings.Join(parts, ", "), nil
+}
+
+func sortKeyword(order SortOrder) string {
+	if order == SortDesc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
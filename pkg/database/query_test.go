@@ -0,0 +1,304 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testColumns = Columns{
+	"id":        {Name: "id", Kind: ColumnUUID},
+	"createdAt": {Name: "created_at", Kind: ColumnTime},
+	"name":      {Name: "name", Kind: ColumnString},
+	"active":    {Name: "active", Kind: ColumnBool},
+}
+
+func TestBuildListQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   Type
+		opts      ListOptions
+		wantSQL   string
+		wantCount string
+		wantArgs  []any
+		wantErr   bool
+	}{
+		{
+			name:      "defaults",
+			dialect:   TypePostgreSQL,
+			opts:      ListOptions{},
+			wantSQL:   `SELECT * FROM "item" ORDER BY "created_at" DESC, "id" DESC LIMIT $1 OFFSET $2`,
+			wantCount: `SELECT COUNT(*) FROM "item"`,
+			wantArgs:  []any{DefaultPageLimit, int32(0)},
+		},
+		{
+			name:    "filter group with sort and page",
+			dialect: TypePostgreSQL,
+			opts: ListOptions{
+				Filter: &Filter{Type: FilterOr, Children: []Filter{
+					{Type: FilterEq, Field: "active", Value: "true"},
+					{Type: FilterStartsWith, Field: "name", Value: "a_b"},
+				}},
+				Sort: []Sort{{Field: "name", Order: SortAsc}},
+				Page: Page{Limit: 5, Offset: 10},
+			},
+			wantSQL: `SELECT * FROM "item" WHERE ("active" = $1 OR "name" ILIKE $2 ESCAPE '\') ` +
+				`ORDER BY "name" ASC, "id" ASC LIMIT $3 OFFSET $4`,
+			wantCount: `SELECT COUNT(*) FROM "item" WHERE ("active" = $1 OR "name" ILIKE $2 ESCAPE '\')`,
+			wantArgs:  []any{true, `a\_b%`, int32(5), int32(10)},
+		},
+		{
+			name:    "sqlite placeholders",
+			dialect: TypeSQLite,
+			opts: ListOptions{
+				Filter: &Filter{Type: FilterGte, Field: "createdAt", Value: "2025-01-02T03:04:05Z"},
+				Page:   Page{Limit: 1},
+			},
+			wantSQL: `SELECT * FROM "item" WHERE "created_at" >= ? ` +
+				`ORDER BY "created_at" DESC, "id" DESC LIMIT ? OFFSET ?`,
+			wantCount: `SELECT COUNT(*) FROM "item" WHERE "created_at" >= ?`,
+			wantArgs:  []any{"2025-01-02 03:04:05.000000000", int32(1), int32(0)},
+		},
+		{
+			name:    "excludes deleted rows",
+			dialect: TypePostgreSQL,
+			opts: ListOptions{
+				Filter:  &Filter{Type: FilterEq, Field: "name", Value: "x"},
+				Deleted: DeletedExclude,
+			},
+			wantSQL: `SELECT * FROM "item" WHERE "name" = $1 AND "deleted_at" IS NULL ` +
+				`ORDER BY "created_at" DESC, "id" DESC LIMIT $2 OFFSET $3`,
+			wantCount: `SELECT COUNT(*) FROM "item" WHERE "name" = $1 AND "deleted_at" IS NULL`,
+			wantArgs:  []any{"x", DefaultPageLimit, int32(0)},
+		},
+		{
+			name:    "only deleted rows",
+			dialect: TypeSQLite,
+			opts:    ListOptions{Deleted: DeletedOnly},
+			wantSQL: `SELECT * FROM "item" WHERE "deleted_at" IS NOT NULL ` +
+				`ORDER BY "created_at" DESC, "id" DESC LIMIT ? OFFSET ?`,
+			wantCount: `SELECT COUNT(*) FROM "item" WHERE "deleted_at" IS NOT NULL`,
+			wantArgs:  []any{DefaultPageLimit, int32(0)},
+		},
+		{
+			name:    "scoped to a tenant",
+			dialect: TypeSQLite,
+			opts: ListOptions{
+				Filter: &Filter{Type: FilterOr, Children: []Filter{
+					{Type: FilterEq, Field: "name", Value: "x"},
+					{Type: FilterEq, Field: "name", Value: "y"},
+				}},
+				Tenant: &TenantScope{
+					Column: "organization_id",
+					ID:     uuid.MustParse("6f1c2a4e-8d3b-4c5a-9e7f-0a1b2c3d4e5f"),
+				},
+			},
+			wantSQL: `SELECT * FROM "item" WHERE ("name" = ? OR "name" = ?) AND "organization_id" = ? ` +
+				`ORDER BY "created_at" DESC, "id" DESC LIMIT ? OFFSET ?`,
+			wantCount: `SELECT COUNT(*) FROM "item" WHERE ("name" = ? OR "name" = ?) AND "organization_id" = ?`,
+			wantArgs: []any{
+				"x", "y", "6f1c2a4e-8d3b-4c5a-9e7f-0a1b2c3d4e5f",
+				DefaultPageLimit, int32(0),
+			},
+		},
+		{
+			name:    "search ranked by relevance",
+			dialect: TypePostgreSQL,
+			opts: ListOptions{
+				Filter:      &Filter{Type: FilterEq, Field: "active", Value: true},
+				Search:      "quick fox",
+				SearchIndex: &SearchIndex{Columns: []string{"name"}},
+			},
+			wantSQL: `SELECT * FROM "item" WHERE "active" = $2 AND ` +
+				`"search_vector" @@ websearch_to_tsquery('english', $1) ` +
+				`ORDER BY ts_rank("search_vector", websearch_to_tsquery('english', $1)) DESC, ` +
+				`"created_at" DESC, "id" DESC LIMIT $3 OFFSET $4`,
+			wantCount: `SELECT COUNT(*) FROM "item" WHERE "active" = $2 AND ` +
+				`"search_vector" @@ websearch_to_tsquery('english', $1)`,
+			wantArgs: []any{"quick fox", true, DefaultPageLimit, int32(0)},
+		},
+		{
+			name:    "sqlite search joins the fts table",
+			dialect: TypeSQLite,
+			opts: ListOptions{
+				Search:      `quick "brown fox" OR`,
+				SearchIndex: &SearchIndex{Columns: []string{"name"}},
+				Sort:        []Sort{{Field: "name", Order: SortAsc}},
+				Deleted:     DeletedExclude,
+			},
+			wantSQL: `SELECT "item".* FROM "item" JOIN (SELECT rowid AS "search_rowid", rank AS "search_rank" ` +
+				`FROM "item_fts" WHERE "item_fts" MATCH ?) AS "search" ON "search"."search_rowid" = "item".rowid ` +
+				`WHERE "deleted_at" IS NULL ORDER BY "name" ASC, "id" ASC LIMIT ? OFFSET ?`,
+			wantCount: `SELECT COUNT(*) FROM "item" JOIN (SELECT rowid AS "search_rowid", rank AS "search_rank" ` +
+				`FROM "item_fts" WHERE "item_fts" MATCH ?) AS "search" ON "search"."search_rowid" = "item".rowid ` +
+				`WHERE "deleted_at" IS NULL`,
+			wantArgs: []any{`"quick" "brown fox" "OR"`, DefaultPageLimit, int32(0)},
+		},
+		{
+			name:    "selected columns",
+			dialect: TypeSQLite,
+			opts: ListOptions{
+				Search:      "fox",
+				SearchIndex: &SearchIndex{Columns: []string{"name"}},
+				Select:      []string{"id", "name"},
+			},
+			wantSQL: `SELECT "item"."id", "item"."name" FROM "item" JOIN (SELECT rowid AS "search_rowid", rank AS "search_rank" ` +
+				`FROM "item_fts" WHERE "item_fts" MATCH ?) AS "search" ON "search"."search_rowid" = "item".rowid ` +
+				`ORDER BY "search"."search_rank", "created_at" DESC, "id" DESC LIMIT ? OFFSET ?`,
+			wantCount: `SELECT COUNT(*) FROM "item" JOIN (SELECT rowid AS "search_rowid", rank AS "search_rank" ` +
+				`FROM "item_fts" WHERE "item_fts" MATCH ?) AS "search" ON "search"."search_rowid" = "item".rowid`,
+			wantArgs: []any{`"fox"`, DefaultPageLimit, int32(0)},
+		},
+		{
+			name:      "blank search is ignored",
+			dialect:   TypeSQLite,
+			opts:      ListOptions{Search: ` "" `},
+			wantSQL:   `SELECT * FROM "item" ORDER BY "created_at" DESC, "id" DESC LIMIT ? OFFSET ?`,
+			wantCount: `SELECT COUNT(*) FROM "item"`,
+			wantArgs:  []any{DefaultPageLimit, int32(0)},
+		},
+		{
+			name:    "search without index",
+			dialect: TypePostgreSQL,
+			opts:    ListOptions{Search: "fox"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			dialect: TypePostgreSQL,
+			opts:    ListOptions{Filter: &Filter{Type: FilterEq, Field: "password", Value: "x"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown sort field",
+			dialect: TypePostgreSQL,
+			opts:    ListOptions{Sort: []Sort{{Field: "name; DROP TABLE item", Order: SortAsc}}},
+			wantErr: true,
+		},
+		{
+			name:    "empty filter group",
+			dialect: TypePostgreSQL,
+			opts:    ListOptions{Filter: &Filter{Type: FilterOr}},
+			wantErr: true,
+		},
+		{
+			name:    "empty nested filter group",
+			dialect: TypeSQLite,
+			opts: ListOptions{Filter: &Filter{Type: FilterAnd, Children: []Filter{
+				{Type: FilterEq, Field: "active", Value: "true"},
+				{Type: FilterOr},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:    "contains on non-string field",
+			dialect: TypePostgreSQL,
+			opts:    ListOptions{Filter: &Filter{Type: FilterContains, Field: "active", Value: "t"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := BuildListQuery(tt.dialect, "item", testColumns, tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, ErrInvalidListOptions))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, q.SQL)
+			assert.Equal(t, tt.wantCount, q.CountSQL)
+			assert.Equal(t, tt.wantArgs, q.Args)
+			assert.ElementsMatch(t, tt.wantArgs[:len(tt.wantArgs)-2], q.CountArgs)
+		})
+	}
+}
+
+func TestNewListOptions(t *testing.T) {
+	opts, err := NewListOptions(
+		map[string]any{
+			"type":     "and",
+			"children": []any{map[string]any{"type": "eq", "field": "name", "value": "x"}},
+		},
+		map[string]any{"limit": int32(20)},
+		[]map[string]any{{"field": "name", "order": "desc"}},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, opts.Filter)
+	assert.Equal(t, FilterAnd, opts.Filter.Type)
+	assert.Len(t, opts.Filter.Children, 1)
+	assert.Equal(t, Page{Limit: 20}, opts.Page)
+	assert.Equal(t, []Sort{{Field: "name", Order: SortDesc}}, opts.Sort)
+
+	_, err = NewListOptions(nil, map[string]any{"limit": int32(MaxPageLimit + 1)}, nil)
+	assert.True(t, errors.Is(err, ErrInvalidListOptions))
+
+	_, err = NewListOptions(map[string]any{"type": "like"}, nil, nil)
+	assert.True(t, errors.Is(err, ErrInvalidListOptions))
+}
+
+func TestListOptionsWhere(t *testing.T) {
+	var opts ListOptions
+	opts.Where("organizationID", "org")
+	assert.Equal(t, &Filter{Type: FilterEq, Field: "organizationID", Value: "org"}, opts.Filter)
+
+	opts = ListOptions{Filter: &Filter{Type: FilterEq, Field: "name", Value: "x"}}
+	opts.Where("organizationID", "org")
+	assert.Equal(t, &Filter{Type: FilterAnd, Children: []Filter{
+		{Type: FilterEq, Field: "name", Value: "x"},
+		{Type: FilterEq, Field: "organizationID", Value: "org"},
+	}}, opts.Filter)
+}
+
+func TestBuildCursorQuery(t *testing.T) {
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	cursor := Cursor{CreatedAt: at, ID: id}.Encode()
+
+	q, err := BuildCursorQuery(TypeSQLite, "item", testColumns, ListOptions{
+		Filter: &Filter{Type: FilterEq, Field: "active", Value: "true"},
+		Page:   Page{Limit: 2, Cursor: cursor},
+	})
+	require.NoError(t, err)
+	assert.Equal(t,
+		`SELECT * FROM "item" WHERE "active" = ? AND `+
+			`("created_at" < ? OR ("created_at" = ? AND "id" < ?)) `+
+			`ORDER BY "created_at" DESC, "id" DESC LIMIT ?`,
+		q.SQL,
+	)
+	assert.Equal(t, `SELECT COUNT(*) FROM "item" WHERE "active" = ?`, q.CountSQL)
+	assert.Equal(t, []any{
+		true, "2025-01-02 03:04:05.000000000", "2025-01-02 03:04:05.000000000", id.String(), int32(3),
+	}, q.Args)
+
+	type row struct {
+		at time.Time
+		id uuid.UUID
+	}
+	rows := []row{{at, uuid.New()}, {at, uuid.New()}, {at, uuid.New()}}
+	page, info := PaginateCursor(q, rows, func(r row) (time.Time, uuid.UUID) { return r.at, r.id })
+	assert.Len(t, page, 2)
+	require.NotNil(t, info.Next)
+	require.NotNil(t, info.Prev)
+	prev, err := DecodeCursor(*info.Prev)
+	require.NoError(t, err)
+	assert.True(t, prev.Backward)
+	assert.Equal(t, rows[0].id, prev.ID)
+
+	_, err = BuildCursorQuery(TypePostgreSQL, "item", testColumns, ListOptions{Page: Page{Cursor: "%%"}})
+	assert.True(t, errors.Is(err, ErrInvalidListOptions))
+	_, err = BuildCursorQuery(TypePostgreSQL, "item", testColumns, ListOptions{
+		Sort: []Sort{{Field: "name", Order: SortAsc}},
+	})
+	assert.True(t, errors.Is(err, ErrInvalidListOptions))
+	_, err = BuildCursorQuery(TypePostgreSQL, "item", testColumns, ListOptions{
+		Search:      "fox",
+		SearchIndex: &SearchIndex{Columns: []string{"name"}},
+	})
+	assert.True(t, errors.Is(err, ErrInvalidListOptions))
+}
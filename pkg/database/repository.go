@@ -12,5 +12,5 @@ type Repository[T any] interface {
 	Get(ctx context.Context, id uuid.UUID) (*T, error)
 	Update(ctx context.Context, id uuid.UUID, entity *T) (*T, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*T, int64, error)
+	List(ctx context.Context, opts ListOptions) ([]*T, PageInfo, error)
 }
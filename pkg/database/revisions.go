@@ -0,0 +1,197 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// RevisionsTable is the table the migration runner records applied
+// migrations in. Generated schemas never contain it.
+const RevisionsTable = "atlas_schema_revisions"
+
+// revisionStore keeps the Atlas revision history in RevisionsTable. The table
+// is created by the first migration written, so reading an unmigrated
+// database changes nothing.
+type revisionStore struct {
+	db     SQLQuerier
+	dbType Type
+}
+
+var _ migrate.RevisionReadWriter = (*revisionStore)(nil)
+
+// Ident implements migrate.RevisionReadWriter. The table lives in the schema
+// the migrations create their tables in.
+func (s *revisionStore) Ident() *migrate.TableIdent {
+	if s.dbType == TypePostgreSQL {
+		return &migrate.TableIdent{Name: RevisionsTable, Schema: "public"}
+	}
+	return &migrate.TableIdent{Name: RevisionsTable}
+}
+
+// ReadRevisions implements migrate.RevisionReadWriter.
+func (s *revisionStore) ReadRevisions(ctx context.Context) ([]*migrate.Revision, error) {
+	exists, err := s.exists(ctx)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return s.query(ctx, "")
+}
+
+// ReadRevision implements migrate.RevisionReadWriter.
+func (s *revisionStore) ReadRevision(ctx context.Context, version string) (*migrate.Revision, error) {
+	exists, err := s.exists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, migrate.ErrRevisionNotExist
+	}
+	revs, err := s.query(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	if len(revs) == 0 {
+		return nil, migrate.ErrRevisionNotExist
+	}
+	return revs[0], nil
+}
+
+// WriteRevision implements migrate.RevisionReadWriter.
+func (s *revisionStore) WriteRevision(ctx context.Context, r *migrate.Revision) error {
+	if err := s.create(ctx); err != nil {
+		return err
+	}
+	hashes, err := json.Marshal(r.PartialHashes)
+	if err != nil {
+		return fmt.Errorf("failed to encode partial hashes: %w", err)
+	}
+	b := &queryBuilder{dialect: s.dbType}
+	query := fmt.Sprintf(
+		`INSERT INTO %s (version, description, type, applied, total, executed_at, execution_time, error, error_stmt, hash, partial_hashes, operator_version)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+ON CONFLICT (version) DO UPDATE SET description = excluded.description, type = excluded.type,
+applied = excluded.applied, total = excluded.total, executed_at = excluded.executed_at,
+execution_time = excluded.execution_time, error = excluded.error, error_stmt = excluded.error_stmt,
+hash = excluded.hash, partial_hashes = excluded.partial_hashes, operator_version = excluded.operator_version`,
+		quoteIdent(RevisionsTable),
+		b.bind(r.Version), b.bind(r.Description), b.bind(int(r.Type)), b.bind(r.Applied), b.bind(r.Total),
+		b.bind(b.timeValue(r.ExecutedAt)), b.bind(int64(r.ExecutionTime)), b.bind(r.Error), b.bind(r.ErrorStmt),
+		b.bind(r.Hash), b.bind(string(hashes)), b.bind(r.OperatorVersion),
+	)
+	if _, err := s.db.ExecContext(ctx, query, b.args...); err != nil {
+		return fmt.Errorf("failed to write revision %s: %w", r.Version, err)
+	}
+	return nil
+}
+
+// DeleteRevision implements migrate.RevisionReadWriter.
+func (s *revisionStore) DeleteRevision(ctx context.Context, version string) error {
+	b := &queryBuilder{dialect: s.dbType}
+	query := fmt.Sprintf("DELETE FROM %s WHERE version = %s", quoteIdent(RevisionsTable), b.bind(version))
+	if _, err := s.db.ExecContext(ctx, query, b.args...); err != nil {
+		return fmt.Errorf("failed to delete revision %s: %w", version, err)
+	}
+	return nil
+}
+
+func (s *revisionStore) exists(ctx context.Context) (bool, error) {
+	var query string
+	if s.dbType == TypeSQLite {
+		query = "SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = '" + RevisionsTable + "'"
+	} else {
+		query = "SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = '" + RevisionsTable + "'"
+	}
+	var n int
+	if err := s.db.QueryRowContext(ctx, query).Scan(&n); err != nil {
+		return false, fmt.Errorf("failed to look up %s: %w", RevisionsTable, err)
+	}
+	return n > 0, nil
+}
+
+func (s *revisionStore) create(ctx context.Context) error {
+	timestamp := "timestamptz"
+	if s.dbType == TypeSQLite {
+		timestamp = "text"
+	}
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+  version text NOT NULL PRIMARY KEY,
+  description text NOT NULL,
+  type integer NOT NULL,
+  applied integer NOT NULL,
+  total integer NOT NULL,
+  executed_at %s NOT NULL,
+  execution_time bigint NOT NULL,
+  error text NOT NULL,
+  error_stmt text NOT NULL,
+  hash text NOT NULL,
+  partial_hashes text NOT NULL,
+  operator_version text NOT NULL
+)`, quoteIdent(RevisionsTable), timestamp))
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", RevisionsTable, err)
+	}
+	return nil
+}
+
+// query returns the revisions ordered by version, or the one with version.
+func (s *revisionStore) query(ctx context.Context, version string) ([]*migrate.Revision, error) {
+	b := &queryBuilder{dialect: s.dbType}
+	query := fmt.Sprintf(
+		"SELECT version, description, type, applied, total, executed_at, execution_time, error, error_stmt, hash, partial_hashes, operator_version FROM %s",
+		quoteIdent(RevisionsTable),
+	)
+	if version != "" {
+		query += " WHERE version = " + b.bind(version)
+	}
+	rows, err := s.db.QueryContext(ctx, query+" ORDER BY version", b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revisions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var revs []*migrate.Revision
+	for rows.Next() {
+		var (
+			r             migrate.Revision
+			typ           int
+			executedAt    any
+			executionTime int64
+			hashes        string
+		)
+		if err := rows.Scan(
+			&r.Version, &r.Description, &typ, &r.Applied, &r.Total, &executedAt, &executionTime,
+			&r.Error, &r.ErrorStmt, &r.Hash, &hashes, &r.OperatorVersion,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan revision: %w", err)
+		}
+		r.Type = migrate.RevisionType(typ)
+		r.ExecutionTime = time.Duration(executionTime)
+		if r.ExecutedAt, err = revisionTime(executedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(hashes), &r.PartialHashes); err != nil {
+			return nil, fmt.Errorf("failed to decode partial hashes of %s: %w", r.Version, err)
+		}
+		revs = append(revs, &r)
+	}
+	return revs, rows.Err()
+}
+
+// revisionTime converts an executed_at value, which SQLite stores as text.
+func revisionTime(v any) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case string:
+		return time.Parse(SQLiteTimeLayout, t)
+	case []byte:
+		return time.Parse(SQLiteTimeLayout, string(t))
+	default:
+		return time.Time{}, errors.New("unexpected executed_at value")
+	}
+}
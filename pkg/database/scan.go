@@ -0,0 +1,146 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// sqliteTimeLayouts are the layouts accepted when reading SQLite timestamps,
+// covering SQLiteTimeLayout, CURRENT_TIMESTAMP and the driver's own format.
+var sqliteTimeLayouts = []string{
+	SQLiteTimeLayout,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05",
+	time.RFC3339Nano,
+}
+
+// SQLiteTime returns a scanner that reads a SQLite TEXT timestamp into dest.
+func SQLiteTime(dest *time.Time) sql.Scanner {
+	return &timeScanner{dest: dest}
+}
+
+// SQLiteNullTime returns a scanner that reads a nullable SQLite TEXT
+// timestamp into dest.
+func SQLiteNullTime(dest **time.Time) sql.Scanner {
+	return &nullTimeScanner{dest: dest}
+}
+
+// JSONColumn returns a scanner that decodes a JSON TEXT column into dest.
+// NULL leaves dest untouched.
+func JSONColumn(dest any) sql.Scanner {
+	return &jsonScanner{dest: dest}
+}
+
+type timeScanner struct {
+	dest *time.Time
+}
+
+func (s *timeScanner) Scan(src any) error {
+	t, err := parseSQLiteTime(src)
+	if err != nil {
+		return err
+	}
+	if t != nil {
+		*s.dest = *t
+	}
+	return nil
+}
+
+type nullTimeScanner struct {
+	dest **time.Time
+}
+
+func (s *nullTimeScanner) Scan(src any) error {
+	t, err := parseSQLiteTime(src)
+	if err != nil {
+		return err
+	}
+	*s.dest = t
+	return nil
+}
+
+func parseSQLiteTime(src any) (*time.Time, error) {
+	var text string
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		return &v, nil
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return nil, fmt.Errorf("cannot scan %T into time.Time", src)
+	}
+	for _, layout := range sqliteTimeLayouts {
+		if t, err := time.ParseInLocation(layout, text, time.UTC); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot parse %q as time.Time", text)
+}
+
+type jsonScanner struct {
+	dest any
+}
+
+func (s *jsonScanner) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return json.Unmarshal([]byte(v), s.dest)
+	case []byte:
+		return json.Unmarshal(v, s.dest)
+	default:
+		return fmt.Errorf("cannot scan %T as JSON", src)
+	}
+}
+
+// SQLiteTimeValue returns t in the layout SQLite TEXT timestamps are stored in.
+func SQLiteTimeValue(t time.Time) string {
+	return t.UTC().Format(SQLiteTimeLayout)
+}
+
+// SQLiteNow returns the current time in the layout SQLite TEXT timestamps are
+// stored in. Repositories create rows with it rather than relying on
+// CURRENT_TIMESTAMP, whose layout has no fractional seconds and would not
+// compare as text with the created_at of cursors.
+func SQLiteNow() string {
+	return SQLiteTimeValue(time.Now())
+}
+
+// SQLiteNullTimeValue returns t in the layout SQLite TEXT timestamps are
+// stored in, or nil if t is nil.
+func SQLiteNullTimeValue(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return SQLiteTimeValue(*t)
+}
+
+// JSONValue returns a value that encodes v as JSON TEXT when written.
+// Nil pointers are written as NULL.
+func JSONValue(v any) driver.Valuer {
+	return jsonValuer{v: v}
+}
+
+type jsonValuer struct {
+	v any
+}
+
+func (j jsonValuer) Value() (driver.Value, error) {
+	if rv := reflect.ValueOf(j.v); !rv.IsValid() || rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, nil
+	}
+	b, err := json.Marshal(j.v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode %T as JSON: %w", j.v, err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// SearchVectorColumn is the generated tsvector column that indexes the
+// searchable fields of a table on PostgreSQL.
+const SearchVectorColumn = "search_vector"
+
+// SearchConfig is the text search configuration searchable columns are
+// parsed with on PostgreSQL.
+const SearchConfig = "english"
+
+// Markers around the matched terms of search snippets.
+const (
+	SnippetStart = "<mark>"
+	SnippetEnd   = "</mark>"
+)
+
+// The database wraps matched terms in control characters, which are replaced
+// with SnippetStart and SnippetEnd once the stored text has been escaped.
+const (
+	snippetOpen  = "\x02"
+	snippetClose = "\x03"
+)
+
+// snippetMarkers replaces the markers the database writes with the HTML ones.
+var snippetMarkers = strings.NewReplacer(snippetOpen, SnippetStart, snippetClose, SnippetEnd)
+
+// SearchIndex describes the full-text index of a searchable table.
+type SearchIndex struct {
+	// Columns are the indexed columns, in the order they are declared.
+	Columns []string
+}
+
+// SearchTable returns the name of the FTS5 table that indexes table on SQLite.
+func SearchTable(table string) string {
+	return table + "_fts"
+}
+
+// SnippetRows is satisfied by pgx.Rows and *sql.Rows.
+type SnippetRows interface {
+	Next() bool
+	Scan(dest ...any) error
+	Err() error
+}
+
+// CollectSnippets reads the rows of a ListQuery's SnippetSQL into a map from
+// item ID to snippet. Snippets are HTML: the stored text is escaped, so only
+// the SnippetStart and SnippetEnd markers are markup. The caller closes rows.
+func CollectSnippets(rows SnippetRows) (map[uuid.UUID]string, error) {
+	snippets := make(map[uuid.UUID]string)
+	for rows.Next() {
+		var id uuid.UUID
+		var snippet string
+		if err := rows.Scan(&id, &snippet); err != nil {
+			return nil, err
+		}
+		snippets[id] = snippetMarkers.Replace(html.EscapeString(snippet))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return snippets, nil
+}
+
+// searchClause holds the SQL fragments restricting a list to the rows that
+// match its search terms.
+type searchClause struct {
+	// join and snippetJoin join the FTS5 table on SQLite, the latter also
+	// selecting the snippet.
+	join        string
+	snippetJoin string
+	// cond filters the rows on PostgreSQL.
+	cond string
+	// rank orders the rows best match first.
+	rank string
+	// snippet is the expression selecting the snippet of a row.
+	snippet string
+}
+
+// search renders the search clause of opts, or nil when opts has no search
+// terms. On SQLite the terms are bound before any other argument, since the
+// join precedes the WHERE clause.
+func (b *queryBuilder) search(table string, opts ListOptions) (*searchClause, error) {
+	terms := searchTerms(opts.Search)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	if opts.SearchIndex == nil || len(opts.SearchIndex.Columns) == 0 {
+		return nil, fmt.Errorf("%w: search is not supported", ErrInvalidListOptions)
+	}
+
+	if b.dialect == TypeSQLite {
+		fts := quoteIdent(SearchTable(table))
+		match := b.bind(strings.Join(terms, " "))
+		join := func(columns string) string {
+			return ` JOIN (SELECT rowid AS "search_rowid", rank AS "search_rank"` + columns +
+				" FROM " + fts + " WHERE " + fts + " MATCH " + match +
+				`) AS "search" ON "search"."search_rowid" = ` + quoteIdent(table) + ".rowid"
+		}
+		return &searchClause{
+			join: join(""),
+			snippetJoin: join(fmt.Sprintf(
+				`, snippet(%s, -1, '%s', '%s', '…', 24) AS "search_snippet"`,
+				fts, snippetOpen, snippetClose,
+			)),
+			rank:    `"search"."search_rank"`,
+			snippet: `"search"."search_snippet"`,
+		}, nil
+	}
+
+	query := "websearch_to_tsquery('" + SearchConfig + "', " + b.bind(opts.Search) + ")"
+	columns := make([]string, len(opts.SearchIndex.Columns))
+	for i, col := range opts.SearchIndex.Columns {
+		columns[i] = quoteIdent(col)
+	}
+	vector := quoteIdent(SearchVectorColumn)
+	return &searchClause{
+		cond: vector + " @@ " + query,
+		rank: "ts_rank(" + vector + ", " + query + ") DESC",
+		snippet: fmt.Sprintf(
+			"ts_headline('%s', concat_ws(' ', %s), %s, 'StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=24, MinWords=8')",
+			SearchConfig, strings.Join(columns, ", "), query, snippetOpen, snippetClose,
+		),
+	}, nil
+}
+
+// searchTerms splits search input into words and double-quoted phrases,
+// each quoted as an FTS5 string so the input is never read as FTS5 query
+// syntax. FTS5 matches the rows that contain all of them.
+func searchTerms(s string) []string {
+	var terms []string
+	for i, part := range strings.Split(s, `"`) {
+		if i%2 == 1 {
+			if strings.TrimSpace(part) != "" {
+				terms = append(terms, `"`+part+`"`)
+			}
+			continue
+		}
+		for _, word := range strings.Fields(part) {
+			terms = append(terms, `"`+word+`"`)
+		}
+	}
+	return terms
+}
@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// searchSchema mirrors the FTS5 table and triggers generated for a
+// searchable SQLite table.
+const searchSchema = `
+CREATE TABLE "item" (
+  "id" TEXT PRIMARY KEY,
+  "created_at" TEXT NOT NULL,
+  "name" TEXT NOT NULL,
+  "description" TEXT
+);
+CREATE VIRTUAL TABLE "item_fts" USING fts5("name", "description", content='item', content_rowid='rowid', tokenize='porter unicode61');
+CREATE TRIGGER "item_fts_ai" AFTER INSERT ON "item" BEGIN
+  INSERT INTO "item_fts" (rowid, "name", "description") VALUES (new.rowid, new."name", new."description");
+END;
+CREATE TRIGGER "item_fts_ad" AFTER DELETE ON "item" BEGIN
+  INSERT INTO "item_fts" ("item_fts", rowid, "name", "description") VALUES ('delete', old.rowid, old."name", old."description");
+END;
+CREATE TRIGGER "item_fts_au" AFTER UPDATE ON "item" BEGIN
+  INSERT INTO "item_fts" ("item_fts", rowid, "name", "description") VALUES ('delete', old.rowid, old."name", old."description");
+  INSERT INTO "item_fts" (rowid, "name", "description") VALUES (new.rowid, new."name", new."description");
+END;
+`
+
+func TestSQLiteSearch(t *testing.T) {
+	ctx := context.Background()
+	db, err := StartSQLite()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.SQLDB().Close() })
+	db.SQLDB().SetMaxOpenConns(1)
+
+	_, err = db.SQLDB().ExecContext(ctx, searchSchema)
+	require.NoError(t, err)
+
+	ids := map[string]uuid.UUID{}
+	for _, item := range []struct{ name, description string }{
+		{"Invoices", "Monthly invoices exported from billing"},
+		{"Reports", "Quarterly reports mentioning invoices once"},
+		{"Photos", "Holiday pictures"},
+	} {
+		id := uuid.New()
+		ids[item.name] = id
+		_, err := db.SQLDB().ExecContext(ctx,
+			`INSERT INTO "item" ("id", "created_at", "name", "description") VALUES (?, ?, ?, ?)`,
+			id.String(), SQLiteNow(), item.name, item.description,
+		)
+		require.NoError(t, err)
+	}
+	_, err = db.SQLDB().ExecContext(ctx,
+		`UPDATE "item" SET "description" = 'Holiday <img src=x onerror=alert(1)> pictures of the invoice desk' WHERE "name" = 'Photos'`,
+	)
+	require.NoError(t, err)
+	_, err = db.SQLDB().ExecContext(ctx, `DELETE FROM "item" WHERE "name" = 'Reports'`)
+	require.NoError(t, err)
+
+	q, err := BuildListQuery(TypeSQLite, "item", testColumns, ListOptions{
+		Search:      "invoice",
+		SearchIndex: &SearchIndex{Columns: []string{"name", "description"}},
+	})
+	require.NoError(t, err)
+
+	rows, err := db.SQLDB().QueryContext(ctx, q.SQL, q.Args...)
+	require.NoError(t, err)
+	var names []string
+	for rows.Next() {
+		var id, createdAt, name string
+		var description *string
+		require.NoError(t, rows.Scan(&id, &createdAt, &name, &description))
+		names = append(names, name)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	// Stemming matches "invoices"; the best match comes first.
+	assert.Equal(t, []string{"Invoices", "Photos"}, names)
+
+	var total int64
+	require.NoError(t, db.SQLDB().QueryRowContext(ctx, q.CountSQL, q.CountArgs...).Scan(&total))
+	assert.Equal(t, int64(2), total)
+
+	rows, err = db.SQLDB().QueryContext(ctx, q.SnippetSQL, q.SnippetArgs...)
+	require.NoError(t, err)
+	snippets, err := CollectSnippets(rows)
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+	assert.Len(t, snippets, 2)
+	assert.Contains(t, snippets[ids["Invoices"]], SnippetStart+"Invoices"+SnippetEnd)
+	assert.Contains(t, snippets[ids["Photos"]], SnippetStart+"invoice"+SnippetEnd)
+	// Stored text is escaped, so only the markers are markup
+	assert.Contains(t, snippets[ids["Photos"]], "&lt;img src=x onerror=alert(1)&gt;")
+	assert.NotContains(t, snippets[ids["Photos"]], "<img")
+}
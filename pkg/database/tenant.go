@@ -0,0 +1,41 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrNoTenant is returned by the repositories of tenant-scoped entities when
+// the context carries no tenant.
+var ErrNoTenant = errors.New("no tenant in context")
+
+type tenantContextKey struct{}
+
+// WithTenant returns a context scoped to the tenant with the given ID.
+// Repositories of tenant-scoped entities only see the rows of that tenant.
+func WithTenant(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, id)
+}
+
+// TenantFromContext returns the tenant the context is scoped to, if any.
+func TenantFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(tenantContextKey{}).(uuid.UUID)
+	return id, ok && id != uuid.Nil
+}
+
+// RequireTenant returns the tenant the context is scoped to, or ErrNoTenant.
+func RequireTenant(ctx context.Context) (uuid.UUID, error) {
+	id, ok := TenantFromContext(ctx)
+	if !ok {
+		return uuid.Nil, ErrNoTenant
+	}
+	return id, nil
+}
+
+// TenantScope restricts a list to the rows of one tenant.
+type TenantScope struct {
+	Column string
+	ID     uuid.UUID
+}
@@ -0,0 +1,36 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireTenant(t *testing.T) {
+	id := uuid.New()
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    uuid.UUID
+		wantErr bool
+	}{
+		{name: "scoped", ctx: WithTenant(context.Background(), id), want: id},
+		{name: "unscoped", ctx: context.Background(), wantErr: true},
+		{name: "nil tenant", ctx: WithTenant(context.Background(), uuid.Nil), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RequireTenant(tt.ctx)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrNoTenant)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxQuerier is satisfied by *pgxpool.Pool and pgx.Tx.
+type PgxQuerier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// SQLQuerier is satisfied by *sql.DB and *sql.Tx.
+type SQLQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type pgxTxKey struct{}
+
+type sqlTxKey struct{}
+
+// sqlTxState is a database/sql transaction and the number of savepoints
+// open in it.
+type sqlTxState struct {
+	tx    *sql.Tx
+	depth int
+}
+
+// PgxTxFromContext returns the pgx transaction the context runs in.
+func PgxTxFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(pgxTxKey{}).(pgx.Tx)
+	return tx, ok
+}
+
+// SQLTxFromContext returns the database/sql transaction the context runs in.
+func SQLTxFromContext(ctx context.Context) (*sql.Tx, bool) {
+	state, ok := ctx.Value(sqlTxKey{}).(*sqlTxState)
+	if !ok {
+		return nil, false
+	}
+	return state.tx, true
+}
+
+// PgxConn returns the transaction of the context, or pool when it has none.
+func PgxConn(ctx context.Context, pool *pgxpool.Pool) PgxQuerier {
+	if tx, ok := PgxTxFromContext(ctx); ok {
+		return tx
+	}
+	return pool
+}
+
+// SQLConn returns the transaction of the context, or db when it has none.
+func SQLConn(ctx context.Context, db *sql.DB) SQLQuerier {
+	if tx, ok := SQLTxFromContext(ctx); ok {
+		return tx
+	}
+	return db
+}
+
+// RunPgxTx runs fn in a transaction of pool. The transaction is committed
+// when fn returns nil and rolled back otherwise.
+//
+// The context passed to fn carries the transaction. When ctx already carries
+// one, fn runs in a savepoint of it instead, which is released or rolled back
+// without ending the outer transaction.
+func RunPgxTx(ctx context.Context, pool *pgxpool.Pool, fn func(ctx context.Context, tx pgx.Tx) error) error {
+	var tx pgx.Tx
+	var err error
+	if outer, ok := PgxTxFromContext(ctx); ok {
+		tx, err = outer.Begin(ctx)
+	} else {
+		tx, err = pool.Begin(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(context.WithValue(ctx, pgxTxKey{}, tx), tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// RunSQLTx runs fn in a transaction of db. The transaction is committed when
+// fn returns nil and rolled back otherwise.
+//
+// The context passed to fn carries the transaction. When ctx already carries
+// one, fn runs in a savepoint of it instead, which is released or rolled back
+// without ending the outer transaction.
+func RunSQLTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	if outer, ok := ctx.Value(sqlTxKey{}).(*sqlTxState); ok {
+		return runSavepoint(ctx, outer, fn)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(context.WithValue(ctx, sqlTxKey{}, &sqlTxState{tx: tx}), tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func runSavepoint(ctx context.Context, outer *sqlTxState, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	tx := outer.tx
+	name := fmt.Sprintf("sp_%d", outer.depth+1)
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	released := false
+	defer func() {
+		if !released {
+			_, _ = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			_, _ = tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+		}
+	}()
+
+	inner := &sqlTxState{tx: tx, depth: outer.depth + 1}
+	if err := fn(context.WithValue(ctx, sqlTxKey{}, inner), tx); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	released = true
+	return nil
+}
+
+// TxManager runs units of work that span several repositories.
+//
+// Generated repositories run their queries in the transaction of the context
+// they are given, so the writes made within WithinTx commit or roll back
+// together, along with the events they recorded in the outbox.
+type TxManager struct {
+	db *Database
+}
+
+// NewTxManager creates a TxManager for db.
+func NewTxManager(db *Database) *TxManager {
+	return &TxManager{db: db}
+}
+
+// WithinTx runs fn in a transaction, which is committed when fn returns nil
+// and rolled back otherwise. Calls nested in fn run in savepoints, so a
+// failing inner unit of work undoes its own writes only. A nil manager runs
+// fn without a transaction.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m == nil {
+		return fn(ctx)
+	}
+	if m.db.IsSQLite() {
+		return RunSQLTx(ctx, m.db.SQLDB(), func(ctx context.Context, _ *sql.Tx) error {
+			return fn(ctx)
+		})
+	}
+	return RunPgxTx(ctx, m.db.PgxPool(), func(ctx context.Context, _ pgx.Tx) error {
+		return fn(ctx)
+	})
+}
@@ -0,0 +1,87 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/database/databasetest"
+)
+
+// Open builds the pgx pool PostgreSQL repositories and the TxManager use,
+// without connecting until it is first used.
+func TestOpenPostgresPool(t *testing.T) {
+	db, err := database.Open(database.TypePostgreSQL.String(), "postgres://postgres@127.0.0.1:1/archesai")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	assert.True(t, db.IsPostgreSQL())
+	assert.NotNil(t, db.PgxPool())
+}
+
+func TestTxManagerPostgres(t *testing.T) {
+	url := os.Getenv(databasetest.PostgresURLEnv)
+	if url == "" {
+		t.Skipf("%s is not set", databasetest.PostgresURLEnv)
+	}
+	db, err := database.Open(database.TypePostgreSQL.String(), url)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	ctx := context.Background()
+	table := "tx_item_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	_, err = db.PgxPool().Exec(ctx, "CREATE TABLE "+table+" (name text NOT NULL)")
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = db.PgxPool().Exec(ctx, "DROP TABLE "+table) })
+
+	insert := func(ctx context.Context, name string) error {
+		_, err := database.PgxConn(ctx, db.PgxPool()).Exec(ctx, "INSERT INTO "+table+" (name) VALUES ($1)", name)
+		return err
+	}
+	names := func() []string {
+		t.Helper()
+		rows, err := db.PgxPool().Query(ctx, "SELECT name FROM "+table+" ORDER BY name")
+		require.NoError(t, err)
+		defer rows.Close()
+		var names []string
+		for rows.Next() {
+			var name string
+			require.NoError(t, rows.Scan(&name))
+			names = append(names, name)
+		}
+		require.NoError(t, rows.Err())
+		return names
+	}
+
+	errFailed := errors.New("failed")
+	tm := database.NewTxManager(db)
+
+	// A failing unit of work leaves nothing behind
+	err = tm.WithinTx(ctx, func(ctx context.Context) error {
+		require.NoError(t, insert(ctx, "a"))
+		return errFailed
+	})
+	require.ErrorIs(t, err, errFailed)
+	assert.Empty(t, names())
+
+	// A failing nested unit of work rolls back to its savepoint
+	err = tm.WithinTx(ctx, func(ctx context.Context) error {
+		_, ok := database.PgxTxFromContext(ctx)
+		require.True(t, ok)
+		require.NoError(t, insert(ctx, "b"))
+		err := tm.WithinTx(ctx, func(ctx context.Context) error {
+			require.NoError(t, insert(ctx, "c"))
+			return errFailed
+		})
+		require.ErrorIs(t, err, errFailed)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, names())
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTxDB(t *testing.T) *Database {
+	t.Helper()
+	db, err := StartSQLite()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.SQLDB().Close() })
+	// Every connection to :memory: opens a database of its own
+	db.SQLDB().SetMaxOpenConns(1)
+
+	_, err = db.SQLDB().Exec(`CREATE TABLE item (name TEXT NOT NULL)`)
+	require.NoError(t, err)
+	return db
+}
+
+func insertItem(ctx context.Context, db *Database, name string) error {
+	_, err := SQLConn(ctx, db.SQLDB()).ExecContext(ctx, `INSERT INTO item (name) VALUES (?)`, name)
+	return err
+}
+
+func itemNames(t *testing.T, db *Database) []string {
+	t.Helper()
+	rows, err := db.SQLDB().Query(`SELECT name FROM item ORDER BY name`)
+	require.NoError(t, err)
+	defer func() { _ = rows.Close() }()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	require.NoError(t, rows.Err())
+	return names
+}
+
+func TestTxManagerWithinTx(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	t.Run("commits", func(t *testing.T) {
+		db := newTxDB(t)
+		err := NewTxManager(db).WithinTx(context.Background(), func(ctx context.Context) error {
+			_, ok := SQLTxFromContext(ctx)
+			assert.True(t, ok)
+			require.NoError(t, insertItem(ctx, db, "a"))
+			return insertItem(ctx, db, "b")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, itemNames(t, db))
+	})
+
+	t.Run("rolls back", func(t *testing.T) {
+		db := newTxDB(t)
+		err := NewTxManager(db).WithinTx(context.Background(), func(ctx context.Context) error {
+			require.NoError(t, insertItem(ctx, db, "a"))
+			return errFailed
+		})
+		require.ErrorIs(t, err, errFailed)
+		assert.Empty(t, itemNames(t, db))
+	})
+
+	t.Run("nested failure rolls back to savepoint", func(t *testing.T) {
+		db := newTxDB(t)
+		tm := NewTxManager(db)
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			require.NoError(t, insertItem(ctx, db, "a"))
+			err := tm.WithinTx(ctx, func(ctx context.Context) error {
+				require.NoError(t, insertItem(ctx, db, "b"))
+				return tm.WithinTx(ctx, func(ctx context.Context) error {
+					return insertItem(ctx, db, "c")
+				})
+			})
+			require.NoError(t, err)
+			err = tm.WithinTx(ctx, func(ctx context.Context) error {
+				require.NoError(t, insertItem(ctx, db, "d"))
+				return errFailed
+			})
+			require.ErrorIs(t, err, errFailed)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, itemNames(t, db))
+	})
+
+	t.Run("nested success rolls back with outer", func(t *testing.T) {
+		db := newTxDB(t)
+		tm := NewTxManager(db)
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			require.NoError(t, tm.WithinTx(ctx, func(ctx context.Context) error {
+				return insertItem(ctx, db, "a")
+			}))
+			return errFailed
+		})
+		require.ErrorIs(t, err, errFailed)
+		assert.Empty(t, itemNames(t, db))
+	})
+
+	t.Run("nil manager", func(t *testing.T) {
+		db := newTxDB(t)
+		var tm *TxManager
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			_, ok := SQLTxFromContext(ctx)
+			assert.False(t, ok)
+			return insertItem(ctx, db, "a")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, itemNames(t, db))
+	})
+}
@@ -0,0 +1,30 @@
+package database
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrVersionConflict is returned by Update on a versioned entity when the
+// stored row no longer has the version the caller read.
+var ErrVersionConflict = errors.New("version conflict")
+
+// FormatETag returns the strong entity tag for a row version.
+func FormatETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ParseETag returns the row version of an entity tag produced by FormatETag.
+// Weak tags and lists of tags never match a version and are rejected.
+func ParseETag(tag string) (int64, error) {
+	tag = strings.TrimSpace(tag)
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, ErrVersionConflict
+	}
+	version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil || version < 1 {
+		return 0, ErrVersionConflict
+	}
+	return version, nil
+}
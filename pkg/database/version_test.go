@@ -0,0 +1,39 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseETag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		want    int64
+		wantErr bool
+	}{
+		{name: "strong tag", tag: `"3"`, want: 3},
+		{name: "surrounding spaces", tag: ` "12" `, want: 12},
+		{name: "round trip", tag: FormatETag(42), want: 42},
+		{name: "unquoted", tag: `3`, wantErr: true},
+		{name: "weak tag", tag: `W/"3"`, wantErr: true},
+		{name: "list of tags", tag: `"3", "4"`, wantErr: true},
+		{name: "not a number", tag: `"abc"`, wantErr: true},
+		{name: "zero version", tag: `"0"`, wantErr: true},
+		{name: "empty", tag: ``, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseETag(tt.tag)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrVersionConflict)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,7 +3,11 @@
 // This package implements a centralized event system that all domains use
 // for publishing domain events. It provides:
 //   - A common Publisher interface
+//   - A Message envelope carrying the organization an event was published in
 //   - Redis-based event publishing and subscription
+//   - An in-process MemoryBus for single instance deployments
+//   - A transactional outbox that repositories write events to, and an
+//     OutboxRelay that publishes it
 //   - NoOp implementation for testing
 //
 // Each domain generates its own event types but uses this shared infrastructure
@@ -33,7 +37,9 @@ type Publisher interface {
 
 // Subscriber handles event subscriptions.
 type Subscriber interface {
-	// Subscribe to all events from a specific domain
+	// Subscribe to all events from a specific domain, or from every domain
+	// when it is empty. Handlers receive *Message values. Subscribe blocks
+	// until the context is done.
 	Subscribe(ctx context.Context, domain string, handler func(event Event) error) error
 
 	// SubscribeToType subscribes to specific event types
@@ -0,0 +1,118 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+var (
+	_ Publisher  = (*MemoryBus)(nil)
+	_ Subscriber = (*MemoryBus)(nil)
+)
+
+// memoryBufferSize is the number of messages a subscription holds before
+// Publish waits for it.
+const memoryBufferSize = 256
+
+// MemoryBus is an in-process Publisher and Subscriber, for single instance
+// deployments and tests.
+type MemoryBus struct {
+	mu   sync.RWMutex
+	subs map[*memorySubscription]struct{}
+}
+
+type memorySubscription struct {
+	domain    string
+	eventType string
+	messages  chan *Message
+	done      <-chan struct{}
+}
+
+// NewMemoryBus creates a new in-process event bus.
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{
+		subs: make(map[*memorySubscription]struct{}),
+	}
+}
+
+// Publish delivers an event to every matching subscription. It waits while a
+// subscription's buffer is full.
+func (b *MemoryBus) Publish(ctx context.Context, event Event) error {
+	msg, err := NewMessage(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for sub := range b.subs {
+		if !msg.matches(sub.domain, sub.eventType) {
+			continue
+		}
+		select {
+		case sub.messages <- msg:
+		case <-sub.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// PublishMultiple publishes multiple events in order.
+func (b *MemoryBus) PublishMultiple(ctx context.Context, events []Event) error {
+	for _, event := range events {
+		if err := b.Publish(ctx, event); err != nil {
+			return fmt.Errorf("failed to publish event %s: %w", event.EventType(), err)
+		}
+	}
+	return nil
+}
+
+// Subscribe calls the handler with every event of the domain, or of every
+// domain when it is empty, until the context is done.
+func (b *MemoryBus) Subscribe(ctx context.Context, domain string, handler func(event Event) error) error {
+	return b.SubscribeToType(ctx, domain, "", handler)
+}
+
+// SubscribeToType calls the handler with every event of the type until the
+// context is done.
+func (b *MemoryBus) SubscribeToType(
+	ctx context.Context,
+	domain string,
+	eventType string,
+	handler func(event Event) error,
+) error {
+	sub := &memorySubscription{
+		domain:    domain,
+		eventType: eventType,
+		messages:  make(chan *Message, memoryBufferSize),
+		done:      ctx.Done(),
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		delete(b.subs, sub)
+		b.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg := <-sub.messages:
+			if err := handler(msg); err != nil {
+				slog.Error("Event handler failed",
+					slog.String("type", msg.Type),
+					slog.String("id", msg.ID),
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/database"
+)
+
+type runEvent struct {
+	BaseEvent
+	RunID uuid.UUID `json:"run_id"`
+}
+
+func (e *runEvent) EventType() string { return e.Type }
+func (e *runEvent) EventData() any    { return e }
+
+func TestMemoryBus(t *testing.T) {
+	bus := NewMemoryBus()
+	orgID := uuid.New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan Event, 2)
+	go func() {
+		_ = bus.SubscribeToType(ctx, "run", "run.updated", func(event Event) error {
+			received <- event
+			return nil
+		})
+	}()
+	require.Eventually(t, func() bool {
+		bus.mu.RLock()
+		defer bus.mu.RUnlock()
+		return len(bus.subs) == 1
+	}, time.Second, time.Millisecond)
+
+	published := &runEvent{BaseEvent: NewBaseEvent("run", "run.updated"), RunID: uuid.New()}
+	pubCtx := database.WithTenant(context.Background(), orgID)
+	require.NoError(t, bus.PublishMultiple(pubCtx, []Event{
+		&runEvent{BaseEvent: NewBaseEvent("run", "run.created")},
+		published,
+	}))
+
+	var msg *Message
+	select {
+	case event := <-received:
+		var ok bool
+		msg, ok = event.(*Message)
+		require.True(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("event not received")
+	}
+	assert.Equal(t, published.ID, msg.ID)
+	assert.Equal(t, "run.updated", msg.EventType())
+	assert.Equal(t, orgID, msg.OrganizationID)
+
+	var data runEvent
+	require.NoError(t, json.Unmarshal(msg.Data, &data))
+	assert.Equal(t, published.RunID, data.RunID)
+	assert.Empty(t, received)
+}
@@ -0,0 +1,71 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/database"
+)
+
+var _ Event = (*Message)(nil)
+
+// Message is the envelope events travel in between publishers and
+// subscribers. It carries the header of the event, the organization it was
+// published in and the event itself as JSON.
+type Message struct {
+	BaseEvent
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	Data           json.RawMessage `json:"data"`
+}
+
+// NewMessage wraps an event in a message, stamped with the tenant of the
+// context. Messages are returned as they are.
+func NewMessage(ctx context.Context, event Event) (*Message, error) {
+	if msg, ok := event.(*Message); ok {
+		return msg, nil
+	}
+
+	data, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	msg := &Message{Data: data}
+	// Generated events embed BaseEvent, so their header is in the data
+	if err := json.Unmarshal(data, &msg.BaseEvent); err != nil {
+		msg.BaseEvent = BaseEvent{}
+	}
+	if msg.ID == "" {
+		msg.ID = uuid.New().String()
+	}
+	if msg.Type == "" {
+		msg.Type = event.EventType()
+	}
+	if msg.Timestamp.IsZero() {
+		msg.Timestamp = time.Now().UTC()
+	}
+	if organizationID, ok := database.TenantFromContext(ctx); ok {
+		msg.OrganizationID = organizationID
+	}
+	return msg, nil
+}
+
+// EventType returns the type of the wrapped event.
+func (m *Message) EventType() string {
+	return m.Type
+}
+
+// EventData returns the wrapped event as JSON.
+func (m *Message) EventData() any {
+	return m.Data
+}
+
+// matches reports whether the message belongs to the domain, where an empty
+// domain matches every domain.
+func (m *Message) matches(domain, eventType string) bool {
+	return (domain == "" || m.Domain == domain) && (eventType == "" || m.Type == eventType)
+}
@@ -0,0 +1,113 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/archesai/archesai/pkg/database"
+)
+
+// OutboxTable is the table repositories write pending events to. The
+// OutboxRelay publishes its rows.
+const OutboxTable = "event_outbox"
+
+// Ensure returns pending with event appended, unless pending already holds an
+// event of the same type. Repositories use it to record the lifecycle event of
+// a write alongside the events the entity accumulated itself.
+func Ensure(pending []Event, event Event) []Event {
+	for _, e := range pending {
+		if e.EventType() == event.EventType() {
+			return pending
+		}
+	}
+	return append(pending[:len(pending):len(pending)], event)
+}
+
+// outboxRow is an event as it is stored in the outbox.
+type outboxRow struct {
+	id             string
+	eventType      string
+	organizationID *uuid.UUID
+	payload        string
+	createdAt      time.Time
+}
+
+// outboxRows wraps the pending events in messages stamped with the tenant of
+// the context. The message ID is the primary key of the row, so writing the
+// same event twice stores it once.
+func outboxRows(ctx context.Context, pending []Event) ([]outboxRow, error) {
+	rows := make([]outboxRow, 0, len(pending))
+	for _, event := range pending {
+		msg, err := NewMessage(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := json.Marshal(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal event: %w", err)
+		}
+		row := outboxRow{
+			id:        msg.ID,
+			eventType: msg.Type,
+			payload:   string(payload),
+			createdAt: msg.Timestamp,
+		}
+		if msg.OrganizationID != uuid.Nil {
+			row.organizationID = &msg.OrganizationID
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// WritePgxOutbox records the pending events in the outbox within tx, so they
+// are published if and only if tx commits.
+func WritePgxOutbox(ctx context.Context, tx pgx.Tx, pending []Event) error {
+	rows, err := outboxRows(ctx, pending)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO "`+OutboxTable+`" (id, event_type, organization_id, payload, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (id) DO NOTHING`,
+			row.id, row.eventType, row.organizationID, row.payload, row.createdAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to write event to outbox: %w", err)
+		}
+	}
+	return nil
+}
+
+// WriteSQLOutbox records the pending events in the outbox of a SQLite
+// database within tx, so they are published if and only if tx commits.
+func WriteSQLOutbox(ctx context.Context, tx *sql.Tx, pending []Event) error {
+	rows, err := outboxRows(ctx, pending)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		var organizationID any
+		if row.organizationID != nil {
+			organizationID = row.organizationID.String()
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO "`+OutboxTable+`" (id, event_type, organization_id, payload, created_at)
+			VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (id) DO NOTHING`,
+			row.id, row.eventType, organizationID, row.payload, database.SQLiteTimeValue(row.createdAt),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to write event to outbox: %w", err)
+		}
+	}
+	return nil
+}
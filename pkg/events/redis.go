@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -24,11 +25,15 @@ func NewRedisPublisher(client *redis.Client) Publisher {
 	}
 }
 
-// Publish sends a domain event to Redis.
+// Publish sends a domain event to Redis, wrapped in a Message.
 func (p *RedisPublisher) Publish(ctx context.Context, event Event) error {
+	msg, err := NewMessage(ctx, event)
+	if err != nil {
+		return err
+	}
 
 	// Marshal event to JSON
-	data, err := json.Marshal(event)
+	data, err := json.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
@@ -60,3 +65,85 @@ func (p *RedisPublisher) PublishMultiple(
 	}
 	return nil
 }
+
+var _ Subscriber = (*RedisSubscriber)(nil)
+
+// RedisSubscriber implements Subscriber using Redis pub/sub. It receives the
+// messages published by RedisPublisher.
+type RedisSubscriber struct {
+	client  *redis.Client
+	channel string
+}
+
+// NewRedisSubscriber creates a new Redis event subscriber with default channel.
+func NewRedisSubscriber(client *redis.Client) Subscriber {
+	return &RedisSubscriber{
+		client:  client,
+		channel: "events",
+	}
+}
+
+// Subscribe calls the handler with every event of the domain, or of every
+// domain when it is empty, until the context is done.
+func (s *RedisSubscriber) Subscribe(
+	ctx context.Context,
+	domain string,
+	handler func(event Event) error,
+) error {
+	return s.receive(ctx, s.channel, domain, "", handler)
+}
+
+// SubscribeToType calls the handler with every event of the type until the
+// context is done.
+func (s *RedisSubscriber) SubscribeToType(
+	ctx context.Context,
+	domain string,
+	eventType string,
+	handler func(event Event) error,
+) error {
+	typeChannel := fmt.Sprintf("%s:%s", s.channel, eventType)
+	return s.receive(ctx, typeChannel, domain, eventType, handler)
+}
+
+func (s *RedisSubscriber) receive(
+	ctx context.Context,
+	channel string,
+	domain string,
+	eventType string,
+	handler func(event Event) error,
+) error {
+	pubsub := s.client.Subscribe(ctx, channel)
+	defer func() { _ = pubsub.Close() }()
+
+	// Wait for the subscription so no message published after we return is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", channel, err)
+	}
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case redisMsg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			var msg Message
+			if err := json.Unmarshal([]byte(redisMsg.Payload), &msg); err != nil {
+				slog.Warn("Dropping malformed event", slog.String("channel", channel), slog.Any("error", err))
+				continue
+			}
+			if !msg.matches(domain, eventType) {
+				continue
+			}
+			if err := handler(&msg); err != nil {
+				slog.Error("Event handler failed",
+					slog.String("type", msg.Type),
+					slog.String("id", msg.ID),
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
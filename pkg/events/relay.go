@@ -0,0 +1,273 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/archesai/archesai/pkg/database"
+)
+
+// RelayConfig controls how the OutboxRelay drains the outbox.
+type RelayConfig struct {
+	// BatchSize is the number of rows published per query.
+	BatchSize int
+
+	// Interval is the time between polls of the outbox.
+	Interval time.Duration
+
+	// Retention is how long published rows are kept. Zero keeps them.
+	Retention time.Duration
+
+	// MaxAttempts is the number of failed publishes after which a row is
+	// dead-lettered. Zero retries it forever.
+	MaxAttempts int
+}
+
+// DefaultRelayConfig polls every second, keeps published rows for a day and
+// dead-letters a row after 20 failed publishes.
+func DefaultRelayConfig() RelayConfig {
+	return RelayConfig{
+		BatchSize:   100,
+		Interval:    time.Second,
+		Retention:   24 * time.Hour,
+		MaxAttempts: 20,
+	}
+}
+
+// pruneInterval is the time between deletions of expired rows.
+const pruneInterval = time.Minute
+
+// OutboxRelay publishes the events repositories wrote to the outbox.
+//
+// Delivery is at least once: a row is marked published only after the
+// publisher accepted it, so a crash in between publishes it again. The
+// message keeps the ID the event was written with, which consumers use to
+// recognize repeats. Rows are published in the order they were written, and a
+// failing row holds back the rows after it until it is published or, after
+// MaxAttempts failures, dead-lettered. Dead-lettered rows keep their
+// last_error and are neither published nor pruned.
+type OutboxRelay struct {
+	db        *database.Database
+	publisher Publisher
+	config    RelayConfig
+	pruned    time.Time
+}
+
+// NewOutboxRelay creates a relay that publishes the outbox of db through
+// publisher.
+func NewOutboxRelay(db *database.Database, publisher Publisher, config RelayConfig) *OutboxRelay {
+	return &OutboxRelay{
+		db:        db,
+		publisher: publisher,
+		config:    config,
+	}
+}
+
+// Run drains the outbox every interval until the context is done.
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := r.Flush(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.Error("outbox relay error", "error", err)
+				}
+				break
+			}
+			if n < r.config.BatchSize {
+				break
+			}
+		}
+
+		if r.config.Retention > 0 && time.Since(r.pruned) >= pruneInterval {
+			if err := r.Prune(ctx, time.Now().Add(-r.config.Retention)); err != nil && ctx.Err() == nil {
+				slog.Error("outbox relay error", "error", err)
+			}
+			r.pruned = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush publishes the next batch of unpublished rows and returns how many
+// were published.
+//
+// On PostgreSQL the batch is locked with SKIP LOCKED until it is marked, so
+// several relays can drain the same outbox. SQLite has a single writer and
+// reads the batch without a transaction.
+func (r *OutboxRelay) Flush(ctx context.Context) (int, error) {
+	if r.db.IsSQLite() {
+		return r.flush(ctx, r.db.SQLDB())
+	}
+
+	var published int
+	var failed error
+	err := database.RunSQLTx(ctx, r.db.SQLDB(), func(ctx context.Context, tx *sql.Tx) error {
+		n, err := r.flush(ctx, tx)
+		published = n
+		// The failure is recorded on the row, which must be committed
+		if pe := (*publishError)(nil); errors.As(err, &pe) {
+			failed = err
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return published, failed
+}
+
+// Prune deletes the rows published before the given time. Dead-lettered rows
+// are kept for inspection.
+func (r *OutboxRelay) Prune(ctx context.Context, before time.Time) error {
+	_, err := r.db.SQLDB().ExecContext(ctx,
+		r.bind(`DELETE FROM "`+OutboxTable+`" WHERE published_at IS NOT NULL AND published_at < ?`),
+		r.timeValue(before),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to prune outbox: %w", err)
+	}
+	return nil
+}
+
+// publishError is a failure of the publisher, as opposed to one of the
+// database.
+type publishError struct {
+	id  string
+	err error
+}
+
+func (e *publishError) Error() string {
+	return fmt.Sprintf("failed to publish event %s: %v", e.id, e.err)
+}
+
+func (e *publishError) Unwrap() error {
+	return e.err
+}
+
+type outboxEntry struct {
+	id       string
+	payload  []byte
+	attempts int
+}
+
+func (r *OutboxRelay) flush(ctx context.Context, q database.SQLQuerier) (int, error) {
+	query := `SELECT id, payload, attempts FROM "` + OutboxTable + `"
+		WHERE published_at IS NULL AND dead_lettered_at IS NULL
+		ORDER BY created_at, id
+		LIMIT ?`
+	if !r.db.IsSQLite() {
+		query += ` FOR UPDATE SKIP LOCKED`
+	}
+
+	rows, err := q.QueryContext(ctx, r.bind(query), r.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	var entries []outboxEntry
+	for rows.Next() {
+		var entry outboxEntry
+		if err := rows.Scan(&entry.id, &entry.payload, &entry.attempts); err != nil {
+			_ = rows.Close()
+			return 0, fmt.Errorf("failed to read outbox: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	for i, entry := range entries {
+		if err := r.publish(ctx, entry); err != nil {
+			if markErr := r.fail(ctx, q, entry, err); markErr != nil {
+				return i, markErr
+			}
+			return i, &publishError{id: entry.id, err: err}
+		}
+		if err := r.mark(ctx, q, entry.id, time.Now().UTC()); err != nil {
+			return i, err
+		}
+	}
+	return len(entries), nil
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, entry outboxEntry) error {
+	var msg Message
+	if err := json.Unmarshal(entry.payload, &msg); err != nil {
+		return fmt.Errorf("failed to decode event: %w", err)
+	}
+	return r.publisher.Publish(ctx, &msg)
+}
+
+// mark records a successful attempt to publish a row.
+func (r *OutboxRelay) mark(ctx context.Context, q database.SQLQuerier, id string, publishedAt time.Time) error {
+	_, err := q.ExecContext(ctx,
+		r.bind(`UPDATE "`+OutboxTable+`" SET published_at = ?, attempts = attempts + 1, last_error = NULL WHERE id = ?`),
+		r.timeValue(publishedAt), id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update outbox: %w", err)
+	}
+	return nil
+}
+
+// fail records a failed attempt to publish a row, and dead-letters the row
+// once it reached MaxAttempts.
+func (r *OutboxRelay) fail(ctx context.Context, q database.SQLQuerier, entry outboxEntry, cause error) error {
+	var deadLettered any
+	if r.config.MaxAttempts > 0 && entry.attempts+1 >= r.config.MaxAttempts {
+		deadLettered = r.timeValue(time.Now().UTC())
+		slog.Error("outbox event dead-lettered",
+			"id", entry.id, "attempts", entry.attempts+1, "error", cause)
+	}
+	_, err := q.ExecContext(ctx,
+		r.bind(`UPDATE "`+OutboxTable+`" SET attempts = attempts + 1, last_error = ?, dead_lettered_at = ? WHERE id = ?`),
+		cause.Error(), deadLettered, entry.id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update outbox: %w", err)
+	}
+	return nil
+}
+
+// bind rewrites the ? placeholders of query for the database.
+func (r *OutboxRelay) bind(query string) string {
+	if r.db.IsSQLite() {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+func (r *OutboxRelay) timeValue(t time.Time) any {
+	if r.db.IsSQLite() {
+		return database.SQLiteTimeValue(t)
+	}
+	return t
+}
@@ -0,0 +1,204 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/database"
+)
+
+// recordingPublisher keeps the events it publishes, or fails with err.
+type recordingPublisher struct {
+	published []*Message
+	err       error
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, event Event) error {
+	if p.err != nil {
+		return p.err
+	}
+	msg, err := NewMessage(ctx, event)
+	if err != nil {
+		return err
+	}
+	p.published = append(p.published, msg)
+	return nil
+}
+
+func (p *recordingPublisher) PublishMultiple(ctx context.Context, batch []Event) error {
+	for _, event := range batch {
+		if err := p.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newOutboxDB(t *testing.T) *database.Database {
+	t.Helper()
+	db, err := database.StartSQLite()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.SQLDB().Close() })
+	// Every connection to :memory: opens a database of its own
+	db.SQLDB().SetMaxOpenConns(1)
+
+	_, err = db.SQLDB().Exec(`CREATE TABLE "event_outbox" (
+		id TEXT NOT NULL PRIMARY KEY,
+		event_type TEXT NOT NULL,
+		organization_id TEXT NULL,
+		payload TEXT NOT NULL,
+		created_at TEXT NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+		published_at TEXT NULL,
+		attempts INTEGER NOT NULL DEFAULT 0,
+		last_error TEXT NULL,
+		dead_lettered_at TEXT NULL
+	)`)
+	require.NoError(t, err)
+	return db
+}
+
+func writeOutbox(ctx context.Context, db *database.Database, fail bool, pending ...Event) error {
+	return database.RunSQLTx(ctx, db.SQLDB(), func(ctx context.Context, tx *sql.Tx) error {
+		if err := WriteSQLOutbox(ctx, tx, pending); err != nil {
+			return err
+		}
+		if fail {
+			return errors.New("write failed")
+		}
+		return nil
+	})
+}
+
+func TestEnsure(t *testing.T) {
+	created := &runEvent{BaseEvent: NewBaseEvent("run", "run.created")}
+	updated := &runEvent{BaseEvent: NewBaseEvent("run", "run.updated")}
+
+	pending := []Event{created}
+	assert.Equal(t, pending, Ensure(pending, &runEvent{BaseEvent: NewBaseEvent("run", "run.created")}))
+	assert.Equal(t, []Event{created, updated}, Ensure(pending, updated))
+	assert.Len(t, pending, 1)
+	assert.Equal(t, []Event{updated}, Ensure(nil, updated))
+}
+
+func TestOutboxRelay(t *testing.T) {
+	db := newOutboxDB(t)
+	orgID := uuid.New()
+	ctx := database.WithTenant(context.Background(), orgID)
+
+	first := &runEvent{BaseEvent: NewBaseEvent("run", "run.created"), RunID: uuid.New()}
+	second := &runEvent{BaseEvent: NewBaseEvent("run", "run.updated"), RunID: first.RunID}
+	first.Timestamp = second.Timestamp.Add(-time.Second)
+	require.NoError(t, writeOutbox(ctx, db, false, first, second))
+	// Writing an event again keeps a single row
+	require.NoError(t, writeOutbox(ctx, db, false, first))
+	// Events of a rolled back write are never published
+	require.Error(t, writeOutbox(ctx, db, true, &runEvent{BaseEvent: NewBaseEvent("run", "run.deleted")}))
+
+	publisher := &recordingPublisher{}
+	relay := NewOutboxRelay(db, publisher, DefaultRelayConfig())
+	n, err := relay.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	require.Len(t, publisher.published, 2)
+	assert.Equal(t, first.ID, publisher.published[0].ID)
+	assert.Equal(t, "run.created", publisher.published[0].Type)
+	assert.Equal(t, orgID, publisher.published[0].OrganizationID)
+	assert.Equal(t, second.ID, publisher.published[1].ID)
+	var data runEvent
+	require.NoError(t, json.Unmarshal(publisher.published[1].Data, &data))
+	assert.Equal(t, first.RunID, data.RunID)
+
+	n, err = relay.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Len(t, publisher.published, 2)
+}
+
+func TestOutboxRelayRetriesFailedEvents(t *testing.T) {
+	db := newOutboxDB(t)
+	ctx := database.WithTenant(context.Background(), uuid.New())
+	event := &runEvent{BaseEvent: NewBaseEvent("run", "run.created")}
+	require.NoError(t, writeOutbox(ctx, db, false, event))
+
+	publisher := &recordingPublisher{err: errors.New("bus unavailable")}
+	relay := NewOutboxRelay(db, publisher, DefaultRelayConfig())
+	n, err := relay.Flush(context.Background())
+	require.ErrorContains(t, err, "bus unavailable")
+	assert.Zero(t, n)
+
+	var attempts int
+	var lastError sql.NullString
+	require.NoError(t, db.SQLDB().QueryRow(
+		`SELECT attempts, last_error FROM "event_outbox" WHERE id = ?`, event.ID,
+	).Scan(&attempts, &lastError))
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, "bus unavailable", lastError.String)
+
+	publisher.err = nil
+	n, err = relay.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Len(t, publisher.published, 1)
+	assert.Equal(t, event.ID, publisher.published[0].ID)
+
+	// Published rows are deleted once they expire
+	require.NoError(t, relay.Prune(context.Background(), time.Now().Add(-time.Hour)))
+	var count int
+	require.NoError(t, db.SQLDB().QueryRow(`SELECT COUNT(*) FROM "event_outbox"`).Scan(&count))
+	assert.Equal(t, 1, count)
+	require.NoError(t, relay.Prune(context.Background(), time.Now().Add(time.Hour)))
+	require.NoError(t, db.SQLDB().QueryRow(`SELECT COUNT(*) FROM "event_outbox"`).Scan(&count))
+	assert.Zero(t, count)
+}
+
+func TestOutboxRelayDeadLettersFailingEvents(t *testing.T) {
+	db := newOutboxDB(t)
+	ctx := database.WithTenant(context.Background(), uuid.New())
+	poison := &runEvent{BaseEvent: NewBaseEvent("run", "run.created")}
+	next := &runEvent{BaseEvent: NewBaseEvent("run", "run.updated")}
+	poison.Timestamp = next.Timestamp.Add(-time.Second)
+	require.NoError(t, writeOutbox(ctx, db, false, poison, next))
+
+	publisher := &recordingPublisher{err: errors.New("bus unavailable")}
+	config := DefaultRelayConfig()
+	config.MaxAttempts = 3
+	relay := NewOutboxRelay(db, publisher, config)
+
+	// The failing row holds back the next one until it reaches MaxAttempts
+	for range config.MaxAttempts {
+		_, err := relay.Flush(context.Background())
+		require.ErrorContains(t, err, poison.ID)
+	}
+	var attempts int
+	var deadLettered sql.NullString
+	require.NoError(t, db.SQLDB().QueryRow(
+		`SELECT attempts, dead_lettered_at FROM "event_outbox" WHERE id = ?`, poison.ID,
+	).Scan(&attempts, &deadLettered))
+	assert.Equal(t, 3, attempts)
+	assert.True(t, deadLettered.Valid)
+
+	publisher.err = nil
+	n, err := relay.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Len(t, publisher.published, 1)
+	assert.Equal(t, next.ID, publisher.published[0].ID)
+
+	// Dead-lettered rows are neither retried nor pruned
+	n, err = relay.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	require.NoError(t, relay.Prune(context.Background(), time.Now().Add(time.Hour)))
+	var count int
+	require.NoError(t, db.SQLDB().QueryRow(`SELECT COUNT(*) FROM "event_outbox"`).Scan(&count))
+	assert.Equal(t, 1, count)
+}
@@ -3,7 +3,6 @@
 package bootstrap
 
 import (
-	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/executor/handlers"
 	"github.com/archesai/archesai/pkg/executor/repositories"
 )
@@ -21,14 +20,13 @@ type ApplicationHandlers struct {
 // NewApplicationHandlers creates all application handlers with proper dependency injection.
 func NewApplicationHandlers(
 	executorRepo repositories.ExecutorRepository,
-	publisher events.Publisher,
 ) *ApplicationHandlers {
 	return &ApplicationHandlers{
-		CreateExecutor:  handlers.NewCreateExecutor(executorRepo, publisher),
-		DeleteExecutor:  handlers.NewDeleteExecutor(executorRepo, publisher),
+		CreateExecutor:  handlers.NewCreateExecutor(executorRepo),
+		DeleteExecutor:  handlers.NewDeleteExecutor(executorRepo),
 		ExecuteExecutor: handlers.NewExecuteExecutor(),
 		GetExecutor:     handlers.NewGetExecutor(executorRepo),
 		ListExecutors:   handlers.NewListExecutors(executorRepo),
-		UpdateExecutor:  handlers.NewUpdateExecutor(executorRepo, publisher),
+		UpdateExecutor:  handlers.NewUpdateExecutor(executorRepo),
 	}
 }
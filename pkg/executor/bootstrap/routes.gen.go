@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/executor/routes"
 )
 
@@ -20,14 +21,15 @@ type HTTPHandlers struct {
 }
 
 // NewHTTPHandlers creates all HTTP handlers from the given application handlers.
-func NewHTTPHandlers(appHandlers *ApplicationHandlers) *HTTPHandlers {
+// Operations that declare x-codegen-permissions are checked with the authorizer.
+func NewHTTPHandlers(appHandlers *ApplicationHandlers, authorizer auth.Authorizer) *HTTPHandlers {
 	return &HTTPHandlers{
-		CreateExecutor:  routes.NewCreateExecutorHandler(appHandlers.CreateExecutor),
-		DeleteExecutor:  routes.NewDeleteExecutorHandler(appHandlers.DeleteExecutor),
-		ExecuteExecutor: routes.NewExecuteExecutorHandler(appHandlers.ExecuteExecutor),
-		GetExecutor:     routes.NewGetExecutorHandler(appHandlers.GetExecutor),
-		ListExecutors:   routes.NewListExecutorsHandler(appHandlers.ListExecutors),
-		UpdateExecutor:  routes.NewUpdateExecutorHandler(appHandlers.UpdateExecutor),
+		CreateExecutor:  routes.NewCreateExecutorHandler(appHandlers.CreateExecutor, authorizer),
+		DeleteExecutor:  routes.NewDeleteExecutorHandler(appHandlers.DeleteExecutor, authorizer),
+		ExecuteExecutor: routes.NewExecuteExecutorHandler(appHandlers.ExecuteExecutor, authorizer),
+		GetExecutor:     routes.NewGetExecutorHandler(appHandlers.GetExecutor, authorizer),
+		ListExecutors:   routes.NewListExecutorsHandler(appHandlers.ListExecutors, authorizer),
+		UpdateExecutor:  routes.NewUpdateExecutorHandler(appHandlers.UpdateExecutor, authorizer),
 	}
 }
 
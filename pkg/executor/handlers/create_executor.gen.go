@@ -9,7 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/executor/repositories"
 )
@@ -20,6 +20,7 @@ import (
 
 // CreateExecutorInput represents the input for the CreateExecutor operation.
 type CreateExecutorInput struct {
+	SessionID    uuid.UUID
 	CPUShares    *int32
 	Dependencies *string
 	Description  string
@@ -46,28 +47,31 @@ type CreateExecutor interface {
 
 // CreateExecutorImpl is the default implementation of CreateExecutor.
 type CreateExecutorImpl struct {
-	repo      repositories.ExecutorRepository
-	publisher events.Publisher
+	repo repositories.ExecutorRepository
 }
 
 // NewCreateExecutor creates a new CreateExecutor handler.
 func NewCreateExecutor(
 	repo repositories.ExecutorRepository,
-	publisher events.Publisher,
 ) CreateExecutor {
 	return &CreateExecutorImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
 // Execute performs the CreateExecutor operation.
 func (h *CreateExecutorImpl) Execute(ctx context.Context, input *CreateExecutorInput) (*CreateExecutorOutput, error) {
+	organizationID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create entity
 	entity := &models.Executor{
-		ID:        uuid.New(),
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ID:             uuid.New(),
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+		OrganizationID: organizationID,
 		// TODO: Map input fields to entity
 	}
 
@@ -77,15 +81,10 @@ func (h *CreateExecutorImpl) Execute(ctx context.Context, input *CreateExecutorI
 		return nil, fmt.Errorf("failed to create executor: %w", err)
 	}
 
-	// Publish event
-	event := models.NewExecutorCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &CreateExecutorOutput{
-		// TODO: Map created entity to output
+		Data: *created,
 	}
-	_ = created
 
 	return output, nil
 }
@@ -8,8 +8,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
-	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/executor/repositories"
 )
 
@@ -19,7 +17,8 @@ import (
 
 // DeleteExecutorInput represents the input for the DeleteExecutor operation.
 type DeleteExecutorInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // DeleteExecutor defines the interface for the DeleteExecutor operation.
@@ -29,18 +28,15 @@ type DeleteExecutor interface {
 
 // DeleteExecutorImpl is the default implementation of DeleteExecutor.
 type DeleteExecutorImpl struct {
-	repo      repositories.ExecutorRepository
-	publisher events.Publisher
+	repo repositories.ExecutorRepository
 }
 
 // NewDeleteExecutor creates a new DeleteExecutor handler.
 func NewDeleteExecutor(
 	repo repositories.ExecutorRepository,
-	publisher events.Publisher,
 ) DeleteExecutor {
 	return &DeleteExecutorImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -51,9 +47,5 @@ func (h *DeleteExecutorImpl) Execute(ctx context.Context, input *DeleteExecutorI
 		return fmt.Errorf("failed to delete executor: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewExecutorDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
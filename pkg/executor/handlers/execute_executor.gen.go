@@ -14,8 +14,9 @@ import (
 
 // ExecuteExecutorInput represents the input for the ExecuteExecutor operation.
 type ExecuteExecutorInput struct {
-	ID    uuid.UUID
-	Input map[string]any
+	SessionID uuid.UUID
+	ID        uuid.UUID
+	Input     map[string]any
 }
 
 // ExecuteExecutorOutputData defines the data structure in the output.
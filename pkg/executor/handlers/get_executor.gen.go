@@ -18,7 +18,8 @@ import (
 
 // GetExecutorInput represents the input for the GetExecutor operation.
 type GetExecutorInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // GetExecutorOutput represents the output for the GetExecutor operation.
@@ -55,9 +56,8 @@ func (h *GetExecutorImpl) Execute(ctx context.Context, input *GetExecutorInput)
 
 	// Map to output
 	output := &GetExecutorOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
@@ -6,6 +6,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/executor/repositories"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
@@ -17,9 +20,10 @@ import (
 
 // ListExecutorsInput represents the input for the ListExecutors operation.
 type ListExecutorsInput struct {
-	Filter map[string]any
-	Page   map[string]any
-	Sort   []map[string]any
+	SessionID uuid.UUID
+	Filter    map[string]any
+	Page      map[string]any
+	Sort      []map[string]any
 }
 
 // ListExecutorsOutput represents the output for the ListExecutors operation.
@@ -49,20 +53,30 @@ func NewListExecutors(
 
 // Execute performs the ListExecutors operation.
 func (h *ListExecutorsImpl) Execute(ctx context.Context, input *ListExecutorsInput) (*ListExecutorsOutput, error) {
+	opts, err := database.NewListOptions(input.Filter, input.Page, input.Sort)
+	if err != nil {
+		return nil, err
+	}
+
 	// List from repository
-	results, total, err := h.repo.List(ctx, 100, 0) // TODO: Use pagination from input
+	results, info, err := h.repo.List(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list executors: %w", err)
 	}
 
 	// Map to output
+	data := make([]models.Executor, len(results))
+	for i, result := range results {
+		data[i] = *result
+	}
 	output := &ListExecutorsOutput{
-		// TODO: Map results to output structure
-		// Data: results,
-		// Total: total,
+		Data: data,
+		Meta: servermodels.PaginationMeta{
+			Total: int32(info.Total),
+			Next:  info.Next,
+			Prev:  info.Prev,
+		},
 	}
-	_ = results
-	_ = total
 
 	return output, nil
 }
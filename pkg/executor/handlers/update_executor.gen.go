@@ -9,7 +9,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/executor/repositories"
 )
@@ -20,6 +19,7 @@ import (
 
 // UpdateExecutorInput represents the input for the UpdateExecutor operation.
 type UpdateExecutorInput struct {
+	SessionID    uuid.UUID
 	ID           uuid.UUID
 	CPUShares    *int32
 	Dependencies *string
@@ -48,18 +48,15 @@ type UpdateExecutor interface {
 
 // UpdateExecutorImpl is the default implementation of UpdateExecutor.
 type UpdateExecutorImpl struct {
-	repo      repositories.ExecutorRepository
-	publisher events.Publisher
+	repo repositories.ExecutorRepository
 }
 
 // NewUpdateExecutor creates a new UpdateExecutor handler.
 func NewUpdateExecutor(
 	repo repositories.ExecutorRepository,
-	publisher events.Publisher,
 ) UpdateExecutor {
 	return &UpdateExecutorImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -81,15 +78,10 @@ func (h *UpdateExecutorImpl) Execute(ctx context.Context, input *UpdateExecutorI
 		return nil, fmt.Errorf("failed to update executor: %w", err)
 	}
 
-	// Publish event
-	event := models.NewExecutorUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdateExecutorOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
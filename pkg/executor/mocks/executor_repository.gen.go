@@ -0,0 +1,398 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/executor/models"
+	"github.com/archesai/archesai/pkg/executor/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.ExecutorRepository = (*ExecutorRepository)(nil)
+
+// ExecutorRepository is a mock of repositories.ExecutorRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type ExecutorRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Executor) (*models.Executor, error)
+
+	createCalls   []ExecutorRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Executor) (*models.Executor, error)
+	createReturns func(context.Context, *models.Executor) (*models.Executor, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Executor, error)
+
+	getCalls   []ExecutorRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Executor, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Executor, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Executor) (*models.Executor, error)
+
+	updateCalls   []ExecutorRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Executor) (*models.Executor, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Executor) (*models.Executor, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []ExecutorRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Executor, database.PageInfo, error)
+
+	listCalls   []ExecutorRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Executor, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Executor, database.PageInfo, error)
+
+	// ListExecutorsByOrganizationFunc, if set, is called by ListExecutorsByOrganization.
+	ListExecutorsByOrganizationFunc func(ctx context.Context, organizationID string) ([]*models.Executor, error)
+
+	listExecutorsByOrganizationCalls   []ExecutorRepositoryListExecutorsByOrganizationCall
+	listExecutorsByOrganizationOnCall  map[int]func(context.Context, string) ([]*models.Executor, error)
+	listExecutorsByOrganizationReturns func(context.Context, string) ([]*models.Executor, error)
+}
+
+// ExecutorRepositoryCreateCall holds the arguments of a call to Create.
+type ExecutorRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Executor
+}
+
+// Create records the call and returns the configured result.
+func (m *ExecutorRepository) Create(ctx context.Context, entity *models.Executor) (r0 *models.Executor, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, ExecutorRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *ExecutorRepository) CreateReturns(r0 *models.Executor, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Executor) (*models.Executor, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *ExecutorRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Executor) (*models.Executor, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Executor) (*models.Executor, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *ExecutorRepository) CreateCalls() []ExecutorRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// ExecutorRepositoryGetCall holds the arguments of a call to Get.
+type ExecutorRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *ExecutorRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Executor, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, ExecutorRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *ExecutorRepository) GetReturns(r0 *models.Executor, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Executor, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *ExecutorRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Executor, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Executor, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *ExecutorRepository) GetCalls() []ExecutorRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// ExecutorRepositoryUpdateCall holds the arguments of a call to Update.
+type ExecutorRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Executor
+}
+
+// Update records the call and returns the configured result.
+func (m *ExecutorRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Executor) (r0 *models.Executor, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, ExecutorRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *ExecutorRepository) UpdateReturns(r0 *models.Executor, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Executor) (*models.Executor, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *ExecutorRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Executor) (*models.Executor, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Executor) (*models.Executor, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *ExecutorRepository) UpdateCalls() []ExecutorRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// ExecutorRepositoryDeleteCall holds the arguments of a call to Delete.
+type ExecutorRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *ExecutorRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, ExecutorRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *ExecutorRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *ExecutorRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *ExecutorRepository) DeleteCalls() []ExecutorRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// ExecutorRepositoryListCall holds the arguments of a call to List.
+type ExecutorRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *ExecutorRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Executor, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, ExecutorRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *ExecutorRepository) ListReturns(r0 []*models.Executor, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Executor, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *ExecutorRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Executor, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Executor, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *ExecutorRepository) ListCalls() []ExecutorRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// ExecutorRepositoryListExecutorsByOrganizationCall holds the arguments of a call to ListExecutorsByOrganization.
+type ExecutorRepositoryListExecutorsByOrganizationCall struct {
+	Ctx            context.Context
+	OrganizationID string
+}
+
+// ListExecutorsByOrganization records the call and returns the configured result.
+func (m *ExecutorRepository) ListExecutorsByOrganization(ctx context.Context, organizationID string) (r0 []*models.Executor, r1 error) {
+	m.mu.Lock()
+	m.listExecutorsByOrganizationCalls = append(m.listExecutorsByOrganizationCalls, ExecutorRepositoryListExecutorsByOrganizationCall{
+		Ctx:            ctx,
+		OrganizationID: organizationID,
+	})
+	fn := m.listExecutorsByOrganizationOnCall[len(m.listExecutorsByOrganizationCalls)-1]
+	if fn == nil {
+		fn = m.ListExecutorsByOrganizationFunc
+	}
+	if fn == nil {
+		fn = m.listExecutorsByOrganizationReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, organizationID)
+}
+
+// ListExecutorsByOrganizationReturns makes calls to ListExecutorsByOrganization return the given values.
+func (m *ExecutorRepository) ListExecutorsByOrganizationReturns(r0 []*models.Executor, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listExecutorsByOrganizationReturns = func(context.Context, string) ([]*models.Executor, error) {
+		return r0, r1
+	}
+}
+
+// OnListExecutorsByOrganizationCall makes the call to ListExecutorsByOrganization with index i, counting from
+// zero, return the result of fn.
+func (m *ExecutorRepository) OnListExecutorsByOrganizationCall(i int, fn func(ctx context.Context, organizationID string) ([]*models.Executor, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listExecutorsByOrganizationOnCall == nil {
+		m.listExecutorsByOrganizationOnCall = make(map[int]func(context.Context, string) ([]*models.Executor, error))
+	}
+	m.listExecutorsByOrganizationOnCall[i] = fn
+}
+
+// ListExecutorsByOrganizationCalls returns the calls made to ListExecutorsByOrganization, in order.
+func (m *ExecutorRepository) ListExecutorsByOrganizationCalls() []ExecutorRepositoryListExecutorsByOrganizationCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listExecutorsByOrganizationCalls)
+}
@@ -5,6 +5,7 @@ package repositories
 import (
 	"context"
 
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/google/uuid"
 )
@@ -16,7 +17,7 @@ type ExecutorRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Executor, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Executor) (*models.Executor, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Executor, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Executor, database.PageInfo, error)
 
 	// ListExecutorsByOrganization retrieves multiple executors by organizationID
 	ListExecutorsByOrganization(ctx context.Context, organizationID string) ([]*models.Executor, error)
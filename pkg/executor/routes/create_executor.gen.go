@@ -4,9 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/executor/handlers"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -19,11 +25,15 @@ import (
 // CreateExecutorHandler is the HTTP handler for CreateExecutor.
 type CreateExecutorHandler struct {
 	createExecutor handlers.CreateExecutor
+	authorizer     auth.Authorizer
 }
 
 // NewCreateExecutorHandler creates a new HTTP handler.
-func NewCreateExecutorHandler(createExecutor handlers.CreateExecutor) *CreateExecutorHandler {
-	return &CreateExecutorHandler{createExecutor: createExecutor}
+func NewCreateExecutorHandler(createExecutor handlers.CreateExecutor, authorizer auth.Authorizer) *CreateExecutorHandler {
+	return &CreateExecutorHandler{
+		createExecutor: createExecutor,
+		authorizer:     authorizer,
+	}
 }
 
 // RegisterCreateExecutorRoute registers the HTTP route for CreateExecutor.
@@ -85,6 +95,16 @@ func (response CreateExecutor401Response) VisitCreateExecutorResponse(w http.Res
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type CreateExecutor403Response struct {
+	server.ProblemDetails
+}
+
+func (response CreateExecutor403Response) VisitCreateExecutorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type CreateExecutor422Response struct {
 	server.ProblemDetails
 }
@@ -119,12 +139,50 @@ func (response CreateExecutor500Response) VisitCreateExecutorResponse(w http.Res
 func (h *CreateExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := CreateExecutor401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitCreateExecutorResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.CreateExecutorInput{}
+	input.SessionID = sessionID
+
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "executors:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := CreateExecutor400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitCreateExecutorResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &CreateExecutorRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := CreateExecutor400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -133,6 +191,57 @@ func (h *CreateExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "name", "description", "language", "executeCode")
+	if body.CPUShares != nil {
+		v.Minimum("/cpuShares", float64(*body.CPUShares), 128, false)
+		v.Maximum("/cpuShares", float64(*body.CPUShares), 2048, false)
+	}
+	if body.Dependencies != nil {
+		v.MaxLength("/dependencies", *body.Dependencies, 50000)
+		v.Pattern("/dependencies", *body.Dependencies, `^[\s\S]*$`)
+	}
+	v.MaxLength("/description", body.Description, 1000)
+	v.Pattern("/description", body.Description, `^[\w\s\-.,!?()@#+/':;]*$`)
+	if body.Env != nil {
+		v.MaxLength("/env", *body.Env, 10000)
+		v.Pattern("/env", *body.Env, `^[\s\S]*$`)
+	}
+	v.MaxLength("/executeCode", body.ExecuteCode, 100000)
+	v.Pattern("/executeCode", body.ExecuteCode, `^[\s\S]*$`)
+	if body.ExtraFiles != nil {
+		v.MaxLength("/extraFiles", *body.ExtraFiles, 100000)
+		v.Pattern("/extraFiles", *body.ExtraFiles, `^[\s\S]*$`)
+	}
+	v.Enum("/language", body.Language, "nodejs", "python", "go")
+	if body.MemoryMB != nil {
+		v.Minimum("/memoryMB", float64(*body.MemoryMB), 128, false)
+		v.Maximum("/memoryMB", float64(*body.MemoryMB), 4096, false)
+	}
+	v.MinLength("/name", body.Name, 1)
+	v.MaxLength("/name", body.Name, 255)
+	v.Pattern("/name", body.Name, `^[\w\s\-.,!?()@#+/']+$`)
+	if body.SchemaIn != nil {
+		v.MaxLength("/schemaIn", *body.SchemaIn, 10000)
+		v.Pattern("/schemaIn", *body.SchemaIn, `^[\s\S]*$`)
+	}
+	if body.SchemaOut != nil {
+		v.MaxLength("/schemaOut", *body.SchemaOut, 10000)
+		v.Pattern("/schemaOut", *body.SchemaOut, `^[\s\S]*$`)
+	}
+	if body.Timeout != nil {
+		v.Minimum("/timeout", float64(*body.Timeout), 1, false)
+		v.Maximum("/timeout", float64(*body.Timeout), 300, false)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.CPUShares = body.CPUShares
 	input.Dependencies = body.Dependencies
 	input.Description = body.Description
@@ -148,6 +257,13 @@ func (h *CreateExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	// Execute
 	result, err := h.createExecutor.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := CreateExecutor500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,13 +4,17 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/executor/handlers"
+	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -21,11 +25,15 @@ import (
 // DeleteExecutorHandler is the HTTP handler for DeleteExecutor.
 type DeleteExecutorHandler struct {
 	deleteExecutor handlers.DeleteExecutor
+	authorizer     auth.Authorizer
 }
 
 // NewDeleteExecutorHandler creates a new HTTP handler.
-func NewDeleteExecutorHandler(deleteExecutor handlers.DeleteExecutor) *DeleteExecutorHandler {
-	return &DeleteExecutorHandler{deleteExecutor: deleteExecutor}
+func NewDeleteExecutorHandler(deleteExecutor handlers.DeleteExecutor, authorizer auth.Authorizer) *DeleteExecutorHandler {
+	return &DeleteExecutorHandler{
+		deleteExecutor: deleteExecutor,
+		authorizer:     authorizer,
+	}
 }
 
 // RegisterDeleteExecutorRoute registers the HTTP route for DeleteExecutor.
@@ -69,6 +77,16 @@ func (response DeleteExecutor401Response) VisitDeleteExecutorResponse(w http.Res
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type DeleteExecutor403Response struct {
+	server.ProblemDetails
+}
+
+func (response DeleteExecutor403Response) VisitDeleteExecutorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type DeleteExecutor404Response struct {
 	server.ProblemDetails
 }
@@ -113,8 +131,22 @@ func (response DeleteExecutor500Response) VisitDeleteExecutorResponse(w http.Res
 func (h *DeleteExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := DeleteExecutor401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitDeleteExecutorResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.DeleteExecutorInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -129,8 +161,36 @@ func (h *DeleteExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "executors:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	if err := h.deleteExecutor.Execute(ctx, input); err != nil {
+		if errors.Is(err, database.ErrNoTenant) {
+			problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
+		if errors.Is(err, models.ErrExecutorNotFound) {
+			problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := DeleteExecutor500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
@@ -4,13 +4,18 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/executor/handlers"
+	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -21,11 +26,15 @@ import (
 // ExecuteExecutorHandler is the HTTP handler for ExecuteExecutor.
 type ExecuteExecutorHandler struct {
 	executeExecutor handlers.ExecuteExecutor
+	authorizer      auth.Authorizer
 }
 
 // NewExecuteExecutorHandler creates a new HTTP handler.
-func NewExecuteExecutorHandler(executeExecutor handlers.ExecuteExecutor) *ExecuteExecutorHandler {
-	return &ExecuteExecutorHandler{executeExecutor: executeExecutor}
+func NewExecuteExecutorHandler(executeExecutor handlers.ExecuteExecutor, authorizer auth.Authorizer) *ExecuteExecutorHandler {
+	return &ExecuteExecutorHandler{
+		executeExecutor: executeExecutor,
+		authorizer:      authorizer,
+	}
 }
 
 // RegisterExecuteExecutorRoute registers the HTTP route for ExecuteExecutor.
@@ -83,6 +92,16 @@ func (response ExecuteExecutor401Response) VisitExecuteExecutorResponse(w http.R
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type ExecuteExecutor403Response struct {
+	server.ProblemDetails
+}
+
+func (response ExecuteExecutor403Response) VisitExecuteExecutorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type ExecuteExecutor404Response struct {
 	server.ProblemDetails
 }
@@ -127,8 +146,22 @@ func (response ExecuteExecutor500Response) VisitExecuteExecutorResponse(w http.R
 func (h *ExecuteExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := ExecuteExecutor401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitExecuteExecutorResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.ExecuteExecutorInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -143,9 +176,33 @@ func (h *ExecuteExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "executors:execute",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := ExecuteExecutor400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitExecuteExecutorResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &ExecuteExecutorRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := ExecuteExecutor400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -154,10 +211,50 @@ func (h *ExecuteExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "input")
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Input = body.Input
 
 	// Execute
 	result, err := h.executeExecutor.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrExecutorNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, auth.ErrForbidden) {
+		problem := server.NewForbiddenResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := ExecuteExecutor500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,12 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/executor/handlers"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +25,15 @@ import (
 // GetExecutorHandler is the HTTP handler for GetExecutor.
 type GetExecutorHandler struct {
 	getExecutor handlers.GetExecutor
+	authorizer  auth.Authorizer
 }
 
 // NewGetExecutorHandler creates a new HTTP handler.
-func NewGetExecutorHandler(getExecutor handlers.GetExecutor) *GetExecutorHandler {
-	return &GetExecutorHandler{getExecutor: getExecutor}
+func NewGetExecutorHandler(getExecutor handlers.GetExecutor, authorizer auth.Authorizer) *GetExecutorHandler {
+	return &GetExecutorHandler{
+		getExecutor: getExecutor,
+		authorizer:  authorizer,
+	}
 }
 
 // RegisterGetExecutorRoute registers the HTTP route for GetExecutor.
@@ -72,6 +79,16 @@ func (response GetExecutor401Response) VisitGetExecutorResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type GetExecutor403Response struct {
+	server.ProblemDetails
+}
+
+func (response GetExecutor403Response) VisitGetExecutorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type GetExecutor404Response struct {
 	server.ProblemDetails
 }
@@ -116,8 +133,22 @@ func (response GetExecutor500Response) VisitGetExecutorResponse(w http.ResponseW
 func (h *GetExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := GetExecutor401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitGetExecutorResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.GetExecutorInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -132,8 +163,36 @@ func (h *GetExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "executors:read",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	result, err := h.getExecutor.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrExecutorNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := GetExecutor500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,11 +4,14 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
-	"github.com/oapi-codegen/runtime"
+	"github.com/google/uuid"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/executor/handlers"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +25,15 @@ import (
 // ListExecutorsHandler is the HTTP handler for ListExecutors.
 type ListExecutorsHandler struct {
 	listExecutors handlers.ListExecutors
+	authorizer    auth.Authorizer
 }
 
 // NewListExecutorsHandler creates a new HTTP handler.
-func NewListExecutorsHandler(listExecutors handlers.ListExecutors) *ListExecutorsHandler {
-	return &ListExecutorsHandler{listExecutors: listExecutors}
+func NewListExecutorsHandler(listExecutors handlers.ListExecutors, authorizer auth.Authorizer) *ListExecutorsHandler {
+	return &ListExecutorsHandler{
+		listExecutors: listExecutors,
+		authorizer:    authorizer,
+	}
 }
 
 // RegisterListExecutorsRoute registers the HTTP route for ListExecutors.
@@ -80,6 +87,16 @@ func (response ListExecutors401Response) VisitListExecutorsResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type ListExecutors403Response struct {
+	server.ProblemDetails
+}
+
+func (response ListExecutors403Response) VisitListExecutorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type ListExecutors422Response struct {
 	server.ProblemDetails
 }
@@ -114,13 +131,42 @@ func (response ListExecutors500Response) VisitListExecutorsResponse(w http.Respo
 func (h *ListExecutorsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := ListExecutors401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitListExecutorsResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.ListExecutorsInput{}
+	input.SessionID = sessionID
+
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "executors:read",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
 
 	// Query parameters
 
 	// Optional query parameter "filter"
-	if err := runtime.BindQueryParameter("deepObject", true, false, "filter", r.URL.Query(), &input.Filter); err != nil {
+	filter, err := server.BindFilterQuery(r.URL.Query(), "filter")
+	if err != nil {
 		errorResp := ListExecutors400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter filter: %s", err), r.URL.Path),
 		}
@@ -129,9 +175,11 @@ func (h *ListExecutorsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+	input.Filter = filter
 
 	// Optional query parameter "page"
-	if err := runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &input.Page); err != nil {
+	page, err := server.BindPageQuery(r.URL.Query())
+	if err != nil {
 		errorResp := ListExecutors400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter page: %s", err), r.URL.Path),
 		}
@@ -140,9 +188,11 @@ func (h *ListExecutorsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+	input.Page = page
 
 	// Optional query parameter "sort"
-	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &input.Sort); err != nil {
+	sort, err := server.BindSortQuery(r.URL.Query(), "sort")
+	if err != nil {
 		errorResp := ListExecutors400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter sort: %s", err), r.URL.Path),
 		}
@@ -151,9 +201,26 @@ func (h *ListExecutorsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+	input.Sort = sort
 
 	// Execute
 	result, err := h.listExecutors.Execute(ctx, input)
+	if errors.Is(err, database.ErrInvalidListOptions) {
+		errorResp := ListExecutors400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitListExecutorsResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := ListExecutors500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
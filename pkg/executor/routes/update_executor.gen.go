@@ -4,12 +4,16 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/executor/handlers"
 	"github.com/archesai/archesai/pkg/executor/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +26,15 @@ import (
 // UpdateExecutorHandler is the HTTP handler for UpdateExecutor.
 type UpdateExecutorHandler struct {
 	updateExecutor handlers.UpdateExecutor
+	authorizer     auth.Authorizer
 }
 
 // NewUpdateExecutorHandler creates a new HTTP handler.
-func NewUpdateExecutorHandler(updateExecutor handlers.UpdateExecutor) *UpdateExecutorHandler {
-	return &UpdateExecutorHandler{updateExecutor: updateExecutor}
+func NewUpdateExecutorHandler(updateExecutor handlers.UpdateExecutor, authorizer auth.Authorizer) *UpdateExecutorHandler {
+	return &UpdateExecutorHandler{
+		updateExecutor: updateExecutor,
+		authorizer:     authorizer,
+	}
 }
 
 // RegisterUpdateExecutorRoute registers the HTTP route for UpdateExecutor.
@@ -89,6 +97,16 @@ func (response UpdateExecutor401Response) VisitUpdateExecutorResponse(w http.Res
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type UpdateExecutor403Response struct {
+	server.ProblemDetails
+}
+
+func (response UpdateExecutor403Response) VisitUpdateExecutorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type UpdateExecutor404Response struct {
 	server.ProblemDetails
 }
@@ -133,8 +151,22 @@ func (response UpdateExecutor500Response) VisitUpdateExecutorResponse(w http.Res
 func (h *UpdateExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := UpdateExecutor401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateExecutorResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.UpdateExecutorInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -149,9 +181,33 @@ func (h *UpdateExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "executors:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdateExecutor400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateExecutorResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdateExecutorRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdateExecutor400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -160,6 +216,64 @@ func (h *UpdateExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.CPUShares != nil {
+		v.Minimum("/cpuShares", float64(*body.CPUShares), 128, false)
+		v.Maximum("/cpuShares", float64(*body.CPUShares), 2048, false)
+	}
+	if body.Dependencies != nil {
+		v.MaxLength("/dependencies", *body.Dependencies, 50000)
+		v.Pattern("/dependencies", *body.Dependencies, `^[\s\S]*$`)
+	}
+	if body.Description != nil {
+		v.MaxLength("/description", *body.Description, 1000)
+		v.Pattern("/description", *body.Description, `^[\w\s\-.,!?()@#+/':;]*$`)
+	}
+	if body.Env != nil {
+		v.MaxLength("/env", *body.Env, 10000)
+		v.Pattern("/env", *body.Env, `^[\s\S]*$`)
+	}
+	if body.ExecuteCode != nil {
+		v.MaxLength("/executeCode", *body.ExecuteCode, 100000)
+		v.Pattern("/executeCode", *body.ExecuteCode, `^[\s\S]*$`)
+	}
+	if body.ExtraFiles != nil {
+		v.MaxLength("/extraFiles", *body.ExtraFiles, 100000)
+		v.Pattern("/extraFiles", *body.ExtraFiles, `^[\s\S]*$`)
+	}
+	if body.Language != nil {
+		v.Enum("/language", *body.Language, "nodejs", "python", "go")
+	}
+	if body.MemoryMB != nil {
+		v.Minimum("/memoryMB", float64(*body.MemoryMB), 128, false)
+		v.Maximum("/memoryMB", float64(*body.MemoryMB), 4096, false)
+	}
+	if body.Name != nil {
+		v.MinLength("/name", *body.Name, 1)
+		v.MaxLength("/name", *body.Name, 255)
+		v.Pattern("/name", *body.Name, `^[\w\s\-.,!?()@#+/']+$`)
+	}
+	if body.SchemaIn != nil {
+		v.MaxLength("/schemaIn", *body.SchemaIn, 10000)
+		v.Pattern("/schemaIn", *body.SchemaIn, `^[\s\S]*$`)
+	}
+	if body.SchemaOut != nil {
+		v.MaxLength("/schemaOut", *body.SchemaOut, 10000)
+		v.Pattern("/schemaOut", *body.SchemaOut, `^[\s\S]*$`)
+	}
+	if body.Timeout != nil {
+		v.Minimum("/timeout", float64(*body.Timeout), 1, false)
+		v.Maximum("/timeout", float64(*body.Timeout), 300, false)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.CPUShares = body.CPUShares
 	input.Dependencies = body.Dependencies
 	input.Description = body.Description
@@ -176,6 +290,20 @@ func (h *UpdateExecutorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	// Execute
 	result, err := h.updateExecutor.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrExecutorNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := UpdateExecutor500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
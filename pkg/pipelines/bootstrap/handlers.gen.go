@@ -3,7 +3,6 @@
 package bootstrap
 
 import (
-	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
@@ -36,16 +35,15 @@ func NewApplicationHandlers(
 	pipelineRepo repositories.PipelineRepository,
 	runRepo repositories.RunRepository,
 	toolRepo repositories.ToolRepository,
-	publisher events.Publisher,
 ) *ApplicationHandlers {
 	return &ApplicationHandlers{
-		CreatePipeline:                handlers.NewCreatePipeline(pipelineRepo, publisher),
+		CreatePipeline:                handlers.NewCreatePipeline(pipelineRepo),
 		CreatePipelineStep:            handlers.NewCreatePipelineStep(),
-		CreateRun:                     handlers.NewCreateRun(runRepo, publisher),
-		CreateTool:                    handlers.NewCreateTool(toolRepo, publisher),
-		DeletePipeline:                handlers.NewDeletePipeline(pipelineRepo, publisher),
-		DeleteRun:                     handlers.NewDeleteRun(runRepo, publisher),
-		DeleteTool:                    handlers.NewDeleteTool(toolRepo, publisher),
+		CreateRun:                     handlers.NewCreateRun(runRepo),
+		CreateTool:                    handlers.NewCreateTool(toolRepo),
+		DeletePipeline:                handlers.NewDeletePipeline(pipelineRepo),
+		DeleteRun:                     handlers.NewDeleteRun(runRepo),
+		DeleteTool:                    handlers.NewDeleteTool(toolRepo),
 		GetPipeline:                   handlers.NewGetPipeline(pipelineRepo),
 		GetPipelineExecutionPlan:      handlers.NewGetPipelineExecutionPlan(),
 		GetPipelineSteps:              handlers.NewGetPipelineSteps(),
@@ -54,9 +52,9 @@ func NewApplicationHandlers(
 		ListPipelines:                 handlers.NewListPipelines(pipelineRepo),
 		ListRuns:                      handlers.NewListRuns(runRepo),
 		ListTools:                     handlers.NewListTools(toolRepo),
-		UpdatePipeline:                handlers.NewUpdatePipeline(pipelineRepo, publisher),
-		UpdateRun:                     handlers.NewUpdateRun(runRepo, publisher),
-		UpdateTool:                    handlers.NewUpdateTool(toolRepo, publisher),
+		UpdatePipeline:                handlers.NewUpdatePipeline(pipelineRepo),
+		UpdateRun:                     handlers.NewUpdateRun(runRepo),
+		UpdateTool:                    handlers.NewUpdateTool(toolRepo),
 		ValidatePipelineExecutionPlan: handlers.NewValidatePipelineExecutionPlan(),
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/pipelines/routes"
 )
 
@@ -33,27 +34,28 @@ type HTTPHandlers struct {
 }
 
 // NewHTTPHandlers creates all HTTP handlers from the given application handlers.
-func NewHTTPHandlers(appHandlers *ApplicationHandlers) *HTTPHandlers {
+// Operations that declare x-codegen-permissions are checked with the authorizer.
+func NewHTTPHandlers(appHandlers *ApplicationHandlers, authorizer auth.Authorizer) *HTTPHandlers {
 	return &HTTPHandlers{
-		CreatePipeline:                routes.NewCreatePipelineHandler(appHandlers.CreatePipeline),
-		CreatePipelineStep:            routes.NewCreatePipelineStepHandler(appHandlers.CreatePipelineStep),
-		CreateRun:                     routes.NewCreateRunHandler(appHandlers.CreateRun),
-		CreateTool:                    routes.NewCreateToolHandler(appHandlers.CreateTool),
-		DeletePipeline:                routes.NewDeletePipelineHandler(appHandlers.DeletePipeline),
-		DeleteRun:                     routes.NewDeleteRunHandler(appHandlers.DeleteRun),
-		DeleteTool:                    routes.NewDeleteToolHandler(appHandlers.DeleteTool),
-		GetPipeline:                   routes.NewGetPipelineHandler(appHandlers.GetPipeline),
-		GetPipelineExecutionPlan:      routes.NewGetPipelineExecutionPlanHandler(appHandlers.GetPipelineExecutionPlan),
-		GetPipelineSteps:              routes.NewGetPipelineStepsHandler(appHandlers.GetPipelineSteps),
-		GetRun:                        routes.NewGetRunHandler(appHandlers.GetRun),
-		GetTool:                       routes.NewGetToolHandler(appHandlers.GetTool),
-		ListPipelines:                 routes.NewListPipelinesHandler(appHandlers.ListPipelines),
-		ListRuns:                      routes.NewListRunsHandler(appHandlers.ListRuns),
-		ListTools:                     routes.NewListToolsHandler(appHandlers.ListTools),
-		UpdatePipeline:                routes.NewUpdatePipelineHandler(appHandlers.UpdatePipeline),
-		UpdateRun:                     routes.NewUpdateRunHandler(appHandlers.UpdateRun),
-		UpdateTool:                    routes.NewUpdateToolHandler(appHandlers.UpdateTool),
-		ValidatePipelineExecutionPlan: routes.NewValidatePipelineExecutionPlanHandler(appHandlers.ValidatePipelineExecutionPlan),
+		CreatePipeline:                routes.NewCreatePipelineHandler(appHandlers.CreatePipeline, authorizer),
+		CreatePipelineStep:            routes.NewCreatePipelineStepHandler(appHandlers.CreatePipelineStep, authorizer),
+		CreateRun:                     routes.NewCreateRunHandler(appHandlers.CreateRun, authorizer),
+		CreateTool:                    routes.NewCreateToolHandler(appHandlers.CreateTool, authorizer),
+		DeletePipeline:                routes.NewDeletePipelineHandler(appHandlers.DeletePipeline, authorizer),
+		DeleteRun:                     routes.NewDeleteRunHandler(appHandlers.DeleteRun, authorizer),
+		DeleteTool:                    routes.NewDeleteToolHandler(appHandlers.DeleteTool, authorizer),
+		GetPipeline:                   routes.NewGetPipelineHandler(appHandlers.GetPipeline, authorizer),
+		GetPipelineExecutionPlan:      routes.NewGetPipelineExecutionPlanHandler(appHandlers.GetPipelineExecutionPlan, authorizer),
+		GetPipelineSteps:              routes.NewGetPipelineStepsHandler(appHandlers.GetPipelineSteps, authorizer),
+		GetRun:                        routes.NewGetRunHandler(appHandlers.GetRun, authorizer),
+		GetTool:                       routes.NewGetToolHandler(appHandlers.GetTool, authorizer),
+		ListPipelines:                 routes.NewListPipelinesHandler(appHandlers.ListPipelines, authorizer),
+		ListRuns:                      routes.NewListRunsHandler(appHandlers.ListRuns, authorizer),
+		ListTools:                     routes.NewListToolsHandler(appHandlers.ListTools, authorizer),
+		UpdatePipeline:                routes.NewUpdatePipelineHandler(appHandlers.UpdatePipeline, authorizer),
+		UpdateRun:                     routes.NewUpdateRunHandler(appHandlers.UpdateRun, authorizer),
+		UpdateTool:                    routes.NewUpdateToolHandler(appHandlers.UpdateTool, authorizer),
+		ValidatePipelineExecutionPlan: routes.NewValidatePipelineExecutionPlanHandler(appHandlers.ValidatePipelineExecutionPlan, authorizer),
 	}
 }
 
@@ -9,7 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
@@ -20,6 +20,7 @@ import (
 
 // CreatePipelineInput represents the input for the CreatePipeline operation.
 type CreatePipelineInput struct {
+	SessionID   uuid.UUID
 	Description *string
 	Name        *string
 }
@@ -36,28 +37,31 @@ type CreatePipeline interface {
 
 // CreatePipelineImpl is the default implementation of CreatePipeline.
 type CreatePipelineImpl struct {
-	repo      repositories.PipelineRepository
-	publisher events.Publisher
+	repo repositories.PipelineRepository
 }
 
 // NewCreatePipeline creates a new CreatePipeline handler.
 func NewCreatePipeline(
 	repo repositories.PipelineRepository,
-	publisher events.Publisher,
 ) CreatePipeline {
 	return &CreatePipelineImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
 // Execute performs the CreatePipeline operation.
 func (h *CreatePipelineImpl) Execute(ctx context.Context, input *CreatePipelineInput) (*CreatePipelineOutput, error) {
+	organizationID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create entity
 	entity := &models.Pipeline{
-		ID:        uuid.New(),
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ID:             uuid.New(),
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+		OrganizationID: organizationID,
 		// TODO: Map input fields to entity
 	}
 
@@ -67,15 +71,10 @@ func (h *CreatePipelineImpl) Execute(ctx context.Context, input *CreatePipelineI
 		return nil, fmt.Errorf("failed to create pipeline: %w", err)
 	}
 
-	// Publish event
-	event := models.NewPipelineCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &CreatePipelineOutput{
-		// TODO: Map created entity to output
+		Data: *created,
 	}
-	_ = created
 
 	return output, nil
 }
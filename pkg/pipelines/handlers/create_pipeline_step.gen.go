@@ -16,7 +16,9 @@ import (
 
 // CreatePipelineStepInput represents the input for the CreatePipelineStep operation.
 type CreatePipelineStepInput struct {
+	SessionID    uuid.UUID
 	ID           uuid.UUID
+	Config       *models.StepConfig
 	Dependencies []uuid.UUID
 	Description  *string
 	Name         string
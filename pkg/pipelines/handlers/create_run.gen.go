@@ -9,7 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
@@ -20,6 +20,7 @@ import (
 
 // CreateRunInput represents the input for the CreateRun operation.
 type CreateRunInput struct {
+	SessionID  uuid.UUID
 	PipelineID uuid.UUID
 }
 
@@ -35,28 +36,31 @@ type CreateRun interface {
 
 // CreateRunImpl is the default implementation of CreateRun.
 type CreateRunImpl struct {
-	repo      repositories.RunRepository
-	publisher events.Publisher
+	repo repositories.RunRepository
 }
 
 // NewCreateRun creates a new CreateRun handler.
 func NewCreateRun(
 	repo repositories.RunRepository,
-	publisher events.Publisher,
 ) CreateRun {
 	return &CreateRunImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
 // Execute performs the CreateRun operation.
 func (h *CreateRunImpl) Execute(ctx context.Context, input *CreateRunInput) (*CreateRunOutput, error) {
+	organizationID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create entity
 	entity := &models.Run{
-		ID:        uuid.New(),
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ID:             uuid.New(),
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+		OrganizationID: organizationID,
 		// TODO: Map input fields to entity
 	}
 
@@ -66,15 +70,10 @@ func (h *CreateRunImpl) Execute(ctx context.Context, input *CreateRunInput) (*Cr
 		return nil, fmt.Errorf("failed to create run: %w", err)
 	}
 
-	// Publish event
-	event := models.NewRunCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &CreateRunOutput{
-		// TODO: Map created entity to output
+		Data: *created,
 	}
-	_ = created
 
 	return output, nil
 }
@@ -9,7 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
@@ -20,6 +20,7 @@ import (
 
 // CreateToolInput represents the input for the CreateTool operation.
 type CreateToolInput struct {
+	SessionID   uuid.UUID
 	Description string
 	Name        string
 }
@@ -36,28 +37,31 @@ type CreateTool interface {
 
 // CreateToolImpl is the default implementation of CreateTool.
 type CreateToolImpl struct {
-	repo      repositories.ToolRepository
-	publisher events.Publisher
+	repo repositories.ToolRepository
 }
 
 // NewCreateTool creates a new CreateTool handler.
 func NewCreateTool(
 	repo repositories.ToolRepository,
-	publisher events.Publisher,
 ) CreateTool {
 	return &CreateToolImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
 // Execute performs the CreateTool operation.
 func (h *CreateToolImpl) Execute(ctx context.Context, input *CreateToolInput) (*CreateToolOutput, error) {
+	organizationID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create entity
 	entity := &models.Tool{
-		ID:        uuid.New(),
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ID:             uuid.New(),
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+		OrganizationID: organizationID,
 		// TODO: Map input fields to entity
 	}
 
@@ -67,15 +71,10 @@ func (h *CreateToolImpl) Execute(ctx context.Context, input *CreateToolInput) (*
 		return nil, fmt.Errorf("failed to create tool: %w", err)
 	}
 
-	// Publish event
-	event := models.NewToolCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &CreateToolOutput{
-		// TODO: Map created entity to output
+		Data: *created,
 	}
-	_ = created
 
 	return output, nil
 }
@@ -8,8 +8,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
-	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
 
@@ -19,7 +17,8 @@ import (
 
 // DeletePipelineInput represents the input for the DeletePipeline operation.
 type DeletePipelineInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // DeletePipeline defines the interface for the DeletePipeline operation.
@@ -29,18 +28,15 @@ type DeletePipeline interface {
 
 // DeletePipelineImpl is the default implementation of DeletePipeline.
 type DeletePipelineImpl struct {
-	repo      repositories.PipelineRepository
-	publisher events.Publisher
+	repo repositories.PipelineRepository
 }
 
 // NewDeletePipeline creates a new DeletePipeline handler.
 func NewDeletePipeline(
 	repo repositories.PipelineRepository,
-	publisher events.Publisher,
 ) DeletePipeline {
 	return &DeletePipelineImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -51,9 +47,5 @@ func (h *DeletePipelineImpl) Execute(ctx context.Context, input *DeletePipelineI
 		return fmt.Errorf("failed to delete pipeline: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewPipelineDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
@@ -8,8 +8,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
-	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
 
@@ -19,7 +17,8 @@ import (
 
 // DeleteRunInput represents the input for the DeleteRun operation.
 type DeleteRunInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // DeleteRun defines the interface for the DeleteRun operation.
@@ -29,18 +28,15 @@ type DeleteRun interface {
 
 // DeleteRunImpl is the default implementation of DeleteRun.
 type DeleteRunImpl struct {
-	repo      repositories.RunRepository
-	publisher events.Publisher
+	repo repositories.RunRepository
 }
 
 // NewDeleteRun creates a new DeleteRun handler.
 func NewDeleteRun(
 	repo repositories.RunRepository,
-	publisher events.Publisher,
 ) DeleteRun {
 	return &DeleteRunImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -51,9 +47,5 @@ func (h *DeleteRunImpl) Execute(ctx context.Context, input *DeleteRunInput) erro
 		return fmt.Errorf("failed to delete run: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewRunDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
@@ -8,8 +8,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
-	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
 
@@ -19,7 +17,8 @@ import (
 
 // DeleteToolInput represents the input for the DeleteTool operation.
 type DeleteToolInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // DeleteTool defines the interface for the DeleteTool operation.
@@ -29,18 +28,15 @@ type DeleteTool interface {
 
 // DeleteToolImpl is the default implementation of DeleteTool.
 type DeleteToolImpl struct {
-	repo      repositories.ToolRepository
-	publisher events.Publisher
+	repo repositories.ToolRepository
 }
 
 // NewDeleteTool creates a new DeleteTool handler.
 func NewDeleteTool(
 	repo repositories.ToolRepository,
-	publisher events.Publisher,
 ) DeleteTool {
 	return &DeleteToolImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -51,9 +47,5 @@ func (h *DeleteToolImpl) Execute(ctx context.Context, input *DeleteToolInput) er
 		return fmt.Errorf("failed to delete tool: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewToolDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
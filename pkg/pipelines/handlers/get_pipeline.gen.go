@@ -18,7 +18,8 @@ import (
 
 // GetPipelineInput represents the input for the GetPipeline operation.
 type GetPipelineInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // GetPipelineOutput represents the output for the GetPipeline operation.
@@ -55,9 +56,8 @@ func (h *GetPipelineImpl) Execute(ctx context.Context, input *GetPipelineInput)
 
 	// Map to output
 	output := &GetPipelineOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
@@ -14,7 +14,8 @@ import (
 
 // GetPipelineExecutionPlanInput represents the input for the GetPipelineExecutionPlan operation.
 type GetPipelineExecutionPlanInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // GetPipelineExecutionPlanOutputData defines the data structure in the output.
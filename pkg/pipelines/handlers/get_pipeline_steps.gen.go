@@ -16,7 +16,8 @@ import (
 
 // GetPipelineStepsInput represents the input for the GetPipelineSteps operation.
 type GetPipelineStepsInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // GetPipelineStepsOutput represents the output for the GetPipelineSteps operation.
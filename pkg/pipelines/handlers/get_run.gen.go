@@ -18,7 +18,8 @@ import (
 
 // GetRunInput represents the input for the GetRun operation.
 type GetRunInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // GetRunOutput represents the output for the GetRun operation.
@@ -55,9 +56,8 @@ func (h *GetRunImpl) Execute(ctx context.Context, input *GetRunInput) (*GetRunOu
 
 	// Map to output
 	output := &GetRunOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
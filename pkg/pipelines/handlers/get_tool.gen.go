@@ -18,7 +18,8 @@ import (
 
 // GetToolInput represents the input for the GetTool operation.
 type GetToolInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // GetToolOutput represents the output for the GetTool operation.
@@ -55,9 +56,8 @@ func (h *GetToolImpl) Execute(ctx context.Context, input *GetToolInput) (*GetToo
 
 	// Map to output
 	output := &GetToolOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
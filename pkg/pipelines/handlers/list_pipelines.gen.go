@@ -6,6 +6,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
@@ -17,15 +20,18 @@ import (
 
 // ListPipelinesInput represents the input for the ListPipelines operation.
 type ListPipelinesInput struct {
-	Filter map[string]any
-	Page   map[string]any
-	Sort   []map[string]any
+	SessionID uuid.UUID
+	Filter    map[string]any
+	Page      map[string]any
+	Q         *string
+	Sort      []map[string]any
 }
 
 // ListPipelinesOutput represents the output for the ListPipelines operation.
 type ListPipelinesOutput struct {
-	Data []models.Pipeline           `json:"data"`
-	Meta servermodels.PaginationMeta `json:"meta"`
+	Data       []models.Pipeline           `json:"data"`
+	Highlights map[string]string           `json:"highlights,omitempty"`
+	Meta       servermodels.PaginationMeta `json:"meta"`
 }
 
 // ListPipelines defines the interface for the ListPipelines operation.
@@ -49,20 +55,39 @@ func NewListPipelines(
 
 // Execute performs the ListPipelines operation.
 func (h *ListPipelinesImpl) Execute(ctx context.Context, input *ListPipelinesInput) (*ListPipelinesOutput, error) {
+	opts, err := database.NewListOptions(input.Filter, input.Page, input.Sort)
+	if err != nil {
+		return nil, err
+	}
+	if input.Q != nil {
+		opts.Search = *input.Q
+	}
+
 	// List from repository
-	results, total, err := h.repo.List(ctx, 100, 0) // TODO: Use pagination from input
+	results, info, err := h.repo.List(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pipelines: %w", err)
 	}
 
 	// Map to output
+	data := make([]models.Pipeline, len(results))
+	for i, result := range results {
+		data[i] = *result
+	}
 	output := &ListPipelinesOutput{
-		// TODO: Map results to output structure
-		// Data: results,
-		// Total: total,
+		Data: data,
+		Meta: servermodels.PaginationMeta{
+			Total: int32(info.Total),
+			Next:  info.Next,
+			Prev:  info.Prev,
+		},
+	}
+	if info.Snippets != nil {
+		output.Highlights = make(map[string]string, len(info.Snippets))
+		for id, snippet := range info.Snippets {
+			output.Highlights[id.String()] = snippet
+		}
 	}
-	_ = results
-	_ = total
 
 	return output, nil
 }
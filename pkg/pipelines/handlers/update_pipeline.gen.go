@@ -9,7 +9,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
@@ -20,6 +19,7 @@ import (
 
 // UpdatePipelineInput represents the input for the UpdatePipeline operation.
 type UpdatePipelineInput struct {
+	SessionID   uuid.UUID
 	ID          uuid.UUID
 	Description *string
 	Name        *string
@@ -37,18 +37,15 @@ type UpdatePipeline interface {
 
 // UpdatePipelineImpl is the default implementation of UpdatePipeline.
 type UpdatePipelineImpl struct {
-	repo      repositories.PipelineRepository
-	publisher events.Publisher
+	repo repositories.PipelineRepository
 }
 
 // NewUpdatePipeline creates a new UpdatePipeline handler.
 func NewUpdatePipeline(
 	repo repositories.PipelineRepository,
-	publisher events.Publisher,
 ) UpdatePipeline {
 	return &UpdatePipelineImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -70,15 +67,10 @@ func (h *UpdatePipelineImpl) Execute(ctx context.Context, input *UpdatePipelineI
 		return nil, fmt.Errorf("failed to update pipeline: %w", err)
 	}
 
-	// Publish event
-	event := models.NewPipelineUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdatePipelineOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
@@ -9,7 +9,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
@@ -20,6 +19,7 @@ import (
 
 // UpdateRunInput represents the input for the UpdateRun operation.
 type UpdateRunInput struct {
+	SessionID  uuid.UUID
 	ID         uuid.UUID
 	PipelineID *uuid.UUID
 }
@@ -36,18 +36,15 @@ type UpdateRun interface {
 
 // UpdateRunImpl is the default implementation of UpdateRun.
 type UpdateRunImpl struct {
-	repo      repositories.RunRepository
-	publisher events.Publisher
+	repo repositories.RunRepository
 }
 
 // NewUpdateRun creates a new UpdateRun handler.
 func NewUpdateRun(
 	repo repositories.RunRepository,
-	publisher events.Publisher,
 ) UpdateRun {
 	return &UpdateRunImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -69,15 +66,10 @@ func (h *UpdateRunImpl) Execute(ctx context.Context, input *UpdateRunInput) (*Up
 		return nil, fmt.Errorf("failed to update run: %w", err)
 	}
 
-	// Publish event
-	event := models.NewRunUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdateRunOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
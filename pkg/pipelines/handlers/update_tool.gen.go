@@ -9,7 +9,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/pipelines/repositories"
 )
@@ -20,6 +19,7 @@ import (
 
 // UpdateToolInput represents the input for the UpdateTool operation.
 type UpdateToolInput struct {
+	SessionID   uuid.UUID
 	ID          uuid.UUID
 	Description *string
 	Name        *string
@@ -37,18 +37,15 @@ type UpdateTool interface {
 
 // UpdateToolImpl is the default implementation of UpdateTool.
 type UpdateToolImpl struct {
-	repo      repositories.ToolRepository
-	publisher events.Publisher
+	repo repositories.ToolRepository
 }
 
 // NewUpdateTool creates a new UpdateTool handler.
 func NewUpdateTool(
 	repo repositories.ToolRepository,
-	publisher events.Publisher,
 ) UpdateTool {
 	return &UpdateToolImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -70,15 +67,10 @@ func (h *UpdateToolImpl) Execute(ctx context.Context, input *UpdateToolInput) (*
 		return nil, fmt.Errorf("failed to update tool: %w", err)
 	}
 
-	// Publish event
-	event := models.NewToolUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdateToolOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
@@ -16,7 +16,8 @@ import (
 
 // ValidatePipelineExecutionPlanInput represents the input for the ValidatePipelineExecutionPlan operation.
 type ValidatePipelineExecutionPlanInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // ValidatePipelineExecutionPlanOutput represents the output for the ValidatePipelineExecutionPlan operation.
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/pipelines/handlers"
+)
+
+var _ handlers.CreateRun = (*CreateRun)(nil)
+
+// CreateRun is a mock of handlers.CreateRun that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type CreateRun struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.CreateRunInput) (*handlers.CreateRunOutput, error)
+
+	executeCalls   []CreateRunExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.CreateRunInput) (*handlers.CreateRunOutput, error)
+	executeReturns func(context.Context, *handlers.CreateRunInput) (*handlers.CreateRunOutput, error)
+}
+
+// CreateRunExecuteCall holds the arguments of a call to Execute.
+type CreateRunExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.CreateRunInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *CreateRun) Execute(ctx context.Context, input *handlers.CreateRunInput) (r0 *handlers.CreateRunOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, CreateRunExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *CreateRun) ExecuteReturns(r0 *handlers.CreateRunOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.CreateRunInput) (*handlers.CreateRunOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *CreateRun) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.CreateRunInput) (*handlers.CreateRunOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.CreateRunInput) (*handlers.CreateRunOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *CreateRun) ExecuteCalls() []CreateRunExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/pipelines/handlers"
+)
+
+var _ handlers.ListPipelines = (*ListPipelines)(nil)
+
+// ListPipelines is a mock of handlers.ListPipelines that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type ListPipelines struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.ListPipelinesInput) (*handlers.ListPipelinesOutput, error)
+
+	executeCalls   []ListPipelinesExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.ListPipelinesInput) (*handlers.ListPipelinesOutput, error)
+	executeReturns func(context.Context, *handlers.ListPipelinesInput) (*handlers.ListPipelinesOutput, error)
+}
+
+// ListPipelinesExecuteCall holds the arguments of a call to Execute.
+type ListPipelinesExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.ListPipelinesInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *ListPipelines) Execute(ctx context.Context, input *handlers.ListPipelinesInput) (r0 *handlers.ListPipelinesOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, ListPipelinesExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *ListPipelines) ExecuteReturns(r0 *handlers.ListPipelinesOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.ListPipelinesInput) (*handlers.ListPipelinesOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *ListPipelines) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.ListPipelinesInput) (*handlers.ListPipelinesOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.ListPipelinesInput) (*handlers.ListPipelinesOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *ListPipelines) ExecuteCalls() []ListPipelinesExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
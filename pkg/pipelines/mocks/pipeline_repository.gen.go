@@ -0,0 +1,398 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+	"github.com/archesai/archesai/pkg/pipelines/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.PipelineRepository = (*PipelineRepository)(nil)
+
+// PipelineRepository is a mock of repositories.PipelineRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type PipelineRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Pipeline) (*models.Pipeline, error)
+
+	createCalls   []PipelineRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Pipeline) (*models.Pipeline, error)
+	createReturns func(context.Context, *models.Pipeline) (*models.Pipeline, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Pipeline, error)
+
+	getCalls   []PipelineRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Pipeline, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Pipeline, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Pipeline) (*models.Pipeline, error)
+
+	updateCalls   []PipelineRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Pipeline) (*models.Pipeline, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Pipeline) (*models.Pipeline, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []PipelineRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Pipeline, database.PageInfo, error)
+
+	listCalls   []PipelineRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Pipeline, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Pipeline, database.PageInfo, error)
+
+	// ListPipelinesByOrganizationFunc, if set, is called by ListPipelinesByOrganization.
+	ListPipelinesByOrganizationFunc func(ctx context.Context, organizationID string) ([]*models.Pipeline, error)
+
+	listPipelinesByOrganizationCalls   []PipelineRepositoryListPipelinesByOrganizationCall
+	listPipelinesByOrganizationOnCall  map[int]func(context.Context, string) ([]*models.Pipeline, error)
+	listPipelinesByOrganizationReturns func(context.Context, string) ([]*models.Pipeline, error)
+}
+
+// PipelineRepositoryCreateCall holds the arguments of a call to Create.
+type PipelineRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Pipeline
+}
+
+// Create records the call and returns the configured result.
+func (m *PipelineRepository) Create(ctx context.Context, entity *models.Pipeline) (r0 *models.Pipeline, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, PipelineRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *PipelineRepository) CreateReturns(r0 *models.Pipeline, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Pipeline) (*models.Pipeline, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Pipeline) (*models.Pipeline, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Pipeline) (*models.Pipeline, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *PipelineRepository) CreateCalls() []PipelineRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// PipelineRepositoryGetCall holds the arguments of a call to Get.
+type PipelineRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *PipelineRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Pipeline, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, PipelineRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *PipelineRepository) GetReturns(r0 *models.Pipeline, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Pipeline, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Pipeline, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Pipeline, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *PipelineRepository) GetCalls() []PipelineRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// PipelineRepositoryUpdateCall holds the arguments of a call to Update.
+type PipelineRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Pipeline
+}
+
+// Update records the call and returns the configured result.
+func (m *PipelineRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Pipeline) (r0 *models.Pipeline, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, PipelineRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *PipelineRepository) UpdateReturns(r0 *models.Pipeline, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Pipeline) (*models.Pipeline, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Pipeline) (*models.Pipeline, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Pipeline) (*models.Pipeline, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *PipelineRepository) UpdateCalls() []PipelineRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// PipelineRepositoryDeleteCall holds the arguments of a call to Delete.
+type PipelineRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *PipelineRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, PipelineRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *PipelineRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *PipelineRepository) DeleteCalls() []PipelineRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// PipelineRepositoryListCall holds the arguments of a call to List.
+type PipelineRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *PipelineRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Pipeline, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, PipelineRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *PipelineRepository) ListReturns(r0 []*models.Pipeline, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Pipeline, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Pipeline, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Pipeline, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *PipelineRepository) ListCalls() []PipelineRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// PipelineRepositoryListPipelinesByOrganizationCall holds the arguments of a call to ListPipelinesByOrganization.
+type PipelineRepositoryListPipelinesByOrganizationCall struct {
+	Ctx            context.Context
+	OrganizationID string
+}
+
+// ListPipelinesByOrganization records the call and returns the configured result.
+func (m *PipelineRepository) ListPipelinesByOrganization(ctx context.Context, organizationID string) (r0 []*models.Pipeline, r1 error) {
+	m.mu.Lock()
+	m.listPipelinesByOrganizationCalls = append(m.listPipelinesByOrganizationCalls, PipelineRepositoryListPipelinesByOrganizationCall{
+		Ctx:            ctx,
+		OrganizationID: organizationID,
+	})
+	fn := m.listPipelinesByOrganizationOnCall[len(m.listPipelinesByOrganizationCalls)-1]
+	if fn == nil {
+		fn = m.ListPipelinesByOrganizationFunc
+	}
+	if fn == nil {
+		fn = m.listPipelinesByOrganizationReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, organizationID)
+}
+
+// ListPipelinesByOrganizationReturns makes calls to ListPipelinesByOrganization return the given values.
+func (m *PipelineRepository) ListPipelinesByOrganizationReturns(r0 []*models.Pipeline, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listPipelinesByOrganizationReturns = func(context.Context, string) ([]*models.Pipeline, error) {
+		return r0, r1
+	}
+}
+
+// OnListPipelinesByOrganizationCall makes the call to ListPipelinesByOrganization with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineRepository) OnListPipelinesByOrganizationCall(i int, fn func(ctx context.Context, organizationID string) ([]*models.Pipeline, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listPipelinesByOrganizationOnCall == nil {
+		m.listPipelinesByOrganizationOnCall = make(map[int]func(context.Context, string) ([]*models.Pipeline, error))
+	}
+	m.listPipelinesByOrganizationOnCall[i] = fn
+}
+
+// ListPipelinesByOrganizationCalls returns the calls made to ListPipelinesByOrganization, in order.
+func (m *PipelineRepository) ListPipelinesByOrganizationCalls() []PipelineRepositoryListPipelinesByOrganizationCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listPipelinesByOrganizationCalls)
+}
@@ -0,0 +1,336 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+	"github.com/archesai/archesai/pkg/pipelines/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.PipelineStepRepository = (*PipelineStepRepository)(nil)
+
+// PipelineStepRepository is a mock of repositories.PipelineStepRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type PipelineStepRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.PipelineStep) (*models.PipelineStep, error)
+
+	createCalls   []PipelineStepRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.PipelineStep) (*models.PipelineStep, error)
+	createReturns func(context.Context, *models.PipelineStep) (*models.PipelineStep, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.PipelineStep, error)
+
+	getCalls   []PipelineStepRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.PipelineStep, error)
+	getReturns func(context.Context, uuid.UUID) (*models.PipelineStep, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.PipelineStep) (*models.PipelineStep, error)
+
+	updateCalls   []PipelineStepRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.PipelineStep) (*models.PipelineStep, error)
+	updateReturns func(context.Context, uuid.UUID, *models.PipelineStep) (*models.PipelineStep, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []PipelineStepRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.PipelineStep, database.PageInfo, error)
+
+	listCalls   []PipelineStepRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.PipelineStep, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.PipelineStep, database.PageInfo, error)
+}
+
+// PipelineStepRepositoryCreateCall holds the arguments of a call to Create.
+type PipelineStepRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.PipelineStep
+}
+
+// Create records the call and returns the configured result.
+func (m *PipelineStepRepository) Create(ctx context.Context, entity *models.PipelineStep) (r0 *models.PipelineStep, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, PipelineStepRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *PipelineStepRepository) CreateReturns(r0 *models.PipelineStep, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.PipelineStep) (*models.PipelineStep, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineStepRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.PipelineStep) (*models.PipelineStep, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.PipelineStep) (*models.PipelineStep, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *PipelineStepRepository) CreateCalls() []PipelineStepRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// PipelineStepRepositoryGetCall holds the arguments of a call to Get.
+type PipelineStepRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *PipelineStepRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.PipelineStep, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, PipelineStepRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *PipelineStepRepository) GetReturns(r0 *models.PipelineStep, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.PipelineStep, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineStepRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.PipelineStep, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.PipelineStep, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *PipelineStepRepository) GetCalls() []PipelineStepRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// PipelineStepRepositoryUpdateCall holds the arguments of a call to Update.
+type PipelineStepRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.PipelineStep
+}
+
+// Update records the call and returns the configured result.
+func (m *PipelineStepRepository) Update(ctx context.Context, id uuid.UUID, entity *models.PipelineStep) (r0 *models.PipelineStep, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, PipelineStepRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *PipelineStepRepository) UpdateReturns(r0 *models.PipelineStep, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.PipelineStep) (*models.PipelineStep, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineStepRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.PipelineStep) (*models.PipelineStep, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.PipelineStep) (*models.PipelineStep, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *PipelineStepRepository) UpdateCalls() []PipelineStepRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// PipelineStepRepositoryDeleteCall holds the arguments of a call to Delete.
+type PipelineStepRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *PipelineStepRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, PipelineStepRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *PipelineStepRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineStepRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *PipelineStepRepository) DeleteCalls() []PipelineStepRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// PipelineStepRepositoryListCall holds the arguments of a call to List.
+type PipelineStepRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *PipelineStepRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.PipelineStep, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, PipelineStepRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *PipelineStepRepository) ListReturns(r0 []*models.PipelineStep, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.PipelineStep, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *PipelineStepRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.PipelineStep, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.PipelineStep, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *PipelineStepRepository) ListCalls() []PipelineStepRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
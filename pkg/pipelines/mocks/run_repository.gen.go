@@ -0,0 +1,522 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+	"github.com/archesai/archesai/pkg/pipelines/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.RunRepository = (*RunRepository)(nil)
+
+// RunRepository is a mock of repositories.RunRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type RunRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Run) (*models.Run, error)
+
+	createCalls   []RunRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Run) (*models.Run, error)
+	createReturns func(context.Context, *models.Run) (*models.Run, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Run, error)
+
+	getCalls   []RunRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Run, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Run, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Run) (*models.Run, error)
+
+	updateCalls   []RunRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Run) (*models.Run, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Run) (*models.Run, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []RunRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Run, database.PageInfo, error)
+
+	listCalls   []RunRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Run, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Run, database.PageInfo, error)
+
+	// ListRunsByPipelineFunc, if set, is called by ListRunsByPipeline.
+	ListRunsByPipelineFunc func(ctx context.Context, pipelineID string) ([]*models.Run, error)
+
+	listRunsByPipelineCalls   []RunRepositoryListRunsByPipelineCall
+	listRunsByPipelineOnCall  map[int]func(context.Context, string) ([]*models.Run, error)
+	listRunsByPipelineReturns func(context.Context, string) ([]*models.Run, error)
+
+	// ListRunsByOrganizationFunc, if set, is called by ListRunsByOrganization.
+	ListRunsByOrganizationFunc func(ctx context.Context, organizationID string) ([]*models.Run, error)
+
+	listRunsByOrganizationCalls   []RunRepositoryListRunsByOrganizationCall
+	listRunsByOrganizationOnCall  map[int]func(context.Context, string) ([]*models.Run, error)
+	listRunsByOrganizationReturns func(context.Context, string) ([]*models.Run, error)
+
+	// ListRunsByToolFunc, if set, is called by ListRunsByTool.
+	ListRunsByToolFunc func(ctx context.Context, toolID string) ([]*models.Run, error)
+
+	listRunsByToolCalls   []RunRepositoryListRunsByToolCall
+	listRunsByToolOnCall  map[int]func(context.Context, string) ([]*models.Run, error)
+	listRunsByToolReturns func(context.Context, string) ([]*models.Run, error)
+}
+
+// RunRepositoryCreateCall holds the arguments of a call to Create.
+type RunRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Run
+}
+
+// Create records the call and returns the configured result.
+func (m *RunRepository) Create(ctx context.Context, entity *models.Run) (r0 *models.Run, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, RunRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *RunRepository) CreateReturns(r0 *models.Run, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Run) (*models.Run, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *RunRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Run) (*models.Run, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Run) (*models.Run, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *RunRepository) CreateCalls() []RunRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// RunRepositoryGetCall holds the arguments of a call to Get.
+type RunRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *RunRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Run, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, RunRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *RunRepository) GetReturns(r0 *models.Run, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Run, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *RunRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Run, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Run, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *RunRepository) GetCalls() []RunRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// RunRepositoryUpdateCall holds the arguments of a call to Update.
+type RunRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Run
+}
+
+// Update records the call and returns the configured result.
+func (m *RunRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Run) (r0 *models.Run, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, RunRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *RunRepository) UpdateReturns(r0 *models.Run, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Run) (*models.Run, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *RunRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Run) (*models.Run, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Run) (*models.Run, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *RunRepository) UpdateCalls() []RunRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// RunRepositoryDeleteCall holds the arguments of a call to Delete.
+type RunRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *RunRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, RunRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *RunRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *RunRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *RunRepository) DeleteCalls() []RunRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// RunRepositoryListCall holds the arguments of a call to List.
+type RunRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *RunRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Run, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, RunRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *RunRepository) ListReturns(r0 []*models.Run, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Run, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *RunRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Run, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Run, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *RunRepository) ListCalls() []RunRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// RunRepositoryListRunsByPipelineCall holds the arguments of a call to ListRunsByPipeline.
+type RunRepositoryListRunsByPipelineCall struct {
+	Ctx        context.Context
+	PipelineID string
+}
+
+// ListRunsByPipeline records the call and returns the configured result.
+func (m *RunRepository) ListRunsByPipeline(ctx context.Context, pipelineID string) (r0 []*models.Run, r1 error) {
+	m.mu.Lock()
+	m.listRunsByPipelineCalls = append(m.listRunsByPipelineCalls, RunRepositoryListRunsByPipelineCall{
+		Ctx:        ctx,
+		PipelineID: pipelineID,
+	})
+	fn := m.listRunsByPipelineOnCall[len(m.listRunsByPipelineCalls)-1]
+	if fn == nil {
+		fn = m.ListRunsByPipelineFunc
+	}
+	if fn == nil {
+		fn = m.listRunsByPipelineReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, pipelineID)
+}
+
+// ListRunsByPipelineReturns makes calls to ListRunsByPipeline return the given values.
+func (m *RunRepository) ListRunsByPipelineReturns(r0 []*models.Run, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listRunsByPipelineReturns = func(context.Context, string) ([]*models.Run, error) {
+		return r0, r1
+	}
+}
+
+// OnListRunsByPipelineCall makes the call to ListRunsByPipeline with index i, counting from
+// zero, return the result of fn.
+func (m *RunRepository) OnListRunsByPipelineCall(i int, fn func(ctx context.Context, pipelineID string) ([]*models.Run, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listRunsByPipelineOnCall == nil {
+		m.listRunsByPipelineOnCall = make(map[int]func(context.Context, string) ([]*models.Run, error))
+	}
+	m.listRunsByPipelineOnCall[i] = fn
+}
+
+// ListRunsByPipelineCalls returns the calls made to ListRunsByPipeline, in order.
+func (m *RunRepository) ListRunsByPipelineCalls() []RunRepositoryListRunsByPipelineCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listRunsByPipelineCalls)
+}
+
+// RunRepositoryListRunsByOrganizationCall holds the arguments of a call to ListRunsByOrganization.
+type RunRepositoryListRunsByOrganizationCall struct {
+	Ctx            context.Context
+	OrganizationID string
+}
+
+// ListRunsByOrganization records the call and returns the configured result.
+func (m *RunRepository) ListRunsByOrganization(ctx context.Context, organizationID string) (r0 []*models.Run, r1 error) {
+	m.mu.Lock()
+	m.listRunsByOrganizationCalls = append(m.listRunsByOrganizationCalls, RunRepositoryListRunsByOrganizationCall{
+		Ctx:            ctx,
+		OrganizationID: organizationID,
+	})
+	fn := m.listRunsByOrganizationOnCall[len(m.listRunsByOrganizationCalls)-1]
+	if fn == nil {
+		fn = m.ListRunsByOrganizationFunc
+	}
+	if fn == nil {
+		fn = m.listRunsByOrganizationReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, organizationID)
+}
+
+// ListRunsByOrganizationReturns makes calls to ListRunsByOrganization return the given values.
+func (m *RunRepository) ListRunsByOrganizationReturns(r0 []*models.Run, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listRunsByOrganizationReturns = func(context.Context, string) ([]*models.Run, error) {
+		return r0, r1
+	}
+}
+
+// OnListRunsByOrganizationCall makes the call to ListRunsByOrganization with index i, counting from
+// zero, return the result of fn.
+func (m *RunRepository) OnListRunsByOrganizationCall(i int, fn func(ctx context.Context, organizationID string) ([]*models.Run, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listRunsByOrganizationOnCall == nil {
+		m.listRunsByOrganizationOnCall = make(map[int]func(context.Context, string) ([]*models.Run, error))
+	}
+	m.listRunsByOrganizationOnCall[i] = fn
+}
+
+// ListRunsByOrganizationCalls returns the calls made to ListRunsByOrganization, in order.
+func (m *RunRepository) ListRunsByOrganizationCalls() []RunRepositoryListRunsByOrganizationCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listRunsByOrganizationCalls)
+}
+
+// RunRepositoryListRunsByToolCall holds the arguments of a call to ListRunsByTool.
+type RunRepositoryListRunsByToolCall struct {
+	Ctx    context.Context
+	ToolID string
+}
+
+// ListRunsByTool records the call and returns the configured result.
+func (m *RunRepository) ListRunsByTool(ctx context.Context, toolID string) (r0 []*models.Run, r1 error) {
+	m.mu.Lock()
+	m.listRunsByToolCalls = append(m.listRunsByToolCalls, RunRepositoryListRunsByToolCall{
+		Ctx:    ctx,
+		ToolID: toolID,
+	})
+	fn := m.listRunsByToolOnCall[len(m.listRunsByToolCalls)-1]
+	if fn == nil {
+		fn = m.ListRunsByToolFunc
+	}
+	if fn == nil {
+		fn = m.listRunsByToolReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, toolID)
+}
+
+// ListRunsByToolReturns makes calls to ListRunsByTool return the given values.
+func (m *RunRepository) ListRunsByToolReturns(r0 []*models.Run, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listRunsByToolReturns = func(context.Context, string) ([]*models.Run, error) {
+		return r0, r1
+	}
+}
+
+// OnListRunsByToolCall makes the call to ListRunsByTool with index i, counting from
+// zero, return the result of fn.
+func (m *RunRepository) OnListRunsByToolCall(i int, fn func(ctx context.Context, toolID string) ([]*models.Run, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listRunsByToolOnCall == nil {
+		m.listRunsByToolOnCall = make(map[int]func(context.Context, string) ([]*models.Run, error))
+	}
+	m.listRunsByToolOnCall[i] = fn
+}
+
+// ListRunsByToolCalls returns the calls made to ListRunsByTool, in order.
+func (m *RunRepository) ListRunsByToolCalls() []RunRepositoryListRunsByToolCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listRunsByToolCalls)
+}
@@ -0,0 +1,398 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/pipelines/models"
+	"github.com/archesai/archesai/pkg/pipelines/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.ToolRepository = (*ToolRepository)(nil)
+
+// ToolRepository is a mock of repositories.ToolRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type ToolRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Tool) (*models.Tool, error)
+
+	createCalls   []ToolRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Tool) (*models.Tool, error)
+	createReturns func(context.Context, *models.Tool) (*models.Tool, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Tool, error)
+
+	getCalls   []ToolRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Tool, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Tool, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Tool) (*models.Tool, error)
+
+	updateCalls   []ToolRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Tool) (*models.Tool, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Tool) (*models.Tool, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []ToolRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Tool, database.PageInfo, error)
+
+	listCalls   []ToolRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Tool, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Tool, database.PageInfo, error)
+
+	// ListToolsByOrganizationFunc, if set, is called by ListToolsByOrganization.
+	ListToolsByOrganizationFunc func(ctx context.Context, organizationID string) ([]*models.Tool, error)
+
+	listToolsByOrganizationCalls   []ToolRepositoryListToolsByOrganizationCall
+	listToolsByOrganizationOnCall  map[int]func(context.Context, string) ([]*models.Tool, error)
+	listToolsByOrganizationReturns func(context.Context, string) ([]*models.Tool, error)
+}
+
+// ToolRepositoryCreateCall holds the arguments of a call to Create.
+type ToolRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Tool
+}
+
+// Create records the call and returns the configured result.
+func (m *ToolRepository) Create(ctx context.Context, entity *models.Tool) (r0 *models.Tool, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, ToolRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *ToolRepository) CreateReturns(r0 *models.Tool, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Tool) (*models.Tool, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *ToolRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Tool) (*models.Tool, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Tool) (*models.Tool, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *ToolRepository) CreateCalls() []ToolRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// ToolRepositoryGetCall holds the arguments of a call to Get.
+type ToolRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *ToolRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Tool, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, ToolRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *ToolRepository) GetReturns(r0 *models.Tool, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Tool, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *ToolRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Tool, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Tool, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *ToolRepository) GetCalls() []ToolRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// ToolRepositoryUpdateCall holds the arguments of a call to Update.
+type ToolRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Tool
+}
+
+// Update records the call and returns the configured result.
+func (m *ToolRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Tool) (r0 *models.Tool, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, ToolRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *ToolRepository) UpdateReturns(r0 *models.Tool, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Tool) (*models.Tool, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *ToolRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Tool) (*models.Tool, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Tool) (*models.Tool, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *ToolRepository) UpdateCalls() []ToolRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// ToolRepositoryDeleteCall holds the arguments of a call to Delete.
+type ToolRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *ToolRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, ToolRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *ToolRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *ToolRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *ToolRepository) DeleteCalls() []ToolRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// ToolRepositoryListCall holds the arguments of a call to List.
+type ToolRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *ToolRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Tool, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, ToolRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *ToolRepository) ListReturns(r0 []*models.Tool, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Tool, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *ToolRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Tool, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Tool, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *ToolRepository) ListCalls() []ToolRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// ToolRepositoryListToolsByOrganizationCall holds the arguments of a call to ListToolsByOrganization.
+type ToolRepositoryListToolsByOrganizationCall struct {
+	Ctx            context.Context
+	OrganizationID string
+}
+
+// ListToolsByOrganization records the call and returns the configured result.
+func (m *ToolRepository) ListToolsByOrganization(ctx context.Context, organizationID string) (r0 []*models.Tool, r1 error) {
+	m.mu.Lock()
+	m.listToolsByOrganizationCalls = append(m.listToolsByOrganizationCalls, ToolRepositoryListToolsByOrganizationCall{
+		Ctx:            ctx,
+		OrganizationID: organizationID,
+	})
+	fn := m.listToolsByOrganizationOnCall[len(m.listToolsByOrganizationCalls)-1]
+	if fn == nil {
+		fn = m.ListToolsByOrganizationFunc
+	}
+	if fn == nil {
+		fn = m.listToolsByOrganizationReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, organizationID)
+}
+
+// ListToolsByOrganizationReturns makes calls to ListToolsByOrganization return the given values.
+func (m *ToolRepository) ListToolsByOrganizationReturns(r0 []*models.Tool, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listToolsByOrganizationReturns = func(context.Context, string) ([]*models.Tool, error) {
+		return r0, r1
+	}
+}
+
+// OnListToolsByOrganizationCall makes the call to ListToolsByOrganization with index i, counting from
+// zero, return the result of fn.
+func (m *ToolRepository) OnListToolsByOrganizationCall(i int, fn func(ctx context.Context, organizationID string) ([]*models.Tool, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listToolsByOrganizationOnCall == nil {
+		m.listToolsByOrganizationOnCall = make(map[int]func(context.Context, string) ([]*models.Tool, error))
+	}
+	m.listToolsByOrganizationOnCall[i] = fn
+}
+
+// ListToolsByOrganizationCalls returns the calls made to ListToolsByOrganization, in order.
+func (m *ToolRepository) ListToolsByOrganizationCalls() []ToolRepositoryListToolsByOrganizationCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listToolsByOrganizationCalls)
+}
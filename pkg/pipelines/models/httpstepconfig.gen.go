@@ -0,0 +1,183 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HTTPStepConfigKind represents the enumeration of valid values for Kind
+type HTTPStepConfigKind string
+
+// Valid Kind values
+const (
+	HTTPStepConfigKindHTTP HTTPStepConfigKind = "http"
+)
+
+// String returns the string representation
+func (e HTTPStepConfigKind) String() string {
+	return string(e)
+}
+
+// IsValid checks if the value is valid
+func (e HTTPStepConfigKind) IsValid() bool {
+	switch e {
+	case HTTPStepConfigKindHTTP:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseHTTPStepConfigKind parses a string into the enum type
+func ParseHTTPStepConfigKind(s string) (HTTPStepConfigKind, error) {
+	v := HTTPStepConfigKind(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Kind: %s", s)
+	}
+	return v, nil
+}
+
+// HTTPStepConfigMethod represents the enumeration of valid values for Method
+type HTTPStepConfigMethod string
+
+// Valid Method values
+const (
+	HTTPStepConfigMethodGET    HTTPStepConfigMethod = "GET"
+	HTTPStepConfigMethodPOST   HTTPStepConfigMethod = "POST"
+	HTTPStepConfigMethodPUT    HTTPStepConfigMethod = "PUT"
+	HTTPStepConfigMethodPATCH  HTTPStepConfigMethod = "PATCH"
+	HTTPStepConfigMethodDELETE HTTPStepConfigMethod = "DELETE"
+)
+
+// String returns the string representation
+func (e HTTPStepConfigMethod) String() string {
+	return string(e)
+}
+
+// IsValid checks if the value is valid
+func (e HTTPStepConfigMethod) IsValid() bool {
+	switch e {
+	case HTTPStepConfigMethodGET:
+		return true
+	case HTTPStepConfigMethodPOST:
+		return true
+	case HTTPStepConfigMethodPUT:
+		return true
+	case HTTPStepConfigMethodPATCH:
+		return true
+	case HTTPStepConfigMethodDELETE:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseHTTPStepConfigMethod parses a string into the enum type
+func ParseHTTPStepConfigMethod(s string) (HTTPStepConfigMethod, error) {
+	v := HTTPStepConfigMethod(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Method: %s", s)
+	}
+	return v, nil
+}
+
+// HTTPStepConfig represents A step that sends its input to an HTTP endpoint
+type HTTPStepConfig struct {
+
+	// Kind The kind of step, always http
+	Kind *HTTPStepConfigKind `json:"kind,omitempty" yaml:"kind,omitempty"`
+
+	// Method The HTTP method of the request
+	Method HTTPStepConfigMethod `json:"method" yaml:"method"`
+
+	// TimeoutSeconds How long to wait for a response
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty" yaml:"timeoutSeconds,omitempty"`
+
+	// URL The URL the request is sent to
+	URL string `json:"url" yaml:"url"`
+}
+
+// NewHTTPStepConfig creates a new immutable HTTPStepConfig value object.
+// Value objects are immutable and validated upon creation.
+func NewHTTPStepConfig(
+	kind *HTTPStepConfigKind,
+	method HTTPStepConfigMethod,
+	timeoutSeconds *int32,
+	url string,
+) (HTTPStepConfig, error) {
+	// Validate required fields
+	if !method.IsValid() {
+		return HTTPStepConfig{}, fmt.Errorf("invalid Method: %s", method)
+	}
+	if url == "" {
+		return HTTPStepConfig{}, fmt.Errorf("URL cannot be empty")
+	}
+	return HTTPStepConfig{
+		Kind:           kind,
+		Method:         method,
+		TimeoutSeconds: timeoutSeconds,
+		URL:            url,
+	}, nil
+}
+
+// ZeroHTTPStepConfig returns the zero value for HTTPStepConfig.
+// This is useful for comparisons and as a default value.
+func ZeroHTTPStepConfig() HTTPStepConfig {
+	return HTTPStepConfig{}
+}
+
+// GetKind returns the Kind value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v HTTPStepConfig) GetKind() *HTTPStepConfigKind {
+	return v.Kind
+}
+
+// GetMethod returns the Method value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v HTTPStepConfig) GetMethod() HTTPStepConfigMethod {
+	return v.Method
+}
+
+// GetTimeoutSeconds returns the TimeoutSeconds value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v HTTPStepConfig) GetTimeoutSeconds() *int32 {
+	return v.TimeoutSeconds
+}
+
+// GetURL returns the URL value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v HTTPStepConfig) GetURL() string {
+	return v.URL
+}
+
+// Validate validates the HTTPStepConfig value object.
+// Returns an error if any field fails validation.
+func (v HTTPStepConfig) Validate() error {
+	// Optional enum - validate only if present
+	if v.Kind != nil && !v.Kind.IsValid() {
+		return fmt.Errorf("invalid Kind: %s", v.Kind)
+	}
+	if !v.Method.IsValid() {
+		return fmt.Errorf("invalid Method: %s", v.Method)
+	}
+	return nil
+}
+
+// IsZero returns true if this is the zero value.
+func (v HTTPStepConfig) IsZero() bool {
+	zero := ZeroHTTPStepConfig()
+	// Compare using string representation as a simple equality check
+	return v.String() == zero.String()
+}
+
+// String returns a string representation of HTTPStepConfig
+func (v HTTPStepConfig) String() string {
+	var fields []string
+	fields = append(fields, fmt.Sprintf("Kind: %v", v.Kind))
+	fields = append(fields, fmt.Sprintf("Method: %v", v.Method))
+	fields = append(fields, fmt.Sprintf("TimeoutSeconds: %v", v.TimeoutSeconds))
+	fields = append(fields, fmt.Sprintf("URL: %v", v.URL))
+	return fmt.Sprintf("HTTPStepConfig{%s}", strings.Join(fields, ", "))
+}
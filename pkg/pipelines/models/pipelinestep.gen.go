@@ -27,6 +27,9 @@ type PipelineStep struct {
 	// UpdatedAt The date and time when the resource was last updated
 	UpdatedAt time.Time `json:"updatedAt" yaml:"updatedAt"`
 
+	// Config How the step processes its input
+	Config *StepConfig `json:"config" yaml:"config"`
+
 	// PipelineID The pipeline this step belongs to
 	PipelineID uuid.UUID `json:"pipelineID" yaml:"pipelineID"`
 
@@ -39,6 +42,7 @@ type PipelineStep struct {
 // NewPipelineStep creates a new PipelineStep entity.
 // All required fields must be provided and valid.
 func NewPipelineStep(
+	config *StepConfig,
 	pipelineID uuid.UUID,
 	toolID uuid.UUID,
 ) (*PipelineStep, error) {
@@ -55,6 +59,7 @@ func NewPipelineStep(
 		ID:         id,
 		CreatedAt:  now,
 		UpdatedAt:  now,
+		Config:     config,
 		PipelineID: pipelineID,
 		ToolID:     toolID,
 		events:     []events.Event{},
@@ -85,6 +90,11 @@ func (e *PipelineStep) GetUpdatedAt() time.Time {
 	return e.UpdatedAt
 }
 
+// GetConfig returns the Config
+func (e *PipelineStep) GetConfig() *StepConfig {
+	return e.Config
+}
+
 // GetPipelineID returns the PipelineID
 func (e *PipelineStep) GetPipelineID() uuid.UUID {
 	return e.PipelineID
@@ -0,0 +1,160 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScriptStepConfigKind represents the enumeration of valid values for Kind
+type ScriptStepConfigKind string
+
+// Valid Kind values
+const (
+	ScriptStepConfigKindScript ScriptStepConfigKind = "script"
+)
+
+// String returns the string representation
+func (e ScriptStepConfigKind) String() string {
+	return string(e)
+}
+
+// IsValid checks if the value is valid
+func (e ScriptStepConfigKind) IsValid() bool {
+	switch e {
+	case ScriptStepConfigKindScript:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseScriptStepConfigKind parses a string into the enum type
+func ParseScriptStepConfigKind(s string) (ScriptStepConfigKind, error) {
+	v := ScriptStepConfigKind(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Kind: %s", s)
+	}
+	return v, nil
+}
+
+// ScriptStepConfigRuntime represents the enumeration of valid values for Runtime
+type ScriptStepConfigRuntime string
+
+// Valid Runtime values
+const (
+	ScriptStepConfigRuntimePython ScriptStepConfigRuntime = "python"
+	ScriptStepConfigRuntimeNode   ScriptStepConfigRuntime = "node"
+	ScriptStepConfigRuntimeBash   ScriptStepConfigRuntime = "bash"
+)
+
+// String returns the string representation
+func (e ScriptStepConfigRuntime) String() string {
+	return string(e)
+}
+
+// IsValid checks if the value is valid
+func (e ScriptStepConfigRuntime) IsValid() bool {
+	switch e {
+	case ScriptStepConfigRuntimePython:
+		return true
+	case ScriptStepConfigRuntimeNode:
+		return true
+	case ScriptStepConfigRuntimeBash:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseScriptStepConfigRuntime parses a string into the enum type
+func ParseScriptStepConfigRuntime(s string) (ScriptStepConfigRuntime, error) {
+	v := ScriptStepConfigRuntime(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Runtime: %s", s)
+	}
+	return v, nil
+}
+
+// ScriptStepConfig represents A step that runs a script on its input
+type ScriptStepConfig struct {
+
+	// Kind The kind of step, always script
+	Kind *ScriptStepConfigKind `json:"kind,omitempty" yaml:"kind,omitempty"`
+
+	// Runtime The runtime that executes the script
+	Runtime ScriptStepConfigRuntime `json:"runtime" yaml:"runtime"`
+	Source  ScriptSource            `json:"source" yaml:"source"`
+}
+
+// NewScriptStepConfig creates a new immutable ScriptStepConfig value object.
+// Value objects are immutable and validated upon creation.
+func NewScriptStepConfig(
+	kind *ScriptStepConfigKind,
+	runtime ScriptStepConfigRuntime,
+	source ScriptSource,
+) (ScriptStepConfig, error) {
+	// Validate required fields
+	if !runtime.IsValid() {
+		return ScriptStepConfig{}, fmt.Errorf("invalid Runtime: %s", runtime)
+	}
+	return ScriptStepConfig{
+		Kind:    kind,
+		Runtime: runtime,
+		Source:  source,
+	}, nil
+}
+
+// ZeroScriptStepConfig returns the zero value for ScriptStepConfig.
+// This is useful for comparisons and as a default value.
+func ZeroScriptStepConfig() ScriptStepConfig {
+	return ScriptStepConfig{}
+}
+
+// GetKind returns the Kind value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v ScriptStepConfig) GetKind() *ScriptStepConfigKind {
+	return v.Kind
+}
+
+// GetRuntime returns the Runtime value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v ScriptStepConfig) GetRuntime() ScriptStepConfigRuntime {
+	return v.Runtime
+}
+
+// GetSource returns the Source value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v ScriptStepConfig) GetSource() ScriptSource {
+	return v.Source
+}
+
+// Validate validates the ScriptStepConfig value object.
+// Returns an error if any field fails validation.
+func (v ScriptStepConfig) Validate() error {
+	// Optional enum - validate only if present
+	if v.Kind != nil && !v.Kind.IsValid() {
+		return fmt.Errorf("invalid Kind: %s", v.Kind)
+	}
+	if !v.Runtime.IsValid() {
+		return fmt.Errorf("invalid Runtime: %s", v.Runtime)
+	}
+	return nil
+}
+
+// IsZero returns true if this is the zero value.
+func (v ScriptStepConfig) IsZero() bool {
+	zero := ZeroScriptStepConfig()
+	// Compare using string representation as a simple equality check
+	return v.String() == zero.String()
+}
+
+// String returns a string representation of ScriptStepConfig
+func (v ScriptStepConfig) String() string {
+	var fields []string
+	fields = append(fields, fmt.Sprintf("Kind: %v", v.Kind))
+	fields = append(fields, fmt.Sprintf("Runtime: %v", v.Runtime))
+	fields = append(fields, fmt.Sprintf("Source: %v", v.Source))
+	return fmt.Sprintf("ScriptStepConfig{%s}", strings.Join(fields, ", "))
+}
@@ -0,0 +1,127 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// StepConfigVariant is implemented by every type a StepConfig may hold.
+type StepConfigVariant interface {
+	isStepConfig()
+}
+
+func (HTTPStepConfig) isStepConfig() {}
+
+func (ScriptStepConfig) isStepConfig() {}
+
+// StepConfig represents The configuration of a pipeline step, selected by its kind
+// Value holds exactly one of: HTTPStepConfig ScriptStepConfig.
+type StepConfig struct {
+	Value StepConfigVariant
+}
+
+// StepConfig kind values
+const (
+	StepConfigKindHTTP   = "http"
+	StepConfigKindScript = "script"
+)
+
+// NewStepConfig wraps a variant in a StepConfig
+func NewStepConfig(v StepConfigVariant) StepConfig {
+	return StepConfig{Value: v}
+}
+
+// AsHTTPStepConfig returns the HTTPStepConfig variant if it is the one set
+func (u StepConfig) AsHTTPStepConfig() (HTTPStepConfig, bool) {
+	v, ok := u.Value.(HTTPStepConfig)
+	return v, ok
+}
+
+// AsScriptStepConfig returns the ScriptStepConfig variant if it is the one set
+func (u StepConfig) AsScriptStepConfig() (ScriptStepConfig, bool) {
+	v, ok := u.Value.(ScriptStepConfig)
+	return v, ok
+}
+
+// IsZero returns true if no variant is set.
+func (u StepConfig) IsZero() bool {
+	return u.Value == nil
+}
+
+// Validate validates the variant held by StepConfig.
+func (u StepConfig) Validate() error {
+	if u.Value == nil {
+		return fmt.Errorf("StepConfig has no variant set")
+	}
+	if v, ok := u.Value.(HTTPStepConfig); ok {
+		return v.Validate()
+	}
+	if v, ok := u.Value.(ScriptStepConfig); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// MarshalJSON encodes the variant held by StepConfig.
+// The kind property is added when the variant leaves it empty.
+func (u StepConfig) MarshalJSON() ([]byte, error) {
+	if u.Value == nil {
+		return []byte("null"), nil
+	}
+	data, err := json.Marshal(u.Value)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if tag, ok := fields["kind"]; ok && string(tag) != `""` {
+		return data, nil
+	}
+	var tag string
+	switch u.Value.(type) {
+	case HTTPStepConfig:
+		tag = "http"
+	case ScriptStepConfig:
+		tag = "script"
+	}
+	fields["kind"], _ = json.Marshal(tag)
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON decodes StepConfig by dispatching on the kind property.
+func (u *StepConfig) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		u.Value = nil
+		return nil
+	}
+	var tag struct {
+		Value string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &tag); err != nil {
+		return fmt.Errorf("invalid StepConfig: %w", err)
+	}
+	switch tag.Value {
+	case "http":
+		var v HTTPStepConfig
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("invalid StepConfig: %w", err)
+		}
+		u.Value = v
+	case "script":
+		var v ScriptStepConfig
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("invalid StepConfig: %w", err)
+		}
+		u.Value = v
+	case "":
+		return fmt.Errorf("invalid StepConfig: missing kind")
+	default:
+		return fmt.Errorf("invalid StepConfig: unknown kind %q", tag.Value)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepConfigJSON(t *testing.T) {
+	artifactID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	http := HTTPStepConfig{Method: HTTPStepConfigMethodPOST, URL: "https://example.com/hooks"}
+	script := ScriptStepConfig{
+		Runtime: ScriptStepConfigRuntimePython,
+		Source:  NewScriptSource(ArtifactScriptSource{ArtifactID: artifactID}),
+	}
+
+	tests := []struct {
+		name   string
+		config StepConfig
+		want   string
+	}{
+		{
+			// The kind is added from the discriminator mapping
+			name:   "http",
+			config: NewStepConfig(http),
+			want:   `{"kind":"http","method":"POST","url":"https://example.com/hooks"}`,
+		},
+		{
+			name:   "script with an artifact source",
+			config: NewStepConfig(script),
+			want:   `{"kind":"script","runtime":"python","source":{"artifactID":"550e8400-e29b-41d4-a716-446655440000"}}`,
+		},
+		{
+			name:   "script with an inline source",
+			config: NewStepConfig(ScriptStepConfig{Runtime: ScriptStepConfigRuntimeBash, Source: NewScriptSource(InlineScriptSource{Code: "cat"})}),
+			want:   `{"kind":"script","runtime":"bash","source":{"code":"cat"}}`,
+		},
+		{
+			name:   "no variant",
+			config: StepConfig{},
+			want:   `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.config)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(data))
+
+			var got StepConfig
+			require.NoError(t, json.Unmarshal(data, &got))
+			if tt.config.IsZero() {
+				assert.True(t, got.IsZero())
+				return
+			}
+
+			// The decoded variant carries the kind it was selected by
+			want := tt.config.Value
+			switch v := want.(type) {
+			case HTTPStepConfig:
+				kind := HTTPStepConfigKindHTTP
+				v.Kind = &kind
+				want = v
+			case ScriptStepConfig:
+				kind := ScriptStepConfigKindScript
+				v.Kind = &kind
+				want = v
+			}
+			assert.Equal(t, want, got.Value)
+			assert.NoError(t, got.Validate())
+		})
+	}
+}
+
+func TestStepConfigUnmarshalJSON(t *testing.T) {
+	httpKind, scriptKind := HTTPStepConfigKindHTTP, ScriptStepConfigKindScript
+
+	tests := []struct {
+		name    string
+		data    string
+		want    StepConfigVariant
+		wantErr string
+	}{
+		{
+			name: "http",
+			data: `{"kind":"http","method":"GET","url":"https://example.com"}`,
+			want: HTTPStepConfig{Kind: &httpKind, Method: HTTPStepConfigMethodGET, URL: "https://example.com"},
+		},
+		{
+			// The anyOf source goes to the variant whose required properties
+			// the payload carries
+			name: "script",
+			data: `{"kind":"script","runtime":"node","source":{"code":"process.exit(0)"}}`,
+			want: ScriptStepConfig{
+				Kind:    &scriptKind,
+				Runtime: ScriptStepConfigRuntimeNode,
+				Source:  NewScriptSource(InlineScriptSource{Code: "process.exit(0)"}),
+			},
+		},
+		{
+			name:    "missing kind",
+			data:    `{"method":"GET","url":"https://example.com"}`,
+			wantErr: "invalid StepConfig: missing kind",
+		},
+		{
+			name:    "unmapped kind",
+			data:    `{"kind":"HTTPStepConfig","method":"GET","url":"https://example.com"}`,
+			wantErr: `invalid StepConfig: unknown kind "HTTPStepConfig"`,
+		},
+		{
+			name:    "source matching no variant",
+			data:    `{"kind":"script","runtime":"node","source":{}}`,
+			wantErr: "invalid ScriptSource: no variant matches",
+		},
+		{
+			name:    "source with unknown properties",
+			data:    `{"kind":"script","runtime":"node","source":{"code":"cat","path":"run.sh"}}`,
+			wantErr: "invalid ScriptSource: no variant matches",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got StepConfig
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Value)
+		})
+	}
+}
+
+func TestStepConfigValidate(t *testing.T) {
+	assert.EqualError(t, StepConfig{}.Validate(), "StepConfig has no variant set")
+	assert.EqualError(t,
+		NewStepConfig(HTTPStepConfig{Method: "TRACE", URL: "https://example.com"}).Validate(),
+		"invalid Method: TRACE",
+	)
+	assert.NoError(t, NewStepConfig(HTTPStepConfig{Method: HTTPStepConfigMethodPUT, URL: "https://example.com"}).Validate())
+}
@@ -5,6 +5,7 @@ package repositories
 import (
 	"context"
 
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
@@ -16,7 +17,7 @@ type PipelineRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Pipeline, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Pipeline) (*models.Pipeline, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Pipeline, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Pipeline, database.PageInfo, error)
 
 	// ListPipelinesByOrganization retrieves multiple pipelines by organizationID
 	ListPipelinesByOrganization(ctx context.Context, organizationID string) ([]*models.Pipeline, error)
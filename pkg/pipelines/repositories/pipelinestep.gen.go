@@ -5,6 +5,7 @@ package repositories
 import (
 	"context"
 
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
@@ -16,5 +17,5 @@ type PipelineStepRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.PipelineStep, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.PipelineStep) (*models.PipelineStep, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.PipelineStep, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.PipelineStep, database.PageInfo, error)
 }
@@ -5,6 +5,7 @@ package repositories
 import (
 	"context"
 
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
@@ -16,7 +17,7 @@ type RunRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Run, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Run) (*models.Run, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Run, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Run, database.PageInfo, error)
 
 	// ListRunsByPipeline retrieves multiple runs by pipelineID
 	ListRunsByPipeline(ctx context.Context, pipelineID string) ([]*models.Run, error)
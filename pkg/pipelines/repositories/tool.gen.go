@@ -5,6 +5,7 @@ package repositories
 import (
 	"context"
 
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/google/uuid"
 )
@@ -16,7 +17,7 @@ type ToolRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Tool, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Tool) (*models.Tool, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Tool, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Tool, database.PageInfo, error)
 
 	// ListToolsByOrganization retrieves multiple tools by organizationID
 	ListToolsByOrganization(ctx context.Context, organizationID string) ([]*models.Tool, error)
@@ -4,9 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -19,11 +25,15 @@ import (
 // CreatePipelineHandler is the HTTP handler for CreatePipeline.
 type CreatePipelineHandler struct {
 	createPipeline handlers.CreatePipeline
+	authorizer     auth.Authorizer
 }
 
 // NewCreatePipelineHandler creates a new HTTP handler.
-func NewCreatePipelineHandler(createPipeline handlers.CreatePipeline) *CreatePipelineHandler {
-	return &CreatePipelineHandler{createPipeline: createPipeline}
+func NewCreatePipelineHandler(createPipeline handlers.CreatePipeline, authorizer auth.Authorizer) *CreatePipelineHandler {
+	return &CreatePipelineHandler{
+		createPipeline: createPipeline,
+		authorizer:     authorizer,
+	}
 }
 
 // RegisterCreatePipelineRoute registers the HTTP route for CreatePipeline.
@@ -75,6 +85,16 @@ func (response CreatePipeline401Response) VisitCreatePipelineResponse(w http.Res
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type CreatePipeline403Response struct {
+	server.ProblemDetails
+}
+
+func (response CreatePipeline403Response) VisitCreatePipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type CreatePipeline422Response struct {
 	server.ProblemDetails
 }
@@ -109,12 +129,50 @@ func (response CreatePipeline500Response) VisitCreatePipelineResponse(w http.Res
 func (h *CreatePipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := CreatePipeline401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitCreatePipelineResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.CreatePipelineInput{}
+	input.SessionID = sessionID
+
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "pipelines:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := CreatePipeline400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitCreatePipelineResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &CreatePipelineRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := CreatePipeline400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -123,11 +181,38 @@ func (h *CreatePipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Description != nil {
+		v.MinLength("/description", *body.Description, 1)
+		v.MaxLength("/description", *body.Description, 1000)
+		v.Pattern("/description", *body.Description, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if body.Name != nil {
+		v.MinLength("/name", *body.Name, 1)
+		v.MaxLength("/name", *body.Name, 255)
+		v.Pattern("/name", *body.Name, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Description = body.Description
 	input.Name = body.Name
 
 	// Execute
 	result, err := h.createPipeline.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := CreatePipeline500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,12 +4,16 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +26,15 @@ import (
 // CreatePipelineStepHandler is the HTTP handler for CreatePipelineStep.
 type CreatePipelineStepHandler struct {
 	createPipelineStep handlers.CreatePipelineStep
+	authorizer         auth.Authorizer
 }
 
 // NewCreatePipelineStepHandler creates a new HTTP handler.
-func NewCreatePipelineStepHandler(createPipelineStep handlers.CreatePipelineStep) *CreatePipelineStepHandler {
-	return &CreatePipelineStepHandler{createPipelineStep: createPipelineStep}
+func NewCreatePipelineStepHandler(createPipelineStep handlers.CreatePipelineStep, authorizer auth.Authorizer) *CreatePipelineStepHandler {
+	return &CreatePipelineStepHandler{
+		createPipelineStep: createPipelineStep,
+		authorizer:         authorizer,
+	}
 }
 
 // RegisterCreatePipelineStepRoute registers the HTTP route for CreatePipelineStep.
@@ -38,11 +46,12 @@ func RegisterCreatePipelineStepRoute(mux *http.ServeMux, handler *CreatePipeline
 
 // CreatePipelineStepRequestBody defines the request body for CreatePipelineStep
 type CreatePipelineStepRequestBody struct {
-	Dependencies []uuid.UUID `json:"dependencies,omitempty"`
-	Description  *string     `json:"description,omitempty"`
-	Name         string      `json:"name"`
-	Position     *int32      `json:"position,omitempty"`
-	ToolID       uuid.UUID   `json:"toolID"`
+	Config       *models.StepConfig `json:"config,omitempty"`
+	Dependencies []uuid.UUID        `json:"dependencies,omitempty"`
+	Description  *string            `json:"description,omitempty"`
+	Name         string             `json:"name"`
+	Position     *int32             `json:"position,omitempty"`
+	ToolID       uuid.UUID          `json:"toolID"`
 }
 
 // Response types
@@ -81,6 +90,16 @@ func (response CreatePipelineStep401Response) VisitCreatePipelineStepResponse(w
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type CreatePipelineStep403Response struct {
+	server.ProblemDetails
+}
+
+func (response CreatePipelineStep403Response) VisitCreatePipelineStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type CreatePipelineStep404Response struct {
 	server.ProblemDetails
 }
@@ -125,8 +144,22 @@ func (response CreatePipelineStep500Response) VisitCreatePipelineStepResponse(w
 func (h *CreatePipelineStepHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := CreatePipelineStep401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitCreatePipelineStepResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.CreatePipelineStepInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -141,9 +174,33 @@ func (h *CreatePipelineStepHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "pipelines:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := CreatePipelineStep400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitCreatePipelineStepResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &CreatePipelineStepRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := CreatePipelineStep400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -152,6 +209,30 @@ func (h *CreatePipelineStepHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "toolID", "name")
+	v.MaxItems("/dependencies", len(body.Dependencies), 1000)
+	if body.Description != nil {
+		v.MaxLength("/description", *body.Description, 1000)
+		v.Pattern("/description", *body.Description, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	v.MinLength("/name", body.Name, 1)
+	v.MaxLength("/name", body.Name, 255)
+	v.Pattern("/name", body.Name, `^[\w\s\-.,!?()@#+/]*$`)
+	if body.Position != nil {
+		v.Minimum("/position", float64(*body.Position), 0, false)
+		v.Maximum("/position", float64(*body.Position), 2.147483647e+09, false)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	input.Config = body.Config
 	input.Dependencies = body.Dependencies
 	input.Description = body.Description
 	input.Name = body.Name
@@ -160,6 +241,35 @@ func (h *CreatePipelineStepHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 	// Execute
 	result, err := h.createPipelineStep.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrPipelineNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, auth.ErrForbidden) {
+		problem := server.NewForbiddenResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := CreatePipelineStep500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,11 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -20,12 +24,16 @@ import (
 
 // CreateRunHandler is the HTTP handler for CreateRun.
 type CreateRunHandler struct {
-	createRun handlers.CreateRun
+	createRun  handlers.CreateRun
+	authorizer auth.Authorizer
 }
 
 // NewCreateRunHandler creates a new HTTP handler.
-func NewCreateRunHandler(createRun handlers.CreateRun) *CreateRunHandler {
-	return &CreateRunHandler{createRun: createRun}
+func NewCreateRunHandler(createRun handlers.CreateRun, authorizer auth.Authorizer) *CreateRunHandler {
+	return &CreateRunHandler{
+		createRun:  createRun,
+		authorizer: authorizer,
+	}
 }
 
 // RegisterCreateRunRoute registers the HTTP route for CreateRun.
@@ -76,6 +84,16 @@ func (response CreateRun401Response) VisitCreateRunResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type CreateRun403Response struct {
+	server.ProblemDetails
+}
+
+func (response CreateRun403Response) VisitCreateRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type CreateRun422Response struct {
 	server.ProblemDetails
 }
@@ -110,12 +128,50 @@ func (response CreateRun500Response) VisitCreateRunResponse(w http.ResponseWrite
 func (h *CreateRunHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := CreateRun401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitCreateRunResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.CreateRunInput{}
+	input.SessionID = sessionID
+
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "runs:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := CreateRun400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitCreateRunResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &CreateRunRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := CreateRun400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -124,10 +180,28 @@ func (h *CreateRunHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "pipelineID")
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.PipelineID = body.PipelineID
 
 	// Execute
 	result, err := h.createRun.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := CreateRun500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,13 +4,17 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
+	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -21,11 +25,15 @@ import (
 // DeletePipelineHandler is the HTTP handler for DeletePipeline.
 type DeletePipelineHandler struct {
 	deletePipeline handlers.DeletePipeline
+	authorizer     auth.Authorizer
 }
 
 // NewDeletePipelineHandler creates a new HTTP handler.
-func NewDeletePipelineHandler(deletePipeline handlers.DeletePipeline) *DeletePipelineHandler {
-	return &DeletePipelineHandler{deletePipeline: deletePipeline}
+func NewDeletePipelineHandler(deletePipeline handlers.DeletePipeline, authorizer auth.Authorizer) *DeletePipelineHandler {
+	return &DeletePipelineHandler{
+		deletePipeline: deletePipeline,
+		authorizer:     authorizer,
+	}
 }
 
 // RegisterDeletePipelineRoute registers the HTTP route for DeletePipeline.
@@ -69,6 +77,16 @@ func (response DeletePipeline401Response) VisitDeletePipelineResponse(w http.Res
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type DeletePipeline403Response struct {
+	server.ProblemDetails
+}
+
+func (response DeletePipeline403Response) VisitDeletePipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type DeletePipeline404Response struct {
 	server.ProblemDetails
 }
@@ -113,8 +131,22 @@ func (response DeletePipeline500Response) VisitDeletePipelineResponse(w http.Res
 func (h *DeletePipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := DeletePipeline401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitDeletePipelineResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.DeletePipelineInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -129,8 +161,36 @@ func (h *DeletePipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "pipelines:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	if err := h.deletePipeline.Execute(ctx, input); err != nil {
+		if errors.Is(err, database.ErrNoTenant) {
+			problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
+		if errors.Is(err, models.ErrPipelineNotFound) {
+			problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := DeletePipeline500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
@@ -4,13 +4,17 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
+	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -20,12 +24,16 @@ import (
 
 // DeleteRunHandler is the HTTP handler for DeleteRun.
 type DeleteRunHandler struct {
-	deleteRun handlers.DeleteRun
+	deleteRun  handlers.DeleteRun
+	authorizer auth.Authorizer
 }
 
 // NewDeleteRunHandler creates a new HTTP handler.
-func NewDeleteRunHandler(deleteRun handlers.DeleteRun) *DeleteRunHandler {
-	return &DeleteRunHandler{deleteRun: deleteRun}
+func NewDeleteRunHandler(deleteRun handlers.DeleteRun, authorizer auth.Authorizer) *DeleteRunHandler {
+	return &DeleteRunHandler{
+		deleteRun:  deleteRun,
+		authorizer: authorizer,
+	}
 }
 
 // RegisterDeleteRunRoute registers the HTTP route for DeleteRun.
@@ -69,6 +77,16 @@ func (response DeleteRun401Response) VisitDeleteRunResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type DeleteRun403Response struct {
+	server.ProblemDetails
+}
+
+func (response DeleteRun403Response) VisitDeleteRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type DeleteRun404Response struct {
 	server.ProblemDetails
 }
@@ -113,8 +131,22 @@ func (response DeleteRun500Response) VisitDeleteRunResponse(w http.ResponseWrite
 func (h *DeleteRunHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := DeleteRun401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitDeleteRunResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.DeleteRunInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -129,8 +161,36 @@ func (h *DeleteRunHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "runs:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	if err := h.deleteRun.Execute(ctx, input); err != nil {
+		if errors.Is(err, database.ErrNoTenant) {
+			problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
+		if errors.Is(err, models.ErrRunNotFound) {
+			problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := DeleteRun500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
@@ -4,13 +4,17 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
+	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -21,11 +25,15 @@ import (
 // DeleteToolHandler is the HTTP handler for DeleteTool.
 type DeleteToolHandler struct {
 	deleteTool handlers.DeleteTool
+	authorizer auth.Authorizer
 }
 
 // NewDeleteToolHandler creates a new HTTP handler.
-func NewDeleteToolHandler(deleteTool handlers.DeleteTool) *DeleteToolHandler {
-	return &DeleteToolHandler{deleteTool: deleteTool}
+func NewDeleteToolHandler(deleteTool handlers.DeleteTool, authorizer auth.Authorizer) *DeleteToolHandler {
+	return &DeleteToolHandler{
+		deleteTool: deleteTool,
+		authorizer: authorizer,
+	}
 }
 
 // RegisterDeleteToolRoute registers the HTTP route for DeleteTool.
@@ -69,6 +77,16 @@ func (response DeleteTool401Response) VisitDeleteToolResponse(w http.ResponseWri
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type DeleteTool403Response struct {
+	server.ProblemDetails
+}
+
+func (response DeleteTool403Response) VisitDeleteToolResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type DeleteTool404Response struct {
 	server.ProblemDetails
 }
@@ -113,8 +131,22 @@ func (response DeleteTool500Response) VisitDeleteToolResponse(w http.ResponseWri
 func (h *DeleteToolHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := DeleteTool401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitDeleteToolResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.DeleteToolInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -129,8 +161,36 @@ func (h *DeleteToolHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "tools:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	if err := h.deleteTool.Execute(ctx, input); err != nil {
+		if errors.Is(err, database.ErrNoTenant) {
+			problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
+		if errors.Is(err, models.ErrToolNotFound) {
+			problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := DeleteTool500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
@@ -4,12 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +25,15 @@ import (
 // GetPipelineHandler is the HTTP handler for GetPipeline.
 type GetPipelineHandler struct {
 	getPipeline handlers.GetPipeline
+	authorizer  auth.Authorizer
 }
 
 // NewGetPipelineHandler creates a new HTTP handler.
-func NewGetPipelineHandler(getPipeline handlers.GetPipeline) *GetPipelineHandler {
-	return &GetPipelineHandler{getPipeline: getPipeline}
+func NewGetPipelineHandler(getPipeline handlers.GetPipeline, authorizer auth.Authorizer) *GetPipelineHandler {
+	return &GetPipelineHandler{
+		getPipeline: getPipeline,
+		authorizer:  authorizer,
+	}
 }
 
 // RegisterGetPipelineRoute registers the HTTP route for GetPipeline.
@@ -72,6 +79,16 @@ func (response GetPipeline401Response) VisitGetPipelineResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type GetPipeline403Response struct {
+	server.ProblemDetails
+}
+
+func (response GetPipeline403Response) VisitGetPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type GetPipeline404Response struct {
 	server.ProblemDetails
 }
@@ -116,8 +133,22 @@ func (response GetPipeline500Response) VisitGetPipelineResponse(w http.ResponseW
 func (h *GetPipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := GetPipeline401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitGetPipelineResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.GetPipelineInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -132,8 +163,36 @@ func (h *GetPipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "pipelines:read",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	result, err := h.getPipeline.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrPipelineNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := GetPipeline500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
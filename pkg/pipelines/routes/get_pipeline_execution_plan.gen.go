@@ -4,13 +4,17 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
+	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
 )
 
@@ -21,11 +25,15 @@ import (
 // GetPipelineExecutionPlanHandler is the HTTP handler for GetPipelineExecutionPlan.
 type GetPipelineExecutionPlanHandler struct {
 	getPipelineExecutionPlan handlers.GetPipelineExecutionPlan
+	authorizer               auth.Authorizer
 }
 
 // NewGetPipelineExecutionPlanHandler creates a new HTTP handler.
-func NewGetPipelineExecutionPlanHandler(getPipelineExecutionPlan handlers.GetPipelineExecutionPlan) *GetPipelineExecutionPlanHandler {
-	return &GetPipelineExecutionPlanHandler{getPipelineExecutionPlan: getPipelineExecutionPlan}
+func NewGetPipelineExecutionPlanHandler(getPipelineExecutionPlan handlers.GetPipelineExecutionPlan, authorizer auth.Authorizer) *GetPipelineExecutionPlanHandler {
+	return &GetPipelineExecutionPlanHandler{
+		getPipelineExecutionPlan: getPipelineExecutionPlan,
+		authorizer:               authorizer,
+	}
 }
 
 // RegisterGetPipelineExecutionPlanRoute registers the HTTP route for GetPipelineExecutionPlan.
@@ -83,6 +91,16 @@ func (response GetPipelineExecutionPlan401Response) VisitGetPipelineExecutionPla
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type GetPipelineExecutionPlan403Response struct {
+	server.ProblemDetails
+}
+
+func (response GetPipelineExecutionPlan403Response) VisitGetPipelineExecutionPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type GetPipelineExecutionPlan404Response struct {
 	server.ProblemDetails
 }
@@ -127,8 +145,22 @@ func (response GetPipelineExecutionPlan500Response) VisitGetPipelineExecutionPla
 func (h *GetPipelineExecutionPlanHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := GetPipelineExecutionPlan401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitGetPipelineExecutionPlanResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.GetPipelineExecutionPlanInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -143,8 +175,51 @@ func (h *GetPipelineExecutionPlanHandler) ServeHTTP(w http.ResponseWriter, r *ht
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "pipelines:read",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	result, err := h.getPipelineExecutionPlan.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrPipelineNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, auth.ErrForbidden) {
+		problem := server.NewForbiddenResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := GetPipelineExecutionPlan500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
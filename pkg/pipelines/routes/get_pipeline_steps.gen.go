@@ -4,12 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +25,15 @@ import (
 // GetPipelineStepsHandler is the HTTP handler for GetPipelineSteps.
 type GetPipelineStepsHandler struct {
 	getPipelineSteps handlers.GetPipelineSteps
+	authorizer       auth.Authorizer
 }
 
 // NewGetPipelineStepsHandler creates a new HTTP handler.
-func NewGetPipelineStepsHandler(getPipelineSteps handlers.GetPipelineSteps) *GetPipelineStepsHandler {
-	return &GetPipelineStepsHandler{getPipelineSteps: getPipelineSteps}
+func NewGetPipelineStepsHandler(getPipelineSteps handlers.GetPipelineSteps, authorizer auth.Authorizer) *GetPipelineStepsHandler {
+	return &GetPipelineStepsHandler{
+		getPipelineSteps: getPipelineSteps,
+		authorizer:       authorizer,
+	}
 }
 
 // RegisterGetPipelineStepsRoute registers the HTTP route for GetPipelineSteps.
@@ -72,6 +79,16 @@ func (response GetPipelineSteps401Response) VisitGetPipelineStepsResponse(w http
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type GetPipelineSteps403Response struct {
+	server.ProblemDetails
+}
+
+func (response GetPipelineSteps403Response) VisitGetPipelineStepsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type GetPipelineSteps404Response struct {
 	server.ProblemDetails
 }
@@ -116,8 +133,22 @@ func (response GetPipelineSteps500Response) VisitGetPipelineStepsResponse(w http
 func (h *GetPipelineStepsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := GetPipelineSteps401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitGetPipelineStepsResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.GetPipelineStepsInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -132,8 +163,51 @@ func (h *GetPipelineStepsHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "pipelines:read",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	result, err := h.getPipelineSteps.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrPipelineNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	var invalid *server.ValidationError
+	if errors.As(err, &invalid) {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, invalid.Validator, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, auth.ErrForbidden) {
+		problem := server.NewForbiddenResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := GetPipelineSteps500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -4,12 +4,16 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/pipelines/handlers"
 	"github.com/archesai/archesai/pkg/pipelines/models"
 	"github.com/archesai/archesai/pkg/server"
@@ -22,11 +26,15 @@ import (
 // UpdatePipelineHandler is the HTTP handler for UpdatePipeline.
 type UpdatePipelineHandler struct {
 	updatePipeline handlers.UpdatePipeline
+	authorizer     auth.Authorizer
 }
 
 // NewUpdatePipelineHandler creates a new HTTP handler.
-func NewUpdatePipelineHandler(updatePipeline handlers.UpdatePipeline) *UpdatePipelineHandler {
-	return &UpdatePipelineHandler{updatePipeline: updatePipeline}
+func NewUpdatePipelineHandler(updatePipeline handlers.UpdatePipeline, authorizer auth.Authorizer) *UpdatePipelineHandler {
+	return &UpdatePipelineHandler{
+		updatePipeline: updatePipeline,
+		authorizer:     authorizer,
+	}
 }
 
 // RegisterUpdatePipelineRoute registers the HTTP route for UpdatePipeline.
@@ -78,6 +86,16 @@ func (response UpdatePipeline401Response) VisitUpdatePipelineResponse(w http.Res
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type UpdatePipeline403Response struct {
+	server.ProblemDetails
+}
+
+func (response UpdatePipeline403Response) VisitUpdatePipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type UpdatePipeline404Response struct {
 	server.ProblemDetails
 }
@@ -122,8 +140,22 @@ func (response UpdatePipeline500Response) VisitUpdatePipelineResponse(w http.Res
 func (h *UpdatePipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := UpdatePipeline401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitUpdatePipelineResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.UpdatePipelineInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -138,9 +170,33 @@ func (h *UpdatePipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "pipelines:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdatePipeline400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdatePipelineResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdatePipelineRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdatePipeline400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -149,11 +205,43 @@ func (h *UpdatePipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Description != nil {
+		v.MaxLength("/description", *body.Description, 1000)
+		v.Pattern("/description", *body.Description, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if body.Name != nil {
+		v.MaxLength("/name", *body.Name, 255)
+		v.Pattern("/name", *body.Name, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Description = body.Description
 	input.Name = body.Name
 
 	// Execute
 	result, err := h.updatePipeline.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrPipelineNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := UpdatePipeline500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 )
 
 // Auth context keys
@@ -29,11 +31,12 @@ const (
 	// SessionCookieScopes is used by handlers for session cookie authentication
 	SessionCookieScopes = "sessionCookie.Scopes"
 
-	// AuthAPIKeyContextKey is the context key for API token
-	AuthAPIKeyContextKey = "auth_api_token"
+	// AuthAPIKeyContextKey is the context key for the ID of the API key used
+	AuthAPIKeyContextKey contextKey = "auth_api_token"
 
-	// AuthMethodContextKey is the context key for the auth method used
-	AuthMethodContextKey = "auth_method"
+	// AuthMethodContextKey is the context key for the auth method used,
+	// "session" or "api_key"
+	AuthMethodContextKey contextKey = "auth_method"
 
 	// BearerPrefix is the prefix for Bearer token authentication
 	BearerPrefix = "Bearer"
@@ -61,10 +64,11 @@ func NewAuthMiddleware(authService *auth.Service) *AuthMiddleware {
 	}
 }
 
-// RequireAuth creates middleware that validates JWT tokens.
-func (am *AuthMiddleware) RequireAuth() func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+// RequireAuth creates middleware that validates JWT access tokens and API
+// keys.
+func (am *AuthMiddleware) RequireAuth() Middleware {
+	return func(next http.Handler) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
 			// Extract token from Authorization header
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
@@ -92,8 +96,8 @@ func (am *AuthMiddleware) RequireAuth() func(http.Handler) http.Handler {
 				return
 			}
 
-			// Validate access token using auth service
-			claims, err := am.authService.ValidateAccessToken(tokenString)
+			// Validate the token using auth service
+			ctx, err := am.authenticate(r.Context(), tokenString)
 			if err != nil {
 				response := NewUnauthorizedResponse("invalid token", r.URL.Path)
 				w.Header().Set("Content-Type", "application/problem+json")
@@ -104,20 +108,15 @@ func (am *AuthMiddleware) RequireAuth() func(http.Handler) http.Handler {
 				return
 			}
 
-			// Add claims to context
-			ctx := context.WithValue(r.Context(), AuthUserContextKey, claims.UserID)
-			ctx = context.WithValue(ctx, AuthClaimsContextKey, claims)
-			ctx = context.WithValue(ctx, SessionIDContextKey, claims.SessionID)
-
 			next.ServeHTTP(w, r.WithContext(ctx))
-		})
+		}
 	}
 }
 
 // OptionalAuth creates middleware for optional authentication.
-func (am *AuthMiddleware) OptionalAuth() func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+func (am *AuthMiddleware) OptionalAuth() Middleware {
+	return func(next http.Handler) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
 			// Extract token from Authorization header
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
@@ -134,20 +133,63 @@ func (am *AuthMiddleware) OptionalAuth() func(http.Handler) http.Handler {
 				return
 			}
 
-			tokenString := parts[1]
-
 			// Try to validate token, but don't fail if invalid
-			claims, err := am.authService.ValidateAccessToken(tokenString)
-			if err == nil && claims != nil {
-				// Add claims to context
-				ctx := context.WithValue(r.Context(), AuthUserContextKey, claims.UserID)
-				ctx = context.WithValue(ctx, AuthClaimsContextKey, claims)
-				ctx = context.WithValue(ctx, SessionIDContextKey, claims.SessionID)
+			if ctx, err := am.authenticate(r.Context(), parts[1]); err == nil {
 				r = r.WithContext(ctx)
 			}
 
 			next.ServeHTTP(w, r)
-		})
+		}
+	}
+}
+
+// authenticate validates a bearer token, which is either an access token or
+// an API key, and adds the caller to the context.
+func (am *AuthMiddleware) authenticate(ctx context.Context, token string) (context.Context, error) {
+	if auth.IsAPIKey(token) {
+		// API keys act in the organization they were created in
+		apiKey, err := am.authService.AuthenticateAPIKey(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		ctx = context.WithValue(ctx, AuthUserContextKey, apiKey.UserID)
+		ctx = context.WithValue(ctx, AuthAPIKeyContextKey, apiKey.ID)
+		ctx = context.WithValue(ctx, AuthMethodContextKey, "api_key")
+		return database.WithTenant(ctx, apiKey.OrganizationID), nil
+	}
+
+	claims, err := am.authService.ValidateAccessToken(token)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, AuthUserContextKey, claims.UserID)
+	ctx = context.WithValue(ctx, AuthClaimsContextKey, claims)
+	ctx = context.WithValue(ctx, SessionIDContextKey, claims.SessionID)
+	ctx = context.WithValue(ctx, AuthMethodContextKey, "session")
+	return withTenant(ctx, claims), nil
+}
+
+// withTenant scopes the context to the organization of the token, so that
+// repositories of tenant-scoped entities only see that organization's rows.
+func withTenant(ctx context.Context, claims *auth.TokenClaims) context.Context {
+	if claims.OrganizationID == uuid.Nil {
+		return ctx
+	}
+	return database.WithTenant(ctx, claims.OrganizationID)
+}
+
+// NewAuthorizationProblem maps an error from auth.Authorize to a 401, 403 or
+// 500 problem details response
+func NewAuthorizationProblem(err error, instance string) ProblemDetails {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return NewUnauthorizedResponse(err.Error(), instance)
+	case errors.Is(err, database.ErrNoTenant):
+		return NewForbiddenResponse("No organization selected", instance)
+	case errors.Is(err, auth.ErrForbidden):
+		return NewForbiddenResponse(err.Error(), instance)
+	default:
+		return NewInternalServerErrorResponse(err.Error(), instance)
 	}
 }
 
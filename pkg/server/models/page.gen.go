@@ -7,9 +7,12 @@ import (
 	"strings"
 )
 
-// Page represents Pagination parameters (limit & offset)
+// Page represents Pagination parameters (limit & offset, or limit & cursor)
 type Page struct {
 
+	// Cursor Opaque cursor from the next or prev field of a previous response, for endpoints using cursor pagination
+	Cursor *string `json:"cursor,omitempty" yaml:"cursor,omitempty"`
+
 	// Limit Maximum number of items to return
 	Limit *int32 `json:"limit,omitempty" yaml:"limit,omitempty"`
 
@@ -20,11 +23,13 @@ type Page struct {
 // NewPage creates a new immutable Page value object.
 // Value objects are immutable and validated upon creation.
 func NewPage(
+	cursor *string,
 	limit *int32,
 	offset *int32,
 ) (Page, error) {
 	// Validate required fields
 	return Page{
+		Cursor: cursor,
 		Limit:  limit,
 		Offset: offset,
 	}, nil
@@ -36,6 +41,12 @@ func ZeroPage() Page {
 	return Page{}
 }
 
+// GetCursor returns the Cursor value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v Page) GetCursor() *string {
+	return v.Cursor
+}
+
 // GetLimit returns the Limit value.
 // Value objects are immutable, so this returns a copy of the value.
 func (v Page) GetLimit() *int32 {
@@ -64,6 +75,7 @@ func (v Page) IsZero() bool {
 // String returns a string representation of Page
 func (v Page) String() string {
 	var fields []string
+	fields = append(fields, fmt.Sprintf("Cursor: %v", v.Cursor))
 	fields = append(fields, fmt.Sprintf("Limit: %v", v.Limit))
 	fields = append(fields, fmt.Sprintf("Offset: %v", v.Offset))
 	return fmt.Sprintf("Page{%s}", strings.Join(fields, ", "))
@@ -10,6 +10,12 @@ import (
 // PaginationMeta represents Pagination metadata
 type PaginationMeta struct {
 
+	// Next Cursor for the following page, present with cursor pagination when more items follow
+	Next *string `json:"next,omitempty" yaml:"next,omitempty"`
+
+	// Prev Cursor for the preceding page, present with cursor pagination when earlier items exist
+	Prev *string `json:"prev,omitempty" yaml:"prev,omitempty"`
+
 	// Total Total number of items in the collection
 	Total int32 `json:"total" yaml:"total"`
 }
@@ -17,10 +23,14 @@ type PaginationMeta struct {
 // NewPaginationMeta creates a new immutable PaginationMeta value object.
 // Value objects are immutable and validated upon creation.
 func NewPaginationMeta(
+	next *string,
+	prev *string,
 	total int32,
 ) (PaginationMeta, error) {
 	// Validate required fields
 	return PaginationMeta{
+		Next:  next,
+		Prev:  prev,
 		Total: total,
 	}, nil
 }
@@ -31,6 +41,18 @@ func ZeroPaginationMeta() PaginationMeta {
 	return PaginationMeta{}
 }
 
+// GetNext returns the Next value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v PaginationMeta) GetNext() *string {
+	return v.Next
+}
+
+// GetPrev returns the Prev value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v PaginationMeta) GetPrev() *string {
+	return v.Prev
+}
+
 // GetTotal returns the Total value.
 // Value objects are immutable, so this returns a copy of the value.
 func (v PaginationMeta) GetTotal() int32 {
@@ -53,6 +75,8 @@ func (v PaginationMeta) IsZero() bool {
 // String returns a string representation of PaginationMeta
 func (v PaginationMeta) String() string {
 	var fields []string
+	fields = append(fields, fmt.Sprintf("Next: %v", v.Next))
+	fields = append(fields, fmt.Sprintf("Prev: %v", v.Prev))
 	fields = append(fields, fmt.Sprintf("Total: %v", v.Total))
 	return fmt.Sprintf("PaginationMeta{%s}", strings.Join(fields, ", "))
 }
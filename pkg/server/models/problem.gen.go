@@ -7,12 +7,25 @@ import (
 	"strings"
 )
 
+// ProblemErrorsItem represents a nested type for Problem
+type ProblemErrorsItem struct {
+
+	// Message Why the value is invalid
+	Message string `json:"message" yaml:"message"`
+
+	// Pointer JSON pointer to the invalid body member, or /<location>/<name> for parameters
+	Pointer string `json:"pointer" yaml:"pointer"`
+}
+
 // Problem represents RFC 7807 (Problem Details) compliant error response
 type Problem struct {
 
 	// Detail Human-readable explanation specific to this occurrence
 	Detail *string `json:"detail,omitempty" yaml:"detail,omitempty"`
 
+	// Errors Invalid request values, reported when validation fails
+	Errors []ProblemErrorsItem `json:"errors,omitempty" yaml:"errors,omitempty"`
+
 	// Instance URI identifying the specific occurrence
 	Instance *string `json:"instance,omitempty" yaml:"instance,omitempty"`
 
@@ -30,6 +43,7 @@ type Problem struct {
 // Value objects are immutable and validated upon creation.
 func NewProblem(
 	detail *string,
+	errors []ProblemErrorsItem,
 	instance *string,
 	status int32,
 	title string,
@@ -41,6 +55,7 @@ func NewProblem(
 	}
 	return Problem{
 		Detail:   detail,
+		Errors:   errors,
 		Instance: instance,
 		Status:   status,
 		Title:    title,
@@ -60,6 +75,12 @@ func (v Problem) GetDetail() *string {
 	return v.Detail
 }
 
+// GetErrors returns the Errors value.
+// Value objects are immutable, so this returns a copy of the value.
+func (v Problem) GetErrors() []ProblemErrorsItem {
+	return v.Errors
+}
+
 // GetInstance returns the Instance value.
 // Value objects are immutable, so this returns a copy of the value.
 func (v Problem) GetInstance() *string {
@@ -101,6 +122,7 @@ func (v Problem) IsZero() bool {
 func (v Problem) String() string {
 	var fields []string
 	fields = append(fields, fmt.Sprintf("Detail: %v", v.Detail))
+	fields = append(fields, fmt.Sprintf("Errors: %v", v.Errors))
 	fields = append(fields, fmt.Sprintf("Instance: %v", v.Instance))
 	fields = append(fields, fmt.Sprintf("Status: %v", v.Status))
 	fields = append(fields, fmt.Sprintf("Title: %v", v.Title))
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// BindFilterQuery decodes a deepObject filter parameter such as
+// filter[type]=eq&filter[field]=name&filter[value]=x into a nested map.
+// Group filters list their conditions by index, for example
+// filter[type]=or&filter[children][0][type]=eq&filter[children][0][field]=name.
+// It returns nil when the parameter is absent.
+func BindFilterQuery(values url.Values, name string) (map[string]any, error) {
+	root := map[string]any{}
+	prefix := name + "["
+
+	for key, vals := range values {
+		if !strings.HasPrefix(key, prefix) || !strings.HasSuffix(key, "]") || len(vals) == 0 {
+			continue
+		}
+		path := strings.Split(key[len(prefix):len(key)-1], "][")
+		node := root
+		for i, segment := range path {
+			if segment == "" {
+				return nil, fmt.Errorf("invalid %s key %q", name, key)
+			}
+			if i == len(path)-1 {
+				node[segment] = vals[0]
+				break
+			}
+			child, ok := node[segment].(map[string]any)
+			if !ok {
+				if _, exists := node[segment]; exists {
+					return nil, fmt.Errorf("conflicting %s key %q", name, key)
+				}
+				child = map[string]any{}
+				node[segment] = child
+			}
+			node = child
+		}
+	}
+
+	if len(root) == 0 {
+		return nil, nil
+	}
+	result, err := indexedMapsToSlices(root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return result.(map[string]any), nil
+}
+
+// indexedMapsToSlices replaces maps keyed by consecutive indices with slices.
+func indexedMapsToSlices(v any) (any, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v, nil
+	}
+
+	for key, child := range m {
+		converted, err := indexedMapsToSlices(child)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = converted
+	}
+
+	indices := make([]int, 0, len(m))
+	for key := range m {
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 {
+			return m, nil
+		}
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+	list := make([]any, len(indices))
+	for pos, i := range indices {
+		if i != pos {
+			return nil, fmt.Errorf("missing index %d", pos)
+		}
+		list[pos] = m[strconv.Itoa(i)]
+	}
+	return list, nil
+}
+
+// BindPageQuery decodes the exploded form page parameter (limit, offset and
+// cursor query keys). It returns nil when none of the keys is present.
+func BindPageQuery(values url.Values) (map[string]any, error) {
+	page := map[string]any{}
+	for _, key := range []string{"limit", "offset"} {
+		raw := values.Get(key)
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		page[key] = int32(n)
+	}
+	if cursor := values.Get("cursor"); cursor != "" {
+		page["cursor"] = cursor
+	}
+	if len(page) == 0 {
+		return nil, nil
+	}
+	return page, nil
+}
+
+// BindSortQuery decodes the form sort parameter. Each entry is a field name,
+// optionally prefixed with "-" or suffixed with ":asc" or ":desc". Entries
+// may be repeated (sort=a&sort=b) or comma separated (sort=a,-b).
+func BindSortQuery(values url.Values, name string) ([]map[string]any, error) {
+	var sorts []map[string]any
+	for _, raw := range values[name] {
+		for _, entry := range strings.Split(raw, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			field, order := entry, "asc"
+			if strings.HasPrefix(field, "-") {
+				field, order = field[1:], "desc"
+			} else if f, o, ok := strings.Cut(field, ":"); ok {
+				field, order = f, strings.ToLower(o)
+			}
+			if field == "" || (order != "asc" && order != "desc") {
+				return nil, fmt.Errorf("invalid %s entry %q", name, entry)
+			}
+			sorts = append(sorts, map[string]any{"field": field, "order": order})
+		}
+	}
+	return sorts, nil
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/json"
 	"net/http"
 	"time"
 )
@@ -9,12 +10,13 @@ import (
 
 // ProblemDetails represents an RFC 7807 problem details response.
 type ProblemDetails struct {
-	Type      string    `json:"type"`
-	Title     string    `json:"title"`
-	Status    int       `json:"status"`
-	Detail    string    `json:"detail,omitempty"`
-	Instance  string    `json:"instance,omitempty"`
-	Timestamp time.Time `json:"timestamp"`
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	Timestamp time.Time    `json:"timestamp"`
+	Errors    []FieldError `json:"errors,omitempty"`
 }
 
 // NewBadRequestResponse creates a new 400 Bad Request response
@@ -77,6 +79,42 @@ func NewConflictResponse(detail, instance string) ProblemDetails {
 	}
 }
 
+// NewUnprocessableEntityResponse creates a new 422 Unprocessable Entity response
+func NewUnprocessableEntityResponse(detail, instance string) ProblemDetails {
+	return ProblemDetails{
+		Type:      "https://tools.ietf.org/html/rfc4918#section-11.2",
+		Title:     "Unprocessable Entity",
+		Status:    http.StatusUnprocessableEntity,
+		Detail:    detail,
+		Instance:  instance,
+		Timestamp: time.Now(),
+	}
+}
+
+// NewPreconditionFailedResponse creates a new 412 Precondition Failed response
+func NewPreconditionFailedResponse(detail, instance string) ProblemDetails {
+	return ProblemDetails{
+		Type:      "https://tools.ietf.org/html/rfc7232#section-4.2",
+		Title:     "Precondition Failed",
+		Status:    http.StatusPreconditionFailed,
+		Detail:    detail,
+		Instance:  instance,
+		Timestamp: time.Now(),
+	}
+}
+
+// NewPreconditionRequiredResponse creates a new 428 Precondition Required response
+func NewPreconditionRequiredResponse(detail, instance string) ProblemDetails {
+	return ProblemDetails{
+		Type:      "https://tools.ietf.org/html/rfc6585#section-3",
+		Title:     "Precondition Required",
+		Status:    http.StatusPreconditionRequired,
+		Detail:    detail,
+		Instance:  instance,
+		Timestamp: time.Now(),
+	}
+}
+
 // NewInternalServerErrorResponse creates a new 500 Internal Server Error response
 func NewInternalServerErrorResponse(detail, instance string) ProblemDetails {
 	return ProblemDetails{
@@ -100,3 +138,11 @@ func NewTooManyRequestsResponse(detail, instance string) ProblemDetails {
 		Timestamp: time.Now(),
 	}
 }
+
+// WriteProblem writes a problem details response for statuses that an
+// operation does not declare in the spec
+func WriteProblem(w http.ResponseWriter, problem ProblemDetails) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(problem.Status)
+	return json.NewEncoder(w).Encode(problem)
+}
@@ -156,9 +156,10 @@ func (s *APIServer) Shutdown(ctx context.Context) error {
 	return s.server.Shutdown(ctx)
 }
 
-// ApplyMiddleware applies middleware to the server's handler.
-func (s *APIServer) ApplyMiddleware() {
-	s.server.Handler = MiddlewareChain(
+// ApplyMiddleware applies middleware to the server's handler. The given
+// middleware runs after the built-in chain, closest to the routes.
+func (s *APIServer) ApplyMiddleware(middleware ...Middleware) {
+	chain := []Middleware{
 		RequestIDMiddleware,
 		LoggerMiddleware,
 		RecoverMiddleware,
@@ -166,5 +167,6 @@ func (s *APIServer) ApplyMiddleware() {
 		SecurityMiddleware,
 		RateLimitMiddleware,
 		TimeoutMiddleware,
-	)(s.server.Handler)
+	}
+	s.server.Handler = MiddlewareChain(append(chain, middleware...)...)(s.server.Handler)
 }
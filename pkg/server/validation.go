@@ -0,0 +1,214 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// FieldError describes one invalid request value. Pointer is a JSON pointer
+// into the request body, such as /name, or the location and name of a
+// parameter, such as /query/limit.
+type FieldError struct {
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+// Validator collects the constraint violations of a request.
+type Validator struct {
+	errors []FieldError
+}
+
+// NewValidator creates an empty validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Add records a violation.
+func (v *Validator) Add(pointer, message string) {
+	v.errors = append(v.errors, FieldError{Pointer: pointer, Message: message})
+}
+
+// Valid returns true if no violations were recorded.
+func (v *Validator) Valid() bool {
+	return len(v.errors) == 0
+}
+
+// Errors returns the recorded violations.
+func (v *Validator) Errors() []FieldError {
+	return v.errors
+}
+
+// Err returns the recorded violations as a *ValidationError, or nil if there
+// are none. Handlers return it for rules the spec cannot express; controllers
+// of custom handlers that declare a 422 response answer it with one.
+func (v *Validator) Err() error {
+	if v.Valid() {
+		return nil
+	}
+	return &ValidationError{Validator: v}
+}
+
+// ValidationError is an error carrying the violations of a request.
+type ValidationError struct {
+	Validator *Validator
+}
+
+// Error lists the violations.
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Validator.errors))
+	for _, fe := range e.Validator.errors {
+		messages = append(messages, fe.Pointer+" "+fe.Message)
+	}
+	return "invalid request: " + strings.Join(messages, "; ")
+}
+
+// RequireFields checks that the JSON object in data has the named members.
+// Members are reported relative to pointer.
+func (v *Validator) RequireFields(data []byte, pointer string, names ...string) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		v.Add(pointer, "must be an object")
+		return
+	}
+	for _, name := range names {
+		if _, ok := object[name]; !ok {
+			v.Add(pointer+"/"+name, "is required")
+		}
+	}
+}
+
+// MinLength checks that value has at least n characters.
+func (v *Validator) MinLength(pointer, value string, n int) {
+	if utf8.RuneCountInString(value) < n {
+		v.Add(pointer, fmt.Sprintf("must be at least %d characters", n))
+	}
+}
+
+// MaxLength checks that value has at most n characters.
+func (v *Validator) MaxLength(pointer, value string, n int) {
+	if utf8.RuneCountInString(value) > n {
+		v.Add(pointer, fmt.Sprintf("must be at most %d characters", n))
+	}
+}
+
+var patterns sync.Map // pattern string -> *regexp.Regexp
+
+// Pattern checks that value matches the regular expression pattern. The
+// generator rejects patterns that do not compile, so Pattern panics on them.
+func (v *Validator) Pattern(pointer, value, pattern string) {
+	re, ok := patterns.Load(pattern)
+	if !ok {
+		re, _ = patterns.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+	if !re.(*regexp.Regexp).MatchString(value) {
+		v.Add(pointer, fmt.Sprintf("must match pattern %s", pattern))
+	}
+}
+
+// Minimum checks that value is at least minimum, or above it if exclusive.
+func (v *Validator) Minimum(pointer string, value, minimum float64, exclusive bool) {
+	switch {
+	case exclusive && value <= minimum:
+		v.Add(pointer, fmt.Sprintf("must be greater than %v", minimum))
+	case value < minimum:
+		v.Add(pointer, fmt.Sprintf("must be at least %v", minimum))
+	}
+}
+
+// Maximum checks that value is at most maximum, or below it if exclusive.
+func (v *Validator) Maximum(pointer string, value, maximum float64, exclusive bool) {
+	switch {
+	case exclusive && value >= maximum:
+		v.Add(pointer, fmt.Sprintf("must be less than %v", maximum))
+	case value > maximum:
+		v.Add(pointer, fmt.Sprintf("must be at most %v", maximum))
+	}
+}
+
+// Enum checks that value is one of allowed.
+func (v *Validator) Enum(pointer, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.Add(pointer, "must be one of "+strings.Join(allowed, ", "))
+}
+
+// MinItems checks that a list has at least minimum items.
+func (v *Validator) MinItems(pointer string, n, minimum int) {
+	if n < minimum {
+		v.Add(pointer, fmt.Sprintf("must have at least %d items", minimum))
+	}
+}
+
+// MaxItems checks that a list has at most maximum items.
+func (v *Validator) MaxItems(pointer string, n, maximum int) {
+	if n > maximum {
+		v.Add(pointer, fmt.Sprintf("must have at most %d items", maximum))
+	}
+}
+
+// Format checks that value is a valid instance of an OpenAPI string format.
+// Unknown formats are accepted.
+func (v *Validator) Format(pointer, value, format string) {
+	var err error
+	switch format {
+	case "uuid":
+		_, err = uuid.Parse(value)
+	case "email":
+		var addr *mail.Address
+		if addr, err = mail.ParseAddress(value); err == nil && addr.Address != value {
+			err = fmt.Errorf("not a bare address")
+		}
+	case "uri", "url":
+		var u *url.URL
+		if u, err = url.Parse(value); err == nil && !u.IsAbs() {
+			err = fmt.Errorf("not absolute")
+		}
+	case "date-time":
+		_, err = time.Parse(time.RFC3339, value)
+	case "date":
+		_, err = time.Parse(time.DateOnly, value)
+	case "ipv4":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			err = fmt.Errorf("not an IPv4 address")
+		}
+	case "ipv6":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+			err = fmt.Errorf("not an IPv6 address")
+		}
+	case "hostname":
+		if value == "" || len(value) > 253 || strings.ContainsAny(value, " /:@") {
+			err = fmt.Errorf("not a hostname")
+		}
+	}
+	if err != nil {
+		v.Add(pointer, "must be a valid "+format)
+	}
+}
+
+// NewValidationProblem creates a problem details response that lists the
+// violations of v. Invalid parameters are reported with status 400, invalid
+// request bodies with status 422.
+func NewValidationProblem(status int, v *Validator, instance string) ProblemDetails {
+	var problem ProblemDetails
+	if status == http.StatusUnprocessableEntity {
+		problem = NewUnprocessableEntityResponse("The request body is invalid", instance)
+	} else {
+		problem = NewBadRequestResponse("The request parameters are invalid", instance)
+	}
+	problem.Errors = v.Errors()
+	return problem
+}
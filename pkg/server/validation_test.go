@@ -0,0 +1,139 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(v *Validator)
+		want  []FieldError
+	}{
+		{
+			name: "missing required fields",
+			check: func(v *Validator) {
+				v.RequireFields([]byte(`{"name":"a"}`), "", "name", "email")
+			},
+			want: []FieldError{{Pointer: "/email", Message: "is required"}},
+		},
+		{
+			name: "body is not an object",
+			check: func(v *Validator) {
+				v.RequireFields([]byte(`[]`), "", "name")
+			},
+			want: []FieldError{{Pointer: "", Message: "must be an object"}},
+		},
+		{
+			name: "string lengths count characters",
+			check: func(v *Validator) {
+				v.MinLength("/name", "héé", 3)
+				v.MaxLength("/name", "héé", 3)
+				v.MinLength("/code", "ab", 3)
+				v.MaxLength("/code", "abcd", 3)
+			},
+			want: []FieldError{
+				{Pointer: "/code", Message: "must be at least 3 characters"},
+				{Pointer: "/code", Message: "must be at most 3 characters"},
+			},
+		},
+		{
+			name: "pattern",
+			check: func(v *Validator) {
+				v.Pattern("/slug", "my-org", `^[a-z-]+$`)
+				v.Pattern("/slug", "My Org", `^[a-z-]+$`)
+			},
+			want: []FieldError{{Pointer: "/slug", Message: "must match pattern ^[a-z-]+$"}},
+		},
+		{
+			name: "inclusive and exclusive bounds",
+			check: func(v *Validator) {
+				v.Minimum("/query/limit", 1, 1, false)
+				v.Minimum("/query/limit", 1, 1, true)
+				v.Maximum("/query/limit", 100, 100, false)
+				v.Maximum("/query/limit", 101, 100, false)
+			},
+			want: []FieldError{
+				{Pointer: "/query/limit", Message: "must be greater than 1"},
+				{Pointer: "/query/limit", Message: "must be at most 100"},
+			},
+		},
+		{
+			name: "enum",
+			check: func(v *Validator) {
+				v.Enum("/role", "admin", "admin", "member")
+				v.Enum("/role", "root", "admin", "member")
+			},
+			want: []FieldError{{Pointer: "/role", Message: "must be one of admin, member"}},
+		},
+		{
+			name: "items",
+			check: func(v *Validator) {
+				v.MinItems("/tags", 0, 1)
+				v.MaxItems("/tags", 3, 2)
+			},
+			want: []FieldError{
+				{Pointer: "/tags", Message: "must have at least 1 items"},
+				{Pointer: "/tags", Message: "must have at most 2 items"},
+			},
+		},
+		{
+			name: "formats",
+			check: func(v *Validator) {
+				v.Format("/a", "user@example.com", "email")
+				v.Format("/b", "Jane <user@example.com>", "email")
+				v.Format("/c", "https://example.com/cb", "uri")
+				v.Format("/d", "/relative", "uri")
+				v.Format("/e", "2024-01-02T03:04:05Z", "date-time")
+				v.Format("/f", "2024-13-01", "date")
+				v.Format("/g", "not-a-uuid", "uuid")
+				v.Format("/h", "anything", "custom")
+			},
+			want: []FieldError{
+				{Pointer: "/b", Message: "must be a valid email"},
+				{Pointer: "/d", Message: "must be a valid uri"},
+				{Pointer: "/f", Message: "must be a valid date"},
+				{Pointer: "/g", Message: "must be a valid uuid"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewValidator()
+			tt.check(v)
+			assert.Equal(t, tt.want, v.Errors())
+			assert.Equal(t, len(tt.want) == 0, v.Valid())
+		})
+	}
+}
+
+func TestNewValidationProblem(t *testing.T) {
+	v := NewValidator()
+	v.Add("/name", "is required")
+
+	problem := NewValidationProblem(http.StatusUnprocessableEntity, v, "/organizations")
+	assert.Equal(t, http.StatusUnprocessableEntity, problem.Status)
+	assert.Equal(t, "/organizations", problem.Instance)
+	assert.Equal(t, []FieldError{{Pointer: "/name", Message: "is required"}}, problem.Errors)
+
+	problem = NewValidationProblem(http.StatusBadRequest, v, "/organizations")
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+}
+
+func TestValidatorErr(t *testing.T) {
+	v := NewValidator()
+	assert.NoError(t, v.Err())
+
+	v.Add("/url", "must use https")
+	v.Add("/secret", "is too short")
+	err := v.Err()
+	var invalid *ValidationError
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, v.Errors(), invalid.Validator.Errors())
+	assert.EqualError(t, err, "invalid request: /url must use https; /secret is too short")
+}
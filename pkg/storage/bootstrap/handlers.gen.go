@@ -3,41 +3,47 @@
 package bootstrap
 
 import (
-	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/storage/handlers"
 	"github.com/archesai/archesai/pkg/storage/repositories"
 )
 
 // ApplicationHandlers holds all application-layer handlers for this package.
 type ApplicationHandlers struct {
-	CreateArtifact handlers.CreateArtifact
-	CreateLabel    handlers.CreateLabel
-	DeleteArtifact handlers.DeleteArtifact
-	DeleteLabel    handlers.DeleteLabel
-	GetArtifact    handlers.GetArtifact
-	GetLabel       handlers.GetLabel
-	ListArtifacts  handlers.ListArtifacts
-	ListLabels     handlers.ListLabels
-	UpdateArtifact handlers.UpdateArtifact
-	UpdateLabel    handlers.UpdateLabel
+	AddArtifactLabel    handlers.AddArtifactLabel
+	CreateArtifact      handlers.CreateArtifact
+	CreateLabel         handlers.CreateLabel
+	DeleteArtifact      handlers.DeleteArtifact
+	DeleteLabel         handlers.DeleteLabel
+	GetArtifact         handlers.GetArtifact
+	GetLabel            handlers.GetLabel
+	ListArtifactLabels  handlers.ListArtifactLabels
+	ListArtifacts       handlers.ListArtifacts
+	ListLabels          handlers.ListLabels
+	RemoveArtifactLabel handlers.RemoveArtifactLabel
+	RestoreArtifact     handlers.RestoreArtifact
+	UpdateArtifact      handlers.UpdateArtifact
+	UpdateLabel         handlers.UpdateLabel
 }
 
 // NewApplicationHandlers creates all application handlers with proper dependency injection.
 func NewApplicationHandlers(
 	artifactRepo repositories.ArtifactRepository,
 	labelRepo repositories.LabelRepository,
-	publisher events.Publisher,
 ) *ApplicationHandlers {
 	return &ApplicationHandlers{
-		CreateArtifact: handlers.NewCreateArtifact(artifactRepo, publisher),
-		CreateLabel:    handlers.NewCreateLabel(labelRepo, publisher),
-		DeleteArtifact: handlers.NewDeleteArtifact(artifactRepo, publisher),
-		DeleteLabel:    handlers.NewDeleteLabel(labelRepo, publisher),
-		GetArtifact:    handlers.NewGetArtifact(artifactRepo),
-		GetLabel:       handlers.NewGetLabel(labelRepo),
-		ListArtifacts:  handlers.NewListArtifacts(artifactRepo),
-		ListLabels:     handlers.NewListLabels(labelRepo),
-		UpdateArtifact: handlers.NewUpdateArtifact(artifactRepo, publisher),
-		UpdateLabel:    handlers.NewUpdateLabel(labelRepo, publisher),
+		AddArtifactLabel:    handlers.NewAddArtifactLabel(artifactRepo),
+		CreateArtifact:      handlers.NewCreateArtifact(artifactRepo),
+		CreateLabel:         handlers.NewCreateLabel(labelRepo),
+		DeleteArtifact:      handlers.NewDeleteArtifact(artifactRepo),
+		DeleteLabel:         handlers.NewDeleteLabel(labelRepo),
+		GetArtifact:         handlers.NewGetArtifact(artifactRepo),
+		GetLabel:            handlers.NewGetLabel(labelRepo),
+		ListArtifactLabels:  handlers.NewListArtifactLabels(artifactRepo),
+		ListArtifacts:       handlers.NewListArtifacts(artifactRepo),
+		ListLabels:          handlers.NewListLabels(labelRepo),
+		RemoveArtifactLabel: handlers.NewRemoveArtifactLabel(artifactRepo),
+		RestoreArtifact:     handlers.NewRestoreArtifact(artifactRepo),
+		UpdateArtifact:      handlers.NewUpdateArtifact(artifactRepo),
+		UpdateLabel:         handlers.NewUpdateLabel(labelRepo),
 	}
 }
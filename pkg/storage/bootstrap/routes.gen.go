@@ -6,41 +6,53 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/archesai/archesai/pkg/auth"
 	"github.com/archesai/archesai/pkg/storage/routes"
 )
 
 // HTTPHandlers holds all HTTP handlers for this package.
 type HTTPHandlers struct {
-	CreateArtifact *routes.CreateArtifactHandler
-	CreateLabel    *routes.CreateLabelHandler
-	DeleteArtifact *routes.DeleteArtifactHandler
-	DeleteLabel    *routes.DeleteLabelHandler
-	GetArtifact    *routes.GetArtifactHandler
-	GetLabel       *routes.GetLabelHandler
-	ListArtifacts  *routes.ListArtifactsHandler
-	ListLabels     *routes.ListLabelsHandler
-	UpdateArtifact *routes.UpdateArtifactHandler
-	UpdateLabel    *routes.UpdateLabelHandler
+	AddArtifactLabel    *routes.AddArtifactLabelHandler
+	CreateArtifact      *routes.CreateArtifactHandler
+	CreateLabel         *routes.CreateLabelHandler
+	DeleteArtifact      *routes.DeleteArtifactHandler
+	DeleteLabel         *routes.DeleteLabelHandler
+	GetArtifact         *routes.GetArtifactHandler
+	GetLabel            *routes.GetLabelHandler
+	ListArtifactLabels  *routes.ListArtifactLabelsHandler
+	ListArtifacts       *routes.ListArtifactsHandler
+	ListLabels          *routes.ListLabelsHandler
+	RemoveArtifactLabel *routes.RemoveArtifactLabelHandler
+	RestoreArtifact     *routes.RestoreArtifactHandler
+	UpdateArtifact      *routes.UpdateArtifactHandler
+	UpdateLabel         *routes.UpdateLabelHandler
 }
 
 // NewHTTPHandlers creates all HTTP handlers from the given application handlers.
-func NewHTTPHandlers(appHandlers *ApplicationHandlers) *HTTPHandlers {
+// Operations that declare x-codegen-permissions are checked with the authorizer.
+func NewHTTPHandlers(appHandlers *ApplicationHandlers, authorizer auth.Authorizer) *HTTPHandlers {
 	return &HTTPHandlers{
-		CreateArtifact: routes.NewCreateArtifactHandler(appHandlers.CreateArtifact),
-		CreateLabel:    routes.NewCreateLabelHandler(appHandlers.CreateLabel),
-		DeleteArtifact: routes.NewDeleteArtifactHandler(appHandlers.DeleteArtifact),
-		DeleteLabel:    routes.NewDeleteLabelHandler(appHandlers.DeleteLabel),
-		GetArtifact:    routes.NewGetArtifactHandler(appHandlers.GetArtifact),
-		GetLabel:       routes.NewGetLabelHandler(appHandlers.GetLabel),
-		ListArtifacts:  routes.NewListArtifactsHandler(appHandlers.ListArtifacts),
-		ListLabels:     routes.NewListLabelsHandler(appHandlers.ListLabels),
-		UpdateArtifact: routes.NewUpdateArtifactHandler(appHandlers.UpdateArtifact),
-		UpdateLabel:    routes.NewUpdateLabelHandler(appHandlers.UpdateLabel),
+		AddArtifactLabel:    routes.NewAddArtifactLabelHandler(appHandlers.AddArtifactLabel, authorizer),
+		CreateArtifact:      routes.NewCreateArtifactHandler(appHandlers.CreateArtifact, authorizer),
+		CreateLabel:         routes.NewCreateLabelHandler(appHandlers.CreateLabel),
+		DeleteArtifact:      routes.NewDeleteArtifactHandler(appHandlers.DeleteArtifact, authorizer),
+		DeleteLabel:         routes.NewDeleteLabelHandler(appHandlers.DeleteLabel),
+		GetArtifact:         routes.NewGetArtifactHandler(appHandlers.GetArtifact, authorizer),
+		GetLabel:            routes.NewGetLabelHandler(appHandlers.GetLabel),
+		ListArtifactLabels:  routes.NewListArtifactLabelsHandler(appHandlers.ListArtifactLabels, authorizer),
+		ListArtifacts:       routes.NewListArtifactsHandler(appHandlers.ListArtifacts, authorizer),
+		ListLabels:          routes.NewListLabelsHandler(appHandlers.ListLabels),
+		RemoveArtifactLabel: routes.NewRemoveArtifactLabelHandler(appHandlers.RemoveArtifactLabel, authorizer),
+		RestoreArtifact:     routes.NewRestoreArtifactHandler(appHandlers.RestoreArtifact, authorizer),
+		UpdateArtifact:      routes.NewUpdateArtifactHandler(appHandlers.UpdateArtifact, authorizer),
+		UpdateLabel:         routes.NewUpdateLabelHandler(appHandlers.UpdateLabel),
 	}
 }
 
 // RegisterRoutes registers all routes for this package with the http.ServeMux.
 func RegisterRoutes(mux *http.ServeMux, handlers *HTTPHandlers) {
+	slog.Info("registering route", "method", "PUT", "path", "/artifacts/{id}/labels/{labelID}")
+	routes.RegisterAddArtifactLabelRoute(mux, handlers.AddArtifactLabel)
 	slog.Info("registering route", "method", "POST", "path", "/artifacts")
 	routes.RegisterCreateArtifactRoute(mux, handlers.CreateArtifact)
 	slog.Info("registering route", "method", "POST", "path", "/labels")
@@ -53,10 +65,16 @@ func RegisterRoutes(mux *http.ServeMux, handlers *HTTPHandlers) {
 	routes.RegisterGetArtifactRoute(mux, handlers.GetArtifact)
 	slog.Info("registering route", "method", "GET", "path", "/labels/{id}")
 	routes.RegisterGetLabelRoute(mux, handlers.GetLabel)
+	slog.Info("registering route", "method", "GET", "path", "/artifacts/{id}/labels")
+	routes.RegisterListArtifactLabelsRoute(mux, handlers.ListArtifactLabels)
 	slog.Info("registering route", "method", "GET", "path", "/artifacts")
 	routes.RegisterListArtifactsRoute(mux, handlers.ListArtifacts)
 	slog.Info("registering route", "method", "GET", "path", "/labels")
 	routes.RegisterListLabelsRoute(mux, handlers.ListLabels)
+	slog.Info("registering route", "method", "DELETE", "path", "/artifacts/{id}/labels/{labelID}")
+	routes.RegisterRemoveArtifactLabelRoute(mux, handlers.RemoveArtifactLabel)
+	slog.Info("registering route", "method", "POST", "path", "/artifacts/{id}/restore")
+	routes.RegisterRestoreArtifactRoute(mux, handlers.RestoreArtifact)
 	slog.Info("registering route", "method", "PATCH", "path", "/artifacts/{id}")
 	routes.RegisterUpdateArtifactRoute(mux, handlers.UpdateArtifact)
 	slog.Info("registering route", "method", "PATCH", "path", "/labels/{id}")
@@ -9,7 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/archesai/archesai/pkg/storage/repositories"
 )
@@ -20,8 +20,9 @@ import (
 
 // CreateArtifactInput represents the input for the CreateArtifact operation.
 type CreateArtifactInput struct {
-	Name *string
-	Text string
+	SessionID uuid.UUID
+	Name      *string
+	Text      string
 }
 
 // CreateArtifactOutput represents the output for the CreateArtifact operation.
@@ -36,28 +37,31 @@ type CreateArtifact interface {
 
 // CreateArtifactImpl is the default implementation of CreateArtifact.
 type CreateArtifactImpl struct {
-	repo      repositories.ArtifactRepository
-	publisher events.Publisher
+	repo repositories.ArtifactRepository
 }
 
 // NewCreateArtifact creates a new CreateArtifact handler.
 func NewCreateArtifact(
 	repo repositories.ArtifactRepository,
-	publisher events.Publisher,
 ) CreateArtifact {
 	return &CreateArtifactImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
 // Execute performs the CreateArtifact operation.
 func (h *CreateArtifactImpl) Execute(ctx context.Context, input *CreateArtifactInput) (*CreateArtifactOutput, error) {
+	organizationID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create entity
 	entity := &models.Artifact{
-		ID:        uuid.New(),
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ID:             uuid.New(),
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+		OrganizationID: organizationID,
 		// TODO: Map input fields to entity
 	}
 
@@ -67,15 +71,10 @@ func (h *CreateArtifactImpl) Execute(ctx context.Context, input *CreateArtifactI
 		return nil, fmt.Errorf("failed to create artifact: %w", err)
 	}
 
-	// Publish event
-	event := models.NewArtifactCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &CreateArtifactOutput{
-		// TODO: Map created entity to output
+		Data: *created,
 	}
-	_ = created
 
 	return output, nil
 }
@@ -9,7 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/archesai/archesai/pkg/storage/repositories"
 )
@@ -20,7 +20,8 @@ import (
 
 // CreateLabelInput represents the input for the CreateLabel operation.
 type CreateLabelInput struct {
-	Name string
+	SessionID uuid.UUID
+	Name      string
 }
 
 // CreateLabelOutput represents the output for the CreateLabel operation.
@@ -35,28 +36,31 @@ type CreateLabel interface {
 
 // CreateLabelImpl is the default implementation of CreateLabel.
 type CreateLabelImpl struct {
-	repo      repositories.LabelRepository
-	publisher events.Publisher
+	repo repositories.LabelRepository
 }
 
 // NewCreateLabel creates a new CreateLabel handler.
 func NewCreateLabel(
 	repo repositories.LabelRepository,
-	publisher events.Publisher,
 ) CreateLabel {
 	return &CreateLabelImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
 // Execute performs the CreateLabel operation.
 func (h *CreateLabelImpl) Execute(ctx context.Context, input *CreateLabelInput) (*CreateLabelOutput, error) {
+	organizationID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create entity
 	entity := &models.Label{
-		ID:        uuid.New(),
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ID:             uuid.New(),
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+		OrganizationID: organizationID,
 		// TODO: Map input fields to entity
 	}
 
@@ -66,15 +70,10 @@ func (h *CreateLabelImpl) Execute(ctx context.Context, input *CreateLabelInput)
 		return nil, fmt.Errorf("failed to create label: %w", err)
 	}
 
-	// Publish event
-	event := models.NewLabelCreatedEvent(created.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &CreateLabelOutput{
-		// TODO: Map created entity to output
+		Data: *created,
 	}
-	_ = created
 
 	return output, nil
 }
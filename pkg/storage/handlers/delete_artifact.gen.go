@@ -8,8 +8,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
-	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/archesai/archesai/pkg/storage/repositories"
 )
 
@@ -19,7 +17,8 @@ import (
 
 // DeleteArtifactInput represents the input for the DeleteArtifact operation.
 type DeleteArtifactInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // DeleteArtifact defines the interface for the DeleteArtifact operation.
@@ -29,18 +28,15 @@ type DeleteArtifact interface {
 
 // DeleteArtifactImpl is the default implementation of DeleteArtifact.
 type DeleteArtifactImpl struct {
-	repo      repositories.ArtifactRepository
-	publisher events.Publisher
+	repo repositories.ArtifactRepository
 }
 
 // NewDeleteArtifact creates a new DeleteArtifact handler.
 func NewDeleteArtifact(
 	repo repositories.ArtifactRepository,
-	publisher events.Publisher,
 ) DeleteArtifact {
 	return &DeleteArtifactImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -51,9 +47,5 @@ func (h *DeleteArtifactImpl) Execute(ctx context.Context, input *DeleteArtifactI
 		return fmt.Errorf("failed to delete artifact: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewArtifactDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
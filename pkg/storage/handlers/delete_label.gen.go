@@ -8,8 +8,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
-	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/archesai/archesai/pkg/storage/repositories"
 )
 
@@ -19,7 +17,8 @@ import (
 
 // DeleteLabelInput represents the input for the DeleteLabel operation.
 type DeleteLabelInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // DeleteLabel defines the interface for the DeleteLabel operation.
@@ -29,18 +28,15 @@ type DeleteLabel interface {
 
 // DeleteLabelImpl is the default implementation of DeleteLabel.
 type DeleteLabelImpl struct {
-	repo      repositories.LabelRepository
-	publisher events.Publisher
+	repo repositories.LabelRepository
 }
 
 // NewDeleteLabel creates a new DeleteLabel handler.
 func NewDeleteLabel(
 	repo repositories.LabelRepository,
-	publisher events.Publisher,
 ) DeleteLabel {
 	return &DeleteLabelImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -51,9 +47,5 @@ func (h *DeleteLabelImpl) Execute(ctx context.Context, input *DeleteLabelInput)
 		return fmt.Errorf("failed to delete label: %w", err)
 	}
 
-	// Publish domain event
-	event := models.NewLabelDeletedEvent(input.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	return nil
 }
@@ -18,7 +18,8 @@ import (
 
 // GetArtifactInput represents the input for the GetArtifact operation.
 type GetArtifactInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // GetArtifactOutput represents the output for the GetArtifact operation.
@@ -55,9 +56,8 @@ func (h *GetArtifactImpl) Execute(ctx context.Context, input *GetArtifactInput)
 
 	// Map to output
 	output := &GetArtifactOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
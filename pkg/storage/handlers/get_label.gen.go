@@ -18,7 +18,8 @@ import (
 
 // GetLabelInput represents the input for the GetLabel operation.
 type GetLabelInput struct {
-	ID uuid.UUID
+	SessionID uuid.UUID
+	ID        uuid.UUID
 }
 
 // GetLabelOutput represents the output for the GetLabel operation.
@@ -55,9 +56,8 @@ func (h *GetLabelImpl) Execute(ctx context.Context, input *GetLabelInput) (*GetL
 
 	// Map to output
 	output := &GetLabelOutput{
-		// TODO: Map result fields to output
+		Data: *result,
 	}
-	_ = result
 
 	return output, nil
 }
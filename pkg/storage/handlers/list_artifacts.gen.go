@@ -6,6 +6,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/database"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/archesai/archesai/pkg/storage/repositories"
@@ -17,15 +20,18 @@ import (
 
 // ListArtifactsInput represents the input for the ListArtifacts operation.
 type ListArtifactsInput struct {
-	Filter map[string]any
-	Page   map[string]any
-	Sort   []map[string]any
+	SessionID uuid.UUID
+	Filter    map[string]any
+	Page      map[string]any
+	Q         *string
+	Sort      []map[string]any
 }
 
 // ListArtifactsOutput represents the output for the ListArtifacts operation.
 type ListArtifactsOutput struct {
-	Data []models.Artifact           `json:"data"`
-	Meta servermodels.PaginationMeta `json:"meta"`
+	Data       []models.Artifact           `json:"data"`
+	Highlights map[string]string           `json:"highlights,omitempty"`
+	Meta       servermodels.PaginationMeta `json:"meta"`
 }
 
 // ListArtifacts defines the interface for the ListArtifacts operation.
@@ -49,20 +55,39 @@ func NewListArtifacts(
 
 // Execute performs the ListArtifacts operation.
 func (h *ListArtifactsImpl) Execute(ctx context.Context, input *ListArtifactsInput) (*ListArtifactsOutput, error) {
+	opts, err := database.NewListOptions(input.Filter, input.Page, input.Sort)
+	if err != nil {
+		return nil, err
+	}
+	if input.Q != nil {
+		opts.Search = *input.Q
+	}
+
 	// List from repository
-	results, total, err := h.repo.List(ctx, 100, 0) // TODO: Use pagination from input
+	results, info, err := h.repo.List(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list artifacts: %w", err)
 	}
 
 	// Map to output
+	data := make([]models.Artifact, len(results))
+	for i, result := range results {
+		data[i] = *result
+	}
 	output := &ListArtifactsOutput{
-		// TODO: Map results to output structure
-		// Data: results,
-		// Total: total,
+		Data: data,
+		Meta: servermodels.PaginationMeta{
+			Total: int32(info.Total),
+			Next:  info.Next,
+			Prev:  info.Prev,
+		},
+	}
+	if info.Snippets != nil {
+		output.Highlights = make(map[string]string, len(info.Snippets))
+		for id, snippet := range info.Snippets {
+			output.Highlights[id.String()] = snippet
+		}
 	}
-	_ = results
-	_ = total
 
 	return output, nil
 }
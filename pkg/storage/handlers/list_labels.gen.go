@@ -6,6 +6,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/database"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/archesai/archesai/pkg/storage/repositories"
@@ -17,9 +20,10 @@ import (
 
 // ListLabelsInput represents the input for the ListLabels operation.
 type ListLabelsInput struct {
-	Filter map[string]any
-	Page   map[string]any
-	Sort   []map[string]any
+	SessionID uuid.UUID
+	Filter    map[string]any
+	Page      map[string]any
+	Sort      []map[string]any
 }
 
 // ListLabelsOutput represents the output for the ListLabels operation.
@@ -49,20 +53,30 @@ func NewListLabels(
 
 // Execute performs the ListLabels operation.
 func (h *ListLabelsImpl) Execute(ctx context.Context, input *ListLabelsInput) (*ListLabelsOutput, error) {
+	opts, err := database.NewListOptions(input.Filter, input.Page, input.Sort)
+	if err != nil {
+		return nil, err
+	}
+
 	// List from repository
-	results, total, err := h.repo.List(ctx, 100, 0) // TODO: Use pagination from input
+	results, info, err := h.repo.List(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list labels: %w", err)
 	}
 
 	// Map to output
+	data := make([]models.Label, len(results))
+	for i, result := range results {
+		data[i] = *result
+	}
 	output := &ListLabelsOutput{
-		// TODO: Map results to output structure
-		// Data: results,
-		// Total: total,
+		Data: data,
+		Meta: servermodels.PaginationMeta{
+			Total: int32(info.Total),
+			Next:  info.Next,
+			Prev:  info.Prev,
+		},
 	}
-	_ = results
-	_ = total
 
 	return output, nil
 }
@@ -9,7 +9,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/archesai/archesai/pkg/storage/repositories"
 )
@@ -20,10 +19,11 @@ import (
 
 // UpdateArtifactInput represents the input for the UpdateArtifact operation.
 type UpdateArtifactInput struct {
-	ID   uuid.UUID
-	Name *string
-	Text *string
-	URL  *string
+	SessionID uuid.UUID
+	ID        uuid.UUID
+	Name      *string
+	Text      *string
+	URL       *string
 }
 
 // UpdateArtifactOutput represents the output for the UpdateArtifact operation.
@@ -38,18 +38,15 @@ type UpdateArtifact interface {
 
 // UpdateArtifactImpl is the default implementation of UpdateArtifact.
 type UpdateArtifactImpl struct {
-	repo      repositories.ArtifactRepository
-	publisher events.Publisher
+	repo repositories.ArtifactRepository
 }
 
 // NewUpdateArtifact creates a new UpdateArtifact handler.
 func NewUpdateArtifact(
 	repo repositories.ArtifactRepository,
-	publisher events.Publisher,
 ) UpdateArtifact {
 	return &UpdateArtifactImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -71,15 +68,10 @@ func (h *UpdateArtifactImpl) Execute(ctx context.Context, input *UpdateArtifactI
 		return nil, fmt.Errorf("failed to update artifact: %w", err)
 	}
 
-	// Publish event
-	event := models.NewArtifactUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdateArtifactOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
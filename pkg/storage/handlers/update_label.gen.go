@@ -9,7 +9,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/archesai/archesai/pkg/events"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/archesai/archesai/pkg/storage/repositories"
 )
@@ -20,8 +19,9 @@ import (
 
 // UpdateLabelInput represents the input for the UpdateLabel operation.
 type UpdateLabelInput struct {
-	ID   uuid.UUID
-	Name *string
+	SessionID uuid.UUID
+	ID        uuid.UUID
+	Name      *string
 }
 
 // UpdateLabelOutput represents the output for the UpdateLabel operation.
@@ -36,18 +36,15 @@ type UpdateLabel interface {
 
 // UpdateLabelImpl is the default implementation of UpdateLabel.
 type UpdateLabelImpl struct {
-	repo      repositories.LabelRepository
-	publisher events.Publisher
+	repo repositories.LabelRepository
 }
 
 // NewUpdateLabel creates a new UpdateLabel handler.
 func NewUpdateLabel(
 	repo repositories.LabelRepository,
-	publisher events.Publisher,
 ) UpdateLabel {
 	return &UpdateLabelImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo: repo,
 	}
 }
 
@@ -69,15 +66,10 @@ func (h *UpdateLabelImpl) Execute(ctx context.Context, input *UpdateLabelInput)
 		return nil, fmt.Errorf("failed to update label: %w", err)
 	}
 
-	// Publish event
-	event := models.NewLabelUpdatedEvent(updated.ID)
-	_ = h.publisher.Publish(ctx, event)
-
 	// Map to output
 	output := &UpdateLabelOutput{
-		// TODO: Map updated entity to output
+		Data: *updated,
 	}
-	_ = updated
 
 	return output, nil
 }
@@ -27,6 +27,15 @@ func NewMemoryStorage() *MemoryStorage {
 	}
 }
 
+// NewMemoryStorageWithBaseDir creates a new MemoryStorage that reports
+// baseDir as its base directory. Generators use the base directory to look
+// at files on disk, so dry runs stay accurate.
+func NewMemoryStorageWithBaseDir(baseDir string) *MemoryStorage {
+	m := NewMemoryStorage()
+	m.baseDir = baseDir
+	return m
+}
+
 // WriteFile writes data to a file at the given path
 func (m *MemoryStorage) WriteFile(path string, data []byte, _ os.FileMode) error {
 	m.mu.Lock()
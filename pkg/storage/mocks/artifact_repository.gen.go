@@ -0,0 +1,837 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/storage/models"
+	"github.com/archesai/archesai/pkg/storage/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.ArtifactRepository = (*ArtifactRepository)(nil)
+
+// ArtifactRepository is a mock of repositories.ArtifactRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type ArtifactRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Artifact) (*models.Artifact, error)
+
+	createCalls   []ArtifactRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Artifact) (*models.Artifact, error)
+	createReturns func(context.Context, *models.Artifact) (*models.Artifact, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Artifact, error)
+
+	getCalls   []ArtifactRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Artifact, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Artifact, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Artifact) (*models.Artifact, error)
+
+	updateCalls   []ArtifactRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Artifact) (*models.Artifact, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Artifact) (*models.Artifact, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []ArtifactRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error)
+
+	listCalls   []ArtifactRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Artifact, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Artifact, database.PageInfo, error)
+
+	// RestoreFunc, if set, is called by Restore.
+	RestoreFunc func(ctx context.Context, id uuid.UUID) error
+
+	restoreCalls   []ArtifactRepositoryRestoreCall
+	restoreOnCall  map[int]func(context.Context, uuid.UUID) error
+	restoreReturns func(context.Context, uuid.UUID) error
+
+	// ListDeletedFunc, if set, is called by ListDeleted.
+	ListDeletedFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error)
+
+	listDeletedCalls   []ArtifactRepositoryListDeletedCall
+	listDeletedOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Artifact, database.PageInfo, error)
+	listDeletedReturns func(context.Context, database.ListOptions) ([]*models.Artifact, database.PageInfo, error)
+
+	// PurgeFunc, if set, is called by Purge.
+	PurgeFunc func(ctx context.Context, before time.Time) (int64, error)
+
+	purgeCalls   []ArtifactRepositoryPurgeCall
+	purgeOnCall  map[int]func(context.Context, time.Time) (int64, error)
+	purgeReturns func(context.Context, time.Time) (int64, error)
+
+	// AddLabelFunc, if set, is called by AddLabel.
+	AddLabelFunc func(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error
+
+	addLabelCalls   []ArtifactRepositoryAddLabelCall
+	addLabelOnCall  map[int]func(context.Context, uuid.UUID, uuid.UUID) error
+	addLabelReturns func(context.Context, uuid.UUID, uuid.UUID) error
+
+	// RemoveLabelFunc, if set, is called by RemoveLabel.
+	RemoveLabelFunc func(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error
+
+	removeLabelCalls   []ArtifactRepositoryRemoveLabelCall
+	removeLabelOnCall  map[int]func(context.Context, uuid.UUID, uuid.UUID) error
+	removeLabelReturns func(context.Context, uuid.UUID, uuid.UUID) error
+
+	// ListLabelsFunc, if set, is called by ListLabels.
+	ListLabelsFunc func(ctx context.Context, id uuid.UUID) ([]*models.Label, error)
+
+	listLabelsCalls   []ArtifactRepositoryListLabelsCall
+	listLabelsOnCall  map[int]func(context.Context, uuid.UUID) ([]*models.Label, error)
+	listLabelsReturns func(context.Context, uuid.UUID) ([]*models.Label, error)
+
+	// ListArtifactsByOrganizationFunc, if set, is called by ListArtifactsByOrganization.
+	ListArtifactsByOrganizationFunc func(ctx context.Context, organizationID string) ([]*models.Artifact, error)
+
+	listArtifactsByOrganizationCalls   []ArtifactRepositoryListArtifactsByOrganizationCall
+	listArtifactsByOrganizationOnCall  map[int]func(context.Context, string) ([]*models.Artifact, error)
+	listArtifactsByOrganizationReturns func(context.Context, string) ([]*models.Artifact, error)
+
+	// ListArtifactsByProducerFunc, if set, is called by ListArtifactsByProducer.
+	ListArtifactsByProducerFunc func(ctx context.Context, producerID string) ([]*models.Artifact, error)
+
+	listArtifactsByProducerCalls   []ArtifactRepositoryListArtifactsByProducerCall
+	listArtifactsByProducerOnCall  map[int]func(context.Context, string) ([]*models.Artifact, error)
+	listArtifactsByProducerReturns func(context.Context, string) ([]*models.Artifact, error)
+}
+
+// ArtifactRepositoryCreateCall holds the arguments of a call to Create.
+type ArtifactRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Artifact
+}
+
+// Create records the call and returns the configured result.
+func (m *ArtifactRepository) Create(ctx context.Context, entity *models.Artifact) (r0 *models.Artifact, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, ArtifactRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *ArtifactRepository) CreateReturns(r0 *models.Artifact, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Artifact) (*models.Artifact, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Artifact) (*models.Artifact, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Artifact) (*models.Artifact, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *ArtifactRepository) CreateCalls() []ArtifactRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// ArtifactRepositoryGetCall holds the arguments of a call to Get.
+type ArtifactRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *ArtifactRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Artifact, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, ArtifactRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *ArtifactRepository) GetReturns(r0 *models.Artifact, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Artifact, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Artifact, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Artifact, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *ArtifactRepository) GetCalls() []ArtifactRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// ArtifactRepositoryUpdateCall holds the arguments of a call to Update.
+type ArtifactRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Artifact
+}
+
+// Update records the call and returns the configured result.
+func (m *ArtifactRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Artifact) (r0 *models.Artifact, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, ArtifactRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *ArtifactRepository) UpdateReturns(r0 *models.Artifact, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Artifact) (*models.Artifact, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Artifact) (*models.Artifact, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Artifact) (*models.Artifact, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *ArtifactRepository) UpdateCalls() []ArtifactRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// ArtifactRepositoryDeleteCall holds the arguments of a call to Delete.
+type ArtifactRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *ArtifactRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, ArtifactRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *ArtifactRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *ArtifactRepository) DeleteCalls() []ArtifactRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// ArtifactRepositoryListCall holds the arguments of a call to List.
+type ArtifactRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *ArtifactRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Artifact, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, ArtifactRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *ArtifactRepository) ListReturns(r0 []*models.Artifact, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Artifact, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Artifact, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *ArtifactRepository) ListCalls() []ArtifactRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// ArtifactRepositoryRestoreCall holds the arguments of a call to Restore.
+type ArtifactRepositoryRestoreCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Restore records the call and returns the configured result.
+func (m *ArtifactRepository) Restore(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.restoreCalls = append(m.restoreCalls, ArtifactRepositoryRestoreCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.restoreOnCall[len(m.restoreCalls)-1]
+	if fn == nil {
+		fn = m.RestoreFunc
+	}
+	if fn == nil {
+		fn = m.restoreReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// RestoreReturns makes calls to Restore return the given values.
+func (m *ArtifactRepository) RestoreReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.restoreReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnRestoreCall makes the call to Restore with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnRestoreCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.restoreOnCall == nil {
+		m.restoreOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.restoreOnCall[i] = fn
+}
+
+// RestoreCalls returns the calls made to Restore, in order.
+func (m *ArtifactRepository) RestoreCalls() []ArtifactRepositoryRestoreCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.restoreCalls)
+}
+
+// ArtifactRepositoryListDeletedCall holds the arguments of a call to ListDeleted.
+type ArtifactRepositoryListDeletedCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// ListDeleted records the call and returns the configured result.
+func (m *ArtifactRepository) ListDeleted(ctx context.Context, opts database.ListOptions) (r0 []*models.Artifact, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listDeletedCalls = append(m.listDeletedCalls, ArtifactRepositoryListDeletedCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listDeletedOnCall[len(m.listDeletedCalls)-1]
+	if fn == nil {
+		fn = m.ListDeletedFunc
+	}
+	if fn == nil {
+		fn = m.listDeletedReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListDeletedReturns makes calls to ListDeleted return the given values.
+func (m *ArtifactRepository) ListDeletedReturns(r0 []*models.Artifact, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listDeletedReturns = func(context.Context, database.ListOptions) ([]*models.Artifact, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListDeletedCall makes the call to ListDeleted with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnListDeletedCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listDeletedOnCall == nil {
+		m.listDeletedOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Artifact, database.PageInfo, error))
+	}
+	m.listDeletedOnCall[i] = fn
+}
+
+// ListDeletedCalls returns the calls made to ListDeleted, in order.
+func (m *ArtifactRepository) ListDeletedCalls() []ArtifactRepositoryListDeletedCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listDeletedCalls)
+}
+
+// ArtifactRepositoryPurgeCall holds the arguments of a call to Purge.
+type ArtifactRepositoryPurgeCall struct {
+	Ctx    context.Context
+	Before time.Time
+}
+
+// Purge records the call and returns the configured result.
+func (m *ArtifactRepository) Purge(ctx context.Context, before time.Time) (r0 int64, r1 error) {
+	m.mu.Lock()
+	m.purgeCalls = append(m.purgeCalls, ArtifactRepositoryPurgeCall{
+		Ctx:    ctx,
+		Before: before,
+	})
+	fn := m.purgeOnCall[len(m.purgeCalls)-1]
+	if fn == nil {
+		fn = m.PurgeFunc
+	}
+	if fn == nil {
+		fn = m.purgeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, before)
+}
+
+// PurgeReturns makes calls to Purge return the given values.
+func (m *ArtifactRepository) PurgeReturns(r0 int64, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purgeReturns = func(context.Context, time.Time) (int64, error) {
+		return r0, r1
+	}
+}
+
+// OnPurgeCall makes the call to Purge with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnPurgeCall(i int, fn func(ctx context.Context, before time.Time) (int64, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.purgeOnCall == nil {
+		m.purgeOnCall = make(map[int]func(context.Context, time.Time) (int64, error))
+	}
+	m.purgeOnCall[i] = fn
+}
+
+// PurgeCalls returns the calls made to Purge, in order.
+func (m *ArtifactRepository) PurgeCalls() []ArtifactRepositoryPurgeCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.purgeCalls)
+}
+
+// ArtifactRepositoryAddLabelCall holds the arguments of a call to AddLabel.
+type ArtifactRepositoryAddLabelCall struct {
+	Ctx     context.Context
+	ID      uuid.UUID
+	LabelID uuid.UUID
+}
+
+// AddLabel records the call and returns the configured result.
+func (m *ArtifactRepository) AddLabel(ctx context.Context, id uuid.UUID, labelID uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.addLabelCalls = append(m.addLabelCalls, ArtifactRepositoryAddLabelCall{
+		Ctx:     ctx,
+		ID:      id,
+		LabelID: labelID,
+	})
+	fn := m.addLabelOnCall[len(m.addLabelCalls)-1]
+	if fn == nil {
+		fn = m.AddLabelFunc
+	}
+	if fn == nil {
+		fn = m.addLabelReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id, labelID)
+}
+
+// AddLabelReturns makes calls to AddLabel return the given values.
+func (m *ArtifactRepository) AddLabelReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.addLabelReturns = func(context.Context, uuid.UUID, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnAddLabelCall makes the call to AddLabel with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnAddLabelCall(i int, fn func(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.addLabelOnCall == nil {
+		m.addLabelOnCall = make(map[int]func(context.Context, uuid.UUID, uuid.UUID) error)
+	}
+	m.addLabelOnCall[i] = fn
+}
+
+// AddLabelCalls returns the calls made to AddLabel, in order.
+func (m *ArtifactRepository) AddLabelCalls() []ArtifactRepositoryAddLabelCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.addLabelCalls)
+}
+
+// ArtifactRepositoryRemoveLabelCall holds the arguments of a call to RemoveLabel.
+type ArtifactRepositoryRemoveLabelCall struct {
+	Ctx     context.Context
+	ID      uuid.UUID
+	LabelID uuid.UUID
+}
+
+// RemoveLabel records the call and returns the configured result.
+func (m *ArtifactRepository) RemoveLabel(ctx context.Context, id uuid.UUID, labelID uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.removeLabelCalls = append(m.removeLabelCalls, ArtifactRepositoryRemoveLabelCall{
+		Ctx:     ctx,
+		ID:      id,
+		LabelID: labelID,
+	})
+	fn := m.removeLabelOnCall[len(m.removeLabelCalls)-1]
+	if fn == nil {
+		fn = m.RemoveLabelFunc
+	}
+	if fn == nil {
+		fn = m.removeLabelReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id, labelID)
+}
+
+// RemoveLabelReturns makes calls to RemoveLabel return the given values.
+func (m *ArtifactRepository) RemoveLabelReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.removeLabelReturns = func(context.Context, uuid.UUID, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnRemoveLabelCall makes the call to RemoveLabel with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnRemoveLabelCall(i int, fn func(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.removeLabelOnCall == nil {
+		m.removeLabelOnCall = make(map[int]func(context.Context, uuid.UUID, uuid.UUID) error)
+	}
+	m.removeLabelOnCall[i] = fn
+}
+
+// RemoveLabelCalls returns the calls made to RemoveLabel, in order.
+func (m *ArtifactRepository) RemoveLabelCalls() []ArtifactRepositoryRemoveLabelCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.removeLabelCalls)
+}
+
+// ArtifactRepositoryListLabelsCall holds the arguments of a call to ListLabels.
+type ArtifactRepositoryListLabelsCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// ListLabels records the call and returns the configured result.
+func (m *ArtifactRepository) ListLabels(ctx context.Context, id uuid.UUID) (r0 []*models.Label, r1 error) {
+	m.mu.Lock()
+	m.listLabelsCalls = append(m.listLabelsCalls, ArtifactRepositoryListLabelsCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.listLabelsOnCall[len(m.listLabelsCalls)-1]
+	if fn == nil {
+		fn = m.ListLabelsFunc
+	}
+	if fn == nil {
+		fn = m.listLabelsReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// ListLabelsReturns makes calls to ListLabels return the given values.
+func (m *ArtifactRepository) ListLabelsReturns(r0 []*models.Label, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listLabelsReturns = func(context.Context, uuid.UUID) ([]*models.Label, error) {
+		return r0, r1
+	}
+}
+
+// OnListLabelsCall makes the call to ListLabels with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnListLabelsCall(i int, fn func(ctx context.Context, id uuid.UUID) ([]*models.Label, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listLabelsOnCall == nil {
+		m.listLabelsOnCall = make(map[int]func(context.Context, uuid.UUID) ([]*models.Label, error))
+	}
+	m.listLabelsOnCall[i] = fn
+}
+
+// ListLabelsCalls returns the calls made to ListLabels, in order.
+func (m *ArtifactRepository) ListLabelsCalls() []ArtifactRepositoryListLabelsCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listLabelsCalls)
+}
+
+// ArtifactRepositoryListArtifactsByOrganizationCall holds the arguments of a call to ListArtifactsByOrganization.
+type ArtifactRepositoryListArtifactsByOrganizationCall struct {
+	Ctx            context.Context
+	OrganizationID string
+}
+
+// ListArtifactsByOrganization records the call and returns the configured result.
+func (m *ArtifactRepository) ListArtifactsByOrganization(ctx context.Context, organizationID string) (r0 []*models.Artifact, r1 error) {
+	m.mu.Lock()
+	m.listArtifactsByOrganizationCalls = append(m.listArtifactsByOrganizationCalls, ArtifactRepositoryListArtifactsByOrganizationCall{
+		Ctx:            ctx,
+		OrganizationID: organizationID,
+	})
+	fn := m.listArtifactsByOrganizationOnCall[len(m.listArtifactsByOrganizationCalls)-1]
+	if fn == nil {
+		fn = m.ListArtifactsByOrganizationFunc
+	}
+	if fn == nil {
+		fn = m.listArtifactsByOrganizationReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, organizationID)
+}
+
+// ListArtifactsByOrganizationReturns makes calls to ListArtifactsByOrganization return the given values.
+func (m *ArtifactRepository) ListArtifactsByOrganizationReturns(r0 []*models.Artifact, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listArtifactsByOrganizationReturns = func(context.Context, string) ([]*models.Artifact, error) {
+		return r0, r1
+	}
+}
+
+// OnListArtifactsByOrganizationCall makes the call to ListArtifactsByOrganization with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnListArtifactsByOrganizationCall(i int, fn func(ctx context.Context, organizationID string) ([]*models.Artifact, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listArtifactsByOrganizationOnCall == nil {
+		m.listArtifactsByOrganizationOnCall = make(map[int]func(context.Context, string) ([]*models.Artifact, error))
+	}
+	m.listArtifactsByOrganizationOnCall[i] = fn
+}
+
+// ListArtifactsByOrganizationCalls returns the calls made to ListArtifactsByOrganization, in order.
+func (m *ArtifactRepository) ListArtifactsByOrganizationCalls() []ArtifactRepositoryListArtifactsByOrganizationCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listArtifactsByOrganizationCalls)
+}
+
+// ArtifactRepositoryListArtifactsByProducerCall holds the arguments of a call to ListArtifactsByProducer.
+type ArtifactRepositoryListArtifactsByProducerCall struct {
+	Ctx        context.Context
+	ProducerID string
+}
+
+// ListArtifactsByProducer records the call and returns the configured result.
+func (m *ArtifactRepository) ListArtifactsByProducer(ctx context.Context, producerID string) (r0 []*models.Artifact, r1 error) {
+	m.mu.Lock()
+	m.listArtifactsByProducerCalls = append(m.listArtifactsByProducerCalls, ArtifactRepositoryListArtifactsByProducerCall{
+		Ctx:        ctx,
+		ProducerID: producerID,
+	})
+	fn := m.listArtifactsByProducerOnCall[len(m.listArtifactsByProducerCalls)-1]
+	if fn == nil {
+		fn = m.ListArtifactsByProducerFunc
+	}
+	if fn == nil {
+		fn = m.listArtifactsByProducerReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, producerID)
+}
+
+// ListArtifactsByProducerReturns makes calls to ListArtifactsByProducer return the given values.
+func (m *ArtifactRepository) ListArtifactsByProducerReturns(r0 []*models.Artifact, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listArtifactsByProducerReturns = func(context.Context, string) ([]*models.Artifact, error) {
+		return r0, r1
+	}
+}
+
+// OnListArtifactsByProducerCall makes the call to ListArtifactsByProducer with index i, counting from
+// zero, return the result of fn.
+func (m *ArtifactRepository) OnListArtifactsByProducerCall(i int, fn func(ctx context.Context, producerID string) ([]*models.Artifact, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listArtifactsByProducerOnCall == nil {
+		m.listArtifactsByProducerOnCall = make(map[int]func(context.Context, string) ([]*models.Artifact, error))
+	}
+	m.listArtifactsByProducerOnCall[i] = fn
+}
+
+// ListArtifactsByProducerCalls returns the calls made to ListArtifactsByProducer, in order.
+func (m *ArtifactRepository) ListArtifactsByProducerCalls() []ArtifactRepositoryListArtifactsByProducerCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listArtifactsByProducerCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/storage/handlers"
+)
+
+var _ handlers.DeleteArtifact = (*DeleteArtifact)(nil)
+
+// DeleteArtifact is a mock of handlers.DeleteArtifact that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type DeleteArtifact struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.DeleteArtifactInput) error
+
+	executeCalls   []DeleteArtifactExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.DeleteArtifactInput) error
+	executeReturns func(context.Context, *handlers.DeleteArtifactInput) error
+}
+
+// DeleteArtifactExecuteCall holds the arguments of a call to Execute.
+type DeleteArtifactExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.DeleteArtifactInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *DeleteArtifact) Execute(ctx context.Context, input *handlers.DeleteArtifactInput) (r0 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, DeleteArtifactExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *DeleteArtifact) ExecuteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.DeleteArtifactInput) error {
+		return r0
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *DeleteArtifact) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.DeleteArtifactInput) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.DeleteArtifactInput) error)
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *DeleteArtifact) ExecuteCalls() []DeleteArtifactExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/storage/handlers"
+)
+
+var _ handlers.DeleteLabel = (*DeleteLabel)(nil)
+
+// DeleteLabel is a mock of handlers.DeleteLabel that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type DeleteLabel struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.DeleteLabelInput) error
+
+	executeCalls   []DeleteLabelExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.DeleteLabelInput) error
+	executeReturns func(context.Context, *handlers.DeleteLabelInput) error
+}
+
+// DeleteLabelExecuteCall holds the arguments of a call to Execute.
+type DeleteLabelExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.DeleteLabelInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *DeleteLabel) Execute(ctx context.Context, input *handlers.DeleteLabelInput) (r0 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, DeleteLabelExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *DeleteLabel) ExecuteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.DeleteLabelInput) error {
+		return r0
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *DeleteLabel) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.DeleteLabelInput) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.DeleteLabelInput) error)
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *DeleteLabel) ExecuteCalls() []DeleteLabelExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,462 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/storage/models"
+	"github.com/archesai/archesai/pkg/storage/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.LabelRepository = (*LabelRepository)(nil)
+
+// LabelRepository is a mock of repositories.LabelRepository that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type LabelRepository struct {
+	mu sync.Mutex
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(ctx context.Context, entity *models.Label) (*models.Label, error)
+
+	createCalls   []LabelRepositoryCreateCall
+	createOnCall  map[int]func(context.Context, *models.Label) (*models.Label, error)
+	createReturns func(context.Context, *models.Label) (*models.Label, error)
+
+	// GetFunc, if set, is called by Get.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*models.Label, error)
+
+	getCalls   []LabelRepositoryGetCall
+	getOnCall  map[int]func(context.Context, uuid.UUID) (*models.Label, error)
+	getReturns func(context.Context, uuid.UUID) (*models.Label, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(ctx context.Context, id uuid.UUID, entity *models.Label) (*models.Label, error)
+
+	updateCalls   []LabelRepositoryUpdateCall
+	updateOnCall  map[int]func(context.Context, uuid.UUID, *models.Label) (*models.Label, error)
+	updateReturns func(context.Context, uuid.UUID, *models.Label) (*models.Label, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	deleteCalls   []LabelRepositoryDeleteCall
+	deleteOnCall  map[int]func(context.Context, uuid.UUID) error
+	deleteReturns func(context.Context, uuid.UUID) error
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(ctx context.Context, opts database.ListOptions) ([]*models.Label, database.PageInfo, error)
+
+	listCalls   []LabelRepositoryListCall
+	listOnCall  map[int]func(context.Context, database.ListOptions) ([]*models.Label, database.PageInfo, error)
+	listReturns func(context.Context, database.ListOptions) ([]*models.Label, database.PageInfo, error)
+
+	// ListLabelsByOrganizationFunc, if set, is called by ListLabelsByOrganization.
+	ListLabelsByOrganizationFunc func(ctx context.Context, organizationID string) ([]*models.Label, error)
+
+	listLabelsByOrganizationCalls   []LabelRepositoryListLabelsByOrganizationCall
+	listLabelsByOrganizationOnCall  map[int]func(context.Context, string) ([]*models.Label, error)
+	listLabelsByOrganizationReturns func(context.Context, string) ([]*models.Label, error)
+
+	// GetLabelByNameFunc, if set, is called by GetLabelByName.
+	GetLabelByNameFunc func(ctx context.Context, name string, organizationID string) (*models.Label, error)
+
+	getLabelByNameCalls   []LabelRepositoryGetLabelByNameCall
+	getLabelByNameOnCall  map[int]func(context.Context, string, string) (*models.Label, error)
+	getLabelByNameReturns func(context.Context, string, string) (*models.Label, error)
+}
+
+// LabelRepositoryCreateCall holds the arguments of a call to Create.
+type LabelRepositoryCreateCall struct {
+	Ctx    context.Context
+	Entity *models.Label
+}
+
+// Create records the call and returns the configured result.
+func (m *LabelRepository) Create(ctx context.Context, entity *models.Label) (r0 *models.Label, r1 error) {
+	m.mu.Lock()
+	m.createCalls = append(m.createCalls, LabelRepositoryCreateCall{
+		Ctx:    ctx,
+		Entity: entity,
+	})
+	fn := m.createOnCall[len(m.createCalls)-1]
+	if fn == nil {
+		fn = m.CreateFunc
+	}
+	if fn == nil {
+		fn = m.createReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, entity)
+}
+
+// CreateReturns makes calls to Create return the given values.
+func (m *LabelRepository) CreateReturns(r0 *models.Label, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createReturns = func(context.Context, *models.Label) (*models.Label, error) {
+		return r0, r1
+	}
+}
+
+// OnCreateCall makes the call to Create with index i, counting from
+// zero, return the result of fn.
+func (m *LabelRepository) OnCreateCall(i int, fn func(ctx context.Context, entity *models.Label) (*models.Label, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createOnCall == nil {
+		m.createOnCall = make(map[int]func(context.Context, *models.Label) (*models.Label, error))
+	}
+	m.createOnCall[i] = fn
+}
+
+// CreateCalls returns the calls made to Create, in order.
+func (m *LabelRepository) CreateCalls() []LabelRepositoryCreateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.createCalls)
+}
+
+// LabelRepositoryGetCall holds the arguments of a call to Get.
+type LabelRepositoryGetCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Get records the call and returns the configured result.
+func (m *LabelRepository) Get(ctx context.Context, id uuid.UUID) (r0 *models.Label, r1 error) {
+	m.mu.Lock()
+	m.getCalls = append(m.getCalls, LabelRepositoryGetCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.getOnCall[len(m.getCalls)-1]
+	if fn == nil {
+		fn = m.GetFunc
+	}
+	if fn == nil {
+		fn = m.getReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id)
+}
+
+// GetReturns makes calls to Get return the given values.
+func (m *LabelRepository) GetReturns(r0 *models.Label, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getReturns = func(context.Context, uuid.UUID) (*models.Label, error) {
+		return r0, r1
+	}
+}
+
+// OnGetCall makes the call to Get with index i, counting from
+// zero, return the result of fn.
+func (m *LabelRepository) OnGetCall(i int, fn func(ctx context.Context, id uuid.UUID) (*models.Label, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getOnCall == nil {
+		m.getOnCall = make(map[int]func(context.Context, uuid.UUID) (*models.Label, error))
+	}
+	m.getOnCall[i] = fn
+}
+
+// GetCalls returns the calls made to Get, in order.
+func (m *LabelRepository) GetCalls() []LabelRepositoryGetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getCalls)
+}
+
+// LabelRepositoryUpdateCall holds the arguments of a call to Update.
+type LabelRepositoryUpdateCall struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Entity *models.Label
+}
+
+// Update records the call and returns the configured result.
+func (m *LabelRepository) Update(ctx context.Context, id uuid.UUID, entity *models.Label) (r0 *models.Label, r1 error) {
+	m.mu.Lock()
+	m.updateCalls = append(m.updateCalls, LabelRepositoryUpdateCall{
+		Ctx:    ctx,
+		ID:     id,
+		Entity: entity,
+	})
+	fn := m.updateOnCall[len(m.updateCalls)-1]
+	if fn == nil {
+		fn = m.UpdateFunc
+	}
+	if fn == nil {
+		fn = m.updateReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, id, entity)
+}
+
+// UpdateReturns makes calls to Update return the given values.
+func (m *LabelRepository) UpdateReturns(r0 *models.Label, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateReturns = func(context.Context, uuid.UUID, *models.Label) (*models.Label, error) {
+		return r0, r1
+	}
+}
+
+// OnUpdateCall makes the call to Update with index i, counting from
+// zero, return the result of fn.
+func (m *LabelRepository) OnUpdateCall(i int, fn func(ctx context.Context, id uuid.UUID, entity *models.Label) (*models.Label, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updateOnCall == nil {
+		m.updateOnCall = make(map[int]func(context.Context, uuid.UUID, *models.Label) (*models.Label, error))
+	}
+	m.updateOnCall[i] = fn
+}
+
+// UpdateCalls returns the calls made to Update, in order.
+func (m *LabelRepository) UpdateCalls() []LabelRepositoryUpdateCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.updateCalls)
+}
+
+// LabelRepositoryDeleteCall holds the arguments of a call to Delete.
+type LabelRepositoryDeleteCall struct {
+	Ctx context.Context
+	ID  uuid.UUID
+}
+
+// Delete records the call and returns the configured result.
+func (m *LabelRepository) Delete(ctx context.Context, id uuid.UUID) (r0 error) {
+	m.mu.Lock()
+	m.deleteCalls = append(m.deleteCalls, LabelRepositoryDeleteCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.deleteOnCall[len(m.deleteCalls)-1]
+	if fn == nil {
+		fn = m.DeleteFunc
+	}
+	if fn == nil {
+		fn = m.deleteReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0
+	}
+	return fn(ctx, id)
+}
+
+// DeleteReturns makes calls to Delete return the given values.
+func (m *LabelRepository) DeleteReturns(r0 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteReturns = func(context.Context, uuid.UUID) error {
+		return r0
+	}
+}
+
+// OnDeleteCall makes the call to Delete with index i, counting from
+// zero, return the result of fn.
+func (m *LabelRepository) OnDeleteCall(i int, fn func(ctx context.Context, id uuid.UUID) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteOnCall == nil {
+		m.deleteOnCall = make(map[int]func(context.Context, uuid.UUID) error)
+	}
+	m.deleteOnCall[i] = fn
+}
+
+// DeleteCalls returns the calls made to Delete, in order.
+func (m *LabelRepository) DeleteCalls() []LabelRepositoryDeleteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deleteCalls)
+}
+
+// LabelRepositoryListCall holds the arguments of a call to List.
+type LabelRepositoryListCall struct {
+	Ctx  context.Context
+	Opts database.ListOptions
+}
+
+// List records the call and returns the configured result.
+func (m *LabelRepository) List(ctx context.Context, opts database.ListOptions) (r0 []*models.Label, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	m.listCalls = append(m.listCalls, LabelRepositoryListCall{
+		Ctx:  ctx,
+		Opts: opts,
+	})
+	fn := m.listOnCall[len(m.listCalls)-1]
+	if fn == nil {
+		fn = m.ListFunc
+	}
+	if fn == nil {
+		fn = m.listReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1, r2
+	}
+	return fn(ctx, opts)
+}
+
+// ListReturns makes calls to List return the given values.
+func (m *LabelRepository) ListReturns(r0 []*models.Label, r1 database.PageInfo, r2 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listReturns = func(context.Context, database.ListOptions) ([]*models.Label, database.PageInfo, error) {
+		return r0, r1, r2
+	}
+}
+
+// OnListCall makes the call to List with index i, counting from
+// zero, return the result of fn.
+func (m *LabelRepository) OnListCall(i int, fn func(ctx context.Context, opts database.ListOptions) ([]*models.Label, database.PageInfo, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listOnCall == nil {
+		m.listOnCall = make(map[int]func(context.Context, database.ListOptions) ([]*models.Label, database.PageInfo, error))
+	}
+	m.listOnCall[i] = fn
+}
+
+// ListCalls returns the calls made to List, in order.
+func (m *LabelRepository) ListCalls() []LabelRepositoryListCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listCalls)
+}
+
+// LabelRepositoryListLabelsByOrganizationCall holds the arguments of a call to ListLabelsByOrganization.
+type LabelRepositoryListLabelsByOrganizationCall struct {
+	Ctx            context.Context
+	OrganizationID string
+}
+
+// ListLabelsByOrganization records the call and returns the configured result.
+func (m *LabelRepository) ListLabelsByOrganization(ctx context.Context, organizationID string) (r0 []*models.Label, r1 error) {
+	m.mu.Lock()
+	m.listLabelsByOrganizationCalls = append(m.listLabelsByOrganizationCalls, LabelRepositoryListLabelsByOrganizationCall{
+		Ctx:            ctx,
+		OrganizationID: organizationID,
+	})
+	fn := m.listLabelsByOrganizationOnCall[len(m.listLabelsByOrganizationCalls)-1]
+	if fn == nil {
+		fn = m.ListLabelsByOrganizationFunc
+	}
+	if fn == nil {
+		fn = m.listLabelsByOrganizationReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, organizationID)
+}
+
+// ListLabelsByOrganizationReturns makes calls to ListLabelsByOrganization return the given values.
+func (m *LabelRepository) ListLabelsByOrganizationReturns(r0 []*models.Label, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listLabelsByOrganizationReturns = func(context.Context, string) ([]*models.Label, error) {
+		return r0, r1
+	}
+}
+
+// OnListLabelsByOrganizationCall makes the call to ListLabelsByOrganization with index i, counting from
+// zero, return the result of fn.
+func (m *LabelRepository) OnListLabelsByOrganizationCall(i int, fn func(ctx context.Context, organizationID string) ([]*models.Label, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listLabelsByOrganizationOnCall == nil {
+		m.listLabelsByOrganizationOnCall = make(map[int]func(context.Context, string) ([]*models.Label, error))
+	}
+	m.listLabelsByOrganizationOnCall[i] = fn
+}
+
+// ListLabelsByOrganizationCalls returns the calls made to ListLabelsByOrganization, in order.
+func (m *LabelRepository) ListLabelsByOrganizationCalls() []LabelRepositoryListLabelsByOrganizationCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.listLabelsByOrganizationCalls)
+}
+
+// LabelRepositoryGetLabelByNameCall holds the arguments of a call to GetLabelByName.
+type LabelRepositoryGetLabelByNameCall struct {
+	Ctx            context.Context
+	Name           string
+	OrganizationID string
+}
+
+// GetLabelByName records the call and returns the configured result.
+func (m *LabelRepository) GetLabelByName(ctx context.Context, name string, organizationID string) (r0 *models.Label, r1 error) {
+	m.mu.Lock()
+	m.getLabelByNameCalls = append(m.getLabelByNameCalls, LabelRepositoryGetLabelByNameCall{
+		Ctx:            ctx,
+		Name:           name,
+		OrganizationID: organizationID,
+	})
+	fn := m.getLabelByNameOnCall[len(m.getLabelByNameCalls)-1]
+	if fn == nil {
+		fn = m.GetLabelByNameFunc
+	}
+	if fn == nil {
+		fn = m.getLabelByNameReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, name, organizationID)
+}
+
+// GetLabelByNameReturns makes calls to GetLabelByName return the given values.
+func (m *LabelRepository) GetLabelByNameReturns(r0 *models.Label, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getLabelByNameReturns = func(context.Context, string, string) (*models.Label, error) {
+		return r0, r1
+	}
+}
+
+// OnGetLabelByNameCall makes the call to GetLabelByName with index i, counting from
+// zero, return the result of fn.
+func (m *LabelRepository) OnGetLabelByNameCall(i int, fn func(ctx context.Context, name string, organizationID string) (*models.Label, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getLabelByNameOnCall == nil {
+		m.getLabelByNameOnCall = make(map[int]func(context.Context, string, string) (*models.Label, error))
+	}
+	m.getLabelByNameOnCall[i] = fn
+}
+
+// GetLabelByNameCalls returns the calls made to GetLabelByName, in order.
+func (m *LabelRepository) GetLabelByNameCalls() []LabelRepositoryGetLabelByNameCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.getLabelByNameCalls)
+}
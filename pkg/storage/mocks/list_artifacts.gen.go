@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/storage/handlers"
+)
+
+var _ handlers.ListArtifacts = (*ListArtifacts)(nil)
+
+// ListArtifacts is a mock of handlers.ListArtifacts that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type ListArtifacts struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.ListArtifactsInput) (*handlers.ListArtifactsOutput, error)
+
+	executeCalls   []ListArtifactsExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.ListArtifactsInput) (*handlers.ListArtifactsOutput, error)
+	executeReturns func(context.Context, *handlers.ListArtifactsInput) (*handlers.ListArtifactsOutput, error)
+}
+
+// ListArtifactsExecuteCall holds the arguments of a call to Execute.
+type ListArtifactsExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.ListArtifactsInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *ListArtifacts) Execute(ctx context.Context, input *handlers.ListArtifactsInput) (r0 *handlers.ListArtifactsOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, ListArtifactsExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *ListArtifacts) ExecuteReturns(r0 *handlers.ListArtifactsOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.ListArtifactsInput) (*handlers.ListArtifactsOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *ListArtifacts) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.ListArtifactsInput) (*handlers.ListArtifactsOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.ListArtifactsInput) (*handlers.ListArtifactsOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *ListArtifacts) ExecuteCalls() []ListArtifactsExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/storage/handlers"
+)
+
+var _ handlers.ListLabels = (*ListLabels)(nil)
+
+// ListLabels is a mock of handlers.ListLabels that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type ListLabels struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.ListLabelsInput) (*handlers.ListLabelsOutput, error)
+
+	executeCalls   []ListLabelsExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.ListLabelsInput) (*handlers.ListLabelsOutput, error)
+	executeReturns func(context.Context, *handlers.ListLabelsInput) (*handlers.ListLabelsOutput, error)
+}
+
+// ListLabelsExecuteCall holds the arguments of a call to Execute.
+type ListLabelsExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.ListLabelsInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *ListLabels) Execute(ctx context.Context, input *handlers.ListLabelsInput) (r0 *handlers.ListLabelsOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, ListLabelsExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *ListLabels) ExecuteReturns(r0 *handlers.ListLabelsOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.ListLabelsInput) (*handlers.ListLabelsOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *ListLabels) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.ListLabelsInput) (*handlers.ListLabelsOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.ListLabelsInput) (*handlers.ListLabelsOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *ListLabels) ExecuteCalls() []ListLabelsExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
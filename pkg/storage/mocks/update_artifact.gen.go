@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/storage/handlers"
+)
+
+var _ handlers.UpdateArtifact = (*UpdateArtifact)(nil)
+
+// UpdateArtifact is a mock of handlers.UpdateArtifact that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type UpdateArtifact struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.UpdateArtifactInput) (*handlers.UpdateArtifactOutput, error)
+
+	executeCalls   []UpdateArtifactExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.UpdateArtifactInput) (*handlers.UpdateArtifactOutput, error)
+	executeReturns func(context.Context, *handlers.UpdateArtifactInput) (*handlers.UpdateArtifactOutput, error)
+}
+
+// UpdateArtifactExecuteCall holds the arguments of a call to Execute.
+type UpdateArtifactExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.UpdateArtifactInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *UpdateArtifact) Execute(ctx context.Context, input *handlers.UpdateArtifactInput) (r0 *handlers.UpdateArtifactOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, UpdateArtifactExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *UpdateArtifact) ExecuteReturns(r0 *handlers.UpdateArtifactOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.UpdateArtifactInput) (*handlers.UpdateArtifactOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *UpdateArtifact) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.UpdateArtifactInput) (*handlers.UpdateArtifactOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.UpdateArtifactInput) (*handlers.UpdateArtifactOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *UpdateArtifact) ExecuteCalls() []UpdateArtifactExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
@@ -0,0 +1,83 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/archesai/archesai/pkg/storage/handlers"
+)
+
+var _ handlers.UpdateLabel = (*UpdateLabel)(nil)
+
+// UpdateLabel is a mock of handlers.UpdateLabel that records its calls.
+// A call to a method M returns the result of the func set with OnMCall for
+// the index of the call, else of MFunc, else the values set with MReturns,
+// else zero values. The zero value is ready to use.
+type UpdateLabel struct {
+	mu sync.Mutex
+
+	// ExecuteFunc, if set, is called by Execute.
+	ExecuteFunc func(ctx context.Context, input *handlers.UpdateLabelInput) (*handlers.UpdateLabelOutput, error)
+
+	executeCalls   []UpdateLabelExecuteCall
+	executeOnCall  map[int]func(context.Context, *handlers.UpdateLabelInput) (*handlers.UpdateLabelOutput, error)
+	executeReturns func(context.Context, *handlers.UpdateLabelInput) (*handlers.UpdateLabelOutput, error)
+}
+
+// UpdateLabelExecuteCall holds the arguments of a call to Execute.
+type UpdateLabelExecuteCall struct {
+	Ctx   context.Context
+	Input *handlers.UpdateLabelInput
+}
+
+// Execute records the call and returns the configured result.
+func (m *UpdateLabel) Execute(ctx context.Context, input *handlers.UpdateLabelInput) (r0 *handlers.UpdateLabelOutput, r1 error) {
+	m.mu.Lock()
+	m.executeCalls = append(m.executeCalls, UpdateLabelExecuteCall{
+		Ctx:   ctx,
+		Input: input,
+	})
+	fn := m.executeOnCall[len(m.executeCalls)-1]
+	if fn == nil {
+		fn = m.ExecuteFunc
+	}
+	if fn == nil {
+		fn = m.executeReturns
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		return r0, r1
+	}
+	return fn(ctx, input)
+}
+
+// ExecuteReturns makes calls to Execute return the given values.
+func (m *UpdateLabel) ExecuteReturns(r0 *handlers.UpdateLabelOutput, r1 error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executeReturns = func(context.Context, *handlers.UpdateLabelInput) (*handlers.UpdateLabelOutput, error) {
+		return r0, r1
+	}
+}
+
+// OnExecuteCall makes the call to Execute with index i, counting from
+// zero, return the result of fn.
+func (m *UpdateLabel) OnExecuteCall(i int, fn func(ctx context.Context, input *handlers.UpdateLabelInput) (*handlers.UpdateLabelOutput, error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.executeOnCall == nil {
+		m.executeOnCall = make(map[int]func(context.Context, *handlers.UpdateLabelInput) (*handlers.UpdateLabelOutput, error))
+	}
+	m.executeOnCall[i] = fn
+}
+
+// ExecuteCalls returns the calls made to Execute, in order.
+func (m *UpdateLabel) ExecuteCalls() []UpdateLabelExecuteCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.executeCalls)
+}
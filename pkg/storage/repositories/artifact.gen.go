@@ -4,7 +4,9 @@ package repositories
 
 import (
 	"context"
+	"time"
 
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/google/uuid"
 )
@@ -16,7 +18,17 @@ type ArtifactRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Artifact, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Artifact) (*models.Artifact, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Artifact, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error)
+
+	// Soft delete operations
+	Restore(ctx context.Context, id uuid.UUID) error
+	ListDeleted(ctx context.Context, opts database.ListOptions) ([]*models.Artifact, database.PageInfo, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
+
+	// Labels relation
+	AddLabel(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error
+	RemoveLabel(ctx context.Context, id uuid.UUID, labelID uuid.UUID) error
+	ListLabels(ctx context.Context, id uuid.UUID) ([]*models.Label, error)
 
 	// ListArtifactsByOrganization retrieves multiple artifacts by organizationID
 	ListArtifactsByOrganization(ctx context.Context, organizationID string) ([]*models.Artifact, error)
@@ -5,6 +5,7 @@ package repositories
 import (
 	"context"
 
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/storage/models"
 	"github.com/google/uuid"
 )
@@ -16,7 +17,7 @@ type LabelRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*models.Label, error)
 	Update(ctx context.Context, id uuid.UUID, entity *models.Label) (*models.Label, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*models.Label, int64, error)
+	List(ctx context.Context, opts database.ListOptions) ([]*models.Label, database.PageInfo, error)
 
 	// ListLabelsByOrganization retrieves multiple labels by organizationID
 	ListLabelsByOrganization(ctx context.Context, organizationID string) ([]*models.Label, error)
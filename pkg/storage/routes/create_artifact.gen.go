@@ -4,9 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 	"github.com/archesai/archesai/pkg/storage/handlers"
 	"github.com/archesai/archesai/pkg/storage/models"
@@ -19,11 +25,15 @@ import (
 // CreateArtifactHandler is the HTTP handler for CreateArtifact.
 type CreateArtifactHandler struct {
 	createArtifact handlers.CreateArtifact
+	authorizer     auth.Authorizer
 }
 
 // NewCreateArtifactHandler creates a new HTTP handler.
-func NewCreateArtifactHandler(createArtifact handlers.CreateArtifact) *CreateArtifactHandler {
-	return &CreateArtifactHandler{createArtifact: createArtifact}
+func NewCreateArtifactHandler(createArtifact handlers.CreateArtifact, authorizer auth.Authorizer) *CreateArtifactHandler {
+	return &CreateArtifactHandler{
+		createArtifact: createArtifact,
+		authorizer:     authorizer,
+	}
 }
 
 // RegisterCreateArtifactRoute registers the HTTP route for CreateArtifact.
@@ -75,6 +85,16 @@ func (response CreateArtifact401Response) VisitCreateArtifactResponse(w http.Res
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type CreateArtifact403Response struct {
+	server.ProblemDetails
+}
+
+func (response CreateArtifact403Response) VisitCreateArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type CreateArtifact422Response struct {
 	server.ProblemDetails
 }
@@ -109,12 +129,50 @@ func (response CreateArtifact500Response) VisitCreateArtifactResponse(w http.Res
 func (h *CreateArtifactHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := CreateArtifact401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitCreateArtifactResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.CreateArtifactInput{}
+	input.SessionID = sessionID
+
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "artifacts:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
 
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := CreateArtifact400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitCreateArtifactResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &CreateArtifactRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := CreateArtifact400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -123,11 +181,37 @@ func (h *CreateArtifactHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	v.RequireFields(data, "", "text")
+	if body.Name != nil {
+		v.MinLength("/name", *body.Name, 1)
+		v.MaxLength("/name", *body.Name, 255)
+		v.Pattern("/name", *body.Name, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	v.MinLength("/text", body.Text, 1)
+	v.MaxLength("/text", body.Text, 1000)
+	v.Pattern("/text", body.Text, `^[\w\s\-.,!?()@#+/]*$`)
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Name = body.Name
 	input.Text = body.Text
 
 	// Execute
 	result, err := h.createArtifact.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := CreateArtifact500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
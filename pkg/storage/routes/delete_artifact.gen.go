@@ -4,14 +4,18 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 	"github.com/archesai/archesai/pkg/storage/handlers"
+	"github.com/archesai/archesai/pkg/storage/models"
 )
 
 // ============================================================================
@@ -21,11 +25,15 @@ import (
 // DeleteArtifactHandler is the HTTP handler for DeleteArtifact.
 type DeleteArtifactHandler struct {
 	deleteArtifact handlers.DeleteArtifact
+	authorizer     auth.Authorizer
 }
 
 // NewDeleteArtifactHandler creates a new HTTP handler.
-func NewDeleteArtifactHandler(deleteArtifact handlers.DeleteArtifact) *DeleteArtifactHandler {
-	return &DeleteArtifactHandler{deleteArtifact: deleteArtifact}
+func NewDeleteArtifactHandler(deleteArtifact handlers.DeleteArtifact, authorizer auth.Authorizer) *DeleteArtifactHandler {
+	return &DeleteArtifactHandler{
+		deleteArtifact: deleteArtifact,
+		authorizer:     authorizer,
+	}
 }
 
 // RegisterDeleteArtifactRoute registers the HTTP route for DeleteArtifact.
@@ -69,6 +77,16 @@ func (response DeleteArtifact401Response) VisitDeleteArtifactResponse(w http.Res
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type DeleteArtifact403Response struct {
+	server.ProblemDetails
+}
+
+func (response DeleteArtifact403Response) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type DeleteArtifact404Response struct {
 	server.ProblemDetails
 }
@@ -113,8 +131,22 @@ func (response DeleteArtifact500Response) VisitDeleteArtifactResponse(w http.Res
 func (h *DeleteArtifactHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := DeleteArtifact401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitDeleteArtifactResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.DeleteArtifactInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -129,8 +161,36 @@ func (h *DeleteArtifactHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "artifacts:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	if err := h.deleteArtifact.Execute(ctx, input); err != nil {
+		if errors.Is(err, database.ErrNoTenant) {
+			problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
+		if errors.Is(err, models.ErrArtifactNotFound) {
+			problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+			if err := server.WriteProblem(w, problem); err != nil {
+				fmt.Fprintf(w, "error writing response: %v", err)
+			}
+			return
+		}
 		errorResp := DeleteArtifact500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
 		}
@@ -4,12 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 	"github.com/archesai/archesai/pkg/storage/handlers"
 	"github.com/archesai/archesai/pkg/storage/models"
@@ -22,11 +25,15 @@ import (
 // GetArtifactHandler is the HTTP handler for GetArtifact.
 type GetArtifactHandler struct {
 	getArtifact handlers.GetArtifact
+	authorizer  auth.Authorizer
 }
 
 // NewGetArtifactHandler creates a new HTTP handler.
-func NewGetArtifactHandler(getArtifact handlers.GetArtifact) *GetArtifactHandler {
-	return &GetArtifactHandler{getArtifact: getArtifact}
+func NewGetArtifactHandler(getArtifact handlers.GetArtifact, authorizer auth.Authorizer) *GetArtifactHandler {
+	return &GetArtifactHandler{
+		getArtifact: getArtifact,
+		authorizer:  authorizer,
+	}
 }
 
 // RegisterGetArtifactRoute registers the HTTP route for GetArtifact.
@@ -72,6 +79,16 @@ func (response GetArtifact401Response) VisitGetArtifactResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type GetArtifact403Response struct {
+	server.ProblemDetails
+}
+
+func (response GetArtifact403Response) VisitGetArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type GetArtifact404Response struct {
 	server.ProblemDetails
 }
@@ -116,8 +133,22 @@ func (response GetArtifact500Response) VisitGetArtifactResponse(w http.ResponseW
 func (h *GetArtifactHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := GetArtifact401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitGetArtifactResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.GetArtifactInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -132,8 +163,36 @@ func (h *GetArtifactHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "artifacts:read",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Execute
 	result, err := h.getArtifact.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrArtifactNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := GetArtifact500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
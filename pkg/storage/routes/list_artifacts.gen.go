@@ -4,11 +4,15 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 	servermodels "github.com/archesai/archesai/pkg/server/models"
 	"github.com/archesai/archesai/pkg/storage/handlers"
@@ -22,11 +26,15 @@ import (
 // ListArtifactsHandler is the HTTP handler for ListArtifacts.
 type ListArtifactsHandler struct {
 	listArtifacts handlers.ListArtifacts
+	authorizer    auth.Authorizer
 }
 
 // NewListArtifactsHandler creates a new HTTP handler.
-func NewListArtifactsHandler(listArtifacts handlers.ListArtifacts) *ListArtifactsHandler {
-	return &ListArtifactsHandler{listArtifacts: listArtifacts}
+func NewListArtifactsHandler(listArtifacts handlers.ListArtifacts, authorizer auth.Authorizer) *ListArtifactsHandler {
+	return &ListArtifactsHandler{
+		listArtifacts: listArtifacts,
+		authorizer:    authorizer,
+	}
 }
 
 // RegisterListArtifactsRoute registers the HTTP route for ListArtifacts.
@@ -40,6 +48,7 @@ func RegisterListArtifactsRoute(mux *http.ServeMux, handler *ListArtifactsHandle
 type ListArtifactsParams struct {
 	Filter map[string]any   `json:"filter,omitempty"`
 	Page   map[string]any   `json:"page,omitempty"`
+	Q      *string          `json:"q,omitempty"`
 	Sort   []map[string]any `json:"sort,omitempty"`
 }
 
@@ -50,8 +59,9 @@ type ListArtifactsResponse interface {
 }
 
 type ListArtifacts200Response struct {
-	Data []models.Artifact           `json:"data"`
-	Meta servermodels.PaginationMeta `json:"meta"`
+	Data       []models.Artifact           `json:"data"`
+	Highlights map[string]string           `json:"highlights,omitempty"`
+	Meta       servermodels.PaginationMeta `json:"meta"`
 }
 
 func (response ListArtifacts200Response) VisitListArtifactsResponse(w http.ResponseWriter) error {
@@ -80,6 +90,16 @@ func (response ListArtifacts401Response) VisitListArtifactsResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type ListArtifacts403Response struct {
+	server.ProblemDetails
+}
+
+func (response ListArtifacts403Response) VisitListArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type ListArtifacts422Response struct {
 	server.ProblemDetails
 }
@@ -114,13 +134,42 @@ func (response ListArtifacts500Response) VisitListArtifactsResponse(w http.Respo
 func (h *ListArtifactsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := ListArtifacts401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitListArtifactsResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.ListArtifactsInput{}
+	input.SessionID = sessionID
+
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "artifacts:read",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
 
 	// Query parameters
 
 	// Optional query parameter "filter"
-	if err := runtime.BindQueryParameter("deepObject", true, false, "filter", r.URL.Query(), &input.Filter); err != nil {
+	filter, err := server.BindFilterQuery(r.URL.Query(), "filter")
+	if err != nil {
 		errorResp := ListArtifacts400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter filter: %s", err), r.URL.Path),
 		}
@@ -129,9 +178,11 @@ func (h *ListArtifactsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+	input.Filter = filter
 
 	// Optional query parameter "page"
-	if err := runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &input.Page); err != nil {
+	page, err := server.BindPageQuery(r.URL.Query())
+	if err != nil {
 		errorResp := ListArtifacts400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter page: %s", err), r.URL.Path),
 		}
@@ -140,9 +191,22 @@ func (h *ListArtifactsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+	input.Page = page
+
+	// Optional query parameter "q"
+	if err := runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &input.Q); err != nil {
+		errorResp := ListArtifacts400Response{
+			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter q: %s", err), r.URL.Path),
+		}
+		if err := errorResp.VisitListArtifactsResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 
 	// Optional query parameter "sort"
-	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &input.Sort); err != nil {
+	sort, err := server.BindSortQuery(r.URL.Query(), "sort")
+	if err != nil {
 		errorResp := ListArtifacts400Response{
 			ProblemDetails: server.NewBadRequestResponse(fmt.Sprintf("Invalid format for parameter sort: %s", err), r.URL.Path),
 		}
@@ -151,9 +215,42 @@ func (h *ListArtifactsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+	input.Sort = sort
+
+	// Validate parameters
+	v := server.NewValidator()
+	if input.Q != nil {
+		v.MinLength("/query/q", *input.Q, 1)
+		v.MaxLength("/query/q", *input.Q, 256)
+	}
+	if !v.Valid() {
+		errorResp := ListArtifacts400Response{
+			ProblemDetails: server.NewValidationProblem(http.StatusBadRequest, v, r.URL.Path),
+		}
+		if err := errorResp.VisitListArtifactsResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 
 	// Execute
 	result, err := h.listArtifacts.Execute(ctx, input)
+	if errors.Is(err, database.ErrInvalidListOptions) {
+		errorResp := ListArtifacts400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitListArtifactsResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := ListArtifacts500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -167,6 +264,7 @@ func (h *ListArtifactsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	// Map output to response
 	response := ListArtifacts200Response{}
 	response.Data = result.Data
+	response.Highlights = result.Highlights
 	response.Meta = result.Meta
 
 	if err := response.VisitListArtifactsResponse(w); err != nil {
@@ -4,12 +4,16 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 
+	"github.com/archesai/archesai/pkg/auth"
+	"github.com/archesai/archesai/pkg/database"
 	"github.com/archesai/archesai/pkg/server"
 	"github.com/archesai/archesai/pkg/storage/handlers"
 	"github.com/archesai/archesai/pkg/storage/models"
@@ -22,11 +26,15 @@ import (
 // UpdateArtifactHandler is the HTTP handler for UpdateArtifact.
 type UpdateArtifactHandler struct {
 	updateArtifact handlers.UpdateArtifact
+	authorizer     auth.Authorizer
 }
 
 // NewUpdateArtifactHandler creates a new HTTP handler.
-func NewUpdateArtifactHandler(updateArtifact handlers.UpdateArtifact) *UpdateArtifactHandler {
-	return &UpdateArtifactHandler{updateArtifact: updateArtifact}
+func NewUpdateArtifactHandler(updateArtifact handlers.UpdateArtifact, authorizer auth.Authorizer) *UpdateArtifactHandler {
+	return &UpdateArtifactHandler{
+		updateArtifact: updateArtifact,
+		authorizer:     authorizer,
+	}
 }
 
 // RegisterUpdateArtifactRoute registers the HTTP route for UpdateArtifact.
@@ -79,6 +87,16 @@ func (response UpdateArtifact401Response) VisitUpdateArtifactResponse(w http.Res
 	return json.NewEncoder(w).Encode(response.ProblemDetails)
 }
 
+type UpdateArtifact403Response struct {
+	server.ProblemDetails
+}
+
+func (response UpdateArtifact403Response) VisitUpdateArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+	return json.NewEncoder(w).Encode(response.ProblemDetails)
+}
+
 type UpdateArtifact404Response struct {
 	server.ProblemDetails
 }
@@ -123,8 +141,22 @@ func (response UpdateArtifact500Response) VisitUpdateArtifactResponse(w http.Res
 func (h *UpdateArtifactHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// Require an authenticated caller. API keys authenticate without a
+	// session, which leaves the session ID nil.
+	if _, ok := ctx.Value(server.AuthUserContextKey).(uuid.UUID); !ok {
+		errorResp := UpdateArtifact401Response{
+			ProblemDetails: server.NewUnauthorizedResponse("authentication required", r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateArtifactResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	sessionID, _ := ctx.Value(server.SessionIDContextKey).(uuid.UUID)
+
 	// Build input from request
 	input := &handlers.UpdateArtifactInput{}
+	input.SessionID = sessionID
 
 	// Path parameter "id"
 	var id uuid.UUID
@@ -139,9 +171,33 @@ func (h *UpdateArtifactHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 	input.ID = id
 
+	// Authorize against the caller's organization membership
+	userID, _ := ctx.Value(server.AuthUserContextKey).(uuid.UUID)
+	permissions, err := auth.Authorize(ctx, h.authorizer, userID, auth.Requirement{
+		Permission: "artifacts:write",
+	})
+	if err != nil {
+		problem := server.NewAuthorizationProblem(err, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	ctx = auth.WithPermissions(ctx, permissions)
+
 	// Request body
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResp := UpdateArtifact400Response{
+			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
+		}
+		if err := errorResp.VisitUpdateArtifactResponse(w); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	body := &UpdateArtifactRequestBody{}
-	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+	if err := json.Unmarshal(data, body); err != nil {
 		errorResp := UpdateArtifact400Response{
 			ProblemDetails: server.NewBadRequestResponse(err.Error(), r.URL.Path),
 		}
@@ -150,12 +206,49 @@ func (h *UpdateArtifactHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 		return
 	}
+
+	// Validate request body
+	v := server.NewValidator()
+	if body.Name != nil {
+		v.MinLength("/name", *body.Name, 1)
+		v.MaxLength("/name", *body.Name, 255)
+		v.Pattern("/name", *body.Name, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if body.Text != nil {
+		v.MaxLength("/text", *body.Text, 1000)
+		v.Pattern("/text", *body.Text, `^[\w\s\-.,!?()@#+/]*$`)
+	}
+	if body.URL != nil {
+		v.MaxLength("/url", *body.URL, 2048)
+		v.Format("/url", *body.URL, "uri")
+	}
+	if !v.Valid() {
+		problem := server.NewValidationProblem(http.StatusUnprocessableEntity, v, r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	input.Name = body.Name
 	input.Text = body.Text
 	input.URL = body.URL
 
 	// Execute
 	result, err := h.updateArtifact.Execute(ctx, input)
+	if errors.Is(err, database.ErrNoTenant) {
+		problem := server.NewForbiddenResponse("No organization selected", r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
+	if errors.Is(err, models.ErrArtifactNotFound) {
+		problem := server.NewNotFoundResponse(err.Error(), r.URL.Path)
+		if err := server.WriteProblem(w, problem); err != nil {
+			fmt.Fprintf(w, "error writing response: %v", err)
+		}
+		return
+	}
 	if err != nil {
 		errorResp := UpdateArtifact500Response{
 			ProblemDetails: server.NewInternalServerErrorResponse(err.Error(), r.URL.Path),
@@ -0,0 +1,41 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned when an endpoint resolves to an address the
+// dispatcher does not post to.
+var ErrForbiddenAddress = errors.New("endpoint address is not allowed")
+
+// IsPublicAddress reports whether deliveries may be posted to addr. Loopback,
+// private, link-local, multicast and unspecified addresses are refused, so
+// endpoints cannot reach the services next to the app or cloud metadata.
+func IsPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate()
+}
+
+// guardedDialer returns a dialer that refuses connections to addresses that
+// are not public. The check runs on the resolved address of every connection,
+// so hostnames that resolve to internal addresses are refused as well.
+func guardedDialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+			}
+			if !IsPublicAddress(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+}
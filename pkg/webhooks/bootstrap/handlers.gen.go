@@ -0,0 +1,37 @@
+// Code generated by archesai. DO NOT EDIT.
+
+package bootstrap
+
+import (
+	"github.com/archesai/archesai/pkg/webhooks/handlers"
+	"github.com/archesai/archesai/pkg/webhooks/repositories"
+)
+
+// ApplicationHandlers holds all application-layer handlers for this package.
+type ApplicationHandlers struct {
+	CreateWebhookEndpoint    handlers.CreateWebhookEndpoint
+	DeleteWebhookEndpoint    handlers.DeleteWebhookEndpoint
+	GetWebhookDelivery       handlers.GetWebhookDelivery
+	GetWebhookEndpoint       handlers.GetWebhookEndpoint
+	ListWebhookDeliveries    handlers.ListWebhookDeliveries
+	ListWebhookEndpoints     handlers.ListWebhookEndpoints
+	RedeliverWebhookDelivery handlers.RedeliverWebhookDelivery
+	UpdateWebhookEndpoint    handlers.UpdateWebhookEndpoint
+}
+
+// NewApplicationHandlers creates all application handlers with proper dependency injection.
+func NewApplicationHandlers(
+	webhookDeliveryRepo repositories.WebhookDeliveryRepository,
+	webhookEndpointRepo repositories.WebhookEndpointRepository,
+) *ApplicationHandlers {
+	return &ApplicationHandlers{
+		CreateWebhookEndpoint:    handlers.NewCreateWebhookEndpoint(),
+		DeleteWebhookEndpoint:    handlers.NewDeleteWebhookEndpoint(webhookEndpointRepo),
+		GetWebhookDelivery:       handlers.NewGetWebhookDelivery(webhookDeliveryRepo),
+		GetWebhookEndpoint:       handlers.NewGetWebhookEndpoint(webhookEndpointRepo),
+		ListWebhookDeliveries:    handlers.NewListWebhookDeliveries(webhookDeliveryRepo),
+		ListWebhookEndpoints:     handlers.NewListWebhookEndpoints(webhookEndpointRepo),
+		RedeliverWebhookDelivery: handlers.NewRedeliverWebhookDelivery(),
+		UpdateWebhookEndpoint:    handlers.NewUpdateWebhookEndpoint(),
+	}
+}
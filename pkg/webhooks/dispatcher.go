@@ -0,0 +1,493 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/webhooks/models"
+	"github.com/archesai/archesai/pkg/webhooks/repositories"
+)
+
+// maxErrorLength is the longest error recorded on a delivery, matching the
+// lastError column.
+const maxErrorLength = 1000
+
+// Config tunes delivery retries and endpoint disabling.
+type Config struct {
+	// MaxAttempts is the number of attempts made before a delivery fails.
+	MaxAttempts int32
+	// InitialBackoff is the wait before the first retry. Each later retry
+	// waits twice as long as the one before, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// DisableAfter is the number of consecutive failed deliveries after which
+	// an endpoint is disabled. Zero never disables endpoints.
+	DisableAfter int32
+	// Timeout bounds each request to an endpoint.
+	Timeout time.Duration
+	// AllowPrivateAddresses lets endpoints resolve to loopback, private and
+	// link-local addresses. Only enable it when every organization is
+	// trusted, such as in development.
+	AllowPrivateAddresses bool
+}
+
+// DefaultConfig returns a configuration that retries a delivery for about an
+// hour before giving up.
+func DefaultConfig() Config {
+	return Config{
+		MaxAttempts:    8,
+		InitialBackoff: 30 * time.Second,
+		MaxBackoff:     time.Hour,
+		DisableAfter:   5,
+		Timeout:        10 * time.Second,
+	}
+}
+
+// Backoff returns the wait before the attempt after the given number of
+// attempts.
+func (c Config) Backoff(attempts int32) time.Duration {
+	delay := c.InitialBackoff
+	for i := int32(1); i < attempts && delay < c.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, c.MaxBackoff)
+}
+
+// Dispatcher delivers domain events to the webhook endpoints of the
+// organization they were published in. It records a delivery for every
+// matching enabled endpoint, posts the signed event and retries failed
+// attempts with exponential backoff.
+//
+// Retries are scheduled in memory. When the dispatcher starts it resumes the
+// deliveries left pending, each at its next attempt.
+//
+// Unless AllowPrivateAddresses is set, endpoints that resolve to an address
+// that is not public fail without being retried.
+type Dispatcher struct {
+	endpoints  repositories.WebhookEndpointRepository
+	deliveries repositories.WebhookDeliveryRepository
+	pending    PendingLister
+	client     *http.Client
+	config     Config
+
+	wg sync.WaitGroup
+	// scheduled holds the deliveries with an attempt goroutine, so a
+	// redelivery of a pending delivery does not post it twice
+	mu        sync.Mutex
+	scheduled map[uuid.UUID]struct{}
+	// endpointMu serializes failure counting on endpoints
+	endpointMu sync.Mutex
+}
+
+// NewDispatcher creates a dispatcher backed by the given repositories. The
+// pending lister may be nil, in which case no deliveries are resumed.
+func NewDispatcher(
+	endpoints repositories.WebhookEndpointRepository,
+	deliveries repositories.WebhookDeliveryRepository,
+	pending PendingLister,
+	config Config,
+) *Dispatcher {
+	client := &http.Client{
+		Timeout: config.Timeout,
+		// A redirect is a failed attempt; the endpoint URL should be updated
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	if !config.AllowPrivateAddresses {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		// A proxy would be dialed in place of the endpoint, hiding its address
+		transport.Proxy = nil
+		transport.DialContext = guardedDialer(config.Timeout).DialContext
+		client.Transport = transport
+	}
+
+	return &Dispatcher{
+		endpoints:  endpoints,
+		deliveries: deliveries,
+		pending:    pending,
+		client:     client,
+		config:     config,
+		scheduled:  make(map[uuid.UUID]struct{}),
+	}
+}
+
+// Run resumes the pending deliveries, then delivers the events received from
+// the subscriber until the context is done and waits for in-flight attempts
+// to return.
+func (d *Dispatcher) Run(ctx context.Context, subscriber events.Subscriber) error {
+	if err := d.Resume(ctx); err != nil {
+		slog.Error("Failed to resume webhook deliveries", slog.Any("error", err))
+	}
+	err := subscriber.Subscribe(ctx, "", func(event events.Event) error {
+		return d.Dispatch(ctx, event)
+	})
+	d.wg.Wait()
+	return err
+}
+
+// Dispatch records a delivery of the event for every matching enabled
+// endpoint and schedules its first attempt. Redelivery requests schedule the
+// requested delivery instead. Events published outside an organization, and
+// those of endpoints and deliveries, are ignored.
+func (d *Dispatcher) Dispatch(ctx context.Context, event events.Event) error {
+	msg, err := events.NewMessage(ctx, event)
+	if err != nil {
+		return err
+	}
+	if msg.OrganizationID == uuid.Nil {
+		return nil
+	}
+	ctx = database.WithTenant(ctx, msg.OrganizationID)
+
+	if msg.Type == EventRedeliveryRequested {
+		var requested RedeliveryRequestedEvent
+		if err := json.Unmarshal(msg.Data, &requested); err != nil {
+			return fmt.Errorf("failed to decode redelivery request: %w", err)
+		}
+		d.schedule(ctx, requested.WebhookDeliveryID, 0)
+		return nil
+	}
+
+	// The dispatcher writes endpoints and deliveries as it delivers, so their
+	// own events would be delivered without end
+	if msg.Domain == "webhookendpoint" || msg.Domain == "webhookdelivery" {
+		return nil
+	}
+
+	endpoints, err := d.matchingEndpoints(ctx, msg.Type)
+	if err != nil {
+		return err
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	eventID, err := uuid.Parse(msg.ID)
+	if err != nil {
+		eventID = uuid.New()
+	}
+
+	now := time.Now().UTC()
+	for _, endpoint := range endpoints {
+		delivery, err := models.NewWebhookDelivery(
+			0,
+			endpoint.ID,
+			eventID,
+			msg.Type,
+			nil,
+			nil,
+			&now,
+			msg.OrganizationID,
+			string(payload),
+			nil,
+			models.WebhookDeliveryStatusPending,
+		)
+		if err != nil {
+			return err
+		}
+		created, err := d.deliveries.Create(ctx, delivery)
+		if err != nil {
+			return fmt.Errorf("failed to create webhook delivery: %w", err)
+		}
+		d.schedule(ctx, created.ID, 0)
+	}
+	return nil
+}
+
+// Resume schedules every pending delivery for its next attempt, or at once if
+// it is overdue.
+func (d *Dispatcher) Resume(ctx context.Context) error {
+	if d.pending == nil {
+		return nil
+	}
+	pending, err := d.pending.ListPending(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, p := range pending {
+		tenantCtx := database.WithTenant(ctx, p.OrganizationID)
+		delivery, err := d.deliveries.Get(tenantCtx, p.ID)
+		if err != nil {
+			if errors.Is(err, models.ErrWebhookDeliveryNotFound) {
+				continue
+			}
+			return fmt.Errorf("failed to get webhook delivery: %w", err)
+		}
+		var delay time.Duration
+		if delivery.NextAttemptAt != nil {
+			delay = max(delivery.NextAttemptAt.Sub(now), 0)
+		}
+		d.schedule(tenantCtx, delivery.ID, delay)
+	}
+	if len(pending) > 0 {
+		slog.Info("Resumed webhook deliveries", slog.Int("count", len(pending)))
+	}
+	return nil
+}
+
+// matchingEndpoints returns the enabled endpoints of the tenant subscribed to
+// the event type.
+func (d *Dispatcher) matchingEndpoints(
+	ctx context.Context,
+	eventType string,
+) ([]*models.WebhookEndpoint, error) {
+	opts := database.ListOptions{
+		Filter: &database.Filter{Type: database.FilterEq, Field: "enabled", Value: true},
+		Page:   database.Page{Limit: database.MaxPageLimit},
+	}
+
+	var matched []*models.WebhookEndpoint
+	for {
+		endpoints, page, err := d.endpoints.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+		}
+		for _, endpoint := range endpoints {
+			if MatchesEventType(endpoint.EventTypes, eventType) {
+				matched = append(matched, endpoint)
+			}
+		}
+		opts.Page.Offset += int32(len(endpoints))
+		if len(endpoints) == 0 || int64(opts.Page.Offset) >= page.Total {
+			return matched, nil
+		}
+	}
+}
+
+// MatchesEventType reports whether an event type is selected by the filters
+// of an endpoint. A filter is an event type, a domain wildcard such as "run.*"
+// or "*" for every event.
+func MatchesEventType(filters []string, eventType string) bool {
+	for _, filter := range filters {
+		if filter == "*" || filter == eventType {
+			return true
+		}
+		if domain, ok := strings.CutSuffix(filter, ".*"); ok &&
+			strings.HasPrefix(eventType, domain+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// schedule attempts the delivery after the delay, and retries it until it
+// succeeds, fails or the context is done. Deliveries already scheduled are
+// left to their goroutine.
+func (d *Dispatcher) schedule(ctx context.Context, deliveryID uuid.UUID, delay time.Duration) {
+	d.mu.Lock()
+	if _, ok := d.scheduled[deliveryID]; ok {
+		d.mu.Unlock()
+		return
+	}
+	d.scheduled[deliveryID] = struct{}{}
+	d.mu.Unlock()
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer func() {
+			d.mu.Lock()
+			delete(d.scheduled, deliveryID)
+			d.mu.Unlock()
+		}()
+
+		for {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			next, retry := d.attempt(ctx, deliveryID)
+			if !retry {
+				return
+			}
+			delay = next
+		}
+	}()
+}
+
+// attempt posts a pending delivery once and records the outcome. It returns
+// the wait before the next attempt, if there is one.
+func (d *Dispatcher) attempt(ctx context.Context, deliveryID uuid.UUID) (time.Duration, bool) {
+	delivery, err := d.deliveries.Get(ctx, deliveryID)
+	if err != nil {
+		if !errors.Is(err, models.ErrWebhookDeliveryNotFound) {
+			slog.Error("Failed to get webhook delivery",
+				slog.String("delivery", deliveryID.String()),
+				slog.Any("error", err),
+			)
+		}
+		return 0, false
+	}
+	if delivery.Status != models.WebhookDeliveryStatusPending {
+		return 0, false
+	}
+
+	endpoint, err := d.endpoints.Get(ctx, delivery.EndpointID)
+	if err != nil {
+		// Deleting an endpoint deletes its deliveries
+		return 0, false
+	}
+
+	now := time.Now().UTC()
+	var status int32
+	if endpoint.Enabled {
+		status, err = d.post(ctx, endpoint, delivery, now)
+	} else {
+		err = errors.New("endpoint is disabled")
+	}
+	if ctx.Err() != nil {
+		// Shutting down; the attempt did not complete
+		return 0, false
+	}
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.UpdatedAt = now
+	delivery.ResponseStatus = nil
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+	delivery.LastError = nil
+	delivery.NextAttemptAt = nil
+
+	var delay time.Duration
+	switch {
+	case err == nil:
+		delivery.Status = models.WebhookDeliveryStatusSucceeded
+	case endpoint.Enabled && delivery.Attempts < d.config.MaxAttempts &&
+		!errors.Is(err, ErrForbiddenAddress):
+		message := truncate(err.Error(), maxErrorLength)
+		delivery.LastError = &message
+		delay = d.config.Backoff(delivery.Attempts)
+		next := now.Add(delay)
+		delivery.NextAttemptAt = &next
+	default:
+		message := truncate(err.Error(), maxErrorLength)
+		delivery.LastError = &message
+		delivery.Status = models.WebhookDeliveryStatusFailed
+	}
+
+	if _, err := d.deliveries.Update(ctx, delivery.ID, delivery); err != nil {
+		slog.Error("Failed to update webhook delivery",
+			slog.String("delivery", delivery.ID.String()),
+			slog.Any("error", err),
+		)
+		return 0, false
+	}
+
+	switch delivery.Status {
+	case models.WebhookDeliveryStatusSucceeded:
+		d.recordOutcome(ctx, endpoint.ID, true)
+	case models.WebhookDeliveryStatusFailed:
+		if endpoint.Enabled {
+			d.recordOutcome(ctx, endpoint.ID, false)
+		}
+	default:
+		return delay, true
+	}
+	return 0, false
+}
+
+// post sends the delivery to the endpoint and returns the response status.
+// Any status other than 2xx is an error.
+func (d *Dispatcher) post(
+	ctx context.Context,
+	endpoint *models.WebhookEndpoint,
+	delivery *models.WebhookDelivery,
+	now time.Time,
+) (int32, error) {
+	payload := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("invalid endpoint URL: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Arches-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderSignature, Sign(endpoint.Secret, now, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	// Drain a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	status := int32(resp.StatusCode)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return status, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return status, nil
+}
+
+// recordOutcome resets the failure count of an endpoint after a successful
+// delivery, or counts a failed one and disables the endpoint once
+// DisableAfter deliveries in a row have failed.
+func (d *Dispatcher) recordOutcome(ctx context.Context, endpointID uuid.UUID, succeeded bool) {
+	d.endpointMu.Lock()
+	defer d.endpointMu.Unlock()
+
+	endpoint, err := d.endpoints.Get(ctx, endpointID)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	if succeeded {
+		if endpoint.FailureCount == 0 {
+			return
+		}
+		endpoint.FailureCount = 0
+	} else {
+		endpoint.FailureCount++
+		if d.config.DisableAfter > 0 && endpoint.FailureCount >= d.config.DisableAfter && endpoint.Enabled {
+			endpoint.Enabled = false
+			endpoint.DisabledAt = &now
+			slog.Warn("Disabled webhook endpoint after repeated failures",
+				slog.String("endpoint", endpoint.ID.String()),
+				slog.Int("failures", int(endpoint.FailureCount)),
+			)
+		}
+	}
+	endpoint.UpdatedAt = now
+
+	if _, err := d.endpoints.Update(ctx, endpoint.ID, endpoint); err != nil {
+		slog.Error("Failed to update webhook endpoint",
+			slog.String("endpoint", endpoint.ID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
@@ -0,0 +1,401 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/webhooks/handlers"
+	"github.com/archesai/archesai/pkg/webhooks/mocks"
+	"github.com/archesai/archesai/pkg/webhooks/models"
+)
+
+// store keeps endpoints and deliveries in memory behind the generated mocks.
+type store struct {
+	mu         sync.Mutex
+	endpoints  map[uuid.UUID]models.WebhookEndpoint
+	deliveries map[uuid.UUID]models.WebhookDelivery
+}
+
+func newStore() (*store, *mocks.WebhookEndpointRepository, *mocks.WebhookDeliveryRepository) {
+	s := &store{
+		endpoints:  make(map[uuid.UUID]models.WebhookEndpoint),
+		deliveries: make(map[uuid.UUID]models.WebhookDelivery),
+	}
+	endpoints := &mocks.WebhookEndpointRepository{
+		GetFunc: func(_ context.Context, id uuid.UUID) (*models.WebhookEndpoint, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			e, ok := s.endpoints[id]
+			if !ok {
+				return nil, models.ErrWebhookEndpointNotFound
+			}
+			return &e, nil
+		},
+		UpdateFunc: func(_ context.Context, id uuid.UUID, e *models.WebhookEndpoint) (*models.WebhookEndpoint, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.endpoints[id] = *e
+			return e, nil
+		},
+		ListFunc: func(ctx context.Context, _ database.ListOptions) ([]*models.WebhookEndpoint, database.PageInfo, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			tenant, _ := database.TenantFromContext(ctx)
+			var list []*models.WebhookEndpoint
+			for _, e := range s.endpoints {
+				if e.OrganizationID == tenant && e.Enabled {
+					list = append(list, &e)
+				}
+			}
+			return list, database.PageInfo{Total: int64(len(list))}, nil
+		},
+	}
+	deliveries := &mocks.WebhookDeliveryRepository{
+		CreateFunc: func(_ context.Context, d *models.WebhookDelivery) (*models.WebhookDelivery, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.deliveries[d.ID] = *d
+			return d, nil
+		},
+		GetFunc: func(_ context.Context, id uuid.UUID) (*models.WebhookDelivery, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			d, ok := s.deliveries[id]
+			if !ok {
+				return nil, models.ErrWebhookDeliveryNotFound
+			}
+			return &d, nil
+		},
+		UpdateFunc: func(_ context.Context, id uuid.UUID, d *models.WebhookDelivery) (*models.WebhookDelivery, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.deliveries[id] = *d
+			return d, nil
+		},
+	}
+	return s, endpoints, deliveries
+}
+
+func (s *store) addEndpoint(orgID uuid.UUID, url string, eventTypes ...string) uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := uuid.New()
+	s.endpoints[id] = models.WebhookEndpoint{
+		ID:             id,
+		OrganizationID: orgID,
+		URL:            url,
+		EventTypes:     eventTypes,
+		Secret:         "whsec_0123456789abcdef",
+		Enabled:        true,
+	}
+	return id
+}
+
+func (s *store) onlyDelivery(t *testing.T) models.WebhookDelivery {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	require.Len(t, s.deliveries, 1)
+	for _, d := range s.deliveries {
+		return d
+	}
+	return models.WebhookDelivery{}
+}
+
+type testEvent struct {
+	events.BaseEvent
+	RunID uuid.UUID `json:"run_id"`
+}
+
+func (e *testEvent) EventType() string { return e.Type }
+func (e *testEvent) EventData() any    { return e }
+
+// dispatchPublisher hands published events straight to a dispatcher.
+type dispatchPublisher struct{ d *Dispatcher }
+
+func (p dispatchPublisher) Publish(ctx context.Context, event events.Event) error {
+	return p.d.Dispatch(ctx, event)
+}
+
+func (p dispatchPublisher) PublishMultiple(ctx context.Context, batch []events.Event) error {
+	for _, event := range batch {
+		if err := p.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testConfig() Config {
+	return Config{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		DisableAfter:   1,
+		Timeout:        time.Second,
+		// Test servers listen on loopback
+		AllowPrivateAddresses: true,
+	}
+}
+
+func TestDispatcherRetriesUntilDelivered(t *testing.T) {
+	var calls atomic.Int32
+	var verifyErr atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := Verify("whsec_0123456789abcdef", r.Header.Get(HeaderSignature), body, time.Minute); err != nil {
+			verifyErr.Store(err)
+		}
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	s, endpoints, deliveries := newStore()
+	orgID := uuid.New()
+	s.addEndpoint(orgID, srv.URL, "run.*")
+	s.addEndpoint(orgID, srv.URL, "executor.created")
+	s.addEndpoint(uuid.New(), srv.URL, "*")
+
+	d := NewDispatcher(endpoints, deliveries, nil, testConfig())
+	ctx := database.WithTenant(context.Background(), orgID)
+	event := &testEvent{BaseEvent: events.NewBaseEvent("run", "run.updated"), RunID: uuid.New()}
+	require.NoError(t, d.Dispatch(ctx, event))
+	d.wg.Wait()
+
+	assert.Nil(t, verifyErr.Load())
+	assert.Equal(t, int32(2), calls.Load())
+	delivery := s.onlyDelivery(t)
+	assert.Equal(t, models.WebhookDeliveryStatusSucceeded, delivery.Status)
+	assert.Equal(t, int32(2), delivery.Attempts)
+	assert.Equal(t, "run.updated", delivery.EventType)
+	require.NotNil(t, delivery.ResponseStatus)
+	assert.Equal(t, int32(http.StatusNoContent), *delivery.ResponseStatus)
+	assert.Nil(t, delivery.LastError)
+}
+
+func TestDispatcherDisablesFailingEndpoint(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	s, endpoints, deliveries := newStore()
+	orgID := uuid.New()
+	endpointID := s.addEndpoint(orgID, srv.URL, "*")
+
+	d := NewDispatcher(endpoints, deliveries, nil, testConfig())
+	ctx := database.WithTenant(context.Background(), orgID)
+	require.NoError(t, d.Dispatch(ctx, &testEvent{BaseEvent: events.NewBaseEvent("run", "run.created")}))
+	d.wg.Wait()
+
+	assert.Equal(t, int32(3), calls.Load())
+	delivery := s.onlyDelivery(t)
+	assert.Equal(t, models.WebhookDeliveryStatusFailed, delivery.Status)
+	require.NotNil(t, delivery.LastError)
+	assert.Contains(t, *delivery.LastError, "unexpected status 500")
+
+	endpoint := s.endpoints[endpointID]
+	assert.False(t, endpoint.Enabled)
+	assert.NotNil(t, endpoint.DisabledAt)
+	assert.Equal(t, int32(1), endpoint.FailureCount)
+
+	// Disabled endpoints receive no new deliveries
+	require.NoError(t, d.Dispatch(ctx, &testEvent{BaseEvent: events.NewBaseEvent("run", "run.created")}))
+	d.wg.Wait()
+	assert.Len(t, s.deliveries, 1)
+}
+
+func TestDispatcherRefusesPrivateAddresses(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	s, endpoints, deliveries := newStore()
+	orgID := uuid.New()
+	s.addEndpoint(orgID, srv.URL, "*")
+
+	config := testConfig()
+	config.AllowPrivateAddresses = false
+	config.DisableAfter = 0
+	d := NewDispatcher(endpoints, deliveries, nil, config)
+	ctx := database.WithTenant(context.Background(), orgID)
+	require.NoError(t, d.Dispatch(ctx, &testEvent{BaseEvent: events.NewBaseEvent("run", "run.created")}))
+	d.wg.Wait()
+
+	// The attempt is not retried, as the address will not change
+	assert.Zero(t, calls.Load())
+	delivery := s.onlyDelivery(t)
+	assert.Equal(t, models.WebhookDeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, int32(1), delivery.Attempts)
+	require.NotNil(t, delivery.LastError)
+	assert.Contains(t, *delivery.LastError, "endpoint address is not allowed")
+}
+
+// pendingFunc lists pending deliveries with a function.
+type pendingFunc func(ctx context.Context) ([]PendingDelivery, error)
+
+func (f pendingFunc) ListPending(ctx context.Context) ([]PendingDelivery, error) {
+	return f(ctx)
+}
+
+func TestDispatcherResumesPendingDeliveries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	s, endpoints, deliveries := newStore()
+	orgID := uuid.New()
+	endpointID := s.addEndpoint(orgID, srv.URL, "*")
+
+	now := time.Now().UTC()
+	tests := []struct {
+		name          string
+		status        models.WebhookDeliveryStatus
+		nextAttemptAt *time.Time
+		want          models.WebhookDeliveryStatus
+		wantAttempts  int32
+	}{
+		{name: "overdue", status: models.WebhookDeliveryStatusPending, nextAttemptAt: &now, want: models.WebhookDeliveryStatusSucceeded, wantAttempts: 2},
+		{name: "never attempted", status: models.WebhookDeliveryStatusPending, want: models.WebhookDeliveryStatusSucceeded, wantAttempts: 2},
+		{name: "already failed", status: models.WebhookDeliveryStatusFailed, want: models.WebhookDeliveryStatusFailed, wantAttempts: 1},
+	}
+
+	var pending []PendingDelivery
+	ids := make(map[string]uuid.UUID)
+	for _, tt := range tests {
+		id := uuid.New()
+		ids[tt.name] = id
+		s.deliveries[id] = models.WebhookDelivery{
+			ID:             id,
+			Attempts:       1,
+			EndpointID:     endpointID,
+			EventType:      "run.created",
+			NextAttemptAt:  tt.nextAttemptAt,
+			OrganizationID: orgID,
+			Payload:        `{}`,
+			Status:         tt.status,
+		}
+		pending = append(pending, PendingDelivery{ID: id, OrganizationID: orgID})
+	}
+	// A delivery deleted since it was listed is skipped
+	pending = append(pending, PendingDelivery{ID: uuid.New(), OrganizationID: orgID})
+
+	lister := pendingFunc(func(context.Context) ([]PendingDelivery, error) {
+		return pending, nil
+	})
+	d := NewDispatcher(endpoints, deliveries, lister, testConfig())
+	require.NoError(t, d.Resume(context.Background()))
+	d.wg.Wait()
+
+	assert.Equal(t, int32(2), calls.Load())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := s.deliveries[ids[tt.name]]
+			assert.Equal(t, tt.want, delivery.Status)
+			assert.Equal(t, tt.wantAttempts, delivery.Attempts)
+		})
+	}
+}
+
+func TestDispatcherIgnoresWebhookEvents(t *testing.T) {
+	s, endpoints, deliveries := newStore()
+	orgID := uuid.New()
+	s.addEndpoint(orgID, "http://127.0.0.1:0", "*")
+
+	d := NewDispatcher(endpoints, deliveries, nil, testConfig())
+	ctx := database.WithTenant(context.Background(), orgID)
+	require.NoError(t, d.Dispatch(ctx, models.NewWebhookDeliveryUpdatedEvent(uuid.New())))
+	require.NoError(t, d.Dispatch(ctx, models.NewWebhookEndpointUpdatedEvent(uuid.New())))
+	d.wg.Wait()
+	assert.Empty(t, s.deliveries)
+}
+
+func TestRedeliverer(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) <= 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	s, endpoints, deliveries := newStore()
+	orgID := uuid.New()
+	s.addEndpoint(orgID, srv.URL, "run.*")
+
+	config := testConfig()
+	config.DisableAfter = 0
+	d := NewDispatcher(endpoints, deliveries, nil, config)
+	ctx := database.WithTenant(context.Background(), orgID)
+	require.NoError(t, d.Dispatch(ctx, &testEvent{BaseEvent: events.NewBaseEvent("run", "run.created")}))
+	d.wg.Wait()
+	require.Equal(t, models.WebhookDeliveryStatusFailed, s.onlyDelivery(t).Status)
+
+	out, err := NewRedeliverer(deliveries, dispatchPublisher{d}).Execute(
+		ctx,
+		&handlers.RedeliverWebhookDeliveryInput{ID: s.onlyDelivery(t).ID},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryStatusPending, out.Data.Status)
+	assert.Equal(t, int32(0), out.Data.Attempts)
+
+	d.wg.Wait()
+	delivery := s.onlyDelivery(t)
+	assert.Equal(t, models.WebhookDeliveryStatusSucceeded, delivery.Status)
+	assert.Equal(t, int32(1), delivery.Attempts)
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "93.184.216.34", want: true},
+		{addr: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{addr: "127.0.0.1"},
+		{addr: "::1"},
+		{addr: "10.1.2.3"},
+		{addr: "172.16.0.1"},
+		{addr: "192.168.1.1"},
+		{addr: "fd00::1"},
+		{addr: "169.254.169.254"},
+		{addr: "fe80::1"},
+		{addr: "0.0.0.0"},
+		{addr: "::"},
+		{addr: "224.0.0.1"},
+		{addr: "::ffff:127.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublicAddress(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
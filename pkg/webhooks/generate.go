@@ -0,0 +1,9 @@
+//go:generate go run ../../cmd/archesai generate --spec ./api/openapi.yaml --output . --only models,routes,handlers,repositories,mocks,bootstrap_handlers,bootstrap_routes --pretty
+package webhooks
+
+import "embed"
+
+// APISpec embeds the OpenAPI specification files for the webhooks package.
+//
+//go:embed api
+var APISpec embed.FS
@@ -0,0 +1,120 @@
+package handlers
+
+// NOTE: This file is user-editable. The generator will not overwrite it.
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/server"
+	"github.com/archesai/archesai/pkg/webhooks/models"
+	"github.com/archesai/archesai/pkg/webhooks/repositories"
+)
+
+// SecretPrefix starts every generated endpoint secret.
+const SecretPrefix = "whsec_"
+
+// Ensure CreateWebhookEndpointImpl implements CreateWebhookEndpoint
+var _ CreateWebhookEndpoint = (*CreateWebhookEndpointImpl)(nil)
+
+// CreateWebhookEndpointImpl implements the CreateWebhookEndpoint interface.
+type CreateWebhookEndpointImpl struct {
+	repo repositories.WebhookEndpointRepository
+}
+
+// NewCreateWebhookEndpoint creates a new CreateWebhookEndpoint implementation.
+// It has no repository until the app replaces it with
+// NewCreateWebhookEndpointWith.
+func NewCreateWebhookEndpoint() CreateWebhookEndpoint {
+	return &CreateWebhookEndpointImpl{}
+}
+
+// NewCreateWebhookEndpointWith creates a CreateWebhookEndpoint implementation
+// that stores endpoints in repo.
+func NewCreateWebhookEndpointWith(repo repositories.WebhookEndpointRepository) CreateWebhookEndpoint {
+	return &CreateWebhookEndpointImpl{repo: repo}
+}
+
+// Execute registers an endpoint in the caller's organization. Endpoints are
+// enabled unless the input says otherwise. A secret is generated when the
+// input has none; either way it is returned once, in the output.
+func (h *CreateWebhookEndpointImpl) Execute(
+	ctx context.Context,
+	input *CreateWebhookEndpointInput,
+) (*CreateWebhookEndpointOutput, error) {
+	if h.repo == nil {
+		return nil, fmt.Errorf("not implemented")
+	}
+	organizationID, err := database.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v := server.NewValidator()
+	validateEndpointURL(v, input.URL)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	secret := ""
+	if input.Secret != nil {
+		secret = *input.Secret
+	} else if secret, err = NewSecret(); err != nil {
+		return nil, err
+	}
+
+	enabled := true
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+	entity, err := models.NewWebhookEndpoint(
+		input.Description,
+		nil,
+		enabled,
+		input.EventTypes,
+		0,
+		organizationID,
+		input.URL,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhookendpoint: %w", err)
+	}
+	entity.Secret = secret
+
+	created, err := h.repo.Create(ctx, entity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhookendpoint: %w", err)
+	}
+	return &CreateWebhookEndpointOutput{Data: *created, Secret: secret}, nil
+}
+
+// NewSecret generates an endpoint secret: SecretPrefix followed by 32 random
+// bytes in hex.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return SecretPrefix + hex.EncodeToString(b), nil
+}
+
+// validateEndpointURL checks that raw is an http or https URL with a host and
+// without credentials. Whether the address may be posted to depends on the
+// dispatcher's configuration, so it is checked on each delivery instead.
+func validateEndpointURL(v *server.Validator, raw string) {
+	u, err := url.Parse(raw)
+	switch {
+	case err != nil:
+		v.Add("/url", "must be a valid uri")
+	case u.Scheme != "http" && u.Scheme != "https":
+		v.Add("/url", "must use http or https")
+	case u.Hostname() == "":
+		v.Add("/url", "must have a host")
+	case u.User != nil:
+		v.Add("/url", "must not contain credentials")
+	}
+}
@@ -0,0 +1,29 @@
+package handlers
+
+// NOTE: This file is user-editable. The generator will not overwrite it.
+
+import (
+	"context"
+	"fmt"
+)
+
+// Ensure RedeliverWebhookDeliveryImpl implements RedeliverWebhookDelivery
+var _ RedeliverWebhookDelivery = (*RedeliverWebhookDeliveryImpl)(nil)
+
+// RedeliverWebhookDeliveryImpl implements the RedeliverWebhookDelivery interface.
+type RedeliverWebhookDeliveryImpl struct{}
+
+// NewRedeliverWebhookDelivery creates a new RedeliverWebhookDelivery implementation.
+func NewRedeliverWebhookDelivery() RedeliverWebhookDelivery {
+	return &RedeliverWebhookDeliveryImpl{}
+}
+
+// Execute is not implemented here. Redelivery needs the event publisher the
+// dispatcher listens on, so the application container replaces this handler
+// with webhooks.Redeliverer.
+func (h *RedeliverWebhookDeliveryImpl) Execute(
+	_ context.Context,
+	_ *RedeliverWebhookDeliveryInput,
+) (*RedeliverWebhookDeliveryOutput, error) {
+	return nil, fmt.Errorf("not implemented")
+}
@@ -0,0 +1,82 @@
+package handlers
+
+// NOTE: This file is user-editable. The generator will not overwrite it.
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/archesai/archesai/pkg/server"
+	"github.com/archesai/archesai/pkg/webhooks/repositories"
+)
+
+// Ensure UpdateWebhookEndpointImpl implements UpdateWebhookEndpoint
+var _ UpdateWebhookEndpoint = (*UpdateWebhookEndpointImpl)(nil)
+
+// UpdateWebhookEndpointImpl implements the UpdateWebhookEndpoint interface.
+type UpdateWebhookEndpointImpl struct {
+	repo repositories.WebhookEndpointRepository
+}
+
+// NewUpdateWebhookEndpoint creates a new UpdateWebhookEndpoint implementation.
+// It has no repository until the app replaces it with
+// NewUpdateWebhookEndpointWith.
+func NewUpdateWebhookEndpoint() UpdateWebhookEndpoint {
+	return &UpdateWebhookEndpointImpl{}
+}
+
+// NewUpdateWebhookEndpointWith creates an UpdateWebhookEndpoint
+// implementation that stores endpoints in repo.
+func NewUpdateWebhookEndpointWith(repo repositories.WebhookEndpointRepository) UpdateWebhookEndpoint {
+	return &UpdateWebhookEndpointImpl{repo: repo}
+}
+
+// Execute changes the fields set in the input. Enabling an endpoint the
+// dispatcher disabled resets its failure count.
+func (h *UpdateWebhookEndpointImpl) Execute(
+	ctx context.Context,
+	input *UpdateWebhookEndpointInput,
+) (*UpdateWebhookEndpointOutput, error) {
+	if h.repo == nil {
+		return nil, fmt.Errorf("not implemented")
+	}
+	if input.URL != nil {
+		v := server.NewValidator()
+		validateEndpointURL(v, *input.URL)
+		if err := v.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := h.repo.Get(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhookendpoint: %w", err)
+	}
+
+	if input.Description != nil {
+		existing.Description = input.Description
+	}
+	if input.Enabled != nil {
+		if *input.Enabled && !existing.Enabled {
+			existing.FailureCount = 0
+		}
+		existing.Enabled = *input.Enabled
+	}
+	if input.EventTypes != nil {
+		existing.EventTypes = input.EventTypes
+	}
+	if input.Secret != nil {
+		existing.Secret = *input.Secret
+	}
+	if input.URL != nil {
+		existing.URL = *input.URL
+	}
+	existing.UpdatedAt = time.Now().UTC()
+
+	updated, err := h.repo.Update(ctx, input.ID, existing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhookendpoint: %w", err)
+	}
+	return &UpdateWebhookEndpointOutput{Data: *updated}, nil
+}
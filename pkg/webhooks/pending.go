@@ -0,0 +1,72 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/database"
+	"github.com/archesai/archesai/pkg/webhooks/models"
+)
+
+// DeliveryTable is the table the webhook deliveries are stored in.
+const DeliveryTable = "webhook_delivery"
+
+// PendingDelivery identifies a delivery that is still pending.
+type PendingDelivery struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+}
+
+// PendingLister lists the pending deliveries of every organization. The
+// Dispatcher resumes them when it starts.
+type PendingLister interface {
+	ListPending(ctx context.Context) ([]PendingDelivery, error)
+}
+
+// SQLPendingLister reads the pending deliveries from the delivery table.
+type SQLPendingLister struct {
+	db *database.Database
+}
+
+var _ PendingLister = (*SQLPendingLister)(nil)
+
+// NewSQLPendingLister creates a lister that reads the deliveries of db.
+func NewSQLPendingLister(db *database.Database) *SQLPendingLister {
+	return &SQLPendingLister{db: db}
+}
+
+// ListPending returns the pending deliveries of every organization, the ones
+// due first first.
+func (l *SQLPendingLister) ListPending(ctx context.Context) ([]PendingDelivery, error) {
+	rows, err := l.db.SQLDB().QueryContext(ctx,
+		`SELECT id, organization_id FROM "`+DeliveryTable+`"
+		WHERE status = '`+string(models.WebhookDeliveryStatusPending)+`'
+		ORDER BY next_attempt_at, id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending webhook deliveries: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var pending []PendingDelivery
+	for rows.Next() {
+		var id, organizationID string
+		if err := rows.Scan(&id, &organizationID); err != nil {
+			return nil, fmt.Errorf("failed to list pending webhook deliveries: %w", err)
+		}
+		delivery := PendingDelivery{}
+		if delivery.ID, err = uuid.Parse(id); err != nil {
+			return nil, fmt.Errorf("invalid webhook delivery ID %q: %w", id, err)
+		}
+		if delivery.OrganizationID, err = uuid.Parse(organizationID); err != nil {
+			return nil, fmt.Errorf("invalid organization ID %q: %w", organizationID, err)
+		}
+		pending = append(pending, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list pending webhook deliveries: %w", err)
+	}
+	return pending, nil
+}
@@ -0,0 +1,93 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/archesai/archesai/pkg/events"
+	"github.com/archesai/archesai/pkg/webhooks/handlers"
+	"github.com/archesai/archesai/pkg/webhooks/models"
+	"github.com/archesai/archesai/pkg/webhooks/repositories"
+)
+
+// EventRedeliveryRequested is published when a delivery is redelivered. The
+// Dispatcher schedules the delivery when it receives it.
+const EventRedeliveryRequested = "webhookdelivery.redeliveryrequested"
+
+// RedeliveryRequestedEvent asks the Dispatcher to attempt a delivery again.
+type RedeliveryRequestedEvent struct {
+	events.BaseEvent
+	WebhookDeliveryID uuid.UUID `json:"webhookdelivery_id"`
+}
+
+// NewRedeliveryRequestedEvent creates a new redelivery request for a delivery.
+func NewRedeliveryRequestedEvent(id uuid.UUID) *RedeliveryRequestedEvent {
+	return &RedeliveryRequestedEvent{
+		BaseEvent:         events.NewBaseEvent("webhookdelivery", EventRedeliveryRequested),
+		WebhookDeliveryID: id,
+	}
+}
+
+// EventType returns the type of this event.
+func (e *RedeliveryRequestedEvent) EventType() string {
+	return EventRedeliveryRequested
+}
+
+// EventData returns the event data.
+func (e *RedeliveryRequestedEvent) EventData() any {
+	return e
+}
+
+// Redeliverer implements the RedeliverWebhookDelivery operation. It replaces
+// the generated stub in the application container.
+type Redeliverer struct {
+	deliveries repositories.WebhookDeliveryRepository
+	publisher  events.Publisher
+}
+
+var _ handlers.RedeliverWebhookDelivery = (*Redeliverer)(nil)
+
+// NewRedeliverer creates a redeliverer that hands deliveries to the
+// Dispatcher through the publisher.
+func NewRedeliverer(
+	deliveries repositories.WebhookDeliveryRepository,
+	publisher events.Publisher,
+) *Redeliverer {
+	return &Redeliverer{
+		deliveries: deliveries,
+		publisher:  publisher,
+	}
+}
+
+// Execute resets the delivery to pending with a fresh retry budget and asks
+// the Dispatcher to attempt it. The original payload is sent again, under the
+// same delivery ID.
+func (r *Redeliverer) Execute(
+	ctx context.Context,
+	input *handlers.RedeliverWebhookDeliveryInput,
+) (*handlers.RedeliverWebhookDeliveryOutput, error) {
+	delivery, err := r.deliveries.Get(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	now := time.Now().UTC()
+	delivery.Status = models.WebhookDeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = &now
+	delivery.UpdatedAt = now
+
+	updated, err := r.deliveries.Update(ctx, delivery.ID, delivery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	if err := r.publisher.Publish(ctx, NewRedeliveryRequestedEvent(updated.ID)); err != nil {
+		return nil, fmt.Errorf("failed to request redelivery: %w", err)
+	}
+
+	return &handlers.RedeliverWebhookDeliveryOutput{Data: *updated}, nil
+}
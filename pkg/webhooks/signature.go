@@ -0,0 +1,79 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers sent with every delivery.
+const (
+	// HeaderEvent is the type of the delivered event.
+	HeaderEvent = "X-Arches-Event"
+	// HeaderDelivery is the ID of the delivery, which stays the same across
+	// retries and redeliveries.
+	HeaderDelivery = "X-Arches-Delivery"
+	// HeaderSignature signs the timestamp and body of the request.
+	HeaderSignature = "X-Arches-Signature"
+)
+
+// ErrInvalidSignature is returned by Verify when a signature header does not
+// match the payload or is too old.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Sign returns the X-Arches-Signature header for a payload sent at the given
+// time, in the form "t=<unix seconds>,v1=<hex HMAC-SHA256>". The MAC covers the
+// timestamp, a dot and the payload, so a captured request cannot be replayed
+// with a new timestamp.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac(secret, t, payload))
+}
+
+// Verify checks an X-Arches-Signature header against a received payload.
+// Signatures older than the tolerance are rejected; a zero tolerance accepts
+// any age.
+func Verify(secret, header string, payload []byte, tolerance time.Duration) error {
+	var t string
+	var signatures [][]byte
+	for part := range strings.SplitSeq(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			if sig, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, sig)
+			}
+		}
+	}
+
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return fmt.Errorf("%w: malformed header", ErrInvalidSignature)
+	}
+	if tolerance > 0 && time.Since(time.Unix(unix, 0)) > tolerance {
+		return fmt.Errorf("%w: timestamp outside tolerance", ErrInvalidSignature)
+	}
+
+	expected := mac(secret, t, payload)
+	for _, sig := range signatures {
+		if hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+func mac(secret, timestamp string, payload []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(payload)
+	return h.Sum(nil)
+}
@@ -0,0 +1,69 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	secret := "whsec_0123456789abcdef"
+	payload := []byte(`{"type":"run.updated"}`)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		secret    string
+		header    string
+		payload   []byte
+		tolerance time.Duration
+		wantErr   bool
+	}{
+		{name: "valid", secret: secret, header: Sign(secret, now, payload), payload: payload, tolerance: time.Minute},
+		{name: "tampered payload", secret: secret, header: Sign(secret, now, payload), payload: []byte(`{}`), wantErr: true},
+		{name: "wrong secret", secret: "whsec_fedcba9876543210", header: Sign(secret, now, payload), payload: payload, wantErr: true},
+		{
+			name:      "too old",
+			secret:    secret,
+			header:    Sign(secret, now.Add(-time.Hour), payload),
+			payload:   payload,
+			tolerance: time.Minute,
+			wantErr:   true,
+		},
+		{name: "any age", secret: secret, header: Sign(secret, now.Add(-time.Hour), payload), payload: payload},
+		{name: "malformed", secret: secret, header: "v1=zz", payload: payload, wantErr: true},
+		{name: "extra signature", secret: secret, header: Sign(secret, now, payload) + ",v1=00ff", payload: payload},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, tt.payload, tt.tolerance)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidSignature)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMatchesEventType(t *testing.T) {
+	tests := []struct {
+		filters   []string
+		eventType string
+		want      bool
+	}{
+		{filters: []string{"run.updated"}, eventType: "run.updated", want: true},
+		{filters: []string{"run.created"}, eventType: "run.updated", want: false},
+		{filters: []string{"run.*"}, eventType: "run.deleted", want: true},
+		{filters: []string{"run.*"}, eventType: "runner.created", want: false},
+		{filters: []string{"executor.created", "*"}, eventType: "pipeline.updated", want: true},
+		{filters: nil, eventType: "run.updated", want: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, MatchesEventType(tt.filters, tt.eventType), "%v %s", tt.filters, tt.eventType)
+	}
+}